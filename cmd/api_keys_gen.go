@@ -0,0 +1,229 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by svcgen cli. DO NOT EDIT.
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/api_keys"
+)
+
+// apiKeysCommand returns the api-keys command with a subcommand per method of api_keys.Service.
+func apiKeysCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "api-keys",
+		Usage: "Manage API keys",
+		Description: `Examples:
+  onemoney-cli api-keys create --name NAME --scopes SCOPES
+  onemoney-cli api-keys list
+  onemoney-cli api-keys rotate --key-id KEY_ID --grace-period-seconds GRACE_PERIOD_SECONDS
+  onemoney-cli api-keys revoke --key-id KEY_ID`,
+		Subcommands: []*cli.Command{
+			{
+				Name:  "create",
+				Usage: "Create a new API key",
+				Description: `Examples:
+  onemoney-cli api-keys create --name NAME --scopes SCOPES`,
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:    "file",
+						Aliases: []string{"f"},
+						Usage:   "Path to a CreateAPIKeyRequest in JSON or YAML (\"-\" for stdin); flags override its fields",
+					},
+					&cli.StringFlag{Name: "idempotency-key", Usage: "A unique key to ensure idempotent creation"},
+					&cli.StringFlag{Name: "name", Usage: "A label identifying the key, e.g. \"reporting\""},
+					&cli.StringSliceFlag{Name: "scopes", Usage: "The permissions granted to the key"},
+					&cli.StringFlag{
+						Name:  "expires-at",
+						Usage: "When the key stops working (ISO 8601 format, optional)",
+					},
+					&cli.StringSliceFlag{
+						Name:  "allowed-ips",
+						Usage: "AllowedIPs restricts the key to these IP addresses or CIDR ranges (optional)",
+					},
+				},
+				Action: apiKeysCreate,
+			},
+			{
+				Name:  "list",
+				Usage: "Retrieve API keys matching the filters",
+				Description: `Examples:
+  onemoney-cli api-keys list`,
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:    "file",
+						Aliases: []string{"f"},
+						Usage:   "Path to a ListAPIKeysRequest in JSON or YAML (\"-\" for stdin); flags override its fields",
+					},
+					&cli.StringFlag{Name: "status", Usage: "Status filters by key status"},
+					&cli.IntFlag{Name: "page", Usage: "The page number (starts from 1)"},
+					&cli.IntFlag{Name: "size", Usage: "The number of items per page (1-100)"},
+				},
+				Action: apiKeysList,
+			},
+			{
+				Name:  "rotate",
+				Usage: "Issue a new secret for an API key",
+				Description: `Issues a new secret for an API key. The previous secret stays valid
+for the requested grace period, during which the old key is EXPIRING.
+
+Examples:
+  onemoney-cli api-keys rotate --key-id KEY_ID --grace-period-seconds GRACE_PERIOD_SECONDS`,
+				Flags: []cli.Flag{
+					&cli.StringFlag{Name: "key-id", Usage: "Key ID", Required: true},
+					&cli.StringFlag{
+						Name:    "file",
+						Aliases: []string{"f"},
+						Usage:   "Path to a RotateAPIKeyRequest in JSON or YAML (\"-\" for stdin); flags override its fields",
+					},
+					&cli.IntFlag{
+						Name:  "grace-period-seconds",
+						Usage: "How long the previous secret remains valid. Zero revokes it immediately",
+					},
+				},
+				Action: apiKeysRotate,
+			},
+			{
+				Name:  "revoke",
+				Usage: "Revoke an API key immediately",
+				Description: `Examples:
+  onemoney-cli api-keys revoke --key-id KEY_ID`,
+				Flags: []cli.Flag{
+					&cli.StringFlag{Name: "key-id", Usage: "Key ID", Required: true},
+				},
+				Action: apiKeysRevoke,
+			},
+		},
+	}
+}
+
+func apiKeysCreate(c *cli.Context) error {
+	req := &api_keys.CreateAPIKeyRequest{}
+	if c.IsSet("file") {
+		if err := readRequestFile(c.String("file"), req); err != nil {
+			return err
+		}
+	}
+	if c.IsSet("idempotency-key") {
+		req.IdempotencyKey = c.String("idempotency-key")
+	}
+	if c.IsSet("name") {
+		req.Name = c.String("name")
+	}
+	if c.IsSet("scopes") {
+		req.Scopes = nil
+		for _, s := range c.StringSlice("scopes") {
+			v, err := api_keys.ParseScope(s)
+			if err != nil {
+				return err
+			}
+			req.Scopes = append(req.Scopes, v)
+		}
+	}
+	if c.IsSet("expires-at") {
+		v := c.String("expires-at")
+		req.ExpiresAt = &v
+	}
+	if c.IsSet("allowed-ips") {
+		req.AllowedIPs = c.StringSlice("allowed-ips")
+	}
+
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	result, err := client.APIKeys.CreateAPIKey(context.Background(), req)
+	if err != nil {
+		return fmt.Errorf("failed to create API key: %w", err)
+	}
+	return printOutput(result)
+}
+
+func apiKeysList(c *cli.Context) error {
+	req := &api_keys.ListAPIKeysRequest{}
+	if c.IsSet("file") {
+		if err := readRequestFile(c.String("file"), req); err != nil {
+			return err
+		}
+	}
+	if c.IsSet("status") {
+		v, err := api_keys.ParseAPIKeyStatus(c.String("status"))
+		if err != nil {
+			return err
+		}
+		req.Status = v
+	}
+	if c.IsSet("page") {
+		req.Page = c.Int("page")
+	}
+	if c.IsSet("size") {
+		req.Size = c.Int("size")
+	}
+
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	result, err := client.APIKeys.ListAPIKeys(context.Background(), req)
+	if err != nil {
+		return fmt.Errorf("failed to list API keys: %w", err)
+	}
+	return printOutput(result)
+}
+
+func apiKeysRotate(c *cli.Context) error {
+	req := &api_keys.RotateAPIKeyRequest{}
+	if c.IsSet("file") {
+		if err := readRequestFile(c.String("file"), req); err != nil {
+			return err
+		}
+	}
+	if c.IsSet("grace-period-seconds") {
+		req.GracePeriodSeconds = c.Int("grace-period-seconds")
+	}
+
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	result, err := client.APIKeys.RotateAPIKey(context.Background(), c.String("key-id"), req)
+	if err != nil {
+		return fmt.Errorf("failed to rotate API key: %w", err)
+	}
+	return printOutput(result)
+}
+
+func apiKeysRevoke(c *cli.Context) error {
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	result, err := client.APIKeys.RevokeAPIKey(context.Background(), c.String("key-id"))
+	if err != nil {
+		return fmt.Errorf("failed to revoke API key: %w", err)
+	}
+	return printOutput(result)
+}
@@ -0,0 +1,249 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/1Money-Co/1money-go-sdk/cmd/output"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/rates"
+)
+
+// assetColumns selects the fields shown when balances are printed as a table or CSV.
+const assetColumns = `[*].{asset: asset, network: network, available_amount: available_amount, ` +
+	`unavailable_amount: unavailable_amount, modified_at: modified_at}`
+
+// usdDecimals is the number of decimals USD-equivalent values are rounded to.
+const usdDecimals = 2
+
+// assetsCommand returns the assets command with all its subcommands.
+func assetsCommand() *cli.Command {
+	return &cli.Command{
+		Name:    "assets",
+		Aliases: []string{"balances"},
+		Usage:   "Show customer balances",
+		Description: `Examples:
+  onemoney-cli assets list -c CUSTOMER_ID --non-zero
+  onemoney-cli assets portfolio -c CUSTOMER_ID`,
+		Subcommands: []*cli.Command{
+			{
+				Name:  "list",
+				Usage: "List balances per asset and network",
+				Description: `Prints a table by default; use -o json for the full response.
+
+Examples:
+  onemoney-cli assets list -c CUSTOMER_ID --non-zero
+  onemoney-cli assets list -c CUSTOMER_ID --asset USDC --sort DESC
+  onemoney-cli -o csv assets list -c CUSTOMER_ID > balances.csv`,
+				Flags: []cli.Flag{
+					customerFlag(),
+					&cli.StringFlag{Name: "asset", Usage: "Filter by asset, e.g. USD or USDC"},
+					&cli.StringFlag{Name: "network", Usage: "Filter by network, e.g. ETHEREUM"},
+					&cli.StringFlag{Name: "sort", Usage: "Sort order: ASC or DESC"},
+					&cli.BoolFlag{Name: "non-zero", Usage: "Hide balances that are zero"},
+				},
+				Action: assetsList,
+			},
+			{
+				Name:  "portfolio",
+				Usage: "Show the total balance of each asset and its USD equivalent",
+				Description: `Sums every network's available and unavailable balance per asset and values it
+at the current rate against USD. The last row is the USD total of the portfolio.
+
+Examples:
+  onemoney-cli assets portfolio -c CUSTOMER_ID
+  onemoney-cli -o json assets portfolio -c CUSTOMER_ID`,
+				Flags: []cli.Flag{
+					customerFlag(),
+				},
+				Action: assetsPortfolio,
+			},
+		},
+	}
+}
+
+func assetsList(c *cli.Context) error {
+	req := &assets.ListAssetsRequest{}
+	var err error
+	if c.IsSet("asset") {
+		if req.Asset, err = assets.ParseAssetName(c.String("asset")); err != nil {
+			return err
+		}
+	}
+	if c.IsSet("network") {
+		if req.Network, err = assets.ParseNetworkName(c.String("network")); err != nil {
+			return err
+		}
+	}
+	if c.IsSet("sort") {
+		if req.SortOrder, err = assets.ParseSortOrder(c.String("sort")); err != nil {
+			return err
+		}
+	}
+
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	balances, err := client.Assets.ListAssets(context.Background(), customerIDFlag(c), req)
+	if err != nil {
+		return fmt.Errorf("failed to list assets: %w", err)
+	}
+
+	if c.Bool("non-zero") {
+		nonZero := balances[:0]
+		for _, b := range balances {
+			available, unavailable, err := parseBalance(&b)
+			if err != nil || available.Sign() != 0 || unavailable.Sign() != 0 {
+				nonZero = append(nonZero, b)
+			}
+		}
+		balances = nonZero
+	}
+
+	return printView(balances, output.View{Format: output.FormatTable, Columns: assetColumns})
+}
+
+// portfolioRow is the total balance of one asset across networks and its USD equivalent.
+type portfolioRow struct {
+	Asset       string `json:"asset"`
+	Available   string `json:"available"`
+	Unavailable string `json:"unavailable"`
+	Total       string `json:"total"`
+	USDRate     string `json:"usd_rate"`
+	USDValue    string `json:"usd_value"`
+}
+
+func assetsPortfolio(c *cli.Context) error {
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	ctx := context.Background()
+	balances, err := client.Assets.ListAssets(ctx, customerIDFlag(c), nil)
+	if err != nil {
+		return fmt.Errorf("failed to list assets: %w", err)
+	}
+
+	type sums struct{ available, unavailable *big.Rat }
+	byAsset := make(map[string]*sums)
+	for i := range balances {
+		available, unavailable, err := parseBalance(&balances[i])
+		if err != nil {
+			return err
+		}
+
+		s := byAsset[balances[i].Asset]
+		if s == nil {
+			s = &sums{new(big.Rat), new(big.Rat)}
+			byAsset[balances[i].Asset] = s
+		}
+		s.available.Add(s.available, available)
+		s.unavailable.Add(s.unavailable, unavailable)
+	}
+
+	names := make([]string, 0, len(byAsset))
+	for name := range byAsset {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	now := time.Now()
+	totalUSD := new(big.Rat)
+	rows := make([]portfolioRow, 0, len(names)+1)
+	for _, name := range names {
+		s := byAsset[name]
+		total := new(big.Rat).Add(s.available, s.unavailable)
+		row := portfolioRow{
+			Asset:       name,
+			Available:   formatAmount(s.available),
+			Unavailable: formatAmount(s.unavailable),
+			Total:       formatAmount(total),
+		}
+
+		rate, err := usdRate(ctx, client.Rates, name, now)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: no USD value for %s: %v\n", name, err)
+		} else {
+			value := new(big.Rat).Mul(total, rate)
+			totalUSD.Add(totalUSD, value)
+			row.USDRate = formatAmount(rate)
+			row.USDValue = value.FloatString(usdDecimals)
+		}
+		rows = append(rows, row)
+	}
+	rows = append(rows, portfolioRow{Asset: "TOTAL", USDValue: totalUSD.FloatString(usdDecimals)})
+
+	return printView(rows, output.View{Format: output.FormatTable})
+}
+
+// usdRate returns the rate of one unit of the asset in USD at the given time.
+func usdRate(ctx context.Context, service rates.Service, asset string, at time.Time) (*big.Rat, error) {
+	if asset == string(assets.AssetNameUSD) {
+		return big.NewRat(1, 1), nil
+	}
+	resp, err := service.GetHistoricalRate(ctx, rates.Pair{
+		Base:  assets.AssetName(asset),
+		Quote: assets.AssetNameUSD,
+	}, at)
+	if err != nil {
+		return nil, err
+	}
+	rate, ok := new(big.Rat).SetString(resp.Rate)
+	if !ok {
+		return nil, fmt.Errorf("invalid rate %q", resp.Rate)
+	}
+	return rate, nil
+}
+
+// parseBalance parses the available and unavailable amounts of a balance. An empty amount is zero.
+func parseBalance(b *assets.AssetResponse) (available, unavailable *big.Rat, err error) {
+	available, ok := new(big.Rat).SetString(orZero(b.AvailableAmount))
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid available amount %q for %s", b.AvailableAmount, b.Asset)
+	}
+	unavailable, ok = new(big.Rat).SetString(orZero(b.UnavailableAmount))
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid unavailable amount %q for %s", b.UnavailableAmount, b.Asset)
+	}
+	return available, unavailable, nil
+}
+
+func orZero(amount string) string {
+	if amount == "" {
+		return "0"
+	}
+	return amount
+}
+
+// formatAmount formats an exact amount as a decimal without trailing zeros.
+func formatAmount(r *big.Rat) string {
+	s := r.FloatString(18)
+	s = strings.TrimRight(s, "0")
+	return strings.TrimSuffix(s, ".")
+}
@@ -0,0 +1,188 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/1Money-Co/1money-go-sdk/cmd/output"
+	"github.com/1Money-Co/1money-go-sdk/internal/utils"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/auto_conversion_rules"
+)
+
+// Columns shown when auto conversion rules and orders are printed as a table or CSV.
+const (
+	ruleColumns = `items[*].{auto_conversion_rule_id: auto_conversion_rule_id, nickname: nickname, ` +
+		`status: status, source: source.asset, source_network: source.network, ` +
+		`destination: destination.asset, destination_network: destination.network, created_at: created_at}`
+	orderColumns = `items[*].{auto_conversion_order_id: auto_conversion_order_id, ` +
+		`auto_conversion_rule_id: auto_conversion_rule_id, status: status, amount: receipt.initial.amount, ` +
+		`asset: receipt.initial.asset, destination: destination.asset, created_at: created_at}`
+)
+
+// autoConversionRulesCommand returns the auto-conversion-rules command with all its subcommands.
+func autoConversionRulesCommand() *cli.Command {
+	return &cli.Command{
+		Name:    "auto-conversion-rules",
+		Aliases: []string{"acr"},
+		Usage:   "Inspect auto conversion rules and their orders",
+		Description: `Examples:
+  onemoney-cli -o table auto-conversion-rules list -c CUSTOMER_ID
+  onemoney-cli auto-conversion-rules orders list -c CUSTOMER_ID --rule RULE_ID --watch`,
+		Subcommands: []*cli.Command{
+			{
+				Name:  "list",
+				Usage: "List auto conversion rules",
+				Description: `Examples:
+  onemoney-cli -o table auto-conversion-rules list -c CUSTOMER_ID --page 2 --size 50`,
+				Flags: []cli.Flag{
+					customerFlag(),
+					&cli.IntFlag{Name: "page", Usage: "Page number, starting from 1"},
+					&cli.IntFlag{Name: "size", Usage: "Number of rules per page"},
+				},
+				Action: autoConversionRulesList,
+			},
+			{
+				Name:  "orders",
+				Usage: "Inspect auto conversion orders",
+				Subcommands: []*cli.Command{
+					{
+						Name:  "list",
+						Usage: "List auto conversion orders of one rule or of every rule",
+						Description: `With --watch, re-renders the list until every order is completed or failed.
+
+Examples:
+  onemoney-cli -o table auto-conversion-rules orders list -c CUSTOMER_ID
+  onemoney-cli auto-conversion-rules orders list -c CUSTOMER_ID --rule RULE_ID --status "Deposit Completed"
+  onemoney-cli -o table auto-conversion-rules orders list -c CUSTOMER_ID --rule RULE_ID --watch`,
+						Flags: append([]cli.Flag{
+							customerFlag(),
+							&cli.StringFlag{Name: "rule", Usage: "Only list orders of this rule"},
+							&cli.StringFlag{Name: "status", Usage: "Filter by order status"},
+							&cli.IntFlag{Name: "page", Usage: "Page number, starting from 1"},
+							&cli.IntFlag{Name: "size", Usage: "Number of orders per page"},
+						}, watchFlags(
+							auto_conversion_rules.DefaultWaitOptions().PollInterval,
+							auto_conversion_rules.DefaultWaitOptions().MaxWaitTime,
+						)...),
+						Action: autoConversionOrdersList,
+					},
+				},
+			},
+		},
+	}
+}
+
+func autoConversionRulesList(c *cli.Context) error {
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	ctx := context.Background()
+
+	resp, err := client.AutoConversionRules.ListRules(ctx, customerIDFlag(c), &auto_conversion_rules.ListRulesRequest{
+		Page: c.Int("page"),
+		Size: c.Int("size"),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list auto conversion rules: %w", err)
+	}
+
+	return printView(resp, output.View{Columns: ruleColumns})
+}
+
+func autoConversionOrdersList(c *cli.Context) error {
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	customerID := customerIDFlag(c)
+	ruleID := c.String("rule")
+	listOrders := func(ctx context.Context) (*auto_conversion_rules.ListOrdersResponse, error) {
+		var (
+			resp *auto_conversion_rules.ListOrdersResponse
+			err  error
+		)
+		if ruleID != "" {
+			resp, err = client.AutoConversionRules.ListOrders(ctx, customerID, ruleID,
+				&auto_conversion_rules.ListOrdersRequest{
+					Status: c.String("status"),
+					Page:   c.Int("page"),
+					Size:   c.Int("size"),
+				})
+		} else {
+			resp, err = client.AutoConversionRules.ListAllOrders(ctx, customerID,
+				&auto_conversion_rules.ListAllOrdersRequest{
+					Status: c.String("status"),
+					Page:   c.Int("page"),
+					Size:   c.Int("size"),
+				})
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list auto conversion orders: %w", err)
+		}
+		return resp, nil
+	}
+	view := output.View{Columns: orderColumns}
+
+	if c.Bool("watch") {
+		ctx, cancel := watchContext()
+		defer cancel()
+
+		r := &watchRenderer{view: view}
+		_, err := utils.WaitFor(ctx, listOrders,
+			func(resp *auto_conversion_rules.ListOrdersResponse) bool {
+				r.render(resp)
+				return pendingOrders(resp) == 0
+			},
+			func(resp *auto_conversion_rules.ListOrdersResponse) string {
+				return strconv.Itoa(pendingOrders(resp)) + " pending"
+			},
+			"auto_conversion_orders",
+			customerID,
+			&utils.WaitOptions{
+				PollInterval: c.Duration("poll-interval"),
+				MaxWaitTime:  c.Duration("max-wait"),
+			},
+		)
+		return watchDone(r, err)
+	}
+
+	resp, err := listOrders(context.Background())
+	if err != nil {
+		return err
+	}
+
+	return printView(resp, view)
+}
+
+// pendingOrders returns the number of orders that have not reached a terminal status.
+func pendingOrders(resp *auto_conversion_rules.ListOrdersResponse) int {
+	n := 0
+	for i := range resp.Items {
+		if !resp.Items[i].OrderStatus().IsTerminal() {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,155 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+)
+
+// completionCommand returns the completion command, which prints shell completion scripts.
+// The scripts call back into the CLI with --generate-bash-completion, so completions always
+// match the installed version's commands and flags.
+func completionCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "completion",
+		Usage: "Print a shell completion script",
+		Description: `Prints a completion script for your shell. Load it in your shell's startup file.
+
+Examples:
+  # bash (~/.bashrc)
+  source <(onemoney-cli completion bash)
+
+  # zsh (~/.zshrc)
+  source <(onemoney-cli completion zsh)
+
+  # fish
+  onemoney-cli completion fish > ~/.config/fish/completions/onemoney-cli.fish
+
+  # PowerShell ($PROFILE)
+  onemoney-cli completion powershell | Out-String | Invoke-Expression`,
+		Subcommands: []*cli.Command{
+			{
+				Name:   "bash",
+				Usage:  "Print the bash completion script",
+				Action: completionScript(bashCompletion),
+			},
+			{
+				Name:   "zsh",
+				Usage:  "Print the zsh completion script",
+				Action: completionScript(zshCompletion),
+			},
+			{
+				Name:  "fish",
+				Usage: "Print the fish completion script",
+				Action: func(c *cli.Context) error {
+					script, err := c.App.ToFishCompletion()
+					if err != nil {
+						return fmt.Errorf("failed to generate fish completion: %w", err)
+					}
+					fmt.Print(script)
+					return nil
+				},
+			},
+			{
+				Name:   "powershell",
+				Usage:  "Print the PowerShell completion script",
+				Action: completionScript(powershellCompletion),
+			},
+		},
+	}
+}
+
+// completionScript returns an action printing script with PROG replaced by the program name.
+func completionScript(script string) cli.ActionFunc {
+	return func(c *cli.Context) error {
+		fmt.Print(strings.ReplaceAll(script, "PROG", c.App.Name))
+		return nil
+	}
+}
+
+// The scripts below are adapted from urfave/cli's autocomplete directory.
+const (
+	bashCompletion = `# bash completion for PROG
+
+_PROG_bash_autocomplete() {
+  local cur words cword opts
+  COMPREPLY=()
+  if declare -F _init_completion >/dev/null 2>&1; then
+    _init_completion -n "=:" || return
+  else
+    cur="${COMP_WORDS[COMP_CWORD]}"
+    words=("${COMP_WORDS[@]}")
+    cword=$COMP_CWORD
+  fi
+  words=("${words[@]:0:$cword}")
+  if [[ "$cur" == "-"* ]]; then
+    opts=$("${words[@]}" "$cur" --generate-bash-completion 2>/dev/null)
+  else
+    opts=$("${words[@]}" --generate-bash-completion 2>/dev/null)
+  fi
+  COMPREPLY=($(compgen -W "${opts}" -- "${cur}"))
+  return 0
+}
+
+complete -o bashdefault -o default -F _PROG_bash_autocomplete PROG
+`
+
+	zshCompletion = `#compdef PROG
+
+_PROG_zsh_autocomplete() {
+  local -a opts
+  local cur
+  cur=${words[-1]}
+  if [[ "$cur" == "-"* ]]; then
+    opts=("${(@f)$(${words[@]:0:#words[@]-1} ${cur} --generate-bash-completion)}")
+  else
+    opts=("${(@f)$(${words[@]:0:#words[@]-1} --generate-bash-completion)}")
+  fi
+
+  if [[ "${opts[1]}" != "" ]]; then
+    _describe 'values' opts
+  else
+    _files
+  fi
+}
+
+compdef _PROG_zsh_autocomplete PROG
+`
+
+	powershellCompletion = `# PowerShell completion for PROG
+
+Register-ArgumentCompleter -Native -CommandName 'PROG' -ScriptBlock {
+    param($wordToComplete, $commandAst, $cursorPosition)
+    $words = @($commandAst.CommandElements | ForEach-Object { $_.ToString() })
+    if ($wordToComplete -ne '') {
+        $words = @($words | Select-Object -First ($words.Count - 1))
+    }
+    $cliArgs = @($words | Select-Object -Skip 1)
+    if ($wordToComplete -like '-*') {
+        $cliArgs += $wordToComplete
+    }
+    $cliArgs += '--generate-bash-completion'
+    & $words[0] @cliArgs 2>$null | Where-Object { $_ -like "$wordToComplete*" } | ForEach-Object {
+        $name = ($_ -split ':', 2)[0]
+        [System.Management.Automation.CompletionResult]::new($name, $name, 'ParameterValue', $name)
+    }
+}
+`
+)
@@ -0,0 +1,392 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/peterh/liner"
+	"github.com/urfave/cli/v2"
+	"gopkg.in/ini.v1"
+
+	"github.com/1Money-Co/1money-go-sdk/cmd/output"
+	"github.com/1Money-Co/1money-go-sdk/internal/credentials"
+)
+
+const (
+	// cliConfigFile is the CLI settings file inside the config directory.
+	cliConfigFile = "config"
+	// cliConfigSection is the section of the CLI settings file holding the active profile.
+	cliConfigSection = "cli"
+	// outputKey is the profile key holding the default output format. It matches the
+	// --output flag's environment variable; the SDK ignores it.
+	outputKey = "ONEMONEY_OUTPUT"
+)
+
+// configKeys maps the setting names accepted by "config set" to credentials file keys.
+var configKeys = map[string]string{
+	"access-key": credentials.EnvAccessKey,
+	"secret-key": credentials.EnvSecretKey,
+	"base-url":   credentials.EnvBaseURL,
+	"output":     outputKey,
+}
+
+// configCommand returns the config command with all its subcommands.
+func configCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "config",
+		Usage: "Manage profiles in ~/.onemoney/credentials",
+		Description: `Profiles hold credentials and defaults for the base URL and output format.
+Commands use the active profile unless --profile is given.
+
+Examples:
+  onemoney-cli --profile sandbox config init
+  onemoney-cli config set output table
+  onemoney-cli config list-profiles
+  onemoney-cli config use-profile sandbox`,
+		Subcommands: []*cli.Command{
+			{
+				Name:  "init",
+				Usage: "Create or update a profile interactively",
+				Description: `Prompts for each setting, showing the current value as the default.
+Values given with the global --access-key, --secret-key and --base-url flags are used without prompting.
+The first profile created becomes the active profile.
+
+Examples:
+  onemoney-cli config init
+  onemoney-cli --profile production config init
+  onemoney-cli -k ACCESS_KEY -s SECRET_KEY -u https://api.1money.com --profile production config init`,
+				Action: configInit,
+			},
+			{
+				Name:      "set",
+				Usage:     "Set a setting of the active profile",
+				ArgsUsage: "<" + strings.Join(slices.Sorted(maps.Keys(configKeys)), "|") + "> <value>",
+				Description: `Examples:
+  onemoney-cli config set base-url https://api.sandbox.1money.com
+  onemoney-cli config set output table
+  onemoney-cli --profile production config set secret-key SECRET_KEY`,
+				Action: configSet,
+			},
+			{
+				Name:  "list-profiles",
+				Usage: "List profiles, marking the active one",
+				Description: `Examples:
+  onemoney-cli config list-profiles
+  onemoney-cli -o json config list-profiles`,
+				Action: configListProfiles,
+			},
+			{
+				Name:      "use-profile",
+				Usage:     "Make a profile the active profile",
+				ArgsUsage: "<profile>",
+				Description: `Examples:
+  onemoney-cli config use-profile production`,
+				Action: configUseProfile,
+			},
+		},
+	}
+}
+
+func configInit(c *cli.Context) error {
+	creds, err := loadINI(credentialsPath())
+	if err != nil {
+		return err
+	}
+	firstProfile := len(profileNames(creds)) == 0
+	name := activeProfile()
+	section := creds.Section(name)
+
+	line := liner.NewLiner()
+	defer line.Close()
+	line.SetCtrlCAborts(true)
+
+	prompts := []struct {
+		key, label, flag string
+		secret           bool
+	}{
+		{credentials.EnvAccessKey, "Access key", "access-key", false},
+		{credentials.EnvSecretKey, "Secret key", "secret-key", true},
+		{credentials.EnvBaseURL, "Base URL", "base-url", false},
+		{outputKey, "Output format (" + formatNames() + ")", "output", false},
+	}
+	for _, p := range prompts {
+		value := ""
+		if c.IsSet(p.flag) {
+			value = c.String(p.flag)
+		} else {
+			current := keyValue(section, p.key)
+			if value, err = promptValue(line, p.label, current, p.secret); err != nil {
+				return err
+			}
+		}
+		if p.key == outputKey && value != "" {
+			if _, err := output.ParseFormat(value); err != nil {
+				return err
+			}
+		}
+		if value != "" {
+			section.Key(p.key).SetValue(value)
+		}
+	}
+
+	if err := saveINI(creds, credentialsPath()); err != nil {
+		return err
+	}
+	if firstProfile {
+		if err := setActiveProfile(name); err != nil {
+			return err
+		}
+	}
+	fmt.Fprintf(os.Stderr, "Saved profile %q to %s\n", name, credentialsPath())
+	return nil
+}
+
+func configSet(c *cli.Context) error {
+	if c.NArg() != 2 {
+		return fmt.Errorf("usage: config set %s", c.Command.ArgsUsage)
+	}
+	setting, value := c.Args().Get(0), c.Args().Get(1)
+	key, ok := configKeys[setting]
+	if !ok {
+		return fmt.Errorf("unknown setting %q, try %s", setting, strings.Join(slices.Sorted(maps.Keys(configKeys)), ", "))
+	}
+	if key == outputKey {
+		if _, err := output.ParseFormat(value); err != nil {
+			return err
+		}
+	}
+
+	creds, err := loadINI(credentialsPath())
+	if err != nil {
+		return err
+	}
+	creds.Section(activeProfile()).Key(key).SetValue(value)
+	return saveINI(creds, credentialsPath())
+}
+
+// profileSummary describes a profile in "config list-profiles" output. Secrets are never shown.
+type profileSummary struct {
+	Profile   string `json:"profile"`
+	Active    bool   `json:"active"`
+	AccessKey string `json:"access_key"`
+	BaseURL   string `json:"base_url"`
+	Output    string `json:"output"`
+}
+
+func configListProfiles(*cli.Context) error {
+	creds, err := loadINI(credentialsPath())
+	if err != nil {
+		return err
+	}
+
+	active := activeProfile()
+	summaries := []profileSummary{}
+	for _, name := range profileNames(creds) {
+		section := creds.Section(name)
+		summaries = append(summaries, profileSummary{
+			Profile:   name,
+			Active:    name == active,
+			AccessKey: maskKey(keyValue(section, credentials.EnvAccessKey)),
+			BaseURL:   keyValue(section, credentials.EnvBaseURL),
+			Output:    keyValue(section, outputKey),
+		})
+	}
+	return printView(summaries, output.View{Format: output.FormatTable})
+}
+
+func configUseProfile(c *cli.Context) error {
+	name := c.Args().First()
+	if name == "" {
+		return errors.New("profile name is required")
+	}
+
+	creds, err := loadINI(credentialsPath())
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(profileNames(creds), name) {
+		return fmt.Errorf("profile %q not found in %s; create it with: onemoney-cli --profile %s config init",
+			name, credentialsPath(), name)
+	}
+	return setActiveProfile(name)
+}
+
+// applyConfigDefaults resolves the profile and fills the base URL and output format from it
+// when they were not given as flags or environment variables.
+func applyConfigDefaults(c *cli.Context) error {
+	profile = activeProfile()
+
+	creds, err := loadINI(credentialsPath())
+	if err != nil {
+		return err
+	}
+	section, err := creds.GetSection(profile)
+	if err != nil {
+		// The profile may legitimately not exist, e.g. when credentials come from flags.
+		return nil
+	}
+	if v := keyValue(section, credentials.EnvBaseURL); v != "" && !c.IsSet("base-url") {
+		baseURL = v
+	}
+	if v := keyValue(section, outputKey); v != "" && !c.IsSet("output") {
+		format = v
+	}
+	return nil
+}
+
+// activeProfile returns the --profile flag, else the profile chosen with "config use-profile",
+// else the default profile.
+func activeProfile() string {
+	if profile != "" {
+		return profile
+	}
+	if cfg, err := loadINI(cliConfigPath()); err == nil {
+		if name := keyValue(cfg.Section(cliConfigSection), "profile"); name != "" {
+			return name
+		}
+	}
+	return credentials.DefaultProfile
+}
+
+func setActiveProfile(name string) error {
+	cfg, err := loadINI(cliConfigPath())
+	if err != nil {
+		return err
+	}
+	cfg.Section(cliConfigSection).Key("profile").SetValue(name)
+	return saveINI(cfg, cliConfigPath())
+}
+
+// keyValue returns the value of a key, or "" if it is missing. Unlike Section.Key it
+// does not add the key to the section.
+func keyValue(section *ini.Section, key string) string {
+	if !section.HasKey(key) {
+		return ""
+	}
+	return section.Key(key).String()
+}
+
+// profileNames returns the names of the profiles in a credentials file, in file order.
+func profileNames(creds *ini.File) []string {
+	var names []string
+	for _, section := range creds.Sections() {
+		if section.Name() != ini.DefaultSection {
+			names = append(names, section.Name())
+		}
+	}
+	return names
+}
+
+func configDir() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return credentials.DefaultConfigDir
+	}
+	return filepath.Join(home, credentials.DefaultConfigDir)
+}
+
+func credentialsPath() string {
+	return filepath.Join(configDir(), credentials.DefaultCredentialsFile)
+}
+
+func cliConfigPath() string {
+	return filepath.Join(configDir(), cliConfigFile)
+}
+
+// loadINI loads an INI file, returning an empty file if it does not exist.
+func loadINI(path string) (*ini.File, error) {
+	f, err := ini.LooseLoad(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return f, nil
+}
+
+// saveINI writes an INI file readable only by the current user, since it holds secrets.
+func saveINI(f *ini.File, path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create %s: %w", filepath.Dir(path), err)
+	}
+	var buf bytes.Buffer
+	if _, err := f.WriteTo(&buf); err != nil {
+		return fmt.Errorf("failed to encode %s: %w", path, err)
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0o600); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
+// promptValue asks for a setting, returning current if the answer is empty.
+// Secrets are read without echo when the terminal supports it, and their current value is masked.
+func promptValue(line *liner.State, label, current string, secret bool) (string, error) {
+	shown := current
+	if secret {
+		shown = maskKey(current)
+	}
+	prompt := label + ": "
+	if shown != "" {
+		prompt = fmt.Sprintf("%s [%s]: ", label, shown)
+	}
+
+	var (
+		answer string
+		err    error
+	)
+	if secret && liner.TerminalSupported() && isTerminal(os.Stdin) && isTerminal(os.Stdout) {
+		answer, err = line.PasswordPrompt(prompt)
+	} else {
+		answer, err = line.Prompt(prompt)
+	}
+	if errors.Is(err, io.EOF) {
+		return current, nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", strings.ToLower(label), err)
+	}
+
+	if answer = strings.TrimSpace(answer); answer == "" {
+		return current, nil
+	}
+	return answer, nil
+}
+
+// maskKey hides all but the last four characters of a key.
+func maskKey(key string) string {
+	if len(key) <= 4 {
+		return strings.Repeat("*", len(key))
+	}
+	return strings.Repeat("*", len(key)-4) + key[len(key)-4:]
+}
+
+// formatNames returns the supported output formats as "json|yaml|table|csv".
+func formatNames() string {
+	names := make([]string, len(output.Formats))
+	for i, f := range output.Formats {
+		names[i] = string(f)
+	}
+	return strings.Join(names, "|")
+}
@@ -0,0 +1,207 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/onemoney"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/conversions"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/fees"
+)
+
+// convertCommand returns the convert command with all its subcommands.
+func convertCommand() *cli.Command {
+	quoteFlags := func(extra ...cli.Flag) []cli.Flag {
+		return append([]cli.Flag{
+			customerFlag(),
+			&cli.StringFlag{Name: "from", Usage: "Asset to pay, e.g. USD", Required: true},
+			&cli.StringFlag{Name: "to", Usage: "Asset to receive, e.g. USDC", Required: true},
+			&cli.StringFlag{Name: "from-amount", Usage: "Amount to pay (set this or --to-amount)"},
+			&cli.StringFlag{Name: "to-amount", Usage: "Amount to receive (set this or --from-amount)"},
+			&cli.StringFlag{Name: "from-network", Usage: "Network of the paid asset (crypto assets only)"},
+			&cli.StringFlag{Name: "to-network", Usage: "Network of the received asset (crypto assets only)"},
+		}, extra...)
+	}
+
+	return &cli.Command{
+		Name:  "convert",
+		Usage: "Convert between assets",
+		Description: `Examples:
+  onemoney-cli convert quote -c CUSTOMER_ID --from USD --to USDC --from-amount 1000 --to-network ETHEREUM
+  onemoney-cli convert execute -c CUSTOMER_ID --from USDC --to USD --to-amount 500 --from-network SOLANA`,
+		Subcommands: []*cli.Command{
+			{
+				Name:  "quote",
+				Usage: "Request a conversion quote without executing it",
+				Description: `Set exactly one of --from-amount or --to-amount. Crypto assets need a network.
+
+Examples:
+  onemoney-cli convert quote -c CUSTOMER_ID --from USD --to USDC --from-amount 1000 --to-network ETHEREUM`,
+				Flags:  quoteFlags(),
+				Action: convertQuote,
+			},
+			{
+				Name:  "execute",
+				Usage: "Request a quote, confirm its rate and fees, then execute it",
+				Description: `Requests a fresh quote, shows the rate and the fee from the customer's fee schedule,
+and executes the quote only after confirmation and before it expires.
+
+Examples:
+  onemoney-cli convert execute -c CUSTOMER_ID --from USDC --to USD --to-amount 500 --from-network SOLANA
+  onemoney-cli convert execute -c CUSTOMER_ID --from USD --to USDT --from-amount 1000 --to-network POLYGON --yes`,
+				Flags:  quoteFlags(yesFlag()),
+				Action: convertExecute,
+			},
+		},
+	}
+}
+
+func convertQuote(c *cli.Context) error {
+	req, err := quoteRequest(c)
+	if err != nil {
+		return err
+	}
+
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	ctx := context.Background()
+
+	resp, err := client.Conversions.CreateQuote(ctx, customerIDFlag(c), req)
+	if err != nil {
+		return fmt.Errorf("failed to create quote: %w", err)
+	}
+
+	return printOutput(resp)
+}
+
+func convertExecute(c *cli.Context) error {
+	req, err := quoteRequest(c)
+	if err != nil {
+		return err
+	}
+
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	ctx := context.Background()
+	customerID := customerIDFlag(c)
+
+	quote, err := client.Conversions.CreateQuote(ctx, customerID, req)
+	if err != nil {
+		return fmt.Errorf("failed to create quote: %w", err)
+	}
+	quotedAt := time.Now()
+
+	w := tabwriter.NewWriter(os.Stderr, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Quote:\t%s\n", quote.QuoteID)
+	fmt.Fprintf(w, "You pay:\t%s %s\n", quote.UserPayAmount, quote.UserPayAsset)
+	fmt.Fprintf(w, "You receive:\t%s %s\n", quote.UserObtainAmount, quote.UserObtainAsset)
+	fmt.Fprintf(w, "Rate:\t%s\n", quote.Rate)
+	fmt.Fprintf(w, "Fee:\t%s\n", conversionFee(ctx, client, customerID, quote))
+	fmt.Fprintf(w, "Expires in:\t%ds\n", quote.ExpireTime)
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	ok, err := confirm(c, "Execute this conversion?")
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.New("conversion cancelled")
+	}
+	if quote.ExpireTime > 0 && time.Since(quotedAt) > time.Duration(quote.ExpireTime)*time.Second {
+		return fmt.Errorf("quote %s expired before it was confirmed; run the command again for a new quote", quote.QuoteID)
+	}
+
+	order, err := client.Conversions.CreateHedge(ctx, customerID, &conversions.CreateHedgeRequest{
+		QuoteID: quote.QuoteID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to execute conversion: %w", err)
+	}
+
+	return printOutput(order)
+}
+
+// quoteRequest builds a quote request from the convert command's flags.
+func quoteRequest(c *cli.Context) (*conversions.CreateQuoteRequest, error) {
+	req := &conversions.CreateQuoteRequest{
+		FromAsset: conversions.AssetInfo{Amount: c.String("from-amount")},
+		ToAsset:   conversions.AssetInfo{Amount: c.String("to-amount")},
+	}
+	if (req.FromAsset.Amount == "") == (req.ToAsset.Amount == "") {
+		return nil, errors.New("exactly one of --from-amount or --to-amount is required")
+	}
+
+	var err error
+	if req.FromAsset.Asset, err = assets.ParseAssetName(c.String("from")); err != nil {
+		return nil, err
+	}
+	if req.ToAsset.Asset, err = assets.ParseAssetName(c.String("to")); err != nil {
+		return nil, err
+	}
+	if v := c.String("from-network"); v != "" {
+		if req.FromAsset.Network, err = conversions.ParseWalletNetworkName(v); err != nil {
+			return nil, err
+		}
+	}
+	if v := c.String("to-network"); v != "" {
+		if req.ToAsset.Network, err = conversions.ParseWalletNetworkName(v); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// conversionFee describes the fee the customer's fee schedule charges for the quoted
+// conversion. Quotes do not carry fees, so a missing schedule or tier is reported
+// rather than failing the command.
+func conversionFee(
+	ctx context.Context, client *onemoney.Client, customerID string, quote *conversions.QuoteResponse,
+) string {
+	schedule, err := client.Fees.GetFeeSchedule(ctx, customerID)
+	if err != nil {
+		return fmt.Sprintf("unknown (%v)", err)
+	}
+
+	asset := assets.AssetName(quote.UserPayAsset)
+	fee, err := schedule.Calculate(fees.FeeCategoryCONVERSION, asset, "", quote.UserPayAmount)
+	if err != nil {
+		return fmt.Sprintf("unknown (%v)", err)
+	}
+	for _, tier := range schedule.Tiers(fees.FeeCategoryCONVERSION, asset, "") {
+		if tier.FeeAsset != "" {
+			return fee + " " + tier.FeeAsset
+		}
+	}
+	return fee
+}
@@ -0,0 +1,511 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/1Money-Co/1money-go-sdk/cmd/output"
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/customer"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/external_accounts"
+)
+
+// scaffoldDocumentsDir is the directory of the placeholder document paths written by customer scaffold.
+const scaffoldDocumentsDir = "documents"
+
+// customerCommand returns the customer command with all its subcommands.
+func customerCommand() *cli.Command {
+	return &cli.Command{
+		Name:    "customer",
+		Aliases: []string{"c"},
+		Usage:   "Manage business customers",
+		Description: `Creates, inspects and updates business customers and their KYB status.
+Request bodies are read from JSON or YAML files using the API's snake_case field names.
+
+Examples:
+  onemoney-cli customer scaffold --business-type llc --country USA > customer.json
+  onemoney-cli customer create -f customer.yaml
+  onemoney-cli customer list --kyb-status approved
+  onemoney-cli customer kyb-status --wait CUSTOMER_ID`,
+		Subcommands: []*cli.Command{
+			{
+				Name:  "create",
+				Usage: "Create a customer from a JSON or YAML file and print its ID",
+				Description: `Document and image fields take data URIs or file paths; paths are relative to the
+request file and are encoded as data URIs before sending.
+
+Examples:
+  CUSTOMER_ID=$(onemoney-cli customer create -f customer.yaml)
+  cat customer.json | onemoney-cli customer create -f -
+  onemoney-cli -o yaml customer create -f customer.json --full`,
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:     "file",
+						Aliases:  []string{"f"},
+						Usage:    "Path to a CreateCustomerRequest in JSON or YAML (\"-\" for stdin)",
+						Required: true,
+					},
+					&cli.BoolFlag{
+						Name:  "full",
+						Usage: "Print the full customer response instead of only the ID",
+					},
+				},
+				Action: customerCreate,
+			},
+			{
+				Name:  "scaffold",
+				Usage: "Print an example CreateCustomerRequest to fill in",
+				Description: `Prints a placeholder request with the KYB documents required for the business type
+and country. Documents point to files under documents/ next to the request file;
+replace the placeholder values, add the files and pass the result to customer create.
+
+Examples:
+  onemoney-cli customer scaffold --business-type corporation --country USA > customer.json
+  onemoney-cli -o yaml customer scaffold --business-type llc --country DEU > customer.yaml`,
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "business-type",
+						Usage: "Business type: " + strings.Join(customer.BusinessTypeNames(), ", "),
+						Value: string(customer.BusinessTypeCorporation),
+					},
+					&cli.StringFlag{
+						Name:  "country",
+						Usage: "Country of registration (ISO 3166-1 alpha-3)",
+						Value: string(external_accounts.CountryCodeUSA),
+					},
+					&cli.StringFlag{
+						Name:  "signed-agreement-id",
+						Usage: "Signed TOS agreement ID (default: a placeholder)",
+					},
+				},
+				Action: customerScaffold,
+			},
+			{
+				Name:  "get",
+				Usage: "Get a customer",
+				Description: `Examples:
+  onemoney-cli customer get CUSTOMER_ID
+  onemoney-cli -q 'status' customer get CUSTOMER_ID`,
+				ArgsUsage: "[customer-id]",
+				Action:    customerGet,
+			},
+			{
+				Name:  "list",
+				Usage: "List customers",
+				Description: `Examples:
+  onemoney-cli -o table customer list
+  onemoney-cli customer list --page-size 50 --page-num 1 --kyb-status pending_review`,
+				Flags: []cli.Flag{
+					&cli.IntFlag{
+						Name:  "page-size",
+						Usage: "Number of customers per page (1-100)",
+					},
+					&cli.IntFlag{
+						Name:  "page-num",
+						Usage: "Page number, 0-indexed",
+					},
+					&cli.StringFlag{
+						Name:  "kyb-status",
+						Usage: "Filter by KYB status",
+					},
+				},
+				Action: customerList,
+			},
+			{
+				Name:  "update",
+				Usage: "Update a customer from a JSON or YAML file",
+				Description: `Only the fields present in the file are changed.
+
+Examples:
+  onemoney-cli customer update CUSTOMER_ID -f update.yaml`,
+				ArgsUsage: "[customer-id]",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:     "file",
+						Aliases:  []string{"f"},
+						Usage:    "Path to an UpdateCustomerRequest in JSON or YAML (\"-\" for stdin)",
+						Required: true,
+					},
+				},
+				Action: customerUpdate,
+			},
+			{
+				Name:  "kyb-status",
+				Usage: "Print a customer's KYB status",
+				Description: `With --wait, polls until KYB is approved or rejected and prints the decision.
+With --watch, shows the status as it changes until a decision. A rejection exits with an error.
+
+Examples:
+  onemoney-cli customer kyb-status CUSTOMER_ID
+  onemoney-cli customer kyb-status --wait --poll-interval 10s --max-wait 30m CUSTOMER_ID
+  onemoney-cli customer kyb-status --watch CUSTOMER_ID`,
+				ArgsUsage: "[customer-id]",
+				Flags: append([]cli.Flag{
+					&cli.BoolFlag{
+						Name:  "wait",
+						Usage: "Wait until KYB is approved or rejected",
+					},
+				}, watchFlags(customer.DefaultWaitOptions().PollInterval, customer.DefaultWaitOptions().MaxWaitTime)...),
+				Action: customerKybStatus,
+			},
+		},
+	}
+}
+
+func customerCreate(c *cli.Context) error {
+	var req customer.CreateCustomerRequest
+	if err := readRequestFile(c.String("file"), &req); err != nil {
+		return err
+	}
+	dir := "."
+	if file := c.String("file"); file != "-" {
+		dir = filepath.Dir(file)
+	}
+	if err := encodeCustomerFiles(&req, dir); err != nil {
+		return err
+	}
+
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	ctx := context.Background()
+
+	resp, err := client.Customer.CreateCustomer(ctx, &req)
+	if err != nil {
+		return fmt.Errorf("failed to create customer: %w", err)
+	}
+
+	if c.Bool("full") {
+		return printOutput(resp)
+	}
+	fmt.Println(resp.CustomerID)
+	return nil
+}
+
+// encodeCustomerFiles replaces file paths in the document and image fields of req with data URIs.
+// Relative paths are resolved against dir; values that already are data URIs are kept.
+func encodeCustomerFiles(req *customer.CreateCustomerRequest, dir string) error {
+	document := func(p string) (string, error) { return customer.EncodeDocumentFileToDataURI(p, "") }
+	image := func(p string) (string, error) { return customer.EncodeFileToDataURI(p, "") }
+	encode := func(value *string, encoder func(string) (string, error)) error {
+		if *value == "" || strings.HasPrefix(*value, "data:") {
+			return nil
+		}
+		p := *value
+		if !filepath.IsAbs(p) {
+			p = filepath.Join(dir, p)
+		}
+		uri, err := encoder(p)
+		if err != nil {
+			return fmt.Errorf("failed to encode %s: %w", *value, err)
+		}
+		*value = uri
+		return nil
+	}
+
+	for i := range req.Documents {
+		if err := encode(&req.Documents[i].File, document); err != nil {
+			return err
+		}
+	}
+	for i := range req.AssociatedPersons {
+		person := &req.AssociatedPersons[i]
+		if err := encode(&person.POA, document); err != nil {
+			return err
+		}
+		for j := range person.IdentifyingInformation {
+			id := &person.IdentifyingInformation[j]
+			if err := encode(&id.ImageFront, image); err != nil {
+				return err
+			}
+			if err := encode(&id.ImageBack, image); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func customerScaffold(c *cli.Context) error {
+	businessType, err := customer.ParseBusinessType(c.String("business-type"))
+	if err != nil {
+		return err
+	}
+	country, err := external_accounts.ParseCountryCode(c.String("country"))
+	if err != nil {
+		return err
+	}
+
+	req := scaffoldCustomerRequest(businessType, string(country), c.String("signed-agreement-id"))
+
+	// The scaffold is meant to be edited, so JSON is always indented.
+	p := *printer
+	p.Pretty = true
+	return p.Print(req, output.View{})
+}
+
+// scaffoldCustomerRequest returns a placeholder CreateCustomerRequest with one owner and the
+// documents required for the business type and country.
+func scaffoldCustomerRequest(
+	businessType customer.BusinessType,
+	country, signedAgreementID string,
+) *customer.CreateCustomerRequest {
+	if signedAgreementID == "" {
+		signedAgreementID = "SIGNED_AGREEMENT_ID"
+	}
+	documentPath := func(name string) string { return path.Join(scaffoldDocumentsDir, name) }
+
+	taxType, taxID := customer.TaxIDTypeTIN, "123456789"
+	personTaxType, personTaxID := customer.TaxIDTypeTIN, "987654321"
+	address := &customer.Address{
+		StreetLine1: "123 Example Street",
+		City:        "Example City",
+		Country:     country,
+		State:       "Example State",
+		PostalCode:  "12345",
+	}
+	if country == string(external_accounts.CountryCodeUSA) {
+		taxType, taxID = customer.TaxIDTypeEIN, "12-3456789"
+		personTaxType, personTaxID = customer.TaxIDTypeSSN, "123-45-6789"
+		address = &customer.Address{
+			StreetLine1: "123 Main Street",
+			City:        "San Francisco",
+			Country:     country,
+			State:       "CA",
+			PostalCode:  "94102",
+		}
+	}
+	residentialAddress := *address
+	residentialAddress.StreetLine1 = "456 Residential Street"
+
+	documents := customer.RequiredDocuments(businessType, country)
+	for i := range documents {
+		documents[i].File = documentPath(string(documents[i].DocType) + ".pdf")
+	}
+
+	return &customer.CreateCustomerRequest{
+		BusinessLegalName:          "Example Business",
+		BusinessDescription:        "Describe what the business does and who its customers are",
+		BusinessRegistrationNumber: "REG-123456",
+		Email:                      "contact@example.com",
+		BusinessType:               businessType,
+		BusinessIndustry:           "541519",
+		RegisteredAddress:          address,
+		DateOfIncorporation:        "2020-01-15",
+		SignedAgreementID:          signedAgreementID,
+		AssociatedPersons: []customer.AssociatedPerson{
+			{
+				FirstName:           "Jane",
+				LastName:            "Doe",
+				Email:               "jane.doe@example.com",
+				Gender:              customer.GenderFemale,
+				ResidentialAddress:  &residentialAddress,
+				BirthDate:           "1985-06-15",
+				CountryOfBirth:      country,
+				PrimaryNationality:  country,
+				HasOwnership:        true,
+				OwnershipPercentage: 100,
+				HasControl:          true,
+				IsSigner:            true,
+				IsDirector:          true,
+				IdentifyingInformation: []customer.IdentifyingInformation{
+					{
+						Type:                   customer.IDTypePassport,
+						IssuingCountry:         country,
+						ImageFront:             documentPath("owner_id_front.jpg"),
+						ImageBack:              documentPath("owner_id_back.jpg"),
+						NationalIdentityNumber: "X12345678",
+					},
+				},
+				CountryOfTax: country,
+				TaxType:      personTaxType,
+				TaxID:        personTaxID,
+				POA:          documentPath("owner_proof_of_address.pdf"),
+				POAType:      "utility_bill",
+			},
+		},
+		AccountPurpose:                 customer.AccountPurposeTreasuryManagement,
+		SourceOfFunds:                  []customer.SourceOfFunds{customer.SourceOfFundsSalesOfGoodsAndServices},
+		SourceOfWealth:                 []customer.SourceOfWealth{customer.SourceOfWealthBusinessDividendsOrProfits},
+		Documents:                      documents,
+		PrimaryWebsite:                 "https://example.com",
+		EstimatedAnnualRevenueUSD:      customer.MoneyRange099999,
+		ExpectedMonthlyFiatDeposits:    customer.MoneyRange099999,
+		ExpectedMonthlyFiatWithdrawals: customer.MoneyRange099999,
+		TaxID:                          taxID,
+		TaxType:                        taxType,
+		TaxCountry:                     country,
+	}
+}
+
+func customerGet(c *cli.Context) error {
+	customerID, err := customerIDArg(c)
+	if err != nil {
+		return err
+	}
+
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	ctx := context.Background()
+
+	resp, err := client.Customer.GetCustomer(ctx, customerID)
+	if err != nil {
+		return fmt.Errorf("failed to get customer: %w", err)
+	}
+
+	return printOutput(resp)
+}
+
+func customerList(c *cli.Context) error {
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	ctx := context.Background()
+
+	req := &customer.ListCustomersRequest{
+		PageSize:  c.Int("page-size"),
+		PageNum:   c.Int("page-num"),
+		KybStatus: c.String("kyb-status"),
+	}
+
+	resp, err := client.Customer.ListCustomers(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to list customers: %w", err)
+	}
+
+	return printOutput(resp)
+}
+
+func customerUpdate(c *cli.Context) error {
+	customerID, err := customerIDArg(c)
+	if err != nil {
+		return err
+	}
+
+	var req customer.UpdateCustomerRequest
+	if err := readRequestFile(c.String("file"), &req); err != nil {
+		return err
+	}
+
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	ctx := context.Background()
+
+	resp, err := client.Customer.UpdateCustomer(ctx, customerID, &req)
+	if err != nil {
+		return fmt.Errorf("failed to update customer: %w", err)
+	}
+
+	return printOutput(resp)
+}
+
+func customerKybStatus(c *cli.Context) error {
+	customerID, err := customerIDArg(c)
+	if err != nil {
+		return err
+	}
+
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	ctx := context.Background()
+	opts := &customer.WaitOptions{
+		PollInterval: c.Duration("poll-interval"),
+		MaxWaitTime:  c.Duration("max-wait"),
+	}
+
+	var resp *customer.CustomerResponse
+	switch {
+	case c.Bool("watch"):
+		ctx, cancel := watchContext()
+		defer cancel()
+
+		r := &watchRenderer{}
+		resp, err = customer.WaitFor(ctx, client.Customer, customerID, func(cust *customer.CustomerResponse) bool {
+			r.show(string(cust.Status) + "\n")
+			return cust.Status == customer.KybStatusApproved || cust.Status == customer.KybStatusRejected
+		}, opts)
+		if err == nil && resp.Status == customer.KybStatusRejected {
+			err = fmt.Errorf("KYB rejected for customer %s", customerID)
+		}
+		return watchDone(r, err)
+	case c.Bool("wait"):
+		resp, err = customer.WaitForKybDecision(ctx, client.Customer, customerID, opts)
+		// A rejection is still a decision: print it, then report the error.
+		if resp != nil {
+			fmt.Println(resp.Status)
+		}
+		return err
+	}
+
+	resp, err = client.Customer.GetCustomer(ctx, customerID)
+	if err != nil {
+		return fmt.Errorf("failed to get customer: %w", err)
+	}
+
+	fmt.Println(resp.Status)
+	return nil
+}
+
+// customerFlag returns the --customer flag shared by customer-scoped commands.
+func customerFlag() *cli.StringFlag {
+	return &cli.StringFlag{
+		Name:     "customer",
+		Aliases:  []string{"c"},
+		Usage:    "Customer ID",
+		EnvVars:  []string{customerIDEnv},
+		Required: true,
+	}
+}
+
+// customerIDFlag returns the value of the --customer flag.
+func customerIDFlag(c *cli.Context) svc.CustomerID {
+	return svc.CustomerID(c.String("customer"))
+}
+
+// customerIDArg returns the customer ID given as the first positional argument,
+// falling back to the selected customer in ONEMONEY_CUSTOMER_ID.
+func customerIDArg(c *cli.Context) (svc.CustomerID, error) {
+	if id := c.Args().First(); id != "" {
+		return svc.CustomerID(id), nil
+	}
+	if id := os.Getenv(customerIDEnv); id != "" {
+		return svc.CustomerID(id), nil
+	}
+	return "", errors.New("customer ID is required")
+}
@@ -0,0 +1,412 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/1Money-Co/1money-go-sdk/cmd/output"
+	"github.com/1Money-Co/1money-go-sdk/internal/credentials"
+	"github.com/1Money-Co/1money-go-sdk/internal/transport"
+	"github.com/1Money-Co/1money-go-sdk/pkg/onemoney"
+)
+
+// Doctor check statuses.
+const (
+	doctorOK   = "OK"
+	doctorWarn = "WARN"
+	doctorFail = "FAIL"
+	doctorSkip = "SKIP"
+)
+
+const (
+	// clockSkewWarn and clockSkewFail bound the difference between the local and server clocks.
+	// Signed requests carry a timestamp that the server only accepts within a few minutes.
+	clockSkewWarn = 30 * time.Second
+	clockSkewFail = 5 * time.Minute
+	// certExpiryWarn is how long before expiry the server certificate is reported.
+	certExpiryWarn = 14 * 24 * time.Hour
+	// rateLimitWarn is the fraction of the rate limit below which the remaining headroom is reported.
+	rateLimitWarn = 0.1
+)
+
+const credentialsFix = "Run 'onemoney-cli config init', set ONEMONEY_ACCESS_KEY and ONEMONEY_SECRET_KEY, " +
+	"or pass --access-key and --secret-key"
+
+// doctorCheck is the result of one diagnostic check.
+type doctorCheck struct {
+	Check  string `json:"check"`
+	Status string `json:"status"`
+	Detail string `json:"detail"`
+	Fix    string `json:"fix,omitempty"`
+}
+
+// recordingTransport keeps the headers of the last response and when it was received.
+type recordingTransport struct {
+	next     http.RoundTripper
+	header   http.Header
+	received time.Time
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	t.header = resp.Header
+	t.received = time.Now()
+	return resp, nil
+}
+
+// doctorCommand returns the doctor command.
+func doctorCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "doctor",
+		Usage: "Diagnose credentials, connectivity and clock problems",
+		Description: `Checks that credentials resolve, the base URL is reachable and its certificate trusted,
+and sends a signed echo request to verify authentication, clock skew and rate-limit headroom.
+Each problem comes with a suggested fix. The command exits non-zero if any check fails.
+
+Examples:
+  onemoney-cli doctor
+  onemoney-cli --profile production doctor`,
+		Action: doctorRun,
+	}
+}
+
+func doctorRun(*cli.Context) error {
+	credsCheck, credsOK := checkCredentials()
+	urlCheck, target := checkBaseURL()
+	echoCheck, recorder := checkEcho(credsOK && target != nil)
+	checks := []doctorCheck{
+		credsCheck,
+		urlCheck,
+		checkTLS(target),
+		echoCheck,
+		checkClockSkew(recorder),
+		checkRateLimit(recorder),
+	}
+
+	if err := printView(checks, output.View{Format: output.FormatTable}); err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, check := range checks {
+		if check.Status == doctorFail {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d checks failed", failed, len(checks))
+	}
+	return nil
+}
+
+// checkCredentials resolves credentials the way the client does and reports where they came from.
+func checkCredentials() (doctorCheck, bool) {
+	check := doctorCheck{Check: "credentials"}
+	sandbox := os.Getenv(credentials.EnvSandbox) == "1"
+
+	type source struct {
+		name     string
+		provider credentials.Provider
+	}
+	var sources []source
+	if accessKey != "" && (sandbox || secretKey != "") {
+		name := "--access-key and --secret-key flags"
+		if os.Getenv(credentials.EnvAccessKey) == accessKey {
+			name = "ONEMONEY_* environment variables"
+		}
+		sources = append(sources, source{name, credentials.NewStaticProvider(accessKey, secretKey, baseURL, sandbox)})
+	}
+	sources = append(sources,
+		source{"ONEMONEY_* environment variables", credentials.NewEnvProvider()},
+		source{fmt.Sprintf("profile %q in %s", profile, credentialsPath()), credentials.NewFileProvider(credentialsPath(), profile)},
+	)
+
+	for _, s := range sources {
+		creds, err := s.provider.Retrieve()
+		if err != nil || creds == nil || !creds.IsValid() {
+			continue
+		}
+		if creds.Sandbox {
+			check.Status = doctorOK
+			check.Detail = fmt.Sprintf("sandbox access key %s from %s", maskKey(creds.AccessKey), s.name)
+			return check, true
+		}
+		if !validSecretKey(creds.SecretKey) {
+			check.Status = doctorFail
+			check.Detail = fmt.Sprintf("secret key from %s is not base64url encoded", s.name)
+			check.Fix = "Copy the secret key exactly as it was issued, e.g. with 'onemoney-cli config set secret-key'"
+			return check, false
+		}
+		check.Status = doctorOK
+		check.Detail = fmt.Sprintf("access key %s from %s", maskKey(creds.AccessKey), s.name)
+		return check, true
+	}
+
+	check.Status = doctorFail
+	check.Detail = "no credentials found in flags, environment variables or " + credentialsPath()
+	check.Fix = credentialsFix
+	return check, false
+}
+
+// validSecretKey reports whether the secret key decodes like the request signer expects.
+func validSecretKey(key string) bool {
+	padding := (4 - len(key)%4) % 4
+	_, err := base64.URLEncoding.DecodeString(key + strings.Repeat("=", padding))
+	return err == nil
+}
+
+// checkBaseURL checks that the base URL is valid and accepts connections.
+// It returns the parsed URL only when it is reachable.
+func checkBaseURL() (doctorCheck, *url.URL) {
+	check := doctorCheck{Check: "base URL"}
+	target, err := url.Parse(baseURL)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		check.Status = doctorFail
+		check.Detail = fmt.Sprintf("invalid base URL %q", baseURL)
+		check.Fix = "Set --base-url, ONEMONEY_BASE_URL or 'config set base-url' to an http(s) URL, " +
+			"e.g. https://api.sandbox.1money.com"
+		return check, nil
+	}
+
+	start := time.Now()
+	conn, err := net.DialTimeout("tcp", hostPort(target), timeout)
+	if err != nil {
+		check.Status = doctorFail
+		check.Detail = fmt.Sprintf("%s is unreachable: %v", target.Host, err)
+		check.Fix = "Check the base URL, your network connection and any firewall or proxy in between"
+		return check, nil
+	}
+	conn.Close()
+
+	check.Status = doctorOK
+	check.Detail = fmt.Sprintf("%s reachable in %s", baseURL, time.Since(start).Round(time.Millisecond))
+	if target.Scheme == "http" && !isLoopback(target.Hostname()) {
+		check.Status = doctorWarn
+		check.Detail += " over plain HTTP"
+		check.Fix = "Use an https:// base URL outside local development"
+	}
+	return check, target
+}
+
+// checkTLS checks that the server certificate of an https base URL is trusted and not about to expire.
+func checkTLS(target *url.URL) doctorCheck {
+	check := doctorCheck{Check: "TLS"}
+	switch {
+	case target == nil:
+		check.Status, check.Detail = doctorSkip, "base URL is not reachable"
+		return check
+	case target.Scheme != "https":
+		check.Status, check.Detail = doctorSkip, "base URL does not use TLS"
+		return check
+	}
+
+	dialer := &net.Dialer{Timeout: timeout}
+	conn, err := tls.DialWithDialer(dialer, "tcp", hostPort(target), &tls.Config{ServerName: target.Hostname()})
+	if err != nil {
+		check.Status = doctorFail
+		check.Detail = fmt.Sprintf("TLS handshake with %s failed: %v", target.Host, err)
+
+		var unknownAuthority x509.UnknownAuthorityError
+		var hostname x509.HostnameError
+		var invalid x509.CertificateInvalidError
+		switch {
+		case errors.As(err, &unknownAuthority):
+			check.Fix = "Install the system CA certificates, or point SSL_CERT_FILE at the CA bundle of your TLS-inspecting proxy"
+		case errors.As(err, &hostname):
+			check.Fix = "The certificate does not match the host; check the base URL"
+		case errors.As(err, &invalid):
+			check.Fix = "The certificate is expired or not yet valid; check that the system clock is correct"
+		default:
+			check.Fix = "Check that the base URL points at an HTTPS endpoint"
+		}
+		return check
+	}
+	defer conn.Close()
+
+	cert := conn.ConnectionState().PeerCertificates[0]
+	check.Status = doctorOK
+	check.Detail = fmt.Sprintf("certificate for %s issued by %s, valid until %s",
+		cert.Subject.CommonName, cert.Issuer.CommonName, cert.NotAfter.Format(time.DateOnly))
+	if time.Until(cert.NotAfter) < certExpiryWarn {
+		check.Status = doctorWarn
+		check.Fix = "The server certificate expires soon; contact 1Money support if requests start failing"
+	}
+	return check
+}
+
+// checkEcho sends a signed echo request. The returned recorder holds the response headers,
+// or is nil when the request was not sent.
+func checkEcho(ready bool) (doctorCheck, *recordingTransport) {
+	check := doctorCheck{Check: "signed request"}
+	if !ready {
+		check.Status, check.Detail = doctorSkip, "requires credentials and a reachable base URL"
+		return check, nil
+	}
+
+	recorder := &recordingTransport{next: &http.Transport{}}
+	cfg := clientConfig()
+	cfg.HTTPClient = &http.Client{Timeout: timeout, Transport: recorder}
+	cfg.Retry = onemoney.NoRetryConfig()
+	client, err := onemoney.NewClient(cfg)
+	if err != nil {
+		check.Status, check.Detail, check.Fix = doctorFail, err.Error(), credentialsFix
+		return check, nil
+	}
+
+	start := time.Now()
+	_, err = client.Echo.Get(context.Background())
+	apiErr, isAPIErr := transport.IsAPIError(err)
+	switch {
+	case err == nil:
+		check.Status = doctorOK
+		check.Detail = fmt.Sprintf("GET /echo authenticated in %s", time.Since(start).Round(time.Millisecond))
+	case isAPIErr && apiErr.IsAuthError():
+		check.Status = doctorFail
+		check.Detail = "credentials were rejected: " + apiErr.Error()
+		check.Fix = "Check that the access and secret keys are from the same key pair and the same environment " +
+			"as the base URL, and that the clock skew check passes"
+	case isAPIErr && apiErr.IsForbiddenError():
+		check.Status = doctorFail
+		check.Detail = "access denied: " + apiErr.Error()
+		check.Fix = "Check the key's scopes and allowed IP addresses"
+	case isAPIErr && apiErr.IsRateLimitError():
+		check.Status = doctorWarn
+		check.Detail = "rate limited: " + apiErr.Error()
+		check.Fix = "Wait before retrying, or reduce the request rate of other clients using this key"
+	case isAPIErr:
+		check.Status = doctorFail
+		check.Detail = apiErr.Error()
+		check.Fix = "Retry later; if the error persists, contact 1Money support with the request details"
+	default:
+		check.Status = doctorFail
+		check.Detail = err.Error()
+		check.Fix = "Check that the base URL points at the 1Money API"
+	}
+	return check, recorder
+}
+
+// checkClockSkew compares the local clock with the Date header of the echo response.
+func checkClockSkew(recorder *recordingTransport) doctorCheck {
+	check := doctorCheck{Check: "clock skew"}
+	if recorder == nil || recorder.header == nil {
+		check.Status, check.Detail = doctorSkip, "no response from the server"
+		return check
+	}
+	date, err := http.ParseTime(recorder.header.Get("Date"))
+	if err != nil {
+		check.Status, check.Detail = doctorSkip, "server did not send a Date header"
+		return check
+	}
+
+	// The Date header has a one second resolution.
+	skew := recorder.received.Sub(date).Truncate(time.Second)
+	abs := skew.Abs()
+	switch {
+	case abs < time.Second:
+		check.Detail = "local clock matches the server"
+	case skew > 0:
+		check.Detail = fmt.Sprintf("local clock is %s ahead of the server", abs)
+	default:
+		check.Detail = fmt.Sprintf("local clock is %s behind the server", abs)
+	}
+
+	switch {
+	case abs >= clockSkewFail:
+		check.Status = doctorFail
+	case abs >= clockSkewWarn:
+		check.Status = doctorWarn
+	default:
+		check.Status = doctorOK
+		return check
+	}
+	check.Fix = "Synchronize the system clock with NTP; signed requests are rejected when their timestamp is off by minutes"
+	return check
+}
+
+// checkRateLimit reports the remaining rate limit from the echo response headers.
+func checkRateLimit(recorder *recordingTransport) doctorCheck {
+	check := doctorCheck{Check: "rate limit"}
+	if recorder == nil || recorder.header == nil {
+		check.Status, check.Detail = doctorSkip, "no response from the server"
+		return check
+	}
+
+	limit, remaining, ok := rateLimitHeaders(recorder.header)
+	if !ok {
+		check.Status, check.Detail = doctorSkip, "server did not report rate limit headers"
+		return check
+	}
+	check.Status = doctorOK
+	check.Detail = fmt.Sprintf("%d of %d requests remaining", remaining, limit)
+	if float64(remaining) < rateLimitWarn*float64(limit) {
+		check.Status = doctorWarn
+		check.Fix = "Other clients are using most of this key's rate limit; reduce their request rate or use a separate key"
+	}
+	return check
+}
+
+// rateLimitHeaders reads the limit and remaining requests from the X-RateLimit-* or RateLimit-* headers.
+func rateLimitHeaders(header http.Header) (limit, remaining int, ok bool) {
+	for _, prefix := range []string{"X-RateLimit-", "RateLimit-"} {
+		l, errLimit := strconv.Atoi(header.Get(prefix + "Limit"))
+		r, errRemaining := strconv.Atoi(header.Get(prefix + "Remaining"))
+		if errLimit == nil && errRemaining == nil {
+			return l, r, true
+		}
+	}
+	return 0, 0, false
+}
+
+// hostPort returns the host and port of a URL, defaulting the port from the scheme.
+func hostPort(u *url.URL) string {
+	port := u.Port()
+	if port == "" {
+		port = "80"
+		if u.Scheme == "https" {
+			port = "443"
+		}
+	}
+	return net.JoinHostPort(u.Hostname(), port)
+}
+
+// isLoopback reports whether the host is localhost or a loopback address.
+func isLoopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
@@ -0,0 +1,126 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+)
+
+// errDryRun is returned instead of sending a mutating request when --dry-run is set.
+var errDryRun = errors.New("dry run: request not sent")
+
+// redacted replaces secrets in printed requests.
+const redacted = "<redacted>"
+
+// dryRunTransport prints mutating requests instead of sending them. Read-only requests
+// are sent as usual so that commands can still look up the data they need.
+type dryRunTransport struct {
+	next http.RoundTripper
+	out  io.Writer
+}
+
+// newDryRunClient returns an HTTP client that prints signed mutating requests to stdout.
+// Read-only requests go through a clone of the default transport, so they keep its proxy
+// settings, dial and TLS handshake timeouts and HTTP/2 support.
+func newDryRunClient() *http.Client {
+	next := http.DefaultTransport.(*http.Transport).Clone()
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: &dryRunTransport{next: next, out: os.Stdout},
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *dryRunTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return t.next.RoundTrip(req)
+	}
+
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read request body: %w", err)
+		}
+	}
+
+	if err := printRequest(t.out, req, body); err != nil {
+		return nil, err
+	}
+	return nil, errDryRun
+}
+
+// printRequest writes the request line, sorted headers with the signature redacted,
+// and the indented body.
+func printRequest(w io.Writer, req *http.Request, body []byte) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %s\n", req.Method, req.URL)
+
+	names := make([]string, 0, len(req.Header))
+	for name := range req.Header {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		for _, value := range req.Header[name] {
+			if name == "Authorization" {
+				value = redactAuthorization(value)
+			}
+			fmt.Fprintf(&b, "%s: %s\n", name, value)
+		}
+	}
+
+	if len(body) > 0 {
+		var indented bytes.Buffer
+		if json.Indent(&indented, body, "", "  ") == nil {
+			body = indented.Bytes()
+		}
+		b.WriteString("\n")
+		b.Write(body)
+		b.WriteString("\n")
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// redactAuthorization hides the signature of an HMAC authorization header, keeping the
+// access key and timestamp, or the whole token of a Bearer header.
+func redactAuthorization(value string) string {
+	scheme, credential, ok := strings.Cut(value, " ")
+	if !ok {
+		return redacted
+	}
+	if strings.EqualFold(scheme, "Bearer") {
+		return scheme + " " + redacted
+	}
+	if i := strings.LastIndex(credential, ":"); i >= 0 {
+		return scheme + " " + credential[:i+1] + redacted
+	}
+	return scheme + " " + redacted
+}
@@ -32,15 +32,28 @@ func echoCommand() *cli.Command {
 		Name:    "echo",
 		Aliases: []string{"e"},
 		Usage:   "Test echo service",
+		Description: `Calls the echo endpoints to check connectivity and request signing.
+
+Examples:
+  onemoney-cli echo
+  onemoney-cli echo post -m "Hello World"`,
 		Subcommands: []*cli.Command{
 			{
-				Name:   "get",
-				Usage:  "Send a GET echo request",
+				Name:  "get",
+				Usage: "Send a GET echo request",
+				Description: `Examples:
+  onemoney-cli echo get`,
 				Action: echoGet,
 			},
 			{
-				Name:  "post",
-				Usage: "Send a POST echo request",
+				Name:      "post",
+				Usage:     "Send a POST echo request",
+				ArgsUsage: "[message]",
+				Description: `The message can be given with --message or as the first argument.
+
+Examples:
+  onemoney-cli echo post -m "Hello World"
+  onemoney-cli echo post "Hello World"`,
 				Flags: []cli.Flag{
 					&cli.StringFlag{
 						Name:    "message",
@@ -69,7 +82,7 @@ func echoGet(*cli.Context) error {
 		return fmt.Errorf("failed to perform GET echo: %w", err)
 	}
 
-	return printJSON(resp)
+	return printOutput(resp)
 }
 
 func echoPost(c *cli.Context) error {
@@ -94,15 +107,26 @@ func echoPost(c *cli.Context) error {
 		return fmt.Errorf("failed to perform POST echo: %w", err)
 	}
 
-	return printJSON(resp)
+	return printOutput(resp)
 }
 
 func createClient() (*onemoney.Client, error) {
-	return onemoney.NewClient(&onemoney.Config{
+	cfg := clientConfig()
+	if dryRun {
+		// Mutating requests fail with errDryRun after being printed; never retry them.
+		cfg.HTTPClient = newDryRunClient()
+		cfg.Retry = onemoney.NoRetryConfig()
+	}
+	return onemoney.NewClient(cfg)
+}
+
+// clientConfig returns the client configuration built from the global flags.
+func clientConfig() *onemoney.Config {
+	return &onemoney.Config{
 		AccessKey: accessKey,
 		SecretKey: secretKey,
 		BaseURL:   baseURL,
 		Profile:   profile,
 		Timeout:   timeout,
-	})
+	}
 }
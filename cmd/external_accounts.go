@@ -0,0 +1,150 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/1Money-Co/1money-go-sdk/cmd/output"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/external_accounts"
+)
+
+// externalAccountColumns selects the fields shown when external accounts are printed as a table or CSV.
+const externalAccountColumns = `{external_account_id: external_account_id, status: status, network: network, ` +
+	`currency: currency, institution_name: institution_name, account_number: account_number, created_at: created_at}`
+
+// externalAccountsCommand returns the external-accounts command with all its subcommands.
+func externalAccountsCommand() *cli.Command {
+	return &cli.Command{
+		Name:    "external-accounts",
+		Aliases: []string{"ea"},
+		Usage:   "Inspect customer bank accounts used for fiat withdrawals",
+		Description: `Examples:
+  onemoney-cli -o table external-accounts list -c CUSTOMER_ID --status APPROVED
+  onemoney-cli external-accounts get -c CUSTOMER_ID --watch EXTERNAL_ACCOUNT_ID`,
+		Subcommands: []*cli.Command{
+			{
+				Name:  "list",
+				Usage: "List external accounts",
+				Description: `Examples:
+  onemoney-cli -o table external-accounts list -c CUSTOMER_ID
+  onemoney-cli external-accounts list -c CUSTOMER_ID --network SWIFT --currency USD`,
+				Flags: []cli.Flag{
+					customerFlag(),
+					&cli.StringFlag{Name: "currency", Usage: "Filter by currency: USD or EUR"},
+					&cli.StringFlag{Name: "network", Usage: "Filter by network: US_ACH, SWIFT, US_FEDWIRE or SEPA"},
+					&cli.StringFlag{Name: "status", Usage: "Filter by status, e.g. APPROVED"},
+				},
+				Action: externalAccountsList,
+			},
+			{
+				Name:  "get",
+				Usage: "Get an external account",
+				Description: `With --watch, re-renders the account until it is approved, fails or needs a document.
+
+Examples:
+  onemoney-cli external-accounts get -c CUSTOMER_ID EXTERNAL_ACCOUNT_ID
+  onemoney-cli -o table external-accounts get -c CUSTOMER_ID --watch EXTERNAL_ACCOUNT_ID`,
+				ArgsUsage: "<external-account-id>",
+				Flags: append([]cli.Flag{
+					customerFlag(),
+				}, watchFlags(
+					external_accounts.DefaultWaitOptions().PollInterval, external_accounts.DefaultWaitOptions().MaxWaitTime,
+				)...),
+				Action: externalAccountsGet,
+			},
+		},
+	}
+}
+
+func externalAccountsList(c *cli.Context) error {
+	req := &external_accounts.ListReq{}
+	var err error
+	if v := c.String("currency"); v != "" {
+		if req.Currency, err = external_accounts.ParseCurrency(v); err != nil {
+			return err
+		}
+	}
+	if v := c.String("network"); v != "" {
+		if req.Network, err = external_accounts.ParseBankNetworkName(v); err != nil {
+			return err
+		}
+	}
+	if v := c.String("status"); v != "" {
+		if req.Status, err = external_accounts.ParseBankAccountStatus(v); err != nil {
+			return err
+		}
+	}
+
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	ctx := context.Background()
+
+	resp, err := client.ExternalAccounts.ListExternalAccounts(ctx, customerIDFlag(c), req)
+	if err != nil {
+		return fmt.Errorf("failed to list external accounts: %w", err)
+	}
+
+	return printView(resp, output.View{Columns: "[*]." + externalAccountColumns})
+}
+
+func externalAccountsGet(c *cli.Context) error {
+	externalAccountID := c.Args().First()
+	if externalAccountID == "" {
+		return errors.New("external account ID is required")
+	}
+
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	ctx := context.Background()
+	view := output.View{Columns: externalAccountColumns}
+
+	if c.Bool("watch") {
+		ctx, cancel := watchContext()
+		defer cancel()
+
+		r := &watchRenderer{view: view}
+		_, err := external_accounts.WaitFor(ctx, client.ExternalAccounts, customerIDFlag(c), externalAccountID,
+			func(account *external_accounts.Resp) bool {
+				r.render(account)
+				return account.IsTerminal()
+			},
+			&external_accounts.WaitOptions{
+				PollInterval: c.Duration("poll-interval"),
+				MaxWaitTime:  c.Duration("max-wait"),
+			},
+		)
+		return watchDone(r, err)
+	}
+
+	resp, err := client.ExternalAccounts.GetExternalAccount(ctx, customerIDFlag(c), externalAccountID)
+	if err != nil {
+		return fmt.Errorf("failed to get external account: %w", err)
+	}
+
+	return printView(resp, view)
+}
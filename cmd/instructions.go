@@ -0,0 +1,186 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/skip2/go-qrcode"
+	"github.com/urfave/cli/v2"
+
+	"github.com/1Money-Co/1money-go-sdk/cmd/output"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/instructions"
+)
+
+// instructionColumns selects the fields shown when deposit instructions are printed as a table or CSV.
+const instructionColumns = `{asset: asset, network: network, wallet_address: wallet_instruction.wallet_address, ` +
+	`bank_name: bank_instruction.bank_name, routing_number: bank_instruction.routing_number, ` +
+	`account_number: bank_instruction.account_number, iban: bank_instruction.iban}`
+
+// defaultQRSize is the default width and height of PNG QR codes, in pixels.
+const defaultQRSize = 256
+
+// instructionsCommand returns the instructions command with all its subcommands.
+func instructionsCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "instructions",
+		Usage: "Show deposit instructions",
+		Description: `Examples:
+  onemoney-cli instructions list -c CUSTOMER_ID
+  onemoney-cli instructions get -c CUSTOMER_ID --asset USDC --network POLYGON --qr`,
+		Subcommands: []*cli.Command{
+			{
+				Name:  "get",
+				Usage: "Get the deposit instructions for an asset and network",
+				Description: `With --qr, a QR code of the wallet address is drawn on stderr, so stdout stays
+machine-readable. With --token-address on EVM networks and Solana, it encodes a payment URI for
+that token instead. --qr-png writes the same QR code to a PNG file.
+
+Examples:
+  onemoney-cli instructions get -c CUSTOMER_ID --asset USD --network US_ACH
+  onemoney-cli instructions get -c CUSTOMER_ID --asset USDC --network POLYGON --qr
+  onemoney-cli instructions get -c CUSTOMER_ID --asset USDC --network SOLANA --qr-png deposit.png --qr-size 512`,
+				Flags: []cli.Flag{
+					customerFlag(),
+					&cli.StringFlag{Name: "asset", Usage: "Asset to deposit, e.g. USD or USDC", Required: true},
+					&cli.StringFlag{Name: "network", Usage: "Network, e.g. US_ACH or POLYGON", Required: true},
+					&cli.BoolFlag{Name: "qr", Usage: "Draw a QR code of the wallet address in the terminal"},
+					&cli.StringFlag{Name: "qr-png", Usage: "Write a QR code of the wallet address to this PNG file"},
+					&cli.IntFlag{Name: "qr-size", Usage: "Size of the PNG QR code in pixels", Value: defaultQRSize},
+					&cli.StringFlag{
+						Name:  "token-address",
+						Usage: "Token contract or mint address; the QR code then encodes a payment URI for the token",
+					},
+				},
+				Action: instructionsGet,
+			},
+			{
+				Name:  "list",
+				Usage: "List deposit instructions for every asset and network",
+				Description: `Prints a table by default; use -o json for the full response.
+
+Examples:
+  onemoney-cli instructions list -c CUSTOMER_ID
+  onemoney-cli -o json instructions list -c CUSTOMER_ID`,
+				Flags: []cli.Flag{
+					customerFlag(),
+				},
+				Action: instructionsList,
+			},
+		},
+	}
+}
+
+func instructionsGet(c *cli.Context) error {
+	asset, err := assets.ParseAssetName(c.String("asset"))
+	if err != nil {
+		return err
+	}
+	network, err := assets.ParseNetworkName(c.String("network"))
+	if err != nil {
+		return err
+	}
+
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	resp, err := client.Instructions.GetDepositInstruction(context.Background(), customerIDFlag(c), asset, network)
+	if err != nil {
+		return fmt.Errorf("failed to get deposit instructions: %w", err)
+	}
+
+	if err := printView(resp, output.View{Columns: instructionColumns}); err != nil {
+		return err
+	}
+
+	if !c.Bool("qr") && !c.IsSet("qr-png") {
+		return nil
+	}
+	qr, err := instructionQRCode(resp, c.String("token-address"))
+	if err != nil {
+		return err
+	}
+	if c.Bool("qr") {
+		fmt.Fprint(os.Stderr, ansiQRCode(qr.Bitmap()))
+	}
+	if path := c.String("qr-png"); path != "" {
+		if err := qr.WriteFile(c.Int("qr-size"), path); err != nil {
+			return fmt.Errorf("failed to write %s: %w", path, err)
+		}
+		fmt.Fprintf(os.Stderr, "Wrote QR code to %s\n", path)
+	}
+	return nil
+}
+
+func instructionsList(c *cli.Context) error {
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	resp, err := client.Instructions.ListDepositInstructions(context.Background(), customerIDFlag(c))
+	if err != nil {
+		return fmt.Errorf("failed to list deposit instructions: %w", err)
+	}
+
+	return printView(resp, output.View{Format: output.FormatTable, Columns: "[*]." + instructionColumns})
+}
+
+// instructionQRCode encodes the wallet address of a crypto deposit instruction, or a payment
+// URI for the token when a token address is given.
+func instructionQRCode(r *instructions.InstructionResponse, tokenAddress string) (*qrcode.QRCode, error) {
+	content, err := r.QRContent(&instructions.PaymentURIOptions{TokenAddress: tokenAddress})
+	if err != nil {
+		return nil, fmt.Errorf("no QR code for %s on %s: %w", r.Asset, r.Network, err)
+	}
+
+	qr, err := qrcode.New(content, qrcode.Medium)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode QR code: %w", err)
+	}
+	return qr, nil
+}
+
+// ansiQRCode draws a QR code bitmap with ANSI background colors, two columns per module
+// so that modules are roughly square. Dark modules are black on white regardless of the
+// terminal's color scheme, which keeps the code scannable.
+func ansiQRCode(bitmap [][]bool) string {
+	const (
+		dark  = "\x1b[40m  "
+		light = "\x1b[47m  "
+		reset = "\x1b[0m"
+	)
+
+	var b strings.Builder
+	for _, row := range bitmap {
+		for _, module := range row {
+			if module {
+				b.WriteString(dark)
+			} else {
+				b.WriteString(light)
+			}
+		}
+		b.WriteString(reset + "\n")
+	}
+	return b.String()
+}
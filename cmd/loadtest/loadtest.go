@@ -19,8 +19,8 @@ package loadtest
 import (
 	"context"
 	"fmt"
-	"io"
 	"os"
+	"sync"
 	"time"
 
 	vegeta "github.com/tsenart/vegeta/v12/lib"
@@ -62,10 +62,26 @@ Test sequence:
   7. List Auto-Conversion Rules
   8. List All Transactions
 
-Output is Vegeta format, can be piped to vegeta report:
-
-  onemoney loadtest | vegeta report
-  onemoney loadtest | vegeta report -type=hist`,
+With --scenario, endpoints run concurrently instead, as a weighted mix that ramps up
+to a peak rate. See Scenario for the file format:
+
+  name: launch-capacity
+  rate: 50
+  duration: 5m
+  ramp_up: 1m
+  endpoints:
+    - name: list-transactions
+      weight: 5
+    - name: get-customer
+      method: GET
+      path: /v1/customers/{customer_id}
+      weight: 2
+
+The report shows latency percentiles and a histogram per endpoint and in total, as text,
+JSON or a self-contained HTML page:
+
+  onemoney loadtest --scenario launch.yaml --format html -o report.html
+  onemoney loadtest --scenario launch.yaml --format json --buckets "[0,50ms,100ms,250ms,1s]"`,
 		Flags: []cli.Flag{
 			&cli.IntFlag{
 				Name:    "rate",
@@ -84,6 +100,19 @@ Output is Vegeta format, can be piped to vegeta report:
 				Aliases: []string{"o"},
 				Usage:   "Output file for report (default: stdout)",
 			},
+			&cli.StringFlag{
+				Name:  "scenario",
+				Usage: "YAML scenario file with a weighted mix of endpoints, ramp-up and duration",
+			},
+			&cli.StringFlag{
+				Name:  "format",
+				Usage: "Report format: text, json or html (default: from the output file extension, else text)",
+			},
+			&cli.StringFlag{
+				Name:  "buckets",
+				Usage: "Latency histogram buckets",
+				Value: defaultBuckets,
+			},
 		},
 		Action: runLoadtest,
 	}
@@ -107,7 +136,28 @@ type loadtestContext struct {
 func runLoadtest(c *cli.Context) error {
 	rate := c.Int("rate")
 	duration := c.Duration("duration")
-	outputFile := c.String("output")
+
+	opts, err := newReportOptions(c.String("output"), c.String("format"), c.String("buckets"))
+	if err != nil {
+		return err
+	}
+
+	var scenario *Scenario
+	if path := c.String("scenario"); path != "" {
+		if scenario, err = LoadScenario(path); err != nil {
+			return err
+		}
+		// Explicit flags override the scenario file.
+		if c.IsSet("rate") {
+			scenario.Rate = float64(rate)
+		}
+		if c.IsSet("duration") {
+			scenario.Duration = duration
+		}
+		if err := scenario.Validate(); err != nil {
+			return fmt.Errorf("invalid scenario %s: %w", path, err)
+		}
+	}
 
 	// Create SDK client - loads config from env automatically
 	client, err := onemoney.NewClient(nil)
@@ -129,26 +179,16 @@ func runLoadtest(c *cli.Context) error {
 		client: client,
 	}
 
+	if scenario != nil {
+		return runScenario(ctx, scenario, opts)
+	}
+
 	// Pool size for create-customer (need pre-generated signed agreements)
 	poolSize := rate * int(duration.Seconds())
+	testCases := sequenceTestCases(poolSize)
 
-	// Define test cases in order
-	testCases := []testCase{
-		{name: "create-tos-link", targeter: createTOSLinkTargeter},
-		{name: "create-customer", targeter: createCustomerTargeter, setup: func(ctx *loadtestContext) error {
-			return prepareSignedAgreements(ctx, poolSize)
-		}},
-		{name: "list-customers", targeter: listCustomersTargeter},
-		{name: "create-external-account", targeter: createExternalAccountTargeter, setup: setupCustomerID},
-		{name: "get-external-account", targeter: getExternalAccountTargeter, setup: setupExternalAccount},
-		{name: "create-auto-conversion-rule", targeter: createAutoConversionRuleTargeter}, // 500 expected if no verified fiat account
-		{name: "list-auto-conversion-rules", targeter: listAutoConversionRulesTargeter},
-		{name: "list-transactions", targeter: listTransactionsTargeter},
-	}
-
-	// Collect results per test case and all results for final report
-	var allResults []*vegeta.Result
-	caseResults := make(map[string][]*vegeta.Result)
+	// Collect results per test case, in order
+	var cases []caseResults
 
 	for _, tc := range testCases {
 		// Create fresh attacker for each test case
@@ -167,36 +207,137 @@ func runLoadtest(c *cli.Context) error {
 
 		fmt.Fprintf(os.Stderr, "→ %s (%d req/s, %s)\n", tc.name, rate, duration)
 
-		var caseRes []*vegeta.Result
-		targeter := tc.targeter(ctx)
-		resultCount := 0
-		for res := range attacker.Attack(targeter, vegeta.Rate{Freq: rate, Per: time.Second}, duration, tc.name) {
-			resultCount++
-			caseRes = append(caseRes, res)
-			allResults = append(allResults, res)
-			if resultCount <= 3 {
-				log.Debugw("attack result",
-					"seq", resultCount,
-					"code", res.Code,
-					"latency", res.Latency,
-				)
+		pacer := vegeta.Rate{Freq: rate, Per: time.Second}
+		results := collectResults(attacker.Attack(tc.targeter(ctx), pacer, duration, tc.name))
+		cases = append(cases, caseResults{name: tc.name, results: results})
+	}
+
+	// Generate report
+	return writeReport("sequence", cases, opts)
+}
+
+// runScenario runs every endpoint of a scenario concurrently, each at its weighted share
+// of the scenario rate, and writes the report.
+func runScenario(ctx *loadtestContext, scenario *Scenario, opts *reportOptions) error {
+	type run struct {
+		tc    testCase
+		pacer rampPacer
+	}
+
+	runs := make([]run, len(scenario.Endpoints))
+	for i := range scenario.Endpoints {
+		e := &scenario.Endpoints[i]
+		pacer := rampPacer{Peak: scenario.endpointRate(e), RampUp: scenario.RampUp}
+
+		tc := testCase{name: e.Name, targeter: customTargeter(e), setup: customSetup(e)}
+		if e.Path == "" {
+			tc, _ = builtinTestCase(e.Name)
+			if tc.name == "create-customer" {
+				poolSize := pacer.hits(scenario.Duration) + 1
+				tc.setup = func(ctx *loadtestContext) error { return prepareSignedAgreements(ctx, poolSize) }
 			}
-			// Log errors with response body for debugging
-			if res.Error != "" || (res.Code >= 400 && res.Code < 600) {
-				log.Warnw("request failed",
-					"seq", resultCount,
-					"code", res.Code,
-					"error", res.Error,
-					"body", string(res.Body),
-				)
+		}
+
+		// Setups run before any traffic, so that they do not skew the results.
+		if e.Path == "" && needsCustomer(tc.name) {
+			if err := setupCustomerID(ctx); err != nil {
+				return fmt.Errorf("setup failed for %s: %w", tc.name, err)
 			}
 		}
-		log.Debugw("attack loop finished", "totalResults", resultCount)
-		caseResults[tc.name] = caseRes
+		if tc.setup != nil {
+			if err := tc.setup(ctx); err != nil {
+				return fmt.Errorf("setup failed for %s: %w", tc.name, err)
+			}
+		}
+		runs[i] = run{tc: tc, pacer: pacer}
 	}
 
-	// Generate report
-	return writeReport(caseResults, allResults, outputFile)
+	fmt.Fprintf(os.Stderr, "→ %s (%g req/s peak, %s ramp-up, %s)\n",
+		scenario.Name, scenario.Rate, scenario.RampUp, scenario.Duration)
+
+	cases := make([]caseResults, len(runs))
+	var wg sync.WaitGroup
+	for i, r := range runs {
+		fmt.Fprintf(os.Stderr, "  %s: %.2f req/s peak\n", r.tc.name, r.pacer.Peak)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			attacker := vegeta.NewAttacker()
+			results := collectResults(attacker.Attack(r.tc.targeter(ctx), r.pacer, scenario.Duration, r.tc.name))
+			cases[i] = caseResults{name: r.tc.name, results: results}
+		}()
+	}
+	wg.Wait()
+
+	name := scenario.Name
+	if name == "" {
+		name = "scenario"
+	}
+	return writeReport(name, cases, opts)
+}
+
+// collectResults drains an attack, logging the first results and every failure.
+func collectResults(attack <-chan *vegeta.Result) []*vegeta.Result {
+	var results []*vegeta.Result
+	for res := range attack {
+		results = append(results, res)
+		if len(results) <= 3 {
+			log.Debugw("attack result",
+				"attack", res.Attack,
+				"seq", len(results),
+				"code", res.Code,
+				"latency", res.Latency,
+			)
+		}
+		// Log errors with response body for debugging
+		if res.Error != "" || (res.Code >= 400 && res.Code < 600) {
+			log.Warnw("request failed",
+				"attack", res.Attack,
+				"seq", len(results),
+				"code", res.Code,
+				"error", res.Error,
+				"body", string(res.Body),
+			)
+		}
+	}
+	log.Debugw("attack loop finished", "totalResults", len(results))
+	return results
+}
+
+// sequenceTestCases returns the built-in test cases in the order they run without a scenario.
+// poolSize is the number of signed agreements prepared for create-customer.
+func sequenceTestCases(poolSize int) []testCase {
+	return []testCase{
+		{name: "create-tos-link", targeter: createTOSLinkTargeter},
+		{name: "create-customer", targeter: createCustomerTargeter, setup: func(ctx *loadtestContext) error {
+			return prepareSignedAgreements(ctx, poolSize)
+		}},
+		{name: "list-customers", targeter: listCustomersTargeter},
+		{name: "create-external-account", targeter: createExternalAccountTargeter, setup: setupCustomerID},
+		{name: "get-external-account", targeter: getExternalAccountTargeter, setup: setupExternalAccount},
+		{name: "create-auto-conversion-rule", targeter: createAutoConversionRuleTargeter}, // 500 expected if no verified fiat account
+		{name: "list-auto-conversion-rules", targeter: listAutoConversionRulesTargeter},
+		{name: "list-transactions", targeter: listTransactionsTargeter},
+	}
+}
+
+// builtinTestCaseNames returns the names of the built-in test cases.
+func builtinTestCaseNames() []string {
+	var names []string
+	for _, tc := range sequenceTestCases(0) {
+		names = append(names, tc.name)
+	}
+	return names
+}
+
+// builtinTestCase returns the built-in test case with the given name.
+func builtinTestCase(name string) (testCase, bool) {
+	for _, tc := range sequenceTestCases(0) {
+		if tc.name == name {
+			return tc, true
+		}
+	}
+	return testCase{}, false
 }
 
 func needsCustomer(name string) bool {
@@ -241,68 +382,3 @@ func setupExternalAccount(ctx *loadtestContext) error {
 	}
 	return nil
 }
-
-// testCaseOrder defines the order for reporting test cases.
-var testCaseOrder = []string{
-	"create-tos-link",
-	"create-customer",
-	"list-customers",
-	"create-external-account",
-	"get-external-account",
-	"create-auto-conversion-rule",
-	"list-auto-conversion-rules",
-	"list-transactions",
-}
-
-// writeReport generates a text report from results and writes to file or stdout.
-func writeReport(caseResults map[string][]*vegeta.Result, allResults []*vegeta.Result, outputFile string) error {
-	// Determine output destination
-	var out io.Writer = os.Stdout
-	if outputFile != "" {
-		f, err := os.Create(outputFile)
-		if err != nil {
-			return fmt.Errorf("failed to create output file: %w", err)
-		}
-		defer f.Close()
-		out = f
-	}
-
-	// Write per-case reports
-	for _, name := range testCaseOrder {
-		results, ok := caseResults[name]
-		if !ok || len(results) == 0 {
-			continue
-		}
-
-		var metrics vegeta.Metrics
-		for _, res := range results {
-			metrics.Add(res)
-		}
-		metrics.Close()
-
-		fmt.Fprintf(out, "\n=== %s ===\n", name)
-		reporter := vegeta.NewTextReporter(&metrics)
-		if err := reporter.Report(out); err != nil {
-			return fmt.Errorf("failed to write report for %s: %w", name, err)
-		}
-	}
-
-	// Write total report
-	var totalMetrics vegeta.Metrics
-	for _, res := range allResults {
-		totalMetrics.Add(res)
-	}
-	totalMetrics.Close()
-
-	fmt.Fprintf(out, "\n=== TOTAL ===\n")
-	reporter := vegeta.NewTextReporter(&totalMetrics)
-	if err := reporter.Report(out); err != nil {
-		return fmt.Errorf("failed to write total report: %w", err)
-	}
-
-	if outputFile != "" {
-		fmt.Fprintf(os.Stderr, "\nReport written to: %s\n", outputFile)
-	}
-
-	return nil
-}
@@ -0,0 +1,231 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package loadtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	vegeta "github.com/tsenart/vegeta/v12/lib"
+)
+
+// defaultBuckets are the latency histogram buckets used when --buckets is not set.
+const defaultBuckets = "[0,10ms,25ms,50ms,100ms,250ms,500ms,1s,2.5s]"
+
+// Report formats.
+const (
+	formatText = "text"
+	formatJSON = "json"
+	formatHTML = "html"
+)
+
+// caseResults holds the results of one test case or scenario endpoint.
+type caseResults struct {
+	name    string
+	results []*vegeta.Result
+}
+
+// reportOptions configures writeReport.
+type reportOptions struct {
+	outputFile string
+	format     string
+	buckets    vegeta.Buckets
+}
+
+// newReportOptions validates the report flags. Without a format, it is inferred from the
+// output file extension.
+func newReportOptions(outputFile, format, buckets string) (*reportOptions, error) {
+	if format == "" {
+		switch strings.ToLower(filepath.Ext(outputFile)) {
+		case ".json":
+			format = formatJSON
+		case ".html", ".htm":
+			format = formatHTML
+		default:
+			format = formatText
+		}
+	}
+	switch format {
+	case formatText, formatJSON, formatHTML:
+	default:
+		return nil, fmt.Errorf("unsupported report format %q, try text, json or html", format)
+	}
+
+	opts := &reportOptions{outputFile: outputFile, format: format}
+	if err := opts.buckets.UnmarshalText([]byte(buckets)); err != nil {
+		return nil, fmt.Errorf("invalid --buckets: %w", err)
+	}
+	return opts, nil
+}
+
+// caseReport is the summary of one test case, endpoint, or the total.
+type caseReport struct {
+	Name      string            `json:"name"`
+	Metrics   *vegeta.Metrics   `json:"metrics"`
+	Histogram *vegeta.Histogram `json:"histogram"`
+}
+
+// report is the summary of a load test run.
+type report struct {
+	Name  string       `json:"name"`
+	Cases []caseReport `json:"cases"`
+	Total caseReport   `json:"total"`
+}
+
+// newCaseReport computes the metrics and latency histogram of a set of results.
+func newCaseReport(name string, results []*vegeta.Result, buckets vegeta.Buckets) caseReport {
+	metrics := &vegeta.Metrics{}
+	histogram := &vegeta.Histogram{Buckets: buckets}
+	for _, res := range results {
+		metrics.Add(res)
+		histogram.Add(res)
+	}
+	metrics.Close()
+	if histogram.Counts == nil {
+		histogram.Counts = make([]uint64, len(buckets))
+	}
+	return caseReport{Name: name, Metrics: metrics, Histogram: histogram}
+}
+
+// writeReport summarizes the results per case and in total, and writes the report to the
+// output file or stdout.
+func writeReport(name string, cases []caseResults, opts *reportOptions) error {
+	// Determine output destination
+	var out io.Writer = os.Stdout
+	if opts.outputFile != "" {
+		f, err := os.Create(opts.outputFile)
+		if err != nil {
+			return fmt.Errorf("failed to create output file: %w", err)
+		}
+		defer f.Close()
+		out = f
+	}
+
+	r := report{Name: name}
+	var all []*vegeta.Result
+	for _, c := range cases {
+		if len(c.results) == 0 {
+			continue
+		}
+		r.Cases = append(r.Cases, newCaseReport(c.name, c.results, opts.buckets))
+		all = append(all, c.results...)
+	}
+	r.Total = newCaseReport("TOTAL", all, opts.buckets)
+
+	var err error
+	switch opts.format {
+	case formatJSON:
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(r)
+	case formatHTML:
+		err = htmlReport.Execute(out, r)
+	default:
+		err = writeTextReport(out, r)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+
+	if opts.outputFile != "" {
+		fmt.Fprintf(os.Stderr, "\nReport written to: %s\n", opts.outputFile)
+	}
+	return nil
+}
+
+// writeTextReport writes vegeta's text report and latency histogram for each case and the total.
+func writeTextReport(out io.Writer, r report) error {
+	for _, c := range append(r.Cases, r.Total) {
+		fmt.Fprintf(out, "\n=== %s ===\n", c.Name)
+		if err := vegeta.NewTextReporter(c.Metrics).Report(out); err != nil {
+			return fmt.Errorf("%s: %w", c.Name, err)
+		}
+		fmt.Fprintln(out)
+		if err := vegeta.NewHistogramReporter(c.Histogram).Report(out); err != nil {
+			return fmt.Errorf("%s: %w", c.Name, err)
+		}
+	}
+	return nil
+}
+
+// htmlReport renders a report as a self-contained HTML page.
+var htmlReport = template.Must(template.New("report").Funcs(template.FuncMap{
+	"ms": func(d time.Duration) string {
+		return fmt.Sprintf("%.1f ms", float64(d)/float64(time.Millisecond))
+	},
+	"pct": func(f float64) string {
+		return fmt.Sprintf("%.2f%%", f*100)
+	},
+	"bucket": func(bs vegeta.Buckets, i int) string {
+		left, right := bs.Nth(i)
+		return "[" + left + ", " + right + ")"
+	},
+	"share": func(count, total uint64) float64 {
+		if total == 0 {
+			return 0
+		}
+		return float64(count) * 100 / float64(total)
+	},
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Load test report: {{.Name}}</title>
+<style>
+body { font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", Helvetica, Arial, sans-serif; margin: 2em; color: #222; }
+table { border-collapse: collapse; margin-bottom: 1.5em; }
+th, td { padding: 4px 12px; border-bottom: 1px solid #ddd; text-align: right; }
+th:first-child, td:first-child { text-align: left; }
+.bar { background: #4a7bd0; height: 12px; }
+.hist td:last-child { width: 320px; }
+</style>
+</head>
+<body>
+<h1>Load test report: {{.Name}}</h1>
+
+<h2>Summary</h2>
+<table>
+<tr><th>Case</th><th>Requests</th><th>Rate/s</th><th>Success</th><th>Mean</th><th>P50</th><th>P90</th><th>P95</th><th>P99</th><th>Max</th></tr>
+{{range .Cases}}{{template "row" .}}{{end}}{{template "row" .Total}}
+</table>
+
+{{range .Cases}}{{template "case" .}}{{end}}{{template "case" .Total}}
+</body>
+</html>
+
+{{define "row"}}<tr><td>{{.Name}}</td><td>{{.Metrics.Requests}}</td><td>{{printf "%.2f" .Metrics.Rate}}</td><td>{{pct .Metrics.Success}}</td><td>{{ms .Metrics.Latencies.Mean}}</td><td>{{ms .Metrics.Latencies.P50}}</td><td>{{ms .Metrics.Latencies.P90}}</td><td>{{ms .Metrics.Latencies.P95}}</td><td>{{ms .Metrics.Latencies.P99}}</td><td>{{ms .Metrics.Latencies.Max}}</td></tr>
+{{end}}
+
+{{define "case"}}<h2>{{.Name}}</h2>
+<table>
+<tr><th>Status code</th><th>Count</th></tr>
+{{range $code, $count := .Metrics.StatusCodes}}<tr><td>{{$code}}</td><td>{{$count}}</td></tr>
+{{end}}</table>
+<table class="hist">
+<tr><th>Latency</th><th>Count</th><th>Share</th><th></th></tr>
+{{$h := .Histogram}}{{range $i, $count := $h.Counts}}<tr><td>{{bucket $h.Buckets $i}}</td><td>{{$count}}</td><td>{{printf "%.2f%%" (share $count $h.Total)}}</td><td><div class="bar" style="width: {{printf "%.1f" (share $count $h.Total)}}%"></div></td></tr>
+{{end}}</table>
+{{if .Metrics.Errors}}<h3>Errors</h3>
+<ul>{{range .Metrics.Errors}}<li>{{.}}</li>{{end}}</ul>
+{{end}}{{end}}
+`))
@@ -0,0 +1,257 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package loadtest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	vegeta "github.com/tsenart/vegeta/v12/lib"
+	"gopkg.in/yaml.v3"
+)
+
+// Scenario describes a mixed-traffic load test: endpoints share a peak request rate in
+// proportion to their weights, ramping up linearly before holding the peak.
+//
+//	name: launch-capacity
+//	rate: 50          # peak requests per second across all endpoints
+//	duration: 5m      # total duration, including the ramp-up
+//	ramp_up: 1m
+//	endpoints:
+//	  - name: list-transactions     # a built-in test case
+//	    weight: 5
+//	  - name: get-customer          # a custom request
+//	    method: GET
+//	    path: /v1/customers/{customer_id}
+//	    weight: 2
+type Scenario struct {
+	// Name labels the scenario in reports.
+	Name string `yaml:"name"`
+	// Rate is the peak number of requests per second across all endpoints.
+	Rate float64 `yaml:"rate"`
+	// Duration is the total duration of the test, including the ramp-up.
+	Duration time.Duration `yaml:"duration"`
+	// RampUp is how long the rate takes to grow linearly from zero to Rate (optional).
+	RampUp time.Duration `yaml:"ramp_up"`
+	// Endpoints are the requests in the mix.
+	Endpoints []Endpoint `yaml:"endpoints"`
+}
+
+// Endpoint is a request in a scenario's mix: either a built-in test case, referenced by
+// name alone, or a custom request with a method and path.
+type Endpoint struct {
+	// Name is a built-in test case name, or a label for a custom request.
+	Name string `yaml:"name"`
+	// Weight is the endpoint's share of the scenario rate relative to the other endpoints. Default: 1.
+	Weight float64 `yaml:"weight"`
+	// Method is the HTTP method of a custom request.
+	Method string `yaml:"method"`
+	// Path is the path of a custom request. {customer_id} and {external_account_id} are
+	// replaced with IDs of existing resources.
+	Path string `yaml:"path"`
+	// Body is the JSON body of a custom request, written as YAML (optional).
+	Body any `yaml:"body"`
+}
+
+// LoadScenario reads and validates a YAML scenario file.
+func LoadScenario(path string) (*Scenario, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read scenario: %w", err)
+	}
+
+	var s Scenario
+	dec := yaml.NewDecoder(strings.NewReader(string(data)))
+	dec.KnownFields(true)
+	if err := dec.Decode(&s); err != nil {
+		return nil, fmt.Errorf("failed to parse scenario %s: %w", path, err)
+	}
+	if err := s.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid scenario %s: %w", path, err)
+	}
+	return &s, nil
+}
+
+// Validate checks the scenario's rate and durations, and that every endpoint is either a
+// known test case or a custom request with a unique name. It defaults weights to 1.
+func (s *Scenario) Validate() error {
+	if s.Rate <= 0 {
+		return errors.New("rate must be positive")
+	}
+	if s.Duration <= 0 {
+		return errors.New("duration must be positive")
+	}
+	if s.RampUp < 0 || s.RampUp > s.Duration {
+		return errors.New("ramp_up must be between zero and the duration")
+	}
+	if len(s.Endpoints) == 0 {
+		return errors.New("at least one endpoint is required")
+	}
+
+	seen := make(map[string]bool, len(s.Endpoints))
+	for i := range s.Endpoints {
+		e := &s.Endpoints[i]
+		if e.Name == "" {
+			return fmt.Errorf("endpoint %d: name is required", i)
+		}
+		if seen[e.Name] {
+			return fmt.Errorf("endpoint %q is listed twice", e.Name)
+		}
+		seen[e.Name] = true
+
+		if e.Weight < 0 {
+			return fmt.Errorf("endpoint %q: weight must not be negative", e.Name)
+		}
+		if e.Weight == 0 {
+			e.Weight = 1
+		}
+
+		if e.Path == "" {
+			if _, ok := builtinTestCase(e.Name); !ok {
+				return fmt.Errorf("endpoint %q is not a built-in test case (%s) and has no path",
+					e.Name, strings.Join(builtinTestCaseNames(), ", "))
+			}
+			if e.Method != "" || e.Body != nil {
+				return fmt.Errorf("endpoint %q: method and body are only allowed with a path", e.Name)
+			}
+			continue
+		}
+		if !strings.HasPrefix(e.Path, "/") {
+			return fmt.Errorf("endpoint %q: path must start with /", e.Name)
+		}
+		if e.Method == "" {
+			e.Method = http.MethodGet
+		}
+		e.Method = strings.ToUpper(e.Method)
+	}
+	return nil
+}
+
+// endpointRate returns the peak rate of an endpoint, its weighted share of the scenario rate.
+func (s *Scenario) endpointRate(e *Endpoint) float64 {
+	var total float64
+	for i := range s.Endpoints {
+		total += s.Endpoints[i].Weight
+	}
+	return s.Rate * e.Weight / total
+}
+
+// customTargeter returns a targeter for a custom endpoint, with the path placeholders
+// replaced by the IDs found during setup.
+func customTargeter(e *Endpoint) func(ctx *loadtestContext) vegeta.Targeter {
+	return func(ctx *loadtestContext) vegeta.Targeter {
+		path := strings.NewReplacer(
+			"{customer_id}", ctx.customerID,
+			"{external_account_id}", ctx.externalAccountID,
+		).Replace(e.Path)
+
+		var body []byte
+		if e.Body != nil {
+			body, _ = json.Marshal(e.Body)
+		}
+		return vegeta.NewStaticTargeter(vegeta.Target{
+			Method: e.Method,
+			URL:    ctx.client.Config.BaseURL + path,
+			Body:   body,
+			Header: defaultHeaders(ctx.client.Config.AccessKey),
+		})
+	}
+}
+
+// customSetup returns the setup a custom endpoint needs to fill its path placeholders.
+func customSetup(e *Endpoint) func(ctx *loadtestContext) error {
+	switch {
+	case strings.Contains(e.Path, "{external_account_id}"):
+		return func(ctx *loadtestContext) error {
+			if err := setupCustomerID(ctx); err != nil {
+				return err
+			}
+			if err := setupExternalAccount(ctx); err != nil {
+				return err
+			}
+			if ctx.externalAccountID == "" {
+				return errors.New("no existing external account found")
+			}
+			return nil
+		}
+	case strings.Contains(e.Path, "{customer_id}"):
+		return setupCustomerID
+	default:
+		return nil
+	}
+}
+
+// rampPacer paces hits at a rate that grows linearly from zero to Peak over RampUp,
+// then stays at Peak.
+type rampPacer struct {
+	// Peak is the rate in hits per second after the ramp-up.
+	Peak float64
+	// RampUp is the duration of the linear ramp. Zero starts at Peak.
+	RampUp time.Duration
+}
+
+var _ vegeta.Pacer = rampPacer{}
+
+// Pace implements vegeta.Pacer. It waits until the time at which the next hit is due
+// according to the integral of the rate.
+func (p rampPacer) Pace(elapsed time.Duration, hits uint64) (time.Duration, bool) {
+	if p.Peak <= 0 {
+		return 0, true
+	}
+	due := p.hitTime(float64(hits + 1))
+	if due <= elapsed {
+		return 0, false
+	}
+	return due - elapsed, false
+}
+
+// Rate implements vegeta.Pacer.
+func (p rampPacer) Rate(elapsed time.Duration) float64 {
+	if p.RampUp <= 0 || elapsed >= p.RampUp {
+		return p.Peak
+	}
+	return p.Peak * elapsed.Seconds() / p.RampUp.Seconds()
+}
+
+// hitTime returns the elapsed time at which n hits are due.
+func (p rampPacer) hitTime(n float64) time.Duration {
+	ramp := p.RampUp.Seconds()
+	rampHits := p.Peak * ramp / 2
+	var seconds float64
+	if n <= rampHits {
+		seconds = math.Sqrt(2 * ramp * n / p.Peak)
+	} else {
+		seconds = ramp + (n-rampHits)/p.Peak
+	}
+	return time.Duration(seconds * float64(time.Second))
+}
+
+// hits returns the number of hits due by the given elapsed time.
+func (p rampPacer) hits(elapsed time.Duration) int {
+	t := elapsed.Seconds()
+	ramp := p.RampUp.Seconds()
+	if t <= ramp {
+		return int(p.Peak * t * t / (2 * ramp))
+	}
+	return int(p.Peak*ramp/2 + p.Peak*(t-ramp))
+}
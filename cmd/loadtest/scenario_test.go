@@ -0,0 +1,97 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package loadtest
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRampPacer(t *testing.T) {
+	p := rampPacer{Peak: 10, RampUp: 2 * time.Second}
+
+	// 10 hits are due during the 2s ramp, then 10 per second.
+	if got := p.hits(2 * time.Second); got != 10 {
+		t.Errorf("hits(2s) = %d, want 10", got)
+	}
+	if got := p.hits(5 * time.Second); got != 40 {
+		t.Errorf("hits(5s) = %d, want 40", got)
+	}
+	if got := p.Rate(time.Second); got != 5 {
+		t.Errorf("Rate(1s) = %v, want 5", got)
+	}
+	if got := p.Rate(3 * time.Second); got != 10 {
+		t.Errorf("Rate(3s) = %v, want 10", got)
+	}
+
+	// The 10th hit is due at the end of the ramp, the 20th one second later.
+	if wait, stop := p.Pace(0, 9); stop || wait != 2*time.Second {
+		t.Errorf("Pace(0, 9) = %v, %v, want 2s, false", wait, stop)
+	}
+	if wait, _ := p.Pace(2*time.Second, 19); wait != time.Second {
+		t.Errorf("Pace(2s, 19) = %v, want 1s", wait)
+	}
+	if wait, _ := p.Pace(5*time.Second, 19); wait != 0 {
+		t.Errorf("Pace(5s, 19) = %v, want 0 when behind schedule", wait)
+	}
+
+	constant := rampPacer{Peak: 4}
+	if got := constant.hits(time.Second); got != 4 {
+		t.Errorf("hits(1s) without ramp-up = %d, want 4", got)
+	}
+}
+
+func TestScenarioValidate(t *testing.T) {
+	s := &Scenario{
+		Rate:     30,
+		Duration: time.Minute,
+		RampUp:   10 * time.Second,
+		Endpoints: []Endpoint{
+			{Name: "list-transactions", Weight: 2},
+			{Name: "get-customer", Path: "/v1/customers/{customer_id}"},
+		},
+	}
+	if err := s.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	if s.Endpoints[1].Weight != 1 || s.Endpoints[1].Method != "GET" {
+		t.Errorf("custom endpoint defaults = %+v, want weight 1 and method GET", s.Endpoints[1])
+	}
+	if got := s.endpointRate(&s.Endpoints[0]); got != 20 {
+		t.Errorf("endpointRate() = %v, want 20", got)
+	}
+
+	invalid := []struct {
+		name     string
+		scenario Scenario
+	}{
+		{"no rate", Scenario{Duration: time.Second, Endpoints: []Endpoint{{Name: "list-customers"}}}},
+		{"ramp-up too long", Scenario{Rate: 1, Duration: time.Second, RampUp: time.Minute,
+			Endpoints: []Endpoint{{Name: "list-customers"}}}},
+		{"no endpoints", Scenario{Rate: 1, Duration: time.Second}},
+		{"unknown test case", Scenario{Rate: 1, Duration: time.Second, Endpoints: []Endpoint{{Name: "nope"}}}},
+		{"duplicate", Scenario{Rate: 1, Duration: time.Second,
+			Endpoints: []Endpoint{{Name: "list-customers"}, {Name: "list-customers"}}}},
+		{"relative path", Scenario{Rate: 1, Duration: time.Second,
+			Endpoints: []Endpoint{{Name: "x", Path: "v1/customers"}}}},
+	}
+	for _, tt := range invalid {
+		if err := tt.scenario.Validate(); err == nil {
+			t.Errorf("%s: Validate() error = nil, want an error", tt.name)
+		}
+	}
+}
@@ -17,7 +17,7 @@
 package main
 
 import (
-	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"time"
@@ -26,8 +26,12 @@ import (
 	"github.com/urfave/cli/v2"
 
 	"github.com/1Money-Co/1money-go-sdk/cmd/loadtest"
+	"github.com/1Money-Co/1money-go-sdk/cmd/output"
 )
 
+// The api-keys and sweep-rules commands are generated from their service packages.
+//go:generate go run ./tools/svcgen cli -root .. api_keys sweep_rules
+
 const (
 	defaultBaseURL = "http://localhost:9000"
 	defaultTimeout = 30 * time.Second
@@ -41,6 +45,12 @@ var (
 	profile   string
 	timeout   time.Duration
 	pretty    bool
+	format    string
+	query     string
+	dryRun    bool
+
+	// printer renders command output; it is configured from the global flags before any command runs.
+	printer = &output.Printer{Out: os.Stdout}
 )
 
 func main() {
@@ -49,9 +59,22 @@ func main() {
 	_ = godotenv.Load()
 
 	app := &cli.App{
-		Name:    "onemoney-cli",
-		Usage:   "OneMoney API command-line interface",
+		Name:  "onemoney-cli",
+		Usage: "OneMoney API command-line interface",
+		Description: `Manages customers, transactions, withdrawals and conversions on the 1Money platform.
+Credentials come from flags, ONEMONEY_* environment variables, a .env file or ~/.onemoney/credentials.
+Global flags go before the command name. Run "onemoney-cli COMMAND --help" for examples.
+
+Examples:
+  onemoney-cli echo
+  onemoney-cli --profile sandbox customer list
+  onemoney-cli -o table transactions list -c CUSTOMER_ID --status PENDING
+  onemoney-cli --dry-run withdraw create -c CUSTOMER_ID --amount 250 --asset USDC --network ETHEREUM --address 0x...
+  source <(onemoney-cli completion bash)`,
 		Version: ShortVersion(),
+		// EnableBashCompletion makes the CLI answer --generate-bash-completion,
+		// which the scripts printed by the completion command rely on.
+		EnableBashCompletion: true,
 		Authors: []*cli.Author{
 			{
 				Name: "OneMoney",
@@ -82,7 +105,7 @@ func main() {
 			},
 			&cli.StringFlag{
 				Name:        "profile",
-				Usage:       "Profile to use from ~/.onemoney/credentials (default: \"default\")",
+				Usage:       "Profile to use from ~/.onemoney/credentials (default: the profile chosen with 'config use-profile', else \"default\")",
 				Destination: &profile,
 			},
 			&cli.DurationFlag{
@@ -98,43 +121,99 @@ func main() {
 				Usage:       "Pretty print JSON output",
 				Destination: &pretty,
 			},
+			&cli.StringFlag{
+				Name:        "output",
+				Aliases:     []string{"o"},
+				Usage:       "Output format: json, yaml, table or csv (default: the command's default, usually json)",
+				EnvVars:     []string{"ONEMONEY_OUTPUT"},
+				Destination: &format,
+			},
+			&cli.StringFlag{
+				Name:        "query",
+				Aliases:     []string{"q"},
+				Usage:       "JMESPath-style field selection, e.g. 'list[*].{id: transaction_id, status: status}'",
+				Destination: &query,
+			},
+			&cli.BoolFlag{
+				Name:        "dry-run",
+				Usage:       "Print the signed request of mutating commands (redacted signature) instead of sending it",
+				Destination: &dryRun,
+			},
 		},
 		Commands: []*cli.Command{
 			versionCommand(),
 			echoCommand(),
+			customerCommand(),
+			transactionsCommand(),
+			assetsCommand(),
+			instructionsCommand(),
+			externalAccountsCommand(),
+			autoConversionRulesCommand(),
+			withdrawCommand(),
+			convertCommand(),
+			payoutCommand(),
+			sweepRulesCommand(),
+			recipientCommand(),
+			apiKeysCommand(),
+			webhookCommand(),
+			simulateCommand(),
+			doctorCommand(),
+			configCommand(),
 			loadtest.Command(),
+			completionCommand(),
+			shellCommand(),
 		},
-		Before: func(*cli.Context) error {
+		Before: func(c *cli.Context) error {
 			// Credentials validation is now handled by the credential provider chain
 			// No need to validate here as credentials can come from:
 			// 1. Command-line flags
 			// 2. Environment variables
 			// 3. Config file
-			return nil
+			if err := applyConfigDefaults(c); err != nil {
+				return err
+			}
+			return configurePrinter()
 		},
 	}
 
 	if err := app.Run(os.Args); err != nil {
+		if errors.Is(err, errDryRun) {
+			fmt.Fprintln(os.Stderr, "Dry run: request not sent")
+			return
+		}
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		os.Exit(1)
 	}
 }
 
-// printJSON prints the given value as JSON (shared utility function).
-func printJSON(v any) error {
-	var output []byte
-	var err error
-
-	if pretty {
-		output, err = json.MarshalIndent(v, "", "  ")
-	} else {
-		output, err = json.Marshal(v)
+// configurePrinter applies the global output flags to the shared printer.
+func configurePrinter() error {
+	*printer = output.Printer{Out: printer.Out, Pretty: pretty}
+	if format != "" {
+		f, err := output.ParseFormat(format)
+		if err != nil {
+			return err
+		}
+		printer.Format = f
 	}
-
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON: %w", err)
+	if query != "" {
+		q, err := output.ParseQuery(query)
+		if err != nil {
+			return err
+		}
+		printer.Query = q
 	}
-
-	fmt.Println(string(output))
 	return nil
 }
+
+// printOutput prints the given value in the selected output format, JSON by default
+// (shared utility function).
+func printOutput(v any) error {
+	return printer.Print(v, output.View{})
+}
+
+// printView prints the given value like printOutput, using the view's default format
+// and columns when --output or --query are not given.
+func printView(v any, view output.View) error {
+	return printer.Print(v, view)
+}
@@ -0,0 +1,328 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package output renders CLI results as JSON, YAML, tables or CSV, after an optional
+// JMESPath-style query has selected the fields of interest.
+package output
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Format is an output format.
+type Format string
+
+// Supported output formats.
+const (
+	FormatJSON  Format = "json"
+	FormatYAML  Format = "yaml"
+	FormatTable Format = "table"
+	FormatCSV   Format = "csv"
+)
+
+// Formats lists the supported output formats.
+var Formats = []Format{FormatJSON, FormatYAML, FormatTable, FormatCSV}
+
+// ParseFormat parses an output format name, case-insensitively.
+func ParseFormat(name string) (Format, error) {
+	for _, f := range Formats {
+		if strings.EqualFold(name, string(f)) {
+			return f, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported output format %q, try %v", name, Formats)
+}
+
+// View holds a command's defaults, used when the user does not choose a format or query.
+type View struct {
+	// Format is the default output format. Default: FormatJSON.
+	Format Format
+	// Columns is the query applied for table and CSV output, selecting readable
+	// columns from wide responses (optional).
+	Columns string
+}
+
+// Printer writes values in the user's chosen format.
+type Printer struct {
+	// Out is where output is written.
+	Out io.Writer
+	// Format is the output format; empty uses the command's View default.
+	Format Format
+	// Query selects fields before rendering (optional).
+	Query *Query
+	// Pretty indents JSON output.
+	Pretty bool
+}
+
+// Print renders v using the printer's settings, falling back to the view's defaults.
+func (p *Printer) Print(v any, view View) error {
+	format := p.Format
+	if format == "" {
+		format = view.Format
+	}
+	if format == "" {
+		format = FormatJSON
+	}
+
+	value, err := normalize(v)
+	if err != nil {
+		return err
+	}
+
+	query := p.Query
+	if query == nil && view.Columns != "" && (format == FormatTable || format == FormatCSV) {
+		if query, err = ParseQuery(view.Columns); err != nil {
+			return err
+		}
+	}
+	if query != nil {
+		value = query.apply(value)
+	}
+
+	switch format {
+	case FormatJSON:
+		return p.writeJSON(value)
+	case FormatYAML:
+		return p.writeYAML(value)
+	case FormatTable:
+		return p.writeTable(value)
+	case FormatCSV:
+		return p.writeCSV(value)
+	default:
+		return fmt.Errorf("unsupported output format %q", format)
+	}
+}
+
+func (p *Printer) writeJSON(value any) error {
+	var (
+		data []byte
+		err  error
+	)
+	if p.Pretty {
+		data, err = json.MarshalIndent(value, "", "  ")
+	} else {
+		data, err = json.Marshal(value)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+	_, err = fmt.Fprintln(p.Out, string(data))
+	return err
+}
+
+func (p *Printer) writeYAML(value any) error {
+	enc := yaml.NewEncoder(p.Out)
+	enc.SetIndent(2)
+	if err := enc.Encode(yamlNode(value)); err != nil {
+		return fmt.Errorf("failed to marshal YAML: %w", err)
+	}
+	return enc.Close()
+}
+
+// yamlNode converts a normalized value into a YAML node, keeping key order and
+// tagging scalars so that strings such as "001" are not reinterpreted.
+func yamlNode(value any) *yaml.Node {
+	switch v := value.(type) {
+	case *object:
+		node := &yaml.Node{Kind: yaml.MappingNode}
+		for _, key := range v.keys {
+			node.Content = append(node.Content,
+				&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key},
+				yamlNode(v.values[key]),
+			)
+		}
+		return node
+	case []any:
+		node := &yaml.Node{Kind: yaml.SequenceNode}
+		for _, elem := range v {
+			node.Content = append(node.Content, yamlNode(elem))
+		}
+		return node
+	case string:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: v}
+	case json.Number:
+		tag := "!!int"
+		if strings.ContainsAny(v.String(), ".eE") {
+			tag = "!!float"
+		}
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: v.String()}
+	case bool:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: fmt.Sprint(v)}
+	default:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}
+	}
+}
+
+func (p *Printer) writeTable(value any) error {
+	w := tabwriter.NewWriter(p.Out, 0, 0, 2, ' ', 0)
+
+	if obj, ok := value.(*object); ok && listField(obj) == nil {
+		// A single record reads best as one field per line.
+		for _, key := range obj.keys {
+			fmt.Fprintf(w, "%s:\t%s\n", key, tableCell(obj.values[key]))
+		}
+		return w.Flush()
+	}
+
+	columns, rows := tabulate(value)
+	header := make([]string, len(columns))
+	for i, col := range columns {
+		header[i] = strings.ToUpper(strings.ReplaceAll(col, "_", " "))
+	}
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+	for _, row := range rows {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			cells[i] = tableCell(cell)
+		}
+		fmt.Fprintln(w, strings.Join(cells, "\t"))
+	}
+	return w.Flush()
+}
+
+func (p *Printer) writeCSV(value any) error {
+	columns, rows := tabulate(value)
+
+	w := csv.NewWriter(p.Out)
+	if err := w.Write(columns); err != nil {
+		return fmt.Errorf("failed to write csv header: %w", err)
+	}
+	for _, row := range rows {
+		record := make([]string, len(row))
+		for i, cell := range row {
+			record[i] = cellString(cell)
+		}
+		if err := w.Write(record); err != nil {
+			return fmt.Errorf("failed to write csv record: %w", err)
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// valueColumn is the column name used for lists of scalars.
+const valueColumn = "value"
+
+// tabulate flattens a value into columns and rows. Lists become one row per element,
+// and an object wrapping a single list (such as a paged response) is unwrapped.
+// Columns are the union of the elements' keys, in first-seen order.
+func tabulate(value any) ([]string, [][]any) {
+	var records []any
+	switch v := value.(type) {
+	case []any:
+		records = v
+	case *object:
+		if list := listField(v); list != nil {
+			records = list
+		} else {
+			records = []any{v}
+		}
+	default:
+		return []string{valueColumn}, [][]any{{v}}
+	}
+
+	var columns []string
+	seen := make(map[string]bool)
+	for _, record := range records {
+		obj, ok := record.(*object)
+		if !ok {
+			if !seen[valueColumn] {
+				seen[valueColumn] = true
+				columns = append(columns, valueColumn)
+			}
+			continue
+		}
+		for _, key := range obj.keys {
+			if !seen[key] {
+				seen[key] = true
+				columns = append(columns, key)
+			}
+		}
+	}
+
+	rows := make([][]any, len(records))
+	for i, record := range records {
+		rows[i] = make([]any, len(columns))
+		for j, col := range columns {
+			if obj, ok := record.(*object); ok {
+				rows[i][j] = obj.values[col]
+			} else if col == valueColumn {
+				rows[i][j] = record
+			}
+		}
+	}
+	return columns, rows
+}
+
+// listField returns the records of an object that wraps a list of objects, such as a
+// paged {"list": [...], "total": 3} response: its only list field, provided every other
+// field is a scalar. It returns nil for any other object.
+func listField(obj *object) []any {
+	var found []any
+	for _, key := range obj.keys {
+		switch v := obj.values[key].(type) {
+		case []any:
+			if found != nil {
+				return nil
+			}
+			for _, elem := range v {
+				if _, ok := elem.(*object); !ok {
+					return nil
+				}
+			}
+			found = v
+		case *object:
+			return nil
+		}
+	}
+	return found
+}
+
+// tableCell formats a cell for table output, showing "-" for empty values so that
+// columns never collapse.
+func tableCell(value any) string {
+	if s := cellString(value); s != "" {
+		return s
+	}
+	return "-"
+}
+
+// cellString formats a scalar as text and nested values as compact JSON.
+func cellString(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return fmt.Sprint(v)
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(data)
+	}
+}
@@ -0,0 +1,153 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package output
+
+import (
+	"bytes"
+	"testing"
+)
+
+type testTransaction struct {
+	ID     string            `json:"transaction_id"`
+	Amount string            `json:"amount"`
+	Fee    map[string]string `json:"fee"`
+	Tags   []string          `json:"tags,omitempty"`
+}
+
+type testPage struct {
+	List  []testTransaction `json:"list"`
+	Total int               `json:"total"`
+}
+
+var testData = testPage{
+	List: []testTransaction{
+		{ID: "tx-1", Amount: "10.50", Fee: map[string]string{"value": "0.1"}, Tags: []string{"a"}},
+		{ID: "tx-2", Amount: "007"},
+	},
+	Total: 2,
+}
+
+func TestParseQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{"field", "total", `2`},
+		{"index", "list[0].transaction_id", `"tx-1"`},
+		{"negative index", "list[-1].amount", `"007"`},
+		{"out of range", "list[5]", `null`},
+		{"projection drops nulls", "list[*].tags[0]", `["a"]`},
+		{"nested projection", "list[*].fee.value", `["0.1"]`},
+		{"hash keeps order", `list[*].{id: transaction_id, "fee-value": fee.value}`,
+			`[{"id":"tx-1","fee-value":"0.1"},{"id":"tx-2","fee-value":null}]`},
+		{"missing field", "list.amount", `null`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			p := &Printer{Out: &out, Query: mustParse(t, tt.query)}
+			if err := p.Print(testData, View{}); err != nil {
+				t.Fatalf("Print() error = %v", err)
+			}
+			if got := out.String(); got != tt.want+"\n" {
+				t.Errorf("Print() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	for _, bad := range []string{"", "list[", "list[x]", "{id}", "{id: a", "a..b", "list]"} {
+		if _, err := ParseQuery(bad); err == nil {
+			t.Errorf("ParseQuery(%q) error = nil, want error", bad)
+		}
+	}
+}
+
+func TestPrinter_Print(t *testing.T) {
+	view := View{Format: FormatTable, Columns: "list[*].{transaction_id: transaction_id, amount: amount}"}
+	tests := []struct {
+		name   string
+		format Format
+		query  string
+		want   string
+	}{
+		{
+			name: "view default format and columns",
+			want: "TRANSACTION ID  AMOUNT\n" +
+				"tx-1            10.50\n" +
+				"tx-2            007\n",
+		},
+		{
+			name:   "csv uses view columns",
+			format: FormatCSV,
+			want:   "transaction_id,amount\ntx-1,10.50\ntx-2,007\n",
+		},
+		{
+			name:   "table of a single record",
+			format: FormatTable,
+			query:  "list[0]",
+			want: "transaction_id:  tx-1\n" +
+				"amount:          10.50\n" +
+				`fee:             {"value":"0.1"}` + "\n" +
+				`tags:            ["a"]` + "\n",
+		},
+		{
+			name:   "csv of scalars",
+			format: FormatCSV,
+			query:  "list[*].amount",
+			want:   "value\n10.50\n007\n",
+		},
+		{
+			name:   "yaml keeps strings and order",
+			format: FormatYAML,
+			query:  "list[1]",
+			want:   "transaction_id: tx-2\namount: \"007\"\nfee: null\n",
+		},
+		{
+			name:   "json ignores view columns",
+			format: FormatJSON,
+			query:  "total",
+			want:   "2\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			p := &Printer{Out: &out, Format: tt.format}
+			if tt.query != "" {
+				p.Query = mustParse(t, tt.query)
+			}
+			if err := p.Print(testData, view); err != nil {
+				t.Fatalf("Print() error = %v", err)
+			}
+			if got := out.String(); got != tt.want {
+				t.Errorf("Print() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func mustParse(t *testing.T, expr string) *Query {
+	t.Helper()
+	q, err := ParseQuery(expr)
+	if err != nil {
+		t.Fatalf("ParseQuery(%q) error = %v", expr, err)
+	}
+	return q
+}
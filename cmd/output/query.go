@@ -0,0 +1,267 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package output
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Query is a compiled field selection expression. It supports a JMESPath-style subset:
+//
+//	field.nested          select a field; a missing field yields null
+//	list[0], list[-1]     index into a list
+//	list[*].field         project the rest of the expression over every element,
+//	                      dropping null results
+//	{id: field, n: a.b}   build an object from sub-expressions (a multi-select hash)
+//
+// Field names that are not plain identifiers can be double-quoted, e.g. "page-size".
+type Query struct {
+	steps []step
+}
+
+type stepKind int
+
+const (
+	stepField stepKind = iota
+	stepIndex
+	stepProject
+	stepHash
+)
+
+type step struct {
+	kind  stepKind
+	name  string
+	index int
+	keys  []string
+	exprs [][]step
+}
+
+// ParseQuery compiles a query expression.
+func ParseQuery(expr string) (*Query, error) {
+	p := &parser{src: expr}
+	steps, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if !p.done() {
+		return nil, p.errorf("unexpected %q", p.peek())
+	}
+	return &Query{steps: steps}, nil
+}
+
+// apply evaluates the query against a normalized value.
+func (q *Query) apply(v any) any {
+	return eval(q.steps, v)
+}
+
+func eval(steps []step, v any) any {
+	for i, s := range steps {
+		if v == nil {
+			return nil
+		}
+		switch s.kind {
+		case stepField:
+			obj, ok := v.(*object)
+			if !ok {
+				return nil
+			}
+			v = obj.values[s.name]
+		case stepIndex:
+			list, ok := v.([]any)
+			if !ok {
+				return nil
+			}
+			idx := s.index
+			if idx < 0 {
+				idx += len(list)
+			}
+			if idx < 0 || idx >= len(list) {
+				return nil
+			}
+			v = list[idx]
+		case stepProject:
+			list, ok := v.([]any)
+			if !ok {
+				return nil
+			}
+			out := []any{}
+			for _, elem := range list {
+				if r := eval(steps[i+1:], elem); r != nil {
+					out = append(out, r)
+				}
+			}
+			return out
+		case stepHash:
+			obj := newObject()
+			for j, key := range s.keys {
+				obj.set(key, eval(s.exprs[j], v))
+			}
+			v = obj
+		}
+	}
+	return v
+}
+
+type parser struct {
+	src string
+	pos int
+}
+
+func (p *parser) done() bool { return p.pos >= len(p.src) }
+
+func (p *parser) peek() byte {
+	if p.done() {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+func (p *parser) skipSpace() {
+	for !p.done() && (p.peek() == ' ' || p.peek() == '\t') {
+		p.pos++
+	}
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	return fmt.Errorf("invalid query at offset %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
+
+// parseExpr parses a chain of steps up to the end of input, a ',' or a '}'.
+func (p *parser) parseExpr() ([]step, error) {
+	var steps []step
+	for {
+		p.skipSpace()
+		var (
+			s   step
+			err error
+		)
+		switch c := p.peek(); {
+		case c == '{':
+			s, err = p.parseHash()
+		case c == '[':
+			s, err = p.parseBracket()
+		case c == '"' || isIdentStart(c):
+			var name string
+			name, err = p.parseName()
+			s = step{kind: stepField, name: name}
+		case c == 0:
+			return nil, p.errorf("unexpected end of query")
+		default:
+			return nil, p.errorf("unexpected %q", c)
+		}
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, s)
+
+		p.skipSpace()
+		switch p.peek() {
+		case '.':
+			p.pos++
+		case '[':
+		default:
+			return steps, nil
+		}
+	}
+}
+
+func (p *parser) parseBracket() (step, error) {
+	p.pos++ // '['
+	p.skipSpace()
+	if p.peek() == '*' {
+		p.pos++
+		p.skipSpace()
+		if p.peek() != ']' {
+			return step{}, p.errorf("expected ']'")
+		}
+		p.pos++
+		return step{kind: stepProject}, nil
+	}
+
+	end := strings.IndexByte(p.src[p.pos:], ']')
+	if end < 0 {
+		return step{}, p.errorf("expected ']'")
+	}
+	idx, err := strconv.Atoi(strings.TrimSpace(p.src[p.pos : p.pos+end]))
+	if err != nil {
+		return step{}, p.errorf("invalid index %q", p.src[p.pos:p.pos+end])
+	}
+	p.pos += end + 1
+	return step{kind: stepIndex, index: idx}, nil
+}
+
+func (p *parser) parseHash() (step, error) {
+	p.pos++ // '{'
+	s := step{kind: stepHash}
+	for {
+		p.skipSpace()
+		key, err := p.parseName()
+		if err != nil {
+			return step{}, err
+		}
+		p.skipSpace()
+		if p.peek() != ':' {
+			return step{}, p.errorf("expected ':' after %q", key)
+		}
+		p.pos++
+		expr, err := p.parseExpr()
+		if err != nil {
+			return step{}, err
+		}
+		s.keys = append(s.keys, key)
+		s.exprs = append(s.exprs, expr)
+
+		p.skipSpace()
+		switch p.peek() {
+		case ',':
+			p.pos++
+		case '}':
+			p.pos++
+			return s, nil
+		default:
+			return step{}, p.errorf("expected ',' or '}'")
+		}
+	}
+}
+
+func (p *parser) parseName() (string, error) {
+	if p.peek() == '"' {
+		end := strings.IndexByte(p.src[p.pos+1:], '"')
+		if end < 0 {
+			return "", p.errorf("unterminated quoted name")
+		}
+		name := p.src[p.pos+1 : p.pos+1+end]
+		p.pos += end + 2
+		return name, nil
+	}
+
+	start := p.pos
+	if !isIdentStart(p.peek()) {
+		return "", p.errorf("expected a field name")
+	}
+	for !p.done() && (isIdentStart(p.peek()) || (p.peek() >= '0' && p.peek() <= '9')) {
+		p.pos++
+	}
+	return p.src[start:p.pos], nil
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
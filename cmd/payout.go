@@ -0,0 +1,371 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/google/uuid"
+	"github.com/urfave/cli/v2"
+
+	"github.com/1Money-Co/1money-go-sdk/cmd/output"
+	"github.com/1Money-Co/1money-go-sdk/pkg/onemoney"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/payouts"
+)
+
+// payoutItemsPageSize is the page size used when collecting the items of a finished batch.
+const payoutItemsPageSize = 100
+
+// payoutColumns are the CSV columns accepted by "payout run", named after the payouts.Item fields.
+var payoutColumns = []string{
+	"reference", "amount", "asset", "network", "wallet_address", "external_account_id",
+	"recipient_id", "recipient_bank_account_id", "recipient_wallet_address_id", "memo",
+}
+
+// payoutCommand returns the payout command with all its subcommands.
+func payoutCommand() *cli.Command {
+	defaults := payouts.DefaultWaitOptions()
+	return &cli.Command{
+		Name:  "payout",
+		Usage: "Pay many recipients in one batch",
+		Description: `Examples:
+  onemoney-cli payout run -c CUSTOMER_ID --file payouts.csv --dry-run`,
+		Subcommands: []*cli.Command{
+			{
+				Name:  "run",
+				Usage: "Submit a payout batch from a CSV file and report the result of each row",
+				Description: `The CSV file needs a header row. Columns:
+  ` + strings.Join(payoutColumns, ", ") + `
+reference, amount, asset and network are required; each row needs exactly one destination.
+
+Every row is validated and the batch is checked against the available balance, including fees.
+With --dry-run nothing is submitted. Otherwise the batch is submitted after confirmation and
+polled until it finishes. The command exits non-zero if any row is invalid or fails.
+
+Examples:
+  onemoney-cli payout run -c CUSTOMER_ID --file payouts.csv --dry-run
+  onemoney-cli payout run -c CUSTOMER_ID --file payouts.csv --description "January payroll" --yes
+  onemoney-cli -o csv payout run -c CUSTOMER_ID --file payouts.csv > results.csv`,
+				Flags: []cli.Flag{
+					customerFlag(),
+					&cli.StringFlag{
+						Name:     "file",
+						Aliases:  []string{"f"},
+						Usage:    "CSV file of payouts, or - for stdin",
+						Required: true,
+					},
+					&cli.BoolFlag{Name: "dry-run", Usage: "Validate rows and check funding without submitting"},
+					&cli.StringFlag{Name: "description", Usage: "Label for the batch"},
+					&cli.StringFlag{Name: "idempotency-key", Usage: "Idempotency key (default: a random UUID)"},
+					&cli.DurationFlag{
+						Name:  "poll-interval",
+						Usage: "Interval between batch status polls",
+						Value: defaults.PollInterval,
+					},
+					&cli.DurationFlag{
+						Name:  "max-wait",
+						Usage: "Maximum time to wait for the batch to finish",
+						Value: defaults.MaxWaitTime,
+					},
+					yesFlag(),
+				},
+				Action: payoutRun,
+			},
+		},
+	}
+}
+
+// payoutRow is a row of a payout file and its result.
+type payoutRow struct {
+	// Row is the line number in the CSV file.
+	Row           int    `json:"row"`
+	Reference     string `json:"reference"`
+	Amount        string `json:"amount"`
+	Asset         string `json:"asset"`
+	Network       string `json:"network"`
+	Status        string `json:"status"`
+	TransactionID string `json:"transaction_id"`
+	Error         string `json:"error"`
+
+	item payouts.Item
+}
+
+// Row statuses that are not payouts.ItemStatus values.
+const (
+	payoutRowValid   = "VALID"
+	payoutRowInvalid = "INVALID"
+	payoutRowMissing = "MISSING"
+)
+
+func payoutRun(c *cli.Context) error {
+	rows, err := readPayoutFile(c.String("file"))
+	if err != nil {
+		return err
+	}
+	if invalid := validatePayoutRows(rows); invalid > 0 {
+		return payoutReport(rows, fmt.Errorf("%d of %d rows failed validation, nothing was submitted", invalid, len(rows)))
+	}
+
+	req := &payouts.CreateBatchRequest{
+		IdempotencyKey: c.String("idempotency-key"),
+		Description:    c.String("description"),
+	}
+	if req.IdempotencyKey == "" {
+		req.IdempotencyKey = uuid.NewString()
+	}
+	for _, row := range rows {
+		req.Items = append(req.Items, row.item)
+	}
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	ctx := context.Background()
+	customerID := customerIDFlag(c)
+
+	// The funding check is a POST, so the global --dry-run skips it and prints the batch request instead.
+	if !dryRun {
+		funding, err := client.Payouts.CheckFunding(ctx, customerID, req)
+		if err != nil {
+			return fmt.Errorf("failed to check funding: %w", err)
+		}
+		if err := printFunding(len(rows), req.IdempotencyKey, funding); err != nil {
+			return err
+		}
+		if !funding.Sufficient {
+			return payoutReport(rows, errors.New("insufficient balance for the batch, nothing was submitted"))
+		}
+		if c.Bool("dry-run") {
+			return payoutReport(rows, nil)
+		}
+	}
+
+	ok, err := confirm(c, fmt.Sprintf("Submit %d payouts?", len(rows)))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.New("payout cancelled")
+	}
+
+	batch, err := client.Payouts.CreateBatch(ctx, customerID, req)
+	if err != nil {
+		return fmt.Errorf("failed to create payout batch: %w", err)
+	}
+	fmt.Fprintf(os.Stderr, "Submitted batch %s, waiting for it to finish...\n", batch.BatchID)
+
+	opts := &payouts.WaitOptions{
+		PollInterval: c.Duration("poll-interval"),
+		MaxWaitTime:  c.Duration("max-wait"),
+	}
+	if _, err := payouts.WaitForCompleted(ctx, client.Payouts, customerID, batch.BatchID, opts); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+
+	failed, err := collectPayoutResults(ctx, client, customerID, batch.BatchID, rows)
+	if err != nil {
+		return err
+	}
+	if failed > 0 {
+		return payoutReport(rows, fmt.Errorf("%d of %d payouts in batch %s did not complete", failed, len(rows), batch.BatchID))
+	}
+	return payoutReport(rows, nil)
+}
+
+// readPayoutFile reads the rows of a payout CSV file. "-" reads from stdin.
+func readPayoutFile(path string) ([]*payoutRow, error) {
+	var in io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		defer f.Close()
+		in = f
+	}
+
+	r := csv.NewReader(in)
+	r.TrimLeadingSpace = true
+	header, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the header of %s: %w", path, err)
+	}
+	for i, name := range header {
+		header[i] = strings.ToLower(strings.TrimSpace(name))
+		if !slices.Contains(payoutColumns, header[i]) {
+			return nil, fmt.Errorf("%s: unknown column %q, expected %s", path, name, strings.Join(payoutColumns, ", "))
+		}
+	}
+
+	var rows []*payoutRow
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+		line, _ := r.FieldPos(0)
+
+		fields := make(map[string]string, len(header))
+		for i, name := range header {
+			fields[name] = strings.TrimSpace(record[i])
+		}
+		rows = append(rows, &payoutRow{
+			Row:       line,
+			Reference: fields["reference"],
+			Amount:    fields["amount"],
+			Asset:     fields["asset"],
+			Network:   fields["network"],
+			item: payouts.Item{
+				Reference:                fields["reference"],
+				Amount:                   fields["amount"],
+				WalletAddress:            fields["wallet_address"],
+				ExternalAccountID:        fields["external_account_id"],
+				RecipientID:              fields["recipient_id"],
+				RecipientBankAccountID:   fields["recipient_bank_account_id"],
+				RecipientWalletAddressID: fields["recipient_wallet_address_id"],
+				Memo:                     fields["memo"],
+			},
+		})
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("%s has no payout rows", path)
+	}
+	if len(rows) > payouts.MaxBatchItems {
+		return nil, fmt.Errorf("%s has %d rows, the maximum is %d", path, len(rows), payouts.MaxBatchItems)
+	}
+	return rows, nil
+}
+
+// validatePayoutRows validates every row, recording the result in its status, and returns
+// the number of invalid rows.
+func validatePayoutRows(rows []*payoutRow) int {
+	invalid := 0
+	seen := make(map[string]int, len(rows))
+	for _, row := range rows {
+		err := validatePayoutRow(row)
+		if err == nil && row.Reference != "" {
+			if prev, ok := seen[row.Reference]; ok {
+				err = fmt.Errorf("reference %q duplicates row %d", row.Reference, prev)
+			} else {
+				seen[row.Reference] = row.Row
+			}
+		}
+
+		row.Status = payoutRowValid
+		if err != nil {
+			row.Status, row.Error = payoutRowInvalid, err.Error()
+			invalid++
+		}
+	}
+	return invalid
+}
+
+func validatePayoutRow(row *payoutRow) error {
+	if row.Reference == "" {
+		return errors.New("reference is required")
+	}
+	var err error
+	if row.item.Asset, err = assets.ParseAssetName(row.Asset); err != nil {
+		return err
+	}
+	if row.item.Network, err = assets.ParseNetworkName(row.Network); err != nil {
+		return err
+	}
+	return row.item.Validate()
+}
+
+// printFunding prints the funding check of a batch to stderr.
+func printFunding(items int, idempotencyKey string, funding *payouts.FundingCheckResponse) error {
+	w := tabwriter.NewWriter(os.Stderr, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Payouts:\t%d\n", items)
+	fmt.Fprintf(w, "Idempotency key:\t%s\n", idempotencyKey)
+	fmt.Fprintln(w, "\nASSET\tNETWORK\tREQUIRED\tAVAILABLE\tSHORTFALL")
+	for _, req := range funding.Requirements {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", req.Asset, req.Network, req.Required, req.Available, req.Shortfall)
+	}
+	fmt.Fprintln(w)
+	return w.Flush()
+}
+
+// collectPayoutResults copies the result of each item of a finished batch to its row, matching
+// them by reference, and returns the number of rows that did not complete.
+func collectPayoutResults(
+	ctx context.Context,
+	client *onemoney.Client,
+	customerID, batchID string,
+	rows []*payoutRow,
+) (int, error) {
+	byReference := make(map[string]*payoutRow, len(rows))
+	for _, row := range rows {
+		row.Status = payoutRowMissing
+		byReference[row.Reference] = row
+	}
+
+	for page := 1; ; page++ {
+		resp, err := client.Payouts.ListItems(ctx, customerID, batchID, &payouts.ListItemsRequest{
+			Page: page,
+			Size: payoutItemsPageSize,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to list payout items: %w", err)
+		}
+		for _, item := range resp.List {
+			if row, ok := byReference[item.Reference]; ok {
+				row.Status = item.Status.String()
+				row.TransactionID = item.TransactionID
+				row.Error = item.FailureReason
+			}
+		}
+		if len(resp.List) < payoutItemsPageSize {
+			break
+		}
+	}
+
+	failed := 0
+	for _, row := range rows {
+		if row.Status != payouts.ItemStatusCOMPLETED.String() {
+			failed++
+		}
+	}
+	return failed, nil
+}
+
+// payoutReport prints the per-row report and returns err, so that the report is printed
+// even when the command fails.
+func payoutReport(rows []*payoutRow, err error) error {
+	if printErr := printView(rows, output.View{Format: output.FormatTable}); printErr != nil {
+		return printErr
+	}
+	return err
+}
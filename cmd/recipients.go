@@ -0,0 +1,535 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/1Money-Co/1money-go-sdk/cmd/output"
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/external_accounts"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/recipients"
+)
+
+// Columns selecting the fields shown when recipients and their destinations are printed as a table or CSV.
+const (
+	recipientColumns = `{recipient_id: recipient_id, type: type, name: name, nickname: nickname, ` +
+		`email: email, country_code: country_code, created_at: created_at}`
+	recipientBankAccountColumns = `{recipient_bank_account_id: recipient_bank_account_id, network: network, ` +
+		`currency: currency, institution_name: institution_name, account_number: account_number, created_at: created_at}`
+	recipientWalletColumns = `{recipient_wallet_address_id: recipient_wallet_address_id, network: network, ` +
+		`address: address, nickname: nickname, created_at: created_at}`
+)
+
+// recipientCommand returns the recipient command with its bank-account and wallet subcommand groups.
+func recipientCommand() *cli.Command {
+	return &cli.Command{
+		Name:    "recipient",
+		Aliases: []string{"recipients"},
+		Usage:   "Manage saved payout recipients and their bank accounts and wallets",
+		Description: `Examples:
+  onemoney-cli recipient create -c CUSTOMER_ID --type BUSINESS --name "Acme Supplies Ltd"
+  onemoney-cli -o table recipient list -c CUSTOMER_ID
+  onemoney-cli recipient bank-account add -c CUSTOMER_ID --recipient-id RECIPIENT_ID --from-file account.json
+  onemoney-cli recipient wallet add -c CUSTOMER_ID --recipient-id RECIPIENT_ID --network ETHEREUM --address 0x...`,
+		Subcommands: []*cli.Command{
+			{
+				Name:  "create",
+				Usage: "Create a recipient",
+				Description: `Examples:
+  onemoney-cli recipient create -c CUSTOMER_ID --from-file recipient.json
+  onemoney-cli recipient create -c CUSTOMER_ID --type INDIVIDUAL --name "Jane Doe" --email jane@example.com`,
+				Flags: []cli.Flag{
+					customerFlag(),
+					fromFileFlag("CreateRecipientRequest"),
+					&cli.StringFlag{Name: "idempotency-key", Usage: "A unique key to ensure idempotent creation"},
+					&cli.StringFlag{Name: "type", Usage: "Recipient type: INDIVIDUAL or BUSINESS"},
+					&cli.StringFlag{Name: "name", Usage: "Legal name of the recipient"},
+					&cli.StringFlag{Name: "nickname", Usage: "Display name of the recipient"},
+					&cli.StringFlag{Name: "email", Usage: "Contact email address of the recipient"},
+					&cli.StringFlag{Name: "country", Usage: "ISO 3166-1 alpha-3 country code, e.g. GBR"},
+				},
+				Action: recipientCreate,
+			},
+			{
+				Name:  "get",
+				Usage: "Get a recipient",
+				Description: `Examples:
+  onemoney-cli recipient get -c CUSTOMER_ID --recipient-id RECIPIENT_ID`,
+				Flags:  []cli.Flag{customerFlag(), recipientIDFlag()},
+				Action: recipientGet,
+			},
+			{
+				Name:  "list",
+				Usage: "List recipients",
+				Description: `Examples:
+  onemoney-cli -o table recipient list -c CUSTOMER_ID --type BUSINESS`,
+				Flags: []cli.Flag{
+					customerFlag(),
+					fromFileFlag("ListRecipientsRequest"),
+					&cli.StringFlag{Name: "type", Usage: "Filter by type: INDIVIDUAL or BUSINESS"},
+					&cli.IntFlag{Name: "page", Usage: "The page number (starts from 1)"},
+					&cli.IntFlag{Name: "size", Usage: "The number of items per page (1-100)"},
+				},
+				Action: recipientList,
+			},
+			{
+				Name:  "update",
+				Usage: "Update a recipient; only the given fields are changed",
+				Description: `Examples:
+  onemoney-cli recipient update -c CUSTOMER_ID --recipient-id RECIPIENT_ID --email payables@acme.example
+  onemoney-cli recipient update -c CUSTOMER_ID --recipient-id RECIPIENT_ID --from-file changes.json`,
+				Flags: []cli.Flag{
+					customerFlag(),
+					recipientIDFlag(),
+					fromFileFlag("UpdateRecipientRequest"),
+					&cli.StringFlag{Name: "name", Usage: "Legal name of the recipient"},
+					&cli.StringFlag{Name: "nickname", Usage: "Display name of the recipient"},
+					&cli.StringFlag{Name: "email", Usage: "Contact email address of the recipient"},
+					&cli.StringFlag{Name: "country", Usage: "ISO 3166-1 alpha-3 country code, e.g. GBR"},
+				},
+				Action: recipientUpdate,
+			},
+			{
+				Name:  "delete",
+				Usage: "Delete a recipient with its bank accounts and wallets",
+				Description: `Examples:
+  onemoney-cli recipient delete -c CUSTOMER_ID --recipient-id RECIPIENT_ID`,
+				Flags:  []cli.Flag{customerFlag(), recipientIDFlag()},
+				Action: recipientDelete,
+			},
+			recipientBankAccountCommand(),
+			recipientWalletCommand(),
+		},
+	}
+}
+
+// recipientBankAccountCommand returns the recipient bank-account command group.
+func recipientBankAccountCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "bank-account",
+		Usage: "Manage the bank accounts a recipient is paid at",
+		Subcommands: []*cli.Command{
+			{
+				Name:  "add",
+				Usage: "Add a bank account to a recipient",
+				Description: `Examples:
+  onemoney-cli recipient bank-account add -c CUSTOMER_ID --recipient-id RECIPIENT_ID --from-file account.json
+  onemoney-cli recipient bank-account add -c CUSTOMER_ID --recipient-id RECIPIENT_ID \
+    --network US_ACH --currency USD --country USA --account-number 123456789 --institution-id 021000021`,
+				Flags: []cli.Flag{
+					customerFlag(),
+					recipientIDFlag(),
+					fromFileFlag("AddBankAccountRequest"),
+					&cli.StringFlag{Name: "idempotency-key", Usage: "A unique key to ensure idempotent creation"},
+					&cli.StringFlag{Name: "network", Usage: "Bank network: US_ACH, SWIFT, US_FEDWIRE or SEPA"},
+					&cli.StringFlag{Name: "currency", Usage: "Account currency: USD or EUR"},
+					&cli.StringFlag{Name: "country", Usage: "ISO 3166-1 alpha-3 country code of the bank"},
+					&cli.StringFlag{Name: "account-number", Usage: "Account number, or the IBAN for SEPA"},
+					&cli.StringFlag{Name: "institution-id", Usage: "ABA routing number, or the BIC for SWIFT and SEPA"},
+					&cli.StringFlag{Name: "institution-name", Usage: "Name of the bank"},
+					&cli.StringFlag{Name: "nickname", Usage: "Display name of the account"},
+				},
+				Action: recipientBankAccountAdd,
+			},
+			{
+				Name:  "list",
+				Usage: "List the bank accounts of a recipient",
+				Description: `Examples:
+  onemoney-cli -o table recipient bank-account list -c CUSTOMER_ID --recipient-id RECIPIENT_ID`,
+				Flags:  []cli.Flag{customerFlag(), recipientIDFlag()},
+				Action: recipientBankAccountList,
+			},
+			{
+				Name:  "delete",
+				Usage: "Remove a bank account from a recipient",
+				Description: `Examples:
+  onemoney-cli recipient bank-account delete -c CUSTOMER_ID --recipient-id RECIPIENT_ID --bank-account-id ID`,
+				Flags: []cli.Flag{
+					customerFlag(),
+					recipientIDFlag(),
+					&cli.StringFlag{Name: "bank-account-id", Usage: "Recipient bank account ID", Required: true},
+				},
+				Action: recipientBankAccountDelete,
+			},
+		},
+	}
+}
+
+// recipientWalletCommand returns the recipient wallet command group.
+func recipientWalletCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "wallet",
+		Usage: "Manage the wallet addresses a recipient is paid at",
+		Subcommands: []*cli.Command{
+			{
+				Name:  "add",
+				Usage: "Add a wallet address to a recipient",
+				Description: `Examples:
+  onemoney-cli recipient wallet add -c CUSTOMER_ID --recipient-id RECIPIENT_ID --network ETHEREUM --address 0x...
+  onemoney-cli recipient wallet add -c CUSTOMER_ID --recipient-id RECIPIENT_ID --from-file wallet.yaml`,
+				Flags: []cli.Flag{
+					customerFlag(),
+					recipientIDFlag(),
+					fromFileFlag("AddWalletAddressRequest"),
+					&cli.StringFlag{Name: "idempotency-key", Usage: "A unique key to ensure idempotent creation"},
+					&cli.StringFlag{Name: "network", Usage: "Blockchain network, e.g. ETHEREUM"},
+					&cli.StringFlag{Name: "address", Usage: "Wallet address"},
+					&cli.StringFlag{Name: "nickname", Usage: "Display name of the address"},
+				},
+				Action: recipientWalletAdd,
+			},
+			{
+				Name:  "list",
+				Usage: "List the wallet addresses of a recipient",
+				Description: `Examples:
+  onemoney-cli -o table recipient wallet list -c CUSTOMER_ID --recipient-id RECIPIENT_ID`,
+				Flags:  []cli.Flag{customerFlag(), recipientIDFlag()},
+				Action: recipientWalletList,
+			},
+			{
+				Name:  "delete",
+				Usage: "Remove a wallet address from a recipient",
+				Description: `Examples:
+  onemoney-cli recipient wallet delete -c CUSTOMER_ID --recipient-id RECIPIENT_ID --wallet-address-id ID`,
+				Flags: []cli.Flag{
+					customerFlag(),
+					recipientIDFlag(),
+					&cli.StringFlag{Name: "wallet-address-id", Usage: "Recipient wallet address ID", Required: true},
+				},
+				Action: recipientWalletDelete,
+			},
+		},
+	}
+}
+
+// fromFileFlag returns the --from-file flag reading the named request type; flags given
+// alongside it override the fields of the file.
+func fromFileFlag(requestType string) *cli.StringFlag {
+	return &cli.StringFlag{
+		Name:    "from-file",
+		Aliases: []string{"file", "f"},
+		Usage:   fmt.Sprintf("Path to a %s in JSON or YAML (\"-\" for stdin); flags override its fields", requestType),
+	}
+}
+
+// recipientIDFlag returns the required --recipient-id flag.
+func recipientIDFlag() *cli.StringFlag {
+	return &cli.StringFlag{Name: "recipient-id", Usage: "Recipient ID", Required: true}
+}
+
+// recipientIDValue returns the value of the --recipient-id flag.
+func recipientIDValue(c *cli.Context) svc.RecipientID {
+	return svc.RecipientID(c.String("recipient-id"))
+}
+
+// readFromFile decodes the --from-file flag into req, if it was given.
+func readFromFile(c *cli.Context, req any) error {
+	if !c.IsSet("from-file") {
+		return nil
+	}
+	return readRequestFile(c.String("from-file"), req)
+}
+
+func recipientCreate(c *cli.Context) error {
+	req := &recipients.CreateRecipientRequest{}
+	if err := readFromFile(c, req); err != nil {
+		return err
+	}
+	if c.IsSet("idempotency-key") {
+		req.IdempotencyKey = c.String("idempotency-key")
+	}
+	if c.IsSet("type") {
+		v, err := recipients.ParseRecipientType(c.String("type"))
+		if err != nil {
+			return err
+		}
+		req.Type = v
+	}
+	if c.IsSet("name") {
+		req.Name = c.String("name")
+	}
+	if c.IsSet("nickname") {
+		req.Nickname = c.String("nickname")
+	}
+	if c.IsSet("email") {
+		req.Email = c.String("email")
+	}
+	if c.IsSet("country") {
+		v, err := external_accounts.ParseCountryCode(c.String("country"))
+		if err != nil {
+			return err
+		}
+		req.CountryCode = v
+	}
+
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	result, err := client.Recipients.CreateRecipient(context.Background(), customerIDFlag(c), req)
+	if err != nil {
+		return fmt.Errorf("failed to create recipient: %w", err)
+	}
+	return printView(result, output.View{Columns: recipientColumns})
+}
+
+func recipientGet(c *cli.Context) error {
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	result, err := client.Recipients.GetRecipient(context.Background(), customerIDFlag(c), recipientIDValue(c))
+	if err != nil {
+		return fmt.Errorf("failed to get recipient: %w", err)
+	}
+	return printView(result, output.View{Columns: recipientColumns})
+}
+
+func recipientList(c *cli.Context) error {
+	req := &recipients.ListRecipientsRequest{}
+	if err := readFromFile(c, req); err != nil {
+		return err
+	}
+	if c.IsSet("type") {
+		v, err := recipients.ParseRecipientType(c.String("type"))
+		if err != nil {
+			return err
+		}
+		req.Type = v
+	}
+	if c.IsSet("page") {
+		req.Page = c.Int("page")
+	}
+	if c.IsSet("size") {
+		req.Size = c.Int("size")
+	}
+
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	result, err := client.Recipients.ListRecipients(context.Background(), customerIDFlag(c), req)
+	if err != nil {
+		return fmt.Errorf("failed to list recipients: %w", err)
+	}
+	return printView(result, output.View{Columns: "list[*]." + recipientColumns})
+}
+
+func recipientUpdate(c *cli.Context) error {
+	req := &recipients.UpdateRecipientRequest{}
+	if err := readFromFile(c, req); err != nil {
+		return err
+	}
+	if c.IsSet("name") {
+		v := c.String("name")
+		req.Name = &v
+	}
+	if c.IsSet("nickname") {
+		v := c.String("nickname")
+		req.Nickname = &v
+	}
+	if c.IsSet("email") {
+		v := c.String("email")
+		req.Email = &v
+	}
+	if c.IsSet("country") {
+		v, err := external_accounts.ParseCountryCode(c.String("country"))
+		if err != nil {
+			return err
+		}
+		req.CountryCode = &v
+	}
+
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	result, err := client.Recipients.UpdateRecipient(context.Background(), customerIDFlag(c), recipientIDValue(c), req)
+	if err != nil {
+		return fmt.Errorf("failed to update recipient: %w", err)
+	}
+	return printView(result, output.View{Columns: recipientColumns})
+}
+
+func recipientDelete(c *cli.Context) error {
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	if err := client.Recipients.DeleteRecipient(context.Background(), customerIDFlag(c), recipientIDValue(c)); err != nil {
+		return fmt.Errorf("failed to delete recipient: %w", err)
+	}
+	fmt.Fprintln(os.Stderr, "Done")
+	return nil
+}
+
+func recipientBankAccountAdd(c *cli.Context) error {
+	req := &recipients.AddBankAccountRequest{}
+	if err := readFromFile(c, req); err != nil {
+		return err
+	}
+	if c.IsSet("idempotency-key") {
+		req.IdempotencyKey = c.String("idempotency-key")
+	}
+	if c.IsSet("network") {
+		v, err := external_accounts.ParseBankNetworkName(c.String("network"))
+		if err != nil {
+			return err
+		}
+		req.Network = v
+	}
+	if c.IsSet("currency") {
+		v, err := external_accounts.ParseCurrency(c.String("currency"))
+		if err != nil {
+			return err
+		}
+		req.Currency = v
+	}
+	if c.IsSet("country") {
+		v, err := external_accounts.ParseCountryCode(c.String("country"))
+		if err != nil {
+			return err
+		}
+		req.CountryCode = v
+	}
+	if c.IsSet("account-number") {
+		req.AccountNumber = c.String("account-number")
+	}
+	if c.IsSet("institution-id") {
+		req.InstitutionID = c.String("institution-id")
+	}
+	if c.IsSet("institution-name") {
+		req.InstitutionName = c.String("institution-name")
+	}
+	if c.IsSet("nickname") {
+		req.Nickname = c.String("nickname")
+	}
+
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	result, err := client.Recipients.AddBankAccount(context.Background(), customerIDFlag(c), recipientIDValue(c), req)
+	if err != nil {
+		return fmt.Errorf("failed to add bank account: %w", err)
+	}
+	return printView(result, output.View{Columns: recipientBankAccountColumns})
+}
+
+func recipientBankAccountList(c *cli.Context) error {
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	result, err := client.Recipients.ListBankAccounts(context.Background(), customerIDFlag(c), recipientIDValue(c))
+	if err != nil {
+		return fmt.Errorf("failed to list bank accounts: %w", err)
+	}
+	return printView(result, output.View{Columns: "list[*]." + recipientBankAccountColumns})
+}
+
+func recipientBankAccountDelete(c *cli.Context) error {
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	err = client.Recipients.DeleteBankAccount(
+		context.Background(), customerIDFlag(c), recipientIDValue(c), c.String("bank-account-id"),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete bank account: %w", err)
+	}
+	fmt.Fprintln(os.Stderr, "Done")
+	return nil
+}
+
+func recipientWalletAdd(c *cli.Context) error {
+	req := &recipients.AddWalletAddressRequest{}
+	if err := readFromFile(c, req); err != nil {
+		return err
+	}
+	if c.IsSet("idempotency-key") {
+		req.IdempotencyKey = c.String("idempotency-key")
+	}
+	if c.IsSet("network") {
+		v, err := assets.ParseNetworkName(c.String("network"))
+		if err != nil {
+			return err
+		}
+		req.Network = v
+	}
+	if c.IsSet("address") {
+		req.Address = c.String("address")
+	}
+	if c.IsSet("nickname") {
+		req.Nickname = c.String("nickname")
+	}
+
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	result, err := client.Recipients.AddWalletAddress(context.Background(), customerIDFlag(c), recipientIDValue(c), req)
+	if err != nil {
+		return fmt.Errorf("failed to add wallet address: %w", err)
+	}
+	return printView(result, output.View{Columns: recipientWalletColumns})
+}
+
+func recipientWalletList(c *cli.Context) error {
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	result, err := client.Recipients.ListWalletAddresses(context.Background(), customerIDFlag(c), recipientIDValue(c))
+	if err != nil {
+		return fmt.Errorf("failed to list wallet addresses: %w", err)
+	}
+	return printView(result, output.View{Columns: "list[*]." + recipientWalletColumns})
+}
+
+func recipientWalletDelete(c *cli.Context) error {
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	err = client.Recipients.DeleteWalletAddress(
+		context.Background(), customerIDFlag(c), recipientIDValue(c), c.String("wallet-address-id"),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete wallet address: %w", err)
+	}
+	fmt.Fprintln(os.Stderr, "Done")
+	return nil
+}
@@ -0,0 +1,273 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/peterh/liner"
+	"github.com/urfave/cli/v2"
+
+	"github.com/1Money-Co/1money-go-sdk/internal/credentials"
+)
+
+// customerIDEnv is the environment variable holding the default customer ID.
+// The shell's "use customer" sets it for the rest of the session.
+const customerIDEnv = "ONEMONEY_CUSTOMER_ID"
+
+// shellHistoryFile is the shell history file name inside the config directory.
+const shellHistoryFile = "shell_history"
+
+// shellCommand returns the shell command, which runs CLI commands interactively.
+func shellCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "shell",
+		Usage: "Start an interactive session",
+		Description: `Runs CLI commands interactively with history, tab completion and pretty output.
+Global flags given before "shell" apply to every command in the session.
+
+Shell commands:
+  use customer <id>   Select a customer for customer-scoped commands
+  use customer        Clear the selected customer
+  exit, quit          Leave the shell (or press Ctrl-D)
+
+Examples:
+  onemoney-cli --profile sandbox shell
+  onemoney> use customer CUSTOMER_ID
+  onemoney[CUSTOMER_ID]> transactions list --status PENDING
+  onemoney[CUSTOMER_ID]> customer kyb-status`,
+		Action: runShell,
+	}
+}
+
+func runShell(c *cli.Context) error {
+	if c.NArg() > 0 {
+		return fmt.Errorf("unexpected arguments: %s", strings.Join(c.Args().Slice(), " "))
+	}
+	// Re-running the app resets global flags to their defaults, so pass the flags
+	// the shell was started with to every command.
+	globalArgs := append([]string{"--pretty"}, os.Args[1:len(os.Args)-1]...)
+
+	line := liner.NewLiner()
+	defer line.Close()
+	line.SetCtrlCAborts(true)
+	line.SetCompleter(shellCompleter(c.App))
+
+	historyPath := shellHistoryPath()
+	if f, err := os.Open(historyPath); err == nil {
+		_, _ = line.ReadHistory(f)
+		_ = f.Close()
+	}
+	defer saveShellHistory(line, historyPath)
+
+	fmt.Println(`Type "help" for commands, "use customer <id>" to select a customer, "exit" to quit.`)
+	for {
+		input, err := line.Prompt(shellPrompt())
+		if errors.Is(err, liner.ErrPromptAborted) {
+			continue
+		}
+		if errors.Is(err, io.EOF) {
+			fmt.Println()
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read input: %w", err)
+		}
+
+		args, err := splitWords(input)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			continue
+		}
+		if len(args) == 0 {
+			continue
+		}
+		line.AppendHistory(input)
+
+		switch args[0] {
+		case "exit", "quit":
+			return nil
+		case "use":
+			if err := shellUse(args[1:]); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			}
+			continue
+		case c.Command.Name:
+			fmt.Fprintln(os.Stderr, "Error: already in a shell")
+			continue
+		}
+
+		runArgs := append([]string{c.App.Name}, globalArgs...)
+		if err := c.App.Run(append(runArgs, args...)); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		}
+	}
+}
+
+// shellUse handles "use customer [id]".
+func shellUse(args []string) error {
+	if len(args) == 0 || args[0] != "customer" || len(args) > 2 {
+		return errors.New("usage: use customer [<id>]")
+	}
+	if len(args) == 1 {
+		return os.Unsetenv(customerIDEnv)
+	}
+	return os.Setenv(customerIDEnv, args[1])
+}
+
+// shellPrompt returns the prompt, showing the selected customer if any.
+func shellPrompt() string {
+	if id := os.Getenv(customerIDEnv); id != "" {
+		return "onemoney[" + id + "]> "
+	}
+	return "onemoney> "
+}
+
+// shellHistoryPath returns the history file path in the CLI's config directory.
+func shellHistoryPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, credentials.DefaultConfigDir, shellHistoryFile)
+}
+
+func saveShellHistory(line *liner.State, path string) {
+	if path == "" || os.MkdirAll(filepath.Dir(path), 0o700) != nil {
+		return
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	_, _ = line.WriteHistory(f)
+}
+
+// shellCompleter completes command names, subcommand names and flags from the app's
+// command tree, plus the shell's own commands.
+func shellCompleter(app *cli.App) liner.Completer {
+	return func(input string) []string {
+		words := strings.Fields(input)
+		partial := ""
+		if len(words) > 0 && !strings.HasSuffix(input, " ") {
+			partial = words[len(words)-1]
+			words = words[:len(words)-1]
+		}
+		prefix := input[:len(input)-len(partial)]
+
+		var candidates []string
+		if len(words) == 0 {
+			candidates = append(candidates, "use", "exit", "quit")
+		}
+		if len(words) == 1 && words[0] == "use" {
+			candidates = append(candidates, "customer")
+		}
+
+		commands := app.Commands
+		var flags []cli.Flag
+		for _, w := range words {
+			if strings.HasPrefix(w, "-") {
+				continue
+			}
+			i := slices.IndexFunc(commands, func(cmd *cli.Command) bool { return cmd.HasName(w) })
+			if i < 0 {
+				break
+			}
+			flags = commands[i].Flags
+			commands = commands[i].Subcommands
+		}
+
+		if strings.HasPrefix(partial, "-") {
+			for _, f := range flags {
+				for _, name := range f.Names() {
+					if len(name) > 1 {
+						candidates = append(candidates, "--"+name)
+					}
+				}
+			}
+		} else {
+			for _, cmd := range commands {
+				if !cmd.Hidden {
+					candidates = append(candidates, cmd.Name)
+				}
+			}
+		}
+
+		var out []string
+		for _, cand := range candidates {
+			if strings.HasPrefix(cand, partial) {
+				out = append(out, prefix+cand)
+			}
+		}
+		return out
+	}
+}
+
+// splitWords splits a command line into words, honoring single and double quotes
+// and backslash escapes.
+func splitWords(s string) ([]string, error) {
+	var (
+		words   []string
+		word    strings.Builder
+		inWord  bool
+		quote   rune
+		escaped bool
+	)
+	for _, r := range s {
+		switch {
+		case escaped:
+			word.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped, inWord = true, true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				word.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote, inWord = r, true
+		case r == ' ' || r == '\t':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+	if quote != 0 {
+		return nil, errors.New("unterminated quote")
+	}
+	if escaped {
+		return nil, errors.New("trailing backslash")
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return words, nil
+}
@@ -0,0 +1,244 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/customer"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/external_accounts"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/simulations"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/transactions"
+)
+
+// simulateCommand returns the simulate command with all its subcommands.
+func simulateCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "simulate",
+		Usage: "Drive sandbox state: deposits, withdrawal outcomes, KYB and external account reviews",
+		Description: `Simulations are only available in sandbox environments.
+
+Examples:
+  onemoney-cli simulate deposit -c CUSTOMER_ID --asset USD --amount 1000
+  onemoney-cli simulate kyb -c CUSTOMER_ID --status approved`,
+		Subcommands: []*cli.Command{
+			{
+				Name:  "deposit",
+				Usage: "Simulate an incoming deposit",
+				Description: `--network is required for stablecoins and must be a wallet network; it is ignored for USD.
+
+Examples:
+  onemoney-cli simulate deposit -c CUSTOMER_ID --asset USD --amount 1000
+  onemoney-cli simulate deposit -c CUSTOMER_ID --asset USDC --network ETHEREUM --amount 250 \
+    --confirmations 12 --confirmation-interval 5
+  onemoney-cli -q simulation_id simulate deposit -c CUSTOMER_ID --asset USD --amount 1000 \
+    --originator-name "Acme Corp" --originator-bank "First Bank" --originator-account 000123456789`,
+				Flags: []cli.Flag{
+					customerFlag(),
+					&cli.StringFlag{Name: "asset", Usage: "Asset to deposit, e.g. USD or USDC", Required: true},
+					&cli.StringFlag{Name: "amount", Usage: "Amount to deposit", Required: true},
+					&cli.StringFlag{Name: "network", Usage: "Wallet network for stablecoin deposits, e.g. ETHEREUM"},
+					&cli.StringFlag{Name: "reference-code", Usage: "Reference code, e.g. to trigger an auto conversion rule"},
+					&cli.Uint64Flag{Name: "confirmations", Usage: "Block confirmations required before a crypto deposit completes"},
+					&cli.IntFlag{
+						Name:  "confirmation-interval",
+						Usage: "Seconds between simulated confirmations (0: advance them manually)",
+					},
+					&cli.StringFlag{Name: "originator-name", Usage: "Sender's full legal name"},
+					&cli.StringFlag{Name: "originator-bank", Usage: "Sending bank, for fiat deposits"},
+					&cli.StringFlag{Name: "originator-account", Usage: "Sender's account number or IBAN, for fiat deposits"},
+					&cli.StringFlag{Name: "originator-wallet", Usage: "Originating wallet address, for crypto deposits"},
+				},
+				Action: simulateDeposit,
+			},
+			{
+				Name:      "withdrawal-status",
+				Usage:     "Settle, return or fail a pending withdrawal",
+				ArgsUsage: "<transaction-id>",
+				Description: `--reason is required with RETURNED and must be an ACH return code, e.g. R01.
+With FAILED it is an optional failure reason.
+
+Examples:
+  onemoney-cli simulate withdrawal-status -c CUSTOMER_ID --status SETTLED TRANSACTION_ID
+  onemoney-cli simulate withdrawal-status -c CUSTOMER_ID --status RETURNED --reason R01 TRANSACTION_ID`,
+				Flags: []cli.Flag{
+					customerFlag(),
+					&cli.StringFlag{
+						Name:     "status",
+						Usage:    "Target outcome: " + strings.Join(simulations.WithdrawalSimulationStatusNames(), ", "),
+						Required: true,
+					},
+					&cli.StringFlag{Name: "reason", Usage: "ACH return code for RETURNED, or failure reason for FAILED"},
+				},
+				Action: simulateWithdrawalStatus,
+			},
+			{
+				Name:  "kyb",
+				Usage: "Force a customer's KYB status",
+				Description: `Examples:
+  onemoney-cli simulate kyb -c CUSTOMER_ID --status approved
+  onemoney-cli simulate kyb -c CUSTOMER_ID --status rejected --rejection-reason "Missing documents"`,
+				Flags: []cli.Flag{
+					customerFlag(),
+					&cli.StringFlag{
+						Name:     "status",
+						Usage:    "Target KYB status: " + strings.Join(customer.KybStatusNames(), ", "),
+						Required: true,
+					},
+					&cli.StringSliceFlag{Name: "rejection-reason", Usage: "Reason shown to the customer, with status rejected (repeatable)"},
+				},
+				Action: simulateKyb,
+			},
+			{
+				Name:      "external-account",
+				Usage:     "Force the review outcome of an external account",
+				ArgsUsage: "<external-account-id>",
+				Description: `Examples:
+  onemoney-cli simulate external-account -c CUSTOMER_ID --status APPROVED EXTERNAL_ACCOUNT_ID
+  onemoney-cli simulate external-account -c CUSTOMER_ID --status DOCUMENT_REQUIRED EXTERNAL_ACCOUNT_ID`,
+				Flags: []cli.Flag{
+					customerFlag(),
+					&cli.StringFlag{
+						Name:     "status",
+						Usage:    "Target status: " + strings.Join(external_accounts.BankAccountStatusNames(), ", "),
+						Required: true,
+					},
+				},
+				Action: simulateExternalAccount,
+			},
+		},
+	}
+}
+
+func simulateDeposit(c *cli.Context) error {
+	req := &simulations.SimulateDepositRequest{
+		Amount:                      c.String("amount"),
+		ReferenceCode:               c.String("reference-code"),
+		RequiredConfirmations:       c.Uint64("confirmations"),
+		ConfirmationIntervalSeconds: c.Int("confirmation-interval"),
+	}
+
+	var err error
+	if req.Asset, err = assets.ParseAssetName(c.String("asset")); err != nil {
+		return err
+	}
+	if c.IsSet("network") {
+		if req.Network, err = simulations.ParseWalletNetworkName(c.String("network")); err != nil {
+			return err
+		}
+	}
+
+	originator := transactions.Originator{
+		Name:          c.String("originator-name"),
+		BankName:      c.String("originator-bank"),
+		AccountNumber: c.String("originator-account"),
+		WalletAddress: c.String("originator-wallet"),
+	}
+	if originator != (transactions.Originator{}) {
+		req.Originator = &originator
+	}
+
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	resp, err := client.Simulations.SimulateDeposit(context.Background(), customerIDFlag(c), req)
+	if err != nil {
+		return fmt.Errorf("failed to simulate deposit: %w", err)
+	}
+
+	return printOutput(resp)
+}
+
+func simulateWithdrawalStatus(c *cli.Context) error {
+	transactionID := svc.TransactionID(c.Args().First())
+	if transactionID == "" {
+		return errors.New("transaction ID is required")
+	}
+	status, err := simulations.ParseWithdrawalSimulationStatus(c.String("status"))
+	if err != nil {
+		return err
+	}
+
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	resp, err := client.Simulations.SimulateWithdrawalStatus(
+		context.Background(), customerIDFlag(c), transactionID, status, c.String("reason"),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to simulate withdrawal status: %w", err)
+	}
+
+	return printOutput(resp)
+}
+
+func simulateKyb(c *cli.Context) error {
+	status, err := customer.ParseKybStatus(c.String("status"))
+	if err != nil {
+		return err
+	}
+
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	resp, err := client.Simulations.SetKybStatus(
+		context.Background(), customerIDFlag(c), status, c.StringSlice("rejection-reason"),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to set KYB status: %w", err)
+	}
+
+	return printOutput(resp)
+}
+
+func simulateExternalAccount(c *cli.Context) error {
+	externalAccountID := c.Args().First()
+	if externalAccountID == "" {
+		return errors.New("external account ID is required")
+	}
+	status, err := external_accounts.ParseBankAccountStatus(c.String("status"))
+	if err != nil {
+		return err
+	}
+
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	resp, err := client.Simulations.SetExternalAccountStatus(
+		context.Background(), customerIDFlag(c), externalAccountID, status,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to set external account status: %w", err)
+	}
+
+	return printOutput(resp)
+}
@@ -0,0 +1,328 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by svcgen cli. DO NOT EDIT.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/sweep_rules"
+)
+
+// sweepRulesCommand returns the sweep-rules command with a subcommand per method of sweep_rules.Service.
+func sweepRulesCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "sweep-rules",
+		Usage: "Manage sweep rules",
+		Description: `Examples:
+  onemoney-cli sweep-rules create -c CUSTOMER_ID -f request.yaml
+  onemoney-cli sweep-rules get -c CUSTOMER_ID --rule-id RULE_ID
+  onemoney-cli sweep-rules list -c CUSTOMER_ID
+  onemoney-cli sweep-rules pause -c CUSTOMER_ID --rule-id RULE_ID
+  onemoney-cli sweep-rules resume -c CUSTOMER_ID --rule-id RULE_ID
+  onemoney-cli sweep-rules delete -c CUSTOMER_ID --rule-id RULE_ID
+  onemoney-cli sweep-rules list-executions -c CUSTOMER_ID --rule-id RULE_ID`,
+		Subcommands: []*cli.Command{
+			{
+				Name:  "create",
+				Usage: "Create a new sweep rule for a customer",
+				Description: `Examples:
+  onemoney-cli sweep-rules create -c CUSTOMER_ID -f request.yaml`,
+				Flags: []cli.Flag{
+					customerFlag(),
+					&cli.StringFlag{
+						Name:    "file",
+						Aliases: []string{"f"},
+						Usage:   "Path to a CreateRuleRequest in JSON or YAML (\"-\" for stdin); flags override its fields",
+					},
+					&cli.StringFlag{Name: "idempotency-key", Usage: "A unique key to ensure idempotent creation"},
+					&cli.StringFlag{Name: "nickname", Usage: "A display name for the rule (optional)"},
+					&cli.StringFlag{Name: "asset", Usage: "The asset whose balance is swept"},
+					&cli.StringFlag{Name: "network", Usage: "The network the excess is withdrawn over"},
+					&cli.StringFlag{Name: "target-balance", Usage: "The balance to keep; anything above it is swept"},
+					&cli.StringFlag{
+						Name:  "minimum-sweep-amount",
+						Usage: "MinimumSweepAmount skips executions whose excess is below this amount (optional)",
+					},
+					&cli.StringFlag{Name: "wallet-address", Usage: "The destination wallet address for crypto sweeps"},
+					&cli.StringFlag{
+						Name:  "external-account-id",
+						Usage: "The destination external account for fiat sweeps",
+					},
+				},
+				Action: sweepRulesCreate,
+			},
+			{
+				Name:  "get",
+				Usage: "Retrieve a specific sweep rule by ID",
+				Description: `Examples:
+  onemoney-cli sweep-rules get -c CUSTOMER_ID --rule-id RULE_ID`,
+				Flags: []cli.Flag{
+					customerFlag(),
+					&cli.StringFlag{Name: "rule-id", Usage: "Rule ID", Required: true},
+				},
+				Action: sweepRulesGet,
+			},
+			{
+				Name:  "list",
+				Usage: "Retrieve sweep rules for a customer with optional filters and pagination",
+				Description: `Examples:
+  onemoney-cli sweep-rules list -c CUSTOMER_ID`,
+				Flags: []cli.Flag{
+					customerFlag(),
+					&cli.StringFlag{
+						Name:    "file",
+						Aliases: []string{"f"},
+						Usage:   "Path to a ListRulesRequest in JSON or YAML (\"-\" for stdin); flags override its fields",
+					},
+					&cli.StringFlag{Name: "status", Usage: "Status filters by rule status"},
+					&cli.IntFlag{Name: "page", Usage: "The page number (starts from 1)"},
+					&cli.IntFlag{Name: "size", Usage: "The number of items per page (1-100)"},
+				},
+				Action: sweepRulesList,
+			},
+			{
+				Name:  "pause",
+				Usage: "Stop a rule from executing until it is resumed",
+				Description: `Examples:
+  onemoney-cli sweep-rules pause -c CUSTOMER_ID --rule-id RULE_ID`,
+				Flags: []cli.Flag{
+					customerFlag(),
+					&cli.StringFlag{Name: "rule-id", Usage: "Rule ID", Required: true},
+				},
+				Action: sweepRulesPause,
+			},
+			{
+				Name:  "resume",
+				Usage: "Resume a paused rule from its next scheduled time",
+				Description: `Examples:
+  onemoney-cli sweep-rules resume -c CUSTOMER_ID --rule-id RULE_ID`,
+				Flags: []cli.Flag{
+					customerFlag(),
+					&cli.StringFlag{Name: "rule-id", Usage: "Rule ID", Required: true},
+				},
+				Action: sweepRulesResume,
+			},
+			{
+				Name:  "delete",
+				Usage: "Delete a sweep rule",
+				Description: `Examples:
+  onemoney-cli sweep-rules delete -c CUSTOMER_ID --rule-id RULE_ID`,
+				Flags: []cli.Flag{
+					customerFlag(),
+					&cli.StringFlag{Name: "rule-id", Usage: "Rule ID", Required: true},
+				},
+				Action: sweepRulesDelete,
+			},
+			{
+				Name:  "list-executions",
+				Usage: "Retrieve the execution history of a sweep rule, most recent first",
+				Description: `Examples:
+  onemoney-cli sweep-rules list-executions -c CUSTOMER_ID --rule-id RULE_ID`,
+				Flags: []cli.Flag{
+					customerFlag(),
+					&cli.StringFlag{Name: "rule-id", Usage: "Rule ID", Required: true},
+					&cli.StringFlag{
+						Name:    "file",
+						Aliases: []string{"f"},
+						Usage:   "Path to a ListExecutionsRequest in JSON or YAML (\"-\" for stdin); flags override its fields",
+					},
+					&cli.StringFlag{Name: "status", Usage: "Status filters by execution status"},
+					&cli.IntFlag{Name: "page", Usage: "The page number (starts from 1)"},
+					&cli.IntFlag{Name: "size", Usage: "The number of items per page (1-100)"},
+				},
+				Action: sweepRulesListExecutions,
+			},
+		},
+	}
+}
+
+func sweepRulesCreate(c *cli.Context) error {
+	req := &sweep_rules.CreateRuleRequest{}
+	if c.IsSet("file") {
+		if err := readRequestFile(c.String("file"), req); err != nil {
+			return err
+		}
+	}
+	if c.IsSet("idempotency-key") {
+		req.IdempotencyKey = c.String("idempotency-key")
+	}
+	if c.IsSet("nickname") {
+		req.Nickname = c.String("nickname")
+	}
+	if c.IsSet("asset") {
+		v, err := assets.ParseAssetName(c.String("asset"))
+		if err != nil {
+			return err
+		}
+		req.Asset = v
+	}
+	if c.IsSet("network") {
+		v, err := assets.ParseNetworkName(c.String("network"))
+		if err != nil {
+			return err
+		}
+		req.Network = v
+	}
+	if c.IsSet("target-balance") {
+		req.TargetBalance = c.String("target-balance")
+	}
+	if c.IsSet("minimum-sweep-amount") {
+		req.MinimumSweepAmount = c.String("minimum-sweep-amount")
+	}
+	if c.IsSet("wallet-address") {
+		req.WalletAddress = c.String("wallet-address")
+	}
+	if c.IsSet("external-account-id") {
+		req.ExternalAccountID = c.String("external-account-id")
+	}
+
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	result, err := client.SweepRules.CreateRule(context.Background(), customerIDFlag(c), req)
+	if err != nil {
+		return fmt.Errorf("failed to create rule: %w", err)
+	}
+	return printOutput(result)
+}
+
+func sweepRulesGet(c *cli.Context) error {
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	result, err := client.SweepRules.GetRule(context.Background(), customerIDFlag(c), c.String("rule-id"))
+	if err != nil {
+		return fmt.Errorf("failed to get rule: %w", err)
+	}
+	return printOutput(result)
+}
+
+func sweepRulesList(c *cli.Context) error {
+	req := &sweep_rules.ListRulesRequest{}
+	if c.IsSet("file") {
+		if err := readRequestFile(c.String("file"), req); err != nil {
+			return err
+		}
+	}
+	if c.IsSet("status") {
+		v, err := sweep_rules.ParseRuleStatus(c.String("status"))
+		if err != nil {
+			return err
+		}
+		req.Status = v
+	}
+	if c.IsSet("page") {
+		req.Page = c.Int("page")
+	}
+	if c.IsSet("size") {
+		req.Size = c.Int("size")
+	}
+
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	result, err := client.SweepRules.ListRules(context.Background(), customerIDFlag(c), req)
+	if err != nil {
+		return fmt.Errorf("failed to list rules: %w", err)
+	}
+	return printOutput(result)
+}
+
+func sweepRulesPause(c *cli.Context) error {
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	result, err := client.SweepRules.PauseRule(context.Background(), customerIDFlag(c), c.String("rule-id"))
+	if err != nil {
+		return fmt.Errorf("failed to pause rule: %w", err)
+	}
+	return printOutput(result)
+}
+
+func sweepRulesResume(c *cli.Context) error {
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	result, err := client.SweepRules.ResumeRule(context.Background(), customerIDFlag(c), c.String("rule-id"))
+	if err != nil {
+		return fmt.Errorf("failed to resume rule: %w", err)
+	}
+	return printOutput(result)
+}
+
+func sweepRulesDelete(c *cli.Context) error {
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	if err := client.SweepRules.DeleteRule(context.Background(), customerIDFlag(c), c.String("rule-id")); err != nil {
+		return fmt.Errorf("failed to delete rule: %w", err)
+	}
+	fmt.Fprintln(os.Stderr, "Done")
+	return nil
+}
+
+func sweepRulesListExecutions(c *cli.Context) error {
+	req := &sweep_rules.ListExecutionsRequest{}
+	if c.IsSet("file") {
+		if err := readRequestFile(c.String("file"), req); err != nil {
+			return err
+		}
+	}
+	if c.IsSet("status") {
+		v, err := sweep_rules.ParseExecutionStatus(c.String("status"))
+		if err != nil {
+			return err
+		}
+		req.Status = v
+	}
+	if c.IsSet("page") {
+		req.Page = c.Int("page")
+	}
+	if c.IsSet("size") {
+		req.Size = c.Int("size")
+	}
+
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	result, err := client.SweepRules.ListExecutions(context.Background(), customerIDFlag(c), c.String("rule-id"), req)
+	if err != nil {
+		return fmt.Errorf("failed to list executions: %w", err)
+	}
+	return printOutput(result)
+}
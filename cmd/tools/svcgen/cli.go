@@ -0,0 +1,874 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// cliHeader marks the generated CLI command files.
+const cliHeader = "// Code generated by svcgen cli. DO NOT EDIT.\n"
+
+// cliSuffix is the file name suffix of the generated CLI command files.
+const cliSuffix = "_gen.go"
+
+// cliModel is the CLI command group of a service package.
+type cliModel struct {
+	// Package is the name of the service package.
+	Package string
+	// Name is the command name, e.g. sweep-rules.
+	Name string
+	// Func is the name of the function returning the command, e.g. sweepRulesCommand.
+	Func string
+	// Field is the onemoney.Client field exposing the service.
+	Field string
+	// Subcommands are the commands of the service methods, in declaration order.
+	Subcommands []*cliSubcommand
+	// imports maps the import paths used by the generated code to their names.
+	imports map[string]string
+}
+
+// cliSubcommand is the command calling one service method.
+type cliSubcommand struct {
+	Name string
+	// Method is the name of the service method.
+	Method string
+	// Handler is the name of the generated action function.
+	Handler string
+	// Doc is the doc comment of the method, without the method name.
+	Doc   []string
+	Flags []*cliFlag
+	// Args are the expressions passed to the method after the context.
+	Args []string
+	// Parsed are the flags parsed into local variables before the call.
+	Parsed []*cliFlag
+	// Request is the type of the struct parameter filled from --file and the field flags.
+	Request string
+	// Fields are the flags setting the fields of the request.
+	Fields []*cliFlag
+	// NeedsFile reports whether the request has required fields that can only be set with --file.
+	NeedsFile bool
+	// HasResult reports whether the method returns a value besides the error.
+	HasResult bool
+}
+
+// cliFlag is a command-line flag of a subcommand.
+type cliFlag struct {
+	Name     string
+	Usage    string
+	Required bool
+	// Needed reports whether the API requires the request field set from the flag, which
+	// is not marked Required because --file may set it instead.
+	Needed bool
+	Type   cliType
+	// Target is the variable or field set from the flag.
+	Target string
+}
+
+// cliType describes how a flag value is read and converted.
+type cliType struct {
+	// Kind is the Go kind of the value: string, int, int64, uint64 or bool.
+	Kind string
+	// Parse is the qualified parse function of an enum type, e.g. assets.ParseAssetName.
+	Parse string
+	// Slice reports whether the value is a slice, read from a repeated string flag.
+	Slice bool
+	// Pointer reports whether the field is a pointer to the value.
+	Pointer bool
+}
+
+// maxFlagLine is the length above which a flag declaration is split over several lines.
+const maxFlagLine = 100
+
+// cliGetters maps value kinds to the cli.Context getters and flag types reading them.
+var cliGetters = map[string][2]string{
+	"string": {"String", "StringFlag"},
+	"int":    {"Int", "IntFlag"},
+	"int64":  {"Int64", "Int64Flag"},
+	"uint64": {"Uint64", "Uint64Flag"},
+	"bool":   {"Bool", "BoolFlag"},
+}
+
+// reservedCLIVars are the names used by the generated action functions.
+var reservedCLIVars = map[string]bool{"c": true, "client": true, "err": true, "req": true, "result": true, "v": true}
+
+// runCLI generates the CLI commands of service packages into the cmd directory.
+func runCLI(args []string) error {
+	fs := flag.NewFlagSet("cli", flag.ExitOnError)
+	root := fs.String("root", ".", "root directory of the SDK module")
+	fs.Usage = usage
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		usage()
+		os.Exit(1)
+	}
+
+	for _, pkg := range fs.Args() {
+		src, warnings, err := generateCLI(*root, pkg)
+		for _, w := range warnings {
+			fmt.Fprintf(os.Stderr, "⚠️  %s\n", w)
+		}
+		if err != nil {
+			return err
+		}
+		path := filepath.Join(*root, "cmd", pkg+cliSuffix)
+		_, statErr := os.Stat(path)
+		if err := writeFile(path, src); err != nil {
+			return err
+		}
+		fmt.Printf("✅ Generated: %s\n", path)
+		if os.IsNotExist(statErr) {
+			fmt.Printf("   Add %sCommand() to the commands in cmd/main.go and to the go:generate directive there.\n",
+				unexportedName(pkg))
+		}
+	}
+	return nil
+}
+
+// generateCLI returns the generated CLI command file of the service package pkg under
+// root/pkg/service, and warnings about the methods and fields it could not map to flags.
+func generateCLI(root, pkg string) ([]byte, []string, error) {
+	m, warnings, err := loadCLI(root, pkg)
+	if err != nil {
+		return nil, warnings, err
+	}
+	src, err := renderCLI(m)
+	if err != nil {
+		return nil, warnings, fmt.Errorf("failed to render CLI commands of %s: %w", pkg, err)
+	}
+	return src, warnings, nil
+}
+
+// cliLoader resolves the types of a service package to flags.
+type cliLoader struct {
+	root string
+	// path is the import path of the service package.
+	path string
+	fset *token.FileSet
+	// files are the parsed files of the service package.
+	files []*ast.File
+	// structs are the struct types declared in the service package.
+	structs map[string]*ast.StructType
+	// enums caches the enum types of SDK packages by import path.
+	enums map[string]map[string]bool
+	// imports are the imports of the generated file.
+	imports  map[string]string
+	warnings []string
+}
+
+// loadCLI parses a service package and returns the model of its CLI commands.
+func loadCLI(root, pkg string) (*cliModel, []string, error) {
+	dir := filepath.Join(root, "pkg", "service", pkg)
+	importPath := sdkModule + "/pkg/service/" + pkg
+	l := &cliLoader{
+		root:    root,
+		path:    importPath,
+		fset:    token.NewFileSet(),
+		structs: make(map[string]*ast.StructType),
+		enums:   make(map[string]map[string]bool),
+		imports: map[string]string{
+			"context":                  "context",
+			"fmt":                      "fmt",
+			"github.com/urfave/cli/v2": "cli",
+			importPath:                 pkg,
+		},
+	}
+
+	files, err := parseDir(l.fset, dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	l.files = files
+
+	var (
+		serviceFile *ast.File
+		iface       *ast.InterfaceType
+	)
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				if ts := spec.(*ast.TypeSpec); ts.TypeParams == nil {
+					if st, ok := ts.Type.(*ast.StructType); ok {
+						l.structs[ts.Name.Name] = st
+					}
+				}
+			}
+		}
+		if found := serviceInterface(file); found != nil {
+			serviceFile, iface = file, found
+		}
+	}
+	if iface == nil {
+		return nil, nil, fmt.Errorf("%s declares no Service interface", importPath)
+	}
+
+	field, err := clientField(root, importPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	m := &cliModel{
+		Package: pkg,
+		Name:    strings.Join(words(pkg), "-"),
+		Func:    unexportedName(pkg) + "Command",
+		Field:   field,
+		imports: l.imports,
+	}
+
+	q := &qualifier{file: serviceFile, pkg: pkg, imports: make(map[string]string)}
+	for _, f := range iface.Methods.List {
+		fn, ok := f.Type.(*ast.FuncType)
+		if !ok || len(f.Names) == 0 {
+			return nil, nil, fmt.Errorf("%s: embedded interfaces in Service are not supported", importPath)
+		}
+		name := f.Names[0].Name
+		sub, err := l.subcommand(q, name, fn)
+		if err != nil {
+			l.warnings = append(l.warnings, fmt.Sprintf("%s.%s: skipped: %v", pkg, name, err))
+			continue
+		}
+		sub.Doc = methodDoc(name, f.Doc)
+		m.Subcommands = append(m.Subcommands, sub)
+	}
+	if len(m.Subcommands) == 0 {
+		return nil, l.warnings, fmt.Errorf("%s has no methods that can be called from the CLI", importPath)
+	}
+	nameSubcommands(pkg, m.Subcommands)
+	for _, sub := range m.Subcommands {
+		sub.Handler = unexportedName(pkg) + exportedName(sub.Name)
+	}
+	return m, l.warnings, nil
+}
+
+// parseDir parses the non-test Go files of a directory.
+func parseDir(fset *token.FileSet, dir string) ([]*ast.File, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", dir, err)
+	}
+	var files []*ast.File
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ParseComments|parser.SkipObjectResolution)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", name, err)
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+// clientField returns the name of the onemoney.Client field holding the service of a package.
+func clientField(root, importPath string) (string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), filepath.Join(root, clientFile), nil, parser.SkipObjectResolution)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse client: %w", err)
+	}
+	q := &qualifier{file: file}
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			ts := spec.(*ast.TypeSpec)
+			st, ok := ts.Type.(*ast.StructType)
+			if !ok || ts.Name.Name != "Client" {
+				continue
+			}
+			for _, f := range st.Fields.List {
+				sel, ok := f.Type.(*ast.SelectorExpr)
+				if !ok || sel.Sel.Name != "Service" || len(f.Names) == 0 {
+					continue
+				}
+				if path, ok := q.importPath(sel.X.(*ast.Ident).Name); ok && path == importPath {
+					return f.Names[0].Name, nil
+				}
+			}
+		}
+	}
+	return "", fmt.Errorf("%s is not registered in onemoney.Client", importPath)
+}
+
+// subcommand maps the parameters of a service method to flags.
+func (l *cliLoader) subcommand(q *qualifier, name string, fn *ast.FuncType) (*cliSubcommand, error) {
+	sub := &cliSubcommand{Method: name}
+	if err := l.results(sub, fn.Results); err != nil {
+		return nil, err
+	}
+
+	var params []*ast.Ident
+	var types []ast.Expr
+	for _, f := range fn.Params.List {
+		for _, ident := range f.Names {
+			params = append(params, ident)
+			types = append(types, f.Type)
+		}
+	}
+	if len(params) == 0 || !isContext(types[0]) {
+		return nil, fmt.Errorf("the first parameter is not a context.Context")
+	}
+
+	for i := 1; i < len(params); i++ {
+		param, typ := params[i].Name, types[i]
+		if isCustomerID(q, param, typ) {
+			sub.Flags = append(sub.Flags, &cliFlag{Name: "customer", Required: true})
+			sub.Args = append(sub.Args, `customerIDFlag(c)`)
+			continue
+		}
+
+		if star, ok := typ.(*ast.StarExpr); ok {
+			if ident, ok := star.X.(*ast.Ident); ok && l.structs[ident.Name] != nil {
+				if sub.Request != "" {
+					return nil, fmt.Errorf("more than one struct parameter")
+				}
+				sub.Request = q.pkg + "." + ident.Name
+				sub.Fields = l.fields(q, ident.Name, &sub.NeedsFile)
+				sub.Args = append(sub.Args, "req")
+				continue
+			}
+		}
+
+		t, err := l.flagType(q, typ, false)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %s: %w", param, err)
+		}
+		fl := &cliFlag{
+			Name:     strings.Join(words(param), "-"),
+			Usage:    upperFirst(prose(param)),
+			Required: t.Kind != "bool",
+			Type:     t,
+		}
+		sub.Flags = append(sub.Flags, fl)
+		if t.Parse == "" {
+			sub.Args = append(sub.Args, fmt.Sprintf("c.%s(%q)", getter(t), fl.Name))
+			continue
+		}
+		fl.Target = param
+		if reservedCLIVars[param] {
+			fl.Target += "Arg"
+		}
+		sub.Parsed = append(sub.Parsed, fl)
+		sub.Args = append(sub.Args, fl.Target)
+	}
+
+	if sub.Request != "" {
+		sub.Flags = append(sub.Flags, &cliFlag{
+			Name: "file",
+			Usage: fmt.Sprintf(`Path to %s in JSON or YAML ("-" for stdin); flags override its fields`,
+				withArticle(strings.TrimPrefix(sub.Request, q.pkg+"."))),
+		})
+		seen := make(map[string]bool)
+		for _, fl := range sub.Flags {
+			seen[fl.Name] = true
+		}
+		fields := sub.Fields[:0]
+		for _, fl := range sub.Fields {
+			if seen[fl.Name] {
+				l.warnings = append(l.warnings, fmt.Sprintf("%s: field flag --%s clashes with another flag; "+
+					"set it with --file", name, fl.Name))
+				continue
+			}
+			seen[fl.Name] = true
+			fields = append(fields, fl)
+		}
+		sub.Fields = fields
+	}
+	return sub, nil
+}
+
+// results checks that a method returns an error, optionally preceded by a value to print.
+func (l *cliLoader) results(sub *cliSubcommand, results *ast.FieldList) error {
+	var types []ast.Expr
+	if results != nil {
+		for _, f := range results.List {
+			for range max(1, len(f.Names)) {
+				types = append(types, f.Type)
+			}
+		}
+	}
+	if len(types) == 0 || len(types) > 2 {
+		return fmt.Errorf("unsupported results")
+	}
+	if ident, ok := types[len(types)-1].(*ast.Ident); !ok || ident.Name != "error" {
+		return fmt.Errorf("the last result is not an error")
+	}
+	if len(types) == 2 {
+		switch types[0].(type) {
+		case *ast.StarExpr, *ast.ArrayType, *ast.Ident, *ast.SelectorExpr:
+			sub.HasResult = true
+		default:
+			return fmt.Errorf("result type %s cannot be printed", l.source(types[0]))
+		}
+	}
+	return nil
+}
+
+// fields returns the flags setting the fields of a request struct. Fields whose type has
+// no flag representation are skipped; they can still be set with --file.
+// incomplete is set when a field required by the API has no flag.
+func (l *cliLoader) fields(q *qualifier, name string, incomplete *bool) []*cliFlag {
+	var flags []*cliFlag
+	for _, f := range l.structs[name].Fields.List {
+		for _, ident := range f.Names {
+			if !ident.IsExported() {
+				continue
+			}
+			wire, needed := ident.Name, false
+			if f.Tag != nil {
+				tag, _ := strconv.Unquote(f.Tag.Value)
+				json, options, _ := strings.Cut(reflect.StructTag(tag).Get("json"), ",")
+				if json != "" && json != "-" {
+					wire = json
+					needed = !strings.Contains(options, "omitempty")
+				}
+			}
+			t, err := l.flagType(q, f.Type, true)
+			if err != nil {
+				if needed {
+					*incomplete = true
+				}
+				l.warnings = append(l.warnings, fmt.Sprintf("%s.%s: no flag: %v", name, ident.Name, err))
+				continue
+			}
+			flags = append(flags, &cliFlag{
+				Name:   strings.Join(words(wire), "-"),
+				Usage:  fieldUsage(ident.Name, f.Doc),
+				Needed: needed,
+				Type:   t,
+				Target: "req." + ident.Name,
+			})
+		}
+	}
+	return flags
+}
+
+// flagType returns how a value of the given type is read from a flag. Pointers are only
+// allowed for struct fields, whose optional values they represent.
+func (l *cliLoader) flagType(q *qualifier, expr ast.Expr, field bool) (cliType, error) {
+	var t cliType
+	if star, ok := expr.(*ast.StarExpr); ok && field {
+		t.Pointer = true
+		expr = star.X
+	} else if arr, ok := expr.(*ast.ArrayType); ok && arr.Len == nil {
+		t.Slice = true
+		expr = arr.Elt
+	}
+
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if _, ok := cliGetters[e.Name]; ok {
+			if t.Slice && e.Name != "string" {
+				break
+			}
+			t.Kind = e.Name
+			return t, nil
+		}
+		if l.isEnum(l.path, e.Name) {
+			t.Kind = "string"
+			t.Parse = q.pkg + ".Parse" + e.Name
+			return t, nil
+		}
+	case *ast.SelectorExpr:
+		name := e.X.(*ast.Ident).Name
+		if path, ok := q.importPath(name); ok && l.isEnum(path, e.Sel.Name) {
+			l.imports[path] = name
+			t.Kind = "string"
+			t.Parse = name + ".Parse" + e.Sel.Name
+			return t, nil
+		}
+	}
+	return t, fmt.Errorf("type %s has no flag representation", l.source(expr))
+}
+
+// isEnum reports whether name is a type of the SDK package at path with a Parse function.
+func (l *cliLoader) isEnum(path, name string) bool {
+	enums, ok := l.enums[path]
+	if !ok {
+		enums = make(map[string]bool)
+		l.enums[path] = enums
+
+		files := l.files
+		if path != l.path {
+			rel, ok := strings.CutPrefix(path, sdkModule+"/")
+			if !ok {
+				return false
+			}
+			files, _ = parseDir(l.fset, filepath.Join(l.root, filepath.FromSlash(rel)))
+		}
+		for _, file := range files {
+			for _, decl := range file.Decls {
+				fn, ok := decl.(*ast.FuncDecl)
+				if ok && fn.Recv == nil && strings.HasPrefix(fn.Name.Name, "Parse") {
+					enums[strings.TrimPrefix(fn.Name.Name, "Parse")] = true
+				}
+			}
+		}
+	}
+	return enums[name]
+}
+
+// source returns the source of a type expression for messages.
+func (l *cliLoader) source(expr ast.Expr) string {
+	var b bytes.Buffer
+	if err := printer.Fprint(&b, l.fset, expr); err != nil {
+		return fmt.Sprintf("%T", expr)
+	}
+	return b.String()
+}
+
+// isContext reports whether expr is context.Context.
+func isContext(expr ast.Expr) bool {
+	sel, ok := expr.(*ast.SelectorExpr)
+	return ok && sel.Sel.Name == "Context" && sel.X.(*ast.Ident).Name == "context"
+}
+
+// isCustomerID reports whether a parameter is the customer ID, which is read from the shared --customer flag.
+func isCustomerID(q *qualifier, name string, expr ast.Expr) bool {
+	if sel, ok := expr.(*ast.SelectorExpr); ok && sel.Sel.Name == "CustomerID" {
+		path, _ := q.importPath(sel.X.(*ast.Ident).Name)
+		return path == sdkModule+"/pkg/service"
+	}
+	ident, ok := expr.(*ast.Ident)
+	return ok && ident.Name == "string" && name == "customerID"
+}
+
+// nameSubcommands names the subcommands after their methods, dropping the words of the
+// package name ("CreateRule" of sweep_rules becomes "create") unless that makes names clash.
+func nameSubcommands(pkg string, subs []*cliSubcommand) {
+	resource := make(map[string]bool)
+	for _, w := range words(pkg) {
+		resource[w] = true
+		resource[strings.TrimSuffix(w, "s")] = true
+	}
+
+	short := make(map[string]int)
+	for _, sub := range subs {
+		var kept []string
+		for _, w := range words(sub.Method) {
+			if !resource[w] {
+				kept = append(kept, w)
+			}
+		}
+		sub.Name = strings.Join(kept, "-")
+		short[sub.Name]++
+	}
+	for _, sub := range subs {
+		if sub.Name == "" || short[sub.Name] > 1 {
+			sub.Name = strings.Join(words(sub.Method), "-")
+		}
+	}
+}
+
+// methodDoc returns the doc comment of a method without the leading method name,
+// e.g. "CreateRule creates a rule." becomes "Creates a rule.".
+func methodDoc(name string, doc *ast.CommentGroup) []string {
+	if doc == nil {
+		return nil
+	}
+	lines := strings.Split(strings.TrimSpace(doc.Text()), "\n")
+	if rest, ok := strings.CutPrefix(lines[0], name+" "); ok {
+		lines[0] = upperFirst(rest)
+	}
+	return lines
+}
+
+// fieldUsage returns the flag usage of a struct field from the first line of its doc comment,
+// e.g. "Nickname is a display name for the rule (optional)." becomes "A display name for the rule (optional)".
+func fieldUsage(name string, doc *ast.CommentGroup) string {
+	if doc == nil {
+		return upperFirst(prose(name))
+	}
+	usage, _, _ := strings.Cut(strings.TrimSpace(doc.Text()), "\n")
+	for _, verb := range []string{" is ", " are "} {
+		if rest, ok := strings.CutPrefix(usage, name+verb); ok {
+			return upperFirst(strings.TrimSuffix(rest, "."))
+		}
+	}
+	return upperFirst(strings.TrimSuffix(usage, "."))
+}
+
+// imperative turns a sentence starting with a conjugated verb into a command,
+// e.g. "Creates a rule" becomes "Create a rule", as in the usage of the other commands.
+func imperative(s string) string {
+	first, rest, _ := strings.Cut(s, " ")
+	for verb, conjugated := range verbs {
+		if strings.EqualFold(first, conjugated) {
+			return strings.TrimSpace(upperFirst(verb) + " " + rest)
+		}
+	}
+	return s
+}
+
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	r := []rune(s)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
+
+// getter returns the cli.Context method reading a flag of type t.
+func getter(t cliType) string {
+	if t.Slice {
+		return "StringSlice"
+	}
+	return cliGetters[t.Kind][0]
+}
+
+// flagDecl returns the source of the cli.Flag declaring fl.
+func flagDecl(fl *cliFlag) string {
+	switch fl.Name {
+	case "customer":
+		return "customerFlag(),"
+	case "file":
+		return structLiteral("&cli.StringFlag", []string{
+			`Name: "file"`, `Aliases: []string{"f"}`, fmt.Sprintf("Usage: %q", fl.Usage),
+		})
+	}
+	typ := cliGetters[fl.Type.Kind][1]
+	if fl.Type.Slice {
+		typ = "StringSliceFlag"
+	}
+	fields := []string{fmt.Sprintf("Name: %q", fl.Name), fmt.Sprintf("Usage: %q", fl.Usage)}
+	if fl.Required {
+		fields = append(fields, "Required: true")
+	}
+	return structLiteral("&cli."+typ, fields)
+}
+
+// structLiteral returns a composite literal ending in a comma, on one line when it is short
+// and with a field per line otherwise.
+func structLiteral(typ string, fields []string) string {
+	if decl := typ + "{" + strings.Join(fields, ", ") + "},"; len(decl) <= maxFlagLine {
+		return decl
+	}
+	return typ + "{\n" + strings.Join(fields, ",\n") + ",\n},"
+}
+
+// example returns an example invocation of a subcommand with its required flags.
+func example(m *cliModel, sub *cliSubcommand) string {
+	parts := []string{"onemoney-cli", m.Name, sub.Name}
+	for _, fl := range append(sub.Flags, sub.Fields...) {
+		switch {
+		case fl.Name == "customer":
+			parts = append(parts, "-c CUSTOMER_ID")
+		case fl.Required || (fl.Needed && !sub.NeedsFile):
+			parts = append(parts, "--"+fl.Name, strings.ToUpper(strings.ReplaceAll(fl.Name, "-", "_")))
+		}
+	}
+	if sub.NeedsFile {
+		parts = append(parts, "-f request.yaml")
+	}
+	return strings.Join(parts, " ")
+}
+
+// renderCLI returns the source of the CLI command file of a service.
+func renderCLI(m *cliModel) ([]byte, error) {
+	for _, sub := range m.Subcommands {
+		if !sub.HasResult {
+			m.imports["os"] = "os"
+		}
+	}
+
+	w := &codeWriter{}
+	w.line("%s", licenseHeader)
+	w.line("%s", cliHeader)
+	w.line("package main")
+	w.line("")
+	w.line("import (")
+	paths := make([]string, 0, len(m.imports))
+	for path := range m.imports {
+		paths = append(paths, path)
+	}
+	// Standard library, third-party and SDK imports are separate groups, as in the other commands.
+	group := func(path string) int {
+		switch {
+		case isStdlib(path):
+			return 0
+		case strings.HasPrefix(path, sdkModule):
+			return 2
+		default:
+			return 1
+		}
+	}
+	sort.Slice(paths, func(i, j int) bool {
+		if gi, gj := group(paths[i]), group(paths[j]); gi != gj {
+			return gi < gj
+		}
+		return paths[i] < paths[j]
+	})
+	for i, path := range paths {
+		if i > 0 && group(paths[i-1]) != group(path) {
+			w.line("")
+		}
+		if name := m.imports[path]; name != filepath.Base(path) && path != "github.com/urfave/cli/v2" {
+			w.line("\t%s %q", name, path)
+		} else {
+			w.line("\t%q", path)
+		}
+	}
+	w.line(")")
+	w.line("")
+
+	w.line("// %s returns the %s command with a subcommand per method of %s.Service.", m.Func, m.Name, m.Package)
+	w.line("func %s() *cli.Command {", m.Func)
+	w.line("\treturn &cli.Command{")
+	w.line("\t\tName: %q,", m.Name)
+	w.line("\t\tUsage: %q,", "Manage "+prose(m.Package))
+	examples := make([]string, len(m.Subcommands))
+	for i, sub := range m.Subcommands {
+		examples[i] = "  " + example(m, sub)
+	}
+	w.line("\t\tDescription: `Examples:\n%s`,", strings.Join(examples, "\n"))
+	w.line("\t\tSubcommands: []*cli.Command{")
+	for _, sub := range m.Subcommands {
+		w.line("\t\t\t{")
+		w.line("\t\t\t\tName: %q,", sub.Name)
+		if len(sub.Doc) > 0 {
+			w.line("\t\t\t\tUsage: %q,", imperative(firstSentence(sub.Doc[0])))
+		}
+		description := "Examples:\n  " + example(m, sub)
+		if len(sub.Doc) > 1 {
+			description = strings.Join(sub.Doc, "\n") + "\n\n" + description
+		}
+		w.line("\t\t\t\tDescription: `%s`,", strings.ReplaceAll(description, "`", "'"))
+		w.line("\t\t\t\tFlags: []cli.Flag{")
+		for _, fl := range sub.Flags {
+			w.line("\t\t\t\t\t%s", flagDecl(fl))
+		}
+		for _, fl := range sub.Fields {
+			w.line("\t\t\t\t\t%s", flagDecl(fl))
+		}
+		w.line("\t\t\t\t},")
+		w.line("\t\t\t\tAction: %s,", sub.Handler)
+		w.line("\t\t\t},")
+	}
+	w.line("\t\t},")
+	w.line("\t}")
+	w.line("}")
+
+	for _, sub := range m.Subcommands {
+		w.line("")
+		renderAction(w, m, sub)
+	}
+	return w.source()
+}
+
+// renderAction writes the action function of a subcommand.
+func renderAction(w *codeWriter, m *cliModel, sub *cliSubcommand) {
+	w.line("func %s(c *cli.Context) error {", sub.Handler)
+	for _, fl := range sub.Parsed {
+		w.line("\t%s, err := %s(c.String(%q))", fl.Target, fl.Type.Parse, fl.Name)
+		w.line("\tif err != nil {")
+		w.line("\t\treturn err")
+		w.line("\t}")
+	}
+	if sub.Request != "" {
+		w.line("\treq := &%s{}", sub.Request)
+		w.line("\tif c.IsSet(\"file\") {")
+		w.line("\t\tif err := readRequestFile(c.String(\"file\"), req); err != nil {")
+		w.line("\t\t\treturn err")
+		w.line("\t\t}")
+		w.line("\t}")
+		for _, fl := range sub.Fields {
+			renderFieldFlag(w, fl)
+		}
+	}
+	if len(sub.Parsed) > 0 || sub.Request != "" {
+		w.line("")
+	}
+
+	w.line("\tclient, err := createClient()")
+	w.line("\tif err != nil {")
+	w.line("\t\treturn fmt.Errorf(\"failed to create client: %%w\", err)")
+	w.line("\t}")
+	w.line("")
+
+	call := fmt.Sprintf("client.%s.%s(%s)", m.Field, sub.Method,
+		strings.Join(append([]string{"context.Background()"}, sub.Args...), ", "))
+	failure := fmt.Sprintf("failed to %s: %%w", prose(sub.Method))
+	if !sub.HasResult {
+		w.line("\tif err := %s; err != nil {", call)
+		w.line("\t\treturn fmt.Errorf(%q, err)", failure)
+		w.line("\t}")
+		w.line("\tfmt.Fprintln(os.Stderr, %q)", "Done")
+		w.line("\treturn nil")
+		w.line("}")
+		return
+	}
+	w.line("\tresult, err := %s", call)
+	w.line("\tif err != nil {")
+	w.line("\t\treturn fmt.Errorf(%q, err)", failure)
+	w.line("\t}")
+	w.line("\treturn printOutput(result)")
+	w.line("}")
+}
+
+// renderFieldFlag writes the statement setting a request field from its flag when the flag is given.
+func renderFieldFlag(w *codeWriter, fl *cliFlag) {
+	w.line("\tif c.IsSet(%q) {", fl.Name)
+	value := fmt.Sprintf("c.%s(%q)", getter(fl.Type), fl.Name)
+	switch {
+	case fl.Type.Parse != "" && fl.Type.Slice:
+		w.line("\t\t%s = nil", fl.Target)
+		w.line("\t\tfor _, s := range %s {", value)
+		w.line("\t\t\tv, err := %s(s)", fl.Type.Parse)
+		w.line("\t\t\tif err != nil {")
+		w.line("\t\t\t\treturn err")
+		w.line("\t\t\t}")
+		w.line("\t\t\t%s = append(%s, v)", fl.Target, fl.Target)
+		w.line("\t\t}")
+	case fl.Type.Parse != "":
+		w.line("\t\tv, err := %s(%s)", fl.Type.Parse, value)
+		w.line("\t\tif err != nil {")
+		w.line("\t\t\treturn err")
+		w.line("\t\t}")
+		if fl.Type.Pointer {
+			w.line("\t\t%s = &v", fl.Target)
+		} else {
+			w.line("\t\t%s = v", fl.Target)
+		}
+	case fl.Type.Pointer:
+		w.line("\t\tv := %s", value)
+		w.line("\t\t%s = &v", fl.Target)
+	default:
+		w.line("\t\t%s = %s", fl.Target, value)
+	}
+	w.line("\t}")
+}
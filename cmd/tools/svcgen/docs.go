@@ -0,0 +1,164 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// verbs are the summary verbs conjugated in method docs, e.g. "Create a widget" becomes
+// "CreateWidget creates a widget.".
+var verbs = map[string]string{
+	"add": "adds", "approve": "approves", "archive": "archives", "cancel": "cancels",
+	"check": "checks", "close": "closes", "confirm": "confirms", "create": "creates",
+	"delete": "deletes", "disable": "disables", "download": "downloads", "enable": "enables",
+	"estimate": "estimates", "execute": "executes", "fetch": "fetches", "generate": "generates",
+	"get": "gets", "issue": "issues", "list": "lists", "pause": "pauses", "preview": "previews", "quote": "quotes",
+	"reactivate": "reactivates", "refresh": "refreshes", "register": "registers", "reject": "rejects",
+	"remove": "removes", "replace": "replaces", "resend": "resends", "resume": "resumes", "retrieve": "retrieves",
+	"return": "returns", "revoke": "revokes", "rotate": "rotates", "search": "searches",
+	"send": "sends", "set": "sets", "sign": "signs", "simulate": "simulates", "start": "starts",
+	"stop": "stops", "submit": "submits", "update": "updates", "upload": "uploads",
+	"validate": "validates", "verify": "verifies",
+}
+
+// operationDoc returns the doc comment lines of a method, from the operation summary
+// or description when they start with a known verb.
+func operationDoc(m *operationModel, op *operation) []string {
+	var doc []string
+	summary := firstSentence(op.Summary)
+	if summary == "" {
+		summary = firstSentence(op.Description)
+	}
+	verb, rest, _ := strings.Cut(summary, " ")
+	if conjugated, ok := verbs[strings.ToLower(verb)]; ok {
+		doc = append(doc, sentence(m.Name+" "+conjugated+" "+rest))
+	} else {
+		doc = append(doc, fmt.Sprintf("%s calls %s %s.", m.Name, m.Method, m.Path))
+		if summary != "" {
+			doc = append(doc, sentence(summary))
+		}
+	}
+	if op.Deprecated {
+		doc = append(doc, "", "Deprecated: the operation is deprecated in the API specification.")
+	}
+	return doc
+}
+
+// typeDoc returns the doc comment lines of a generated type.
+func typeDoc(name, description string) []string {
+	lines := descriptionLines(description)
+	if len(lines) > 0 && !startsWithVerb(lines[0]) {
+		lines[0] = name + " represents " + lowerFirst(lines[0])
+		return lines
+	}
+	return append([]string{fmt.Sprintf("%s represents %s.", name, withArticle(prose(name)))}, lines...)
+}
+
+// fieldDoc returns the doc comment lines of a struct field.
+func fieldDoc(name, wire, description string) []string {
+	lines := descriptionLines(description)
+	if len(lines) > 0 && startsWithArticle(lines[0]) {
+		lines[0] = name + " is " + lowerFirst(lines[0])
+		return lines
+	}
+	return append([]string{fmt.Sprintf("%s is the %s.", name, prose(wire))}, lines...)
+}
+
+// prose returns an identifier as lower-case words, keeping initialisms in upper case,
+// e.g. "widget_ids" becomes "widget IDs".
+func prose(s string) string {
+	ws := words(s)
+	for i, w := range ws {
+		switch upper := strings.ToUpper(w); {
+		case initialisms[upper]:
+			ws[i] = upper
+		case strings.HasSuffix(upper, "S") && initialisms[strings.TrimSuffix(upper, "S")]:
+			ws[i] = strings.TrimSuffix(upper, "S") + "s"
+		}
+	}
+	return strings.Join(ws, " ")
+}
+
+// withArticle prefixes a noun phrase with "a" or "an".
+func withArticle(s string) string {
+	if s != "" && strings.ContainsRune("aeiouAEIOU", rune(s[0])) {
+		return "an " + s
+	}
+	return "a " + s
+}
+
+// descriptionLines splits a description into trimmed, non-empty lines ending in a period.
+func descriptionLines(description string) []string {
+	var lines []string
+	for _, line := range strings.Split(strings.TrimSpace(description), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if len(lines) > 0 {
+		lines[len(lines)-1] = sentence(lines[len(lines)-1])
+	}
+	return lines
+}
+
+// firstSentence returns the first line of s up to its first period.
+func firstSentence(s string) string {
+	s, _, _ = strings.Cut(strings.TrimSpace(s), "\n")
+	if i := strings.Index(s, ". "); i >= 0 {
+		s = s[:i]
+	}
+	return strings.TrimSuffix(strings.TrimSpace(s), ".")
+}
+
+// sentence ends s with a period unless it already ends with punctuation.
+func sentence(s string) string {
+	s = strings.TrimSpace(s)
+	if s == "" || strings.ContainsAny(s[len(s)-1:], ".!?:") {
+		return s
+	}
+	return s + "."
+}
+
+func startsWithVerb(s string) bool {
+	first, _, _ := strings.Cut(strings.ToLower(s), " ")
+	if _, ok := verbs[first]; ok {
+		return true
+	}
+	for _, conjugated := range verbs {
+		if first == conjugated {
+			return true
+		}
+	}
+	return false
+}
+
+func startsWithArticle(s string) bool {
+	first, _, _ := strings.Cut(strings.ToLower(s), " ")
+	return first == "a" || first == "an" || first == "the"
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	r := []rune(s)
+	r[0] = unicode.ToLower(r[0])
+	return string(r)
+}
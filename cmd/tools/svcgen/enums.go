@@ -0,0 +1,243 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"gopkg.in/yaml.v3"
+)
+
+// enumsFile is the name of the file generated from an enum spec.
+const enumsFile = "enums_enum.go"
+
+// enumSpec is a string enum read from an enums.yaml file.
+type enumSpec struct {
+	// Name is the Go type name.
+	Name string `yaml:"name"`
+	// Doc is the doc comment of the type.
+	Doc string `yaml:"doc"`
+	// Values are the enumerated values, as sent on the wire.
+	Values []string `yaml:"values"`
+}
+
+// runEnums generates the enums_enum.go file of the packages whose enum specs are given.
+func runEnums(args []string) error {
+	fs := flag.NewFlagSet("enums", flag.ExitOnError)
+	fs.Usage = usage
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		usage()
+		os.Exit(1)
+	}
+
+	for _, specPath := range fs.Args() {
+		src, err := generateEnums(specPath)
+		if err != nil {
+			return err
+		}
+		path := filepath.Join(filepath.Dir(specPath), enumsFile)
+		if err := writeFile(path, src); err != nil {
+			return err
+		}
+		fmt.Printf("✅ Generated: %s\n", path)
+	}
+	return nil
+}
+
+// generateEnums returns the source of the enums file described by an enum spec.
+func generateEnums(specPath string) ([]byte, error) {
+	data, err := os.ReadFile(specPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read enum spec: %w", err)
+	}
+	var enums []enumSpec
+	if err := yaml.Unmarshal(data, &enums); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", specPath, err)
+	}
+
+	abs, err := filepath.Abs(specPath)
+	if err != nil {
+		return nil, err
+	}
+	src, err := renderEnumTypes(enums, filepath.Base(filepath.Dir(abs)), filepath.Base(specPath))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", specPath, err)
+	}
+	return src, nil
+}
+
+// enumConstName returns the constant name of an enum value, e.g. "sole_proprietorship"
+// of BusinessType becomes BusinessTypeSoleProprietorship and "US_ACH" of NetworkName
+// becomes NetworkNameUSACH.
+func enumConstName(typeName, value string) string {
+	var b strings.Builder
+	b.WriteString(typeName)
+	for _, part := range strings.FieldsFunc(value, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		r := []rune(part)
+		r[0] = unicode.ToUpper(r[0])
+		b.WriteString(string(r))
+	}
+	return b.String()
+}
+
+// validateEnums checks the enum specs of a package.
+func validateEnums(enums []enumSpec) error {
+	consts := make(map[string]string)
+	for _, e := range enums {
+		if e.Name == "" || !token.IsIdentifier(e.Name) || !token.IsExported(e.Name) {
+			return fmt.Errorf("enum name %q is not an exported identifier", e.Name)
+		}
+		if len(e.Values) == 0 {
+			return fmt.Errorf("enum %s has no values", e.Name)
+		}
+		for _, v := range e.Values {
+			name := enumConstName(e.Name, v)
+			if name == e.Name {
+				return fmt.Errorf("enum %s: value %q has no letters or digits", e.Name, v)
+			}
+			if other, ok := consts[name]; ok {
+				return fmt.Errorf("enum %s: values %q and %q both map to %s", e.Name, other, v, name)
+			}
+			consts[name] = v
+		}
+	}
+	return nil
+}
+
+// renderEnumTypes returns the source of the enums file of a package.
+func renderEnumTypes(enums []enumSpec, pkg, specName string) ([]byte, error) {
+	if err := validateEnums(enums); err != nil {
+		return nil, err
+	}
+
+	w := &codeWriter{}
+	w.line("%s", licenseHeader)
+	w.line("// Code generated by svcgen enums from %s. DO NOT EDIT.", specName)
+	w.line("")
+	w.line("package %s", pkg)
+	w.line("")
+	w.line("import (")
+	w.line("\t\"encoding/json\"")
+	w.line("\t\"fmt\"")
+	w.line("\t\"strings\"")
+	w.line(")")
+
+	for _, e := range enums {
+		x := e.Name
+		w.line("")
+		w.comment("", descriptionLines(e.Doc))
+		w.line("type %s string", x)
+		w.line("")
+		w.line("const (")
+		for _, v := range e.Values {
+			w.line("\t// %s is %s of type %s.", enumConstName(x, v), withArticle(x), v)
+			w.line("\t%s %s = %q", enumConstName(x, v), x, v)
+		}
+		w.line(")")
+		w.line("")
+		w.line("var ErrInvalid%s = fmt.Errorf(\"not a valid %s, try [%%s]\", strings.Join(_%sNames, \", \"))", x, x, x)
+		w.line("")
+		w.line("var _%sNames = []string{", x)
+		for _, v := range e.Values {
+			w.line("\tstring(%s),", enumConstName(x, v))
+		}
+		w.line("}")
+		w.line("")
+		w.line("// %sNames returns a list of possible string values of %s.", x, x)
+		w.line("func %sNames() []string {", x)
+		w.line("\ttmp := make([]string, len(_%sNames))", x)
+		w.line("\tcopy(tmp, _%sNames)", x)
+		w.line("\treturn tmp")
+		w.line("}")
+		w.line("")
+		w.line("// String implements the Stringer interface.")
+		w.line("func (x %s) String() string {", x)
+		w.line("\treturn string(x)")
+		w.line("}")
+		w.line("")
+		w.line("// IsValid reports whether x is one of the enumerated values, ignoring case.")
+		w.line("func (x %s) IsValid() bool {", x)
+		w.line("\t_, err := Parse%s(string(x))", x)
+		w.line("\treturn err == nil")
+		w.line("}")
+		w.line("")
+		w.line("var _%sValue = map[string]%s{", x, x)
+		for _, v := range e.Values {
+			w.line("\t%q: %s,", v, enumConstName(x, v))
+			if lower := strings.ToLower(v); lower != v {
+				w.line("\t%q: %s,", lower, enumConstName(x, v))
+			}
+		}
+		w.line("}")
+		w.line("")
+		w.line("// Parse%s converts a string to a %s, ignoring case.", x, x)
+		w.line("func Parse%s(name string) (%s, error) {", x, x)
+		w.line("\tif x, ok := _%sValue[name]; ok {", x)
+		w.line("\t\treturn x, nil")
+		w.line("\t}")
+		w.line("\tif x, ok := _%sValue[strings.ToLower(name)]; ok {", x)
+		w.line("\t\treturn x, nil")
+		w.line("\t}")
+		w.line("\treturn %s(\"\"), fmt.Errorf(\"%%s is %%w\", name, ErrInvalid%s)", x, x)
+		w.line("}")
+		w.line("")
+		w.line("// MarshalText implements encoding.TextMarshaler.")
+		w.line("func (x %s) MarshalText() ([]byte, error) {", x)
+		w.line("\treturn []byte(string(x)), nil")
+		w.line("}")
+		w.line("")
+		w.line("// UnmarshalText implements encoding.TextUnmarshaler. It rejects unknown values.")
+		w.line("func (x *%s) UnmarshalText(text []byte) error {", x)
+		w.line("\ttmp, err := Parse%s(string(text))", x)
+		w.line("\tif err != nil {")
+		w.line("\t\treturn err")
+		w.line("\t}")
+		w.line("\t*x = tmp")
+		w.line("\treturn nil")
+		w.line("}")
+		w.line("")
+		w.line("// AppendText implements encoding.TextAppender.")
+		w.line("func (x *%s) AppendText(b []byte) ([]byte, error) {", x)
+		w.line("\treturn append(b, x.String()...), nil")
+		w.line("}")
+		w.line("")
+		w.line("// UnmarshalJSON implements json.Unmarshaler. It accepts only a JSON string holding one")
+		w.line("// of the enumerated values; null leaves x unchanged.")
+		w.line("func (x *%s) UnmarshalJSON(data []byte) error {", x)
+		w.line("\tif string(data) == \"null\" {")
+		w.line("\t\treturn nil")
+		w.line("\t}")
+		w.line("\tvar s string")
+		w.line("\tif err := json.Unmarshal(data, &s); err != nil {")
+		w.line("\t\treturn fmt.Errorf(\"%%s is %%w\", data, ErrInvalid%s)", x)
+		w.line("\t}")
+		w.line("\treturn x.UnmarshalText([]byte(s))")
+		w.line("}")
+	}
+	return w.source()
+}
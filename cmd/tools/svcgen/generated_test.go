@@ -0,0 +1,125 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMocksUpToDate(t *testing.T) {
+	root := filepath.Join("..", "..", "..")
+	files, err := generateMocks(root)
+	if err != nil {
+		t.Fatalf("generateMocks() error = %v", err)
+	}
+	if len(files) == 0 {
+		t.Fatal("generateMocks() generated no files")
+	}
+	for name, want := range files {
+		got, err := os.ReadFile(filepath.Join(root, "pkg", "mocks", name))
+		if err != nil || !bytes.Equal(got, want) {
+			t.Errorf("pkg/mocks/%s is out of date; run go generate ./pkg/mocks", name)
+		}
+	}
+}
+
+func TestWaitersUpToDate(t *testing.T) {
+	specs, err := filepath.Glob(filepath.Join("..", "..", "..", "pkg", "service", "*", "waiters.yaml"))
+	if err != nil || len(specs) == 0 {
+		t.Fatalf("found no waiter specs: %v", err)
+	}
+	for _, spec := range specs {
+		want, err := generateWaiters(spec)
+		if err != nil {
+			t.Errorf("generateWaiters(%s) error = %v", spec, err)
+			continue
+		}
+		got, err := os.ReadFile(filepath.Join(filepath.Dir(spec), waitersFile))
+		if err != nil || !bytes.Equal(got, want) {
+			t.Errorf("%s is out of date; run go generate", filepath.Join(filepath.Dir(spec), waitersFile))
+		}
+	}
+}
+
+func TestEnumsUpToDate(t *testing.T) {
+	specs, err := filepath.Glob(filepath.Join("..", "..", "..", "pkg", "service", "*", "enums.yaml"))
+	if err != nil || len(specs) == 0 {
+		t.Fatalf("found no enum specs: %v", err)
+	}
+	for _, spec := range specs {
+		want, err := generateEnums(spec)
+		if err != nil {
+			t.Errorf("generateEnums(%s) error = %v", spec, err)
+			continue
+		}
+		got, err := os.ReadFile(filepath.Join(filepath.Dir(spec), enumsFile))
+		if err != nil || !bytes.Equal(got, want) {
+			t.Errorf("%s is out of date; run go generate", filepath.Join(filepath.Dir(spec), enumsFile))
+		}
+	}
+}
+
+func TestEnumConstName(t *testing.T) {
+	tests := []struct{ typeName, value, want string }{
+		{"BusinessType", "sole_proprietorship", "BusinessTypeSoleProprietorship"},
+		{"NetworkName", "US_ACH", "NetworkNameUSACH"},
+		{"MoneyRange", "0_99999", "MoneyRange099999"},
+		{"DocumentType", "e_signature_certificate", "DocumentTypeESignatureCertificate"},
+	}
+	for _, tt := range tests {
+		if got := enumConstName(tt.typeName, tt.value); got != tt.want {
+			t.Errorf("enumConstName(%q, %q) = %q, want %q", tt.typeName, tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestCLIUpToDate(t *testing.T) {
+	root := filepath.Join("..", "..", "..")
+	files, err := filepath.Glob(filepath.Join(root, "cmd", "*"+cliSuffix))
+	if err != nil || len(files) == 0 {
+		t.Fatalf("found no generated CLI commands: %v", err)
+	}
+	for _, path := range files {
+		want, _, err := generateCLI(root, strings.TrimSuffix(filepath.Base(path), cliSuffix))
+		if err != nil {
+			t.Errorf("generateCLI(%s) error = %v", path, err)
+			continue
+		}
+		got, err := os.ReadFile(path)
+		if err != nil || !bytes.Equal(got, want) {
+			t.Errorf("%s is out of date; run go generate ./cmd", path)
+		}
+	}
+}
+
+func TestSubcommandNames(t *testing.T) {
+	subs := []*cliSubcommand{
+		{Method: "CreateRule"}, {Method: "ListRules"}, {Method: "ListExecutions"},
+		{Method: "GetOrder"}, {Method: "GetOrderByRule"}, {Method: "Rule"},
+	}
+	nameSubcommands("sweep_rules", subs)
+	want := []string{"create", "list", "list-executions", "get-order", "get-order-by", "rule"}
+	for i, sub := range subs {
+		if sub.Name != want[i] {
+			t.Errorf("name of %s = %q, want %q", sub.Method, sub.Name, want[i])
+		}
+	}
+}
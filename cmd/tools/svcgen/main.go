@@ -16,13 +16,28 @@
 
 // Package main provides a code generator for creating new service modules.
 //
-// This tool generates boilerplate code for new services following the project's
-// architecture patterns and conventions.
+// This tool generates code for services following the project's architecture
+// patterns and conventions. It has six modes: "new" scaffolds an empty service
+// package to fill in by hand, "openapi" generates complete service packages
+// (request and response structs, the Service interface, its implementation and
+// enums) from the platform OpenAPI specification, "mocks" generates stub
+// implementations of every Service interface into pkg/mocks, "waiters"
+// generates the WaitFor functions of a package from its waiters.yaml spec,
+// "enums" generates typed string enums from an enums.yaml list of values, and
+// "cli" generates the onemoney-cli commands of service packages, with a subcommand
+// per method and flags for its parameters and request fields.
+//
+// Services created by "new" and "openapi" are registered in onemoney.Client and
+// its e2e initialization test unless -no-register is given.
 //
 // Usage:
 //
-//	go run internal/tools/servicegen/main.go <service-name>
-//	go generate -run servicegen <service-name>
+//	go run ./cmd/tools/svcgen new [-no-register] <service-name>
+//	go run ./cmd/tools/svcgen openapi [-out pkg/service] [-tags tag,...] [-force] [-no-register] <spec.yaml>
+//	go run ./cmd/tools/svcgen mocks [-root .]
+//	go run ./cmd/tools/svcgen waiters pkg/service/<name>/waiters.yaml
+//	go run ./cmd/tools/svcgen enums pkg/service/<name>/enums.yaml
+//	go run ./cmd/tools/svcgen cli [-root .] <package>...
 package main
 
 import (
@@ -40,6 +55,8 @@ import (
 const (
 	// dirPerm defines the permission bits for created directories.
 	dirPerm = 0o755
+	// filePerm defines the permission bits for generated files.
+	filePerm = 0o644
 )
 
 const serviceTemplate = `/*
@@ -160,7 +177,7 @@ func TestNewService(t *testing.T) {
 	base := svc.NewBaseService(tr)
 
 	// Act
-	service := {{.PackageName}}.NewService(&base)
+	service := {{.PackageName}}.NewService(base)
 
 	// Assert
 	require.NotNil(t, service)
@@ -176,28 +193,76 @@ type templateData struct {
 }
 
 func main() {
+	flag.Usage = usage
 	flag.Parse()
 
 	if flag.NArg() < 1 {
-		fmt.Fprintf(os.Stderr, "Usage: %s <service-name>\n", os.Args[0])
-		fmt.Fprintf(os.Stderr, "Example: %s payment\n", os.Args[0])
+		usage()
+		os.Exit(1)
+	}
+
+	var err error
+	switch flag.Arg(0) {
+	case "new":
+		err = runNew(flag.Args()[1:])
+	case "openapi":
+		err = runOpenAPI(flag.Args()[1:])
+	case "mocks":
+		err = runMocks(flag.Args()[1:])
+	case "waiters":
+		err = runWaiters(flag.Args()[1:])
+	case "enums":
+		err = runEnums(flag.Args()[1:])
+	case "cli":
+		err = runCLI(flag.Args()[1:])
+	default:
+		// "svcgen <service-name>" is kept as a shorthand for "svcgen new <service-name>".
+		err = runNew(flag.Args())
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "  %s new [-no-register] <service-name>\n", os.Args[0])
+	fmt.Fprintf(os.Stderr, "  %s openapi [-out dir] [-tags tag,...] [-force] [-no-register] <spec.yaml>\n", os.Args[0])
+	fmt.Fprintf(os.Stderr, "  %s mocks [-root dir]\n", os.Args[0])
+	fmt.Fprintf(os.Stderr, "  %s waiters <waiters.yaml>...\n", os.Args[0])
+	fmt.Fprintf(os.Stderr, "  %s enums <enums.yaml>...\n", os.Args[0])
+	fmt.Fprintf(os.Stderr, "  %s cli [-root dir] <package>...\n", os.Args[0])
+	fmt.Fprintf(os.Stderr, "\nExamples:\n")
+	fmt.Fprintf(os.Stderr, "  %s new payment\n", os.Args[0])
+	fmt.Fprintf(os.Stderr, "  %s openapi -tags api_keys,limits openapi.yaml\n", os.Args[0])
+}
+
+// runNew scaffolds an empty service package and its test.
+func runNew(args []string) error {
+	fs := flag.NewFlagSet("new", flag.ExitOnError)
+	noRegister := fs.Bool("no-register", false, "do not register the service in onemoney.Client")
+	fs.Usage = usage
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		usage()
 		os.Exit(1)
 	}
 
-	serviceName := flag.Arg(0)
+	serviceName := fs.Arg(0)
 	packageName := strings.ToLower(serviceName)
 
 	// Validate service name
 	if packageName == "" {
-		fmt.Fprintf(os.Stderr, "Error: service name cannot be empty\n")
-		os.Exit(1)
+		return fmt.Errorf("service name cannot be empty")
 	}
 
 	// Create service directory
 	serviceDir := filepath.Join("pkg", "service", packageName)
 	if err := os.MkdirAll(serviceDir, dirPerm); err != nil {
-		fmt.Fprintf(os.Stderr, "Error creating directory %s: %v\n", serviceDir, err)
-		os.Exit(1)
+		return fmt.Errorf("failed to create directory %s: %w", serviceDir, err)
 	}
 
 	// Prepare template data
@@ -210,24 +275,139 @@ func main() {
 	// Generate service file
 	servicePath := filepath.Join(serviceDir, "service.go")
 	if err := generateFile(servicePath, serviceTemplate, data); err != nil {
-		fmt.Fprintf(os.Stderr, "Error generating service file: %v\n", err)
-		os.Exit(1)
+		return fmt.Errorf("failed to generate service file: %w", err)
 	}
 	fmt.Printf("✅ Generated: %s\n", servicePath)
 
 	// Generate test file
 	testPath := filepath.Join(serviceDir, "service_test.go")
 	if err := generateFile(testPath, testTemplate, data); err != nil {
-		fmt.Fprintf(os.Stderr, "Error generating test file: %v\n", err)
-		os.Exit(1)
+		return fmt.Errorf("failed to generate test file: %w", err)
 	}
 	fmt.Printf("✅ Generated: %s\n", testPath)
 
+	if !*noRegister {
+		if err := register(filepath.Join("pkg", "service"), packageName); err != nil {
+			return err
+		}
+	}
+
 	fmt.Printf("\n🎉 Service '%s' created successfully!\n", serviceName)
 	fmt.Printf("\nNext steps:\n")
 	fmt.Printf("  1. Implement your service methods in %s\n", servicePath)
 	fmt.Printf("  2. Add tests in %s\n", testPath)
-	fmt.Printf("  3. Register the service in pkg/onemoney/client.go\n")
+	fmt.Printf("  3. Regenerate the mocks: go generate ./pkg/mocks\n")
+	return nil
+}
+
+// register adds a generated service package to onemoney.Client.
+func register(dir, pkg string) error {
+	r, err := newRegistration(dir, pkg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "⏭️  Not registering %s: %v\n", pkg, err)
+		return nil
+	}
+	changed, err := registerService(".", r)
+	if err != nil {
+		return fmt.Errorf("failed to register %s: %w", pkg, err)
+	}
+	if changed {
+		fmt.Printf("✅ Registered: Client.%s in %s and %s\n", r.Field, clientFile, suiteFile)
+	}
+	return nil
+}
+
+// runOpenAPI generates service packages from an OpenAPI specification.
+func runOpenAPI(args []string) error {
+	fs := flag.NewFlagSet("openapi", flag.ExitOnError)
+	out := fs.String("out", filepath.Join("pkg", "service"), "directory to write the service packages to")
+	tags := fs.String("tags", "", "comma-separated tags or package names to generate (default: all)")
+	force := fs.Bool("force", false, "overwrite existing service packages")
+	noRegister := fs.Bool("no-register", false, "do not register the services in onemoney.Client")
+	fs.Usage = usage
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		usage()
+		os.Exit(1)
+	}
+
+	spec, err := loadSpec(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	var filter []string
+	for _, tag := range strings.Split(*tags, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			filter = append(filter, tag)
+		}
+	}
+
+	services, warnings, err := buildServices(spec, filter)
+	if err != nil {
+		return err
+	}
+	for _, w := range warnings {
+		fmt.Fprintf(os.Stderr, "⚠️  %s\n", w)
+	}
+	if len(services) == 0 {
+		return fmt.Errorf("no operations found in %s", fs.Arg(0))
+	}
+
+	var generated []string
+	hasEnums := false
+	for _, s := range services {
+		serviceDir := filepath.Join(*out, s.Package)
+		servicePath := filepath.Join(serviceDir, "service.go")
+		if _, err := os.Stat(servicePath); err == nil && !*force {
+			fmt.Fprintf(os.Stderr, "⏭️  Skipped: %s already exists (use -force to overwrite)\n", servicePath)
+			continue
+		}
+
+		src, err := renderService(s, spec)
+		if err != nil {
+			return fmt.Errorf("failed to render service %s: %w", s.Package, err)
+		}
+		if err := writeFile(servicePath, src); err != nil {
+			return err
+		}
+		fmt.Printf("✅ Generated: %s\n", servicePath)
+
+		enums, err := renderEnums(s)
+		if err != nil {
+			return fmt.Errorf("failed to render enums of %s: %w", s.Package, err)
+		}
+		if enums != nil {
+			enumsPath := filepath.Join(serviceDir, "enums.go")
+			if err := writeFile(enumsPath, enums); err != nil {
+				return err
+			}
+			fmt.Printf("✅ Generated: %s\n", enumsPath)
+			hasEnums = true
+		}
+		generated = append(generated, s.Package)
+
+		if !*noRegister {
+			if err := register(*out, s.Package); err != nil {
+				return err
+			}
+		}
+	}
+	if len(generated) == 0 {
+		return nil
+	}
+
+	fmt.Printf("\n🎉 Generated %d service(s): %s\n", len(generated), strings.Join(generated, ", "))
+	fmt.Printf("\nNext steps:\n")
+	step := 1
+	if hasEnums {
+		fmt.Printf("  %d. Generate the enum constants: go generate ./%s/...\n", step, filepath.ToSlash(*out))
+		step++
+	}
+	fmt.Printf("  %d. Regenerate the mocks: go generate ./pkg/mocks\n", step)
+	return nil
 }
 
 func generateFile(path, tmpl string, data templateData) error {
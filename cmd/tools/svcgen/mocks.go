@@ -0,0 +1,405 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// mocksHeader marks the generated mock files.
+const mocksHeader = "// Code generated by svcgen mocks. DO NOT EDIT.\n"
+
+// mockModel is a stub implementation of a service interface.
+type mockModel struct {
+	// Name is the stub type name, e.g. CustomerService.
+	Name string
+	// Package is the name of the service package.
+	Package string
+	// ImportPath is the import path of the service package.
+	ImportPath string
+	// Methods are the interface methods, in declaration order.
+	Methods []mockMethod
+	// imports maps the import paths used by the method signatures to their names.
+	imports map[string]string
+}
+
+// mockMethod is a method of a service interface.
+type mockMethod struct {
+	Name string
+	// Params are the parameter declarations, qualified for the mocks package.
+	Params []string
+	// Args are the parameter names, with "..." appended to a variadic one.
+	Args []string
+	// Results is the result list, qualified for the mocks package.
+	Results string
+}
+
+// runMocks generates stub implementations of every service interface.
+func runMocks(args []string) error {
+	fs := flag.NewFlagSet("mocks", flag.ExitOnError)
+	root := fs.String("root", ".", "root directory of the SDK module")
+	fs.Usage = usage
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	files, err := generateMocks(*root)
+	if err != nil {
+		return err
+	}
+
+	outDir := filepath.Join(*root, "pkg", "mocks")
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		path := filepath.Join(outDir, name)
+		if err := writeFile(path, files[name]); err != nil {
+			return err
+		}
+		fmt.Printf("✅ Generated: %s\n", path)
+	}
+	return nil
+}
+
+// generateMocks returns the generated mock files of the services under root/pkg/service,
+// keyed by file name.
+func generateMocks(root string) (map[string][]byte, error) {
+	serviceDir := filepath.Join(root, "pkg", "service")
+	entries, err := os.ReadDir(serviceDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", serviceDir, err)
+	}
+
+	files := make(map[string][]byte)
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		m, err := loadMock(filepath.Join(serviceDir, entry.Name()), sdkModule+"/pkg/service/"+entry.Name())
+		if err != nil {
+			return nil, err
+		}
+		if m == nil {
+			continue
+		}
+		src, err := renderMock(m)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render mock of %s: %w", m.Package, err)
+		}
+		files[entry.Name()+".go"] = src
+	}
+	return files, nil
+}
+
+// loadMock parses a service package and returns the model of its Service interface,
+// or nil when the package declares none.
+func loadMock(dir, importPath string) (*mockModel, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", dir, err)
+	}
+
+	fset := token.NewFileSet()
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", name, err)
+		}
+		iface := serviceInterface(file)
+		if iface == nil {
+			continue
+		}
+
+		m := &mockModel{
+			Name:       exportedName(file.Name.Name) + "Service",
+			Package:    file.Name.Name,
+			ImportPath: importPath,
+			imports:    map[string]string{importPath: file.Name.Name},
+		}
+		q := &qualifier{file: file, pkg: file.Name.Name, imports: m.imports}
+		for _, field := range iface.Methods.List {
+			fn, ok := field.Type.(*ast.FuncType)
+			if !ok || len(field.Names) == 0 {
+				return nil, fmt.Errorf("%s: embedded interfaces in Service are not supported", importPath)
+			}
+			method, err := q.method(fset, field.Names[0].Name, fn)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %w", importPath, field.Names[0].Name, err)
+			}
+			m.Methods = append(m.Methods, method)
+		}
+		return m, nil
+	}
+	return nil, nil
+}
+
+// serviceInterface returns the Service interface declared in file, if any.
+func serviceInterface(file *ast.File) *ast.InterfaceType {
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			ts := spec.(*ast.TypeSpec)
+			if iface, ok := ts.Type.(*ast.InterfaceType); ok && ts.Name.Name == "Service" {
+				return iface
+			}
+		}
+	}
+	return nil
+}
+
+// qualifier rewrites the types of a service package so they can be used from the mocks package.
+type qualifier struct {
+	file    *ast.File
+	pkg     string
+	imports map[string]string
+}
+
+// method returns the model of an interface method.
+func (q *qualifier) method(fset *token.FileSet, name string, fn *ast.FuncType) (mockMethod, error) {
+	m := mockMethod{Name: name}
+	n := 0
+	for _, field := range fn.Params.List {
+		typ, err := q.typeString(fset, field.Type)
+		if err != nil {
+			return m, err
+		}
+		_, variadic := field.Type.(*ast.Ellipsis)
+		names := field.Names
+		if len(names) == 0 {
+			names = []*ast.Ident{{Name: "_"}}
+		}
+		for _, ident := range names {
+			arg := ident.Name
+			if arg == "_" {
+				arg = fmt.Sprintf("arg%d", n)
+			}
+			n++
+			m.Params = append(m.Params, arg+" "+typ)
+			if variadic {
+				arg += "..."
+			}
+			m.Args = append(m.Args, arg)
+		}
+	}
+
+	if fn.Results != nil {
+		var results []string
+		for _, field := range fn.Results.List {
+			typ, err := q.typeString(fset, field.Type)
+			if err != nil {
+				return m, err
+			}
+			for range max(1, len(field.Names)) {
+				results = append(results, typ)
+			}
+		}
+		m.Results = strings.Join(results, ", ")
+		if len(results) > 1 {
+			m.Results = "(" + m.Results + ")"
+		}
+	}
+	return m, nil
+}
+
+// typeString returns the source of a type expression, with the identifiers of the service
+// package qualified by its name.
+func (q *qualifier) typeString(fset *token.FileSet, expr ast.Expr) (string, error) {
+	var err error
+	rewritten := q.rewrite(expr, &err)
+	if err != nil {
+		return "", err
+	}
+	var b bytes.Buffer
+	if err := printer.Fprint(&b, fset, rewritten); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// rewrite returns a copy of expr with local type names qualified and records the imports it uses.
+func (q *qualifier) rewrite(expr ast.Expr, errp *error) ast.Expr {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if types.Universe.Lookup(e.Name) != nil {
+			return e
+		}
+		return &ast.SelectorExpr{X: ast.NewIdent(q.pkg), Sel: ast.NewIdent(e.Name)}
+	case *ast.SelectorExpr:
+		name := e.X.(*ast.Ident).Name
+		path, ok := q.importPath(name)
+		if !ok {
+			*errp = fmt.Errorf("unknown package %s", name)
+			return e
+		}
+		q.imports[path] = name
+		return e
+	case *ast.StarExpr:
+		return &ast.StarExpr{X: q.rewrite(e.X, errp)}
+	case *ast.ArrayType:
+		return &ast.ArrayType{Len: e.Len, Elt: q.rewrite(e.Elt, errp)}
+	case *ast.MapType:
+		return &ast.MapType{Key: q.rewrite(e.Key, errp), Value: q.rewrite(e.Value, errp)}
+	case *ast.Ellipsis:
+		return &ast.Ellipsis{Elt: q.rewrite(e.Elt, errp)}
+	case *ast.ChanType:
+		return &ast.ChanType{Dir: e.Dir, Value: q.rewrite(e.Value, errp)}
+	case *ast.IndexExpr:
+		return &ast.IndexExpr{X: q.rewrite(e.X, errp), Index: q.rewrite(e.Index, errp)}
+	case *ast.IndexListExpr:
+		indices := make([]ast.Expr, len(e.Indices))
+		for i, index := range e.Indices {
+			indices[i] = q.rewrite(index, errp)
+		}
+		return &ast.IndexListExpr{X: q.rewrite(e.X, errp), Indices: indices}
+	case *ast.FuncType:
+		return &ast.FuncType{Params: q.rewriteFields(e.Params, errp), Results: q.rewriteFields(e.Results, errp)}
+	case *ast.InterfaceType:
+		if len(e.Methods.List) > 0 {
+			*errp = fmt.Errorf("inline interface types are not supported")
+		}
+		return e
+	default:
+		*errp = fmt.Errorf("unsupported type %T", expr)
+		return expr
+	}
+}
+
+func (q *qualifier) rewriteFields(fields *ast.FieldList, errp *error) *ast.FieldList {
+	if fields == nil {
+		return nil
+	}
+	out := &ast.FieldList{}
+	for _, field := range fields.List {
+		out.List = append(out.List, &ast.Field{Names: field.Names, Type: q.rewrite(field.Type, errp)})
+	}
+	return out
+}
+
+// importPath returns the path of the import named name in the service file.
+func (q *qualifier) importPath(name string) (string, bool) {
+	for _, imp := range q.file.Imports {
+		path, err := strconv.Unquote(imp.Path.Value)
+		if err != nil {
+			continue
+		}
+		if imp.Name != nil && imp.Name.Name == name {
+			return path, true
+		}
+		if imp.Name == nil && filepath.Base(path) == name {
+			return path, true
+		}
+	}
+	return "", false
+}
+
+// renderMock returns the source of the mock file of a service.
+func renderMock(m *mockModel) ([]byte, error) {
+	w := &codeWriter{}
+	w.line("%s", licenseHeader)
+	w.line("%s", mocksHeader)
+	w.line("package mocks")
+	w.line("")
+	w.line("import (")
+	paths := make([]string, 0, len(m.imports))
+	for path := range m.imports {
+		paths = append(paths, path)
+	}
+	// Standard library imports come first, separated from the module imports.
+	sort.Slice(paths, func(i, j int) bool {
+		if si, sj := isStdlib(paths[i]), isStdlib(paths[j]); si != sj {
+			return si
+		}
+		return paths[i] < paths[j]
+	})
+	for i, path := range paths {
+		if i > 0 && isStdlib(paths[i-1]) && !isStdlib(path) {
+			w.line("")
+		}
+		if name := m.imports[path]; name != filepath.Base(path) {
+			w.line("\t%s %q", name, path)
+		} else {
+			w.line("\t%q", path)
+		}
+	}
+	w.line(")")
+	w.line("")
+
+	w.line("// %s is a stub implementation of %s.Service.", m.Name, m.Package)
+	w.line("//")
+	w.line("// Each method records the call and delegates to the function field of the same name")
+	w.line("// with a Func suffix. Calling a method whose function is not set panics.")
+	w.line("type %s struct {", m.Name)
+	w.line("\trecorder")
+	w.line("")
+	for _, method := range m.Methods {
+		w.line("\t// %sFunc implements %s.", method.Name, method.Name)
+		w.line("\t%sFunc func(%s) %s", method.Name, strings.Join(method.Params, ", "), method.Results)
+	}
+	w.line("}")
+	w.line("")
+	w.line("var _ %s.Service = (*%s)(nil)", m.Package, m.Name)
+
+	for _, method := range m.Methods {
+		w.line("")
+		w.line("// %s calls %sFunc.", method.Name, method.Name)
+		w.line("func (mock *%s) %s(%s) %s {", m.Name, method.Name, strings.Join(method.Params, ", "), method.Results)
+		args := make([]string, len(method.Args))
+		for i, arg := range method.Args {
+			args[i] = strings.TrimSuffix(arg, "...")
+		}
+		w.line("\tmock.record(%q, %s)", method.Name, strings.Join(args, ", "))
+		w.line("\tif mock.%sFunc == nil {", method.Name)
+		w.line("\t\tpanic(%q)", fmt.Sprintf("mocks: %s.%s called but %sFunc is not set", m.Name, method.Name, method.Name))
+		w.line("\t}")
+		call := fmt.Sprintf("mock.%sFunc(%s)", method.Name, strings.Join(method.Args, ", "))
+		if method.Results == "" {
+			w.line("\t%s", call)
+		} else {
+			w.line("\treturn %s", call)
+		}
+		w.line("}")
+	}
+
+	return w.source()
+}
+
+func isStdlib(path string) bool {
+	first, _, _ := strings.Cut(path, "/")
+	return !strings.Contains(first, ".")
+}
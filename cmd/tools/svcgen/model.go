@@ -0,0 +1,554 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Kinds of generated types.
+const (
+	kindStruct = "struct"
+	kindEnum   = "enum"
+)
+
+// customerIDParam is the path parameter passed as svc.CustomerID.
+const customerIDParam = "customer_id"
+
+// idempotencyKeyHeader is the only header parameter the service helpers can send.
+const idempotencyKeyHeader = "Idempotency-Key"
+
+// pathParamPattern matches the parameters of a path template, such as {key_id}.
+var pathParamPattern = regexp.MustCompile(`\{([^}]+)\}`)
+
+// Models of the generated code.
+type (
+	// serviceModel is a service package generated from the operations of one tag.
+	serviceModel struct {
+		// Package is the package name, e.g. "api_keys".
+		Package string
+		// Name is the exported name used in docs and the client, e.g. "APIKeys".
+		Name string
+		// Tag is the OpenAPI tag the operations were grouped by.
+		Tag string
+		// Description is the tag description.
+		Description string
+		// Operations are the service methods in specification order.
+		Operations []*operationModel
+		// Types are the request and response structs in order of first use.
+		Types []*typeModel
+		// Enums are the string enums in order of first use.
+		Enums []*enumModel
+
+		// kinds maps each generated type name to kindStruct or kindEnum.
+		kinds map[string]string
+		// components maps component schema names to Go types.
+		components map[string]string
+	}
+
+	// operationModel is a service method.
+	operationModel struct {
+		Name   string
+		Doc    []string
+		Method string
+		Path   string
+		// PathParams are the path parameters in path order.
+		PathParams []paramModel
+		// QueryParams are the fields of Request sent as query parameters.
+		QueryParams []queryParamModel
+		// Request is the Go type of the req argument, empty when there is none.
+		Request string
+		// Idempotent is set when the operation accepts an Idempotency-Key header.
+		Idempotent bool
+		// Response is the Go type of the response body, empty when there is none.
+		Response string
+	}
+
+	// paramModel is a method argument.
+	paramModel struct {
+		Wire string
+		Name string
+		Type string
+	}
+
+	// queryParamModel is a request field sent as a query parameter.
+	queryParamModel struct {
+		Wire  string
+		Field string
+		Type  string
+	}
+
+	// typeModel is a generated struct.
+	typeModel struct {
+		Name     string
+		Doc      []string
+		Embedded []string
+		Fields   []fieldModel
+		// IdempotencyKey adds an IdempotencyKey field sent as a header.
+		IdempotencyKey bool
+	}
+
+	// fieldModel is a struct field.
+	fieldModel struct {
+		Name string
+		Type string
+		Tag  string
+		Doc  []string
+	}
+
+	// enumModel is a string enum, generated with go-enum.
+	enumModel struct {
+		Name   string
+		Doc    []string
+		Values []string
+	}
+)
+
+// builder turns the operations of a specification into service models.
+type builder struct {
+	spec     *openAPISpec
+	services []*serviceModel
+	byTag    map[string]*serviceModel
+	// warnings are the parts of the specification that could not be generated.
+	warnings []string
+}
+
+// buildServices groups the operations of spec by their first tag into service models.
+// When tags is not empty, only operations with one of these tags (or their package names) are kept.
+func buildServices(spec *openAPISpec, tags []string) ([]*serviceModel, []string, error) {
+	b := &builder{spec: spec, byTag: make(map[string]*serviceModel)}
+	methods := []struct {
+		name string
+		op   func(*pathItem) *operation
+	}{
+		{"GET", func(p *pathItem) *operation { return p.Get }},
+		{"POST", func(p *pathItem) *operation { return p.Post }},
+		{"PUT", func(p *pathItem) *operation { return p.Put }},
+		{"PATCH", func(p *pathItem) *operation { return p.Patch }},
+		{"DELETE", func(p *pathItem) *operation { return p.Delete }},
+	}
+
+	for _, path := range spec.Paths {
+		for _, method := range methods {
+			op := method.op(path.Value)
+			if op == nil {
+				continue
+			}
+			tag := "default"
+			if len(op.Tags) > 0 {
+				tag = op.Tags[0]
+			}
+			if len(tags) > 0 && !slices.Contains(tags, tag) && !slices.Contains(tags, packageName(tag)) {
+				continue
+			}
+			if err := b.addOperation(b.service(tag), method.name, path.Key, path.Value, op); err != nil {
+				return nil, nil, fmt.Errorf("%s %s: %w", method.name, path.Key, err)
+			}
+		}
+	}
+	if len(b.services) == 0 {
+		return nil, nil, fmt.Errorf("no operations found for tags %v", tags)
+	}
+	return b.services, b.warnings, nil
+}
+
+// service returns the service model of a tag, creating it on first use.
+func (b *builder) service(tag string) *serviceModel {
+	if s, ok := b.byTag[tag]; ok {
+		return s
+	}
+	s := &serviceModel{
+		Package:    packageName(tag),
+		Name:       exportedName(tag),
+		Tag:        tag,
+		kinds:      make(map[string]string),
+		components: make(map[string]string),
+	}
+	for _, t := range b.spec.Tags {
+		if t.Name == tag {
+			s.Description = t.Description
+		}
+	}
+	b.byTag[tag] = s
+	b.services = append(b.services, s)
+	return s
+}
+
+func (b *builder) warnf(format string, args ...any) {
+	b.warnings = append(b.warnings, fmt.Sprintf(format, args...))
+}
+
+// addOperation adds a method for the operation to the service.
+func (b *builder) addOperation(s *serviceModel, method, path string, item *pathItem, op *operation) error {
+	m := &operationModel{
+		Name:   operationName(method, path, op.OperationID),
+		Method: method,
+		Path:   path,
+	}
+	for _, other := range s.Operations {
+		if other.Name == m.Name {
+			return fmt.Errorf("duplicate method name %s in %s", m.Name, s.Package)
+		}
+	}
+	m.Doc = operationDoc(m, op)
+
+	params, err := b.parameters(item, op)
+	if err != nil {
+		return err
+	}
+
+	// Path parameters become arguments, in the order they appear in the path.
+	for _, match := range pathParamPattern.FindAllStringSubmatch(path, -1) {
+		arg := paramModel{Wire: match[1], Name: unexportedName(match[1]), Type: "string"}
+		if match[1] == customerIDParam || unexportedName(match[1]) == "customerID" {
+			arg = paramModel{Wire: match[1], Name: "id", Type: "svc.CustomerID"}
+		}
+		for _, p := range params {
+			if p.In == "path" && p.Name == match[1] && p.Schema != nil && len(p.Schema.Enum) > 0 {
+				if arg.Type, err = b.goType(s, p.Schema, exportedName(match[1])); err != nil {
+					return err
+				}
+			}
+		}
+		m.PathParams = append(m.PathParams, arg)
+	}
+
+	var queryParams []*parameter
+	for _, p := range params {
+		switch {
+		case p.In == "query":
+			queryParams = append(queryParams, p)
+		case p.In == "header" && strings.EqualFold(p.Name, idempotencyKeyHeader):
+			if method == "POST" {
+				m.Idempotent = true
+			} else {
+				b.warnf("%s %s: the %s header is only sent on POST requests, ignoring it", method, path, p.Name)
+			}
+		case p.In == "header" || p.In == "cookie":
+			b.warnf("%s %s: %s parameter %q is not supported, ignoring it", method, path, p.In, p.Name)
+		}
+	}
+
+	if op.RequestBody != nil {
+		body, err := b.spec.resolveRequestBody(op.RequestBody)
+		if err != nil {
+			return err
+		}
+		sc := jsonSchema(body.Content)
+		switch {
+		case sc == nil:
+			b.warnf("%s %s: request body has no JSON schema, ignoring it", method, path)
+		case method == "GET" || method == "DELETE":
+			b.warnf("%s %s: request bodies are not sent on %s requests, ignoring it", method, path, method)
+		default:
+			if m.Request, err = b.goType(s, sc, m.Name+"Request"); err != nil {
+				return err
+			}
+			if s.kinds[m.Request] == kindStruct {
+				m.Request = "*" + m.Request
+			}
+		}
+	}
+
+	if len(queryParams) > 0 {
+		if m.Request != "" || method != "GET" {
+			b.warnf("%s %s: query parameters are only supported on GET requests without a body, ignoring them",
+				method, path)
+		} else if err := b.queryRequest(s, m, queryParams); err != nil {
+			return err
+		}
+	}
+
+	if m.Idempotent && m.Request != "" {
+		t := s.structType(m.Request)
+		if t == nil {
+			b.warnf("%s %s: request body is not an object, the %s header is ignored", method, path, idempotencyKeyHeader)
+			m.Idempotent = false
+		} else {
+			t.IdempotencyKey = true
+		}
+	}
+
+	if m.Response, err = b.response(s, m, op); err != nil {
+		return err
+	}
+
+	s.Operations = append(s.Operations, m)
+	return nil
+}
+
+// parameters returns the resolved path item and operation parameters. Operation parameters
+// override path item parameters with the same name and location.
+func (b *builder) parameters(item *pathItem, op *operation) ([]*parameter, error) {
+	var params []*parameter
+	for _, p := range slices.Concat(item.Parameters, op.Parameters) {
+		resolved, err := b.spec.resolveParameter(p)
+		if err != nil {
+			return nil, err
+		}
+		params = slices.DeleteFunc(params, func(existing *parameter) bool {
+			return existing.Name == resolved.Name && existing.In == resolved.In
+		})
+		params = append(params, resolved)
+	}
+	return params, nil
+}
+
+// queryRequest adds a request struct holding the query parameters of a GET operation.
+func (b *builder) queryRequest(s *serviceModel, m *operationModel, params []*parameter) error {
+	name := s.uniqueName(m.Name + "Request")
+	t := &typeModel{
+		Name: name,
+		Doc:  []string{fmt.Sprintf("%s represents the query parameters of %s.", name, m.Name)},
+	}
+	s.kinds[t.Name] = kindStruct
+	s.Types = append(s.Types, t)
+
+	for _, p := range params {
+		goType, err := b.goType(s, p.Schema, t.Name+exportedName(p.Name))
+		if err != nil {
+			return err
+		}
+		if !queryParamSupported(s, goType) {
+			b.warnf("%s %s: query parameter %q of type %s is not supported, ignoring it", m.Method, m.Path, p.Name, goType)
+			continue
+		}
+		field := fieldModel{
+			Name: exportedName(p.Name),
+			Type: goType,
+			Tag:  p.Name + ",omitempty",
+			Doc:  fieldDoc(exportedName(p.Name), p.Name, p.Description),
+		}
+		t.Fields = append(t.Fields, field)
+		m.QueryParams = append(m.QueryParams, queryParamModel{Wire: p.Name, Field: field.Name, Type: goType})
+	}
+	m.Request = "*" + t.Name
+	return nil
+}
+
+// queryParamSupported reports whether a value of the Go type can be sent as a query parameter.
+func queryParamSupported(s *serviceModel, goType string) bool {
+	switch goType {
+	case "string", "int", "int64", "float64", "bool", "[]string":
+		return true
+	}
+	return s.kinds[goType] == kindEnum
+}
+
+// response returns the Go type of the first successful JSON response, or "" when there is none.
+func (b *builder) response(s *serviceModel, m *operationModel, op *operation) (string, error) {
+	for _, r := range op.Responses {
+		if !strings.HasPrefix(r.Key, "2") {
+			continue
+		}
+		resp, err := b.spec.resolveResponse(r.Value)
+		if err != nil {
+			return "", err
+		}
+		sc := jsonSchema(resp.Content)
+		if sc == nil {
+			return "", nil
+		}
+		return b.goType(s, sc, m.Name+"Response")
+	}
+	return "", nil
+}
+
+// goType returns the Go type of a schema, generating named types for objects and enums.
+// hint names the generated type of an inline object or enum.
+func (b *builder) goType(s *serviceModel, sc *schema, hint string) (string, error) {
+	if sc == nil {
+		return "any", nil
+	}
+	if sc.Ref != "" {
+		return b.component(s, sc.Ref)
+	}
+	// allOf with a single reference is commonly used to document a property of a shared type.
+	if len(sc.AllOf) == 1 && sc.AllOf[0].Ref != "" && len(sc.Properties) == 0 {
+		return b.component(s, sc.AllOf[0].Ref)
+	}
+	if len(sc.AllOf) > 0 || len(sc.Properties) > 0 {
+		return b.structSchema(s, sc, hint)
+	}
+	if len(sc.OneOf) > 0 || len(sc.AnyOf) > 0 {
+		return "any", nil
+	}
+
+	switch sc.Type.Name {
+	case "string":
+		if len(sc.Enum) > 0 {
+			return b.enumSchema(s, sc, hint), nil
+		}
+		return "string", nil
+	case "integer":
+		if sc.Format == "int64" {
+			return "int64", nil
+		}
+		return "int", nil
+	case "number":
+		return "float64", nil
+	case "boolean":
+		return "bool", nil
+	case "array":
+		elem, err := b.goType(s, sc.Items, hint+"Item")
+		if err != nil {
+			return "", err
+		}
+		return "[]" + elem, nil
+	case "object":
+		return "map[string]any", nil
+	default:
+		return "any", nil
+	}
+}
+
+// component returns the Go type of a component schema, generating it on first use.
+func (b *builder) component(s *serviceModel, ref string) (string, error) {
+	name, err := refName(ref, "schemas")
+	if err != nil {
+		return "", err
+	}
+	if goType, ok := s.components[name]; ok {
+		return goType, nil
+	}
+	sc, ok := b.spec.Components.Schemas.get(name)
+	if !ok {
+		return "", fmt.Errorf("undefined schema %q", ref)
+	}
+
+	// Register the name first so that recursive references resolve to it.
+	goName := exportedName(name)
+	s.components[name] = goName
+	goType, err := b.goType(s, sc, goName)
+	if err != nil {
+		return "", err
+	}
+	s.components[name] = goType
+	return goType, nil
+}
+
+// structSchema generates a struct for an object schema.
+func (b *builder) structSchema(s *serviceModel, sc *schema, name string) (string, error) {
+	t := &typeModel{Name: s.uniqueName(name)}
+	t.Doc = typeDoc(t.Name, sc.Description)
+	s.kinds[t.Name] = kindStruct
+	s.Types = append(s.Types, t)
+
+	for _, part := range sc.AllOf {
+		if part.Ref != "" {
+			embedded, err := b.component(s, part.Ref)
+			if err != nil {
+				return "", err
+			}
+			if s.kinds[embedded] != kindStruct {
+				return "", fmt.Errorf("allOf of %s references %s, which is not an object", t.Name, part.Ref)
+			}
+			t.Embedded = append(t.Embedded, embedded)
+			continue
+		}
+		if err := b.addFields(s, t, part); err != nil {
+			return "", err
+		}
+	}
+	if err := b.addFields(s, t, sc); err != nil {
+		return "", err
+	}
+	return t.Name, nil
+}
+
+// addFields adds the properties of an object schema to a struct.
+func (b *builder) addFields(s *serviceModel, t *typeModel, sc *schema) error {
+	for _, prop := range sc.Properties {
+		fieldName := exportedName(prop.Key)
+		goType, err := b.goType(s, prop.Value, t.Name+fieldName)
+		if err != nil {
+			return err
+		}
+		nullable := prop.Value != nil && (prop.Value.Nullable || prop.Value.Type.Nullable)
+		if s.kinds[goType] == kindStruct || (nullable && !strings.HasPrefix(goType, "[]") &&
+			!strings.HasPrefix(goType, "map[") && goType != "any") {
+			goType = "*" + goType
+		}
+
+		tag := prop.Key
+		if !slices.Contains(sc.Required, prop.Key) {
+			tag += ",omitempty"
+		}
+		description := ""
+		if prop.Value != nil {
+			description = prop.Value.Description
+		}
+		t.Fields = append(t.Fields, fieldModel{
+			Name: fieldName,
+			Type: goType,
+			Tag:  tag,
+			Doc:  fieldDoc(fieldName, prop.Key, description),
+		})
+	}
+	return nil
+}
+
+// enumSchema generates a string enum.
+func (b *builder) enumSchema(s *serviceModel, sc *schema, name string) string {
+	e := &enumModel{Name: s.uniqueName(name), Values: sc.Enum}
+	e.Doc = typeDoc(e.Name, sc.Description)
+	s.kinds[e.Name] = kindEnum
+	s.Enums = append(s.Enums, e)
+	return e.Name
+}
+
+// uniqueName returns name, or name with a numeric suffix if a type with that name exists.
+func (s *serviceModel) uniqueName(name string) string {
+	unique := name
+	for i := 2; s.kinds[unique] != ""; i++ {
+		unique = fmt.Sprintf("%s%d", name, i)
+	}
+	return unique
+}
+
+// structType returns the generated struct of a Go type such as "*Widget", or nil.
+func (s *serviceModel) structType(goType string) *typeModel {
+	name := strings.TrimPrefix(goType, "*")
+	for _, t := range s.Types {
+		if t.Name == name {
+			return t
+		}
+	}
+	return nil
+}
+
+// versionSegment matches API version path segments such as "v1".
+var versionSegment = regexp.MustCompile(`^v\d+$`)
+
+// operationName returns the method name of an operation: its operationId, or the method and
+// the path without parameters and version, e.g. "GetWidgetsStock".
+func operationName(method, path, operationID string) string {
+	if operationID != "" {
+		return exportedName(operationID)
+	}
+	parts := []string{strings.ToLower(method)}
+	for _, segment := range strings.Split(path, "/") {
+		if segment == "" || versionSegment.MatchString(segment) || strings.HasPrefix(segment, "{") {
+			continue
+		}
+		parts = append(parts, segment)
+	}
+	return exportedName(strings.Join(parts, "_"))
+}
@@ -0,0 +1,110 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"go/token"
+	"strings"
+	"unicode"
+)
+
+// initialisms are the words written in upper case in Go identifiers.
+var initialisms = map[string]bool{
+	"ACH": true, "API": true, "BIC": true, "DAO": true, "EIN": true, "HTTP": true,
+	"IBAN": true, "ID": true, "IP": true, "JSON": true, "KYB": true,
+	"KYC": true, "POA": true, "QR": true, "SSN": true, "SWIFT": true, "TOS": true,
+	"UBO": true, "URI": true, "URL": true, "USD": true, "UUID": true,
+}
+
+// words splits an identifier in any of snake_case, kebab-case, camelCase or
+// "Title Case" into lower-case words.
+func words(s string) []string {
+	var out []string
+	var current []rune
+	flush := func() {
+		if len(current) > 0 {
+			out = append(out, strings.ToLower(string(current)))
+			current = current[:0]
+		}
+	}
+
+	runes := []rune(s)
+	for i, r := range runes {
+		switch {
+		case !unicode.IsLetter(r) && !unicode.IsDigit(r):
+			flush()
+		case unicode.IsUpper(r) && i > 0 && len(current) > 0:
+			// Split "apiKey" before K and "APIKey" before K, but keep "API" together.
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				flush()
+			}
+			current = append(current, r)
+		default:
+			current = append(current, r)
+		}
+	}
+	flush()
+	return out
+}
+
+// exportedName converts an identifier such as "api_key_id" into an exported Go name ("APIKeyID").
+func exportedName(s string) string {
+	name := joinWords(words(s))
+	if name == "" || unicode.IsDigit(rune(name[0])) {
+		name = "X" + name
+	}
+	return name
+}
+
+// joinWords capitalizes and joins words, writing initialisms in upper case.
+func joinWords(ws []string) string {
+	var b strings.Builder
+	for _, w := range ws {
+		switch {
+		case w == "ids":
+			b.WriteString("IDs")
+		case initialisms[strings.ToUpper(w)]:
+			b.WriteString(strings.ToUpper(w))
+		default:
+			b.WriteString(strings.ToUpper(w[:1]) + w[1:])
+		}
+	}
+	return b.String()
+}
+
+// unexportedName converts an identifier such as "api_key_id" into an unexported Go name ("apiKeyID").
+func unexportedName(s string) string {
+	ws := words(s)
+	if len(ws) == 0 {
+		return "x"
+	}
+	name := ws[0] + joinWords(ws[1:])
+	switch {
+	case token.IsKeyword(name):
+		name += "Param"
+	case !token.IsIdentifier(name):
+		name = "x" + joinWords(ws)
+	}
+	return name
+}
+
+// packageName converts a tag such as "API Keys" into a package name ("api_keys").
+func packageName(s string) string {
+	return strings.Join(words(s), "_")
+}
@@ -0,0 +1,271 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// The types below cover the subset of OpenAPI 3.0 and 3.1 used to describe JSON APIs.
+// Both YAML and JSON specifications are read, since YAML is a superset of JSON.
+type (
+	// openAPISpec is an OpenAPI document.
+	openAPISpec struct {
+		OpenAPI    string             `yaml:"openapi"`
+		Info       openAPIInfo        `yaml:"info"`
+		Tags       []openAPITag       `yaml:"tags"`
+		Paths      ordered[*pathItem] `yaml:"paths"`
+		Components openAPIComponents  `yaml:"components"`
+	}
+
+	openAPIInfo struct {
+		Title   string `yaml:"title"`
+		Version string `yaml:"version"`
+	}
+
+	openAPITag struct {
+		Name        string `yaml:"name"`
+		Description string `yaml:"description"`
+	}
+
+	openAPIComponents struct {
+		Schemas       ordered[*schema]      `yaml:"schemas"`
+		Parameters    ordered[*parameter]   `yaml:"parameters"`
+		RequestBodies ordered[*requestBody] `yaml:"requestBodies"`
+		Responses     ordered[*response]    `yaml:"responses"`
+	}
+
+	// pathItem holds the operations of a path.
+	pathItem struct {
+		Parameters []*parameter `yaml:"parameters"`
+		Get        *operation   `yaml:"get"`
+		Post       *operation   `yaml:"post"`
+		Put        *operation   `yaml:"put"`
+		Patch      *operation   `yaml:"patch"`
+		Delete     *operation   `yaml:"delete"`
+	}
+
+	operation struct {
+		OperationID string             `yaml:"operationId"`
+		Summary     string             `yaml:"summary"`
+		Description string             `yaml:"description"`
+		Tags        []string           `yaml:"tags"`
+		Parameters  []*parameter       `yaml:"parameters"`
+		RequestBody *requestBody       `yaml:"requestBody"`
+		Responses   ordered[*response] `yaml:"responses"`
+		Deprecated  bool               `yaml:"deprecated"`
+	}
+
+	parameter struct {
+		Ref         string  `yaml:"$ref"`
+		Name        string  `yaml:"name"`
+		In          string  `yaml:"in"`
+		Description string  `yaml:"description"`
+		Required    bool    `yaml:"required"`
+		Schema      *schema `yaml:"schema"`
+	}
+
+	requestBody struct {
+		Ref      string                `yaml:"$ref"`
+		Required bool                  `yaml:"required"`
+		Content  map[string]*mediaType `yaml:"content"`
+	}
+
+	response struct {
+		Ref         string                `yaml:"$ref"`
+		Description string                `yaml:"description"`
+		Content     map[string]*mediaType `yaml:"content"`
+	}
+
+	mediaType struct {
+		Schema *schema `yaml:"schema"`
+	}
+
+	schema struct {
+		Ref         string           `yaml:"$ref"`
+		Type        schemaType       `yaml:"type"`
+		Format      string           `yaml:"format"`
+		Description string           `yaml:"description"`
+		Enum        []string         `yaml:"enum"`
+		Properties  ordered[*schema] `yaml:"properties"`
+		Required    []string         `yaml:"required"`
+		Items       *schema          `yaml:"items"`
+		AllOf       []*schema        `yaml:"allOf"`
+		OneOf       []*schema        `yaml:"oneOf"`
+		AnyOf       []*schema        `yaml:"anyOf"`
+		Nullable    bool             `yaml:"nullable"`
+		Deprecated  bool             `yaml:"deprecated"`
+	}
+)
+
+// schemaType is the type of a schema. OpenAPI 3.1 allows a list such as [string, "null"],
+// which is read as the first non-null type plus nullable.
+type schemaType struct {
+	Name     string
+	Nullable bool
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (t *schemaType) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		t.Name = node.Value
+		return nil
+	}
+	var names []string
+	if err := node.Decode(&names); err != nil {
+		return err
+	}
+	for _, name := range names {
+		if name == "null" {
+			t.Nullable = true
+		} else if t.Name == "" {
+			t.Name = name
+		}
+	}
+	return nil
+}
+
+// ordered is a YAML mapping that keeps the order of its keys, so that generated code
+// follows the order of the specification.
+type ordered[T any] []entry[T]
+
+// entry is a key and value of an ordered mapping.
+type entry[T any] struct {
+	Key   string
+	Value T
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (o *ordered[T]) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.MappingNode {
+		return fmt.Errorf("line %d: expected a mapping", node.Line)
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		var value T
+		if err := node.Content[i+1].Decode(&value); err != nil {
+			return err
+		}
+		*o = append(*o, entry[T]{Key: node.Content[i].Value, Value: value})
+	}
+	return nil
+}
+
+// get returns the value of key.
+func (o ordered[T]) get(key string) (T, bool) {
+	for _, e := range o {
+		if e.Key == key {
+			return e.Value, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// loadSpec reads an OpenAPI specification in YAML or JSON.
+func loadSpec(path string) (*openAPISpec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	var spec openAPISpec
+	if err := yaml.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	if !strings.HasPrefix(spec.OpenAPI, "3.") {
+		return nil, fmt.Errorf("%s: unsupported OpenAPI version %q, want 3.x", path, spec.OpenAPI)
+	}
+	return &spec, nil
+}
+
+// refName returns the component name of a local reference such as "#/components/schemas/Widget".
+func refName(ref, kind string) (string, error) {
+	prefix := "#/components/" + kind + "/"
+	if !strings.HasPrefix(ref, prefix) {
+		return "", fmt.Errorf("unsupported reference %q, want %s<name>", ref, prefix)
+	}
+	return strings.TrimPrefix(ref, prefix), nil
+}
+
+// resolveParameter follows a parameter reference.
+func (s *openAPISpec) resolveParameter(p *parameter) (*parameter, error) {
+	if p.Ref == "" {
+		return p, nil
+	}
+	name, err := refName(p.Ref, "parameters")
+	if err != nil {
+		return nil, err
+	}
+	resolved, ok := s.Components.Parameters.get(name)
+	if !ok {
+		return nil, fmt.Errorf("undefined parameter %q", p.Ref)
+	}
+	return resolved, nil
+}
+
+// resolveRequestBody follows a request body reference.
+func (s *openAPISpec) resolveRequestBody(b *requestBody) (*requestBody, error) {
+	if b.Ref == "" {
+		return b, nil
+	}
+	name, err := refName(b.Ref, "requestBodies")
+	if err != nil {
+		return nil, err
+	}
+	resolved, ok := s.Components.RequestBodies.get(name)
+	if !ok {
+		return nil, fmt.Errorf("undefined request body %q", b.Ref)
+	}
+	return resolved, nil
+}
+
+// resolveResponse follows a response reference.
+func (s *openAPISpec) resolveResponse(r *response) (*response, error) {
+	if r.Ref == "" {
+		return r, nil
+	}
+	name, err := refName(r.Ref, "responses")
+	if err != nil {
+		return nil, err
+	}
+	resolved, ok := s.Components.Responses.get(name)
+	if !ok {
+		return nil, fmt.Errorf("undefined response %q", r.Ref)
+	}
+	return resolved, nil
+}
+
+// jsonSchema returns the schema of the JSON content, or nil when there is none.
+func jsonSchema(content map[string]*mediaType) *schema {
+	keys := make([]string, 0, len(content))
+	for key := range content {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		if key == "application/json" || strings.HasSuffix(key, "+json") {
+			if content[key] != nil {
+				return content[key].Schema
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+)
+
+func TestExportedName(t *testing.T) {
+	tests := map[string]string{
+		"customer_id":          "CustomerID",
+		"createApiKey":         "CreateAPIKey",
+		"asset_ids":            "AssetIDs",
+		"auto-conversion-rule": "AutoConversionRule",
+		"KYBStatus":            "KYBStatus",
+		"3ds_result":           "X3dsResult",
+	}
+	for in, want := range tests {
+		if got := exportedName(in); got != want {
+			t.Errorf("exportedName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestGenerateFromSpec(t *testing.T) {
+	spec, err := loadSpec("testdata/openapi.yaml")
+	if err != nil {
+		t.Fatalf("loadSpec() error = %v", err)
+	}
+	services, warnings, err := buildServices(spec, nil)
+	if err != nil {
+		t.Fatalf("buildServices() error = %v", err)
+	}
+	if len(warnings) > 0 {
+		t.Errorf("buildServices() warnings = %v", warnings)
+	}
+	if len(services) != 1 || services[0].Package != "widgets" {
+		t.Fatalf("buildServices() = %d services, want the widgets service", len(services))
+	}
+
+	src, err := renderService(services[0], spec)
+	if err != nil {
+		t.Fatalf("renderService() error = %v", err)
+	}
+	if _, err := parser.ParseFile(token.NewFileSet(), "service.go", src, parser.ParseComments); err != nil {
+		t.Fatalf("generated service does not parse: %v", err)
+	}
+	for _, want := range []string{
+		"CreateWidget(ctx context.Context, id svc.CustomerID, req *CreateWidgetRequest) (*Widget, error)",
+		"ListWidgets(ctx context.Context, id svc.CustomerID, req *ListWidgetsRequest) ([]Widget, error)",
+		"DeleteWidget(ctx context.Context, id svc.CustomerID, widgetID string) error",
+		`headers["Idempotency-Key"] = req.IdempotencyKey`,
+		`params["asset_ids"] = strings.Join(req.AssetIDs, ",")`,
+		"svc.PatchJSON[*UpdateWidgetRequest, Widget](ctx, s.BaseService, path, req)",
+		"MaxTransactions *int64 `json:\"max_transactions,omitempty\"`",
+		"Widget struct {\n\t\tCreateWidgetRequest\n",
+		"// Deprecated: the operation is deprecated in the API specification.",
+	} {
+		if !strings.Contains(string(src), want) {
+			t.Errorf("generated service does not contain %q", want)
+		}
+	}
+
+	enums, err := renderEnums(services[0])
+	if err != nil {
+		t.Fatalf("renderEnums() error = %v", err)
+	}
+	if !strings.Contains(string(enums), "// ENUM(ACTIVE, PAUSED, ARCHIVED)\ntype WidgetStatus string") {
+		t.Errorf("generated enums = %s", enums)
+	}
+}
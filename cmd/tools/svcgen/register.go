@@ -0,0 +1,256 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Files patched when a service is registered, relative to the module root.
+var (
+	clientFile = filepath.Join("pkg", "onemoney", "client.go")
+	suiteFile  = filepath.Join("tests", "e2e", "suite_test.go")
+)
+
+// initAssertionPattern matches the service assertions of the e2e client initialization test.
+var initAssertionPattern = regexp.MustCompile(`^\s*s\.Require\(\)\.NotNil\(s\.Client\.(\w+),`)
+
+// registration describes a service to add to onemoney.Client.
+type registration struct {
+	// Package is the service package name.
+	Package string
+	// ImportPath is the import path of the service package.
+	ImportPath string
+	// Field is the Client field exposing the service.
+	Field string
+}
+
+// newRegistration returns the registration of a package generated into dir, which must be
+// relative to the module root.
+func newRegistration(dir, pkg string) (registration, error) {
+	dir = filepath.Clean(dir)
+	if filepath.IsAbs(dir) || strings.HasPrefix(dir, "..") {
+		return registration{}, fmt.Errorf("%s is not inside the module", dir)
+	}
+	return registration{
+		Package:    pkg,
+		ImportPath: sdkModule + "/" + filepath.ToSlash(filepath.Join(dir, pkg)),
+		Field:      exportedName(pkg),
+	}, nil
+}
+
+// registerService adds a service to onemoney.Client (import, field and constructor wiring)
+// and to the e2e initialization test, keeping each list sorted. It reports whether
+// anything changed; a service already registered is left as is.
+func registerService(root string, r registration) (bool, error) {
+	clientPath := filepath.Join(root, clientFile)
+	src, err := os.ReadFile(clientPath)
+	if err != nil {
+		return false, fmt.Errorf("failed to read client: %w", err)
+	}
+	if strings.Contains(string(src), strconv.Quote(r.ImportPath)) {
+		return false, nil
+	}
+
+	patched, err := patchClient(src, r)
+	if err != nil {
+		return false, fmt.Errorf("failed to patch %s: %w", clientFile, err)
+	}
+	if err := os.WriteFile(clientPath, patched, filePerm); err != nil {
+		return false, fmt.Errorf("failed to write %s: %w", clientFile, err)
+	}
+
+	suitePath := filepath.Join(root, suiteFile)
+	src, err = os.ReadFile(suitePath)
+	if err != nil {
+		return false, fmt.Errorf("failed to read e2e suite: %w", err)
+	}
+	patched, err = patchSuite(src, r)
+	if err != nil {
+		return false, fmt.Errorf("failed to patch %s: %w", suiteFile, err)
+	}
+	if err := os.WriteFile(suitePath, patched, filePerm); err != nil {
+		return false, fmt.Errorf("failed to write %s: %w", suiteFile, err)
+	}
+	return true, nil
+}
+
+// insertion is a line to insert into a file before the given line (1-based).
+type insertion struct {
+	line int
+	text string
+}
+
+// patchClient adds the service import, Client field and NewClient wiring to client.go.
+func patchClient(src []byte, r registration) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, clientFile, src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	var inserts []insertion
+
+	// Import, among the other service packages.
+	servicePrefix := sdkModule + "/pkg/service/"
+	var imports []sortedLine
+	for _, imp := range file.Imports {
+		if path, _ := strconv.Unquote(imp.Path.Value); strings.HasPrefix(path, servicePrefix) {
+			imports = append(imports, sortedLine{key: path, line: fset.Position(imp.Pos()).Line})
+		}
+	}
+	line, err := sortedPosition(imports, r.ImportPath)
+	if err != nil {
+		return nil, fmt.Errorf("no service imports found")
+	}
+	inserts = append(inserts, insertion{line, fmt.Sprintf("\t%q", r.ImportPath)})
+
+	// Field, among the other Service fields of Client.
+	client := findStruct(file, "Client")
+	if client == nil {
+		return nil, fmt.Errorf("type Client not found")
+	}
+	var fields []sortedLine
+	for _, field := range client.Fields.List {
+		if sel, ok := field.Type.(*ast.SelectorExpr); ok && sel.Sel.Name == "Service" && len(field.Names) == 1 {
+			fields = append(fields, sortedLine{key: field.Names[0].Name, line: fset.Position(field.Pos()).Line})
+		}
+	}
+	if line, err = sortedPosition(fields, r.Field); err != nil {
+		return nil, fmt.Errorf("no service fields found in Client")
+	}
+	inserts = append(inserts, insertion{line, fmt.Sprintf("\t%s %s.Service", r.Field, r.Package)})
+
+	// Wiring, in the Client literal returned by NewClient.
+	lit := findClientLiteral(file)
+	if lit == nil {
+		return nil, fmt.Errorf("&Client{...} literal not found in NewClient")
+	}
+	var elts []sortedLine
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		if key, ok := kv.Key.(*ast.Ident); ok && ast.IsExported(key.Name) && key.Name != "Config" {
+			elts = append(elts, sortedLine{key: key.Name, line: fset.Position(kv.Pos()).Line})
+		}
+	}
+	if line, err = sortedPosition(elts, r.Field); err != nil {
+		return nil, fmt.Errorf("no services found in the Client literal")
+	}
+	inserts = append(inserts, insertion{line, fmt.Sprintf("\t\t%s: %s.NewService(base),", r.Field, r.Package)})
+
+	return format.Source(insertLines(src, inserts))
+}
+
+// patchSuite adds the service to the assertions of the e2e client initialization test.
+func patchSuite(src []byte, r registration) ([]byte, error) {
+	var assertions []sortedLine
+	for i, l := range strings.Split(string(src), "\n") {
+		if m := initAssertionPattern.FindStringSubmatch(l); m != nil {
+			assertions = append(assertions, sortedLine{key: m[1], line: i + 1})
+		}
+	}
+	line, err := sortedPosition(assertions, r.Field)
+	if err != nil {
+		return nil, fmt.Errorf("no service assertions found")
+	}
+	text := fmt.Sprintf("\ts.Require().NotNil(s.Client.%s, %q)", r.Field, r.Field+" service should be initialized")
+	return format.Source(insertLines(src, []insertion{{line, text}}))
+}
+
+// sortedLine is an entry of a sorted list and the line it starts on.
+type sortedLine struct {
+	key  string
+	line int
+}
+
+// sortedPosition returns the line before which key belongs in a sorted list.
+func sortedPosition(list []sortedLine, key string) (int, error) {
+	if len(list) == 0 {
+		return 0, fmt.Errorf("empty list")
+	}
+	for _, entry := range list {
+		if key < entry.key {
+			return entry.line, nil
+		}
+	}
+	return list[len(list)-1].line + 1, nil
+}
+
+// insertLines inserts lines into src, each before its 1-based line number.
+func insertLines(src []byte, inserts []insertion) []byte {
+	lines := strings.Split(string(src), "\n")
+	var out []string
+	for i, l := range lines {
+		for _, ins := range inserts {
+			if ins.line == i+1 {
+				out = append(out, ins.text)
+			}
+		}
+		out = append(out, l)
+	}
+	return []byte(strings.Join(out, "\n"))
+}
+
+// findStruct returns the struct type declared with the given name.
+func findStruct(file *ast.File, name string) *ast.StructType {
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			if ts := spec.(*ast.TypeSpec); ts.Name.Name == name {
+				st, _ := ts.Type.(*ast.StructType)
+				return st
+			}
+		}
+	}
+	return nil
+}
+
+// findClientLiteral returns the Client composite literal in NewClient.
+func findClientLiteral(file *ast.File) *ast.CompositeLit {
+	var found *ast.CompositeLit
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Name.Name != "NewClient" || fn.Body == nil {
+			continue
+		}
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			if lit, ok := n.(*ast.CompositeLit); ok {
+				if ident, ok := lit.Type.(*ast.Ident); ok && ident.Name == "Client" {
+					found = lit
+					return false
+				}
+			}
+			return found == nil
+		})
+	}
+	return found
+}
@@ -0,0 +1,71 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRegisterService(t *testing.T) {
+	root := t.TempDir()
+	for _, file := range []string{clientFile, suiteFile} {
+		src, err := os.ReadFile(filepath.Join("..", "..", "..", file))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.MkdirAll(filepath.Join(root, filepath.Dir(file)), dirPerm); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(root, file), src, filePerm); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	r, err := newRegistration(filepath.Join("pkg", "service"), "widget_templates")
+	if err != nil {
+		t.Fatalf("newRegistration() error = %v", err)
+	}
+	if changed, err := registerService(root, r); err != nil || !changed {
+		t.Fatalf("registerService() = %v, %v, want true", changed, err)
+	}
+
+	client, _ := os.ReadFile(filepath.Join(root, clientFile))
+	for _, want := range []string{
+		"\t\"github.com/1Money-Co/1money-go-sdk/pkg/service/widget_templates\"\n\t\"github.com/1Money-Co/1money-go-sdk/pkg/service/withdraws\"\n",
+		"\tWidgetTemplates     widget_templates.Service\n\tWithdrawals         withdraws.Service\n",
+		"\t\tWidgetTemplates:     widget_templates.NewService(base),\n\t\tWithdrawals:",
+	} {
+		if !strings.Contains(string(client), want) {
+			t.Errorf("client.go does not contain %q", want)
+		}
+	}
+	suite, _ := os.ReadFile(filepath.Join(root, suiteFile))
+	if want := "s.Require().NotNil(s.Client.WidgetTemplates, \"WidgetTemplates service should be initialized\")\n" +
+		"\ts.Require().NotNil(s.Client.Withdrawals,"; !strings.Contains(string(suite), want) {
+		t.Errorf("suite_test.go does not contain %q", want)
+	}
+
+	if changed, err := registerService(root, r); err != nil || changed {
+		t.Errorf("registerService() again = %v, %v, want false", changed, err)
+	}
+	if _, err := newRegistration(filepath.Join("..", "elsewhere"), "widgets"); err == nil {
+		t.Error("newRegistration() outside the module succeeded")
+	}
+}
@@ -0,0 +1,416 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// licenseHeader is the header of every generated file.
+const licenseHeader = `/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+`
+
+// sdkModule is the import path of the SDK module.
+const sdkModule = "github.com/1Money-Co/1money-go-sdk"
+
+// codeWriter accumulates generated source code.
+type codeWriter struct {
+	strings.Builder
+}
+
+// line writes a formatted line.
+func (w *codeWriter) line(format string, args ...any) {
+	fmt.Fprintf(&w.Builder, format, args...)
+	w.WriteByte('\n')
+}
+
+// comment writes comment lines with the given indentation.
+func (w *codeWriter) comment(indent string, lines []string) {
+	for _, l := range lines {
+		if l == "" {
+			w.line("%s//", indent)
+		} else {
+			w.line("%s// %s", indent, l)
+		}
+	}
+}
+
+// source returns the gofmt-formatted code.
+func (w *codeWriter) source() ([]byte, error) {
+	src, err := format.Source([]byte(w.String()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to format generated code: %w\n%s", err, w.String())
+	}
+	return src, nil
+}
+
+// renderService returns the source of the service.go file of a service.
+func renderService(s *serviceModel, spec *openAPISpec) ([]byte, error) {
+	w := &codeWriter{}
+	w.line("%s", licenseHeader)
+	w.comment("", packageDoc(s, spec))
+	w.line("package %s", s.Package)
+	w.line("")
+	w.line("import (")
+	for _, imp := range s.imports() {
+		w.line("\t%q", imp)
+	}
+	w.line("")
+	w.line("\tsvc %q", sdkModule+"/pkg/service")
+	w.line(")")
+	w.line("")
+
+	w.line("// Service defines the %s service interface.", strings.Join(words(s.Name), " "))
+	w.line("type Service interface {")
+	for _, op := range s.Operations {
+		w.comment("\t", op.Doc)
+		w.line("\t%s", op.signature("\t"))
+	}
+	w.line("}")
+
+	if len(s.Types) > 0 {
+		w.line("")
+		w.line("// Request and response types.")
+		w.line("type (")
+		for i, t := range s.Types {
+			if i > 0 {
+				w.line("")
+			}
+			renderStruct(w, t)
+		}
+		w.line(")")
+	}
+
+	w.line("")
+	w.line("type serviceImpl struct {")
+	w.line("\t*svc.BaseService")
+	w.line("}")
+	w.line("")
+	w.line("// NewService creates a new %s service instance with the given base service.", s.Package)
+	w.line("func NewService(base *svc.BaseService) Service {")
+	w.line("\treturn &serviceImpl{")
+	w.line("\t\tBaseService: base,")
+	w.line("\t}")
+	w.line("}")
+
+	for _, op := range s.Operations {
+		w.line("")
+		w.comment("", op.Doc[:1])
+		w.line("func (s *serviceImpl) %s {", op.signature("func (s *serviceImpl) "))
+		renderMethodBody(w, op)
+		w.line("}")
+	}
+	return w.source()
+}
+
+// packageDoc returns the package doc comment of a service.
+func packageDoc(s *serviceModel, spec *openAPISpec) []string {
+	doc := []string{fmt.Sprintf("Package %s provides the %s API of the 1Money platform.", s.Package,
+		strings.Join(words(s.Name), " "))}
+	if lines := descriptionLines(s.Description); len(lines) > 0 {
+		doc = append(append(doc, ""), lines...)
+	}
+	doc = append(doc, "",
+		fmt.Sprintf("This package was generated by svcgen from the %s OpenAPI specification (version %s).",
+			cmpOr(spec.Info.Title, "1Money"), cmpOr(spec.Info.Version, "unknown")),
+		"",
+		"# Basic Usage",
+		"",
+		"\timport (",
+		"\t    \"context\"",
+		"\t    onemoney \""+sdkModule+"/pkg/onemoney\"",
+		"\t    \""+sdkModule+"/pkg/service/"+s.Package+"\"",
+		"\t)",
+		"",
+		"\t// Create client",
+		"\tclient, err := onemoney.NewClient(&onemoney.Config{",
+		"\t    AccessKey: \"your-access-key\",",
+		"\t    SecretKey: \"your-secret-key\",",
+		"\t})",
+	)
+	if len(s.Operations) > 0 {
+		op := s.Operations[0]
+		doc = append(doc, "", "\t"+op.exampleCall(s))
+	}
+	return doc
+}
+
+func cmpOr(s, fallback string) string {
+	if s == "" {
+		return fallback
+	}
+	return s
+}
+
+// imports returns the standard library imports of the service file.
+func (s *serviceModel) imports() []string {
+	needs := map[string]bool{"context": true}
+	for _, op := range s.Operations {
+		if len(op.PathParams) > 0 {
+			needs["fmt"] = true
+		}
+		for _, q := range op.QueryParams {
+			switch q.Type {
+			case "int", "int64", "float64":
+				needs["strconv"] = true
+			case "[]string":
+				needs["strings"] = true
+			}
+		}
+	}
+	var imports []string
+	for _, imp := range []string{"context", "fmt", "strconv", "strings"} {
+		if needs[imp] {
+			imports = append(imports, imp)
+		}
+	}
+	return imports
+}
+
+// renderStruct writes a struct type inside a type block.
+func renderStruct(w *codeWriter, t *typeModel) {
+	w.comment("\t", t.Doc)
+	w.line("\t%s struct {", t.Name)
+	for _, embedded := range t.Embedded {
+		w.line("\t\t%s", embedded)
+	}
+	if t.IdempotencyKey {
+		w.line("\t\t// IdempotencyKey is a unique key to ensure idempotent creation.")
+		w.line("\t\t// This is sent as a header, not in the body.")
+		w.line("\t\tIdempotencyKey string `json:\"-\"`")
+	}
+	for _, f := range t.Fields {
+		w.comment("\t\t", f.Doc)
+		w.line("\t\t%s %s `json:%q`", f.Name, f.Type, f.Tag)
+	}
+	w.line("\t}")
+}
+
+// maxLineLength is the length above which method signatures are wrapped, one argument per line.
+const maxLineLength = 120
+
+// signature returns the method name, arguments and results, wrapped when the line would
+// exceed maxLineLength after prefix.
+func (o *operationModel) signature(prefix string) string {
+	args := []string{"ctx context.Context"}
+	for _, p := range o.PathParams {
+		args = append(args, p.Name+" "+p.Type)
+	}
+	if o.Idempotent && o.Request == "" {
+		args = append(args, "idempotencyKey string")
+	}
+	if o.Request != "" {
+		args = append(args, "req "+o.Request)
+	}
+	sig := fmt.Sprintf("%s(%s) %s", o.Name, strings.Join(args, ", "), o.results())
+	if len(prefix)+len(sig) <= maxLineLength {
+		return sig
+	}
+	return fmt.Sprintf("%s(\n%s,\n) %s", o.Name, strings.Join(args, ",\n"), o.results())
+}
+
+// results returns the method results.
+func (o *operationModel) results() string {
+	switch {
+	case o.Response == "":
+		return "error"
+	case o.returnsValue():
+		return "(" + o.Response + ", error)"
+	default:
+		return "(*" + o.Response + ", error)"
+	}
+}
+
+// returnsValue reports whether the response is returned by value rather than as a pointer.
+func (o *operationModel) returnsValue() bool {
+	return strings.HasPrefix(o.Response, "[]") || strings.HasPrefix(o.Response, "map[") || o.Response == "any"
+}
+
+// exampleCall returns a call of the method for the package doc.
+func (o *operationModel) exampleCall(s *serviceModel) string {
+	args := []string{"ctx"}
+	for _, p := range o.PathParams {
+		args = append(args, strconv.Quote(strings.Join(words(p.Wire), "-")))
+	}
+	if o.Idempotent && o.Request == "" {
+		args = append(args, `"unique-key"`)
+	}
+	switch {
+	case strings.HasPrefix(o.Request, "*"):
+		args = append(args, "&"+s.Package+"."+strings.TrimPrefix(o.Request, "*")+"{}")
+	case o.Request != "":
+		args = append(args, "nil")
+	}
+	call := fmt.Sprintf("client.%s.%s(%s)", s.Name, o.Name, strings.Join(args, ", "))
+	if o.Response == "" {
+		return "err = " + call
+	}
+	return "resp, err := " + call
+}
+
+// renderMethodBody writes the implementation of a method.
+func renderMethodBody(w *codeWriter, o *operationModel) {
+	path := strconv.Quote(o.Path)
+	if len(o.PathParams) > 0 {
+		names := make([]string, len(o.PathParams))
+		for i, p := range o.PathParams {
+			names[i] = p.Name
+		}
+		w.line("\tpath := fmt.Sprintf(%q, %s)", pathParamPattern.ReplaceAllString(o.Path, "%s"), strings.Join(names, ", "))
+		path = "path"
+	}
+
+	respType := cmpOr(o.Response, "any")
+	reqType, reqArg := "any", "nil"
+	if o.Request != "" {
+		reqType, reqArg = o.Request, "req"
+	}
+
+	var call string
+	switch o.Method {
+	case "GET":
+		if len(o.QueryParams) == 0 {
+			call = fmt.Sprintf("svc.GetJSON[%s](ctx, s.BaseService, %s)", respType, path)
+			break
+		}
+		w.line("")
+		w.line("\tparams := make(map[string]string)")
+		w.line("\tif req != nil {")
+		for _, q := range o.QueryParams {
+			renderQueryParam(w, q)
+		}
+		w.line("\t}")
+		w.line("")
+		call = fmt.Sprintf("svc.GetJSONWithParams[%s](ctx, s.BaseService, %s, params)", respType, path)
+	case "POST":
+		if !o.Idempotent {
+			call = fmt.Sprintf("svc.PostJSON[%s, %s](ctx, s.BaseService, %s, %s)", reqType, respType, path, reqArg)
+			break
+		}
+		key := "req.IdempotencyKey"
+		if o.Request == "" {
+			key = "idempotencyKey"
+		}
+		w.line("")
+		w.line("\theaders := make(map[string]string)")
+		w.line("\tif %s != \"\" {", key)
+		w.line("\t\theaders[%q] = %s", idempotencyKeyHeader, key)
+		w.line("\t}")
+		w.line("")
+		call = fmt.Sprintf("svc.PostJSONWithHeaders[%s, %s](ctx, s.BaseService, %s, %s, headers)",
+			reqType, respType, path, reqArg)
+	case "PUT":
+		call = fmt.Sprintf("svc.PutJSON[%s, %s](ctx, s.BaseService, %s, %s)", reqType, respType, path, reqArg)
+	case "PATCH":
+		call = fmt.Sprintf("svc.PatchJSON[%s, %s](ctx, s.BaseService, %s, %s)", reqType, respType, path, reqArg)
+	case "DELETE":
+		call = fmt.Sprintf("svc.DeleteJSON[%s](ctx, s.BaseService, %s)", respType, path)
+	}
+
+	switch {
+	case o.Response == "":
+		w.line("\t_, err := %s", call)
+		w.line("\treturn err")
+	case o.returnsValue():
+		w.line("\tresult, err := %s", call)
+		w.line("\tif err != nil || result == nil {")
+		w.line("\t\treturn nil, err")
+		w.line("\t}")
+		w.line("\treturn *result, nil")
+	default:
+		w.line("\treturn %s", call)
+	}
+}
+
+// renderQueryParam writes the statement adding a request field to the query parameters.
+func renderQueryParam(w *codeWriter, q queryParamModel) {
+	field := "req." + q.Field
+	switch q.Type {
+	case "string":
+		w.line("\t\tif %s != \"\" {", field)
+		w.line("\t\t\tparams[%q] = %s", q.Wire, field)
+	case "int":
+		w.line("\t\tif %s != 0 {", field)
+		w.line("\t\t\tparams[%q] = strconv.Itoa(%s)", q.Wire, field)
+	case "int64":
+		w.line("\t\tif %s != 0 {", field)
+		w.line("\t\t\tparams[%q] = strconv.FormatInt(%s, 10)", q.Wire, field)
+	case "float64":
+		w.line("\t\tif %s != 0 {", field)
+		w.line("\t\t\tparams[%q] = strconv.FormatFloat(%s, 'f', -1, 64)", q.Wire, field)
+	case "bool":
+		w.line("\t\tif %s {", field)
+		w.line("\t\t\tparams[%q] = \"true\"", q.Wire)
+	case "[]string":
+		w.line("\t\tif len(%s) > 0 {", field)
+		w.line("\t\t\tparams[%q] = strings.Join(%s, \",\")", q.Wire, field)
+	default: // enum
+		w.line("\t\tif %s != \"\" {", field)
+		w.line("\t\t\tparams[%q] = string(%s)", q.Wire, field)
+	}
+	w.line("\t\t}")
+}
+
+// renderEnums returns the source of the enums.go file of a service, or nil when it has no enums.
+// The constants are generated from it by go-enum.
+func renderEnums(s *serviceModel) ([]byte, error) {
+	if len(s.Enums) == 0 {
+		return nil, nil
+	}
+	w := &codeWriter{}
+	w.line("%s", licenseHeader)
+	w.line("package %s", s.Package)
+	w.line("")
+	w.line("//go:generate go tool go-enum -f=$GOFILE --marshal --names --nocase")
+	for _, e := range s.Enums {
+		w.line("")
+		w.comment("", e.Doc)
+		w.line("// ENUM(%s)", strings.Join(e.Values, ", "))
+		w.line("type %s string", e.Name)
+	}
+	return w.source()
+}
+
+// writeFile writes generated source, creating its directory.
+func writeFile(path string, src []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), dirPerm); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	if err := os.WriteFile(path, src, filePerm); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,397 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// waitersFile is the name of the file generated from a waiter spec.
+const waitersFile = "waiters.go"
+
+// waiterSpec describes the status state machine of a resource and the waiters to
+// generate for it. It is read from a waiters.yaml file in the service package.
+type waiterSpec struct {
+	// Resource is the human-readable resource name, e.g. "payout batch".
+	Resource string `yaml:"resource"`
+	// Type is the response type returned by the getter, e.g. BatchResponse.
+	Type string `yaml:"type"`
+	// Getter is the Service method fetching the resource.
+	Getter string `yaml:"getter"`
+	// Params are the getter arguments after the context, e.g. "customerID svc.CustomerID".
+	// The last one identifies the resource in logs and errors.
+	Params []string `yaml:"params"`
+	// Status is the status field of the response type.
+	Status string `yaml:"status"`
+	// Condition is the name of the generated condition type, e.g. BatchCondition.
+	Condition string `yaml:"condition"`
+	// PollInterval is the default interval between polls, e.g. "5s".
+	PollInterval string `yaml:"poll_interval"`
+	// MaxWaitTime is the default maximum duration to wait, e.g. "10m".
+	MaxWaitTime string `yaml:"max_wait_time"`
+	// Waiters are the WaitForX functions to generate.
+	Waiters []waiterDef `yaml:"waiters"`
+}
+
+// waiterDef describes a WaitForX function.
+type waiterDef struct {
+	// Name is the function name without the WaitFor prefix.
+	Name string `yaml:"name"`
+	// Doc is the doc comment of the function.
+	Doc string `yaml:"doc"`
+	// Until are the status constants at which polling stops.
+	Until []string `yaml:"until"`
+	// While are the status constants during which polling continues; it is an
+	// alternative to Until.
+	While []string `yaml:"while"`
+	// Failures are the statuses returned together with an error once polling stops.
+	Failures []waiterFailure `yaml:"failures"`
+}
+
+// waiterFailure maps final statuses to an error. The message may reference {id}
+// and {status}.
+type waiterFailure struct {
+	When  []string `yaml:"when"`
+	Error string   `yaml:"error"`
+}
+
+// waiterParam is a getter argument.
+type waiterParam struct {
+	Name string
+	Type string
+}
+
+// qualifiedImports are the packages waiter parameter types may reference.
+var qualifiedImports = map[string]string{
+	"svc": sdkModule + "/pkg/service",
+}
+
+// durationPattern matches the default durations of a waiter spec.
+var durationPattern = regexp.MustCompile(`^(\d+)(ms|s|m|h)$`)
+
+// runWaiters generates the waiters.go file of the service packages whose waiter specs are given.
+func runWaiters(args []string) error {
+	fs := flag.NewFlagSet("waiters", flag.ExitOnError)
+	fs.Usage = usage
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		usage()
+		os.Exit(1)
+	}
+
+	for _, specPath := range fs.Args() {
+		src, err := generateWaiters(specPath)
+		if err != nil {
+			return err
+		}
+		path := filepath.Join(filepath.Dir(specPath), waitersFile)
+		if err := writeFile(path, src); err != nil {
+			return err
+		}
+		fmt.Printf("✅ Generated: %s\n", path)
+	}
+	return nil
+}
+
+// generateWaiters returns the source of the waiters file described by a waiter spec.
+func generateWaiters(specPath string) ([]byte, error) {
+	data, err := os.ReadFile(specPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read waiter spec: %w", err)
+	}
+	var spec waiterSpec
+	if err := yaml.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", specPath, err)
+	}
+
+	abs, err := filepath.Abs(specPath)
+	if err != nil {
+		return nil, err
+	}
+	src, err := renderWaiters(&spec, filepath.Base(filepath.Dir(abs)), filepath.Base(specPath))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", specPath, err)
+	}
+	return src, nil
+}
+
+// validate checks a waiter spec and returns its parsed parameters.
+func (s *waiterSpec) validate() ([]waiterParam, error) {
+	for name, value := range map[string]string{
+		"resource": s.Resource, "type": s.Type, "getter": s.Getter, "status": s.Status, "condition": s.Condition,
+	} {
+		if value == "" {
+			return nil, fmt.Errorf("%s is required", name)
+		}
+	}
+	if len(s.Params) == 0 {
+		return nil, fmt.Errorf("params are required")
+	}
+
+	params := make([]waiterParam, len(s.Params))
+	for i, p := range s.Params {
+		name, typ, ok := strings.Cut(strings.TrimSpace(p), " ")
+		if !ok {
+			return nil, fmt.Errorf("param %q must be \"name type\"", p)
+		}
+		params[i] = waiterParam{Name: name, Type: strings.TrimSpace(typ)}
+		if qualifier, _, ok := strings.Cut(params[i].Type, "."); ok {
+			if _, known := qualifiedImports[qualifier]; !known {
+				return nil, fmt.Errorf("param %q uses unknown package %s", p, qualifier)
+			}
+		}
+	}
+
+	for _, w := range s.Waiters {
+		if w.Name == "" {
+			return nil, fmt.Errorf("waiter name is required")
+		}
+		if (len(w.Until) == 0) == (len(w.While) == 0) {
+			return nil, fmt.Errorf("waiter %s needs exactly one of until or while", w.Name)
+		}
+		for _, f := range w.Failures {
+			if len(f.When) == 0 || f.Error == "" {
+				return nil, fmt.Errorf("waiter %s: failures need when and error", w.Name)
+			}
+		}
+	}
+	return params, nil
+}
+
+// renderWaiters returns the source of the waiters file of a package.
+func renderWaiters(s *waiterSpec, pkg, specName string) ([]byte, error) {
+	params, err := s.validate()
+	if err != nil {
+		return nil, err
+	}
+	pollInterval, err := durationExpr(s.PollInterval, "5s")
+	if err != nil {
+		return nil, err
+	}
+	maxWaitTime, err := durationExpr(s.MaxWaitTime, "10m")
+	if err != nil {
+		return nil, err
+	}
+
+	id := params[len(params)-1].Name
+	// utils.WaitFor logs the ID as a string; typed IDs such as svc.CustomerID need a conversion.
+	idArg := id
+	if params[len(params)-1].Type != "string" {
+		idArg = "string(" + id + ")"
+	}
+	v := strings.ToLower(s.Type[:1])
+	var declParams, callArgs []string
+	for _, p := range params {
+		declParams = append(declParams, fmt.Sprintf("\t%s %s,", p.Name, p.Type))
+		callArgs = append(callArgs, p.Name)
+	}
+
+	imports := []string{"context"}
+	for _, w := range s.Waiters {
+		if len(w.Failures) > 0 {
+			imports = append(imports, "fmt")
+			break
+		}
+	}
+	imports = append(imports, "time", "", "go.uber.org/zap", "")
+	imports = append(imports, strconv.Quote(sdkModule+"/internal/utils"))
+	for _, qualifier := range []string{"svc"} {
+		for _, p := range params {
+			if strings.HasPrefix(p.Type, qualifier+".") {
+				imports = append(imports, qualifier+" "+strconv.Quote(qualifiedImports[qualifier]))
+				break
+			}
+		}
+	}
+
+	w := &codeWriter{}
+	w.line("%s", licenseHeader)
+	w.line("// Code generated by svcgen waiters from %s. DO NOT EDIT.", specName)
+	w.line("")
+	w.line("package %s", pkg)
+	w.line("")
+	w.line("import (")
+	for _, imp := range imports {
+		switch {
+		case imp == "":
+			w.line("")
+		case strings.Contains(imp, `"`):
+			w.line("\t%s", imp)
+		default:
+			w.line("\t%q", imp)
+		}
+	}
+	w.line(")")
+	w.line("")
+
+	w.line("// WaitOptions configures the polling behavior for wait functions.")
+	w.line("type WaitOptions struct {")
+	w.line("\t// PollInterval is the interval between polling attempts. Default: %s.", cmpOr(s.PollInterval, "5s"))
+	w.line("\tPollInterval time.Duration")
+	w.line("\t// MaxWaitTime is the maximum duration to wait. Default: %s.", cmpOr(s.MaxWaitTime, "10m"))
+	w.line("\tMaxWaitTime time.Duration")
+	w.line("\t// Logger is an optional zap logger for logging polling progress.")
+	w.line("\tLogger *zap.Logger")
+	w.line("\t// PrintProgress prints polling progress to stdout using standard log package.")
+	w.line("\t// This is useful for examples and debugging when zap logger is not available.")
+	w.line("\tPrintProgress bool")
+	w.line("}")
+	w.line("")
+	w.line("// DefaultWaitOptions returns the default wait options.")
+	w.line("func DefaultWaitOptions() WaitOptions {")
+	w.line("\treturn WaitOptions{")
+	w.line("\t\tPollInterval: %s,", pollInterval)
+	w.line("\t\tMaxWaitTime:  %s,", maxWaitTime)
+	w.line("\t}")
+	w.line("}")
+	w.line("")
+
+	w.line("// %s is a function that checks if %s meets a condition.", s.Condition, withArticle(s.Resource))
+	w.line("type %s func(*%s) bool", s.Condition, s.Type)
+	w.line("")
+	w.line("// WaitFor polls until the condition returns true.")
+	w.line("// Returns the %s response when condition is met, or an error on timeout/failure.", s.Resource)
+	w.line("func WaitFor(")
+	w.line("\tctx context.Context,")
+	w.line("\tservice Service,")
+	w.line("%s", strings.Join(declParams, "\n"))
+	w.line("\tcondition %s,", s.Condition)
+	w.line("\topts *WaitOptions,")
+	w.line(") (*%s, error) {", s.Type)
+	w.line("\tdefaults := DefaultWaitOptions()")
+	w.line("\tif opts == nil {")
+	w.line("\t\topts = &defaults")
+	w.line("\t}")
+	w.line("")
+	w.line("\tutilOpts := &utils.WaitOptions{")
+	w.line("\t\tPollInterval:  opts.PollInterval,")
+	w.line("\t\tMaxWaitTime:   opts.MaxWaitTime,")
+	w.line("\t\tLogger:        opts.Logger,")
+	w.line("\t\tLogMessage:    %q,", "polling "+s.Resource+" status")
+	w.line("\t\tPrintProgress: opts.PrintProgress,")
+	w.line("\t}")
+	w.line("")
+	w.line("\treturn utils.WaitFor(")
+	w.line("\t\tctx,")
+	w.line("\t\tfunc(ctx context.Context) (*%s, error) {", s.Type)
+	w.line("\t\t\treturn service.%s(ctx, %s)", s.Getter, strings.Join(callArgs, ", "))
+	w.line("\t\t},")
+	w.line("\t\tutils.Condition[%s](condition),", s.Type)
+	w.line("\t\tfunc(%s *%s) string { return string(%s.%s) },", v, s.Type, v, s.Status)
+	w.line("\t\t%q,", strings.Join(words(s.Resource), "_"))
+	w.line("\t\t%s,", idArg)
+	w.line("\t\tutilOpts,")
+	w.line("\t)")
+	w.line("}")
+
+	for _, def := range s.Waiters {
+		w.line("")
+		w.comment("", descriptionLines(def.Doc))
+		w.line("func WaitFor%s(", def.Name)
+		w.line("\tctx context.Context,")
+		w.line("\tservice Service,")
+		w.line("%s", strings.Join(declParams, "\n"))
+		w.line("\topts *WaitOptions,")
+		w.line(") (*%s, error) {", s.Type)
+
+		var cond string
+		if len(def.Until) > 0 {
+			cond = statusTest(v+"."+s.Status, "==", " || ", def.Until)
+		} else {
+			cond = statusTest(v+"."+s.Status, "!=", " && ", def.While)
+		}
+		call := fmt.Sprintf("WaitFor(ctx, service, %s, func(%s *%s) bool {", strings.Join(callArgs, ", "), v, s.Type)
+		if len(def.Failures) == 0 {
+			w.line("\treturn %s", call)
+			w.line("\t\treturn %s", cond)
+			w.line("\t}, opts)")
+			w.line("}")
+			continue
+		}
+
+		w.line("\t%s, err := %s", v, call)
+		w.line("\t\treturn %s", cond)
+		w.line("\t}, opts)")
+		w.line("\tif err != nil {")
+		w.line("\t\treturn nil, err")
+		w.line("\t}")
+		w.line("")
+		w.line("\tswitch %s.%s {", v, s.Status)
+		for _, f := range def.Failures {
+			format, args := failureMessage(f.Error, id, v+"."+s.Status)
+			w.line("\tcase %s:", strings.Join(f.When, ", "))
+			w.line("\t\treturn %s, fmt.Errorf(%q%s)", v, format, args)
+		}
+		w.line("\t}")
+		w.line("\treturn %s, nil", v)
+		w.line("}")
+	}
+	return w.source()
+}
+
+// durationExpr converts a duration such as "10m" into a Go expression such as 10 * time.Minute.
+func durationExpr(s, fallback string) (string, error) {
+	s = cmpOr(s, fallback)
+	match := durationPattern.FindStringSubmatch(s)
+	if match == nil {
+		return "", fmt.Errorf("invalid duration %q, want a number followed by ms, s, m or h", s)
+	}
+	unit := map[string]string{
+		"ms": "time.Millisecond", "s": "time.Second", "m": "time.Minute", "h": "time.Hour",
+	}[match[2]]
+	if match[1] == "1" {
+		return unit, nil
+	}
+	return match[1] + " * " + unit, nil
+}
+
+// statusTest returns the comparison of a status against constants.
+func statusTest(status, op, join string, consts []string) string {
+	tests := make([]string, len(consts))
+	for i, c := range consts {
+		tests[i] = status + " " + op + " " + c
+	}
+	return strings.Join(tests, join)
+}
+
+// failureMessage converts a failure message with {id} and {status} placeholders into a
+// format string and its arguments.
+func failureMessage(message, id, status string) (string, string) {
+	var args []string
+	format := regexp.MustCompile(`\{(id|status)\}`).ReplaceAllStringFunc(message, func(m string) string {
+		if m == "{id}" {
+			args = append(args, id)
+		} else {
+			args = append(args, status)
+		}
+		return "%s"
+	})
+	if len(args) == 0 {
+		return format, ""
+	}
+	return format, ", " + strings.Join(args, ", ")
+}
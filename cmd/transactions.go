@@ -0,0 +1,215 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/1Money-Co/1money-go-sdk/cmd/output"
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/transactions"
+)
+
+// transactionColumns selects the fields shown when a transaction is printed as a table or CSV.
+const transactionColumns = `{transaction_id: transaction_id, action: transaction_action, status: status, ` +
+	`amount: amount, asset: asset, network: network, fee: transaction_fee.value, ` +
+	`fee_asset: transaction_fee.asset, created_at: created_at}`
+
+// transactionsCommand returns the transactions command with all its subcommands.
+func transactionsCommand() *cli.Command {
+	return &cli.Command{
+		Name:    "transactions",
+		Aliases: []string{"tx"},
+		Usage:   "Inspect customer transactions",
+		Description: `Lists and inspects a customer's deposits, withdrawals and conversions.
+The customer is taken from --customer or ONEMONEY_CUSTOMER_ID.
+
+Examples:
+  onemoney-cli transactions list -c CUSTOMER_ID --status PENDING
+  onemoney-cli transactions get -c CUSTOMER_ID TRANSACTION_ID`,
+		Subcommands: []*cli.Command{
+			{
+				Name:  "list",
+				Usage: "List transactions matching the filters",
+				Description: `Prints a table by default; use the global --output flag for json, yaml or csv.
+
+Examples:
+  onemoney-cli transactions list -c CUSTOMER_ID --asset USD --since 2025-01-01 --status PENDING
+  onemoney-cli -o csv transactions list -c CUSTOMER_ID --since 2025-01-01 --until 2025-02-01
+  onemoney-cli -o json transactions list -c CUSTOMER_ID --action WITHDRAWAL --sort ASC`,
+				Flags: []cli.Flag{
+					customerFlag(),
+					&cli.StringFlag{Name: "asset", Usage: "Filter by asset, e.g. USD or USDC"},
+					&cli.StringFlag{Name: "network", Usage: "Filter by network, e.g. ETHEREUM"},
+					&cli.StringFlag{Name: "action", Usage: "Filter by action: DEPOSIT, WITHDRAWAL or CONVERSION"},
+					&cli.StringFlag{Name: "status", Usage: "Filter by status: PENDING, COMPLETED, FAILED or REVERSED"},
+					&cli.StringFlag{Name: "direction", Usage: "Filter by direction: INBOUND or OUTBOUND"},
+					&cli.StringFlag{Name: "tag", Usage: "Filter by tag"},
+					&cli.StringFlag{Name: "since", Usage: "Only transactions created at or after this date (2006-01-02 or RFC3339)"},
+					&cli.StringFlag{Name: "until", Usage: "Only transactions created before this date (2006-01-02 or RFC3339)"},
+					&cli.StringFlag{Name: "sort", Usage: "Sort by creation time: ASC or DESC"},
+					&cli.IntFlag{Name: "page", Usage: "Page number, starting from 1", Value: 1},
+					&cli.IntFlag{Name: "size", Usage: "Number of transactions per page (1-100)", Value: 20},
+				},
+				Action: transactionsList,
+			},
+			{
+				Name:  "get",
+				Usage: "Get a transaction",
+				Description: `With --watch, re-renders the transaction until it is no longer PENDING.
+
+Examples:
+  onemoney-cli transactions get -c CUSTOMER_ID TRANSACTION_ID
+  onemoney-cli -o table transactions get -c CUSTOMER_ID --watch TRANSACTION_ID`,
+				ArgsUsage: "<transaction-id>",
+				Flags: append([]cli.Flag{
+					customerFlag(),
+				}, watchFlags(
+					transactions.DefaultWaitOptions().PollInterval, transactions.DefaultWaitOptions().MaxWaitTime,
+				)...),
+				Action: transactionsGet,
+			},
+		},
+	}
+}
+
+func transactionsList(c *cli.Context) error {
+	req, err := transactionsFilter(c)
+	if err != nil {
+		return err
+	}
+
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	ctx := context.Background()
+
+	resp, err := client.Transactions.ListTransactions(ctx, customerIDFlag(c), req)
+	if err != nil {
+		return fmt.Errorf("failed to list transactions: %w", err)
+	}
+
+	return printView(resp, output.View{Format: output.FormatTable, Columns: "list[*]." + transactionColumns})
+}
+
+func transactionsGet(c *cli.Context) error {
+	transactionID := svc.TransactionID(c.Args().First())
+	if transactionID == "" {
+		return errors.New("transaction ID is required")
+	}
+
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	ctx := context.Background()
+	view := output.View{Columns: transactionColumns}
+
+	if c.Bool("watch") {
+		ctx, cancel := watchContext()
+		defer cancel()
+
+		r := &watchRenderer{view: view}
+		_, err := transactions.WaitFor(ctx, client.Transactions, customerIDFlag(c), transactionID,
+			func(tx *transactions.TransactionResponse) bool {
+				r.render(tx)
+				return tx.Status != transactions.TransactionStatusPENDING
+			},
+			&transactions.WaitOptions{
+				PollInterval: c.Duration("poll-interval"),
+				MaxWaitTime:  c.Duration("max-wait"),
+			},
+		)
+		return watchDone(r, err)
+	}
+
+	resp, err := client.Transactions.GetTransaction(ctx, customerIDFlag(c), transactionID)
+	if err != nil {
+		return fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	return printView(resp, view)
+}
+
+// transactionsFilter builds a list request from the list command's flags.
+func transactionsFilter(c *cli.Context) (*transactions.ListTransactionsRequest, error) {
+	req := &transactions.ListTransactionsRequest{
+		Tag:  c.String("tag"),
+		Page: c.Int("page"),
+		Size: c.Int("size"),
+	}
+
+	var err error
+	if v := c.String("asset"); v != "" {
+		if req.Asset, err = assets.ParseAssetName(v); err != nil {
+			return nil, err
+		}
+	}
+	if v := c.String("network"); v != "" {
+		if req.Network, err = assets.ParseNetworkName(v); err != nil {
+			return nil, err
+		}
+	}
+	if v := c.String("action"); v != "" {
+		if req.TransactionAction, err = transactions.ParseTransactionAction(v); err != nil {
+			return nil, err
+		}
+	}
+	if v := c.String("status"); v != "" {
+		if req.Status, err = transactions.ParseTransactionStatus(v); err != nil {
+			return nil, err
+		}
+	}
+	if v := c.String("direction"); v != "" {
+		if req.Direction, err = transactions.ParseTransactionDirection(v); err != nil {
+			return nil, err
+		}
+	}
+	if v := c.String("sort"); v != "" {
+		if req.SortOrder, err = assets.ParseSortOrder(v); err != nil {
+			return nil, err
+		}
+	}
+	if v := c.String("since"); v != "" {
+		if req.StartTime, err = parseDate(v); err != nil {
+			return nil, fmt.Errorf("invalid --since: %w", err)
+		}
+	}
+	if v := c.String("until"); v != "" {
+		if req.EndTime, err = parseDate(v); err != nil {
+			return nil, fmt.Errorf("invalid --until: %w", err)
+		}
+	}
+	return req, nil
+}
+
+// parseDate parses a date (2006-01-02, taken as UTC midnight) or an RFC3339 timestamp.
+func parseDate(s string) (time.Time, error) {
+	if t, err := time.Parse(time.DateOnly, s); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
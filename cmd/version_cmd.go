@@ -28,6 +28,11 @@ func versionCommand() *cli.Command {
 		Name:    "version",
 		Aliases: []string{"v"},
 		Usage:   "Show version information",
+		Description: `Prints the CLI version, git commit, build date and Go version.
+
+Examples:
+  onemoney-cli version
+  onemoney-cli version --short`,
 		Flags: []cli.Flag{
 			&cli.BoolFlag{
 				Name:    "short",
@@ -0,0 +1,107 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/1Money-Co/1money-go-sdk/cmd/output"
+)
+
+// watchFlags returns the flags of commands that support --watch, with the given polling defaults.
+func watchFlags(pollInterval, maxWait time.Duration) []cli.Flag {
+	return []cli.Flag{
+		&cli.BoolFlag{
+			Name:  "watch",
+			Usage: "Poll and re-render until a terminal state is reached",
+		},
+		&cli.DurationFlag{
+			Name:  "poll-interval",
+			Usage: "Interval between polls",
+			Value: pollInterval,
+		},
+		&cli.DurationFlag{
+			Name:  "max-wait",
+			Usage: "Maximum time to poll before giving up",
+			Value: maxWait,
+		},
+	}
+}
+
+// watchContext returns a context that is cancelled on Ctrl-C, so that watching stops cleanly.
+func watchContext() (context.Context, context.CancelFunc) {
+	return signal.NotifyContext(context.Background(), os.Interrupt)
+}
+
+// watchDone converts the result of a watch into the command's error: an interrupt is a
+// normal way to stop watching, and rendering errors take precedence over polling errors.
+func watchDone(r *watchRenderer, err error) error {
+	if r.err != nil {
+		return r.err
+	}
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+	return err
+}
+
+// watchRenderer prints a value each time a poll observes a change. On a terminal it
+// clears the screen first, so the output is re-rendered in place.
+type watchRenderer struct {
+	view output.View
+	last string
+	err  error
+}
+
+// render prints v in the user's output format if it differs from the last render.
+// Errors are kept in r.err because SDK waiter conditions cannot return them.
+func (r *watchRenderer) render(v any) {
+	var buf bytes.Buffer
+	p := *printer
+	p.Out = &buf
+	if err := p.Print(v, r.view); err != nil {
+		r.err = err
+		return
+	}
+	r.show(buf.String())
+}
+
+// show prints text if it differs from the last render.
+func (r *watchRenderer) show(text string) {
+	if text == r.last {
+		return
+	}
+	r.last = text
+	if isTerminal(os.Stdout) {
+		fmt.Print("\033[H\033[2J")
+	}
+	fmt.Print(text)
+}
+
+// isTerminal reports whether f is a terminal rather than a pipe or file.
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
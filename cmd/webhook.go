@@ -0,0 +1,205 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/onemoney"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/events"
+)
+
+const (
+	// defaultEventPollInterval is how often "webhook poll" polls the event feed.
+	defaultEventPollInterval = 2 * time.Second
+	// forwardTimeout bounds each forwarded request, so a hung local server does not stall the feed.
+	forwardTimeout = 10 * time.Second
+)
+
+// webhookCommand returns the webhook command with all its subcommands.
+func webhookCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "webhook",
+		Usage: "Receive account events during local development",
+		Description: `Examples:
+  onemoney-cli webhook poll -c CUSTOMER_ID --forward-to http://localhost:8080/hooks`,
+		Subcommands: []*cli.Command{
+			{
+				Name:    "poll",
+				Aliases: []string{"listen"},
+				Usage:   "Poll the event feed for new account events and optionally forward them to a local URL",
+				Description: `Polls the customer's event feed with your API credentials. It does not register a webhook
+subscription and does not receive or verify signed webhook deliveries, so no public endpoint
+is needed, and the events are trusted because they come from the authenticated API. Each
+event is printed to stdout. With --forward-to, it is also POSTed as JSON to the given URL
+with the X-OneMoney-Event-ID and X-OneMoney-Event-Type headers, and the response status is
+printed to stderr. Forwarded requests are not signed, so handlers that verify webhook
+signatures must skip verification for them.
+
+Only events after polling starts are shown, unless --since or --replay is given.
+On exit the last cursor is printed so that the next run can resume with --since.
+
+Examples:
+  onemoney-cli webhook poll -c CUSTOMER_ID
+  onemoney-cli webhook poll -c CUSTOMER_ID --forward-to http://localhost:8080/hooks
+  onemoney-cli webhook poll -c CUSTOMER_ID --event-type TRANSACTION_STATUS_CHANGED --event-type KYB_STATUS_CHANGED
+  onemoney-cli webhook poll -c CUSTOMER_ID --since CURSOR --forward-to http://localhost:8080/hooks`,
+				Flags: []cli.Flag{
+					customerFlag(),
+					&cli.StringFlag{Name: "forward-to", Usage: "URL to POST each event to, e.g. http://localhost:8080/hooks"},
+					&cli.StringSliceFlag{Name: "event-type", Usage: "Only show events of this type (repeatable)"},
+					&cli.StringFlag{Name: "since", Usage: "Resume after this event cursor"},
+					&cli.BoolFlag{Name: "replay", Usage: "Start from the beginning of the retained event feed"},
+					&cli.DurationFlag{
+						Name:  "poll-interval",
+						Usage: "Interval between polls of the event feed",
+						Value: defaultEventPollInterval,
+					},
+				},
+				Action: webhookPoll,
+			},
+		},
+	}
+}
+
+func webhookPoll(c *cli.Context) error {
+	forwardTo := c.String("forward-to")
+	if forwardTo != "" {
+		if u, err := url.Parse(forwardTo); err != nil || u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("--forward-to must be an absolute URL, got %q", forwardTo)
+		}
+	}
+	if c.IsSet("since") && c.Bool("replay") {
+		return errors.New("--since and --replay cannot be used together")
+	}
+
+	var types []events.EventType
+	for _, name := range c.StringSlice("event-type") {
+		t, err := events.ParseEventType(name)
+		if err != nil {
+			return err
+		}
+		types = append(types, t)
+	}
+
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	ctx, stop := watchContext()
+	defer stop()
+	customerID := customerIDFlag(c)
+
+	cursor := c.String("since")
+	if cursor == "" && !c.Bool("replay") {
+		if cursor, err = latestEventCursor(ctx, client, customerID); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+	}
+
+	fmt.Fprintf(os.Stderr, "Polling events of customer %s (Ctrl-C to stop)\n", customerID)
+	defer func() {
+		if cursor != "" {
+			fmt.Fprintf(os.Stderr, "Resume with: --since %s\n", cursor)
+		}
+	}()
+
+	forwarder := &http.Client{Timeout: forwardTimeout}
+	for {
+		for event, err := range client.Events.All(ctx, customerID, cursor) {
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+				break
+			}
+			cursor = event.Cursor
+
+			if len(types) > 0 && !slices.Contains(types, event.Type) {
+				continue
+			}
+			if err := printOutput(event); err != nil {
+				return err
+			}
+			if forwardTo != "" {
+				forwardEvent(ctx, forwarder, forwardTo, event)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(c.Duration("poll-interval")):
+		}
+	}
+}
+
+// latestEventCursor returns the cursor of the newest event in the feed, so that polling
+// starts after the events that already happened. It is empty when the feed is empty.
+func latestEventCursor(ctx context.Context, client *onemoney.Client, customerID string) (string, error) {
+	event, err := client.Events.Latest(ctx, customerID)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the newest event: %w", err)
+	}
+	if event == nil {
+		return "", nil
+	}
+	return event.Cursor, nil
+}
+
+// forwardEvent POSTs an event to the forward URL and reports the outcome on stderr.
+// Failures are reported but do not stop polling.
+func forwardEvent(ctx context.Context, client *http.Client, target string, event *events.Event) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to encode event %s: %v\n", event.EventID, err)
+		return
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(body))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to forward event %s: %v\n", event.EventID, err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-OneMoney-Event-ID", event.EventID)
+	req.Header.Set("X-OneMoney-Event-Type", event.Type.String())
+
+	resp, err := client.Do(req)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "<-- %s %s failed: %v\n", event.EventID, event.Type, err)
+		return
+	}
+	resp.Body.Close()
+	fmt.Fprintf(os.Stderr, "<-- %s %s [%d] POST %s\n", event.EventID, event.Type, resp.StatusCode, target)
+}
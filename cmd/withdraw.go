@@ -0,0 +1,147 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/google/uuid"
+	"github.com/urfave/cli/v2"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/withdraws"
+)
+
+// withdrawCommand returns the withdraw command with all its subcommands.
+func withdrawCommand() *cli.Command {
+	return &cli.Command{
+		Name:    "withdraw",
+		Aliases: []string{"w"},
+		Usage:   "Withdraw funds to a bank account or wallet",
+		Description: `Examples:
+  onemoney-cli withdraw create -c CUSTOMER_ID --amount 1000 --asset USD --network US_ACH --external-account ID`,
+		Subcommands: []*cli.Command{
+			{
+				Name:  "create",
+				Usage: "Create a withdrawal after confirming the estimated fees",
+				Description: `Estimates fees, the net amount and the settlement window, then asks for confirmation.
+Use --external-account for fiat withdrawals and --address for crypto withdrawals.
+Reuse the printed idempotency key to retry safely.
+
+Examples:
+  onemoney-cli withdraw create -c CUSTOMER_ID --amount 1000 --asset USD --network US_ACH \
+    --external-account EXTERNAL_ACCOUNT_ID
+  onemoney-cli withdraw create -c CUSTOMER_ID --amount 250 --asset USDC --network ETHEREUM \
+    --address 0x... --idempotency-key payout-2025-01-31 --yes`,
+				Flags: []cli.Flag{
+					customerFlag(),
+					&cli.StringFlag{Name: "amount", Usage: "Amount to withdraw", Required: true},
+					&cli.StringFlag{Name: "asset", Usage: "Asset to withdraw, e.g. USD or USDC", Required: true},
+					&cli.StringFlag{Name: "network", Usage: "Network, e.g. US_ACH, SWIFT or ETHEREUM", Required: true},
+					&cli.StringFlag{Name: "address", Usage: "Destination wallet address (crypto withdrawals)"},
+					&cli.StringFlag{Name: "external-account", Usage: "Destination external account ID (fiat withdrawals)"},
+					&cli.StringFlag{Name: "idempotency-key", Usage: "Idempotency key (default: a random UUID)"},
+					yesFlag(),
+				},
+				Action: withdrawCreate,
+			},
+		},
+	}
+}
+
+func withdrawCreate(c *cli.Context) error {
+	req, err := withdrawRequest(c)
+	if err != nil {
+		return err
+	}
+
+	client, err := createClient()
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	ctx := context.Background()
+	customerID := customerIDFlag(c)
+
+	estimate, err := client.Withdrawals.EstimateFee(ctx, customerID, req.Asset, req.Network, req.Amount)
+	if err != nil {
+		return fmt.Errorf("failed to estimate withdrawal fee: %w", err)
+	}
+
+	destination := req.WalletAddress
+	if destination == "" {
+		destination = "external account " + req.ExternalAccountID
+	}
+
+	w := tabwriter.NewWriter(os.Stderr, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Amount:\t%s %s\n", estimate.Amount, estimate.Asset)
+	fmt.Fprintf(w, "Network:\t%s\n", estimate.Network)
+	fmt.Fprintf(w, "Destination:\t%s\n", destination)
+	fmt.Fprintf(w, "Network fee:\t%s %s\n", estimate.NetworkFee.Value, estimate.NetworkFee.Asset)
+	fmt.Fprintf(w, "Platform fee:\t%s %s\n", estimate.PlatformFee.Value, estimate.PlatformFee.Asset)
+	fmt.Fprintf(w, "Total fee:\t%s %s\n", estimate.TotalFee.Value, estimate.TotalFee.Asset)
+	fmt.Fprintf(w, "Recipient gets:\t%s %s\n", estimate.NetAmount, estimate.Asset)
+	fmt.Fprintf(w, "Settles in:\t%s - %s\n", estimate.ETA.Min(), estimate.ETA.Max())
+	fmt.Fprintf(w, "Idempotency key:\t%s\n", req.IdempotencyKey)
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	ok, err := confirm(c, "Submit this withdrawal?")
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.New("withdrawal cancelled")
+	}
+
+	resp, err := client.Withdrawals.CreateWithdrawal(ctx, customerID, req)
+	if err != nil {
+		return fmt.Errorf("failed to create withdrawal: %w", err)
+	}
+
+	return printOutput(resp)
+}
+
+// withdrawRequest builds a withdrawal request from the create command's flags.
+func withdrawRequest(c *cli.Context) (*withdraws.CreateWithdrawalRequest, error) {
+	req := &withdraws.CreateWithdrawalRequest{
+		IdempotencyKey:    c.String("idempotency-key"),
+		Amount:            c.String("amount"),
+		WalletAddress:     c.String("address"),
+		ExternalAccountID: c.String("external-account"),
+	}
+	if req.IdempotencyKey == "" {
+		req.IdempotencyKey = uuid.NewString()
+	}
+	if (req.WalletAddress == "") == (req.ExternalAccountID == "") {
+		return nil, errors.New("exactly one of --address or --external-account is required")
+	}
+
+	var err error
+	if req.Asset, err = assets.ParseAssetName(c.String("asset")); err != nil {
+		return nil, err
+	}
+	if req.Network, err = assets.ParseNetworkName(c.String("network")); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
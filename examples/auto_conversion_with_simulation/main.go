@@ -22,14 +22,15 @@
 //   - Wait for the rule to become ACTIVE and deposit info to be ready
 //   - Get the reference code from deposit info (required to trigger the rule!)
 //   - Simulate a USD deposit with the reference code
-//   - Poll for auto conversion orders created by the rule
+//   - Wait for the auto conversion order triggered by the deposit to complete
 //
 // Key concept: The reference code is essential for triggering auto conversion.
 // Without it, deposits go to the customer's balance but won't trigger the rule.
 //
 // Prerequisites:
 //   - Set ONEMONEY_ACCESS_KEY and ONEMONEY_SECRET_KEY environment variables
-//   - Set ONEMONEY_CUSTOMER_ID to an existing customer ID
+//   - Optionally set ONEMONEY_CUSTOMER_ID to an existing customer ID; a funded sandbox
+//     customer is bootstrapped otherwise
 //   - Set ONEMONEY_TEST_WALLET_ADDRESS for the destination wallet
 //
 // Run:
@@ -39,7 +40,6 @@ package main
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"os"
 	"time"
@@ -48,8 +48,10 @@ import (
 	"github.com/joho/godotenv"
 
 	"github.com/1Money-Co/1money-go-sdk/pkg/onemoney"
+	"github.com/1Money-Co/1money-go-sdk/pkg/sandbox"
 	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
 	"github.com/1Money-Co/1money-go-sdk/pkg/service/auto_conversion_rules"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/customer"
 	"github.com/1Money-Co/1money-go-sdk/pkg/service/simulations"
 )
 
@@ -57,16 +59,25 @@ func main() {
 	_ = godotenv.Load()
 	ctx := context.Background()
 
-	customerID := os.Getenv("ONEMONEY_CUSTOMER_ID")
-	if customerID == "" {
-		log.Fatal("ONEMONEY_CUSTOMER_ID environment variable is required")
-	}
-
 	client, err := onemoney.NewClient(&onemoney.Config{})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
 
+	// Without a customer ID, bootstrap a funded sandbox customer
+	customerID := os.Getenv("ONEMONEY_CUSTOMER_ID")
+	if customerID == "" {
+		log.Println("ONEMONEY_CUSTOMER_ID not set, bootstrapping a sandbox customer")
+		account, err := sandbox.Bootstrap(ctx, client, &sandbox.Options{
+			WaitOptions: &customer.WaitOptions{PrintProgress: true},
+		})
+		if err != nil {
+			log.Fatalf("failed to bootstrap sandbox customer: %v", err)
+		}
+		customerID = account.CustomerID
+		log.Printf("sandbox customer ready: customer_id=%s", customerID)
+	}
+
 	log.Printf("starting auto conversion rule demo: customer_id=%s", customerID)
 
 	// Step 1: Create a fiat→crypto auto conversion rule (USD ACH → USDC Polygon).
@@ -108,12 +119,24 @@ func main() {
 	log.Printf("USD deposit simulated: simulation_id=%s status=%s amount=50.00 USD",
 		simResp.SimulationID, simResp.Status)
 
-	// Step 4: Poll for auto conversion orders created by this rule.
-	// The rule should automatically create an order when the deposit arrives.
-	log.Println("step 4: polling for auto conversion orders (rule should trigger automatically)")
-	if err := waitForAutoConversionOrder(ctx, client, customerID, ruleID); err != nil {
-		log.Printf("WARNING: no auto conversion orders detected within timeout: %v", err)
+	// Step 4: Wait for the auto conversion order triggered by this deposit, then for it to complete.
+	// The simulation ID is the deposit transaction ID.
+	log.Println("step 4: waiting for the auto conversion order (rule should trigger automatically)")
+	waitOpts := &auto_conversion_rules.WaitOptions{PrintProgress: true, PollInterval: 1 * time.Second, MaxWaitTime: 2 * time.Minute}
+	order, err := auto_conversion_rules.WaitForOrderCreated(ctx, client.AutoConversionRules, customerID,
+		simResp.SimulationID, waitOpts)
+	if err != nil {
+		log.Fatalf("no auto conversion order detected: %v", err)
+	}
+	log.Printf("auto conversion order created: order_id=%s status=%s initial_amount=%s initial_asset=%s",
+		order.AutoConversionOrderID, order.Status, order.Receipt.Initial.Amount, order.Receipt.Initial.Asset)
+
+	order, err = auto_conversion_rules.WaitForOrderCompleted(ctx, client.AutoConversionRules, customerID, ruleID,
+		order.AutoConversionOrderID, waitOpts)
+	if err != nil {
+		log.Fatalf("auto conversion order did not complete: %v", err)
 	}
+	log.Printf("auto conversion order completed: order_id=%s status=%s", order.AutoConversionOrderID, order.Status)
 
 	log.Println("")
 	log.Println("=== workflow complete ===")
@@ -197,52 +220,3 @@ func logDepositInfo(rule *auto_conversion_rules.RuleResponse) string {
 
 	return ""
 }
-
-// waitForAutoConversionOrder polls for orders created by the given rule.
-func waitForAutoConversionOrder(
-	ctx context.Context,
-	client *onemoney.Client,
-	customerID, ruleID string,
-) error {
-	const (
-		pollInterval = 1 * time.Second
-		maxWaitTime  = 60 * time.Second
-	)
-
-	start := time.Now()
-	deadline := start.Add(maxWaitTime)
-
-	for time.Now().Before(deadline) {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		default:
-		}
-
-		orders, err := client.AutoConversionRules.ListOrders(ctx, customerID, ruleID, &auto_conversion_rules.ListOrdersRequest{
-			Page: 1,
-			Size: 10,
-		})
-		if err != nil {
-			return fmt.Errorf("failed to list orders: %w", err)
-		}
-
-		log.Printf("polling auto conversion rule_id=%s orders: elapsed=%.1fs count=%d",
-			ruleID, time.Since(start).Seconds(), len(orders.Items))
-
-		if len(orders.Items) > 0 {
-			log.Printf("auto conversion orders found: rule_id=%s total=%d returned=%d",
-				ruleID, orders.Total, len(orders.Items))
-			for i := range orders.Items {
-				order := &orders.Items[i]
-				log.Printf("auto conversion order: order_id=%s status=%s initial_amount=%s initial_asset=%s",
-					order.AutoConversionOrderID, order.Status, order.Receipt.Initial.Amount, order.Receipt.Initial.Asset)
-			}
-			return nil
-		}
-
-		time.Sleep(pollInterval)
-	}
-
-	return fmt.Errorf("timeout waiting for auto conversion orders after %v", maxWaitTime)
-}
@@ -23,7 +23,8 @@
 //
 // Prerequisites:
 //   - Set ONEMONEY_ACCESS_KEY and ONEMONEY_SECRET_KEY environment variables
-//   - Set CUSTOMER_ID environment variable (from create_customer example)
+//   - Optionally set ONEMONEY_CUSTOMER_ID (from create_customer example); a funded sandbox
+//     customer is bootstrapped otherwise
 //   - Optionally set WALLET_ADDRESS for the destination wallet
 //
 // Run: go run ./examples/fiat_to_usdc_withdrawal
@@ -38,8 +39,10 @@ import (
 	"github.com/joho/godotenv"
 
 	"github.com/1Money-Co/1money-go-sdk/pkg/onemoney"
+	"github.com/1Money-Co/1money-go-sdk/pkg/sandbox"
 	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
 	"github.com/1Money-Co/1money-go-sdk/pkg/service/conversions"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/customer"
 	"github.com/1Money-Co/1money-go-sdk/pkg/service/simulations"
 	"github.com/1Money-Co/1money-go-sdk/pkg/service/transactions"
 	"github.com/1Money-Co/1money-go-sdk/pkg/service/withdraws"
@@ -50,11 +53,6 @@ func main() {
 	_ = godotenv.Load()
 	ctx := context.Background()
 
-	customerID := os.Getenv("ONEMONEY_CUSTOMER_ID")
-	if customerID == "" {
-		log.Fatal("ONEMONEY_CUSTOMER_ID environment variable is required")
-	}
-
 	withdrawalWalletAddress := os.Getenv("ONEMONEY_TEST_WALLET_ADDRESS")
 	if withdrawalWalletAddress == "" {
 		log.Fatalf("missing wallet address: %s", withdrawalWalletAddress)
@@ -65,6 +63,20 @@ func main() {
 		log.Fatalf("failed to create client: %v", err)
 	}
 
+	// Without a customer ID, bootstrap a funded sandbox customer
+	customerID := os.Getenv("ONEMONEY_CUSTOMER_ID")
+	if customerID == "" {
+		log.Println("ONEMONEY_CUSTOMER_ID not set, bootstrapping a sandbox customer")
+		account, err := sandbox.Bootstrap(ctx, client, &sandbox.Options{
+			WaitOptions: &customer.WaitOptions{PrintProgress: true},
+		})
+		if err != nil {
+			log.Fatalf("failed to bootstrap sandbox customer: %v", err)
+		}
+		customerID = account.CustomerID
+		log.Printf("sandbox customer ready: customer_id=%s", customerID)
+	}
+
 	// Step 1: Simulate USD fiat deposit (sandbox only)
 	log.Println("step 1: simulating USD deposit")
 	depositResp, err := client.Simulations.SimulateDeposit(ctx, customerID, &simulations.SimulateDepositRequest{
@@ -24,7 +24,8 @@
 //
 // Prerequisites:
 //   - Set ONEMONEY_ACCESS_KEY and ONEMONEY_SECRET_KEY environment variables
-//   - Set ONEMONEY_CUSTOMER_ID environment variable (from create_customer example)
+//   - Optionally set ONEMONEY_CUSTOMER_ID (from create_customer example); a funded sandbox
+//     customer is bootstrapped otherwise
 //
 // Run: go run ./examples/usdc_to_fiat_withdrawal
 package main
@@ -39,8 +40,10 @@ import (
 	"github.com/joho/godotenv"
 
 	"github.com/1Money-Co/1money-go-sdk/pkg/onemoney"
+	"github.com/1Money-Co/1money-go-sdk/pkg/sandbox"
 	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
 	"github.com/1Money-Co/1money-go-sdk/pkg/service/conversions"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/customer"
 	"github.com/1Money-Co/1money-go-sdk/pkg/service/external_accounts"
 	"github.com/1Money-Co/1money-go-sdk/pkg/service/simulations"
 	"github.com/1Money-Co/1money-go-sdk/pkg/service/transactions"
@@ -52,16 +55,25 @@ func main() {
 	_ = godotenv.Load()
 	ctx := context.Background()
 
-	customerID := os.Getenv("ONEMONEY_CUSTOMER_ID")
-	if customerID == "" {
-		log.Fatal("ONEMONEY_CUSTOMER_ID environment variable is required")
-	}
-
 	client, err := onemoney.NewClient(&onemoney.Config{})
 	if err != nil {
 		log.Fatalf("failed to create client: %v", err)
 	}
 
+	// Without a customer ID, bootstrap a funded sandbox customer
+	customerID := os.Getenv("ONEMONEY_CUSTOMER_ID")
+	if customerID == "" {
+		log.Println("ONEMONEY_CUSTOMER_ID not set, bootstrapping a sandbox customer")
+		account, err := sandbox.Bootstrap(ctx, client, &sandbox.Options{
+			WaitOptions: &customer.WaitOptions{PrintProgress: true},
+		})
+		if err != nil {
+			log.Fatalf("failed to bootstrap sandbox customer: %v", err)
+		}
+		customerID = account.CustomerID
+		log.Printf("sandbox customer ready: customer_id=%s", customerID)
+	}
+
 	// Step 1: Simulate USDC crypto deposit (sandbox only)
 	log.Println("step 1: simulating USDC deposit on Polygon")
 	depositResp, err := client.Simulations.SimulateDeposit(ctx, customerID, &simulations.SimulateDepositRequest{
@@ -163,15 +175,14 @@ func main() {
 
 	// Wait for withdrawal to settle (PENDING means ACH transfer is in progress)
 	// Note: In production, ACH transfers typically take 1-3 business days
-	if withdrawal.Status == string(transactions.TransactionStatusPENDING) {
+	if withdrawal.Status == withdraws.TransactionStatusPENDING {
 		log.Println("withdrawal is processing (ACH transfer in progress)...")
-		var tx *transactions.TransactionResponse
-		tx, err = transactions.WaitForSettled(ctx, client.Transactions, customerID, withdrawal.TransactionID,
-			&transactions.WaitOptions{PrintProgress: true})
+		withdrawal, err = withdraws.WaitForSettled(ctx, client.Withdrawals, customerID, withdrawal.TransactionID,
+			&withdraws.WaitOptions{PrintProgress: true})
 		if err != nil {
 			log.Fatalf("withdrawal failed: %v", err)
 		}
-		log.Printf("withdrawal completed: status=%s", tx.Status)
+		log.Printf("withdrawal completed: status=%s trace_number=%s", withdrawal.Status, withdrawal.Reference())
 	}
 
 	// Step 6: Show updated balances
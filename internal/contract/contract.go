@@ -0,0 +1,164 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package contract checks SDK response types against the JSON the API sends.
+//
+// encoding/json silently drops object members that a struct has no field for, so a
+// field the server starts sending is lost without any error. UnknownFields decodes a
+// response into its SDK type and reports those members by path, so tests can flag the
+// drift. The JSON comes from recorded responses or is synthesized from the OpenAPI
+// specification of the endpoint; see Spec.
+package contract
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+var (
+	jsonUnmarshalerType = reflect.TypeFor[json.Unmarshaler]()
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+	rawMessageType      = reflect.TypeFor[json.RawMessage]()
+)
+
+// UnknownFields decodes data into v, which must be a pointer, and returns the paths of
+// the object members that v has no field for, e.g. "list[].fee.network", sorted. Values
+// of types with their own UnmarshalJSON or UnmarshalText are not inspected.
+// A decoding error, such as a type mismatch, is returned as is.
+func UnknownFields(data []byte, v any) ([]string, error) {
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+	var raw any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	walk("", raw, reflect.TypeOf(v), seen)
+
+	unknown := make([]string, 0, len(seen))
+	for path := range seen {
+		unknown = append(unknown, path)
+	}
+	slices.Sort(unknown)
+	return unknown, nil
+}
+
+// walk records in unknown the members of value that have no field in t.
+func walk(path string, value any, t reflect.Type, unknown map[string]bool) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if opaque(t) {
+		return
+	}
+
+	switch value := value.(type) {
+	case map[string]any:
+		switch t.Kind() {
+		case reflect.Struct:
+			fields := jsonFields(t)
+			for key, member := range value {
+				field, ok := lookup(fields, key)
+				if !ok {
+					unknown[join(path, key)] = true
+					continue
+				}
+				walk(join(path, key), member, field, unknown)
+			}
+		case reflect.Map:
+			for key, member := range value {
+				walk(join(path, key), member, t.Elem(), unknown)
+			}
+		}
+	case []any:
+		if t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+			for _, elem := range value {
+				walk(path+"[]", elem, t.Elem(), unknown)
+			}
+		}
+	}
+}
+
+// opaque reports whether values of t are decoded by custom code or hold arbitrary JSON.
+func opaque(t reflect.Type) bool {
+	if t.Kind() == reflect.Interface || t == rawMessageType {
+		return true
+	}
+	ptr := reflect.PointerTo(t)
+	return ptr.Implements(jsonUnmarshalerType) || ptr.Implements(textUnmarshalerType)
+}
+
+// jsonFields returns the types of the fields of struct t by JSON name, including
+// the fields promoted from embedded structs.
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := make(map[string]reflect.Type)
+	for i := range t.NumField() {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+
+		ft := f.Type
+		if ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		if f.Anonymous && name == "" && ft.Kind() == reflect.Struct {
+			for embedded, et := range jsonFields(ft) {
+				if _, ok := fields[embedded]; !ok {
+					fields[embedded] = et
+				}
+			}
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = f.Type
+	}
+	return fields
+}
+
+// lookup finds the field for a JSON key, preferring an exact match and otherwise
+// matching case-insensitively, as encoding/json does.
+func lookup(fields map[string]reflect.Type, key string) (reflect.Type, bool) {
+	if t, ok := fields[key]; ok {
+		return t, true
+	}
+	for name, t := range fields {
+		if strings.EqualFold(name, key) {
+			return t, true
+		}
+	}
+	return nil, false
+}
+
+func join(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return fmt.Sprintf("%s.%s", path, key)
+}
@@ -0,0 +1,175 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package contract
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type status string
+
+func (s *status) UnmarshalText(text []byte) error {
+	*s = status(text)
+	return nil
+}
+
+type base struct {
+	ID string `json:"id"`
+}
+
+type widget struct {
+	base
+	Name     string           `json:"name"`
+	Status   status           `json:"status"`
+	Parts    []part           `json:"parts"`
+	Labels   map[string]*part `json:"labels,omitempty"`
+	Extra    json.RawMessage  `json:"extra"`
+	Meta     any              `json:"meta"`
+	Internal string           `json:"-"`
+	Owner    *struct{ Email string }
+	Tags     map[string]string `json:"tags"`
+}
+
+type part struct {
+	SKU string `json:"sku"`
+}
+
+func TestUnknownFields(t *testing.T) {
+	data := []byte(`{
+		"id": "w1",
+		"name": "widget",
+		"status": "ACTIVE",
+		"parts": [{"sku": "a", "color": "red"}, {"sku": "b", "size": 2}],
+		"labels": {"main": {"sku": "c", "weight": 1}},
+		"extra": {"anything": 1},
+		"meta": {"anything": 1},
+		"Internal": "x",
+		"owner": {"email": "a@b.c", "phone": "1"},
+		"tags": {"env": "test"},
+		"created_at": "2025-01-01"
+	}`)
+
+	var w widget
+	unknown, err := UnknownFields(data, &w)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"Internal",
+		"created_at",
+		"labels.main.weight",
+		"owner.phone",
+		"parts[].color",
+		"parts[].size",
+	}, unknown)
+	assert.Equal(t, "w1", w.ID, "v should be decoded")
+
+	_, err = UnknownFields([]byte(`{"name": 1}`), &widget{})
+	assert.Error(t, err, "type mismatches should be reported")
+
+	var list []part
+	unknown, err = UnknownFields([]byte(`[{"sku": "a", "qty": 1}]`), &list)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"[].qty"}, unknown)
+}
+
+const testSpec = `
+openapi: 3.1.0
+paths:
+  /v1/widgets/{widget_id}:
+    parameters:
+      - name: widget_id
+        in: path
+    get:
+      responses:
+        "200":
+          $ref: "#/components/responses/Widget"
+    delete:
+      responses:
+        "204":
+          description: No Content
+components:
+  responses:
+    Widget:
+      content:
+        application/json:
+          schema:
+            $ref: "#/components/schemas/Widget"
+  schemas:
+    Widget:
+      allOf:
+        - $ref: "#/components/schemas/Base"
+        - type: object
+          properties:
+            status:
+              type: string
+              enum: [ACTIVE, ARCHIVED]
+            count:
+              type: [integer, "null"]
+            created_at:
+              type: string
+              format: date-time
+            parent:
+              $ref: "#/components/schemas/Widget"
+            parts:
+              type: array
+              items:
+                properties:
+                  sku:
+                    type: string
+            tags:
+              type: object
+              additionalProperties:
+                type: string
+    Base:
+      type: object
+      properties:
+        id:
+          type: string
+`
+
+func TestSpecResponseSample(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "openapi.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(testSpec), 0o600))
+	spec, err := LoadSpec(context.Background(), path)
+	require.NoError(t, err)
+
+	sample, err := spec.ResponseSample("GET", "/v1/widgets/{id}")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"id": "string",
+		"status": "ACTIVE",
+		"count": 1,
+		"created_at": "2025-01-01T00:00:00Z",
+		"parent": null,
+		"parts": [{"sku": "string"}],
+		"tags": {"key": "string"}
+	}`, string(sample))
+
+	sample, err = spec.ResponseSample("DELETE", "/v1/widgets/{id}")
+	require.NoError(t, err)
+	assert.Nil(t, sample, "operations without a JSON body have no sample")
+
+	_, err = spec.ResponseSample("POST", "/v1/widgets")
+	assert.True(t, errors.Is(err, ErrNoOperation), "got %v", err)
+}
@@ -0,0 +1,313 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package contract
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ErrNoOperation is returned for an endpoint that the specification does not describe.
+var ErrNoOperation = errors.New("operation not in specification")
+
+// maxSampleDepth bounds the nesting of synthesized samples.
+const maxSampleDepth = 12
+
+// Sample values of the scalar types.
+const (
+	sampleString  = "string"
+	sampleInteger = 1
+	sampleNumber  = 1.5
+)
+
+// sampleStrings holds the sample values of the string formats.
+var sampleStrings = map[string]string{
+	"date-time": "2025-01-01T00:00:00Z",
+	"date":      "2025-01-01",
+	"uuid":      "00000000-0000-0000-0000-000000000000",
+	"email":     "user@example.com",
+	"uri":       "https://example.com",
+}
+
+// Spec is an OpenAPI 3 specification, read to synthesize the responses of its operations.
+type Spec struct {
+	Paths      map[string]*pathItem `yaml:"paths"`
+	Components struct {
+		Schemas   map[string]*schema   `yaml:"schemas"`
+		Responses map[string]*response `yaml:"responses"`
+	} `yaml:"components"`
+}
+
+// pathItem holds the operations of a path.
+type pathItem struct {
+	Get    *operation `yaml:"get"`
+	Post   *operation `yaml:"post"`
+	Put    *operation `yaml:"put"`
+	Patch  *operation `yaml:"patch"`
+	Delete *operation `yaml:"delete"`
+}
+
+type operation struct {
+	Responses map[string]*response `yaml:"responses"`
+}
+
+type response struct {
+	Ref     string `yaml:"$ref"`
+	Content map[string]struct {
+		Schema *schema `yaml:"schema"`
+	} `yaml:"content"`
+}
+
+type schema struct {
+	Ref                  string             `yaml:"$ref"`
+	Type                 yaml.Node          `yaml:"type"`
+	Format               string             `yaml:"format"`
+	Enum                 []any              `yaml:"enum"`
+	Properties           map[string]*schema `yaml:"properties"`
+	AdditionalProperties *schema            `yaml:"additionalProperties"`
+	Items                *schema            `yaml:"items"`
+	AllOf                []*schema          `yaml:"allOf"`
+	OneOf                []*schema          `yaml:"oneOf"`
+	AnyOf                []*schema          `yaml:"anyOf"`
+}
+
+// LoadSpec reads an OpenAPI specification in YAML or JSON from a file, or from
+// an http or https URL.
+func LoadSpec(ctx context.Context, location string) (*Spec, error) {
+	var data []byte
+	var err error
+	if strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://") {
+		data, err = fetch(ctx, location)
+	} else {
+		data, err = os.ReadFile(location)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", location, err)
+	}
+
+	var spec Spec
+	if err := yaml.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", location, err)
+	}
+	return &spec, nil
+}
+
+func fetch(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// ResponseSample synthesizes the JSON body of the successful response of an operation,
+// with every property of its schema set. Path parameters may be named differently than
+// in the specification, e.g. "/v1/customers/{id}" matches "/v1/customers/{customer_id}".
+// It returns ErrNoOperation when the specification has no such operation, and nil when
+// the operation responds without a JSON body.
+func (s *Spec) ResponseSample(method, path string) ([]byte, error) {
+	op := s.operation(method, path)
+	if op == nil {
+		return nil, fmt.Errorf("%s %s: %w", method, path, ErrNoOperation)
+	}
+
+	codes := make([]string, 0, len(op.Responses))
+	for code := range op.Responses {
+		if strings.HasPrefix(code, "2") {
+			codes = append(codes, code)
+		}
+	}
+	slices.Sort(codes)
+	for _, code := range codes {
+		resp, err := s.resolveResponse(op.Responses[code])
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %w", method, path, err)
+		}
+		for mediaType, content := range resp.Content {
+			if content.Schema == nil || !strings.Contains(mediaType, "json") {
+				continue
+			}
+			value, err := s.sample(content.Schema, nil)
+			if err != nil {
+				return nil, fmt.Errorf("%s %s: %w", method, path, err)
+			}
+			return json.Marshal(value)
+		}
+	}
+	return nil, nil
+}
+
+// operation finds an operation, comparing path templates without their parameter names.
+func (s *Spec) operation(method, path string) *operation {
+	want := normalizePath(path)
+	for p, item := range s.Paths {
+		if normalizePath(p) != want || item == nil {
+			continue
+		}
+		switch strings.ToUpper(method) {
+		case http.MethodGet:
+			return item.Get
+		case http.MethodPost:
+			return item.Post
+		case http.MethodPut:
+			return item.Put
+		case http.MethodPatch:
+			return item.Patch
+		case http.MethodDelete:
+			return item.Delete
+		}
+	}
+	return nil
+}
+
+// normalizePath replaces the parameters of a path template with "{}".
+func normalizePath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, seg := range segments {
+		if strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}") {
+			segments[i] = "{}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+func (s *Spec) resolveResponse(r *response) (*response, error) {
+	if r.Ref == "" {
+		return r, nil
+	}
+	name, ok := strings.CutPrefix(r.Ref, "#/components/responses/")
+	if !ok || s.Components.Responses[name] == nil {
+		return nil, fmt.Errorf("unresolved response %q", r.Ref)
+	}
+	return s.Components.Responses[name], nil
+}
+
+// sample returns a value of the schema. refs holds the references being expanded, so that
+// recursive schemas end in null.
+func (s *Spec) sample(sc *schema, refs []string) (any, error) {
+	if sc.Ref != "" {
+		if slices.Contains(refs, sc.Ref) || len(refs) >= maxSampleDepth {
+			return nil, nil
+		}
+		name, ok := strings.CutPrefix(sc.Ref, "#/components/schemas/")
+		if !ok || s.Components.Schemas[name] == nil {
+			return nil, fmt.Errorf("unresolved schema %q", sc.Ref)
+		}
+		return s.sample(s.Components.Schemas[name], append(refs, sc.Ref))
+	}
+
+	switch {
+	case len(sc.AllOf) > 0:
+		merged := make(map[string]any)
+		for _, part := range sc.AllOf {
+			value, err := s.sample(part, refs)
+			if err != nil {
+				return nil, err
+			}
+			if obj, ok := value.(map[string]any); ok {
+				for k, v := range obj {
+					merged[k] = v
+				}
+			}
+		}
+		return merged, nil
+	case len(sc.OneOf) > 0:
+		return s.sample(sc.OneOf[0], refs)
+	case len(sc.AnyOf) > 0:
+		return s.sample(sc.AnyOf[0], refs)
+	case len(sc.Enum) > 0:
+		return sc.Enum[0], nil
+	}
+
+	switch typeName(sc) {
+	case "object":
+		obj := make(map[string]any, len(sc.Properties))
+		for name, prop := range sc.Properties {
+			value, err := s.sample(prop, refs)
+			if err != nil {
+				return nil, err
+			}
+			obj[name] = value
+		}
+		if sc.AdditionalProperties != nil && len(sc.Properties) == 0 {
+			value, err := s.sample(sc.AdditionalProperties, refs)
+			if err != nil {
+				return nil, err
+			}
+			obj["key"] = value
+		}
+		return obj, nil
+	case "array":
+		if sc.Items == nil {
+			return []any{}, nil
+		}
+		item, err := s.sample(sc.Items, refs)
+		if err != nil {
+			return nil, err
+		}
+		return []any{item}, nil
+	case "string":
+		if v, ok := sampleStrings[sc.Format]; ok {
+			return v, nil
+		}
+		return sampleString, nil
+	case "integer":
+		return sampleInteger, nil
+	case "number":
+		return sampleNumber, nil
+	case "boolean":
+		return true, nil
+	default:
+		return nil, nil
+	}
+}
+
+// typeName returns the type of a schema. OpenAPI 3.1 allows a list such as [string, "null"],
+// whose first non-null type is used. Schemas with properties but no type are objects.
+func typeName(sc *schema) string {
+	switch sc.Type.Kind {
+	case yaml.ScalarNode:
+		return sc.Type.Value
+	case yaml.SequenceNode:
+		for _, n := range sc.Type.Content {
+			if n.Value != "null" {
+				return n.Value
+			}
+		}
+	}
+	if len(sc.Properties) > 0 {
+		return "object"
+	}
+	return ""
+}
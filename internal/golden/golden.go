@@ -0,0 +1,238 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package golden checks response types against recorded JSON responses kept in testdata.
+//
+// For a sample testdata/<name>.json, RoundTrip decodes the JSON into the response type,
+// checks that encoding the value gives the same JSON back, and compares a dump of the
+// decoded value, listing the Go type of every field, with testdata/<name>.golden. A field
+// that stops decoding, or whose type changes (e.g. Total from int to int64), changes the
+// dump and fails the test without any network access.
+//
+// Run the tests with -update to write the .golden files after an intended change:
+//
+//	go test ./pkg/service/... -run Golden -update
+package golden
+
+import (
+	"bytes"
+	"cmp"
+	"encoding"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "write the .golden files of the golden tests")
+
+var (
+	jsonMarshalerType = reflect.TypeFor[json.Marshaler]()
+	textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+)
+
+// RoundTrip checks the sample testdata/<name>.json against the response type T in a subtest.
+func RoundTrip[T any](t *testing.T, name string) {
+	t.Helper()
+	t.Run(name, func(t *testing.T) {
+		sample, err := os.ReadFile(filepath.Join("testdata", name+".json"))
+		if err != nil {
+			t.Fatalf("failed to read sample: %v", err)
+		}
+
+		var v T
+		dec := json.NewDecoder(bytes.NewReader(sample))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&v); err != nil {
+			t.Fatalf("failed to decode %T: %v", v, err)
+		}
+
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			t.Fatalf("failed to encode %T: %v", v, err)
+		}
+		if diff := compareJSON(sample, encoded); diff != "" {
+			t.Errorf("%T does not round-trip: %s", v, diff)
+		}
+
+		got := Dump(v)
+		goldenPath := filepath.Join("testdata", name+".golden")
+		if *update {
+			if err := os.WriteFile(goldenPath, []byte(got), 0o644); err != nil {
+				t.Fatalf("failed to write %s: %v", goldenPath, err)
+			}
+			return
+		}
+		want, err := os.ReadFile(goldenPath)
+		if err != nil {
+			t.Fatalf("failed to read %s (run with -update to create it): %v", goldenPath, err)
+		}
+		if got != string(want) {
+			t.Errorf("decoded %T differs from %s (run with -update if intended):\n%s", v, goldenPath, diffLines(string(want), got))
+		}
+	})
+}
+
+// compareJSON returns a description of the first difference between two JSON documents,
+// or "" when they are equal.
+func compareJSON(want, got []byte) string {
+	var w, g any
+	if err := json.Unmarshal(want, &w); err != nil {
+		return err.Error()
+	}
+	if err := json.Unmarshal(got, &g); err != nil {
+		return err.Error()
+	}
+	return compareValues("$", w, g)
+}
+
+func compareValues(path string, want, got any) string {
+	switch w := want.(type) {
+	case map[string]any:
+		g, ok := got.(map[string]any)
+		if !ok {
+			return fmt.Sprintf("%s: got %v, want an object", path, got)
+		}
+		keys := make([]string, 0, len(w)+len(g))
+		for k := range w {
+			keys = append(keys, k)
+		}
+		for k := range g {
+			if _, ok := w[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			wv, wok := w[k]
+			gv, gok := g[k]
+			switch {
+			case !gok:
+				return fmt.Sprintf("%s.%s is missing", path, k)
+			case !wok:
+				return fmt.Sprintf("%s.%s is unexpected", path, k)
+			}
+			if diff := compareValues(path+"."+k, wv, gv); diff != "" {
+				return diff
+			}
+		}
+		return ""
+	case []any:
+		g, ok := got.([]any)
+		if !ok || len(g) != len(w) {
+			return fmt.Sprintf("%s: got %v, want %v", path, got, want)
+		}
+		for i := range w {
+			if diff := compareValues(fmt.Sprintf("%s[%d]", path, i), w[i], g[i]); diff != "" {
+				return diff
+			}
+		}
+		return ""
+	default:
+		if !reflect.DeepEqual(want, got) {
+			return fmt.Sprintf("%s: got %v, want %v", path, got, want)
+		}
+		return ""
+	}
+}
+
+// diffLines lists the lines that only one of want and got has.
+func diffLines(want, got string) string {
+	wantLines := strings.Split(want, "\n")
+	gotLines := strings.Split(got, "\n")
+	var b strings.Builder
+	for _, line := range wantLines {
+		if !slices.Contains(gotLines, line) {
+			b.WriteString("- " + line + "\n")
+		}
+	}
+	for _, line := range gotLines {
+		if !slices.Contains(wantLines, line) {
+			b.WriteString("+ " + line + "\n")
+		}
+	}
+	return b.String()
+}
+
+// Dump renders v as one line per value, with its path, Go type and, for scalars, its
+// JSON encoding, e.g. `.List[0].Total int = 3`.
+func Dump(v any) string {
+	var b strings.Builder
+	dump(&b, "", reflect.ValueOf(v))
+	return b.String()
+}
+
+func dump(b *strings.Builder, path string, v reflect.Value) {
+	t := v.Type()
+	fmt.Fprintf(b, "%s %s", cmp.Or(path, "."), t)
+
+	if leaf(t) || v.Kind() == reflect.Interface {
+		data, err := json.Marshal(v.Interface())
+		if err != nil {
+			data = []byte(err.Error())
+		}
+		fmt.Fprintf(b, " = %s\n", data)
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			b.WriteString(" = nil\n")
+			return
+		}
+		b.WriteString("\n")
+		dump(b, path, v.Elem())
+	case reflect.Struct:
+		b.WriteString("\n")
+		for i := range t.NumField() {
+			if f := t.Field(i); f.IsExported() {
+				dump(b, path+"."+f.Name, v.Field(i))
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		fmt.Fprintf(b, " len %d\n", v.Len())
+		for i := range v.Len() {
+			dump(b, fmt.Sprintf("%s[%d]", path, i), v.Index(i))
+		}
+	case reflect.Map:
+		fmt.Fprintf(b, " len %d\n", v.Len())
+		keys := v.MapKeys()
+		slices.SortFunc(keys, func(a, b reflect.Value) int {
+			return strings.Compare(fmt.Sprint(a.Interface()), fmt.Sprint(b.Interface()))
+		})
+		for _, k := range keys {
+			dump(b, fmt.Sprintf("%s[%v]", path, k.Interface()), v.MapIndex(k))
+		}
+	default:
+		fmt.Fprintf(b, " = %v\n", v.Interface())
+	}
+}
+
+// leaf reports whether values of t are rendered whole: scalars and types with their own encoding.
+func leaf(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Struct, reflect.Slice, reflect.Array, reflect.Map, reflect.Interface:
+		return t.Implements(jsonMarshalerType) || t.Implements(textMarshalerType)
+	default:
+		return true
+	}
+}
@@ -0,0 +1,177 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package common provides types and validation helpers shared across 1Money services.
+//
+// Money pairs a decimal amount with its currency, so that amounts in different currencies
+// cannot be added or compared by mistake.
+//
+// The bank helpers check IBANs, SWIFT/BIC codes and ABA routing numbers locally
+// so that malformed bank details fail fast instead of being rejected by the bank.
+package common
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Bank identifier validation errors.
+var (
+	// ErrInvalidIBAN is returned when an IBAN fails format, length or checksum validation.
+	ErrInvalidIBAN = errors.New("invalid IBAN")
+	// ErrInvalidBIC is returned when a SWIFT/BIC code is malformed.
+	ErrInvalidBIC = errors.New("invalid BIC")
+	// ErrInvalidABARouting is returned when an ABA routing number fails format or checksum validation.
+	ErrInvalidABARouting = errors.New("invalid ABA routing number")
+)
+
+// ibanLengths maps ISO 3166-1 alpha-2 country codes to their IBAN length per the SWIFT IBAN registry.
+var ibanLengths = map[string]int{
+	"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16, "BG": 22, "BH": 22, "BI": 27,
+	"BR": 29, "BY": 28, "CH": 21, "CR": 22, "CY": 28, "CZ": 24, "DE": 22, "DJ": 27, "DK": 18, "DO": 28,
+	"EE": 20, "EG": 29, "ES": 24, "FI": 18, "FK": 18, "FO": 18, "FR": 27, "GB": 22, "GE": 22, "GI": 23,
+	"GL": 18, "GR": 27, "GT": 28, "HR": 21, "HU": 28, "IE": 22, "IL": 23, "IQ": 23, "IS": 26, "IT": 27,
+	"JO": 30, "KW": 30, "KZ": 20, "LB": 28, "LC": 32, "LI": 21, "LT": 20, "LU": 20, "LV": 21, "LY": 25,
+	"MC": 27, "MD": 24, "ME": 22, "MK": 19, "MN": 20, "MR": 27, "MT": 31, "MU": 30, "NI": 28, "NL": 18,
+	"NO": 15, "OM": 23, "PK": 24, "PL": 28, "PS": 29, "PT": 25, "QA": 29, "RO": 24, "RS": 22, "RU": 33,
+	"SA": 24, "SC": 31, "SD": 18, "SE": 24, "SI": 19, "SK": 24, "SM": 27, "SO": 23, "ST": 25, "SV": 28,
+	"TL": 23, "TN": 24, "TR": 26, "UA": 29, "VA": 22, "VG": 24, "XK": 20, "YE": 30,
+}
+
+// NormalizeIBAN strips spaces and upper-cases an IBAN, e.g. "de89 3704 ..." becomes "DE893704...".
+func NormalizeIBAN(iban string) string {
+	return strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(iban), " ", ""))
+}
+
+// ValidateIBAN checks an IBAN's country code, registered length and ISO 7064 mod-97 checksum.
+// Spaces and lower-case letters are accepted.
+func ValidateIBAN(iban string) error {
+	iban = NormalizeIBAN(iban)
+	if len(iban) < 4 {
+		return fmt.Errorf("%w: too short", ErrInvalidIBAN)
+	}
+
+	country := iban[:2]
+	want, ok := ibanLengths[country]
+	if !ok {
+		return fmt.Errorf("%w: unsupported country code %q", ErrInvalidIBAN, country)
+	}
+	if len(iban) != want {
+		return fmt.Errorf("%w: %s IBANs must be %d characters, got %d", ErrInvalidIBAN, country, want, len(iban))
+	}
+	if !isDigit(iban[2]) || !isDigit(iban[3]) {
+		return fmt.Errorf("%w: check digits must be numeric", ErrInvalidIBAN)
+	}
+
+	// Move the country code and check digits to the end, then expand letters to 10..35.
+	rearranged := iban[4:] + iban[:4]
+	remainder := 0
+	for i := 0; i < len(rearranged); i++ {
+		c := rearranged[i]
+		switch {
+		case isDigit(c):
+			remainder = (remainder*10 + int(c-'0')) % 97
+		case isUpper(c):
+			remainder = (remainder*100 + int(c-'A') + 10) % 97
+		default:
+			return fmt.Errorf("%w: unexpected character %q", ErrInvalidIBAN, c)
+		}
+	}
+	if remainder != 1 {
+		return fmt.Errorf("%w: checksum mismatch", ErrInvalidIBAN)
+	}
+
+	return nil
+}
+
+// ValidateBIC checks that a SWIFT/BIC code is 8 or 11 characters: a 4-letter institution code,
+// a 2-letter country code, a 2-character location code and an optional 3-character branch code.
+func ValidateBIC(bic string) error {
+	bic = strings.ToUpper(strings.TrimSpace(bic))
+	if len(bic) != 8 && len(bic) != 11 {
+		return fmt.Errorf("%w: must be 8 or 11 characters, got %d", ErrInvalidBIC, len(bic))
+	}
+
+	for i := 0; i < len(bic); i++ {
+		c := bic[i]
+		if i < 6 && !isUpper(c) {
+			return fmt.Errorf("%w: institution and country codes must be letters", ErrInvalidBIC)
+		}
+		if i >= 6 && !isUpper(c) && !isDigit(c) {
+			return fmt.Errorf("%w: location and branch codes must be alphanumeric", ErrInvalidBIC)
+		}
+	}
+
+	return nil
+}
+
+// ValidateIBANCountry checks that an IBAN and a BIC belong to the same country.
+// Both values must already be valid. Cross-border accounts legitimately fail this check,
+// so apply it only where the account is expected to be domestic.
+func ValidateIBANCountry(iban, bic string) error {
+	iban = NormalizeIBAN(iban)
+	bic = strings.ToUpper(strings.TrimSpace(bic))
+	if len(iban) < 2 || len(bic) < 6 {
+		return fmt.Errorf("%w: cannot determine country", ErrInvalidIBAN)
+	}
+	if iban[:2] != bic[4:6] {
+		return fmt.Errorf("%w: IBAN country %s does not match BIC country %s", ErrInvalidIBAN, iban[:2], bic[4:6])
+	}
+	return nil
+}
+
+// abaWeights are the weights of the ABA routing number checksum.
+var abaWeights = [9]int{3, 7, 1, 3, 7, 1, 3, 7, 1}
+
+// ValidateABARouting checks that an ABA routing number is 9 digits with a valid checksum.
+func ValidateABARouting(routing string) error {
+	routing = strings.TrimSpace(routing)
+	if len(routing) != 9 {
+		return fmt.Errorf("%w: must be 9 digits, got %d characters", ErrInvalidABARouting, len(routing))
+	}
+
+	sum := 0
+	for i := 0; i < len(routing); i++ {
+		if !isDigit(routing[i]) {
+			return fmt.Errorf("%w: must contain only digits", ErrInvalidABARouting)
+		}
+		sum += int(routing[i]-'0') * abaWeights[i]
+	}
+	if sum%10 != 0 {
+		return fmt.Errorf("%w: checksum mismatch", ErrInvalidABARouting)
+	}
+
+	return nil
+}
+
+// IsABARouting reports whether s has the shape of an ABA routing number (9 digits).
+// It does not verify the checksum.
+func IsABARouting(s string) bool {
+	if len(s) != 9 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if !isDigit(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func isDigit(c byte) bool { return c >= '0' && c <= '9' }
+
+func isUpper(c byte) bool { return c >= 'A' && c <= 'Z' }
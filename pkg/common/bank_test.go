@@ -0,0 +1,104 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateIBAN(t *testing.T) {
+	tests := []struct {
+		name    string
+		iban    string
+		wantErr bool
+	}{
+		{name: "germany", iban: "DE89370400440532013000"},
+		{name: "uk with spaces", iban: "GB82 WEST 1234 5698 7654 32"},
+		{name: "lower case", iban: "fr1420041010050500013m02606"},
+		{name: "bad checksum", iban: "DE89370400440532013001", wantErr: true},
+		{name: "wrong length", iban: "DE8937040044053201300", wantErr: true},
+		{name: "unknown country", iban: "ZZ89370400440532013000", wantErr: true},
+		{name: "non numeric check digits", iban: "DEXX370400440532013000", wantErr: true},
+		{name: "too short", iban: "DE", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateIBAN(tt.iban)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateIBAN(%q) error = %v, wantErr %v", tt.iban, err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidIBAN) {
+				t.Errorf("error %v does not wrap ErrInvalidIBAN", err)
+			}
+		})
+	}
+}
+
+func TestValidateBIC(t *testing.T) {
+	tests := []struct {
+		bic     string
+		wantErr bool
+	}{
+		{bic: "DEUTDEFF"},
+		{bic: "DEUTDEFF500"},
+		{bic: "chasus33"},
+		{bic: "DEUTDEF", wantErr: true},
+		{bic: "DEU1DEFF", wantErr: true},
+		{bic: "DEUTDEFF50-", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		err := ValidateBIC(tt.bic)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ValidateBIC(%q) error = %v, wantErr %v", tt.bic, err, tt.wantErr)
+		}
+	}
+}
+
+func TestValidateIBANCountry(t *testing.T) {
+	if err := ValidateIBANCountry("DE89370400440532013000", "DEUTDEFF"); err != nil {
+		t.Errorf("matching countries: unexpected error %v", err)
+	}
+	if err := ValidateIBANCountry("DE89370400440532013000", "BNPAFRPP"); err == nil {
+		t.Error("mismatched countries: expected error")
+	}
+}
+
+func TestValidateABARouting(t *testing.T) {
+	tests := []struct {
+		routing string
+		wantErr bool
+	}{
+		{routing: "021000021"},
+		{routing: "327984566"},
+		{routing: "021000022", wantErr: true},
+		{routing: "02100002", wantErr: true},
+		{routing: "02100002A", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		err := ValidateABARouting(tt.routing)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ValidateABARouting(%q) error = %v, wantErr %v", tt.routing, err, tt.wantErr)
+		}
+		if err != nil && !errors.Is(err, ErrInvalidABARouting) {
+			t.Errorf("error %v does not wrap ErrInvalidABARouting", err)
+		}
+	}
+}
@@ -0,0 +1,268 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+)
+
+// Money errors.
+var (
+	// ErrInvalidAmount is returned when an amount is not a plain decimal number, e.g. "100.50".
+	ErrInvalidAmount = errors.New("invalid amount")
+	// ErrCurrencyMismatch is returned when combining or comparing amounts in different currencies.
+	ErrCurrencyMismatch = errors.New("currency mismatch")
+)
+
+// decimalPattern matches the plain decimal amounts used by the API, e.g. "100", "-0.5" or "100.50".
+var decimalPattern = regexp.MustCompile(`^[+-]?[0-9]+(\.[0-9]+)?$`)
+
+// Decimal is an exact decimal number. The zero value is 0. Decimals are immutable:
+// arithmetic returns a new value.
+type Decimal struct {
+	rat *big.Rat
+}
+
+// ParseDecimal parses a plain decimal amount such as "100.50". Exponents and fractions are rejected.
+func ParseDecimal(s string) (Decimal, error) {
+	if !decimalPattern.MatchString(s) {
+		return Decimal{}, fmt.Errorf("%w: %q", ErrInvalidAmount, s)
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return Decimal{}, fmt.Errorf("%w: %q", ErrInvalidAmount, s)
+	}
+	return Decimal{rat: r}, nil
+}
+
+// MustParseDecimal is like ParseDecimal but panics on invalid input. Use it for literals.
+func MustParseDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// NewDecimal returns the integer n as a Decimal.
+func NewDecimal(n int64) Decimal {
+	return Decimal{rat: new(big.Rat).SetInt64(n)}
+}
+
+func (d Decimal) value() *big.Rat {
+	if d.rat == nil {
+		return new(big.Rat)
+	}
+	return d.rat
+}
+
+// Add returns d + o.
+func (d Decimal) Add(o Decimal) Decimal {
+	return Decimal{rat: new(big.Rat).Add(d.value(), o.value())}
+}
+
+// Sub returns d - o.
+func (d Decimal) Sub(o Decimal) Decimal {
+	return Decimal{rat: new(big.Rat).Sub(d.value(), o.value())}
+}
+
+// Mul returns d * o.
+func (d Decimal) Mul(o Decimal) Decimal {
+	return Decimal{rat: new(big.Rat).Mul(d.value(), o.value())}
+}
+
+// Neg returns -d.
+func (d Decimal) Neg() Decimal {
+	return Decimal{rat: new(big.Rat).Neg(d.value())}
+}
+
+// Round returns d rounded to the given number of decimal places, half away from zero.
+func (d Decimal) Round(places int) Decimal {
+	return MustParseDecimal(d.StringFixed(places))
+}
+
+// Cmp returns -1, 0 or +1 depending on whether d is less than, equal to or greater than o.
+func (d Decimal) Cmp(o Decimal) int {
+	return d.value().Cmp(o.value())
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of d.
+func (d Decimal) Sign() int {
+	return d.value().Sign()
+}
+
+// IsZero reports whether d is 0.
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// Rat returns d as a new big.Rat.
+func (d Decimal) Rat() *big.Rat {
+	return new(big.Rat).Set(d.value())
+}
+
+// String returns d with as many decimal places as needed, e.g. "100.5" or "100".
+func (d Decimal) String() string {
+	r := d.value()
+	places := 0
+	for scaled := new(big.Rat).Set(r); !scaled.IsInt(); places++ {
+		scaled.Mul(scaled, big.NewRat(10, 1))
+	}
+	return r.FloatString(places)
+}
+
+// StringFixed returns d rounded to exactly the given number of decimal places, e.g. "100.50".
+func (d Decimal) StringFixed(places int) string {
+	return d.value().FloatString(places)
+}
+
+// MarshalText implements encoding.TextMarshaler. Decimals are encoded as JSON strings.
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *Decimal) UnmarshalText(text []byte) error {
+	parsed, err := ParseDecimal(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// UnmarshalJSON accepts a decimal encoded as a JSON string or number.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		data = []byte(s)
+	}
+	return d.UnmarshalText(data)
+}
+
+// Money is an amount paired with its currency, so that amounts in different currencies
+// cannot be added or compared by mistake.
+type Money struct {
+	// Amount is the decimal amount.
+	Amount Decimal `json:"amount"`
+	// Currency is the asset the amount is denominated in, e.g. USD or USDC.
+	Currency assets.AssetName `json:"currency"`
+}
+
+// NewMoney parses amount as a decimal in the given currency.
+func NewMoney(amount string, currency assets.AssetName) (Money, error) {
+	d, err := ParseDecimal(amount)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: d, Currency: normalizeCurrency(currency)}, nil
+}
+
+// MustMoney is like NewMoney but panics on an invalid amount. Use it for literals.
+func MustMoney(amount string, currency assets.AssetName) Money {
+	m, err := NewMoney(amount, currency)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+func normalizeCurrency(currency assets.AssetName) assets.AssetName {
+	return assets.AssetName(strings.ToUpper(string(currency)))
+}
+
+// sameCurrency returns ErrCurrencyMismatch unless m and o share a currency.
+func (m Money) sameCurrency(o Money) error {
+	if !strings.EqualFold(string(m.Currency), string(o.Currency)) {
+		return fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, o.Currency)
+	}
+	return nil
+}
+
+// Add returns m + o, or ErrCurrencyMismatch if the currencies differ.
+func (m Money) Add(o Money) (Money, error) {
+	if err := m.sameCurrency(o); err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: m.Amount.Add(o.Amount), Currency: m.Currency}, nil
+}
+
+// Sub returns m - o, or ErrCurrencyMismatch if the currencies differ.
+func (m Money) Sub(o Money) (Money, error) {
+	if err := m.sameCurrency(o); err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: m.Amount.Sub(o.Amount), Currency: m.Currency}, nil
+}
+
+// Mul returns m scaled by factor, e.g. a percentage or an exchange rate within the same currency.
+func (m Money) Mul(factor Decimal) Money {
+	return Money{Amount: m.Amount.Mul(factor), Currency: m.Currency}
+}
+
+// Neg returns -m.
+func (m Money) Neg() Money {
+	return Money{Amount: m.Amount.Neg(), Currency: m.Currency}
+}
+
+// Cmp compares the amounts of m and o, or returns ErrCurrencyMismatch if the currencies differ.
+func (m Money) Cmp(o Money) (int, error) {
+	if err := m.sameCurrency(o); err != nil {
+		return 0, err
+	}
+	return m.Amount.Cmp(o.Amount), nil
+}
+
+// Equal reports whether m and o have the same currency and amount.
+func (m Money) Equal(o Money) bool {
+	c, err := m.Cmp(o)
+	return err == nil && c == 0
+}
+
+// IsZero reports whether the amount is 0.
+func (m Money) IsZero() bool {
+	return m.Amount.IsZero()
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of the amount.
+func (m Money) Sign() int {
+	return m.Amount.Sign()
+}
+
+// String formats the amount and currency, e.g. "100.5 USD".
+func (m Money) String() string {
+	return m.Amount.String() + " " + string(m.Currency)
+}
+
+// Format formats the amount with exactly the given number of decimal places, e.g. "100.50 USD".
+func (m Money) Format(places int) string {
+	return m.Amount.StringFixed(places) + " " + string(m.Currency)
+}
@@ -0,0 +1,154 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+)
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "100", want: "100"},
+		{in: "100.50", want: "100.5"},
+		{in: "-0.001", want: "-0.001"},
+		{in: "+7.0", want: "7"},
+		{in: "", wantErr: true},
+		{in: "1e3", wantErr: true},
+		{in: "1/3", wantErr: true},
+		{in: ".5", wantErr: true},
+		{in: "1,000.00", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			d, err := ParseDecimal(tt.in)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidAmount) {
+					t.Fatalf("ParseDecimal(%q) error = %v, want ErrInvalidAmount", tt.in, err)
+				}
+				return
+			}
+			if err != nil || d.String() != tt.want {
+				t.Fatalf("ParseDecimal(%q) = %s, %v, want %s", tt.in, d, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	a := MustParseDecimal("0.1")
+	b := MustParseDecimal("0.2")
+
+	if got := a.Add(b).String(); got != "0.3" {
+		t.Errorf("0.1 + 0.2 = %s, want 0.3", got)
+	}
+	if got := a.Sub(b).String(); got != "-0.1" {
+		t.Errorf("0.1 - 0.2 = %s, want -0.1", got)
+	}
+	if got := MustParseDecimal("19.99").Mul(NewDecimal(3)).String(); got != "59.97" {
+		t.Errorf("19.99 * 3 = %s, want 59.97", got)
+	}
+	if got := MustParseDecimal("2.345").Round(2).String(); got != "2.35" {
+		t.Errorf("Round(2.345, 2) = %s, want 2.35", got)
+	}
+	if got := MustParseDecimal("-2.345").StringFixed(2); got != "-2.35" {
+		t.Errorf("StringFixed(-2.345, 2) = %s, want -2.35", got)
+	}
+	if a.Cmp(b) != -1 || b.Cmp(a) != 1 || a.Cmp(MustParseDecimal("0.10")) != 0 {
+		t.Error("Cmp() ordering is wrong")
+	}
+
+	var zero Decimal
+	if !zero.IsZero() || zero.String() != "0" || zero.Add(a).String() != "0.1" {
+		t.Errorf("zero Decimal = %s, want usable 0", zero)
+	}
+}
+
+func TestDecimalJSON(t *testing.T) {
+	var v struct {
+		Str Decimal `json:"str"`
+		Num Decimal `json:"num"`
+	}
+	if err := json.Unmarshal([]byte(`{"str":"100.50","num":12.5}`), &v); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if v.Str.String() != "100.5" || v.Num.String() != "12.5" {
+		t.Errorf("decoded %s and %s", v.Str, v.Num)
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if want := `{"str":"100.5","num":"12.5"}`; string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+
+	if err := json.Unmarshal([]byte(`{"str":"abc"}`), &v); !errors.Is(err, ErrInvalidAmount) {
+		t.Errorf("Unmarshal() of invalid amount error = %v, want ErrInvalidAmount", err)
+	}
+}
+
+func TestMoney(t *testing.T) {
+	price := MustMoney("19.99", assets.AssetNameUSD)
+	fee := MustMoney("0.50", "usd")
+	usdc := MustMoney("10", assets.AssetNameUSDC)
+
+	total, err := price.Add(fee)
+	if err != nil || total.String() != "20.49 USD" {
+		t.Fatalf("Add() = %s, %v, want 20.49 USD", total, err)
+	}
+	if got := total.Format(2); got != "20.49 USD" {
+		t.Errorf("Format(2) = %s", got)
+	}
+	if got := price.Mul(MustParseDecimal("0.1")).Format(2); got != "2.00 USD" {
+		t.Errorf("Mul(0.1).Format(2) = %s, want 2.00 USD", got)
+	}
+	if c, err := fee.Cmp(price); err != nil || c != -1 {
+		t.Errorf("Cmp() = %d, %v, want -1", c, err)
+	}
+	if !price.Equal(MustMoney("19.990", assets.AssetNameUSD)) || price.Equal(usdc) {
+		t.Error("Equal() is wrong")
+	}
+
+	if _, err := price.Add(usdc); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Add() across currencies error = %v, want ErrCurrencyMismatch", err)
+	}
+	if _, err := price.Sub(usdc); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Sub() across currencies error = %v, want ErrCurrencyMismatch", err)
+	}
+	if _, err := price.Cmp(usdc); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Cmp() across currencies error = %v, want ErrCurrencyMismatch", err)
+	}
+
+	data, err := json.Marshal(price)
+	if err != nil || string(data) != `{"amount":"19.99","currency":"USD"}` {
+		t.Fatalf("Marshal() = %s, %v", data, err)
+	}
+	var decoded Money
+	if err := json.Unmarshal(data, &decoded); err != nil || !decoded.Equal(price) {
+		t.Errorf("round trip = %s, %v, want %s", decoded, err, price)
+	}
+}
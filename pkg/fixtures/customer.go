@@ -0,0 +1,161 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package fixtures
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/customer"
+	"github.com/1Money-Co/1money-go-sdk/pkg/testdata"
+)
+
+// Country is the country of the generated addresses, nationalities and tax residences.
+const Country = "DEU"
+
+// germanStates are the German federal state codes (Bundesländer).
+var germanStates = []string{
+	"BW", "BY", "BE", "BB", "HB", "HH", "HE", "MV",
+	"NI", "NW", "RP", "SL", "SN", "ST", "SH", "TH",
+}
+
+// Fixed date ranges keep seeded fixtures the same whatever the current date.
+var (
+	birthDates         = [2]time.Time{date(1950, 1, 1), date(2000, 12, 31)}
+	incorporationDates = [2]time.Time{date(1990, 1, 1), date(2020, 12, 31)}
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// State returns a random German federal state code.
+func (b *Builder) State() string {
+	return germanStates[b.faker.Number(0, len(germanStates)-1)]
+}
+
+// Address returns a random German address.
+func (b *Builder) Address(opts ...Option[customer.Address]) *customer.Address {
+	state := b.State()
+	addr := &customer.Address{
+		StreetLine1: b.faker.Street(),
+		City:        b.faker.City(),
+		State:       state,
+		Country:     Country,
+		PostalCode:  b.faker.Zip(),
+		Subdivision: state,
+	}
+	apply(addr, opts)
+	return addr
+}
+
+// AssociatedPerson returns a German director who owns the business, with a national ID
+// card and a proof of address.
+func (b *Builder) AssociatedPerson(opts ...Option[customer.AssociatedPerson]) customer.AssociatedPerson {
+	gender := customer.GenderMale
+	if b.faker.Bool() {
+		gender = customer.GenderFemale
+	}
+
+	person := customer.AssociatedPerson{
+		FirstName:           b.faker.FirstName(),
+		LastName:            b.faker.LastName(),
+		Email:               b.faker.Email(),
+		Gender:              gender,
+		ResidentialAddress:  b.Address(),
+		BirthDate:           b.faker.DateRange(birthDates[0], birthDates[1]).Format("2006-01-02"),
+		CountryOfBirth:      Country,
+		PrimaryNationality:  Country,
+		HasOwnership:        true,
+		OwnershipPercentage: 100,
+		HasControl:          true,
+		IsSigner:            true,
+		IsDirector:          true,
+		IdentifyingInformation: []customer.IdentifyingInformation{
+			{
+				Type:                   customer.IDTypeNationalId,
+				IssuingCountry:         Country,
+				ImageFront:             testdata.IDFront(),
+				ImageBack:              testdata.IDBack(),
+				NationalIdentityNumber: b.faker.LetterN(8) + b.faker.DigitN(4),
+			},
+		},
+		CountryOfTax: Country,
+		TaxType:      customer.TaxIDTypeSSN,
+		TaxID:        b.faker.SSN(),
+		POA:          testdata.POA(),
+		POAType:      "utility_bill",
+	}
+	apply(&person, opts)
+	return person
+}
+
+// CreateCustomerRequest returns a request for a German corporation with two associated
+// persons, signed with the given TOS agreement. Documents left nil by the options are
+// set to CustomerDocuments for the final business type and country.
+func (b *Builder) CreateCustomerRequest(
+	signedAgreementID string,
+	opts ...Option[customer.CreateCustomerRequest],
+) *customer.CreateCustomerRequest {
+	addr := b.Address()
+	addr.StreetLine2 = fmt.Sprintf("Suite %d", b.faker.Number(100, 999))
+
+	req := &customer.CreateCustomerRequest{
+		BusinessLegalName:          b.faker.Company(),
+		BusinessDescription:        b.faker.JobDescriptor() + " " + b.faker.BS(),
+		BusinessRegistrationNumber: fmt.Sprintf("%s-%d", b.faker.LetterN(3), b.faker.Number(100000, 999999)),
+		Email:                      b.faker.Email(),
+		BusinessType:               customer.BusinessTypeCorporation,
+		BusinessIndustry:           "332999",
+		RegisteredAddress:          addr,
+		DateOfIncorporation:        b.faker.DateRange(incorporationDates[0], incorporationDates[1]).Format("2006-01-02"),
+		SignedAgreementID:          signedAgreementID,
+		AssociatedPersons: []customer.AssociatedPerson{
+			b.AssociatedPerson(),
+			b.AssociatedPerson(),
+		},
+		SourceOfFunds:                  []customer.SourceOfFunds{customer.SourceOfFundsSalesOfGoodsAndServices},
+		SourceOfWealth:                 []customer.SourceOfWealth{customer.SourceOfWealthBusinessDividendsOrProfits},
+		AccountPurpose:                 customer.AccountPurposeTreasuryManagement,
+		EstimatedAnnualRevenueUSD:      customer.MoneyRange099999,
+		ExpectedMonthlyFiatDeposits:    customer.MoneyRange099999,
+		ExpectedMonthlyFiatWithdrawals: customer.MoneyRange099999,
+		TaxID:                          fmt.Sprintf("%d-%d", b.faker.Number(10, 99), b.faker.Number(1000000, 9999999)),
+		TaxType:                        customer.TaxIDTypeEIN,
+		TaxCountry:                     Country,
+	}
+	apply(req, opts)
+
+	if req.Documents == nil {
+		country := req.TaxCountry
+		if req.RegisteredAddress != nil {
+			country = req.RegisteredAddress.Country
+		}
+		req.Documents = CustomerDocuments(req.BusinessType, country)
+	}
+	return req
+}
+
+// CustomerDocuments returns the KYB documents required for a business of the given type
+// and country (ISO 3166-1 alpha-3), each with a sample image as its file.
+func CustomerDocuments(businessType customer.BusinessType, country string) []customer.Document {
+	docs := customer.RequiredDocuments(businessType, country)
+	file := testdata.POA()
+	for i := range docs {
+		docs[i].File = file
+	}
+	return docs
+}
@@ -0,0 +1,127 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package fixtures builds realistic request data for tests of applications built on the SDK.
+//
+// A Builder generates fake but valid requests, such as customers with associated persons and
+// KYB documents, external bank accounts and wallet addresses. The values are accepted by the
+// sandbox, so the fixtures work against it as well as against mocks and fakes. Every builder
+// method takes options that adjust the fixture before it is returned.
+//
+// # Basic Usage
+//
+//	import (
+//	    "github.com/1Money-Co/1money-go-sdk/pkg/fixtures"
+//	    "github.com/1Money-Co/1money-go-sdk/pkg/service/customer"
+//	)
+//
+//	b := fixtures.New(0) // random seed; pass a fixed seed for repeatable data
+//
+//	req := b.CreateCustomerRequest(signedAgreementID, func(r *customer.CreateCustomerRequest) {
+//	    r.BusinessType = customer.BusinessTypeLlc
+//	})
+//	resp, err := client.Customer.CreateCustomer(ctx, req)
+//
+//	account, err := client.ExternalAccounts.CreateExternalAccount(ctx, resp.CustomerID, b.ExternalAccountRequest())
+package fixtures
+
+import (
+	"fmt"
+
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/auto_conversion_rules"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/external_accounts"
+)
+
+// Option adjusts a fixture after its defaults are generated.
+type Option[T any] func(*T)
+
+// Builder generates fixtures. Builders created with the same non-zero seed generate the
+// same fixtures, including idempotency keys. A Builder is not safe for concurrent use;
+// create one per test.
+type Builder struct {
+	faker *gofakeit.Faker
+}
+
+// New returns a Builder seeded with seed. A zero seed picks a random one, so idempotency
+// keys differ between runs.
+func New(seed uint64) *Builder {
+	return &Builder{faker: gofakeit.New(seed)}
+}
+
+// Faker returns the fake data generator of the Builder, for values the fixtures do not cover.
+func (b *Builder) Faker() *gofakeit.Faker {
+	return b.faker
+}
+
+func apply[T any](v *T, opts []Option[T]) {
+	for _, opt := range opts {
+		if opt != nil {
+			opt(v)
+		}
+	}
+}
+
+// IdempotencyKey returns a UUID for use as an idempotency key.
+func (b *Builder) IdempotencyKey() string {
+	return b.faker.UUID()
+}
+
+// EthereumAddress returns a random Ethereum wallet address (0x followed by 40 hex digits).
+func (b *Builder) EthereumAddress() string {
+	addr := make([]byte, 20)
+	for i := range addr {
+		addr[i] = b.faker.Uint8()
+	}
+	return fmt.Sprintf("0x%x", addr)
+}
+
+// ExternalAccountRequest returns a request for a US ACH bank account in USD, with a
+// routing number the sandbox accepts.
+func (b *Builder) ExternalAccountRequest(opts ...Option[external_accounts.CreateReq]) *external_accounts.CreateReq {
+	req := &external_accounts.CreateReq{
+		IdempotencyKey:  b.IdempotencyKey(),
+		Network:         external_accounts.BankNetworkNameUSACH,
+		Currency:        external_accounts.CurrencyUSD,
+		CountryCode:     external_accounts.CountryCodeUSA,
+		AccountNumber:   "5097935393",
+		InstitutionID:   "327984566",
+		InstitutionName: b.faker.Company() + " Bank",
+	}
+	apply(req, opts)
+	return req
+}
+
+// AutoConversionRuleRequest returns a request for a rule converting USD received over
+// US ACH into USDC on Polygon.
+func (b *Builder) AutoConversionRuleRequest(
+	opts ...Option[auto_conversion_rules.CreateRuleRequest],
+) *auto_conversion_rules.CreateRuleRequest {
+	network := "POLYGON"
+	req := &auto_conversion_rules.CreateRuleRequest{
+		IdempotencyKey: b.IdempotencyKey(),
+		Source: auto_conversion_rules.SourceAssetInfo{
+			Asset:   "USD",
+			Network: "US_ACH",
+		},
+		Destination: auto_conversion_rules.DestinationAssetInfo{
+			Asset:   "USDC",
+			Network: &network,
+		},
+	}
+	apply(req, opts)
+	return req
+}
@@ -0,0 +1,96 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package fixtures
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/customer"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/external_accounts"
+)
+
+func TestBuilderSeed(t *testing.T) {
+	a, b := New(42), New(42)
+	assert.Equal(t, a.CreateCustomerRequest("agreement"), b.CreateCustomerRequest("agreement"))
+	assert.Equal(t, a.ExternalAccountRequest(), b.ExternalAccountRequest())
+	assert.Equal(t, a.IdempotencyKey(), b.IdempotencyKey())
+
+	assert.NotEqual(t, New(0).IdempotencyKey(), New(0).IdempotencyKey())
+}
+
+func TestCreateCustomerRequest(t *testing.T) {
+	b := New(1)
+
+	req := b.CreateCustomerRequest("agreement")
+	assert.Equal(t, "agreement", req.SignedAgreementID)
+	assert.Len(t, req.AssociatedPersons, 2)
+	require.Len(t, req.Documents, len(customer.RequiredDocuments(customer.BusinessTypeCorporation, Country)))
+	for _, doc := range req.Documents {
+		assert.NotEmpty(t, doc.File, "document %s has no file", doc.DocType)
+	}
+
+	llc := b.CreateCustomerRequest("agreement", func(r *customer.CreateCustomerRequest) {
+		r.BusinessType = customer.BusinessTypeLlc
+		r.AssociatedPersons = r.AssociatedPersons[:1]
+	})
+	assert.Len(t, llc.AssociatedPersons, 1)
+	var types []customer.DocumentType
+	for _, doc := range llc.Documents {
+		types = append(types, doc.DocType)
+	}
+	var want []customer.DocumentType
+	for _, doc := range customer.RequiredDocuments(customer.BusinessTypeLlc, Country) {
+		want = append(want, doc.DocType)
+	}
+	assert.Equal(t, want, types, "documents follow the business type set by the options")
+
+	explicit := b.CreateCustomerRequest("agreement", func(r *customer.CreateCustomerRequest) {
+		r.Documents = []customer.Document{}
+	})
+	assert.Empty(t, explicit.Documents)
+}
+
+func TestAssociatedPerson(t *testing.T) {
+	person := New(1).AssociatedPerson(func(p *customer.AssociatedPerson) {
+		p.OwnershipPercentage = 25
+	})
+	assert.Equal(t, 25, person.OwnershipPercentage)
+	assert.Equal(t, Country, person.ResidentialAddress.Country)
+	assert.Contains(t, germanStates, person.ResidentialAddress.State)
+	require.Len(t, person.IdentifyingInformation, 1)
+	assert.NotEmpty(t, person.IdentifyingInformation[0].ImageFront)
+}
+
+func TestPaymentFixtures(t *testing.T) {
+	b := New(1)
+
+	account := b.ExternalAccountRequest(func(r *external_accounts.CreateReq) {
+		r.AccountNumber = "123456789"
+	})
+	require.NoError(t, account.Validate())
+	assert.Equal(t, "123456789", account.AccountNumber)
+	assert.NotEmpty(t, account.IdempotencyKey)
+
+	assert.Regexp(t, regexp.MustCompile(`^0x[0-9a-f]{40}$`), b.EthereumAddress())
+
+	rule := b.AutoConversionRuleRequest()
+	assert.Equal(t, "USD", rule.Source.Asset)
+	assert.NotEmpty(t, rule.IdempotencyKey)
+}
@@ -0,0 +1,165 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package ids provides distinct types for 1Money customer, recipient and transaction IDs,
+// with UUID validation and JSON encoding.
+//
+// Each resource has its own type, so an ID of one kind cannot be assigned to another.
+// Service methods still take IDs through the string aliases service.CustomerID,
+// service.RecipientID and service.TransactionID; those aliases are deprecated and will
+// become aliases of these types in the next minor release. Until then, pass an ID to a
+// service method with id.String().
+package ids
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// ErrInvalidID is returned when an ID is empty or not a UUID.
+var ErrInvalidID = errors.New("invalid ID")
+
+// ID types. IDs are UUIDs in canonical form; the zero value is the empty ID.
+//
+// Convert strings explicitly: ids.CustomerID(s) for trusted values, such as IDs read back
+// from your own database, or ParseCustomerID(s) for user input.
+type (
+	// CustomerID identifies a customer.
+	CustomerID string
+	// RecipientID identifies a saved payout recipient.
+	RecipientID string
+	// TransactionID identifies a transaction, such as a deposit, conversion or withdrawal.
+	TransactionID string
+)
+
+// parseID validates s as a canonical UUID.
+func parseID(kind, s string) error {
+	if s == "" {
+		return fmt.Errorf("%w: %s ID is empty", ErrInvalidID, kind)
+	}
+	if _, err := uuid.Parse(s); err != nil || len(s) != len(uuid.Nil.String()) {
+		return fmt.Errorf("%w: %s ID %q is not a UUID", ErrInvalidID, kind, s)
+	}
+	return nil
+}
+
+// unmarshalID decodes an ID from text without validating it: IDs in API responses are
+// accepted as the server sends them, and the empty ID stands for an omitted field.
+func unmarshalID[T ~string](id *T, text []byte) error {
+	*id = T(text)
+	return nil
+}
+
+// ParseCustomerID returns s as a CustomerID, or ErrInvalidID if it is not a UUID.
+func ParseCustomerID(s string) (CustomerID, error) {
+	if err := parseID("customer", s); err != nil {
+		return "", err
+	}
+	return CustomerID(s), nil
+}
+
+// String returns the ID as a string.
+func (id CustomerID) String() string {
+	return string(id)
+}
+
+// IsZero reports whether the ID is empty.
+func (id CustomerID) IsZero() bool {
+	return id == ""
+}
+
+// Validate returns ErrInvalidID if the ID is empty or not a UUID.
+func (id CustomerID) Validate() error {
+	return parseID("customer", string(id))
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (id CustomerID) MarshalText() ([]byte, error) {
+	return []byte(id), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts any string, including the empty ID.
+func (id *CustomerID) UnmarshalText(text []byte) error {
+	return unmarshalID(id, text)
+}
+
+// ParseRecipientID returns s as a RecipientID, or ErrInvalidID if it is not a UUID.
+func ParseRecipientID(s string) (RecipientID, error) {
+	if err := parseID("recipient", s); err != nil {
+		return "", err
+	}
+	return RecipientID(s), nil
+}
+
+// String returns the ID as a string.
+func (id RecipientID) String() string {
+	return string(id)
+}
+
+// IsZero reports whether the ID is empty.
+func (id RecipientID) IsZero() bool {
+	return id == ""
+}
+
+// Validate returns ErrInvalidID if the ID is empty or not a UUID.
+func (id RecipientID) Validate() error {
+	return parseID("recipient", string(id))
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (id RecipientID) MarshalText() ([]byte, error) {
+	return []byte(id), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts any string, including the empty ID.
+func (id *RecipientID) UnmarshalText(text []byte) error {
+	return unmarshalID(id, text)
+}
+
+// ParseTransactionID returns s as a TransactionID, or ErrInvalidID if it is not a UUID.
+func ParseTransactionID(s string) (TransactionID, error) {
+	if err := parseID("transaction", s); err != nil {
+		return "", err
+	}
+	return TransactionID(s), nil
+}
+
+// String returns the ID as a string.
+func (id TransactionID) String() string {
+	return string(id)
+}
+
+// IsZero reports whether the ID is empty.
+func (id TransactionID) IsZero() bool {
+	return id == ""
+}
+
+// Validate returns ErrInvalidID if the ID is empty or not a UUID.
+func (id TransactionID) Validate() error {
+	return parseID("transaction", string(id))
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (id TransactionID) MarshalText() ([]byte, error) {
+	return []byte(id), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts any string, including the empty ID.
+func (id *TransactionID) UnmarshalText(text []byte) error {
+	return unmarshalID(id, text)
+}
@@ -0,0 +1,109 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ids
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+const testUUID = "3f0c6a2e-9b1d-4c8e-a7f5-2d6b8e1c4a90"
+
+func TestParseCustomerID(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		wantErr bool
+	}{
+		{name: "uuid", in: testUUID},
+		{name: "empty", in: "", wantErr: true},
+		{name: "not a uuid", in: "cus_123", wantErr: true},
+		{name: "braced uuid", in: "{" + testUUID + "}", wantErr: true},
+		{name: "urn uuid", in: "urn:uuid:" + testUUID, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := ParseCustomerID(tt.in)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidID) {
+					t.Fatalf("ParseCustomerID(%q) error = %v, want ErrInvalidID", tt.in, err)
+				}
+				return
+			}
+			if err != nil || id.String() != tt.in {
+				t.Fatalf("ParseCustomerID(%q) = %q, %v", tt.in, id, err)
+			}
+		})
+	}
+}
+
+func TestIDValidate(t *testing.T) {
+	if err := TransactionID(testUUID).Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+	var zero RecipientID
+	if !zero.IsZero() || !errors.Is(zero.Validate(), ErrInvalidID) {
+		t.Errorf("zero RecipientID: IsZero() = %v, Validate() = %v", zero.IsZero(), zero.Validate())
+	}
+	if err := RecipientID("not-a-uuid").Validate(); !errors.Is(err, ErrInvalidID) {
+		t.Errorf("Validate() error = %v, want ErrInvalidID", err)
+	}
+}
+
+func TestIDJSON(t *testing.T) {
+	type payload struct {
+		CustomerID    CustomerID    `json:"customer_id"`
+		RecipientID   RecipientID   `json:"recipient_id,omitempty"`
+		TransactionID TransactionID `json:"transaction_id"`
+	}
+
+	in := payload{CustomerID: testUUID, TransactionID: testUUID}
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	want := `{"customer_id":"` + testUUID + `","transaction_id":"` + testUUID + `"}`
+	if string(data) != want {
+		t.Fatalf("Marshal() = %s, want %s", data, want)
+	}
+
+	var out payload
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if out != in {
+		t.Errorf("round trip = %+v, want %+v", out, in)
+	}
+
+	if err := json.Unmarshal([]byte(`{"customer_id":"","transaction_id":""}`), &out); err != nil {
+		t.Errorf("Unmarshal() of empty IDs error = %v", err)
+	}
+	if !out.CustomerID.IsZero() || !out.TransactionID.IsZero() {
+		t.Errorf("empty IDs decoded as %+v", out)
+	}
+
+	if err := json.Unmarshal([]byte(`{"customer_id":"cus_legacy-42"}`), &out); err != nil {
+		t.Fatalf("Unmarshal() of non-UUID ID error = %v", err)
+	}
+	if out.CustomerID != "cus_legacy-42" {
+		t.Errorf("CustomerID = %q, want %q", out.CustomerID, "cus_legacy-42")
+	}
+	if err := out.CustomerID.Validate(); !errors.Is(err, ErrInvalidID) {
+		t.Errorf("Validate() of non-UUID ID error = %v, want ErrInvalidID", err)
+	}
+}
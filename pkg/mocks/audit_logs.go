@@ -0,0 +1,59 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by svcgen mocks. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"iter"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/audit_logs"
+)
+
+// AuditLogsService is a stub implementation of audit_logs.Service.
+//
+// Each method records the call and delegates to the function field of the same name
+// with a Func suffix. Calling a method whose function is not set panics.
+type AuditLogsService struct {
+	recorder
+
+	// ListFunc implements List.
+	ListFunc func(ctx context.Context, filter *audit_logs.ListRequest) (*audit_logs.ListResponse, error)
+	// AllFunc implements All.
+	AllFunc func(ctx context.Context, filter *audit_logs.ListRequest) iter.Seq2[*audit_logs.Entry, error]
+}
+
+var _ audit_logs.Service = (*AuditLogsService)(nil)
+
+// List calls ListFunc.
+func (mock *AuditLogsService) List(ctx context.Context, filter *audit_logs.ListRequest) (*audit_logs.ListResponse, error) {
+	mock.record("List", ctx, filter)
+	if mock.ListFunc == nil {
+		panic("mocks: AuditLogsService.List called but ListFunc is not set")
+	}
+	return mock.ListFunc(ctx, filter)
+}
+
+// All calls AllFunc.
+func (mock *AuditLogsService) All(ctx context.Context, filter *audit_logs.ListRequest) iter.Seq2[*audit_logs.Entry, error] {
+	mock.record("All", ctx, filter)
+	if mock.AllFunc == nil {
+		panic("mocks: AuditLogsService.All called but AllFunc is not set")
+	}
+	return mock.AllFunc(ctx, filter)
+}
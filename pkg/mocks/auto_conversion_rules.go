@@ -0,0 +1,192 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by svcgen mocks. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/auto_conversion_rules"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/transactions"
+)
+
+// AutoConversionRulesService is a stub implementation of auto_conversion_rules.Service.
+//
+// Each method records the call and delegates to the function field of the same name
+// with a Func suffix. Calling a method whose function is not set panics.
+type AutoConversionRulesService struct {
+	recorder
+
+	// CreateRuleFunc implements CreateRule.
+	CreateRuleFunc func(ctx context.Context, customerID svc.CustomerID, req *auto_conversion_rules.CreateRuleRequest) (*auto_conversion_rules.RuleResponse, error)
+	// GetRuleFunc implements GetRule.
+	GetRuleFunc func(ctx context.Context, customerID svc.CustomerID, ruleID string) (*auto_conversion_rules.RuleResponse, error)
+	// GetRuleByIdempotencyKeyFunc implements GetRuleByIdempotencyKey.
+	GetRuleByIdempotencyKeyFunc func(ctx context.Context, customerID svc.CustomerID, idempotencyKey string) (*auto_conversion_rules.RuleResponse, error)
+	// UpdateRuleFunc implements UpdateRule.
+	UpdateRuleFunc func(ctx context.Context, customerID svc.CustomerID, ruleID string, req *auto_conversion_rules.UpdateRuleRequest) (*auto_conversion_rules.RuleResponse, error)
+	// PauseRuleFunc implements PauseRule.
+	PauseRuleFunc func(ctx context.Context, customerID svc.CustomerID, ruleID string) (*auto_conversion_rules.RuleResponse, error)
+	// ResumeRuleFunc implements ResumeRule.
+	ResumeRuleFunc func(ctx context.Context, customerID svc.CustomerID, ruleID string) (*auto_conversion_rules.RuleResponse, error)
+	// ListRulesFunc implements ListRules.
+	ListRulesFunc func(ctx context.Context, customerID svc.CustomerID, req *auto_conversion_rules.ListRulesRequest) (*auto_conversion_rules.ListRulesResponse, error)
+	// DeleteRuleFunc implements DeleteRule.
+	DeleteRuleFunc func(ctx context.Context, customerID svc.CustomerID, ruleID string) error
+	// ListOrdersFunc implements ListOrders.
+	ListOrdersFunc func(ctx context.Context, customerID svc.CustomerID, ruleID string, req *auto_conversion_rules.ListOrdersRequest) (*auto_conversion_rules.ListOrdersResponse, error)
+	// ListAllOrdersFunc implements ListAllOrders.
+	ListAllOrdersFunc func(ctx context.Context, customerID svc.CustomerID, req *auto_conversion_rules.ListAllOrdersRequest) (*auto_conversion_rules.ListOrdersResponse, error)
+	// GetOrderFunc implements GetOrder.
+	GetOrderFunc func(ctx context.Context, customerID svc.CustomerID, ruleID string, orderID string) (*auto_conversion_rules.OrderResponse, error)
+	// RetryOrderFunc implements RetryOrder.
+	RetryOrderFunc func(ctx context.Context, customerID svc.CustomerID, ruleID string, orderID string, retryToken string) (*auto_conversion_rules.OrderResponse, error)
+	// GetRuleStatsFunc implements GetRuleStats.
+	GetRuleStatsFunc func(ctx context.Context, customerID svc.CustomerID, ruleID string, period transactions.Period) (*auto_conversion_rules.RuleStatsResponse, error)
+	// GetOrderByDepositTransactionFunc implements GetOrderByDepositTransaction.
+	GetOrderByDepositTransactionFunc func(ctx context.Context, customerID svc.CustomerID, depositTransactionID svc.TransactionID) (*auto_conversion_rules.OrderResponse, error)
+}
+
+var _ auto_conversion_rules.Service = (*AutoConversionRulesService)(nil)
+
+// CreateRule calls CreateRuleFunc.
+func (mock *AutoConversionRulesService) CreateRule(ctx context.Context, customerID svc.CustomerID, req *auto_conversion_rules.CreateRuleRequest) (*auto_conversion_rules.RuleResponse, error) {
+	mock.record("CreateRule", ctx, customerID, req)
+	if mock.CreateRuleFunc == nil {
+		panic("mocks: AutoConversionRulesService.CreateRule called but CreateRuleFunc is not set")
+	}
+	return mock.CreateRuleFunc(ctx, customerID, req)
+}
+
+// GetRule calls GetRuleFunc.
+func (mock *AutoConversionRulesService) GetRule(ctx context.Context, customerID svc.CustomerID, ruleID string) (*auto_conversion_rules.RuleResponse, error) {
+	mock.record("GetRule", ctx, customerID, ruleID)
+	if mock.GetRuleFunc == nil {
+		panic("mocks: AutoConversionRulesService.GetRule called but GetRuleFunc is not set")
+	}
+	return mock.GetRuleFunc(ctx, customerID, ruleID)
+}
+
+// GetRuleByIdempotencyKey calls GetRuleByIdempotencyKeyFunc.
+func (mock *AutoConversionRulesService) GetRuleByIdempotencyKey(ctx context.Context, customerID svc.CustomerID, idempotencyKey string) (*auto_conversion_rules.RuleResponse, error) {
+	mock.record("GetRuleByIdempotencyKey", ctx, customerID, idempotencyKey)
+	if mock.GetRuleByIdempotencyKeyFunc == nil {
+		panic("mocks: AutoConversionRulesService.GetRuleByIdempotencyKey called but GetRuleByIdempotencyKeyFunc is not set")
+	}
+	return mock.GetRuleByIdempotencyKeyFunc(ctx, customerID, idempotencyKey)
+}
+
+// UpdateRule calls UpdateRuleFunc.
+func (mock *AutoConversionRulesService) UpdateRule(ctx context.Context, customerID svc.CustomerID, ruleID string, req *auto_conversion_rules.UpdateRuleRequest) (*auto_conversion_rules.RuleResponse, error) {
+	mock.record("UpdateRule", ctx, customerID, ruleID, req)
+	if mock.UpdateRuleFunc == nil {
+		panic("mocks: AutoConversionRulesService.UpdateRule called but UpdateRuleFunc is not set")
+	}
+	return mock.UpdateRuleFunc(ctx, customerID, ruleID, req)
+}
+
+// PauseRule calls PauseRuleFunc.
+func (mock *AutoConversionRulesService) PauseRule(ctx context.Context, customerID svc.CustomerID, ruleID string) (*auto_conversion_rules.RuleResponse, error) {
+	mock.record("PauseRule", ctx, customerID, ruleID)
+	if mock.PauseRuleFunc == nil {
+		panic("mocks: AutoConversionRulesService.PauseRule called but PauseRuleFunc is not set")
+	}
+	return mock.PauseRuleFunc(ctx, customerID, ruleID)
+}
+
+// ResumeRule calls ResumeRuleFunc.
+func (mock *AutoConversionRulesService) ResumeRule(ctx context.Context, customerID svc.CustomerID, ruleID string) (*auto_conversion_rules.RuleResponse, error) {
+	mock.record("ResumeRule", ctx, customerID, ruleID)
+	if mock.ResumeRuleFunc == nil {
+		panic("mocks: AutoConversionRulesService.ResumeRule called but ResumeRuleFunc is not set")
+	}
+	return mock.ResumeRuleFunc(ctx, customerID, ruleID)
+}
+
+// ListRules calls ListRulesFunc.
+func (mock *AutoConversionRulesService) ListRules(ctx context.Context, customerID svc.CustomerID, req *auto_conversion_rules.ListRulesRequest) (*auto_conversion_rules.ListRulesResponse, error) {
+	mock.record("ListRules", ctx, customerID, req)
+	if mock.ListRulesFunc == nil {
+		panic("mocks: AutoConversionRulesService.ListRules called but ListRulesFunc is not set")
+	}
+	return mock.ListRulesFunc(ctx, customerID, req)
+}
+
+// DeleteRule calls DeleteRuleFunc.
+func (mock *AutoConversionRulesService) DeleteRule(ctx context.Context, customerID svc.CustomerID, ruleID string) error {
+	mock.record("DeleteRule", ctx, customerID, ruleID)
+	if mock.DeleteRuleFunc == nil {
+		panic("mocks: AutoConversionRulesService.DeleteRule called but DeleteRuleFunc is not set")
+	}
+	return mock.DeleteRuleFunc(ctx, customerID, ruleID)
+}
+
+// ListOrders calls ListOrdersFunc.
+func (mock *AutoConversionRulesService) ListOrders(ctx context.Context, customerID svc.CustomerID, ruleID string, req *auto_conversion_rules.ListOrdersRequest) (*auto_conversion_rules.ListOrdersResponse, error) {
+	mock.record("ListOrders", ctx, customerID, ruleID, req)
+	if mock.ListOrdersFunc == nil {
+		panic("mocks: AutoConversionRulesService.ListOrders called but ListOrdersFunc is not set")
+	}
+	return mock.ListOrdersFunc(ctx, customerID, ruleID, req)
+}
+
+// ListAllOrders calls ListAllOrdersFunc.
+func (mock *AutoConversionRulesService) ListAllOrders(ctx context.Context, customerID svc.CustomerID, req *auto_conversion_rules.ListAllOrdersRequest) (*auto_conversion_rules.ListOrdersResponse, error) {
+	mock.record("ListAllOrders", ctx, customerID, req)
+	if mock.ListAllOrdersFunc == nil {
+		panic("mocks: AutoConversionRulesService.ListAllOrders called but ListAllOrdersFunc is not set")
+	}
+	return mock.ListAllOrdersFunc(ctx, customerID, req)
+}
+
+// GetOrder calls GetOrderFunc.
+func (mock *AutoConversionRulesService) GetOrder(ctx context.Context, customerID svc.CustomerID, ruleID string, orderID string) (*auto_conversion_rules.OrderResponse, error) {
+	mock.record("GetOrder", ctx, customerID, ruleID, orderID)
+	if mock.GetOrderFunc == nil {
+		panic("mocks: AutoConversionRulesService.GetOrder called but GetOrderFunc is not set")
+	}
+	return mock.GetOrderFunc(ctx, customerID, ruleID, orderID)
+}
+
+// RetryOrder calls RetryOrderFunc.
+func (mock *AutoConversionRulesService) RetryOrder(ctx context.Context, customerID svc.CustomerID, ruleID string, orderID string, retryToken string) (*auto_conversion_rules.OrderResponse, error) {
+	mock.record("RetryOrder", ctx, customerID, ruleID, orderID, retryToken)
+	if mock.RetryOrderFunc == nil {
+		panic("mocks: AutoConversionRulesService.RetryOrder called but RetryOrderFunc is not set")
+	}
+	return mock.RetryOrderFunc(ctx, customerID, ruleID, orderID, retryToken)
+}
+
+// GetRuleStats calls GetRuleStatsFunc.
+func (mock *AutoConversionRulesService) GetRuleStats(ctx context.Context, customerID svc.CustomerID, ruleID string, period transactions.Period) (*auto_conversion_rules.RuleStatsResponse, error) {
+	mock.record("GetRuleStats", ctx, customerID, ruleID, period)
+	if mock.GetRuleStatsFunc == nil {
+		panic("mocks: AutoConversionRulesService.GetRuleStats called but GetRuleStatsFunc is not set")
+	}
+	return mock.GetRuleStatsFunc(ctx, customerID, ruleID, period)
+}
+
+// GetOrderByDepositTransaction calls GetOrderByDepositTransactionFunc.
+func (mock *AutoConversionRulesService) GetOrderByDepositTransaction(ctx context.Context, customerID svc.CustomerID, depositTransactionID svc.TransactionID) (*auto_conversion_rules.OrderResponse, error) {
+	mock.record("GetOrderByDepositTransaction", ctx, customerID, depositTransactionID)
+	if mock.GetOrderByDepositTransactionFunc == nil {
+		panic("mocks: AutoConversionRulesService.GetOrderByDepositTransaction called but GetOrderByDepositTransactionFunc is not set")
+	}
+	return mock.GetOrderByDepositTransactionFunc(ctx, customerID, depositTransactionID)
+}
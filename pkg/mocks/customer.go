@@ -0,0 +1,158 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by svcgen mocks. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/customer"
+)
+
+// CustomerService is a stub implementation of customer.Service.
+//
+// Each method records the call and delegates to the function field of the same name
+// with a Func suffix. Calling a method whose function is not set panics.
+type CustomerService struct {
+	recorder
+
+	// CreateTOSLinkFunc implements CreateTOSLink.
+	CreateTOSLinkFunc func(ctx context.Context, req *customer.CreateTOSLinkRequest) (*customer.TOSLinkResponse, error)
+	// SignTOSAgreementFunc implements SignTOSAgreement.
+	SignTOSAgreementFunc func(ctx context.Context, sessionToken string) (*customer.SignAgreementResponse, error)
+	// CreateCustomerFunc implements CreateCustomer.
+	CreateCustomerFunc func(ctx context.Context, req *customer.CreateCustomerRequest) (*customer.CreateCustomerResponse, error)
+	// ListCustomersFunc implements ListCustomers.
+	ListCustomersFunc func(ctx context.Context, req *customer.ListCustomersRequest) (*customer.ListCustomersResponse, error)
+	// GetCustomerFunc implements GetCustomer.
+	GetCustomerFunc func(ctx context.Context, id svc.CustomerID) (*customer.CustomerResponse, error)
+	// UpdateCustomerFunc implements UpdateCustomer.
+	UpdateCustomerFunc func(ctx context.Context, id svc.CustomerID, req *customer.UpdateCustomerRequest) (*customer.UpdateCustomerResponse, error)
+	// CreateAssociatedPersonFunc implements CreateAssociatedPerson.
+	CreateAssociatedPersonFunc func(ctx context.Context, id svc.CustomerID, req *customer.CreateAssociatedPersonRequest) (*customer.AssociatedPersonResponse, error)
+	// ListAssociatedPersonsFunc implements ListAssociatedPersons.
+	ListAssociatedPersonsFunc func(ctx context.Context, id svc.CustomerID) (*customer.ListAssociatedPersonsResponse, error)
+	// GetAssociatedPersonFunc implements GetAssociatedPerson.
+	GetAssociatedPersonFunc func(ctx context.Context, id svc.CustomerID, associatedPersonID string) (*customer.AssociatedPersonResponse, error)
+	// UpdateAssociatedPersonFunc implements UpdateAssociatedPerson.
+	UpdateAssociatedPersonFunc func(ctx context.Context, id svc.CustomerID, associatedPersonID string, req *customer.UpdateAssociatedPersonRequest) (*customer.AssociatedPersonResponse, error)
+	// DeleteAssociatedPersonFunc implements DeleteAssociatedPerson.
+	DeleteAssociatedPersonFunc func(ctx context.Context, id svc.CustomerID, associatedPersonID string) error
+}
+
+var _ customer.Service = (*CustomerService)(nil)
+
+// CreateTOSLink calls CreateTOSLinkFunc.
+func (mock *CustomerService) CreateTOSLink(ctx context.Context, req *customer.CreateTOSLinkRequest) (*customer.TOSLinkResponse, error) {
+	mock.record("CreateTOSLink", ctx, req)
+	if mock.CreateTOSLinkFunc == nil {
+		panic("mocks: CustomerService.CreateTOSLink called but CreateTOSLinkFunc is not set")
+	}
+	return mock.CreateTOSLinkFunc(ctx, req)
+}
+
+// SignTOSAgreement calls SignTOSAgreementFunc.
+func (mock *CustomerService) SignTOSAgreement(ctx context.Context, sessionToken string) (*customer.SignAgreementResponse, error) {
+	mock.record("SignTOSAgreement", ctx, sessionToken)
+	if mock.SignTOSAgreementFunc == nil {
+		panic("mocks: CustomerService.SignTOSAgreement called but SignTOSAgreementFunc is not set")
+	}
+	return mock.SignTOSAgreementFunc(ctx, sessionToken)
+}
+
+// CreateCustomer calls CreateCustomerFunc.
+func (mock *CustomerService) CreateCustomer(ctx context.Context, req *customer.CreateCustomerRequest) (*customer.CreateCustomerResponse, error) {
+	mock.record("CreateCustomer", ctx, req)
+	if mock.CreateCustomerFunc == nil {
+		panic("mocks: CustomerService.CreateCustomer called but CreateCustomerFunc is not set")
+	}
+	return mock.CreateCustomerFunc(ctx, req)
+}
+
+// ListCustomers calls ListCustomersFunc.
+func (mock *CustomerService) ListCustomers(ctx context.Context, req *customer.ListCustomersRequest) (*customer.ListCustomersResponse, error) {
+	mock.record("ListCustomers", ctx, req)
+	if mock.ListCustomersFunc == nil {
+		panic("mocks: CustomerService.ListCustomers called but ListCustomersFunc is not set")
+	}
+	return mock.ListCustomersFunc(ctx, req)
+}
+
+// GetCustomer calls GetCustomerFunc.
+func (mock *CustomerService) GetCustomer(ctx context.Context, id svc.CustomerID) (*customer.CustomerResponse, error) {
+	mock.record("GetCustomer", ctx, id)
+	if mock.GetCustomerFunc == nil {
+		panic("mocks: CustomerService.GetCustomer called but GetCustomerFunc is not set")
+	}
+	return mock.GetCustomerFunc(ctx, id)
+}
+
+// UpdateCustomer calls UpdateCustomerFunc.
+func (mock *CustomerService) UpdateCustomer(ctx context.Context, id svc.CustomerID, req *customer.UpdateCustomerRequest) (*customer.UpdateCustomerResponse, error) {
+	mock.record("UpdateCustomer", ctx, id, req)
+	if mock.UpdateCustomerFunc == nil {
+		panic("mocks: CustomerService.UpdateCustomer called but UpdateCustomerFunc is not set")
+	}
+	return mock.UpdateCustomerFunc(ctx, id, req)
+}
+
+// CreateAssociatedPerson calls CreateAssociatedPersonFunc.
+func (mock *CustomerService) CreateAssociatedPerson(ctx context.Context, id svc.CustomerID, req *customer.CreateAssociatedPersonRequest) (*customer.AssociatedPersonResponse, error) {
+	mock.record("CreateAssociatedPerson", ctx, id, req)
+	if mock.CreateAssociatedPersonFunc == nil {
+		panic("mocks: CustomerService.CreateAssociatedPerson called but CreateAssociatedPersonFunc is not set")
+	}
+	return mock.CreateAssociatedPersonFunc(ctx, id, req)
+}
+
+// ListAssociatedPersons calls ListAssociatedPersonsFunc.
+func (mock *CustomerService) ListAssociatedPersons(ctx context.Context, id svc.CustomerID) (*customer.ListAssociatedPersonsResponse, error) {
+	mock.record("ListAssociatedPersons", ctx, id)
+	if mock.ListAssociatedPersonsFunc == nil {
+		panic("mocks: CustomerService.ListAssociatedPersons called but ListAssociatedPersonsFunc is not set")
+	}
+	return mock.ListAssociatedPersonsFunc(ctx, id)
+}
+
+// GetAssociatedPerson calls GetAssociatedPersonFunc.
+func (mock *CustomerService) GetAssociatedPerson(ctx context.Context, id svc.CustomerID, associatedPersonID string) (*customer.AssociatedPersonResponse, error) {
+	mock.record("GetAssociatedPerson", ctx, id, associatedPersonID)
+	if mock.GetAssociatedPersonFunc == nil {
+		panic("mocks: CustomerService.GetAssociatedPerson called but GetAssociatedPersonFunc is not set")
+	}
+	return mock.GetAssociatedPersonFunc(ctx, id, associatedPersonID)
+}
+
+// UpdateAssociatedPerson calls UpdateAssociatedPersonFunc.
+func (mock *CustomerService) UpdateAssociatedPerson(ctx context.Context, id svc.CustomerID, associatedPersonID string, req *customer.UpdateAssociatedPersonRequest) (*customer.AssociatedPersonResponse, error) {
+	mock.record("UpdateAssociatedPerson", ctx, id, associatedPersonID, req)
+	if mock.UpdateAssociatedPersonFunc == nil {
+		panic("mocks: CustomerService.UpdateAssociatedPerson called but UpdateAssociatedPersonFunc is not set")
+	}
+	return mock.UpdateAssociatedPersonFunc(ctx, id, associatedPersonID, req)
+}
+
+// DeleteAssociatedPerson calls DeleteAssociatedPersonFunc.
+func (mock *CustomerService) DeleteAssociatedPerson(ctx context.Context, id svc.CustomerID, associatedPersonID string) error {
+	mock.record("DeleteAssociatedPerson", ctx, id, associatedPersonID)
+	if mock.DeleteAssociatedPersonFunc == nil {
+		panic("mocks: CustomerService.DeleteAssociatedPerson called but DeleteAssociatedPersonFunc is not set")
+	}
+	return mock.DeleteAssociatedPersonFunc(ctx, id, associatedPersonID)
+}
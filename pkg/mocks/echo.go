@@ -0,0 +1,58 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by svcgen mocks. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/echo"
+)
+
+// EchoService is a stub implementation of echo.Service.
+//
+// Each method records the call and delegates to the function field of the same name
+// with a Func suffix. Calling a method whose function is not set panics.
+type EchoService struct {
+	recorder
+
+	// GetFunc implements Get.
+	GetFunc func(ctx context.Context) (*echo.Response, error)
+	// PostFunc implements Post.
+	PostFunc func(ctx context.Context, req *echo.Request) (*echo.Response, error)
+}
+
+var _ echo.Service = (*EchoService)(nil)
+
+// Get calls GetFunc.
+func (mock *EchoService) Get(ctx context.Context) (*echo.Response, error) {
+	mock.record("Get", ctx)
+	if mock.GetFunc == nil {
+		panic("mocks: EchoService.Get called but GetFunc is not set")
+	}
+	return mock.GetFunc(ctx)
+}
+
+// Post calls PostFunc.
+func (mock *EchoService) Post(ctx context.Context, req *echo.Request) (*echo.Response, error) {
+	mock.record("Post", ctx, req)
+	if mock.PostFunc == nil {
+		panic("mocks: EchoService.Post called but PostFunc is not set")
+	}
+	return mock.PostFunc(ctx, req)
+}
@@ -0,0 +1,71 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by svcgen mocks. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"iter"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/events"
+)
+
+// EventsService is a stub implementation of events.Service.
+//
+// Each method records the call and delegates to the function field of the same name
+// with a Func suffix. Calling a method whose function is not set panics.
+type EventsService struct {
+	recorder
+
+	// ListFunc implements List.
+	ListFunc func(ctx context.Context, id svc.CustomerID, sinceCursor string) (*events.ListResponse, error)
+	// AllFunc implements All.
+	AllFunc func(ctx context.Context, id svc.CustomerID, sinceCursor string) iter.Seq2[*events.Event, error]
+	// LatestFunc implements Latest.
+	LatestFunc func(ctx context.Context, id svc.CustomerID) (*events.Event, error)
+}
+
+var _ events.Service = (*EventsService)(nil)
+
+// List calls ListFunc.
+func (mock *EventsService) List(ctx context.Context, id svc.CustomerID, sinceCursor string) (*events.ListResponse, error) {
+	mock.record("List", ctx, id, sinceCursor)
+	if mock.ListFunc == nil {
+		panic("mocks: EventsService.List called but ListFunc is not set")
+	}
+	return mock.ListFunc(ctx, id, sinceCursor)
+}
+
+// All calls AllFunc.
+func (mock *EventsService) All(ctx context.Context, id svc.CustomerID, sinceCursor string) iter.Seq2[*events.Event, error] {
+	mock.record("All", ctx, id, sinceCursor)
+	if mock.AllFunc == nil {
+		panic("mocks: EventsService.All called but AllFunc is not set")
+	}
+	return mock.AllFunc(ctx, id, sinceCursor)
+}
+
+// Latest calls LatestFunc.
+func (mock *EventsService) Latest(ctx context.Context, id svc.CustomerID) (*events.Event, error) {
+	mock.record("Latest", ctx, id)
+	if mock.LatestFunc == nil {
+		panic("mocks: EventsService.Latest called but LatestFunc is not set")
+	}
+	return mock.LatestFunc(ctx, id)
+}
@@ -0,0 +1,159 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by svcgen mocks. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"iter"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/external_accounts"
+)
+
+// ExternalAccountsService is a stub implementation of external_accounts.Service.
+//
+// Each method records the call and delegates to the function field of the same name
+// with a Func suffix. Calling a method whose function is not set panics.
+type ExternalAccountsService struct {
+	recorder
+
+	// CreateExternalAccountFunc implements CreateExternalAccount.
+	CreateExternalAccountFunc func(ctx context.Context, id svc.CustomerID, req *external_accounts.CreateReq) (*external_accounts.Resp, error)
+	// CreateFromPlaidTokenFunc implements CreateFromPlaidToken.
+	CreateFromPlaidTokenFunc func(ctx context.Context, id svc.CustomerID, processorToken string, meta *external_accounts.PlaidMeta) (*external_accounts.Resp, error)
+	// GetExternalAccountFunc implements GetExternalAccount.
+	GetExternalAccountFunc func(ctx context.Context, id svc.CustomerID, externalAccountID string) (*external_accounts.Resp, error)
+	// GetExternalAccountByIdempotencyKeyFunc implements GetExternalAccountByIdempotencyKey.
+	GetExternalAccountByIdempotencyKeyFunc func(ctx context.Context, id svc.CustomerID, idempotencyKey string) (*external_accounts.Resp, error)
+	// ListExternalAccountsFunc implements ListExternalAccounts.
+	ListExternalAccountsFunc func(ctx context.Context, id svc.CustomerID, req *external_accounts.ListReq) ([]external_accounts.Resp, error)
+	// AllFunc implements All.
+	AllFunc func(ctx context.Context, id svc.CustomerID, filter *external_accounts.ListReq) iter.Seq2[*external_accounts.Resp, error]
+	// UpdateExternalAccountFunc implements UpdateExternalAccount.
+	UpdateExternalAccountFunc func(ctx context.Context, id svc.CustomerID, externalAccountID string, req *external_accounts.UpdateReq) (*external_accounts.Resp, error)
+	// StartMicroDepositVerificationFunc implements StartMicroDepositVerification.
+	StartMicroDepositVerificationFunc func(ctx context.Context, id svc.CustomerID, externalAccountID string) (*external_accounts.Resp, error)
+	// ConfirmMicroDepositsFunc implements ConfirmMicroDeposits.
+	ConfirmMicroDepositsFunc func(ctx context.Context, id svc.CustomerID, externalAccountID string, amounts []string) (*external_accounts.Resp, error)
+	// UploadVerificationDocumentFunc implements UploadVerificationDocument.
+	UploadVerificationDocumentFunc func(ctx context.Context, id svc.CustomerID, externalAccountID string, doc *external_accounts.VerificationDocument) (*external_accounts.Resp, error)
+	// RemoveExternalAccountFunc implements RemoveExternalAccount.
+	RemoveExternalAccountFunc func(ctx context.Context, id svc.CustomerID, externalAccountID string) error
+}
+
+var _ external_accounts.Service = (*ExternalAccountsService)(nil)
+
+// CreateExternalAccount calls CreateExternalAccountFunc.
+func (mock *ExternalAccountsService) CreateExternalAccount(ctx context.Context, id svc.CustomerID, req *external_accounts.CreateReq) (*external_accounts.Resp, error) {
+	mock.record("CreateExternalAccount", ctx, id, req)
+	if mock.CreateExternalAccountFunc == nil {
+		panic("mocks: ExternalAccountsService.CreateExternalAccount called but CreateExternalAccountFunc is not set")
+	}
+	return mock.CreateExternalAccountFunc(ctx, id, req)
+}
+
+// CreateFromPlaidToken calls CreateFromPlaidTokenFunc.
+func (mock *ExternalAccountsService) CreateFromPlaidToken(ctx context.Context, id svc.CustomerID, processorToken string, meta *external_accounts.PlaidMeta) (*external_accounts.Resp, error) {
+	mock.record("CreateFromPlaidToken", ctx, id, processorToken, meta)
+	if mock.CreateFromPlaidTokenFunc == nil {
+		panic("mocks: ExternalAccountsService.CreateFromPlaidToken called but CreateFromPlaidTokenFunc is not set")
+	}
+	return mock.CreateFromPlaidTokenFunc(ctx, id, processorToken, meta)
+}
+
+// GetExternalAccount calls GetExternalAccountFunc.
+func (mock *ExternalAccountsService) GetExternalAccount(ctx context.Context, id svc.CustomerID, externalAccountID string) (*external_accounts.Resp, error) {
+	mock.record("GetExternalAccount", ctx, id, externalAccountID)
+	if mock.GetExternalAccountFunc == nil {
+		panic("mocks: ExternalAccountsService.GetExternalAccount called but GetExternalAccountFunc is not set")
+	}
+	return mock.GetExternalAccountFunc(ctx, id, externalAccountID)
+}
+
+// GetExternalAccountByIdempotencyKey calls GetExternalAccountByIdempotencyKeyFunc.
+func (mock *ExternalAccountsService) GetExternalAccountByIdempotencyKey(ctx context.Context, id svc.CustomerID, idempotencyKey string) (*external_accounts.Resp, error) {
+	mock.record("GetExternalAccountByIdempotencyKey", ctx, id, idempotencyKey)
+	if mock.GetExternalAccountByIdempotencyKeyFunc == nil {
+		panic("mocks: ExternalAccountsService.GetExternalAccountByIdempotencyKey called but GetExternalAccountByIdempotencyKeyFunc is not set")
+	}
+	return mock.GetExternalAccountByIdempotencyKeyFunc(ctx, id, idempotencyKey)
+}
+
+// ListExternalAccounts calls ListExternalAccountsFunc.
+func (mock *ExternalAccountsService) ListExternalAccounts(ctx context.Context, id svc.CustomerID, req *external_accounts.ListReq) ([]external_accounts.Resp, error) {
+	mock.record("ListExternalAccounts", ctx, id, req)
+	if mock.ListExternalAccountsFunc == nil {
+		panic("mocks: ExternalAccountsService.ListExternalAccounts called but ListExternalAccountsFunc is not set")
+	}
+	return mock.ListExternalAccountsFunc(ctx, id, req)
+}
+
+// All calls AllFunc.
+func (mock *ExternalAccountsService) All(ctx context.Context, id svc.CustomerID, filter *external_accounts.ListReq) iter.Seq2[*external_accounts.Resp, error] {
+	mock.record("All", ctx, id, filter)
+	if mock.AllFunc == nil {
+		panic("mocks: ExternalAccountsService.All called but AllFunc is not set")
+	}
+	return mock.AllFunc(ctx, id, filter)
+}
+
+// UpdateExternalAccount calls UpdateExternalAccountFunc.
+func (mock *ExternalAccountsService) UpdateExternalAccount(ctx context.Context, id svc.CustomerID, externalAccountID string, req *external_accounts.UpdateReq) (*external_accounts.Resp, error) {
+	mock.record("UpdateExternalAccount", ctx, id, externalAccountID, req)
+	if mock.UpdateExternalAccountFunc == nil {
+		panic("mocks: ExternalAccountsService.UpdateExternalAccount called but UpdateExternalAccountFunc is not set")
+	}
+	return mock.UpdateExternalAccountFunc(ctx, id, externalAccountID, req)
+}
+
+// StartMicroDepositVerification calls StartMicroDepositVerificationFunc.
+func (mock *ExternalAccountsService) StartMicroDepositVerification(ctx context.Context, id svc.CustomerID, externalAccountID string) (*external_accounts.Resp, error) {
+	mock.record("StartMicroDepositVerification", ctx, id, externalAccountID)
+	if mock.StartMicroDepositVerificationFunc == nil {
+		panic("mocks: ExternalAccountsService.StartMicroDepositVerification called but StartMicroDepositVerificationFunc is not set")
+	}
+	return mock.StartMicroDepositVerificationFunc(ctx, id, externalAccountID)
+}
+
+// ConfirmMicroDeposits calls ConfirmMicroDepositsFunc.
+func (mock *ExternalAccountsService) ConfirmMicroDeposits(ctx context.Context, id svc.CustomerID, externalAccountID string, amounts []string) (*external_accounts.Resp, error) {
+	mock.record("ConfirmMicroDeposits", ctx, id, externalAccountID, amounts)
+	if mock.ConfirmMicroDepositsFunc == nil {
+		panic("mocks: ExternalAccountsService.ConfirmMicroDeposits called but ConfirmMicroDepositsFunc is not set")
+	}
+	return mock.ConfirmMicroDepositsFunc(ctx, id, externalAccountID, amounts)
+}
+
+// UploadVerificationDocument calls UploadVerificationDocumentFunc.
+func (mock *ExternalAccountsService) UploadVerificationDocument(ctx context.Context, id svc.CustomerID, externalAccountID string, doc *external_accounts.VerificationDocument) (*external_accounts.Resp, error) {
+	mock.record("UploadVerificationDocument", ctx, id, externalAccountID, doc)
+	if mock.UploadVerificationDocumentFunc == nil {
+		panic("mocks: ExternalAccountsService.UploadVerificationDocument called but UploadVerificationDocumentFunc is not set")
+	}
+	return mock.UploadVerificationDocumentFunc(ctx, id, externalAccountID, doc)
+}
+
+// RemoveExternalAccount calls RemoveExternalAccountFunc.
+func (mock *ExternalAccountsService) RemoveExternalAccount(ctx context.Context, id svc.CustomerID, externalAccountID string) error {
+	mock.record("RemoveExternalAccount", ctx, id, externalAccountID)
+	if mock.RemoveExternalAccountFunc == nil {
+		panic("mocks: ExternalAccountsService.RemoveExternalAccount called but RemoveExternalAccountFunc is not set")
+	}
+	return mock.RemoveExternalAccountFunc(ctx, id, externalAccountID)
+}
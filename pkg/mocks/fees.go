@@ -0,0 +1,48 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by svcgen mocks. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/fees"
+)
+
+// FeesService is a stub implementation of fees.Service.
+//
+// Each method records the call and delegates to the function field of the same name
+// with a Func suffix. Calling a method whose function is not set panics.
+type FeesService struct {
+	recorder
+
+	// GetFeeScheduleFunc implements GetFeeSchedule.
+	GetFeeScheduleFunc func(ctx context.Context, id svc.CustomerID) (*fees.FeeScheduleResponse, error)
+}
+
+var _ fees.Service = (*FeesService)(nil)
+
+// GetFeeSchedule calls GetFeeScheduleFunc.
+func (mock *FeesService) GetFeeSchedule(ctx context.Context, id svc.CustomerID) (*fees.FeeScheduleResponse, error) {
+	mock.record("GetFeeSchedule", ctx, id)
+	if mock.GetFeeScheduleFunc == nil {
+		panic("mocks: FeesService.GetFeeSchedule called but GetFeeScheduleFunc is not set")
+	}
+	return mock.GetFeeScheduleFunc(ctx, id)
+}
@@ -0,0 +1,60 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by svcgen mocks. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"iter"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/ledger"
+)
+
+// LedgerService is a stub implementation of ledger.Service.
+//
+// Each method records the call and delegates to the function field of the same name
+// with a Func suffix. Calling a method whose function is not set panics.
+type LedgerService struct {
+	recorder
+
+	// ListEntriesFunc implements ListEntries.
+	ListEntriesFunc func(ctx context.Context, id svc.CustomerID, filter *ledger.ListEntriesRequest) (*ledger.ListEntriesResponse, error)
+	// AllFunc implements All.
+	AllFunc func(ctx context.Context, id svc.CustomerID, filter *ledger.ListEntriesRequest) iter.Seq2[*ledger.Entry, error]
+}
+
+var _ ledger.Service = (*LedgerService)(nil)
+
+// ListEntries calls ListEntriesFunc.
+func (mock *LedgerService) ListEntries(ctx context.Context, id svc.CustomerID, filter *ledger.ListEntriesRequest) (*ledger.ListEntriesResponse, error) {
+	mock.record("ListEntries", ctx, id, filter)
+	if mock.ListEntriesFunc == nil {
+		panic("mocks: LedgerService.ListEntries called but ListEntriesFunc is not set")
+	}
+	return mock.ListEntriesFunc(ctx, id, filter)
+}
+
+// All calls AllFunc.
+func (mock *LedgerService) All(ctx context.Context, id svc.CustomerID, filter *ledger.ListEntriesRequest) iter.Seq2[*ledger.Entry, error] {
+	mock.record("All", ctx, id, filter)
+	if mock.AllFunc == nil {
+		panic("mocks: LedgerService.All called but AllFunc is not set")
+	}
+	return mock.AllFunc(ctx, id, filter)
+}
@@ -0,0 +1,59 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by svcgen mocks. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/limits"
+)
+
+// LimitsService is a stub implementation of limits.Service.
+//
+// Each method records the call and delegates to the function field of the same name
+// with a Func suffix. Calling a method whose function is not set panics.
+type LimitsService struct {
+	recorder
+
+	// GetFunc implements Get.
+	GetFunc func(ctx context.Context, id svc.CustomerID) (*limits.LimitsResponse, error)
+	// ListUtilizationFunc implements ListUtilization.
+	ListUtilizationFunc func(ctx context.Context, id svc.CustomerID, period limits.LimitPeriod) ([]limits.Utilization, error)
+}
+
+var _ limits.Service = (*LimitsService)(nil)
+
+// Get calls GetFunc.
+func (mock *LimitsService) Get(ctx context.Context, id svc.CustomerID) (*limits.LimitsResponse, error) {
+	mock.record("Get", ctx, id)
+	if mock.GetFunc == nil {
+		panic("mocks: LimitsService.Get called but GetFunc is not set")
+	}
+	return mock.GetFunc(ctx, id)
+}
+
+// ListUtilization calls ListUtilizationFunc.
+func (mock *LimitsService) ListUtilization(ctx context.Context, id svc.CustomerID, period limits.LimitPeriod) ([]limits.Utilization, error) {
+	mock.record("ListUtilization", ctx, id, period)
+	if mock.ListUtilizationFunc == nil {
+		panic("mocks: LimitsService.ListUtilization called but ListUtilizationFunc is not set")
+	}
+	return mock.ListUtilizationFunc(ctx, id, period)
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mocks
+
+import (
+	"context"
+	"testing"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/customer"
+)
+
+func TestCustomerService(t *testing.T) {
+	stub := &CustomerService{
+		GetCustomerFunc: func(_ context.Context, id svc.CustomerID) (*customer.CustomerResponse, error) {
+			return &customer.CustomerResponse{CustomerID: id}, nil
+		},
+	}
+
+	var service customer.Service = stub
+	resp, err := service.GetCustomer(context.Background(), "cus-1")
+	if err != nil || resp.CustomerID != "cus-1" {
+		t.Fatalf("GetCustomer() = %+v, %v", resp, err)
+	}
+
+	calls := stub.CallsTo("GetCustomer")
+	if len(calls) != 1 || calls[0].Args[1] != svc.CustomerID("cus-1") {
+		t.Errorf("CallsTo(GetCustomer) = %+v", calls)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("calling a method without a function did not panic")
+		}
+		if got := len(stub.Calls()); got != 2 {
+			t.Errorf("Calls() has %d calls, want 2", got)
+		}
+	}()
+	_, _ = service.ListCustomers(context.Background(), nil)
+}
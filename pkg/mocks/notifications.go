@@ -0,0 +1,59 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by svcgen mocks. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/notifications"
+)
+
+// NotificationsService is a stub implementation of notifications.Service.
+//
+// Each method records the call and delegates to the function field of the same name
+// with a Func suffix. Calling a method whose function is not set panics.
+type NotificationsService struct {
+	recorder
+
+	// GetPreferencesFunc implements GetPreferences.
+	GetPreferencesFunc func(ctx context.Context, id svc.CustomerID) (*notifications.PreferencesResponse, error)
+	// UpdatePreferencesFunc implements UpdatePreferences.
+	UpdatePreferencesFunc func(ctx context.Context, id svc.CustomerID, req *notifications.UpdatePreferencesRequest) (*notifications.PreferencesResponse, error)
+}
+
+var _ notifications.Service = (*NotificationsService)(nil)
+
+// GetPreferences calls GetPreferencesFunc.
+func (mock *NotificationsService) GetPreferences(ctx context.Context, id svc.CustomerID) (*notifications.PreferencesResponse, error) {
+	mock.record("GetPreferences", ctx, id)
+	if mock.GetPreferencesFunc == nil {
+		panic("mocks: NotificationsService.GetPreferences called but GetPreferencesFunc is not set")
+	}
+	return mock.GetPreferencesFunc(ctx, id)
+}
+
+// UpdatePreferences calls UpdatePreferencesFunc.
+func (mock *NotificationsService) UpdatePreferences(ctx context.Context, id svc.CustomerID, req *notifications.UpdatePreferencesRequest) (*notifications.PreferencesResponse, error) {
+	mock.record("UpdatePreferences", ctx, id, req)
+	if mock.UpdatePreferencesFunc == nil {
+		panic("mocks: NotificationsService.UpdatePreferences called but UpdatePreferencesFunc is not set")
+	}
+	return mock.UpdatePreferencesFunc(ctx, id, req)
+}
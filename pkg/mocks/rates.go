@@ -0,0 +1,60 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by svcgen mocks. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/rates"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/transactions"
+)
+
+// RatesService is a stub implementation of rates.Service.
+//
+// Each method records the call and delegates to the function field of the same name
+// with a Func suffix. Calling a method whose function is not set panics.
+type RatesService struct {
+	recorder
+
+	// GetHistoricalRateFunc implements GetHistoricalRate.
+	GetHistoricalRateFunc func(ctx context.Context, pair rates.Pair, timestamp time.Time) (*rates.RateResponse, error)
+	// ListDailyRatesFunc implements ListDailyRates.
+	ListDailyRatesFunc func(ctx context.Context, pair rates.Pair, period transactions.Period) ([]rates.DailyRate, error)
+}
+
+var _ rates.Service = (*RatesService)(nil)
+
+// GetHistoricalRate calls GetHistoricalRateFunc.
+func (mock *RatesService) GetHistoricalRate(ctx context.Context, pair rates.Pair, timestamp time.Time) (*rates.RateResponse, error) {
+	mock.record("GetHistoricalRate", ctx, pair, timestamp)
+	if mock.GetHistoricalRateFunc == nil {
+		panic("mocks: RatesService.GetHistoricalRate called but GetHistoricalRateFunc is not set")
+	}
+	return mock.GetHistoricalRateFunc(ctx, pair, timestamp)
+}
+
+// ListDailyRates calls ListDailyRatesFunc.
+func (mock *RatesService) ListDailyRates(ctx context.Context, pair rates.Pair, period transactions.Period) ([]rates.DailyRate, error) {
+	mock.record("ListDailyRates", ctx, pair, period)
+	if mock.ListDailyRatesFunc == nil {
+		panic("mocks: RatesService.ListDailyRates called but ListDailyRatesFunc is not set")
+	}
+	return mock.ListDailyRatesFunc(ctx, pair, period)
+}
@@ -0,0 +1,59 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by svcgen mocks. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/screening"
+)
+
+// ScreeningService is a stub implementation of screening.Service.
+//
+// Each method records the call and delegates to the function field of the same name
+// with a Func suffix. Calling a method whose function is not set panics.
+type ScreeningService struct {
+	recorder
+
+	// ScreenWalletAddressFunc implements ScreenWalletAddress.
+	ScreenWalletAddressFunc func(ctx context.Context, id svc.CustomerID, req *screening.WalletAddressRequest) (*screening.Result, error)
+	// ScreenBankCounterpartyFunc implements ScreenBankCounterparty.
+	ScreenBankCounterpartyFunc func(ctx context.Context, id svc.CustomerID, req *screening.BankCounterpartyRequest) (*screening.Result, error)
+}
+
+var _ screening.Service = (*ScreeningService)(nil)
+
+// ScreenWalletAddress calls ScreenWalletAddressFunc.
+func (mock *ScreeningService) ScreenWalletAddress(ctx context.Context, id svc.CustomerID, req *screening.WalletAddressRequest) (*screening.Result, error) {
+	mock.record("ScreenWalletAddress", ctx, id, req)
+	if mock.ScreenWalletAddressFunc == nil {
+		panic("mocks: ScreeningService.ScreenWalletAddress called but ScreenWalletAddressFunc is not set")
+	}
+	return mock.ScreenWalletAddressFunc(ctx, id, req)
+}
+
+// ScreenBankCounterparty calls ScreenBankCounterpartyFunc.
+func (mock *ScreeningService) ScreenBankCounterparty(ctx context.Context, id svc.CustomerID, req *screening.BankCounterpartyRequest) (*screening.Result, error) {
+	mock.record("ScreenBankCounterparty", ctx, id, req)
+	if mock.ScreenBankCounterpartyFunc == nil {
+		panic("mocks: ScreeningService.ScreenBankCounterparty called but ScreenBankCounterpartyFunc is not set")
+	}
+	return mock.ScreenBankCounterpartyFunc(ctx, id, req)
+}
@@ -0,0 +1,47 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by svcgen mocks. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/status"
+)
+
+// StatusService is a stub implementation of status.Service.
+//
+// Each method records the call and delegates to the function field of the same name
+// with a Func suffix. Calling a method whose function is not set panics.
+type StatusService struct {
+	recorder
+
+	// GetFunc implements Get.
+	GetFunc func(ctx context.Context) (*status.StatusResponse, error)
+}
+
+var _ status.Service = (*StatusService)(nil)
+
+// Get calls GetFunc.
+func (mock *StatusService) Get(ctx context.Context) (*status.StatusResponse, error) {
+	mock.record("Get", ctx)
+	if mock.GetFunc == nil {
+		panic("mocks: StatusService.Get called but GetFunc is not set")
+	}
+	return mock.GetFunc(ctx)
+}
@@ -0,0 +1,149 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by svcgen mocks. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"io"
+	"iter"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/transactions"
+)
+
+// TransactionsService is a stub implementation of transactions.Service.
+//
+// Each method records the call and delegates to the function field of the same name
+// with a Func suffix. Calling a method whose function is not set panics.
+type TransactionsService struct {
+	recorder
+
+	// ListTransactionsFunc implements ListTransactions.
+	ListTransactionsFunc func(ctx context.Context, id svc.CustomerID, req *transactions.ListTransactionsRequest) (*transactions.ListTransactionsResponse, error)
+	// GetTransactionFunc implements GetTransaction.
+	GetTransactionFunc func(ctx context.Context, id svc.CustomerID, transactionID svc.TransactionID) (*transactions.TransactionResponse, error)
+	// GetTransactionByIdempotencyKeyFunc implements GetTransactionByIdempotencyKey.
+	GetTransactionByIdempotencyKeyFunc func(ctx context.Context, id svc.CustomerID, idempotencyKey string) (*transactions.TransactionResponse, error)
+	// ExportFunc implements Export.
+	ExportFunc func(ctx context.Context, id svc.CustomerID, filter *transactions.ListTransactionsRequest, format transactions.ExportFormat, w io.Writer) error
+	// GetReceiptFunc implements GetReceipt.
+	GetReceiptFunc func(ctx context.Context, id svc.CustomerID, transactionID svc.TransactionID, format transactions.ReceiptFormat) (*transactions.ReceiptResponse, error)
+	// WatchFunc implements Watch.
+	WatchFunc func(ctx context.Context, id svc.CustomerID, filter *transactions.ListTransactionsRequest, opts *transactions.WatchOptions) <-chan transactions.WatchEvent
+	// UpdateMetadataFunc implements UpdateMetadata.
+	UpdateMetadataFunc func(ctx context.Context, id svc.CustomerID, transactionID svc.TransactionID, notes string, tags []string) (*transactions.TransactionResponse, error)
+	// AllFunc implements All.
+	AllFunc func(ctx context.Context, id svc.CustomerID, filter *transactions.ListTransactionsRequest, after transactions.Checkpoint) iter.Seq2[*transactions.TransactionResponse, error]
+	// GetChainFunc implements GetChain.
+	GetChainFunc func(ctx context.Context, id svc.CustomerID, transactionID svc.TransactionID) (*transactions.TransactionChain, error)
+	// GetReconciliationSummaryFunc implements GetReconciliationSummary.
+	GetReconciliationSummaryFunc func(ctx context.Context, id svc.CustomerID, period transactions.Period) (*transactions.ReconciliationSummary, error)
+}
+
+var _ transactions.Service = (*TransactionsService)(nil)
+
+// ListTransactions calls ListTransactionsFunc.
+func (mock *TransactionsService) ListTransactions(ctx context.Context, id svc.CustomerID, req *transactions.ListTransactionsRequest) (*transactions.ListTransactionsResponse, error) {
+	mock.record("ListTransactions", ctx, id, req)
+	if mock.ListTransactionsFunc == nil {
+		panic("mocks: TransactionsService.ListTransactions called but ListTransactionsFunc is not set")
+	}
+	return mock.ListTransactionsFunc(ctx, id, req)
+}
+
+// GetTransaction calls GetTransactionFunc.
+func (mock *TransactionsService) GetTransaction(ctx context.Context, id svc.CustomerID, transactionID svc.TransactionID) (*transactions.TransactionResponse, error) {
+	mock.record("GetTransaction", ctx, id, transactionID)
+	if mock.GetTransactionFunc == nil {
+		panic("mocks: TransactionsService.GetTransaction called but GetTransactionFunc is not set")
+	}
+	return mock.GetTransactionFunc(ctx, id, transactionID)
+}
+
+// GetTransactionByIdempotencyKey calls GetTransactionByIdempotencyKeyFunc.
+func (mock *TransactionsService) GetTransactionByIdempotencyKey(ctx context.Context, id svc.CustomerID, idempotencyKey string) (*transactions.TransactionResponse, error) {
+	mock.record("GetTransactionByIdempotencyKey", ctx, id, idempotencyKey)
+	if mock.GetTransactionByIdempotencyKeyFunc == nil {
+		panic("mocks: TransactionsService.GetTransactionByIdempotencyKey called but GetTransactionByIdempotencyKeyFunc is not set")
+	}
+	return mock.GetTransactionByIdempotencyKeyFunc(ctx, id, idempotencyKey)
+}
+
+// Export calls ExportFunc.
+func (mock *TransactionsService) Export(ctx context.Context, id svc.CustomerID, filter *transactions.ListTransactionsRequest, format transactions.ExportFormat, w io.Writer) error {
+	mock.record("Export", ctx, id, filter, format, w)
+	if mock.ExportFunc == nil {
+		panic("mocks: TransactionsService.Export called but ExportFunc is not set")
+	}
+	return mock.ExportFunc(ctx, id, filter, format, w)
+}
+
+// GetReceipt calls GetReceiptFunc.
+func (mock *TransactionsService) GetReceipt(ctx context.Context, id svc.CustomerID, transactionID svc.TransactionID, format transactions.ReceiptFormat) (*transactions.ReceiptResponse, error) {
+	mock.record("GetReceipt", ctx, id, transactionID, format)
+	if mock.GetReceiptFunc == nil {
+		panic("mocks: TransactionsService.GetReceipt called but GetReceiptFunc is not set")
+	}
+	return mock.GetReceiptFunc(ctx, id, transactionID, format)
+}
+
+// Watch calls WatchFunc.
+func (mock *TransactionsService) Watch(ctx context.Context, id svc.CustomerID, filter *transactions.ListTransactionsRequest, opts *transactions.WatchOptions) <-chan transactions.WatchEvent {
+	mock.record("Watch", ctx, id, filter, opts)
+	if mock.WatchFunc == nil {
+		panic("mocks: TransactionsService.Watch called but WatchFunc is not set")
+	}
+	return mock.WatchFunc(ctx, id, filter, opts)
+}
+
+// UpdateMetadata calls UpdateMetadataFunc.
+func (mock *TransactionsService) UpdateMetadata(ctx context.Context, id svc.CustomerID, transactionID svc.TransactionID, notes string, tags []string) (*transactions.TransactionResponse, error) {
+	mock.record("UpdateMetadata", ctx, id, transactionID, notes, tags)
+	if mock.UpdateMetadataFunc == nil {
+		panic("mocks: TransactionsService.UpdateMetadata called but UpdateMetadataFunc is not set")
+	}
+	return mock.UpdateMetadataFunc(ctx, id, transactionID, notes, tags)
+}
+
+// All calls AllFunc.
+func (mock *TransactionsService) All(ctx context.Context, id svc.CustomerID, filter *transactions.ListTransactionsRequest, after transactions.Checkpoint) iter.Seq2[*transactions.TransactionResponse, error] {
+	mock.record("All", ctx, id, filter, after)
+	if mock.AllFunc == nil {
+		panic("mocks: TransactionsService.All called but AllFunc is not set")
+	}
+	return mock.AllFunc(ctx, id, filter, after)
+}
+
+// GetChain calls GetChainFunc.
+func (mock *TransactionsService) GetChain(ctx context.Context, id svc.CustomerID, transactionID svc.TransactionID) (*transactions.TransactionChain, error) {
+	mock.record("GetChain", ctx, id, transactionID)
+	if mock.GetChainFunc == nil {
+		panic("mocks: TransactionsService.GetChain called but GetChainFunc is not set")
+	}
+	return mock.GetChainFunc(ctx, id, transactionID)
+}
+
+// GetReconciliationSummary calls GetReconciliationSummaryFunc.
+func (mock *TransactionsService) GetReconciliationSummary(ctx context.Context, id svc.CustomerID, period transactions.Period) (*transactions.ReconciliationSummary, error) {
+	mock.record("GetReconciliationSummary", ctx, id, period)
+	if mock.GetReconciliationSummaryFunc == nil {
+		panic("mocks: TransactionsService.GetReconciliationSummary called but GetReconciliationSummaryFunc is not set")
+	}
+	return mock.GetReconciliationSummaryFunc(ctx, id, period)
+}
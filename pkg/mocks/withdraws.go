@@ -0,0 +1,148 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by svcgen mocks. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/withdraws"
+)
+
+// WithdrawsService is a stub implementation of withdraws.Service.
+//
+// Each method records the call and delegates to the function field of the same name
+// with a Func suffix. Calling a method whose function is not set panics.
+type WithdrawsService struct {
+	recorder
+
+	// CreateWithdrawalFunc implements CreateWithdrawal.
+	CreateWithdrawalFunc func(ctx context.Context, id svc.CustomerID, req *withdraws.CreateWithdrawalRequest) (*withdraws.WithdrawalResponse, error)
+	// GetWithdrawalFunc implements GetWithdrawal.
+	GetWithdrawalFunc func(ctx context.Context, id svc.CustomerID, transactionID svc.TransactionID) (*withdraws.WithdrawalResponse, error)
+	// GetWithdrawalByIdempotencyKeyFunc implements GetWithdrawalByIdempotencyKey.
+	GetWithdrawalByIdempotencyKeyFunc func(ctx context.Context, id svc.CustomerID, idempotencyKey string) (*withdraws.WithdrawalResponse, error)
+	// ListWithdrawalsFunc implements ListWithdrawals.
+	ListWithdrawalsFunc func(ctx context.Context, id svc.CustomerID, req *withdraws.ListWithdrawalsRequest) (*withdraws.ListWithdrawalsResponse, error)
+	// EstimateFeeFunc implements EstimateFee.
+	EstimateFeeFunc func(ctx context.Context, id svc.CustomerID, asset assets.AssetName, network assets.NetworkName, amount string) (*withdraws.FeeEstimateResponse, error)
+	// CreateBatchFunc implements CreateBatch.
+	CreateBatchFunc func(ctx context.Context, id svc.CustomerID, reqs []withdraws.CreateWithdrawalRequest, opts *withdraws.BatchOptions) ([]withdraws.BatchResult, error)
+	// CreateScheduledWithdrawalFunc implements CreateScheduledWithdrawal.
+	CreateScheduledWithdrawalFunc func(ctx context.Context, id svc.CustomerID, req *withdraws.CreateScheduledWithdrawalRequest) (*withdraws.ScheduledWithdrawalResponse, error)
+	// ListScheduledWithdrawalsFunc implements ListScheduledWithdrawals.
+	ListScheduledWithdrawalsFunc func(ctx context.Context, id svc.CustomerID, req *withdraws.ListScheduledWithdrawalsRequest) (*withdraws.ListScheduledWithdrawalsResponse, error)
+	// CancelScheduledWithdrawalFunc implements CancelScheduledWithdrawal.
+	CancelScheduledWithdrawalFunc func(ctx context.Context, id svc.CustomerID, scheduleID string) (*withdraws.ScheduledWithdrawalResponse, error)
+	// GetLimitsFunc implements GetLimits.
+	GetLimitsFunc func(ctx context.Context, id svc.CustomerID, network assets.NetworkName) (*withdraws.LimitsResponse, error)
+}
+
+var _ withdraws.Service = (*WithdrawsService)(nil)
+
+// CreateWithdrawal calls CreateWithdrawalFunc.
+func (mock *WithdrawsService) CreateWithdrawal(ctx context.Context, id svc.CustomerID, req *withdraws.CreateWithdrawalRequest) (*withdraws.WithdrawalResponse, error) {
+	mock.record("CreateWithdrawal", ctx, id, req)
+	if mock.CreateWithdrawalFunc == nil {
+		panic("mocks: WithdrawsService.CreateWithdrawal called but CreateWithdrawalFunc is not set")
+	}
+	return mock.CreateWithdrawalFunc(ctx, id, req)
+}
+
+// GetWithdrawal calls GetWithdrawalFunc.
+func (mock *WithdrawsService) GetWithdrawal(ctx context.Context, id svc.CustomerID, transactionID svc.TransactionID) (*withdraws.WithdrawalResponse, error) {
+	mock.record("GetWithdrawal", ctx, id, transactionID)
+	if mock.GetWithdrawalFunc == nil {
+		panic("mocks: WithdrawsService.GetWithdrawal called but GetWithdrawalFunc is not set")
+	}
+	return mock.GetWithdrawalFunc(ctx, id, transactionID)
+}
+
+// GetWithdrawalByIdempotencyKey calls GetWithdrawalByIdempotencyKeyFunc.
+func (mock *WithdrawsService) GetWithdrawalByIdempotencyKey(ctx context.Context, id svc.CustomerID, idempotencyKey string) (*withdraws.WithdrawalResponse, error) {
+	mock.record("GetWithdrawalByIdempotencyKey", ctx, id, idempotencyKey)
+	if mock.GetWithdrawalByIdempotencyKeyFunc == nil {
+		panic("mocks: WithdrawsService.GetWithdrawalByIdempotencyKey called but GetWithdrawalByIdempotencyKeyFunc is not set")
+	}
+	return mock.GetWithdrawalByIdempotencyKeyFunc(ctx, id, idempotencyKey)
+}
+
+// ListWithdrawals calls ListWithdrawalsFunc.
+func (mock *WithdrawsService) ListWithdrawals(ctx context.Context, id svc.CustomerID, req *withdraws.ListWithdrawalsRequest) (*withdraws.ListWithdrawalsResponse, error) {
+	mock.record("ListWithdrawals", ctx, id, req)
+	if mock.ListWithdrawalsFunc == nil {
+		panic("mocks: WithdrawsService.ListWithdrawals called but ListWithdrawalsFunc is not set")
+	}
+	return mock.ListWithdrawalsFunc(ctx, id, req)
+}
+
+// EstimateFee calls EstimateFeeFunc.
+func (mock *WithdrawsService) EstimateFee(ctx context.Context, id svc.CustomerID, asset assets.AssetName, network assets.NetworkName, amount string) (*withdraws.FeeEstimateResponse, error) {
+	mock.record("EstimateFee", ctx, id, asset, network, amount)
+	if mock.EstimateFeeFunc == nil {
+		panic("mocks: WithdrawsService.EstimateFee called but EstimateFeeFunc is not set")
+	}
+	return mock.EstimateFeeFunc(ctx, id, asset, network, amount)
+}
+
+// CreateBatch calls CreateBatchFunc.
+func (mock *WithdrawsService) CreateBatch(ctx context.Context, id svc.CustomerID, reqs []withdraws.CreateWithdrawalRequest, opts *withdraws.BatchOptions) ([]withdraws.BatchResult, error) {
+	mock.record("CreateBatch", ctx, id, reqs, opts)
+	if mock.CreateBatchFunc == nil {
+		panic("mocks: WithdrawsService.CreateBatch called but CreateBatchFunc is not set")
+	}
+	return mock.CreateBatchFunc(ctx, id, reqs, opts)
+}
+
+// CreateScheduledWithdrawal calls CreateScheduledWithdrawalFunc.
+func (mock *WithdrawsService) CreateScheduledWithdrawal(ctx context.Context, id svc.CustomerID, req *withdraws.CreateScheduledWithdrawalRequest) (*withdraws.ScheduledWithdrawalResponse, error) {
+	mock.record("CreateScheduledWithdrawal", ctx, id, req)
+	if mock.CreateScheduledWithdrawalFunc == nil {
+		panic("mocks: WithdrawsService.CreateScheduledWithdrawal called but CreateScheduledWithdrawalFunc is not set")
+	}
+	return mock.CreateScheduledWithdrawalFunc(ctx, id, req)
+}
+
+// ListScheduledWithdrawals calls ListScheduledWithdrawalsFunc.
+func (mock *WithdrawsService) ListScheduledWithdrawals(ctx context.Context, id svc.CustomerID, req *withdraws.ListScheduledWithdrawalsRequest) (*withdraws.ListScheduledWithdrawalsResponse, error) {
+	mock.record("ListScheduledWithdrawals", ctx, id, req)
+	if mock.ListScheduledWithdrawalsFunc == nil {
+		panic("mocks: WithdrawsService.ListScheduledWithdrawals called but ListScheduledWithdrawalsFunc is not set")
+	}
+	return mock.ListScheduledWithdrawalsFunc(ctx, id, req)
+}
+
+// CancelScheduledWithdrawal calls CancelScheduledWithdrawalFunc.
+func (mock *WithdrawsService) CancelScheduledWithdrawal(ctx context.Context, id svc.CustomerID, scheduleID string) (*withdraws.ScheduledWithdrawalResponse, error) {
+	mock.record("CancelScheduledWithdrawal", ctx, id, scheduleID)
+	if mock.CancelScheduledWithdrawalFunc == nil {
+		panic("mocks: WithdrawsService.CancelScheduledWithdrawal called but CancelScheduledWithdrawalFunc is not set")
+	}
+	return mock.CancelScheduledWithdrawalFunc(ctx, id, scheduleID)
+}
+
+// GetLimits calls GetLimitsFunc.
+func (mock *WithdrawsService) GetLimits(ctx context.Context, id svc.CustomerID, network assets.NetworkName) (*withdraws.LimitsResponse, error) {
+	mock.record("GetLimits", ctx, id, network)
+	if mock.GetLimitsFunc == nil {
+		panic("mocks: WithdrawsService.GetLimits called but GetLimitsFunc is not set")
+	}
+	return mock.GetLimitsFunc(ctx, id, network)
+}
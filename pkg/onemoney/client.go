@@ -28,15 +28,33 @@ import (
 	"github.com/1Money-Co/1money-go-sdk/internal/credentials"
 	"github.com/1Money-Co/1money-go-sdk/internal/transport"
 	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/address_allowlist"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/api_keys"
 	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/audit_logs"
 	"github.com/1Money-Co/1money-go-sdk/pkg/service/auto_conversion_rules"
 	"github.com/1Money-Co/1money-go-sdk/pkg/service/conversions"
 	"github.com/1Money-Co/1money-go-sdk/pkg/service/customer"
 	"github.com/1Money-Co/1money-go-sdk/pkg/service/echo"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/events"
 	"github.com/1Money-Co/1money-go-sdk/pkg/service/external_accounts"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/fees"
 	"github.com/1Money-Co/1money-go-sdk/pkg/service/instructions"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/invoices"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/ledger"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/limits"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/notifications"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/payouts"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/platform"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/rates"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/recipients"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/screening"
 	"github.com/1Money-Co/1money-go-sdk/pkg/service/simulations"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/statements"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/status"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/sweep_rules"
 	"github.com/1Money-Co/1money-go-sdk/pkg/service/transactions"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/travel_rule"
 	"github.com/1Money-Co/1money-go-sdk/pkg/service/withdraws"
 )
 
@@ -47,15 +65,33 @@ type Client struct {
 	Config    *Config
 
 	// Service modules
+	APIKeys             api_keys.Service
+	AddressAllowlist    address_allowlist.Service
 	Assets              assets.Service
+	AuditLogs           audit_logs.Service
 	AutoConversionRules auto_conversion_rules.Service
 	Conversions         conversions.Service
 	Customer            customer.Service
 	Echo                echo.Service
+	Events              events.Service
 	ExternalAccounts    external_accounts.Service
+	Fees                fees.Service
 	Instructions        instructions.Service
+	Invoices            invoices.Service
+	Ledger              ledger.Service
+	Limits              limits.Service
+	Notifications       notifications.Service
+	Payouts             payouts.Service
+	Platform            platform.Service
+	Rates               rates.Service
+	Recipients          recipients.Service
+	Screening           screening.Service
 	Simulations         simulations.Service
+	Statements          statements.Service
+	Status              status.Service
+	SweepRules          sweep_rules.Service
 	Transactions        transactions.Service
+	TravelRule          travel_rule.Service
 	Withdrawals         withdraws.Service
 }
 
@@ -94,6 +130,19 @@ type Config struct {
 	// If nil, default retry configuration is used (3 retries with exponential backoff).
 	// Use NoRetryConfig() to disable retries.
 	Retry *RetryConfig
+
+	// InstructionCacheTTL enables in-memory caching of deposit instructions when positive.
+	// Client.Instructions is then an *instructions.CachedService.
+	InstructionCacheTTL time.Duration
+
+	// AddressAllowlist rejects crypto withdrawals to addresses that are not ACTIVE on the
+	// address allowlist before they are submitted, when the allowlist is enabled for the account.
+	// Client.Withdrawals is then a *withdraws.GuardedService.
+	AddressAllowlist bool
+
+	// StatusPageURL is the public status page summary read by Client.Status when the
+	// status endpoint is unreachable (default: status.DefaultStatusPageURL).
+	StatusPageURL string
 }
 
 // Option is a function that configures the client.
@@ -149,6 +198,21 @@ func WithRetry(retry *RetryConfig) Option {
 	}
 }
 
+// WithInstructionCache enables in-memory caching of deposit instructions with the given TTL.
+// Cached entries can be invalidated through *instructions.CachedService.
+func WithInstructionCache(ttl time.Duration) Option {
+	return func(c *Config) {
+		c.InstructionCacheTTL = ttl
+	}
+}
+
+// WithAddressAllowlist enables client-side address allowlist checks on crypto withdrawals.
+func WithAddressAllowlist() Option {
+	return func(c *Config) {
+		c.AddressAllowlist = true
+	}
+}
+
 // RetryConfig is an alias for transport.RetryConfig.
 // It holds configuration for retry behavior.
 type RetryConfig = transport.RetryConfig
@@ -271,20 +335,49 @@ func NewClient(cfg *Config, opts ...Option) (*Client, error) {
 	// Initialize all service modules with base service
 	base := svc.NewBaseService(tr)
 
+	var instructionsService instructions.Service = instructions.NewService(base)
+	if cfg.InstructionCacheTTL > 0 {
+		instructionsService = instructions.NewCachedService(instructionsService, cfg.InstructionCacheTTL)
+	}
+
+	addressAllowlistService := address_allowlist.NewService(base)
+	var withdrawalsService withdraws.Service = withdraws.NewService(base)
+	if cfg.AddressAllowlist {
+		withdrawalsService = withdraws.NewGuardedService(withdrawalsService, addressAllowlistService)
+	}
+
 	// Create client with pre-initialized services
 	return &Client{
 		transport:           tr,
 		Config:              cfg,
+		APIKeys:             api_keys.NewService(base),
+		AddressAllowlist:    addressAllowlistService,
 		Assets:              assets.NewService(base),
+		AuditLogs:           audit_logs.NewService(base),
 		AutoConversionRules: auto_conversion_rules.NewService(base),
 		Conversions:         conversions.NewService(base),
 		Customer:            customer.NewService(base),
 		Echo:                echo.NewService(base),
+		Events:              events.NewService(base),
 		ExternalAccounts:    external_accounts.NewService(base),
-		Instructions:        instructions.NewService(base),
+		Fees:                fees.NewService(base),
+		Instructions:        instructionsService,
+		Invoices:            invoices.NewService(base),
+		Ledger:              ledger.NewService(base),
+		Limits:              limits.NewService(base),
+		Notifications:       notifications.NewService(base),
+		Payouts:             payouts.NewService(base),
+		Platform:            platform.NewService(base),
+		Rates:               rates.NewService(base),
+		Recipients:          recipients.NewService(base),
+		Screening:           screening.NewService(base),
 		Simulations:         simulations.NewService(base),
+		Statements:          statements.NewService(base),
+		Status:              status.NewServiceWithStatusPage(base, cfg.StatusPageURL, cfg.HTTPClient),
+		SweepRules:          sweep_rules.NewService(base),
 		Transactions:        transactions.NewService(base),
-		Withdrawals:         withdraws.NewService(base),
+		TravelRule:          travel_rule.NewService(base),
+		Withdrawals:         withdrawalsService,
 	}, nil
 }
 
@@ -293,3 +386,14 @@ func NewClient(cfg *Config, opts ...Option) (*Client, error) {
 func (*Client) Version() string {
 	return onemoney.Version
 }
+
+// ScenarioServices returns the services used to run a simulations.Scenario with this client.
+//
+//	results, err := scenario.Run(ctx, client.ScenarioServices(), customerID)
+func (c *Client) ScenarioServices() simulations.ScenarioServices {
+	return simulations.ScenarioServices{
+		Simulations:  c.Simulations,
+		Transactions: c.Transactions,
+		Withdrawals:  c.Withdrawals,
+	}
+}
@@ -0,0 +1,33 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package onemoney
+
+import "github.com/1Money-Co/1money-go-sdk/internal/transport"
+
+// APIError is an error response returned by the 1Money API.
+type APIError = transport.APIError
+
+// IsAPIError reports whether err is or wraps an APIError and returns it.
+func IsAPIError(err error) (*APIError, bool) {
+	return transport.IsAPIError(err)
+}
+
+// IsNotFoundError reports whether err is a not found error (404), such as the error returned
+// by Transactions.GetTransactionByIdempotencyKey when no transaction matches.
+func IsNotFoundError(err error) bool {
+	return transport.IsNotFoundError(err)
+}
@@ -0,0 +1,50 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package onemoney
+
+import "github.com/1Money-Co/1money-go-sdk/pkg/ids"
+
+// CustomerID is an alias for ids.CustomerID.
+// Convert trusted strings with onemoney.CustomerID(s), or validate them with ParseCustomerID.
+type CustomerID = ids.CustomerID
+
+// RecipientID is an alias for ids.RecipientID.
+type RecipientID = ids.RecipientID
+
+// TransactionID is an alias for ids.TransactionID.
+type TransactionID = ids.TransactionID
+
+// ErrInvalidID is returned when an ID is empty or not a UUID.
+var ErrInvalidID = ids.ErrInvalidID
+
+// ParseCustomerID returns s as a CustomerID, or an error wrapping ErrInvalidID
+// if it is not a UUID.
+func ParseCustomerID(s string) (CustomerID, error) {
+	return ids.ParseCustomerID(s)
+}
+
+// ParseRecipientID returns s as a RecipientID, or an error wrapping ErrInvalidID
+// if it is not a UUID.
+func ParseRecipientID(s string) (RecipientID, error) {
+	return ids.ParseRecipientID(s)
+}
+
+// ParseTransactionID returns s as a TransactionID, or an error wrapping ErrInvalidID
+// if it is not a UUID.
+func ParseTransactionID(s string) (TransactionID, error) {
+	return ids.ParseTransactionID(s)
+}
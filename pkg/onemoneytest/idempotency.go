@@ -0,0 +1,48 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package onemoneytest
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// IdempotencyKeys derives idempotency keys from the test name and a step label instead
+// of generating random ones. A failed test re-run in the same namespace sends the same
+// keys, so the API replays the operations that already succeeded instead of creating
+// duplicate rules, accounts or withdrawals.
+//
+//	keys := onemoneytest.NewIdempotencyKeys(os.Getenv("CI_JOB_ID"))
+//	req.IdempotencyKey = keys.Key(t, "create-rule")
+type IdempotencyKeys struct {
+	namespace uuid.UUID
+}
+
+// NewIdempotencyKeys returns a key generator scoped to namespace, e.g. a CI job ID.
+// Generators of different namespaces never produce the same key, so a fresh namespace
+// starts from scratch.
+func NewIdempotencyKeys(namespace string) *IdempotencyKeys {
+	return &IdempotencyKeys{namespace: uuid.NewSHA1(uuid.NameSpaceURL, []byte("onemoneytest:"+namespace))}
+}
+
+// Key returns the idempotency key for a step of the test t. The same test and step
+// always give the same key; steps within a test need distinct labels. Subtests have
+// their own names, so a step label can be reused across table-driven cases.
+func (k *IdempotencyKeys) Key(t testing.TB, step string) string {
+	return uuid.NewSHA1(k.namespace, []byte(t.Name()+"\x00"+step)).String()
+}
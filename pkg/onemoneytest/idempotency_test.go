@@ -0,0 +1,42 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package onemoneytest_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/onemoneytest"
+)
+
+func TestIdempotencyKeys(t *testing.T) {
+	keys := onemoneytest.NewIdempotencyKeys("job-1")
+
+	key := keys.Key(t, "create")
+	_, err := uuid.Parse(key)
+	require.NoError(t, err, "keys should be UUIDs")
+	assert.Equal(t, key, onemoneytest.NewIdempotencyKeys("job-1").Key(t, "create"), "keys should be deterministic")
+	assert.NotEqual(t, key, keys.Key(t, "update"), "steps should have distinct keys")
+	assert.NotEqual(t, key, onemoneytest.NewIdempotencyKeys("job-2").Key(t, "create"), "namespaces should have distinct keys")
+
+	t.Run("Subtest", func(t *testing.T) {
+		assert.NotEqual(t, key, keys.Key(t, "create"), "subtests should have distinct keys")
+	})
+}
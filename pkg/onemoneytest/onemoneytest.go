@@ -0,0 +1,226 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package onemoneytest provides a mock client, a fake server and an HTTP recorder for testing
+// applications built on the SDK.
+//
+// A MockClient holds a stub from package mocks for every service. Program the stubs the
+// code under test calls, then pass the *onemoney.Client returned by Client to that code.
+// Calls are recorded on each stub; calling a method whose function is not set panics.
+//
+// # Basic Usage
+//
+//	mock := onemoneytest.NewMockClient()
+//	mock.Customer.GetCustomerFunc = func(ctx context.Context, id svc.CustomerID) (*customer.CustomerResponse, error) {
+//	    return &customer.CustomerResponse{CustomerID: id, Status: customer.KybStatusApproved}, nil
+//	}
+//
+//	err := app.Onboard(ctx, mock.Client(), "customer-id")
+//
+//	if calls := mock.Customer.CallsTo("GetCustomer"); len(calls) != 1 {
+//	    t.Errorf("GetCustomer called %d times, want 1", len(calls))
+//	}
+//
+// # Fake Server
+//
+// For tests that exercise a whole flow, Server is an in-memory fake of the core API
+// endpoints. It keeps customers, balances, deposits and withdrawals, so tests run offline
+// and give the same results on every run.
+//
+//	srv := onemoneytest.NewServer(&onemoneytest.ServerOptions{KYBApprovalDelay: time.Minute})
+//	t.Cleanup(srv.Close)
+//	client, err := srv.Client()
+//
+//	created, err := client.Customer.CreateCustomer(ctx, req) // pending_review
+//	srv.Advance(time.Minute)                                 // approved on the next read
+//
+// # Recording
+//
+// Recorder records the interactions of tests run against the sandbox to a cassette file
+// and replays them offline, e.g. in CI. See NewRecorder.
+//
+// # Idempotency Keys
+//
+// IdempotencyKeys derives idempotency keys from the test name and a step label, so a
+// re-run of a failed test resumes instead of creating duplicates. See NewIdempotencyKeys.
+package onemoneytest
+
+import (
+	"github.com/1Money-Co/1money-go-sdk/pkg/mocks"
+	"github.com/1Money-Co/1money-go-sdk/pkg/onemoney"
+)
+
+// MockClient holds a stub for every service of onemoney.Client. Its fields have the
+// names of the Client fields they replace.
+type MockClient struct {
+	APIKeys             *mocks.APIKeysService
+	AddressAllowlist    *mocks.AddressAllowlistService
+	Assets              *mocks.AssetsService
+	AuditLogs           *mocks.AuditLogsService
+	AutoConversionRules *mocks.AutoConversionRulesService
+	Conversions         *mocks.ConversionsService
+	Customer            *mocks.CustomerService
+	Echo                *mocks.EchoService
+	Events              *mocks.EventsService
+	ExternalAccounts    *mocks.ExternalAccountsService
+	Fees                *mocks.FeesService
+	Instructions        *mocks.InstructionsService
+	Invoices            *mocks.InvoicesService
+	Ledger              *mocks.LedgerService
+	Limits              *mocks.LimitsService
+	Notifications       *mocks.NotificationsService
+	Payouts             *mocks.PayoutsService
+	Platform            *mocks.PlatformService
+	Rates               *mocks.RatesService
+	Recipients          *mocks.RecipientsService
+	Screening           *mocks.ScreeningService
+	Simulations         *mocks.SimulationsService
+	Statements          *mocks.StatementsService
+	Status              *mocks.StatusService
+	SweepRules          *mocks.SweepRulesService
+	Transactions        *mocks.TransactionsService
+	TravelRule          *mocks.TravelRuleService
+	Withdrawals         *mocks.WithdrawsService
+}
+
+// NewMockClient returns a MockClient with an empty stub for every service.
+func NewMockClient() *MockClient {
+	return &MockClient{
+		APIKeys:             &mocks.APIKeysService{},
+		AddressAllowlist:    &mocks.AddressAllowlistService{},
+		Assets:              &mocks.AssetsService{},
+		AuditLogs:           &mocks.AuditLogsService{},
+		AutoConversionRules: &mocks.AutoConversionRulesService{},
+		Conversions:         &mocks.ConversionsService{},
+		Customer:            &mocks.CustomerService{},
+		Echo:                &mocks.EchoService{},
+		Events:              &mocks.EventsService{},
+		ExternalAccounts:    &mocks.ExternalAccountsService{},
+		Fees:                &mocks.FeesService{},
+		Instructions:        &mocks.InstructionsService{},
+		Invoices:            &mocks.InvoicesService{},
+		Ledger:              &mocks.LedgerService{},
+		Limits:              &mocks.LimitsService{},
+		Notifications:       &mocks.NotificationsService{},
+		Payouts:             &mocks.PayoutsService{},
+		Platform:            &mocks.PlatformService{},
+		Rates:               &mocks.RatesService{},
+		Recipients:          &mocks.RecipientsService{},
+		Screening:           &mocks.ScreeningService{},
+		Simulations:         &mocks.SimulationsService{},
+		Statements:          &mocks.StatementsService{},
+		Status:              &mocks.StatusService{},
+		SweepRules:          &mocks.SweepRulesService{},
+		Transactions:        &mocks.TransactionsService{},
+		TravelRule:          &mocks.TravelRuleService{},
+		Withdrawals:         &mocks.WithdrawsService{},
+	}
+}
+
+// Client returns a client whose services are the stubs of m. Stub functions set after
+// the call are used by the client too, since it shares the stubs.
+func (m *MockClient) Client() *onemoney.Client {
+	return &onemoney.Client{
+		Config: &onemoney.Config{},
+
+		APIKeys:             m.APIKeys,
+		AddressAllowlist:    m.AddressAllowlist,
+		Assets:              m.Assets,
+		AuditLogs:           m.AuditLogs,
+		AutoConversionRules: m.AutoConversionRules,
+		Conversions:         m.Conversions,
+		Customer:            m.Customer,
+		Echo:                m.Echo,
+		Events:              m.Events,
+		ExternalAccounts:    m.ExternalAccounts,
+		Fees:                m.Fees,
+		Instructions:        m.Instructions,
+		Invoices:            m.Invoices,
+		Ledger:              m.Ledger,
+		Limits:              m.Limits,
+		Notifications:       m.Notifications,
+		Payouts:             m.Payouts,
+		Platform:            m.Platform,
+		Rates:               m.Rates,
+		Recipients:          m.Recipients,
+		Screening:           m.Screening,
+		Simulations:         m.Simulations,
+		Statements:          m.Statements,
+		Status:              m.Status,
+		SweepRules:          m.SweepRules,
+		Transactions:        m.Transactions,
+		TravelRule:          m.TravelRule,
+		Withdrawals:         m.Withdrawals,
+	}
+}
+
+// Reset forgets the calls recorded by every stub. The stub functions are kept.
+func (m *MockClient) Reset() {
+	for _, stub := range m.stubs() {
+		stub.Reset()
+	}
+}
+
+// Calls returns the calls recorded by every stub, keyed by the name of the Client field
+// of the service. Services that were not called are omitted.
+func (m *MockClient) Calls() map[string][]mocks.Call {
+	calls := make(map[string][]mocks.Call)
+	for name, stub := range m.stubs() {
+		if c := stub.Calls(); len(c) > 0 {
+			calls[name] = c
+		}
+	}
+	return calls
+}
+
+// recordingStub is the call recording shared by the stubs of package mocks.
+type recordingStub interface {
+	Calls() []mocks.Call
+	Reset()
+}
+
+// stubs returns the stubs of m keyed by Client field name.
+func (m *MockClient) stubs() map[string]recordingStub {
+	return map[string]recordingStub{
+		"APIKeys":             m.APIKeys,
+		"AddressAllowlist":    m.AddressAllowlist,
+		"Assets":              m.Assets,
+		"AuditLogs":           m.AuditLogs,
+		"AutoConversionRules": m.AutoConversionRules,
+		"Conversions":         m.Conversions,
+		"Customer":            m.Customer,
+		"Echo":                m.Echo,
+		"Events":              m.Events,
+		"ExternalAccounts":    m.ExternalAccounts,
+		"Fees":                m.Fees,
+		"Instructions":        m.Instructions,
+		"Invoices":            m.Invoices,
+		"Ledger":              m.Ledger,
+		"Limits":              m.Limits,
+		"Notifications":       m.Notifications,
+		"Payouts":             m.Payouts,
+		"Platform":            m.Platform,
+		"Rates":               m.Rates,
+		"Recipients":          m.Recipients,
+		"Screening":           m.Screening,
+		"Simulations":         m.Simulations,
+		"Statements":          m.Statements,
+		"Status":              m.Status,
+		"SweepRules":          m.SweepRules,
+		"Transactions":        m.Transactions,
+		"TravelRule":          m.TravelRule,
+		"Withdrawals":         m.Withdrawals,
+	}
+}
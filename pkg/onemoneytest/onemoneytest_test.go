@@ -0,0 +1,83 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package onemoneytest_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/onemoneytest"
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/customer"
+)
+
+func TestMockClientCoversEveryService(t *testing.T) {
+	mock := onemoneytest.NewMockClient()
+	client := reflect.ValueOf(mock.Client()).Elem()
+	stubs := reflect.ValueOf(mock).Elem()
+
+	for i := range client.NumField() {
+		field := client.Type().Field(i)
+		if !field.IsExported() || field.Type.Kind() != reflect.Interface {
+			continue
+		}
+		stub := stubs.FieldByName(field.Name)
+		if !stub.IsValid() {
+			t.Errorf("MockClient has no stub for Client.%s", field.Name)
+			continue
+		}
+		if stub.IsNil() || client.Field(i).Interface() != stub.Interface() {
+			t.Errorf("Client().%s is not the MockClient stub", field.Name)
+		}
+	}
+}
+
+func TestMockClient(t *testing.T) {
+	mock := onemoneytest.NewMockClient()
+	client := mock.Client()
+
+	// Stubs programmed after Client is called are still used.
+	errNotFound := errors.New("not found")
+	mock.Customer.GetCustomerFunc = func(_ context.Context, id svc.CustomerID) (*customer.CustomerResponse, error) {
+		if id != "cus-1" {
+			return nil, errNotFound
+		}
+		return &customer.CustomerResponse{CustomerID: id, Status: customer.KybStatusApproved}, nil
+	}
+
+	resp, err := client.Customer.GetCustomer(context.Background(), "cus-1")
+	if err != nil || resp.Status != customer.KybStatusApproved {
+		t.Fatalf("GetCustomer() = %+v, %v", resp, err)
+	}
+	if _, err := client.Customer.GetCustomer(context.Background(), "cus-2"); !errors.Is(err, errNotFound) {
+		t.Errorf("GetCustomer(cus-2) error = %v, want %v", err, errNotFound)
+	}
+
+	calls := mock.Calls()
+	if len(calls) != 1 || len(calls["Customer"]) != 2 {
+		t.Errorf("Calls() = %+v, want two Customer calls", calls)
+	}
+
+	mock.Reset()
+	if calls := mock.Calls(); len(calls) != 0 {
+		t.Errorf("Calls() after Reset = %+v", calls)
+	}
+	if mock.Customer.GetCustomerFunc == nil {
+		t.Error("Reset cleared the stub functions")
+	}
+}
@@ -0,0 +1,355 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package onemoneytest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Mode selects whether a Recorder records interactions or replays them.
+type Mode int
+
+const (
+	// ModeReplay serves responses from the cassette without reaching the network.
+	ModeReplay Mode = iota
+	// ModeRecord sends requests to the API and records the interactions in the cassette.
+	ModeRecord
+)
+
+// ParseMode parses "replay" or "record", e.g. from an environment variable.
+func ParseMode(s string) (Mode, error) {
+	switch strings.ToLower(s) {
+	case "replay":
+		return ModeReplay, nil
+	case "record":
+		return ModeRecord, nil
+	}
+	return 0, fmt.Errorf("invalid recorder mode %q, want replay or record", s)
+}
+
+// redacted replaces sensitive values in cassettes.
+const redacted = "REDACTED"
+
+// DefaultRedactedHeaders are the headers whose values are never written to a cassette.
+var DefaultRedactedHeaders = []string{"Authorization", "Cookie", "Set-Cookie", "X-Forwarded-For"}
+
+// DefaultRedactedFields are the JSON fields whose values are never written to a cassette:
+// credentials, and the personal data of customers and their associated persons.
+var DefaultRedactedFields = []string{"secret_key", "tax_id", "national_identity_number", "birth_date"}
+
+// RecorderOptions configures a Recorder.
+type RecorderOptions struct {
+	// Transport sends the requests in ModeRecord (default: http.DefaultTransport).
+	Transport http.RoundTripper
+	// RedactHeaders are headers to redact in addition to DefaultRedactedHeaders.
+	RedactHeaders []string
+	// RedactFields are JSON object fields to redact in addition to DefaultRedactedFields,
+	// at any depth of request and response bodies.
+	RedactFields []string
+}
+
+// Recorder is an http.RoundTripper that records API interactions to a cassette file and
+// replays them, so tests written against the sandbox can run offline in CI.
+//
+// Cassettes are sanitized as they are recorded: credentials in DefaultRedactedHeaders
+// and DefaultRedactedFields are replaced, base64 data URIs (documents and images) keep
+// only their media type, and multipart bodies are dropped. Only the path and query of
+// request URLs are kept, so a cassette recorded against the sandbox replays against any
+// base URL.
+//
+// In ModeReplay a request is answered by the first unused interaction with the same
+// method and URL, then by one with the same method and path, since query parameters may
+// hold generated values. Once those are used up, the last matching response is served
+// again, so polling loops that ran a different number of times still finish.
+type Recorder struct {
+	path          string
+	mode          Mode
+	transport     http.RoundTripper
+	redactHeaders []string
+	redactFields  map[string]bool
+
+	mu           sync.Mutex
+	interactions []*interaction
+	used         []bool
+}
+
+// cassette is the file format of a Recorder.
+type cassette struct {
+	Interactions []*interaction `json:"interactions"`
+}
+
+type interaction struct {
+	Request  recordedRequest  `json:"request"`
+	Response recordedResponse `json:"response"`
+}
+
+type recordedRequest struct {
+	Method  string      `json:"method"`
+	URL     string      `json:"url"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+type recordedResponse struct {
+	Status  int         `json:"status"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+	// BodyEncoding is "base64" for bodies that are not valid UTF-8.
+	BodyEncoding string `json:"body_encoding,omitempty"`
+}
+
+// NewRecorder returns a Recorder for the cassette at path. In ModeReplay the cassette must
+// exist; in ModeRecord it is written by Save. A nil opts uses the defaults.
+func NewRecorder(path string, mode Mode, opts *RecorderOptions) (*Recorder, error) {
+	if opts == nil {
+		opts = &RecorderOptions{}
+	}
+	r := &Recorder{
+		path:          path,
+		mode:          mode,
+		transport:     opts.Transport,
+		redactHeaders: append(append([]string{}, DefaultRedactedHeaders...), opts.RedactHeaders...),
+		redactFields:  make(map[string]bool),
+	}
+	if r.transport == nil {
+		r.transport = http.DefaultTransport
+	}
+	for _, field := range append(append([]string{}, DefaultRedactedFields...), opts.RedactFields...) {
+		r.redactFields[field] = true
+	}
+
+	if mode == ModeReplay {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read cassette: %w", err)
+		}
+		var c cassette
+		if err := json.Unmarshal(data, &c); err != nil {
+			return nil, fmt.Errorf("failed to parse cassette %s: %w", path, err)
+		}
+		r.interactions = c.Interactions
+		r.used = make([]bool, len(c.Interactions))
+	}
+	return r, nil
+}
+
+// Client returns an HTTP client using the Recorder, for onemoney.Config.HTTPClient.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	if r.mode == ModeReplay {
+		return r.replay(req)
+	}
+	return r.record(req)
+}
+
+// Save writes the recorded interactions to the cassette. It does nothing in ModeReplay.
+func (r *Recorder) Save() error {
+	if r.mode == ModeReplay {
+		return nil
+	}
+	r.mu.Lock()
+	data, err := json.MarshalIndent(cassette{Interactions: r.interactions}, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("failed to marshal cassette: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return fmt.Errorf("failed to create cassette directory: %w", err)
+	}
+	if err := os.WriteFile(r.path, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("failed to write cassette: %w", err)
+	}
+	return nil
+}
+
+func (r *Recorder) record(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		if reqBody, err = io.ReadAll(req.Body); err != nil {
+			return nil, fmt.Errorf("failed to read request body: %w", err)
+		}
+		_ = req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	recorded := &interaction{
+		Request: recordedRequest{
+			Method:  req.Method,
+			URL:     req.URL.RequestURI(),
+			Headers: r.sanitizeHeaders(req.Header),
+			Body:    r.sanitizeBody(req.Header.Get("Content-Type"), reqBody),
+		},
+		Response: recordedResponse{
+			Status:  resp.StatusCode,
+			Headers: r.sanitizeHeaders(resp.Header),
+		},
+	}
+	if utf8.Valid(respBody) {
+		recorded.Response.Body = r.sanitizeBody(resp.Header.Get("Content-Type"), respBody)
+	} else {
+		recorded.Response.Body = base64.StdEncoding.EncodeToString(respBody)
+		recorded.Response.BodyEncoding = "base64"
+	}
+
+	r.mu.Lock()
+	r.interactions = append(r.interactions, recorded)
+	r.mu.Unlock()
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	uri, path := req.URL.RequestURI(), req.URL.Path
+	match := -1
+	for _, exact := range []bool{true, false} {
+		for i, it := range r.interactions {
+			if !r.used[i] && it.Request.Method == req.Method && r.matches(it, uri, path, exact) {
+				match = i
+				break
+			}
+		}
+		if match >= 0 {
+			break
+		}
+	}
+	if match >= 0 {
+		r.used[match] = true
+	} else {
+		for i, it := range r.interactions {
+			if it.Request.Method == req.Method && r.matches(it, uri, path, false) {
+				match = i
+			}
+		}
+	}
+	if match < 0 {
+		return nil, fmt.Errorf("onemoneytest: no recorded interaction for %s %s in %s", req.Method, uri, r.path)
+	}
+
+	recorded := r.interactions[match].Response
+	body := []byte(recorded.Body)
+	if recorded.BodyEncoding == "base64" {
+		var err error
+		if body, err = base64.StdEncoding.DecodeString(recorded.Body); err != nil {
+			return nil, fmt.Errorf("onemoneytest: invalid recorded body for %s %s: %w", req.Method, uri, err)
+		}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.Status, http.StatusText(recorded.Status)),
+		StatusCode:    recorded.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        recorded.Headers.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// matches reports whether a recorded request has the URI, or only the path unless exact is set.
+func (*Recorder) matches(it *interaction, uri, path string, exact bool) bool {
+	if exact {
+		return it.Request.URL == uri
+	}
+	recordedPath, _, _ := strings.Cut(it.Request.URL, "?")
+	return recordedPath == path
+}
+
+func (r *Recorder) sanitizeHeaders(h http.Header) http.Header {
+	if len(h) == 0 {
+		return nil
+	}
+	h = h.Clone()
+	for _, name := range r.redactHeaders {
+		if h.Get(name) != "" {
+			h.Set(name, redacted)
+		}
+	}
+	return h
+}
+
+// sanitizeBody redacts a JSON body. Multipart bodies are dropped, as they carry files.
+func (r *Recorder) sanitizeBody(contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	if mediaType, _, _ := mime.ParseMediaType(contentType); strings.HasPrefix(mediaType, "multipart/") {
+		return redacted
+	}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		return string(body)
+	}
+	sanitized, err := json.Marshal(r.redact(v))
+	if err != nil {
+		return string(body)
+	}
+	return string(sanitized)
+}
+
+// redact replaces the values of redacted fields and the data of base64 data URIs.
+func (r *Recorder) redact(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if r.redactFields[key] {
+				v[key] = redacted
+			} else {
+				v[key] = r.redact(value)
+			}
+		}
+	case []any:
+		for i, value := range v {
+			v[i] = r.redact(value)
+		}
+	case string:
+		if header, _, ok := strings.Cut(v, ";base64,"); ok && strings.HasPrefix(header, "data:") {
+			return header + ";base64," + redacted
+		}
+	}
+	return v
+}
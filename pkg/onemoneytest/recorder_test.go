@@ -0,0 +1,166 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package onemoneytest_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/1Money-Co/1money-go-sdk/internal/transport"
+	"github.com/1Money-Co/1money-go-sdk/pkg/onemoney"
+	"github.com/1Money-Co/1money-go-sdk/pkg/onemoneytest"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/customer"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/simulations"
+)
+
+// recorderClient returns a client sending its requests to baseURL through rec.
+func recorderClient(t *testing.T, baseURL string, rec *onemoneytest.Recorder) *onemoney.Client {
+	t.Helper()
+	client, err := onemoney.NewClient(&onemoney.Config{
+		BaseURL:    baseURL,
+		AccessKey:  "test-access-key",
+		SecretKey:  "test-secret-key",
+		HTTPClient: rec.Client(),
+		Retry:      onemoney.NoRetryConfig(),
+	})
+	require.NoError(t, err)
+	return client
+}
+
+func TestRecorder(t *testing.T) {
+	cassette := filepath.Join(t.TempDir(), "cassettes", "funds.json")
+	ctx := context.Background()
+
+	// Record against the fake server.
+	srv := onemoneytest.NewServer(nil)
+	rec, err := onemoneytest.NewRecorder(cassette, onemoneytest.ModeRecord, nil)
+	require.NoError(t, err)
+	client := recorderClient(t, srv.URL, rec)
+
+	id := newCustomer(t, client, "vcr@acme.test").CustomerID
+	_, err = client.Simulations.SimulateDeposit(ctx, id, &simulations.SimulateDepositRequest{
+		Asset:  assets.AssetNameUSD,
+		Amount: "25",
+	})
+	require.NoError(t, err)
+	recorded, err := client.Assets.ListAssets(ctx, id, nil)
+	require.NoError(t, err)
+	require.NoError(t, rec.Save())
+	srv.Close()
+
+	data, err := os.ReadFile(cassette)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "test-access-key", "credentials must be redacted")
+	assert.Contains(t, string(data), `"REDACTED"`)
+
+	// Replay with the server gone.
+	rec, err = onemoneytest.NewRecorder(cassette, onemoneytest.ModeReplay, nil)
+	require.NoError(t, err)
+	client = recorderClient(t, "http://replay.invalid", rec)
+
+	replayed := newCustomer(t, client, "vcr@acme.test")
+	assert.Equal(t, id, replayed.CustomerID)
+	_, err = client.Simulations.SimulateDeposit(ctx, id, &simulations.SimulateDepositRequest{
+		Asset:  assets.AssetNameUSD,
+		Amount: "25",
+	})
+	require.NoError(t, err)
+	balances, err := client.Assets.ListAssets(ctx, id, nil)
+	require.NoError(t, err)
+	assert.Equal(t, recorded, balances)
+
+	// Used-up interactions are served again, for polling loops.
+	balances, err = client.Assets.ListAssets(ctx, id, nil)
+	require.NoError(t, err)
+	assert.Equal(t, recorded, balances)
+
+	_, err = client.Transactions.ListTransactions(ctx, id, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no recorded interaction")
+	assert.False(t, transport.IsNotFoundError(err))
+}
+
+func TestRecorderRedaction(t *testing.T) {
+	cassette := filepath.Join(t.TempDir(), "documents.json")
+	srv := onemoneytest.NewServer(nil)
+	t.Cleanup(srv.Close)
+	rec, err := onemoneytest.NewRecorder(cassette, onemoneytest.ModeRecord, &onemoneytest.RecorderOptions{
+		RedactFields: []string{"email"},
+	})
+	require.NoError(t, err)
+	client := recorderClient(t, srv.URL, rec)
+
+	// The request fails on the fake server, but is recorded all the same.
+	_, _ = client.Customer.CreateCustomer(context.Background(), customerWithDocument("private@acme.test"))
+	require.NoError(t, rec.Save())
+
+	data, err := os.ReadFile(cassette)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "private@acme.test")
+	assert.NotContains(t, string(data), "iVBORw0KGgo")
+	assert.True(t, strings.Contains(string(data), `data:image/png;base64,REDACTED`), "data URIs keep their media type")
+}
+
+func TestRecorderRedactsPersonalData(t *testing.T) {
+	cassette := filepath.Join(t.TempDir(), "customer.json")
+	srv := onemoneytest.NewServer(nil)
+	t.Cleanup(srv.Close)
+	rec, err := onemoneytest.NewRecorder(cassette, onemoneytest.ModeRecord, nil)
+	require.NoError(t, err)
+	client := recorderClient(t, srv.URL, rec)
+
+	req := customerWithDocument("kyb@acme.test")
+	req.TaxID = "12-3456789"
+	req.AssociatedPersons = []customer.AssociatedPerson{{
+		FirstName: "Jane",
+		LastName:  "Doe",
+		BirthDate: "1980-04-12",
+		TaxID:     "123-45-6789",
+		IdentifyingInformation: []customer.IdentifyingInformation{{
+			NationalIdentityNumber: "X1234567",
+		}},
+	}}
+	_, _ = client.Customer.CreateCustomer(context.Background(), req)
+	require.NoError(t, rec.Save())
+
+	data, err := os.ReadFile(cassette)
+	require.NoError(t, err)
+	for _, value := range []string{"12-3456789", "123-45-6789", "1980-04-12", "X1234567"} {
+		assert.NotContains(t, string(data), value)
+	}
+	assert.Contains(t, string(data), "Jane", "fields that are not redacted are kept")
+}
+
+// customerWithDocument returns a customer request carrying a PNG document.
+func customerWithDocument(email string) *customer.CreateCustomerRequest {
+	return &customer.CreateCustomerRequest{
+		BusinessLegalName: "Acme Inc",
+		BusinessType:      customer.BusinessTypeCorporation,
+		Email:             email,
+		Documents: []customer.Document{{
+			DocType:     customer.DocumentTypeProofOfAddress,
+			File:        "data:image/png;base64,iVBORw0KGgoAAAANSUhEUg==",
+			Description: "Proof of Address",
+		}},
+	}
+}
@@ -0,0 +1,504 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package onemoneytest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/onemoney"
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/customer"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/simulations"
+)
+
+// DefaultServerTime is the time the clock of a Server starts at unless ServerOptions.Now is set.
+var DefaultServerTime = time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// defaultPageSize is the page size of list endpoints when the request does not give one.
+const defaultPageSize = 10
+
+// ServerOptions configures a fake server.
+type ServerOptions struct {
+	// Now returns the current time of the server clock before Advance is applied
+	// (default: DefaultServerTime, so timestamps are the same on every run).
+	Now func() time.Time
+	// KYBApprovalDelay is how long after creation a customer in pending_review is approved,
+	// measured on the server clock. Zero approves customers on their first read.
+	KYBApprovalDelay time.Duration
+	// DisableKYBAutoApproval keeps new customers in pending_review until their status is
+	// set with the KYB simulation endpoint.
+	DisableKYBAutoApproval bool
+}
+
+// Server is an in-memory fake of the core 1Money API endpoints, for hermetic tests.
+//
+// It emulates customers (TOS links, creation, listing and KYB auto-approval), balances,
+// simulated deposits, withdrawals with Idempotency-Key semantics, withdrawal outcome and
+// KYB simulations, and transactions. Responses use the SDK response types and errors use
+// the API error format, so the SDK services behave as they do against the sandbox.
+// Requests to other endpoints fail with 404 Not Found.
+//
+// IDs are sequential and the clock only moves with Advance unless ServerOptions.Now is
+// set, so a test sees the same responses on every run. A Server is safe for concurrent use.
+type Server struct {
+	// URL is the base URL of the server, e.g. http://127.0.0.1:54321.
+	URL string
+
+	srv  *httptest.Server
+	opts ServerOptions
+
+	mu        sync.Mutex
+	offset    time.Duration
+	seq       int
+	sessions  map[string]string
+	customers map[svc.CustomerID]*fakeCustomer
+	// customerOrder holds the customer IDs in creation order.
+	customerOrder []svc.CustomerID
+	balances      map[svc.CustomerID]map[balanceKey]*balance
+	txns          map[svc.TransactionID]*fakeTxn
+	// txnOrder holds the transaction IDs in creation order.
+	txnOrder []svc.TransactionID
+	// idempotent maps a customer ID and idempotency key to the withdrawal created with them.
+	idempotent map[string]*fakeTxn
+}
+
+// fakeCustomer is a customer and its pending KYB auto-approval.
+type fakeCustomer struct {
+	customer.CustomerResponse
+	// approveAt is when the customer is auto-approved; zero when it is not pending.
+	approveAt time.Time
+}
+
+// NewServer starts a fake server. Call Close when done, e.g. with t.Cleanup(srv.Close).
+// A nil opts uses the defaults.
+func NewServer(opts *ServerOptions) *Server {
+	s := &Server{
+		sessions:   make(map[string]string),
+		customers:  make(map[svc.CustomerID]*fakeCustomer),
+		balances:   make(map[svc.CustomerID]map[balanceKey]*balance),
+		txns:       make(map[svc.TransactionID]*fakeTxn),
+		idempotent: make(map[string]*fakeTxn),
+	}
+	if opts != nil {
+		s.opts = *opts
+	}
+	if s.opts.Now == nil {
+		s.opts.Now = func() time.Time { return DefaultServerTime }
+	}
+	s.srv = httptest.NewServer(s.routes())
+	s.URL = s.srv.URL
+	return s
+}
+
+// Close shuts the server down.
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// Client returns a client sending its requests to the server, without retries.
+func (s *Server) Client() (*onemoney.Client, error) {
+	return onemoney.NewClient(&onemoney.Config{
+		BaseURL:   s.URL,
+		AccessKey: "test-access-key",
+		SecretKey: "test-secret-key",
+		Retry:     onemoney.NoRetryConfig(),
+	})
+}
+
+// Advance moves the server clock forward, e.g. past ServerOptions.KYBApprovalDelay.
+func (s *Server) Advance(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.offset += d
+}
+
+// Now returns the current time of the server clock.
+func (s *Server) Now() time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.now()
+}
+
+func (s *Server) now() time.Time {
+	return s.opts.Now().Add(s.offset).UTC()
+}
+
+// routes returns the handler of the emulated endpoints.
+func (s *Server) routes() http.Handler {
+	// The TOS routes have their own mux: ServeMux rejects the sign route as conflicting
+	// with the customer routes, although tos_links is never a customer ID.
+	mux, tos := http.NewServeMux(), http.NewServeMux()
+	handle := func(m *http.ServeMux, pattern string, h func(w http.ResponseWriter, r *http.Request) error) {
+		m.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			if err := h(w, r); err != nil {
+				writeError(w, r, err)
+			}
+		})
+	}
+
+	handle(tos, "POST /v1/customers/tos_links", s.createTOSLink)
+	handle(tos, "POST /v1/customers/tos_links/{token}/sign", s.signTOSAgreement)
+	handle(mux, "POST /v1/customers", s.createCustomer)
+	handle(mux, "GET /v1/customers", s.listCustomers)
+	handle(mux, "GET /v1/customers/{id}", s.getCustomer)
+	handle(mux, "POST /v1/customers/{id}/simulate-kyb-status", s.setKYBStatus)
+
+	handle(mux, "GET /v1/customers/{id}/assets", s.listAssets)
+	handle(mux, "POST /v1/customers/{id}/simulate-transactions", s.simulateDeposit)
+	handle(mux, "POST /v1/customers/{id}/withdrawals", s.createWithdrawal)
+	handle(mux, "GET /v1/customers/{id}/withdrawals", s.getWithdrawalByIdempotencyKey)
+	handle(mux, "GET /v1/customers/{id}/withdrawals/list", s.listWithdrawals)
+	handle(mux, "GET /v1/customers/{id}/withdrawals/{txn}", s.getWithdrawal)
+	handle(mux, "POST /v1/customers/{id}/simulate-withdrawals/{txn}", s.simulateWithdrawalStatus)
+	handle(mux, "POST /v1/customers/{id}/simulate-reset", s.resetCustomerData)
+	handle(mux, "GET /v1/customers/{id}/transactions", s.listTransactions)
+	handle(mux, "GET /v1/customers/{id}/transactions/{txn}", s.getTransaction)
+
+	notEmulated := func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, r, apiError(http.StatusNotFound, "%s %s is not emulated by the fake server", r.Method, r.URL.Path))
+	}
+	mux.HandleFunc("/", notEmulated)
+	tos.HandleFunc("/", notEmulated)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			writeError(w, r, apiError(http.StatusUnauthorized, "missing Authorization header"))
+			return
+		}
+		if r.URL.Path == "/v1/customers/tos_links" || strings.HasPrefix(r.URL.Path, "/v1/customers/tos_links/") {
+			tos.ServeHTTP(w, r)
+			return
+		}
+		mux.ServeHTTP(w, r)
+	})
+}
+
+// newID returns the next ID. IDs are UUID-shaped and sequential across all resources.
+func (s *Server) newID() string {
+	s.seq++
+	return fmt.Sprintf("00000000-0000-4000-8000-%012d", s.seq)
+}
+
+func (s *Server) createTOSLink(w http.ResponseWriter, r *http.Request) error {
+	var req customer.CreateTOSLinkRequest
+	if err := decode(r, &req); err != nil {
+		return err
+	}
+	token := s.newID()
+	s.sessions[token] = ""
+	return writeJSON(w, http.StatusOK, customer.TOSLinkResponse{
+		Url:          s.URL + "/tos/" + token,
+		SessionToken: token,
+		ExpiresIn:    3600,
+	})
+}
+
+func (s *Server) signTOSAgreement(w http.ResponseWriter, r *http.Request) error {
+	token := r.PathValue("token")
+	agreementID, ok := s.sessions[token]
+	if !ok {
+		return apiError(http.StatusNotFound, "TOS session %s not found", token)
+	}
+	if agreementID == "" {
+		agreementID = s.newID()
+		s.sessions[token] = agreementID
+	}
+	return writeJSON(w, http.StatusOK, customer.SignAgreementResponse{SignedAgreementID: agreementID})
+}
+
+// createCustomerBody holds the fields of a customer.CreateCustomerRequest the server keeps.
+// Enum fields are plain strings so that tests may leave the KYB details they do not need empty.
+type createCustomerBody struct {
+	BusinessLegalName          string            `json:"business_legal_name"`
+	BusinessDescription        string            `json:"business_description"`
+	BusinessRegistrationNumber string            `json:"business_registration_number"`
+	Email                      string            `json:"email"`
+	BusinessType               string            `json:"business_type"`
+	BusinessIndustry           string            `json:"business_industry"`
+	RegisteredAddress          *customer.Address `json:"registered_address"`
+	DateOfIncorporation        string            `json:"date_of_incorporation"`
+	PhysicalAddress            *customer.Address `json:"physical_address"`
+	SignedAgreementID          string            `json:"signed_agreement_id"`
+	PrimaryWebsite             string            `json:"primary_website"`
+	PubliclyTraded             bool              `json:"publicly_traded"`
+	TaxID                      string            `json:"tax_id"`
+	TaxType                    string            `json:"tax_type"`
+	TaxCountry                 string            `json:"tax_country"`
+}
+
+func (s *Server) createCustomer(w http.ResponseWriter, r *http.Request) error {
+	var req createCustomerBody
+	if err := decode(r, &req); err != nil {
+		return err
+	}
+	businessType, err := customer.ParseBusinessType(req.BusinessType)
+	if err != nil {
+		return apiError(http.StatusUnprocessableEntity, "business_type: %v", err)
+	}
+	switch {
+	case req.BusinessLegalName == "":
+		return apiError(http.StatusUnprocessableEntity, "business_legal_name is required")
+	case req.Email == "":
+		return apiError(http.StatusUnprocessableEntity, "email is required")
+	case !s.signedAgreement(req.SignedAgreementID):
+		return apiError(http.StatusUnprocessableEntity, "signed_agreement_id %q is not a signed agreement", req.SignedAgreementID)
+	}
+	for _, c := range s.customers {
+		if strings.EqualFold(c.Email, req.Email) {
+			return apiError(http.StatusConflict, "a customer with email %s already exists", req.Email)
+		}
+	}
+
+	now := s.now()
+	c := &fakeCustomer{CustomerResponse: customer.CustomerResponse{
+		CustomerID:                 svc.CustomerID(s.newID()),
+		Email:                      req.Email,
+		BusinessLegalName:          req.BusinessLegalName,
+		BusinessDescription:        req.BusinessDescription,
+		BusinessType:               businessType,
+		BusinessIndustry:           req.BusinessIndustry,
+		BusinessRegistrationNumber: req.BusinessRegistrationNumber,
+		DateOfIncorporation:        req.DateOfIncorporation,
+		RegisteredAddress:          req.RegisteredAddress,
+		PhysicalAddress:            req.PhysicalAddress,
+		PrimaryWebsite:             req.PrimaryWebsite,
+		PubliclyTraded:             req.PubliclyTraded,
+		TaxID:                      req.TaxID,
+		TaxType:                    customer.TaxIDType(req.TaxType),
+		TaxCountry:                 req.TaxCountry,
+		Status:                     customer.KybStatusPendingReview,
+		SubmittedAt:                formatTime(now),
+		CreatedAt:                  formatTime(now),
+		UpdatedAt:                  formatTime(now),
+	}}
+	if req.RegisteredAddress != nil {
+		c.IncorporationCountry = req.RegisteredAddress.Country
+	}
+	if !s.opts.DisableKYBAutoApproval {
+		c.approveAt = now.Add(s.opts.KYBApprovalDelay)
+	}
+	s.customers[c.CustomerID] = c
+	s.customerOrder = append(s.customerOrder, c.CustomerID)
+	return writeJSON(w, http.StatusOK, c.CustomerResponse)
+}
+
+// signedAgreement reports whether id is the ID of a signed TOS agreement.
+func (s *Server) signedAgreement(id string) bool {
+	for _, agreementID := range s.sessions {
+		if id != "" && agreementID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) listCustomers(w http.ResponseWriter, r *http.Request) error {
+	query := r.URL.Query()
+	size, err := intParam(query.Get("page_size"), defaultPageSize)
+	if err != nil {
+		return err
+	}
+	pageNum, err := intParam(query.Get("page_num"), 0)
+	if err != nil {
+		return err
+	}
+
+	var matches []customer.CustomerSummary
+	for _, id := range s.customerOrder {
+		c := s.customer(id)
+		if status := query.Get("kyb_status"); status != "" && string(c.Status) != status {
+			continue
+		}
+		matches = append(matches, customer.CustomerSummary{
+			CustomerID:        c.CustomerID,
+			Email:             c.Email,
+			BusinessLegalName: c.BusinessLegalName,
+			BusinessType:      c.BusinessType,
+			Status:            c.Status,
+			CreatedAt:         c.CreatedAt,
+			UpdatedAt:         c.UpdatedAt,
+		})
+	}
+	// page_num is 0-indexed.
+	page := paginate(matches, pageNum+1, size)
+	return writeJSON(w, http.StatusOK, customer.ListCustomersResponse{Customers: page, Total: len(page)})
+}
+
+func (s *Server) getCustomer(w http.ResponseWriter, r *http.Request) error {
+	c, err := s.findCustomer(r)
+	if err != nil {
+		return err
+	}
+	return writeJSON(w, http.StatusOK, c.CustomerResponse)
+}
+
+func (s *Server) setKYBStatus(w http.ResponseWriter, r *http.Request) error {
+	c, err := s.findCustomer(r)
+	if err != nil {
+		return err
+	}
+	var req simulations.SetKybStatusRequest
+	if err := decode(r, &req); err != nil {
+		return err
+	}
+	c.Status = req.Status
+	c.UpdatedAt = formatTime(s.now())
+	c.approveAt = time.Time{}
+	return writeJSON(w, http.StatusOK, c.CustomerResponse)
+}
+
+// customer returns a customer after applying a due KYB auto-approval.
+func (s *Server) customer(id svc.CustomerID) *fakeCustomer {
+	c := s.customers[id]
+	if c != nil && !c.approveAt.IsZero() && !s.now().Before(c.approveAt) {
+		if c.Status == customer.KybStatusPendingReview {
+			c.Status = customer.KybStatusApproved
+			c.UpdatedAt = formatTime(c.approveAt)
+		}
+		c.approveAt = time.Time{}
+	}
+	return c
+}
+
+// findCustomer returns the customer of the request path.
+func (s *Server) findCustomer(r *http.Request) (*fakeCustomer, error) {
+	id := svc.CustomerID(r.PathValue("id"))
+	c := s.customer(id)
+	if c == nil {
+		return nil, apiError(http.StatusNotFound, "customer %s not found", id)
+	}
+	return c, nil
+}
+
+// approvedCustomer returns the customer of the request path if its KYB is approved.
+func (s *Server) approvedCustomer(r *http.Request) (*fakeCustomer, error) {
+	c, err := s.findCustomer(r)
+	if err != nil {
+		return nil, err
+	}
+	if c.Status != customer.KybStatusApproved {
+		return nil, apiError(http.StatusUnprocessableEntity, "customer %s is not approved (KYB status %s)", c.CustomerID, c.Status)
+	}
+	return c, nil
+}
+
+// errorBody is an API error in the format the SDK parses.
+type errorBody struct {
+	Code     string `json:"code"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail"`
+	Instance string `json:"instance,omitempty"`
+}
+
+// statusError is a handler error reported with an HTTP status.
+type statusError struct {
+	status int
+	detail string
+}
+
+func (e *statusError) Error() string {
+	return e.detail
+}
+
+func apiError(status int, format string, args ...any) error {
+	return &statusError{status: status, detail: fmt.Sprintf(format, args...)}
+}
+
+// writeError writes err in the API error format, e.g. {"code":"Not_Found","status":404,...}.
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	status := http.StatusInternalServerError
+	if se := (*statusError)(nil); errors.As(err, &se) {
+		status = se.status
+	}
+	_ = writeJSON(w, status, errorBody{
+		Code:     strings.ReplaceAll(http.StatusText(status), " ", "_"),
+		Status:   status,
+		Detail:   err.Error(),
+		Instance: r.URL.Path,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	return json.NewEncoder(w).Encode(v)
+}
+
+// decode reads the JSON request body into v. An empty body leaves v unchanged.
+func decode(r *http.Request, v any) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil && !errors.Is(err, io.EOF) {
+		return apiError(http.StatusBadRequest, "invalid request body: %v", err)
+	}
+	return nil
+}
+
+// intParam parses an integer query parameter, returning def when it is empty.
+func intParam(value string, def int) (int, error) {
+	if value == "" {
+		return def, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		return 0, apiError(http.StatusBadRequest, "invalid integer %q", value)
+	}
+	return n, nil
+}
+
+// paginate returns the items of a 1-based page.
+func paginate[T any](items []T, page, size int) []T {
+	if size <= 0 {
+		size = defaultPageSize
+	}
+	start := min(max(page-1, 0)*size, len(items))
+	end := min(start+size, len(items))
+	return slices.Clip(items[start:end])
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// parseAmount parses a positive decimal amount.
+func parseAmount(s string) (*big.Rat, error) {
+	amount, ok := new(big.Rat).SetString(s)
+	if !ok || amount.Sign() <= 0 {
+		return nil, apiError(http.StatusUnprocessableEntity, "amount %q must be a positive decimal", s)
+	}
+	return amount, nil
+}
+
+// formatAmount formats an amount as a decimal without trailing zeros, e.g. "100" or "0.5".
+func formatAmount(r *big.Rat) string {
+	s := r.FloatString(amountDecimals)
+	s = strings.TrimRight(s, "0")
+	return strings.TrimSuffix(s, ".")
+}
+
+// amountDecimals is the precision of the amounts returned by the server.
+const amountDecimals = 18
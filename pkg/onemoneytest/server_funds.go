@@ -0,0 +1,526 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package onemoneytest
+
+import (
+	"cmp"
+	"math/big"
+	"net/http"
+	"net/url"
+	"reflect"
+	"slices"
+	"time"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/simulations"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/transactions"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/withdraws"
+)
+
+// balanceKey identifies a balance of a customer. Fiat balances have no network.
+type balanceKey struct {
+	asset   string
+	network string
+}
+
+// balance is a customer balance of one asset on one network.
+type balance struct {
+	available   *big.Rat
+	unavailable *big.Rat
+	createdAt   time.Time
+	modifiedAt  time.Time
+}
+
+// fakeTxn is a deposit or withdrawal transaction.
+type fakeTxn struct {
+	transactions.TransactionResponse
+	key balanceKey
+	// withdrawal is the request that created a withdrawal; nil for deposits.
+	withdrawal *withdraws.CreateWithdrawalRequest
+}
+
+// keyFor returns the balance key of an asset on a network. USD is held in a single fiat
+// balance whatever the rail, while tokens are held per network.
+func keyFor(asset assets.AssetName, network string) (balanceKey, error) {
+	if asset == assets.AssetNameUSD {
+		return balanceKey{asset: string(asset)}, nil
+	}
+	if network == "" {
+		return balanceKey{}, apiError(http.StatusUnprocessableEntity, "network is required for %s", asset)
+	}
+	return balanceKey{asset: string(asset), network: network}, nil
+}
+
+// balance returns the balance of a customer, creating an empty one when create is set.
+func (s *Server) balance(customerID svc.CustomerID, key balanceKey, create bool) *balance {
+	balances := s.balances[customerID]
+	if balances == nil {
+		if !create {
+			return nil
+		}
+		balances = make(map[balanceKey]*balance)
+		s.balances[customerID] = balances
+	}
+	b := balances[key]
+	if b == nil && create {
+		now := s.now()
+		b = &balance{available: new(big.Rat), unavailable: new(big.Rat), createdAt: now, modifiedAt: now}
+		balances[key] = b
+	}
+	return b
+}
+
+func (s *Server) listAssets(w http.ResponseWriter, r *http.Request) error {
+	c, err := s.findCustomer(r)
+	if err != nil {
+		return err
+	}
+	query := r.URL.Query()
+
+	keys := make([]balanceKey, 0, len(s.balances[c.CustomerID]))
+	for key := range s.balances[c.CustomerID] {
+		if asset := query.Get("asset"); asset != "" && key.asset != asset {
+			continue
+		}
+		if network := query.Get("network"); network != "" && key.network != network {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b balanceKey) int {
+		ba, bb := s.balances[c.CustomerID][a], s.balances[c.CustomerID][b]
+		if n := ba.createdAt.Compare(bb.createdAt); n != 0 {
+			return n
+		}
+		return compareKeys(a, b)
+	})
+	if query.Get("sort_order") == string(assets.SortOrderDESC) {
+		slices.Reverse(keys)
+	}
+
+	result := make([]assets.AssetResponse, 0, len(keys))
+	for _, key := range keys {
+		b := s.balances[c.CustomerID][key]
+		resp := assets.AssetResponse{
+			CustomerID:        c.CustomerID,
+			Asset:             key.asset,
+			AvailableAmount:   formatAmount(b.available),
+			UnavailableAmount: formatAmount(b.unavailable),
+			CreatedAt:         formatTime(b.createdAt),
+			ModifiedAt:        formatTime(b.modifiedAt),
+		}
+		if key.network != "" {
+			resp.Network = &key.network
+		}
+		result = append(result, resp)
+	}
+	return writeJSON(w, http.StatusOK, result)
+}
+
+func compareKeys(a, b balanceKey) int {
+	return cmp.Or(cmp.Compare(a.asset, b.asset), cmp.Compare(a.network, b.network))
+}
+
+// simulateDeposit credits the available balance with a completed deposit. Confirmation
+// tracking is not emulated, so crypto deposits complete immediately too.
+func (s *Server) simulateDeposit(w http.ResponseWriter, r *http.Request) error {
+	c, err := s.approvedCustomer(r)
+	if err != nil {
+		return err
+	}
+	var req simulations.SimulateDepositRequest
+	if err := decode(r, &req); err != nil {
+		return err
+	}
+	amount, err := parseAmount(req.Amount)
+	if err != nil {
+		return err
+	}
+	key, err := keyFor(req.Asset, string(req.Network))
+	if err != nil {
+		return err
+	}
+
+	now := s.now()
+	b := s.balance(c.CustomerID, key, true)
+	b.available.Add(b.available, amount)
+	b.modifiedAt = now
+
+	id := svc.TransactionID(s.newID())
+	endpoint := transactions.TransactionEndpoint{
+		Amount:  formatAmount(amount),
+		Asset:   string(req.Asset),
+		Network: string(req.Network),
+	}
+	txn := &fakeTxn{key: key, TransactionResponse: transactions.TransactionResponse{
+		CustomerID:        c.CustomerID,
+		TransactionID:     id,
+		IdempotencyKey:    string(id),
+		TransactionAction: string(transactions.TransactionActionDEPOSIT),
+		Amount:            formatAmount(amount),
+		Asset:             string(req.Asset),
+		Network:           string(req.Network),
+		TransactionFee:    transactions.TransactionFee{Value: "0", Asset: string(req.Asset)},
+		Source:            endpoint,
+		Destination:       endpoint,
+		Originator:        req.Originator,
+		Status:            transactions.TransactionStatusCOMPLETED,
+		CreatedAt:         formatTime(now),
+		ModifiedAt:        formatTime(now),
+	}}
+	s.addTxn(txn)
+
+	return writeJSON(w, http.StatusOK, simulations.SimulateDepositResponse{
+		SimulationID: id,
+		Status:       txn.Status,
+		CreatedAt:    txn.CreatedAt,
+		ModifiedAt:   txn.ModifiedAt,
+	})
+}
+
+func (s *Server) addTxn(txn *fakeTxn) {
+	s.txns[txn.TransactionID] = txn
+	s.txnOrder = append(s.txnOrder, txn.TransactionID)
+}
+
+// createWithdrawal holds the amount of a new withdrawal until its outcome is simulated.
+//
+// Repeating a request with the same Idempotency-Key returns the withdrawal it created;
+// reusing the key with a different request fails with 409 Conflict.
+func (s *Server) createWithdrawal(w http.ResponseWriter, r *http.Request) error {
+	c, err := s.approvedCustomer(r)
+	if err != nil {
+		return err
+	}
+	var req withdraws.CreateWithdrawalRequest
+	if err := decode(r, &req); err != nil {
+		return err
+	}
+	req.IdempotencyKey = r.Header.Get("Idempotency-Key")
+	if req.IdempotencyKey == "" {
+		return apiError(http.StatusBadRequest, "Idempotency-Key header is required")
+	}
+
+	idempotencyKey := string(c.CustomerID) + "/" + req.IdempotencyKey
+	if prev := s.idempotent[idempotencyKey]; prev != nil {
+		if !reflect.DeepEqual(*prev.withdrawal, req) {
+			return apiError(http.StatusConflict, "idempotency key %s was already used with a different request", req.IdempotencyKey)
+		}
+		return writeJSON(w, http.StatusOK, prev.withdrawalResponse())
+	}
+
+	amount, err := parseAmount(req.Amount)
+	if err != nil {
+		return err
+	}
+	if cmp.Or(req.WalletAddress, req.ExternalAccountID, req.RecipientBankAccountID, req.RecipientWalletAddressID) == "" {
+		return apiError(http.StatusUnprocessableEntity, "a wallet address, external account or recipient is required")
+	}
+	key, err := keyFor(req.Asset, string(req.Network))
+	if err != nil {
+		return err
+	}
+	b := s.balance(c.CustomerID, key, false)
+	if b == nil || b.available.Cmp(amount) < 0 {
+		available := "0"
+		if b != nil {
+			available = formatAmount(b.available)
+		}
+		return apiError(http.StatusUnprocessableEntity, "insufficient %s balance: available %s, requested %s",
+			req.Asset, available, formatAmount(amount))
+	}
+
+	now := s.now()
+	b.available.Sub(b.available, amount)
+	b.unavailable.Add(b.unavailable, amount)
+	b.modifiedAt = now
+
+	destination := cmp.Or(req.WalletAddress, req.ExternalAccountID, req.RecipientBankAccountID, req.RecipientWalletAddressID)
+	txn := &fakeTxn{key: key, withdrawal: &req, TransactionResponse: transactions.TransactionResponse{
+		CustomerID:        c.CustomerID,
+		TransactionID:     svc.TransactionID(s.newID()),
+		IdempotencyKey:    req.IdempotencyKey,
+		TransactionAction: string(transactions.TransactionActionWITHDRAWAL),
+		Amount:            formatAmount(amount),
+		Asset:             string(req.Asset),
+		Network:           string(req.Network),
+		TransactionFee:    transactions.TransactionFee{Value: "0", Asset: string(req.Asset)},
+		Source: transactions.TransactionEndpoint{
+			Amount:  formatAmount(amount),
+			Asset:   string(req.Asset),
+			Network: string(req.Network),
+		},
+		Destination: transactions.TransactionEndpoint{
+			Amount:    formatAmount(amount),
+			Asset:     string(req.Asset),
+			Network:   string(req.Network),
+			AddressID: destination,
+		},
+		Status:     transactions.TransactionStatusPENDING,
+		CreatedAt:  formatTime(now),
+		ModifiedAt: formatTime(now),
+	}}
+	s.addTxn(txn)
+	s.idempotent[idempotencyKey] = txn
+	return writeJSON(w, http.StatusOK, txn.withdrawalResponse())
+}
+
+// withdrawalResponse returns the withdrawal as the withdrawals endpoints report it.
+func (t *fakeTxn) withdrawalResponse() withdraws.WithdrawalResponse {
+	return withdraws.WithdrawalResponse{
+		TransactionID:            t.TransactionID,
+		IdempotencyKey:           t.IdempotencyKey,
+		Amount:                   t.Amount,
+		Asset:                    t.Asset,
+		Network:                  t.Network,
+		WalletAddress:            t.withdrawal.WalletAddress,
+		ExternalAccountID:        t.withdrawal.ExternalAccountID,
+		RecipientID:              t.withdrawal.RecipientID,
+		RecipientBankAccountID:   t.withdrawal.RecipientBankAccountID,
+		RecipientWalletAddressID: t.withdrawal.RecipientWalletAddressID,
+		Code:                     t.withdrawal.Code,
+		Status:                   withdraws.TransactionStatus(t.Status),
+		TransactionFee:           withdraws.FeeMeta{Value: t.TransactionFee.Value, Asset: t.TransactionFee.Asset},
+		TransactionAction:        t.TransactionAction,
+		Originator:               t.withdrawal.Originator,
+		Beneficiary:              t.withdrawal.Beneficiary,
+		BeneficiaryVASP:          t.withdrawal.BeneficiaryVASP,
+		CreatedAt:                t.CreatedAt,
+		ModifiedAt:               t.ModifiedAt,
+	}
+}
+
+// findTxn returns the transaction of the request path if it belongs to the customer
+// and, when action is set, has that action.
+func (s *Server) findTxn(r *http.Request, customerID svc.CustomerID, action transactions.TransactionAction) (*fakeTxn, error) {
+	id := svc.TransactionID(r.PathValue("txn"))
+	txn := s.txns[id]
+	if txn == nil || txn.CustomerID != customerID || (action != "" && txn.TransactionAction != string(action)) {
+		return nil, apiError(http.StatusNotFound, "transaction %s not found", id)
+	}
+	return txn, nil
+}
+
+func (s *Server) getWithdrawal(w http.ResponseWriter, r *http.Request) error {
+	c, err := s.findCustomer(r)
+	if err != nil {
+		return err
+	}
+	txn, err := s.findTxn(r, c.CustomerID, transactions.TransactionActionWITHDRAWAL)
+	if err != nil {
+		return err
+	}
+	return writeJSON(w, http.StatusOK, txn.withdrawalResponse())
+}
+
+func (s *Server) getWithdrawalByIdempotencyKey(w http.ResponseWriter, r *http.Request) error {
+	c, err := s.findCustomer(r)
+	if err != nil {
+		return err
+	}
+	key := r.URL.Query().Get("idempotency_key")
+	txn := s.idempotent[string(c.CustomerID)+"/"+key]
+	if txn == nil {
+		return apiError(http.StatusNotFound, "no withdrawal with idempotency key %q", key)
+	}
+	return writeJSON(w, http.StatusOK, txn.withdrawalResponse())
+}
+
+func (s *Server) listWithdrawals(w http.ResponseWriter, r *http.Request) error {
+	c, err := s.findCustomer(r)
+	if err != nil {
+		return err
+	}
+	query := r.URL.Query()
+	query.Set("transaction_action", string(transactions.TransactionActionWITHDRAWAL))
+	matches, err := s.filterTxns(c.CustomerID, query)
+	if err != nil {
+		return err
+	}
+	page, err := pageOf(matches, query)
+	if err != nil {
+		return err
+	}
+
+	list := make([]withdraws.WithdrawalResponse, 0, len(page))
+	for _, txn := range page {
+		list = append(list, txn.withdrawalResponse())
+	}
+	return writeJSON(w, http.StatusOK, withdraws.ListWithdrawalsResponse{List: list, Total: len(matches)})
+}
+
+// simulateWithdrawalStatus settles a pending withdrawal, or returns its amount to the
+// available balance when it is RETURNED or FAILED.
+func (s *Server) simulateWithdrawalStatus(w http.ResponseWriter, r *http.Request) error {
+	c, err := s.findCustomer(r)
+	if err != nil {
+		return err
+	}
+	txn, err := s.findTxn(r, c.CustomerID, transactions.TransactionActionWITHDRAWAL)
+	if err != nil {
+		return err
+	}
+	var req simulations.SimulateWithdrawalStatusRequest
+	if err := decode(r, &req); err != nil {
+		return err
+	}
+	if txn.Status != transactions.TransactionStatusPENDING {
+		return apiError(http.StatusUnprocessableEntity, "withdrawal %s is %s, not PENDING", txn.TransactionID, txn.Status)
+	}
+
+	amount, _ := new(big.Rat).SetString(txn.Amount)
+	b := s.balance(c.CustomerID, txn.key, true)
+	b.unavailable.Sub(b.unavailable, amount)
+	switch req.Status {
+	case simulations.WithdrawalSimulationStatusSETTLED:
+		txn.Status = transactions.TransactionStatusCOMPLETED
+	case simulations.WithdrawalSimulationStatusRETURNED:
+		txn.Status = transactions.TransactionStatusREVERSED
+		b.available.Add(b.available, amount)
+	default:
+		txn.Status = transactions.TransactionStatusFAILED
+		b.available.Add(b.available, amount)
+	}
+	now := s.now()
+	b.modifiedAt = now
+	txn.ModifiedAt = formatTime(now)
+
+	return writeJSON(w, http.StatusOK, simulations.SimulateWithdrawalStatusResponse{
+		TransactionID: txn.TransactionID,
+		Status:        txn.Status,
+		Reason:        req.Reason,
+		ModifiedAt:    txn.ModifiedAt,
+	})
+}
+
+// resetCustomerData removes the balances and transactions of a customer.
+func (s *Server) resetCustomerData(w http.ResponseWriter, r *http.Request) error {
+	c, err := s.findCustomer(r)
+	if err != nil {
+		return err
+	}
+	delete(s.balances, c.CustomerID)
+	s.txnOrder = slices.DeleteFunc(s.txnOrder, func(id svc.TransactionID) bool {
+		txn := s.txns[id]
+		if txn.CustomerID != c.CustomerID {
+			return false
+		}
+		delete(s.txns, id)
+		if txn.withdrawal != nil {
+			delete(s.idempotent, string(c.CustomerID)+"/"+txn.IdempotencyKey)
+		}
+		return true
+	})
+	return writeJSON(w, http.StatusOK, struct{}{})
+}
+
+func (s *Server) listTransactions(w http.ResponseWriter, r *http.Request) error {
+	c, err := s.findCustomer(r)
+	if err != nil {
+		return err
+	}
+	query := r.URL.Query()
+	matches, err := s.filterTxns(c.CustomerID, query)
+	if err != nil {
+		return err
+	}
+	page, err := pageOf(matches, query)
+	if err != nil {
+		return err
+	}
+
+	list := make([]transactions.TransactionResponse, 0, len(page))
+	for _, txn := range page {
+		list = append(list, txn.TransactionResponse)
+	}
+	return writeJSON(w, http.StatusOK, transactions.ListTransactionsResponse{List: list, Total: len(matches)})
+}
+
+func (s *Server) getTransaction(w http.ResponseWriter, r *http.Request) error {
+	c, err := s.findCustomer(r)
+	if err != nil {
+		return err
+	}
+	txn, err := s.findTxn(r, c.CustomerID, "")
+	if err != nil {
+		return err
+	}
+	return writeJSON(w, http.StatusOK, txn.TransactionResponse)
+}
+
+// filterTxns returns the transactions of a customer matching the list query parameters,
+// newest first unless sort_order is ASC.
+func (s *Server) filterTxns(customerID svc.CustomerID, query url.Values) ([]*fakeTxn, error) {
+	get := query.Get
+	var after, before time.Time
+	for name, t := range map[string]*time.Time{"created_after": &after, "created_before": &before} {
+		if value := get(name); value != "" {
+			parsed, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return nil, apiError(http.StatusBadRequest, "invalid %s %q", name, value)
+			}
+			*t = parsed
+		}
+	}
+
+	var matches []*fakeTxn
+	for _, id := range s.txnOrder {
+		txn := s.txns[id]
+		created, _ := txn.CreatedTime()
+		direction := transactions.TransactionDirectionINBOUND
+		if txn.TransactionAction == string(transactions.TransactionActionWITHDRAWAL) {
+			direction = transactions.TransactionDirectionOUTBOUND
+		}
+		switch {
+		case txn.CustomerID != customerID,
+			!matchParam(get("transaction_id"), string(txn.TransactionID)),
+			!matchParam(get("idempotency_key"), txn.IdempotencyKey),
+			!matchParam(get("asset"), txn.Asset),
+			!matchParam(get("network"), txn.Network),
+			!matchParam(get("transaction_action"), txn.TransactionAction),
+			!matchParam(get("status"), string(txn.Status)),
+			!matchParam(get("direction"), string(direction)),
+			!after.IsZero() && created.Before(after),
+			!before.IsZero() && !created.Before(before):
+			continue
+		case get("tag") != "" && !slices.Contains(txn.Tags, get("tag")):
+			continue
+		}
+		matches = append(matches, txn)
+	}
+	if get("sort_order") != string(assets.SortOrderASC) {
+		slices.Reverse(matches)
+	}
+	return matches, nil
+}
+
+func matchParam(param, value string) bool {
+	return param == "" || param == value
+}
+
+// pageOf returns the page of items selected by the 1-based page and size query parameters.
+func pageOf(items []*fakeTxn, query url.Values) ([]*fakeTxn, error) {
+	page, err := intParam(query.Get("page"), 1)
+	if err != nil {
+		return nil, err
+	}
+	size, err := intParam(query.Get("size"), defaultPageSize)
+	if err != nil {
+		return nil, err
+	}
+	return paginate(items, page, size), nil
+}
@@ -0,0 +1,199 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package onemoneytest_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/1Money-Co/1money-go-sdk/internal/transport"
+	"github.com/1Money-Co/1money-go-sdk/pkg/onemoney"
+	"github.com/1Money-Co/1money-go-sdk/pkg/onemoneytest"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/customer"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/simulations"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/transactions"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/withdraws"
+)
+
+// newCustomer signs the TOS and creates a customer on the fake server.
+func newCustomer(t *testing.T, client *onemoney.Client, email string) *customer.CustomerResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	link, err := client.Customer.CreateTOSLink(ctx, &customer.CreateTOSLinkRequest{})
+	require.NoError(t, err)
+	signed, err := client.Customer.SignTOSAgreement(ctx, link.SessionToken)
+	require.NoError(t, err)
+
+	created, err := client.Customer.CreateCustomer(ctx, &customer.CreateCustomerRequest{
+		BusinessLegalName: "Acme Inc",
+		BusinessType:      customer.BusinessTypeCorporation,
+		Email:             email,
+		SignedAgreementID: signed.SignedAgreementID,
+	})
+	require.NoError(t, err)
+	return created
+}
+
+func TestServerKYBAutoApproval(t *testing.T) {
+	srv := onemoneytest.NewServer(&onemoneytest.ServerOptions{KYBApprovalDelay: time.Minute})
+	t.Cleanup(srv.Close)
+	client, err := srv.Client()
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	created := newCustomer(t, client, "ops@acme.test")
+	assert.Equal(t, customer.KybStatusPendingReview, created.Status)
+	assert.Equal(t, "2025-01-01T00:00:00Z", created.CreatedAt)
+
+	_, err = client.Simulations.SimulateDeposit(ctx, created.CustomerID, &simulations.SimulateDepositRequest{
+		Asset:  assets.AssetNameUSD,
+		Amount: "100",
+	})
+	assert.True(t, transport.IsUnprocessableError(err), "deposit before approval: %v", err)
+
+	srv.Advance(time.Minute)
+	got, err := client.Customer.GetCustomer(ctx, created.CustomerID)
+	require.NoError(t, err)
+	assert.Equal(t, customer.KybStatusApproved, got.Status)
+	assert.Equal(t, "2025-01-01T00:01:00Z", got.UpdatedAt)
+
+	_, err = client.Customer.CreateCustomer(ctx, &customer.CreateCustomerRequest{
+		BusinessLegalName: "Acme Again",
+		Email:             "ops@acme.test",
+		SignedAgreementID: "unsigned",
+	})
+	assert.True(t, transport.IsUnprocessableError(err), "create with unsigned agreement: %v", err)
+
+	rejected := newCustomer(t, client, "risk@acme.test")
+	updated, err := client.Simulations.SetKybStatus(ctx, rejected.CustomerID, customer.KybStatusRejected, []string{"sanctions"})
+	require.NoError(t, err)
+	assert.Equal(t, customer.KybStatusRejected, updated.Status)
+	srv.Advance(time.Hour)
+	got, err = client.Customer.GetCustomer(ctx, rejected.CustomerID)
+	require.NoError(t, err)
+	assert.Equal(t, customer.KybStatusRejected, got.Status, "a simulated status must not be auto-approved")
+
+	list, err := client.Customer.ListCustomers(ctx, &customer.ListCustomersRequest{KybStatus: string(customer.KybStatusApproved)})
+	require.NoError(t, err)
+	require.Len(t, list.Customers, 1)
+	assert.Equal(t, created.CustomerID, list.Customers[0].CustomerID)
+
+	_, err = client.Customer.GetCustomer(ctx, "missing")
+	assert.True(t, transport.IsNotFoundError(err), "get missing customer: %v", err)
+}
+
+func TestServerFunds(t *testing.T) {
+	srv := onemoneytest.NewServer(nil)
+	t.Cleanup(srv.Close)
+	client, err := srv.Client()
+	require.NoError(t, err)
+	ctx := context.Background()
+	id := newCustomer(t, client, "treasury@acme.test").CustomerID
+
+	deposit, err := client.Simulations.SimulateDeposit(ctx, id, &simulations.SimulateDepositRequest{
+		Asset:   assets.AssetNameUSDC,
+		Network: simulations.WalletNetworkNameETHEREUM,
+		Amount:  "100.50",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, transactions.TransactionStatusCOMPLETED, deposit.Status)
+
+	req := &withdraws.CreateWithdrawalRequest{
+		IdempotencyKey: "withdraw-1",
+		Amount:         "40",
+		Asset:          assets.AssetNameUSDC,
+		Network:        assets.NetworkNameETHEREUM,
+		WalletAddress:  "0x1111111111111111111111111111111111111111",
+	}
+	withdrawal, err := client.Withdrawals.CreateWithdrawal(ctx, id, req)
+	require.NoError(t, err)
+	assert.Equal(t, withdraws.TransactionStatusPENDING, withdrawal.Status)
+
+	again, err := client.Withdrawals.CreateWithdrawal(ctx, id, req)
+	require.NoError(t, err)
+	assert.Equal(t, withdrawal.TransactionID, again.TransactionID, "a retried request must not withdraw twice")
+
+	conflicting := *req
+	conflicting.Amount = "41"
+	_, err = client.Withdrawals.CreateWithdrawal(ctx, id, &conflicting)
+	apiErr, ok := transport.IsAPIError(err)
+	require.True(t, ok, "reused idempotency key: %v", err)
+	assert.True(t, apiErr.IsConflictError())
+
+	balances, err := client.Assets.ListAssets(ctx, id, nil)
+	require.NoError(t, err)
+	require.Len(t, balances, 1)
+	assert.Equal(t, "60.5", balances[0].AvailableAmount)
+	assert.Equal(t, "40", balances[0].UnavailableAmount)
+	require.NotNil(t, balances[0].Network)
+	assert.Equal(t, "ETHEREUM", *balances[0].Network)
+
+	overdraft := *req
+	overdraft.IdempotencyKey = "withdraw-2"
+	overdraft.Amount = "1000"
+	_, err = client.Withdrawals.CreateWithdrawal(ctx, id, &overdraft)
+	assert.True(t, transport.IsUnprocessableError(err), "overdraft: %v", err)
+
+	settled, err := client.Simulations.SimulateWithdrawalStatus(ctx, id, withdrawal.TransactionID,
+		simulations.WithdrawalSimulationStatusRETURNED, "R01")
+	require.NoError(t, err)
+	assert.Equal(t, transactions.TransactionStatusREVERSED, settled.Status)
+
+	balances, err = client.Assets.ListAssets(ctx, id, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "100.5", balances[0].AvailableAmount)
+	assert.Equal(t, "0", balances[0].UnavailableAmount)
+
+	byKey, err := client.Withdrawals.GetWithdrawalByIdempotencyKey(ctx, id, "withdraw-1")
+	require.NoError(t, err)
+	assert.Equal(t, withdraws.TransactionStatusREVERSED, byKey.Status)
+
+	txns, err := client.Transactions.ListTransactions(ctx, id, nil)
+	require.NoError(t, err)
+	require.Len(t, txns.List, 2)
+	assert.Equal(t, withdrawal.TransactionID, txns.List[0].TransactionID, "transactions are listed newest first")
+	assert.Equal(t, deposit.SimulationID, txns.List[1].TransactionID)
+
+	deposits, err := client.Transactions.ListTransactions(ctx, id, &transactions.ListTransactionsRequest{
+		Direction: transactions.TransactionDirectionINBOUND,
+	})
+	require.NoError(t, err)
+	require.Len(t, deposits.List, 1)
+	assert.Equal(t, "100.5", deposits.List[0].Amount)
+
+	require.NoError(t, client.Simulations.ResetCustomerData(ctx, id))
+	balances, err = client.Assets.ListAssets(ctx, id, nil)
+	require.NoError(t, err)
+	assert.Empty(t, balances)
+	_, err = client.Transactions.GetTransaction(ctx, id, deposit.SimulationID)
+	assert.True(t, transport.IsNotFoundError(err), "get reset transaction: %v", err)
+}
+
+func TestServerUnknownEndpoint(t *testing.T) {
+	srv := onemoneytest.NewServer(nil)
+	t.Cleanup(srv.Close)
+	client, err := srv.Client()
+	require.NoError(t, err)
+
+	_, err = client.Conversions.GetOrder(context.Background(), "customer", "order")
+	assert.True(t, transport.IsNotFoundError(err), "unemulated endpoint: %v", err)
+}
@@ -0,0 +1,272 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package sandbox prepares data in the 1Money sandbox environment for integrations and tests.
+//
+// Bootstrap creates a KYB-approved customer with funded USD and USDC balances and an approved
+// external bank account. It generates customer data with package fixtures, so it is kept out of
+// package onemoney to keep fake data and sample documents out of production binaries.
+package sandbox
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/1Money-Co/1money-go-sdk/internal/transport"
+	"github.com/1Money-Co/1money-go-sdk/internal/utils"
+	"github.com/1Money-Co/1money-go-sdk/pkg/fixtures"
+	"github.com/1Money-Co/1money-go-sdk/pkg/onemoney"
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/customer"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/external_accounts"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/instructions"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/simulations"
+)
+
+// Default sandbox bootstrap values.
+const (
+	// DefaultAmount is the USD and USDC balance funded by Bootstrap.
+	DefaultAmount = "10000.00"
+	// DefaultUSDCNetwork is the network the USDC balance is funded on.
+	DefaultUSDCNetwork = simulations.WalletNetworkNamePOLYGON
+)
+
+// Fiat provisioning polling defaults.
+const (
+	provisioningPollInterval = 2 * time.Second
+	provisioningMaxWaitTime  = 5 * time.Minute
+)
+
+// Sandbox bootstrap option and result types.
+type (
+	// Options configures Bootstrap. The zero value creates a customer with
+	// random fixture data and funds DefaultAmount of USD and USDC.
+	Options struct {
+		// Fixtures generates the customer and external account requests. Default: fixtures.New(0).
+		Fixtures *fixtures.Builder
+		// Customer adjusts the generated customer request, e.g. to set a recognizable email.
+		Customer []fixtures.Option[customer.CreateCustomerRequest]
+		// ExternalAccount adjusts the generated US ACH external account request.
+		ExternalAccount []fixtures.Option[external_accounts.CreateReq]
+		// USDAmount is the USD balance funded over US ACH. Default: DefaultAmount.
+		USDAmount string
+		// USDCAmount is the USDC balance funded on USDCNetwork. Default: DefaultAmount.
+		USDCAmount string
+		// USDCNetwork is the network of the USDC deposit. Default: DefaultUSDCNetwork.
+		USDCNetwork simulations.WalletNetworkName
+		// WaitOptions configures polling for fiat account provisioning. Default: poll every 2s for up to 5m.
+		WaitOptions *customer.WaitOptions
+	}
+
+	// Account holds the IDs of the data created by Bootstrap.
+	Account struct {
+		// CustomerID is the KYB-approved customer.
+		CustomerID svc.CustomerID
+		// SignedAgreementID is the Terms of Service agreement signed for the customer.
+		SignedAgreementID string
+		// AssociatedPersonIDs are the customer's associated persons.
+		AssociatedPersonIDs []string
+		// USDDepositID is the simulated deposit funding the USD balance.
+		USDDepositID svc.TransactionID
+		// USDCDepositID is the simulated deposit funding the USDC balance.
+		USDCDepositID svc.TransactionID
+		// ExternalAccountID is the approved US ACH external account.
+		ExternalAccountID string
+	}
+
+	// bootstrapServices are the services Bootstrap uses.
+	bootstrapServices struct {
+		customer     customer.Service
+		simulations  simulations.Service
+		instructions instructions.Service
+	}
+)
+
+// Bootstrap prepares a sandbox customer ready for conversions and withdrawals:
+// it creates a customer with fixture data, forces KYB approval, waits for the fiat account
+// to be provisioned, funds USD and USDC balances with simulated deposits, and creates an
+// approved external bank account. Only available in non-production environments.
+//
+// When a step fails, the returned Account holds the IDs created so far, so callers
+// can clean up the customer.
+//
+//	account, err := sandbox.Bootstrap(ctx, client, nil)
+//	quote, err := client.Conversions.CreateQuote(ctx, account.CustomerID, req)
+func Bootstrap(ctx context.Context, client *onemoney.Client, opts *Options) (*Account, error) {
+	return bootstrap(ctx, bootstrapServices{
+		customer:     client.Customer,
+		simulations:  client.Simulations,
+		instructions: client.Instructions,
+	}, opts)
+}
+
+func bootstrap(ctx context.Context, services bootstrapServices, opts *Options) (*Account, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+	builder := opts.Fixtures
+	if builder == nil {
+		builder = fixtures.New(0)
+	}
+	account := &Account{}
+
+	// Step 1: Sign the Terms of Service and create the customer
+	tos, err := services.customer.CreateTOSLink(ctx, &customer.CreateTOSLinkRequest{
+		RedirectUrl: "https://example.com/redirect",
+	})
+	if err != nil {
+		return account, fmt.Errorf("failed to create TOS link: %w", err)
+	}
+	signed, err := services.customer.SignTOSAgreement(ctx, tos.SessionToken)
+	if err != nil {
+		return account, fmt.Errorf("failed to sign TOS agreement: %w", err)
+	}
+	account.SignedAgreementID = signed.SignedAgreementID
+
+	created, err := services.customer.CreateCustomer(ctx,
+		builder.CreateCustomerRequest(signed.SignedAgreementID, opts.Customer...))
+	if err != nil {
+		return account, fmt.Errorf("failed to create customer: %w", err)
+	}
+	account.CustomerID = created.CustomerID
+
+	persons, err := services.customer.ListAssociatedPersons(ctx, created.CustomerID)
+	if err != nil {
+		return account, fmt.Errorf("failed to list associated persons: %w", err)
+	}
+	for i := range *persons {
+		account.AssociatedPersonIDs = append(account.AssociatedPersonIDs, (*persons)[i].AssociatedPersonID)
+	}
+
+	// Step 2: Approve KYB instead of waiting for sandbox auto-approval
+	if created.Status != customer.KybStatusApproved {
+		if _, err := services.simulations.SetKybStatus(ctx, created.CustomerID, customer.KybStatusApproved, nil); err != nil {
+			return account, fmt.Errorf("failed to approve customer: %w", err)
+		}
+	}
+
+	// Step 3: Wait for the fiat account, which external accounts and USD deposits require
+	if err := waitForFiatAccount(ctx, services.instructions, created.CustomerID, opts.WaitOptions); err != nil {
+		return account, err
+	}
+
+	// Step 4: Fund balances and approve an external account
+	network := opts.USDCNetwork
+	if network == "" {
+		network = DefaultUSDCNetwork
+	}
+	seeded, err := services.simulations.Seed(ctx, created.CustomerID, &simulations.SeedScenario{
+		Balances: []simulations.SimulateDepositRequest{
+			{
+				Asset:   assets.AssetNameUSD,
+				Network: simulations.WalletNetworkNameUSACH,
+				Amount:  cmp.Or(opts.USDAmount, DefaultAmount),
+			},
+			{
+				Asset:   assets.AssetNameUSDC,
+				Network: network,
+				Amount:  cmp.Or(opts.USDCAmount, DefaultAmount),
+			},
+		},
+		ExternalAccount: builder.ExternalAccountRequest(opts.ExternalAccount...),
+	})
+	if seeded != nil {
+		if len(seeded.Deposits) > 0 {
+			account.USDDepositID = seeded.Deposits[0].SimulationID
+		}
+		if len(seeded.Deposits) > 1 {
+			account.USDCDepositID = seeded.Deposits[1].SimulationID
+		}
+		if seeded.ExternalAccount != nil {
+			account.ExternalAccountID = seeded.ExternalAccount.ExternalAccountID
+		}
+	}
+	if err != nil {
+		return account, fmt.Errorf("failed to fund sandbox customer: %w", err)
+	}
+
+	return account, nil
+}
+
+// waitForFiatAccount polls the customer's deposit instructions until a fiat bank instruction
+// is available. Until the fiat account is provisioned, the API rejects the request with a
+// "verified fiat account is required" error, which is treated as not ready yet.
+func waitForFiatAccount(
+	ctx context.Context,
+	service instructions.Service,
+	customerID svc.CustomerID,
+	opts *customer.WaitOptions,
+) error {
+	utilOpts := &utils.WaitOptions{
+		PollInterval: provisioningPollInterval,
+		MaxWaitTime:  provisioningMaxWaitTime,
+		LogMessage:   "waiting for fiat account provisioning",
+	}
+	if opts != nil {
+		utilOpts.PollInterval = cmp.Or(opts.PollInterval, provisioningPollInterval)
+		utilOpts.MaxWaitTime = cmp.Or(opts.MaxWaitTime, provisioningMaxWaitTime)
+		utilOpts.Logger = opts.Logger
+		utilOpts.PrintProgress = opts.PrintProgress
+	}
+
+	_, err := utils.WaitFor(
+		ctx,
+		func(ctx context.Context) (*[]instructions.InstructionResponse, error) {
+			list, err := service.ListDepositInstructions(ctx, customerID)
+			if isFiatAccountPending(err) {
+				return &[]instructions.InstructionResponse{}, nil
+			}
+			return &list, err
+		},
+		func(list *[]instructions.InstructionResponse) bool {
+			return hasBankInstruction(*list)
+		},
+		func(list *[]instructions.InstructionResponse) string {
+			if hasBankInstruction(*list) {
+				return "READY"
+			}
+			return "PROVISIONING"
+		},
+		"customer",
+		string(customerID),
+		utilOpts,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to wait for fiat account: %w", err)
+	}
+	return nil
+}
+
+func isFiatAccountPending(err error) bool {
+	var apiErr *transport.APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusBadRequest &&
+		strings.Contains(apiErr.Detail, "verified fiat account")
+}
+
+func hasBankInstruction(list []instructions.InstructionResponse) bool {
+	for i := range list {
+		if list[i].BankInstruction != nil {
+			return true
+		}
+	}
+	return false
+}
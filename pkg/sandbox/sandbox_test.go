@@ -0,0 +1,173 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sandbox
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/1Money-Co/1money-go-sdk/internal/transport"
+	"github.com/1Money-Co/1money-go-sdk/pkg/fixtures"
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/customer"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/external_accounts"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/instructions"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/simulations"
+)
+
+// fakeCustomerService creates a single pending customer.
+type fakeCustomerService struct {
+	customer.Service
+	created *customer.CreateCustomerRequest
+}
+
+func (*fakeCustomerService) CreateTOSLink(context.Context, *customer.CreateTOSLinkRequest) (*customer.TOSLinkResponse, error) {
+	return &customer.TOSLinkResponse{SessionToken: "session"}, nil
+}
+
+func (*fakeCustomerService) SignTOSAgreement(context.Context, string) (*customer.SignAgreementResponse, error) {
+	return &customer.SignAgreementResponse{SignedAgreementID: "agreement-1"}, nil
+}
+
+func (f *fakeCustomerService) CreateCustomer(
+	_ context.Context, req *customer.CreateCustomerRequest,
+) (*customer.CreateCustomerResponse, error) {
+	f.created = req
+	return &customer.CreateCustomerResponse{CustomerID: "cid", Status: customer.KybStatusUnderReview}, nil
+}
+
+func (*fakeCustomerService) ListAssociatedPersons(
+	context.Context, svc.CustomerID,
+) (*customer.ListAssociatedPersonsResponse, error) {
+	return &customer.ListAssociatedPersonsResponse{{AssociatedPersonID: "ap-1"}, {AssociatedPersonID: "ap-2"}}, nil
+}
+
+// fakeSimulationsService records KYB approvals and seeded scenarios.
+type fakeSimulationsService struct {
+	simulations.Service
+	kybStatus customer.KybStatus
+	scenario  *simulations.SeedScenario
+	seedErr   error
+}
+
+func (f *fakeSimulationsService) SetKybStatus(
+	_ context.Context, id svc.CustomerID, status customer.KybStatus, _ []string,
+) (*customer.CustomerResponse, error) {
+	f.kybStatus = status
+	return &customer.CustomerResponse{CustomerID: id, Status: status}, nil
+}
+
+func (f *fakeSimulationsService) Seed(
+	_ context.Context, _ svc.CustomerID, scenario *simulations.SeedScenario,
+) (*simulations.SeedResult, error) {
+	f.scenario = scenario
+	result := &simulations.SeedResult{
+		Deposits: []simulations.SimulateDepositResponse{{SimulationID: "sim-usd"}, {SimulationID: "sim-usdc"}},
+	}
+	if f.seedErr != nil {
+		return result, f.seedErr
+	}
+	result.ExternalAccount = &external_accounts.Resp{ExternalAccountID: "ea-1"}
+	return result, nil
+}
+
+// fakeInstructionsService rejects requests until the fiat account is provisioned.
+type fakeInstructionsService struct {
+	instructions.Service
+	pendingCalls int
+	calls        int
+}
+
+func (f *fakeInstructionsService) ListDepositInstructions(
+	context.Context, svc.CustomerID,
+) ([]instructions.InstructionResponse, error) {
+	f.calls++
+	if f.calls <= f.pendingCalls {
+		return nil, &transport.APIError{StatusCode: 400, Detail: "A verified fiat account is required"}
+	}
+	return []instructions.InstructionResponse{
+		{Asset: "USDC", WalletInstruction: &instructions.WalletInstruction{}},
+		{Asset: "USD", BankInstruction: &instructions.BankInstruction{}},
+	}, nil
+}
+
+func TestBootstrap(t *testing.T) {
+	customers := &fakeCustomerService{}
+	sims := &fakeSimulationsService{}
+	instr := &fakeInstructionsService{pendingCalls: 2}
+	services := bootstrapServices{customer: customers, simulations: sims, instructions: instr}
+
+	account, err := bootstrap(context.Background(), services, &Options{
+		Customer: []fixtures.Option[customer.CreateCustomerRequest]{func(r *customer.CreateCustomerRequest) {
+			r.Email = "bootstrap@example.com"
+		}},
+		USDAmount:   "25.00",
+		WaitOptions: &customer.WaitOptions{PollInterval: time.Millisecond},
+	})
+	if err != nil {
+		t.Fatalf("bootstrap() error = %v", err)
+	}
+
+	want := Account{
+		CustomerID:          "cid",
+		SignedAgreementID:   "agreement-1",
+		AssociatedPersonIDs: []string{"ap-1", "ap-2"},
+		USDDepositID:        "sim-usd",
+		USDCDepositID:       "sim-usdc",
+		ExternalAccountID:   "ea-1",
+	}
+	if !reflect.DeepEqual(*account, want) {
+		t.Errorf("account = %+v, want %+v", *account, want)
+	}
+	if customers.created.Email != "bootstrap@example.com" || customers.created.SignedAgreementID != "agreement-1" {
+		t.Errorf("customer request email = %q, agreement = %q", customers.created.Email, customers.created.SignedAgreementID)
+	}
+	if sims.kybStatus != customer.KybStatusApproved {
+		t.Errorf("KYB status = %q, want APPROVED", sims.kybStatus)
+	}
+	if instr.calls != 3 {
+		t.Errorf("polled instructions %d times, want 3", instr.calls)
+	}
+
+	balances := sims.scenario.Balances
+	if len(balances) != 2 || balances[0].Amount != "25.00" || balances[1].Amount != DefaultAmount ||
+		balances[1].Network != DefaultUSDCNetwork {
+		t.Errorf("balances = %+v", balances)
+	}
+	if sims.scenario.ExternalAccount == nil || sims.scenario.ExternalAccount.IdempotencyKey == "" {
+		t.Errorf("external account request = %+v", sims.scenario.ExternalAccount)
+	}
+}
+
+func TestBootstrap_PartialResult(t *testing.T) {
+	services := bootstrapServices{
+		customer:     &fakeCustomerService{},
+		simulations:  &fakeSimulationsService{seedErr: errors.New("boom")},
+		instructions: &fakeInstructionsService{},
+	}
+
+	account, err := bootstrap(context.Background(), services, nil)
+	if err == nil {
+		t.Fatal("bootstrap() expected error")
+	}
+	if account.CustomerID != "cid" || account.USDCDepositID != "sim-usdc" || account.ExternalAccountID != "" {
+		t.Errorf("account = %+v, want the customer and deposits created before the failure", *account)
+	}
+}
@@ -0,0 +1,86 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: v0.9.2
+
+// Built By: go install
+
+package address_allowlist
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// EntryStatusPENDING is a EntryStatus of type PENDING.
+	EntryStatusPENDING EntryStatus = "PENDING"
+	// EntryStatusACTIVE is a EntryStatus of type ACTIVE.
+	EntryStatusACTIVE EntryStatus = "ACTIVE"
+)
+
+var ErrInvalidEntryStatus = fmt.Errorf("not a valid EntryStatus, try [%s]", strings.Join(_EntryStatusNames, ", "))
+
+var _EntryStatusNames = []string{
+	string(EntryStatusPENDING),
+	string(EntryStatusACTIVE),
+}
+
+// EntryStatusNames returns a list of possible string values of EntryStatus.
+func EntryStatusNames() []string {
+	tmp := make([]string, len(_EntryStatusNames))
+	copy(tmp, _EntryStatusNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x EntryStatus) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x EntryStatus) IsValid() bool {
+	_, err := ParseEntryStatus(string(x))
+	return err == nil
+}
+
+var _EntryStatusValue = map[string]EntryStatus{
+	"PENDING": EntryStatusPENDING,
+	"pending": EntryStatusPENDING,
+	"ACTIVE":  EntryStatusACTIVE,
+	"active":  EntryStatusACTIVE,
+}
+
+// ParseEntryStatus attempts to convert a string to a EntryStatus.
+func ParseEntryStatus(name string) (EntryStatus, error) {
+	if x, ok := _EntryStatusValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _EntryStatusValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return EntryStatus(""), fmt.Errorf("%s is %w", name, ErrInvalidEntryStatus)
+}
+
+// MarshalText implements the text marshaller method.
+func (x EntryStatus) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *EntryStatus) UnmarshalText(text []byte) error {
+	tmp, err := ParseEntryStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *EntryStatus) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
@@ -0,0 +1,105 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package address_allowlist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+)
+
+// Address allowlist errors.
+var (
+	// ErrInvalidRequest is returned when an add request is missing required fields.
+	ErrInvalidRequest = errors.New("invalid address allowlist request")
+	// ErrAddressNotAllowlisted is returned by CheckAddress when the address is not on the allowlist.
+	ErrAddressNotAllowlisted = errors.New("withdrawal address is not allowlisted")
+	// ErrAddressPending is returned by CheckAddress when the address is still in its cooldown period.
+	ErrAddressPending = errors.New("withdrawal address is still in its allowlist cooldown")
+)
+
+// Validate checks that the request has an address and a network.
+func (r *AddAddressRequest) Validate() error {
+	if r == nil {
+		return fmt.Errorf("%w: request is nil", ErrInvalidRequest)
+	}
+	if strings.TrimSpace(r.Address) == "" {
+		return fmt.Errorf("%w: address is required", ErrInvalidRequest)
+	}
+	if r.Network == "" {
+		return fmt.Errorf("%w: network is required", ErrInvalidRequest)
+	}
+	return nil
+}
+
+// checkAddress allows the address when the allowlist is disabled, and otherwise requires an
+// ACTIVE entry for the address on the network.
+func checkAddress(ctx context.Context, service Service, network assets.NetworkName, address string) error {
+	settings, err := service.GetSettings(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get allowlist settings: %w", err)
+	}
+	if !settings.Enabled {
+		return nil
+	}
+
+	entries, err := service.ListAddresses(ctx, &ListAddressesRequest{Network: network, Address: address})
+	if err != nil {
+		return fmt.Errorf("failed to list allowlisted addresses: %w", err)
+	}
+
+	pending := false
+	for i := range entries {
+		e := &entries[i]
+		if !strings.EqualFold(e.Network, string(network)) || !sameAddress(network, e.Address, address) {
+			continue
+		}
+		if e.Status == EntryStatusACTIVE {
+			return nil
+		}
+		pending = pending || e.Status == EntryStatusPENDING
+	}
+
+	if pending {
+		return fmt.Errorf("%w: %s on %s", ErrAddressPending, address, network)
+	}
+	return fmt.Errorf("%w: %s on %s", ErrAddressNotAllowlisted, address, network)
+}
+
+// sameAddress compares wallet addresses. Hex EVM addresses are compared case-insensitively,
+// since their checksum casing is optional; Solana base58 addresses are case-sensitive.
+func sameAddress(network assets.NetworkName, a, b string) bool {
+	if network == assets.NetworkNameSOLANA {
+		return a == b
+	}
+	return strings.EqualFold(a, b)
+}
+
+// ActiveTime parses ActiveAt into a time.Time.
+func (r *EntryResponse) ActiveTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.ActiveAt)
+}
+
+// CreatedTime parses CreatedAt into a time.Time.
+func (r *EntryResponse) CreatedTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.CreatedAt)
+}
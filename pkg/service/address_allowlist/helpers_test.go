@@ -0,0 +1,99 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package address_allowlist
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+)
+
+// fakeAllowlistService serves fixed settings and entries.
+type fakeAllowlistService struct {
+	Service
+	settings SettingsResponse
+	entries  []EntryResponse
+}
+
+func (f *fakeAllowlistService) GetSettings(context.Context) (*SettingsResponse, error) {
+	return &f.settings, nil
+}
+
+func (f *fakeAllowlistService) ListAddresses(context.Context, *ListAddressesRequest) ([]EntryResponse, error) {
+	return f.entries, nil
+}
+
+func TestCheckAddress(t *testing.T) {
+	entries := []EntryResponse{
+		{Address: "0xAbC0000000000000000000000000000000000001", Network: "ETHEREUM", Status: EntryStatusACTIVE},
+		{Address: "0xabc0000000000000000000000000000000000002", Network: "ETHEREUM", Status: EntryStatusPENDING},
+		{Address: "So1anaAddr", Network: "SOLANA", Status: EntryStatusACTIVE},
+	}
+
+	tests := []struct {
+		name    string
+		enabled bool
+		network assets.NetworkName
+		address string
+		wantErr error
+	}{
+		{"disabled allows anything", false, assets.NetworkNameETHEREUM, "0xdead", nil},
+		{"active address", true, assets.NetworkNameETHEREUM, "0xabc0000000000000000000000000000000000001", nil},
+		{"pending address", true, assets.NetworkNameETHEREUM, "0xabc0000000000000000000000000000000000002", ErrAddressPending},
+		{"unknown address", true, assets.NetworkNameETHEREUM, "0xdead", ErrAddressNotAllowlisted},
+		{"other network", true, assets.NetworkNamePOLYGON, "0xabc0000000000000000000000000000000000001", ErrAddressNotAllowlisted},
+		{"solana is case-sensitive", true, assets.NetworkNameSOLANA, "so1anaaddr", ErrAddressNotAllowlisted},
+		{"solana exact match", true, assets.NetworkNameSOLANA, "So1anaAddr", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &fakeAllowlistService{settings: SettingsResponse{Enabled: tt.enabled}, entries: entries}
+			err := checkAddress(context.Background(), service, tt.network, tt.address)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("checkAddress() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAddAddressRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *AddAddressRequest
+		wantErr bool
+	}{
+		{"valid", &AddAddressRequest{Address: "0xabc", Network: assets.NetworkNameETHEREUM}, false},
+		{"nil", nil, true},
+		{"missing address", &AddAddressRequest{Network: assets.NetworkNameETHEREUM}, true},
+		{"missing network", &AddAddressRequest{Address: "0xabc"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidRequest) {
+				t.Errorf("Validate() error = %v, want ErrInvalidRequest", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package address_allowlist provides management of the withdrawal address allowlist for the platform account.
+//
+// This package implements the address allowlist service client for the 1Money platform.
+// When the allowlist is enabled, crypto withdrawals may only be sent to allowlisted addresses,
+// and newly added addresses only become usable after a cooldown period, limiting the damage
+// a compromised API key can do.
+//
+// # Basic Usage
+//
+//	import (
+//	    "context"
+//	    onemoney "github.com/1Money-Co/1money-go-sdk/pkg/onemoney"
+//	    "github.com/1Money-Co/1money-go-sdk/pkg/service/address_allowlist"
+//	    "github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+//	)
+//
+//	// Create client that also checks withdrawal addresses before submitting them
+//	client, err := onemoney.NewClient(&onemoney.Config{
+//	    AccessKey: "your-access-key",
+//	    SecretKey: "your-secret-key",
+//	}, onemoney.WithAddressAllowlist())
+//
+//	// Add an address; it becomes ACTIVE once the cooldown has elapsed
+//	entry, err := client.AddressAllowlist.AddAddress(ctx, &address_allowlist.AddAddressRequest{
+//	    IdempotencyKey: "unique-key",
+//	    Address:        "0x...",
+//	    Network:        assets.NetworkNameETHEREUM,
+//	    Label:          "treasury cold wallet",
+//	})
+package address_allowlist
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+)
+
+// Service defines the address allowlist service interface for managing allowlisted withdrawal addresses.
+type Service interface {
+	// GetSettings retrieves whether the allowlist is enforced and its cooldown period.
+	GetSettings(ctx context.Context) (*SettingsResponse, error)
+	// AddAddress adds an address to the allowlist. It stays PENDING until the cooldown has elapsed.
+	AddAddress(ctx context.Context, req *AddAddressRequest) (*EntryResponse, error)
+	// ListAddresses retrieves allowlisted addresses matching the filters.
+	ListAddresses(ctx context.Context, req *ListAddressesRequest) ([]EntryResponse, error)
+	// RemoveAddress removes an address from the allowlist immediately.
+	RemoveAddress(ctx context.Context, entryID string) (*EntryResponse, error)
+	// CheckAddress returns nil if a crypto withdrawal to the address is allowed: either the
+	// allowlist is disabled or the address is ACTIVE on it for the network.
+	CheckAddress(ctx context.Context, network assets.NetworkName, address string) error
+}
+
+// Address allowlist request and response types.
+type (
+	// SettingsResponse represents the allowlist settings of the platform account.
+	SettingsResponse struct {
+		// Enabled reports whether crypto withdrawals are restricted to allowlisted addresses.
+		Enabled bool `json:"enabled"`
+		// CooldownSeconds is how long a newly added address stays PENDING.
+		CooldownSeconds int `json:"cooldown_seconds"`
+	}
+
+	// AddAddressRequest represents the request body for adding an address to the allowlist.
+	AddAddressRequest struct {
+		// IdempotencyKey is a unique key to ensure idempotent creation.
+		// This is sent as a header, not in the body.
+		IdempotencyKey string `json:"-"`
+		// Address is the wallet address.
+		Address string `json:"address"`
+		// Network is the network the address belongs to.
+		Network assets.NetworkName `json:"network"`
+		// Label is a description of the address, e.g. "treasury cold wallet" (optional).
+		Label string `json:"label,omitempty"`
+	}
+
+	// ListAddressesRequest represents optional query parameters for listing allowlisted addresses.
+	ListAddressesRequest struct {
+		// Network filters by network.
+		Network assets.NetworkName `json:"network,omitempty"`
+		// Address filters by wallet address.
+		Address string `json:"address,omitempty"`
+		// Status filters by entry status.
+		Status EntryStatus `json:"status,omitempty"`
+		// Page is the page number (starts from 1).
+		Page int `json:"page,omitempty"`
+		// Size is the number of items per page (1-100).
+		Size int `json:"size,omitempty"`
+	}
+
+	// EntryResponse represents an allowlisted address.
+	EntryResponse struct {
+		// EntryID is the unique identifier of the entry.
+		EntryID string `json:"entry_id"`
+		// Address is the wallet address.
+		Address string `json:"address"`
+		// Network is the network the address belongs to.
+		Network string `json:"network"`
+		// Label is the description of the address (optional).
+		Label string `json:"label,omitempty"`
+		// Status is the current status of the entry.
+		Status EntryStatus `json:"status"`
+		// ActiveAt is when the cooldown ends and the address becomes ACTIVE (ISO 8601 format).
+		ActiveAt string `json:"active_at"`
+		// CreatedAt is the entry creation timestamp (ISO 8601 format).
+		CreatedAt string `json:"created_at"`
+	}
+)
+
+type serviceImpl struct {
+	*svc.BaseService
+}
+
+// NewService creates a new address allowlist service instance with the given base service.
+func NewService(base *svc.BaseService) Service {
+	return &serviceImpl{
+		BaseService: base,
+	}
+}
+
+// GetSettings retrieves whether the allowlist is enforced and its cooldown period.
+func (s *serviceImpl) GetSettings(ctx context.Context) (*SettingsResponse, error) {
+	return svc.GetJSON[SettingsResponse](ctx, s.BaseService, "/v1/address-allowlist/settings")
+}
+
+// AddAddress adds an address to the allowlist.
+func (s *serviceImpl) AddAddress(ctx context.Context, req *AddAddressRequest) (*EntryResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	headers := make(map[string]string)
+	if req.IdempotencyKey != "" {
+		headers["Idempotency-Key"] = req.IdempotencyKey
+	}
+
+	return svc.PostJSONWithHeaders[*AddAddressRequest, EntryResponse](
+		ctx, s.BaseService, "/v1/address-allowlist", req, headers,
+	)
+}
+
+// ListAddresses retrieves allowlisted addresses matching the filters.
+func (s *serviceImpl) ListAddresses(ctx context.Context, req *ListAddressesRequest) ([]EntryResponse, error) {
+	params := make(map[string]string)
+	if req != nil {
+		if req.Network != "" {
+			params["network"] = string(req.Network)
+		}
+		if req.Address != "" {
+			params["address"] = req.Address
+		}
+		if req.Status != "" {
+			params["status"] = string(req.Status)
+		}
+		if req.Page > 0 {
+			params["page"] = strconv.Itoa(req.Page)
+		}
+		if req.Size > 0 {
+			params["size"] = strconv.Itoa(req.Size)
+		}
+	}
+
+	result, err := svc.GetJSONWithParams[[]EntryResponse](ctx, s.BaseService, "/v1/address-allowlist/list", params)
+	if err != nil {
+		return nil, err
+	}
+	return *result, nil
+}
+
+// RemoveAddress removes an address from the allowlist immediately.
+func (s *serviceImpl) RemoveAddress(ctx context.Context, entryID string) (*EntryResponse, error) {
+	path := fmt.Sprintf("/v1/address-allowlist/%s/remove", entryID)
+	return svc.PostJSON[any, EntryResponse](ctx, s.BaseService, path, nil)
+}
+
+// CheckAddress returns nil if a crypto withdrawal to the address is allowed.
+func (s *serviceImpl) CheckAddress(ctx context.Context, network assets.NetworkName, address string) error {
+	return checkAddress(ctx, s, network, address)
+}
@@ -16,6 +16,22 @@
 
 package service
 
-// CustomerID is a type alias for customer identifiers.
-// Using this alias improves code readability by making the purpose of string parameters clear.
-type CustomerID = string
+// ID aliases. Service methods and response fields use these for customer, recipient and
+// transaction IDs, so they accept and hold plain strings.
+type (
+	// CustomerID is a type alias for customer identifiers.
+	// Using this alias improves code readability by making the purpose of string parameters clear.
+	//
+	// Deprecated: CustomerID will become an alias of ids.CustomerID, a distinct type, in the next
+	// minor release. Validate IDs with ids.ParseCustomerID now, and convert string variables
+	// with service.CustomerID(s) where a CustomerID is expected so that the change compiles.
+	CustomerID = string
+	// RecipientID is a type alias for saved payout recipient identifiers.
+	//
+	// Deprecated: RecipientID will become an alias of ids.RecipientID in the next minor release.
+	RecipientID = string
+	// TransactionID is a type alias for transaction identifiers.
+	//
+	// Deprecated: TransactionID will become an alias of ids.TransactionID in the next minor release.
+	TransactionID = string
+)
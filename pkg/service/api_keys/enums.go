@@ -0,0 +1,36 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api_keys
+
+//go:generate go tool go-enum -f=$GOFILE --marshal --names --nocase
+
+// Scope represents a permission granted to an API key.
+/* ENUM(
+CUSTOMERS_READ
+CUSTOMERS_WRITE
+TRANSACTIONS_READ
+WITHDRAWALS_WRITE
+CONVERSIONS_WRITE
+ADMIN
+)
+*/
+type Scope string
+
+// APIKeyStatus represents the status of an API key.
+// EXPIRING keys were rotated and stop working at the end of their grace period.
+// ENUM(ACTIVE, EXPIRING, EXPIRED, REVOKED)
+type APIKeyStatus string
@@ -0,0 +1,191 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: v0.9.2
+
+// Built By: go install
+
+package api_keys
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// APIKeyStatusACTIVE is a APIKeyStatus of type ACTIVE.
+	APIKeyStatusACTIVE APIKeyStatus = "ACTIVE"
+	// APIKeyStatusEXPIRING is a APIKeyStatus of type EXPIRING.
+	APIKeyStatusEXPIRING APIKeyStatus = "EXPIRING"
+	// APIKeyStatusEXPIRED is a APIKeyStatus of type EXPIRED.
+	APIKeyStatusEXPIRED APIKeyStatus = "EXPIRED"
+	// APIKeyStatusREVOKED is a APIKeyStatus of type REVOKED.
+	APIKeyStatusREVOKED APIKeyStatus = "REVOKED"
+)
+
+var ErrInvalidAPIKeyStatus = fmt.Errorf("not a valid APIKeyStatus, try [%s]", strings.Join(_APIKeyStatusNames, ", "))
+
+var _APIKeyStatusNames = []string{
+	string(APIKeyStatusACTIVE),
+	string(APIKeyStatusEXPIRING),
+	string(APIKeyStatusEXPIRED),
+	string(APIKeyStatusREVOKED),
+}
+
+// APIKeyStatusNames returns a list of possible string values of APIKeyStatus.
+func APIKeyStatusNames() []string {
+	tmp := make([]string, len(_APIKeyStatusNames))
+	copy(tmp, _APIKeyStatusNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x APIKeyStatus) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x APIKeyStatus) IsValid() bool {
+	_, err := ParseAPIKeyStatus(string(x))
+	return err == nil
+}
+
+var _APIKeyStatusValue = map[string]APIKeyStatus{
+	"ACTIVE":   APIKeyStatusACTIVE,
+	"active":   APIKeyStatusACTIVE,
+	"EXPIRING": APIKeyStatusEXPIRING,
+	"expiring": APIKeyStatusEXPIRING,
+	"EXPIRED":  APIKeyStatusEXPIRED,
+	"expired":  APIKeyStatusEXPIRED,
+	"REVOKED":  APIKeyStatusREVOKED,
+	"revoked":  APIKeyStatusREVOKED,
+}
+
+// ParseAPIKeyStatus attempts to convert a string to a APIKeyStatus.
+func ParseAPIKeyStatus(name string) (APIKeyStatus, error) {
+	if x, ok := _APIKeyStatusValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _APIKeyStatusValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return APIKeyStatus(""), fmt.Errorf("%s is %w", name, ErrInvalidAPIKeyStatus)
+}
+
+// MarshalText implements the text marshaller method.
+func (x APIKeyStatus) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *APIKeyStatus) UnmarshalText(text []byte) error {
+	tmp, err := ParseAPIKeyStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *APIKeyStatus) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+const (
+	// ScopeCUSTOMERSREAD is a Scope of type CUSTOMERS_READ.
+	ScopeCUSTOMERSREAD Scope = "CUSTOMERS_READ"
+	// ScopeCUSTOMERSWRITE is a Scope of type CUSTOMERS_WRITE.
+	ScopeCUSTOMERSWRITE Scope = "CUSTOMERS_WRITE"
+	// ScopeTRANSACTIONSREAD is a Scope of type TRANSACTIONS_READ.
+	ScopeTRANSACTIONSREAD Scope = "TRANSACTIONS_READ"
+	// ScopeWITHDRAWALSWRITE is a Scope of type WITHDRAWALS_WRITE.
+	ScopeWITHDRAWALSWRITE Scope = "WITHDRAWALS_WRITE"
+	// ScopeCONVERSIONSWRITE is a Scope of type CONVERSIONS_WRITE.
+	ScopeCONVERSIONSWRITE Scope = "CONVERSIONS_WRITE"
+	// ScopeADMIN is a Scope of type ADMIN.
+	ScopeADMIN Scope = "ADMIN"
+)
+
+var ErrInvalidScope = fmt.Errorf("not a valid Scope, try [%s]", strings.Join(_ScopeNames, ", "))
+
+var _ScopeNames = []string{
+	string(ScopeCUSTOMERSREAD),
+	string(ScopeCUSTOMERSWRITE),
+	string(ScopeTRANSACTIONSREAD),
+	string(ScopeWITHDRAWALSWRITE),
+	string(ScopeCONVERSIONSWRITE),
+	string(ScopeADMIN),
+}
+
+// ScopeNames returns a list of possible string values of Scope.
+func ScopeNames() []string {
+	tmp := make([]string, len(_ScopeNames))
+	copy(tmp, _ScopeNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x Scope) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Scope) IsValid() bool {
+	_, err := ParseScope(string(x))
+	return err == nil
+}
+
+var _ScopeValue = map[string]Scope{
+	"CUSTOMERS_READ":    ScopeCUSTOMERSREAD,
+	"customers_read":    ScopeCUSTOMERSREAD,
+	"CUSTOMERS_WRITE":   ScopeCUSTOMERSWRITE,
+	"customers_write":   ScopeCUSTOMERSWRITE,
+	"TRANSACTIONS_READ": ScopeTRANSACTIONSREAD,
+	"transactions_read": ScopeTRANSACTIONSREAD,
+	"WITHDRAWALS_WRITE": ScopeWITHDRAWALSWRITE,
+	"withdrawals_write": ScopeWITHDRAWALSWRITE,
+	"CONVERSIONS_WRITE": ScopeCONVERSIONSWRITE,
+	"conversions_write": ScopeCONVERSIONSWRITE,
+	"ADMIN":             ScopeADMIN,
+	"admin":             ScopeADMIN,
+}
+
+// ParseScope attempts to convert a string to a Scope.
+func ParseScope(name string) (Scope, error) {
+	if x, ok := _ScopeValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _ScopeValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return Scope(""), fmt.Errorf("%s is %w", name, ErrInvalidScope)
+}
+
+// MarshalText implements the text marshaller method.
+func (x Scope) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *Scope) UnmarshalText(text []byte) error {
+	tmp, err := ParseScope(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *Scope) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
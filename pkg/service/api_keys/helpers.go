@@ -0,0 +1,95 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api_keys
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"time"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+)
+
+// ErrInvalidRequest is returned when an API key request fails validation.
+var ErrInvalidRequest = errors.New("invalid API key request")
+
+// Validate checks that the request has a name and at least one known scope, that the
+// expiry is a future RFC 3339 timestamp, and that allowed IPs are addresses or CIDR ranges.
+func (r *CreateAPIKeyRequest) Validate() error {
+	if r == nil {
+		return fmt.Errorf("%w: request is nil", ErrInvalidRequest)
+	}
+	if r.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidRequest)
+	}
+	if len(r.Scopes) == 0 {
+		return fmt.Errorf("%w: at least one scope is required", ErrInvalidRequest)
+	}
+	for _, scope := range r.Scopes {
+		if !scope.IsValid() {
+			return fmt.Errorf("%w: unknown scope %q", ErrInvalidRequest, scope)
+		}
+	}
+
+	if r.ExpiresAt != nil {
+		expiresAt, err := time.Parse(time.RFC3339, *r.ExpiresAt)
+		if err != nil {
+			return fmt.Errorf("%w: expires_at must be RFC 3339, got %q", ErrInvalidRequest, *r.ExpiresAt)
+		}
+		if !expiresAt.After(time.Now()) {
+			return fmt.Errorf("%w: expires_at %s is in the past", ErrInvalidRequest, *r.ExpiresAt)
+		}
+	}
+
+	for _, ip := range r.AllowedIPs {
+		if _, err := netip.ParseAddr(ip); err == nil {
+			continue
+		}
+		if _, _, err := net.ParseCIDR(ip); err != nil {
+			return fmt.Errorf("%w: allowed_ips entry %q is not an IP address or CIDR range", ErrInvalidRequest, ip)
+		}
+	}
+
+	return nil
+}
+
+// IsUsable reports whether the key can still authenticate requests.
+func (r *APIKeyResponse) IsUsable() bool {
+	return r.Status == APIKeyStatusACTIVE || r.Status == APIKeyStatusEXPIRING
+}
+
+// ExpiryTime parses ExpiresAt into a time.Time, returning the zero time if it is not set.
+func (r *APIKeyResponse) ExpiryTime() (time.Time, error) {
+	return svc.ParseOptionalTimestamp(r.ExpiresAt)
+}
+
+// LastUsedTime parses LastUsedAt into a time.Time, returning the zero time if it is not set.
+func (r *APIKeyResponse) LastUsedTime() (time.Time, error) {
+	return svc.ParseOptionalTimestamp(r.LastUsedAt)
+}
+
+// RevokedTime parses RevokedAt into a time.Time, returning the zero time if it is not set.
+func (r *APIKeyResponse) RevokedTime() (time.Time, error) {
+	return svc.ParseOptionalTimestamp(r.RevokedAt)
+}
+
+// CreatedTime parses CreatedAt into a time.Time.
+func (r *APIKeyResponse) CreatedTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.CreatedAt)
+}
@@ -0,0 +1,63 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api_keys
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCreateAPIKeyRequest_Validate(t *testing.T) {
+	future := time.Now().Add(24 * time.Hour).UTC().Format(time.RFC3339)
+	past := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	notRFC3339 := "2030-01-01"
+
+	valid := func() *CreateAPIKeyRequest {
+		return &CreateAPIKeyRequest{Name: "reporting", Scopes: []Scope{ScopeCUSTOMERSREAD}}
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(r *CreateAPIKeyRequest)
+		wantErr bool
+	}{
+		{"valid", func(*CreateAPIKeyRequest) {}, false},
+		{"missing name", func(r *CreateAPIKeyRequest) { r.Name = "" }, true},
+		{"missing scopes", func(r *CreateAPIKeyRequest) { r.Scopes = nil }, true},
+		{"unknown scope", func(r *CreateAPIKeyRequest) { r.Scopes = []Scope{"EVERYTHING"} }, true},
+		{"future expiry", func(r *CreateAPIKeyRequest) { r.ExpiresAt = &future }, false},
+		{"past expiry", func(r *CreateAPIKeyRequest) { r.ExpiresAt = &past }, true},
+		{"date-only expiry", func(r *CreateAPIKeyRequest) { r.ExpiresAt = &notRFC3339 }, true},
+		{"ip and cidr", func(r *CreateAPIKeyRequest) { r.AllowedIPs = []string{"203.0.113.7", "10.0.0.0/8", "2001:db8::/32"} }, false},
+		{"invalid ip", func(r *CreateAPIKeyRequest) { r.AllowedIPs = []string{"10.0.0.300"} }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := valid()
+			tt.modify(req)
+			err := req.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidRequest) {
+				t.Errorf("Validate() error = %v, want ErrInvalidRequest", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package api_keys provides API key management for the platform account.
+//
+// This package implements the API keys service client for the 1Money platform,
+// enabling platform admins to create, list, rotate and revoke machine credentials
+// with scoped permissions and optional expiry.
+//
+// # Basic Usage
+//
+//	import (
+//	    "context"
+//	    onemoney "github.com/1Money-Co/1money-go-sdk/pkg/onemoney"
+//	    "github.com/1Money-Co/1money-go-sdk/pkg/service/api_keys"
+//	)
+//
+//	// Create client
+//	client, err := onemoney.NewClient(&onemoney.Config{
+//	    AccessKey: "your-access-key",
+//	    SecretKey: "your-secret-key",
+//	})
+//
+//	// Create a read-only key; the secret is only returned once
+//	key, err := client.APIKeys.CreateAPIKey(ctx, &api_keys.CreateAPIKeyRequest{
+//	    IdempotencyKey: "unique-key",
+//	    Name:           "reporting",
+//	    Scopes:         []api_keys.Scope{api_keys.ScopeCUSTOMERSREAD, api_keys.ScopeTRANSACTIONSREAD},
+//	})
+//
+//	// Rotate it, keeping the old secret valid for an hour
+//	rotated, err := client.APIKeys.RotateAPIKey(ctx, key.KeyID, &api_keys.RotateAPIKeyRequest{
+//	    GracePeriodSeconds: 3600,
+//	})
+package api_keys
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+)
+
+// Service defines the API keys service interface for managing platform API keys.
+type Service interface {
+	// CreateAPIKey creates a new API key. The secret key is only returned in this response.
+	CreateAPIKey(ctx context.Context, req *CreateAPIKeyRequest) (*APIKeySecretResponse, error)
+	// ListAPIKeys retrieves API keys matching the filters. Secrets are never returned.
+	ListAPIKeys(ctx context.Context, req *ListAPIKeysRequest) ([]APIKeyResponse, error)
+	// RotateAPIKey issues a new secret for an API key. The previous secret stays valid
+	// for the requested grace period, during which the old key is EXPIRING.
+	RotateAPIKey(ctx context.Context, keyID string, req *RotateAPIKeyRequest) (*APIKeySecretResponse, error)
+	// RevokeAPIKey revokes an API key immediately. Revocation cannot be undone.
+	RevokeAPIKey(ctx context.Context, keyID string) (*APIKeyResponse, error)
+}
+
+// API key request and response types.
+type (
+	// CreateAPIKeyRequest represents the request body for creating an API key.
+	CreateAPIKeyRequest struct {
+		// IdempotencyKey is a unique key to ensure idempotent creation.
+		// This is sent as a header, not in the body.
+		IdempotencyKey string `json:"-"`
+		// Name is a label identifying the key, e.g. "reporting".
+		Name string `json:"name"`
+		// Scopes are the permissions granted to the key.
+		Scopes []Scope `json:"scopes"`
+		// ExpiresAt is when the key stops working (ISO 8601 format, optional).
+		ExpiresAt *string `json:"expires_at,omitempty"`
+		// AllowedIPs restricts the key to these IP addresses or CIDR ranges (optional).
+		AllowedIPs []string `json:"allowed_ips,omitempty"`
+	}
+
+	// ListAPIKeysRequest represents optional query parameters for listing API keys.
+	ListAPIKeysRequest struct {
+		// Status filters by key status.
+		Status APIKeyStatus `json:"status,omitempty"`
+		// Page is the page number (starts from 1).
+		Page int `json:"page,omitempty"`
+		// Size is the number of items per page (1-100).
+		Size int `json:"size,omitempty"`
+	}
+
+	// RotateAPIKeyRequest represents the request body for rotating an API key.
+	RotateAPIKeyRequest struct {
+		// GracePeriodSeconds is how long the previous secret remains valid. Zero revokes it immediately.
+		GracePeriodSeconds int `json:"grace_period_seconds"`
+	}
+
+	// APIKeyResponse represents an API key without its secret.
+	APIKeyResponse struct {
+		// KeyID is the unique identifier of the key.
+		KeyID string `json:"key_id"`
+		// Name is the label identifying the key.
+		Name string `json:"name"`
+		// AccessKey is the public access key used to sign requests.
+		AccessKey string `json:"access_key"`
+		// Scopes are the permissions granted to the key.
+		Scopes []Scope `json:"scopes"`
+		// Status is the current status of the key.
+		Status APIKeyStatus `json:"status"`
+		// AllowedIPs are the IP addresses or CIDR ranges the key is restricted to (optional).
+		AllowedIPs []string `json:"allowed_ips,omitempty"`
+		// ExpiresAt is when the key stops working (ISO 8601 format, optional).
+		ExpiresAt *string `json:"expires_at,omitempty"`
+		// LastUsedAt is when the key last authenticated a request (ISO 8601 format, optional).
+		LastUsedAt *string `json:"last_used_at,omitempty"`
+		// RevokedAt is when the key was revoked (ISO 8601 format, optional).
+		RevokedAt *string `json:"revoked_at,omitempty"`
+		// CreatedAt is the key creation timestamp (ISO 8601 format).
+		CreatedAt string `json:"created_at"`
+	}
+
+	// APIKeySecretResponse represents a newly created or rotated API key, including its secret.
+	APIKeySecretResponse struct {
+		APIKeyResponse
+		// SecretKey is the secret used to sign requests. It cannot be retrieved again.
+		SecretKey string `json:"secret_key"`
+	}
+)
+
+type serviceImpl struct {
+	*svc.BaseService
+}
+
+// NewService creates a new API keys service instance with the given base service.
+func NewService(base *svc.BaseService) Service {
+	return &serviceImpl{
+		BaseService: base,
+	}
+}
+
+// CreateAPIKey creates a new API key.
+func (s *serviceImpl) CreateAPIKey(ctx context.Context, req *CreateAPIKeyRequest) (*APIKeySecretResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	headers := make(map[string]string)
+	if req.IdempotencyKey != "" {
+		headers["Idempotency-Key"] = req.IdempotencyKey
+	}
+
+	return svc.PostJSONWithHeaders[*CreateAPIKeyRequest, APIKeySecretResponse](
+		ctx, s.BaseService, "/v1/api-keys", req, headers,
+	)
+}
+
+// ListAPIKeys retrieves API keys matching the filters.
+func (s *serviceImpl) ListAPIKeys(ctx context.Context, req *ListAPIKeysRequest) ([]APIKeyResponse, error) {
+	params := make(map[string]string)
+	if req != nil {
+		if req.Status != "" {
+			params["status"] = string(req.Status)
+		}
+		if req.Page > 0 {
+			params["page"] = strconv.Itoa(req.Page)
+		}
+		if req.Size > 0 {
+			params["size"] = strconv.Itoa(req.Size)
+		}
+	}
+
+	result, err := svc.GetJSONWithParams[[]APIKeyResponse](ctx, s.BaseService, "/v1/api-keys/list", params)
+	if err != nil {
+		return nil, err
+	}
+	return *result, nil
+}
+
+// RotateAPIKey issues a new secret for an API key.
+func (s *serviceImpl) RotateAPIKey(
+	ctx context.Context,
+	keyID string,
+	req *RotateAPIKeyRequest,
+) (*APIKeySecretResponse, error) {
+	if req == nil {
+		req = &RotateAPIKeyRequest{}
+	}
+	if req.GracePeriodSeconds < 0 {
+		return nil, fmt.Errorf("%w: grace_period_seconds must not be negative", ErrInvalidRequest)
+	}
+
+	path := fmt.Sprintf("/v1/api-keys/%s/rotate", keyID)
+	return svc.PostJSON[*RotateAPIKeyRequest, APIKeySecretResponse](ctx, s.BaseService, path, req)
+}
+
+// RevokeAPIKey revokes an API key immediately.
+func (s *serviceImpl) RevokeAPIKey(ctx context.Context, keyID string) (*APIKeyResponse, error) {
+	path := fmt.Sprintf("/v1/api-keys/%s/revoke", keyID)
+	return svc.PostJSON[any, APIKeyResponse](ctx, s.BaseService, path, nil)
+}
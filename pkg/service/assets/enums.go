@@ -16,28 +16,5 @@
 
 package assets
 
-//go:generate go tool go-enum -f=$GOFILE --marshal --names --nocase
-
-// AssetName represents the supported asset types for filtering.
-// ENUM(USD, USDC, USDT, PYUSD, RLUSD, USDG, USDP, EURC, MXNB)
-type AssetName string
-
-// NetworkName represents the supported network types.
-/* ENUM(
-US_ACH
-SWIFT
-US_FEDWIRE
-ARBITRUM
-AVALANCHE
-BASE
-BNBCHAIN
-ETHEREUM
-POLYGON
-SOLANA
-)
-*/
-type NetworkName string
-
-// SortOrder represents the sort order for results.
-// ENUM(ASC, DESC)
-type SortOrder string
+// The enums of this package are declared in enums.yaml and generated into enums_enum.go.
+//go:generate go run ../../../cmd/tools/svcgen enums enums.yaml
@@ -1,33 +1,50 @@
-// Code generated by go-enum DO NOT EDIT.
-// Version: v0.9.2
-
-// Built By: go install
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by svcgen enums from enums.yaml. DO NOT EDIT.
 
 package assets
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 )
 
+// AssetName represents the supported asset types for filtering.
+type AssetName string
+
 const (
-	// AssetNameUSD is a AssetName of type USD.
+	// AssetNameUSD is an AssetName of type USD.
 	AssetNameUSD AssetName = "USD"
-	// AssetNameUSDC is a AssetName of type USDC.
+	// AssetNameUSDC is an AssetName of type USDC.
 	AssetNameUSDC AssetName = "USDC"
-	// AssetNameUSDT is a AssetName of type USDT.
+	// AssetNameUSDT is an AssetName of type USDT.
 	AssetNameUSDT AssetName = "USDT"
-	// AssetNamePYUSD is a AssetName of type PYUSD.
+	// AssetNamePYUSD is an AssetName of type PYUSD.
 	AssetNamePYUSD AssetName = "PYUSD"
-	// AssetNameRLUSD is a AssetName of type RLUSD.
+	// AssetNameRLUSD is an AssetName of type RLUSD.
 	AssetNameRLUSD AssetName = "RLUSD"
-	// AssetNameUSDG is a AssetName of type USDG.
+	// AssetNameUSDG is an AssetName of type USDG.
 	AssetNameUSDG AssetName = "USDG"
-	// AssetNameUSDP is a AssetName of type USDP.
+	// AssetNameUSDP is an AssetName of type USDP.
 	AssetNameUSDP AssetName = "USDP"
-	// AssetNameEURC is a AssetName of type EURC.
+	// AssetNameEURC is an AssetName of type EURC.
 	AssetNameEURC AssetName = "EURC"
-	// AssetNameMXNB is a AssetName of type MXNB.
+	// AssetNameMXNB is an AssetName of type MXNB.
 	AssetNameMXNB AssetName = "MXNB"
 )
 
@@ -57,8 +74,7 @@ func (x AssetName) String() string {
 	return string(x)
 }
 
-// IsValid provides a quick way to determine if the typed value is
-// part of the allowed enumerated values
+// IsValid reports whether x is one of the enumerated values, ignoring case.
 func (x AssetName) IsValid() bool {
 	_, err := ParseAssetName(string(x))
 	return err == nil
@@ -85,24 +101,23 @@ var _AssetNameValue = map[string]AssetName{
 	"mxnb":  AssetNameMXNB,
 }
 
-// ParseAssetName attempts to convert a string to a AssetName.
+// ParseAssetName converts a string to a AssetName, ignoring case.
 func ParseAssetName(name string) (AssetName, error) {
 	if x, ok := _AssetNameValue[name]; ok {
 		return x, nil
 	}
-	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
 	if x, ok := _AssetNameValue[strings.ToLower(name)]; ok {
 		return x, nil
 	}
 	return AssetName(""), fmt.Errorf("%s is %w", name, ErrInvalidAssetName)
 }
 
-// MarshalText implements the text marshaller method.
+// MarshalText implements encoding.TextMarshaler.
 func (x AssetName) MarshalText() ([]byte, error) {
 	return []byte(string(x)), nil
 }
 
-// UnmarshalText implements the text unmarshaller method.
+// UnmarshalText implements encoding.TextUnmarshaler. It rejects unknown values.
 func (x *AssetName) UnmarshalText(text []byte) error {
 	tmp, err := ParseAssetName(string(text))
 	if err != nil {
@@ -112,14 +127,27 @@ func (x *AssetName) UnmarshalText(text []byte) error {
 	return nil
 }
 
-// AppendText appends the textual representation of itself to the end of b
-// (allocating a larger slice if necessary) and returns the updated slice.
-//
-// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+// AppendText implements encoding.TextAppender.
 func (x *AssetName) AppendText(b []byte) ([]byte, error) {
 	return append(b, x.String()...), nil
 }
 
+// UnmarshalJSON implements json.Unmarshaler. It accepts only a JSON string holding one
+// of the enumerated values; null leaves x unchanged.
+func (x *AssetName) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("%s is %w", data, ErrInvalidAssetName)
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// NetworkName represents the supported network types.
+type NetworkName string
+
 const (
 	// NetworkNameUSACH is a NetworkName of type US_ACH.
 	NetworkNameUSACH NetworkName = "US_ACH"
@@ -170,8 +198,7 @@ func (x NetworkName) String() string {
 	return string(x)
 }
 
-// IsValid provides a quick way to determine if the typed value is
-// part of the allowed enumerated values
+// IsValid reports whether x is one of the enumerated values, ignoring case.
 func (x NetworkName) IsValid() bool {
 	_, err := ParseNetworkName(string(x))
 	return err == nil
@@ -200,24 +227,23 @@ var _NetworkNameValue = map[string]NetworkName{
 	"solana":     NetworkNameSOLANA,
 }
 
-// ParseNetworkName attempts to convert a string to a NetworkName.
+// ParseNetworkName converts a string to a NetworkName, ignoring case.
 func ParseNetworkName(name string) (NetworkName, error) {
 	if x, ok := _NetworkNameValue[name]; ok {
 		return x, nil
 	}
-	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
 	if x, ok := _NetworkNameValue[strings.ToLower(name)]; ok {
 		return x, nil
 	}
 	return NetworkName(""), fmt.Errorf("%s is %w", name, ErrInvalidNetworkName)
 }
 
-// MarshalText implements the text marshaller method.
+// MarshalText implements encoding.TextMarshaler.
 func (x NetworkName) MarshalText() ([]byte, error) {
 	return []byte(string(x)), nil
 }
 
-// UnmarshalText implements the text unmarshaller method.
+// UnmarshalText implements encoding.TextUnmarshaler. It rejects unknown values.
 func (x *NetworkName) UnmarshalText(text []byte) error {
 	tmp, err := ParseNetworkName(string(text))
 	if err != nil {
@@ -227,14 +253,27 @@ func (x *NetworkName) UnmarshalText(text []byte) error {
 	return nil
 }
 
-// AppendText appends the textual representation of itself to the end of b
-// (allocating a larger slice if necessary) and returns the updated slice.
-//
-// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+// AppendText implements encoding.TextAppender.
 func (x *NetworkName) AppendText(b []byte) ([]byte, error) {
 	return append(b, x.String()...), nil
 }
 
+// UnmarshalJSON implements json.Unmarshaler. It accepts only a JSON string holding one
+// of the enumerated values; null leaves x unchanged.
+func (x *NetworkName) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("%s is %w", data, ErrInvalidNetworkName)
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// SortOrder represents the sort order for results.
+type SortOrder string
+
 const (
 	// SortOrderASC is a SortOrder of type ASC.
 	SortOrderASC SortOrder = "ASC"
@@ -261,8 +300,7 @@ func (x SortOrder) String() string {
 	return string(x)
 }
 
-// IsValid provides a quick way to determine if the typed value is
-// part of the allowed enumerated values
+// IsValid reports whether x is one of the enumerated values, ignoring case.
 func (x SortOrder) IsValid() bool {
 	_, err := ParseSortOrder(string(x))
 	return err == nil
@@ -275,24 +313,23 @@ var _SortOrderValue = map[string]SortOrder{
 	"desc": SortOrderDESC,
 }
 
-// ParseSortOrder attempts to convert a string to a SortOrder.
+// ParseSortOrder converts a string to a SortOrder, ignoring case.
 func ParseSortOrder(name string) (SortOrder, error) {
 	if x, ok := _SortOrderValue[name]; ok {
 		return x, nil
 	}
-	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
 	if x, ok := _SortOrderValue[strings.ToLower(name)]; ok {
 		return x, nil
 	}
 	return SortOrder(""), fmt.Errorf("%s is %w", name, ErrInvalidSortOrder)
 }
 
-// MarshalText implements the text marshaller method.
+// MarshalText implements encoding.TextMarshaler.
 func (x SortOrder) MarshalText() ([]byte, error) {
 	return []byte(string(x)), nil
 }
 
-// UnmarshalText implements the text unmarshaller method.
+// UnmarshalText implements encoding.TextUnmarshaler. It rejects unknown values.
 func (x *SortOrder) UnmarshalText(text []byte) error {
 	tmp, err := ParseSortOrder(string(text))
 	if err != nil {
@@ -302,10 +339,20 @@ func (x *SortOrder) UnmarshalText(text []byte) error {
 	return nil
 }
 
-// AppendText appends the textual representation of itself to the end of b
-// (allocating a larger slice if necessary) and returns the updated slice.
-//
-// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+// AppendText implements encoding.TextAppender.
 func (x *SortOrder) AppendText(b []byte) ([]byte, error) {
 	return append(b, x.String()...), nil
 }
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts only a JSON string holding one
+// of the enumerated values; null leaves x unchanged.
+func (x *SortOrder) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("%s is %w", data, ErrInvalidSortOrder)
+	}
+	return x.UnmarshalText([]byte(s))
+}
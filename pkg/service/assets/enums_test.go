@@ -0,0 +1,40 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assets
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestNetworkNameUnmarshalJSON(t *testing.T) {
+	var got struct {
+		Network NetworkName `json:"network"`
+	}
+	if err := json.Unmarshal([]byte(`{"network":"us_ach"}`), &got); err != nil || got.Network != NetworkNameUSACH {
+		t.Errorf("Unmarshal(us_ach) = %q, %v, want %q", got.Network, err, NetworkNameUSACH)
+	}
+	if err := json.Unmarshal([]byte(`{"network":null}`), &got); err != nil || got.Network != NetworkNameUSACH {
+		t.Errorf("Unmarshal(null) = %q, %v, want the value unchanged", got.Network, err)
+	}
+	for _, input := range []string{`{"network":"LIGHTNING"}`, `{"network":1}`, `{"network":""}`} {
+		if err := json.Unmarshal([]byte(input), &got); !errors.Is(err, ErrInvalidNetworkName) {
+			t.Errorf("Unmarshal(%s) error = %v, want ErrInvalidNetworkName", input, err)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package assets
+
+import (
+	"time"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+)
+
+// CreatedTime parses CreatedAt into a time.Time.
+func (r *AssetResponse) CreatedTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.CreatedAt)
+}
+
+// ModifiedTime parses ModifiedAt into a time.Time.
+func (r *AssetResponse) ModifiedTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.ModifiedAt)
+}
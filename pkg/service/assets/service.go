@@ -72,7 +72,7 @@ type (
 	// AssetResponse represents a customer's asset balance.
 	AssetResponse struct {
 		// CustomerID is the unique identifier of the customer.
-		CustomerID string `json:"customer_id"`
+		CustomerID svc.CustomerID `json:"customer_id"`
 		// Asset is the asset name/symbol (e.g., "USD", "USDT").
 		// Uses string to handle any asset type returned by the API.
 		Asset string `json:"asset"`
@@ -0,0 +1,86 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: v0.9.2
+
+// Built By: go install
+
+package audit_logs
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// ResultSUCCESS is a Result of type SUCCESS.
+	ResultSUCCESS Result = "SUCCESS"
+	// ResultFAILURE is a Result of type FAILURE.
+	ResultFAILURE Result = "FAILURE"
+)
+
+var ErrInvalidResult = fmt.Errorf("not a valid Result, try [%s]", strings.Join(_ResultNames, ", "))
+
+var _ResultNames = []string{
+	string(ResultSUCCESS),
+	string(ResultFAILURE),
+}
+
+// ResultNames returns a list of possible string values of Result.
+func ResultNames() []string {
+	tmp := make([]string, len(_ResultNames))
+	copy(tmp, _ResultNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x Result) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Result) IsValid() bool {
+	_, err := ParseResult(string(x))
+	return err == nil
+}
+
+var _ResultValue = map[string]Result{
+	"SUCCESS": ResultSUCCESS,
+	"success": ResultSUCCESS,
+	"FAILURE": ResultFAILURE,
+	"failure": ResultFAILURE,
+}
+
+// ParseResult attempts to convert a string to a Result.
+func ParseResult(name string) (Result, error) {
+	if x, ok := _ResultValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _ResultValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return Result(""), fmt.Errorf("%s is %w", name, ErrInvalidResult)
+}
+
+// MarshalText implements the text marshaller method.
+func (x Result) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *Result) UnmarshalText(text []byte) error {
+	tmp, err := ParseResult(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *Result) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
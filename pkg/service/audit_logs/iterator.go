@@ -0,0 +1,71 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package audit_logs
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// listPageSize is the page size used when iterating over audit log entries.
+const listPageSize = 100
+
+// All iterates over every audit log entry matching the filter.
+// If the filter has no EndTime, it is pinned to the time iteration starts so that entries
+// recorded meanwhile do not shift the newest-first pages and repeat entries.
+func (s *serviceImpl) All(ctx context.Context, filter *ListRequest) iter.Seq2[*Entry, error] {
+	return allEntries(ctx, s, filter)
+}
+
+func allEntries(ctx context.Context, service Service, filter *ListRequest) iter.Seq2[*Entry, error] {
+	return func(yield func(*Entry, error) bool) {
+		req := ListRequest{}
+		if filter != nil {
+			req = *filter
+		}
+		req.Size = listPageSize
+		if req.EndTime.IsZero() {
+			req.EndTime = time.Now()
+		}
+
+		for page := 1; ; page++ {
+			if err := ctx.Err(); err != nil {
+				yield(nil, err)
+				return
+			}
+
+			req.Page = page
+			resp, err := service.List(ctx, &req)
+			if err != nil {
+				yield(nil, fmt.Errorf("failed to list audit logs (page %d): %w", page, err))
+				return
+			}
+
+			for i := range resp.List {
+				if !yield(&resp.List[i], nil) {
+					return
+				}
+			}
+
+			if len(resp.List) < listPageSize {
+				return
+			}
+		}
+	}
+}
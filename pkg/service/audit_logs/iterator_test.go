@@ -0,0 +1,97 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package audit_logs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// fakeListService serves a fixed set of audit log entries page by page.
+type fakeListService struct {
+	Service
+	entries  []Entry
+	requests []ListRequest
+	failPage int
+}
+
+func (f *fakeListService) List(_ context.Context, req *ListRequest) (*ListResponse, error) {
+	f.requests = append(f.requests, *req)
+	if req.Page == f.failPage {
+		return nil, errors.New("unavailable")
+	}
+	start := min((req.Page-1)*req.Size, len(f.entries))
+	end := min(start+req.Size, len(f.entries))
+	return &ListResponse{List: f.entries[start:end], Total: len(f.entries)}, nil
+}
+
+func TestAllEntries(t *testing.T) {
+	entries := make([]Entry, 250)
+	for i := range entries {
+		entries[i] = Entry{EventID: fmt.Sprintf("ev-%03d", i)}
+	}
+	service := &fakeListService{entries: entries}
+	filter := &ListRequest{KeyID: "key-1", Page: 4, Size: 10}
+
+	var ids []string
+	for entry, err := range allEntries(context.Background(), service, filter) {
+		if err != nil {
+			t.Fatalf("allEntries() error = %v", err)
+		}
+		ids = append(ids, entry.EventID)
+	}
+
+	if len(ids) != len(entries) || ids[0] != "ev-000" || ids[len(ids)-1] != "ev-249" {
+		t.Fatalf("got %d entries (first %v), want %d", len(ids), ids[:1], len(entries))
+	}
+	for i, req := range service.requests {
+		if req.Page != i+1 || req.Size != listPageSize || req.KeyID != "key-1" {
+			t.Errorf("request %d = %+v", i, req)
+		}
+		if req.EndTime.IsZero() || !req.EndTime.Equal(service.requests[0].EndTime) {
+			t.Errorf("request %d EndTime = %v, want pinned to %v", i, req.EndTime, service.requests[0].EndTime)
+		}
+	}
+
+	end := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	bounded := &fakeListService{entries: entries[:5]}
+	for _, err := range allEntries(context.Background(), bounded, &ListRequest{EndTime: end}) {
+		if err != nil {
+			t.Fatalf("allEntries() error = %v", err)
+		}
+	}
+	if got := bounded.requests[0].EndTime; !got.Equal(end) {
+		t.Errorf("EndTime = %v, want %v", got, end)
+	}
+
+	failing := &fakeListService{entries: entries, failPage: 2}
+	var count int
+	var gotErr error
+	for _, err := range allEntries(context.Background(), failing, nil) {
+		if err != nil {
+			gotErr = err
+			break
+		}
+		count++
+	}
+	if gotErr == nil || count != listPageSize {
+		t.Errorf("got %d entries and error %v, want %d entries then an error", count, gotErr, listPageSize)
+	}
+}
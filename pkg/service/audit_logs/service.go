@@ -0,0 +1,165 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package audit_logs provides access to the API activity audit log of the platform account.
+//
+// This package implements the audit logs service client for the 1Money platform,
+// recording who (API key), what (method and path), when and with which result each
+// API request was made, e.g. for SOC 2 evidence collection.
+//
+// # Basic Usage
+//
+//	import (
+//	    "context"
+//	    onemoney "github.com/1Money-Co/1money-go-sdk/pkg/onemoney"
+//	    "github.com/1Money-Co/1money-go-sdk/pkg/service/audit_logs"
+//	)
+//
+//	// Create client
+//	client, err := onemoney.NewClient(&onemoney.Config{
+//	    AccessKey: "your-access-key",
+//	    SecretKey: "your-secret-key",
+//	})
+//
+//	// List failed requests made by one key in the last day
+//	logs, err := client.AuditLogs.List(ctx, &audit_logs.ListRequest{
+//	    KeyID:     "key-id",
+//	    Result:    audit_logs.ResultFAILURE,
+//	    StartTime: time.Now().Add(-24 * time.Hour),
+//	})
+//
+//	// Iterate over every entry in a window
+//	for entry, err := range client.AuditLogs.All(ctx, &audit_logs.ListRequest{StartTime: start, EndTime: end}) {
+//	    ...
+//	}
+package audit_logs
+
+import (
+	"context"
+	"iter"
+	"strconv"
+	"time"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+)
+
+// Service defines the audit logs service interface for retrieving API activity.
+type Service interface {
+	// List retrieves audit log entries matching the filter, newest first.
+	List(ctx context.Context, filter *ListRequest) (*ListResponse, error)
+	// All iterates over every audit log entry matching the filter, paginating automatically.
+	// Page and Size in the filter are ignored.
+	All(ctx context.Context, filter *ListRequest) iter.Seq2[*Entry, error]
+}
+
+// Audit log request and response types.
+type (
+	// ListRequest represents optional query parameters for listing audit log entries.
+	ListRequest struct {
+		// KeyID filters by the API key that made the request.
+		KeyID string `json:"key_id,omitempty"`
+		// Method filters by HTTP method (e.g., "POST").
+		Method string `json:"method,omitempty"`
+		// PathPrefix filters by request path prefix (e.g., "/v1/customers").
+		PathPrefix string `json:"path_prefix,omitempty"`
+		// Result filters by request outcome.
+		Result Result `json:"result,omitempty"`
+		// StartTime filters entries recorded at or after this time.
+		StartTime time.Time `json:"-"`
+		// EndTime filters entries recorded before this time.
+		EndTime time.Time `json:"-"`
+		// Page is the page number (starts from 1).
+		Page int `json:"page,omitempty"`
+		// Size is the number of items per page (1-100).
+		Size int `json:"size,omitempty"`
+	}
+
+	// Entry represents a single audited API request.
+	Entry struct {
+		// EventID is the unique identifier of the entry.
+		EventID string `json:"event_id"`
+		// KeyID is the API key that made the request.
+		KeyID string `json:"key_id"`
+		// Method is the HTTP method of the request.
+		Method string `json:"method"`
+		// Path is the request path.
+		Path string `json:"path"`
+		// StatusCode is the HTTP status code returned.
+		StatusCode int `json:"status_code"`
+		// Result is the outcome of the request.
+		Result Result `json:"result"`
+		// SourceIP is the client IP address.
+		SourceIP string `json:"source_ip"`
+		// UserAgent is the client user agent (optional).
+		UserAgent string `json:"user_agent,omitempty"`
+		// RequestID is the server-assigned request ID, for correlating with support.
+		RequestID string `json:"request_id"`
+		// Timestamp is when the request was received (ISO 8601 format).
+		Timestamp string `json:"timestamp"`
+	}
+
+	// ListResponse represents a page of audit log entries.
+	ListResponse struct {
+		// List contains the audit log entries.
+		List []Entry `json:"list"`
+		// Total is the total number of matching entries.
+		Total int `json:"total,omitempty"`
+	}
+)
+
+type serviceImpl struct {
+	*svc.BaseService
+}
+
+// NewService creates a new audit logs service instance with the given base service.
+func NewService(base *svc.BaseService) Service {
+	return &serviceImpl{
+		BaseService: base,
+	}
+}
+
+// List retrieves audit log entries matching the filter.
+func (s *serviceImpl) List(ctx context.Context, filter *ListRequest) (*ListResponse, error) {
+	params := make(map[string]string)
+	if filter != nil {
+		if filter.KeyID != "" {
+			params["key_id"] = filter.KeyID
+		}
+		if filter.Method != "" {
+			params["method"] = filter.Method
+		}
+		if filter.PathPrefix != "" {
+			params["path_prefix"] = filter.PathPrefix
+		}
+		if filter.Result != "" {
+			params["result"] = string(filter.Result)
+		}
+		if !filter.StartTime.IsZero() {
+			params["start_time"] = filter.StartTime.UTC().Format(time.RFC3339)
+		}
+		if !filter.EndTime.IsZero() {
+			params["end_time"] = filter.EndTime.UTC().Format(time.RFC3339)
+		}
+		if filter.Page > 0 {
+			params["page"] = strconv.Itoa(filter.Page)
+		}
+		if filter.Size > 0 {
+			params["size"] = strconv.Itoa(filter.Size)
+		}
+	}
+
+	return svc.GetJSONWithParams[ListResponse](ctx, s.BaseService, "/v1/audit-logs", params)
+}
@@ -19,9 +19,15 @@ package auto_conversion_rules
 //go:generate go tool go-enum -f=$GOFILE --marshal --names --nocase
 
 // RuleStatus represents the status of an auto-conversion rule.
-// ENUM(PENDING, ACTIVE, INACTIVE)
+// PAUSED rules keep their deposit instructions but do not convert new deposits.
+// ENUM(PENDING, ACTIVE, PAUSED, INACTIVE)
 type RuleStatus string
 
 // DepositInfoStatus represents the status of source deposit info availability.
 // ENUM(PENDING, ACTIVE, INACTIVE)
 type DepositInfoStatus string
+
+// OrderStatus represents the normalized status of an auto conversion order.
+// The API reports statuses such as "Deposit Completed"; use OrderResponse.OrderStatus to normalize them.
+// ENUM(INIT, DEPOSIT_COMPLETED, CONVERSION_COMPLETED, COMPLETED, DEPOSIT_FAILED, CONVERSION_FAILED, WITHDRAWAL_FAILED)
+type OrderStatus string
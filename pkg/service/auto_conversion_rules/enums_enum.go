@@ -90,11 +90,113 @@ func (x *DepositInfoStatus) AppendText(b []byte) ([]byte, error) {
 	return append(b, x.String()...), nil
 }
 
+const (
+	// OrderStatusINIT is a OrderStatus of type INIT.
+	OrderStatusINIT OrderStatus = "INIT"
+	// OrderStatusDEPOSITCOMPLETED is a OrderStatus of type DEPOSIT_COMPLETED.
+	OrderStatusDEPOSITCOMPLETED OrderStatus = "DEPOSIT_COMPLETED"
+	// OrderStatusCONVERSIONCOMPLETED is a OrderStatus of type CONVERSION_COMPLETED.
+	OrderStatusCONVERSIONCOMPLETED OrderStatus = "CONVERSION_COMPLETED"
+	// OrderStatusCOMPLETED is a OrderStatus of type COMPLETED.
+	OrderStatusCOMPLETED OrderStatus = "COMPLETED"
+	// OrderStatusDEPOSITFAILED is a OrderStatus of type DEPOSIT_FAILED.
+	OrderStatusDEPOSITFAILED OrderStatus = "DEPOSIT_FAILED"
+	// OrderStatusCONVERSIONFAILED is a OrderStatus of type CONVERSION_FAILED.
+	OrderStatusCONVERSIONFAILED OrderStatus = "CONVERSION_FAILED"
+	// OrderStatusWITHDRAWALFAILED is a OrderStatus of type WITHDRAWAL_FAILED.
+	OrderStatusWITHDRAWALFAILED OrderStatus = "WITHDRAWAL_FAILED"
+)
+
+var ErrInvalidOrderStatus = fmt.Errorf("not a valid OrderStatus, try [%s]", strings.Join(_OrderStatusNames, ", "))
+
+var _OrderStatusNames = []string{
+	string(OrderStatusINIT),
+	string(OrderStatusDEPOSITCOMPLETED),
+	string(OrderStatusCONVERSIONCOMPLETED),
+	string(OrderStatusCOMPLETED),
+	string(OrderStatusDEPOSITFAILED),
+	string(OrderStatusCONVERSIONFAILED),
+	string(OrderStatusWITHDRAWALFAILED),
+}
+
+// OrderStatusNames returns a list of possible string values of OrderStatus.
+func OrderStatusNames() []string {
+	tmp := make([]string, len(_OrderStatusNames))
+	copy(tmp, _OrderStatusNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x OrderStatus) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x OrderStatus) IsValid() bool {
+	_, err := ParseOrderStatus(string(x))
+	return err == nil
+}
+
+var _OrderStatusValue = map[string]OrderStatus{
+	"INIT":                 OrderStatusINIT,
+	"init":                 OrderStatusINIT,
+	"DEPOSIT_COMPLETED":    OrderStatusDEPOSITCOMPLETED,
+	"deposit_completed":    OrderStatusDEPOSITCOMPLETED,
+	"CONVERSION_COMPLETED": OrderStatusCONVERSIONCOMPLETED,
+	"conversion_completed": OrderStatusCONVERSIONCOMPLETED,
+	"COMPLETED":            OrderStatusCOMPLETED,
+	"completed":            OrderStatusCOMPLETED,
+	"DEPOSIT_FAILED":       OrderStatusDEPOSITFAILED,
+	"deposit_failed":       OrderStatusDEPOSITFAILED,
+	"CONVERSION_FAILED":    OrderStatusCONVERSIONFAILED,
+	"conversion_failed":    OrderStatusCONVERSIONFAILED,
+	"WITHDRAWAL_FAILED":    OrderStatusWITHDRAWALFAILED,
+	"withdrawal_failed":    OrderStatusWITHDRAWALFAILED,
+}
+
+// ParseOrderStatus attempts to convert a string to a OrderStatus.
+func ParseOrderStatus(name string) (OrderStatus, error) {
+	if x, ok := _OrderStatusValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _OrderStatusValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return OrderStatus(""), fmt.Errorf("%s is %w", name, ErrInvalidOrderStatus)
+}
+
+// MarshalText implements the text marshaller method.
+func (x OrderStatus) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *OrderStatus) UnmarshalText(text []byte) error {
+	tmp, err := ParseOrderStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *OrderStatus) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
 const (
 	// RuleStatusPENDING is a RuleStatus of type PENDING.
 	RuleStatusPENDING RuleStatus = "PENDING"
 	// RuleStatusACTIVE is a RuleStatus of type ACTIVE.
 	RuleStatusACTIVE RuleStatus = "ACTIVE"
+	// RuleStatusPAUSED is a RuleStatus of type PAUSED.
+	RuleStatusPAUSED RuleStatus = "PAUSED"
 	// RuleStatusINACTIVE is a RuleStatus of type INACTIVE.
 	RuleStatusINACTIVE RuleStatus = "INACTIVE"
 )
@@ -104,6 +206,7 @@ var ErrInvalidRuleStatus = fmt.Errorf("not a valid RuleStatus, try [%s]", string
 var _RuleStatusNames = []string{
 	string(RuleStatusPENDING),
 	string(RuleStatusACTIVE),
+	string(RuleStatusPAUSED),
 	string(RuleStatusINACTIVE),
 }
 
@@ -131,6 +234,8 @@ var _RuleStatusValue = map[string]RuleStatus{
 	"pending":  RuleStatusPENDING,
 	"ACTIVE":   RuleStatusACTIVE,
 	"active":   RuleStatusACTIVE,
+	"PAUSED":   RuleStatusPAUSED,
+	"paused":   RuleStatusPAUSED,
 	"INACTIVE": RuleStatusINACTIVE,
 	"inactive": RuleStatusINACTIVE,
 }
@@ -18,9 +18,14 @@ package auto_conversion_rules
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"time"
+
+	"github.com/1Money-Co/1money-go-sdk/internal/transport"
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
 )
 
 // WaitOptions configures the polling behavior for wait functions.
@@ -48,7 +53,7 @@ type RuleCondition func(*RuleResponse) bool
 // WaitFor polls until the condition returns true.
 // Returns the rule response when condition is met, or an error on timeout/failure.
 func WaitFor(
-	ctx context.Context, svc Service, customerID, ruleID string,
+	ctx context.Context, svc Service, customerID svc.CustomerID, ruleID string,
 	condition RuleCondition, opts *WaitOptions,
 ) (*RuleResponse, error) {
 	if opts == nil {
@@ -86,15 +91,168 @@ func WaitFor(
 }
 
 // WaitForActive polls until the rule's Status becomes ACTIVE.
-func WaitForActive(ctx context.Context, svc Service, customerID, ruleID string, opts *WaitOptions) (*RuleResponse, error) {
+func WaitForActive(ctx context.Context, svc Service, customerID svc.CustomerID, ruleID string, opts *WaitOptions) (*RuleResponse, error) {
 	return WaitFor(ctx, svc, customerID, ruleID, func(r *RuleResponse) bool {
 		return r.Status == RuleStatusACTIVE
 	}, opts)
 }
 
 // WaitForDepositInfoReady polls until the rule's DepositInfoStatus is no longer PENDING.
-func WaitForDepositInfoReady(ctx context.Context, svc Service, customerID, ruleID string, opts *WaitOptions) (*RuleResponse, error) {
+func WaitForDepositInfoReady(ctx context.Context, svc Service, customerID svc.CustomerID, ruleID string, opts *WaitOptions) (*RuleResponse, error) {
 	return WaitFor(ctx, svc, customerID, ruleID, func(r *RuleResponse) bool {
 		return r.DepositInfoStatus != DepositInfoStatusPENDING
 	}, opts)
 }
+
+// ErrOrderFailed is returned by WaitForOrderCompleted when the order reaches a failed status.
+var ErrOrderFailed = errors.New("auto conversion order failed")
+
+// OrderStatus returns the order status normalized to an OrderStatus constant
+// (e.g., "Deposit Completed" becomes OrderStatusDEPOSITCOMPLETED).
+// Returns an empty OrderStatus if the status is not recognized.
+func (o *OrderResponse) OrderStatus() OrderStatus {
+	normalized := strings.ReplaceAll(strings.TrimSpace(o.Status), " ", "_")
+	status, err := ParseOrderStatus(normalized)
+	if err != nil {
+		return ""
+	}
+	return status
+}
+
+// IsTerminal reports whether the order status is final (completed or failed).
+func (s OrderStatus) IsTerminal() bool {
+	return s == OrderStatusCOMPLETED || s.IsFailed()
+}
+
+// IsFailed reports whether the order status is one of the documented failure statuses.
+func (s OrderStatus) IsFailed() bool {
+	return s == OrderStatusDEPOSITFAILED || s == OrderStatusCONVERSIONFAILED || s == OrderStatusWITHDRAWALFAILED
+}
+
+// IsRetryable reports whether a failed order can be retried with RetryOrder.
+// Deposit failures are not retryable because no funds were received.
+func (s OrderStatus) IsRetryable() bool {
+	return s == OrderStatusCONVERSIONFAILED || s == OrderStatusWITHDRAWALFAILED
+}
+
+// OrderCondition is a function that checks if an order meets a condition.
+type OrderCondition func(*OrderResponse) bool
+
+// WaitForOrder polls the order until the condition returns true.
+// Returns the order response when condition is met, or an error on timeout/failure.
+func WaitForOrder(
+	ctx context.Context, svc Service, customerID svc.CustomerID, ruleID, orderID string,
+	condition OrderCondition, opts *WaitOptions,
+) (*OrderResponse, error) {
+	if opts == nil {
+		defaults := DefaultWaitOptions()
+		opts = &defaults
+	}
+
+	start := time.Now()
+	deadline := start.Add(opts.MaxWaitTime)
+	for time.Now().Before(deadline) {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+
+		order, err := svc.GetOrder(ctx, customerID, ruleID, orderID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get order: %w", err)
+		}
+
+		if opts.PrintProgress {
+			log.Printf("polling order status: order=%s elapsed=%.1fs status=%s",
+				orderID, time.Since(start).Seconds(), order.Status)
+		}
+
+		if condition(order) {
+			return order, nil
+		}
+
+		time.Sleep(opts.PollInterval)
+	}
+
+	return nil, fmt.Errorf("timeout waiting for order %s after %v", orderID, opts.MaxWaitTime)
+}
+
+// WaitForOrderCompleted polls until the order reaches COMPLETED.
+// Returns the order together with an error wrapping ErrOrderFailed if it ends in
+// Deposit Failed, Conversion Failed, or Withdrawal Failed.
+func WaitForOrderCompleted(
+	ctx context.Context, svc Service, customerID svc.CustomerID, ruleID, orderID string, opts *WaitOptions,
+) (*OrderResponse, error) {
+	order, err := WaitForOrder(ctx, svc, customerID, ruleID, orderID, func(o *OrderResponse) bool {
+		return o.OrderStatus().IsTerminal()
+	}, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if order.OrderStatus().IsFailed() {
+		return order, fmt.Errorf("%w: order %s status %s", ErrOrderFailed, orderID, order.Status)
+	}
+
+	return order, nil
+}
+
+// WaitForOrderCreated polls until the deposit transaction has triggered an auto conversion order.
+// Not-found responses are treated as "not created yet".
+func WaitForOrderCreated(
+	ctx context.Context, svc Service, customerID svc.CustomerID, depositTransactionID svc.TransactionID, opts *WaitOptions,
+) (*OrderResponse, error) {
+	if opts == nil {
+		defaults := DefaultWaitOptions()
+		opts = &defaults
+	}
+
+	start := time.Now()
+	deadline := start.Add(opts.MaxWaitTime)
+	for time.Now().Before(deadline) {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+
+		order, err := svc.GetOrderByDepositTransaction(ctx, customerID, depositTransactionID)
+		if err == nil {
+			return order, nil
+		}
+		if !transport.IsNotFoundError(err) {
+			return nil, fmt.Errorf("failed to get order by deposit transaction: %w", err)
+		}
+
+		if opts.PrintProgress {
+			log.Printf("waiting for order: deposit_transaction=%s elapsed=%.1fs",
+				depositTransactionID, time.Since(start).Seconds())
+		}
+
+		time.Sleep(opts.PollInterval)
+	}
+
+	return nil, fmt.Errorf("timeout waiting for order for deposit transaction %s after %v",
+		depositTransactionID, opts.MaxWaitTime)
+}
+
+// CreatedTime parses CreatedAt into a time.Time.
+func (r *RuleResponse) CreatedTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.CreatedAt)
+}
+
+// ModifiedTime parses ModifiedAt into a time.Time.
+func (r *RuleResponse) ModifiedTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.ModifiedAt)
+}
+
+// CreatedTime parses CreatedAt into a time.Time.
+func (o *OrderResponse) CreatedTime() (time.Time, error) {
+	return svc.ParseTimestamp(o.CreatedAt)
+}
+
+// UpdatedTime parses UpdatedAt into a time.Time.
+func (o *OrderResponse) UpdatedTime() (time.Time, error) {
+	return svc.ParseTimestamp(o.UpdatedAt)
+}
@@ -0,0 +1,58 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package auto_conversion_rules
+
+import "testing"
+
+func TestOrderResponse_OrderStatus(t *testing.T) {
+	tests := []struct {
+		raw          string
+		want         OrderStatus
+		wantTerminal bool
+		wantFailed   bool
+		wantRetry    bool
+	}{
+		{raw: "Init", want: OrderStatusINIT},
+		{raw: "Deposit Completed", want: OrderStatusDEPOSITCOMPLETED},
+		{raw: "Conversion Completed", want: OrderStatusCONVERSIONCOMPLETED},
+		{raw: "Completed", want: OrderStatusCOMPLETED, wantTerminal: true},
+		{raw: "COMPLETED", want: OrderStatusCOMPLETED, wantTerminal: true},
+		{raw: "Deposit Failed", want: OrderStatusDEPOSITFAILED, wantTerminal: true, wantFailed: true},
+		{raw: "Conversion Failed", want: OrderStatusCONVERSIONFAILED, wantTerminal: true, wantFailed: true, wantRetry: true},
+		{raw: "WITHDRAWAL_FAILED", want: OrderStatusWITHDRAWALFAILED, wantTerminal: true, wantFailed: true, wantRetry: true},
+		{raw: "Unknown", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			order := &OrderResponse{Status: tt.raw}
+			got := order.OrderStatus()
+			if got != tt.want {
+				t.Errorf("OrderStatus() = %q, want %q", got, tt.want)
+			}
+			if got.IsTerminal() != tt.wantTerminal {
+				t.Errorf("IsTerminal() = %v, want %v", got.IsTerminal(), tt.wantTerminal)
+			}
+			if got.IsFailed() != tt.wantFailed {
+				t.Errorf("IsFailed() = %v, want %v", got.IsFailed(), tt.wantFailed)
+			}
+			if got.IsRetryable() != tt.wantRetry {
+				t.Errorf("IsRetryable() = %v, want %v", got.IsRetryable(), tt.wantRetry)
+			}
+		})
+	}
+}
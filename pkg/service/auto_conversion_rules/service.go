@@ -64,33 +64,63 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
+
+	"github.com/google/uuid"
 
 	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/transactions"
 )
 
 // Service defines the auto conversion rules service interface for managing automatic conversions.
 type Service interface {
 	// CreateRule creates a new auto conversion rule for a customer.
 	// The IdempotencyKey in the request is used to ensure idempotent creation.
-	CreateRule(ctx context.Context, customerID string, req *CreateRuleRequest) (*RuleResponse, error)
+	CreateRule(ctx context.Context, customerID svc.CustomerID, req *CreateRuleRequest) (*RuleResponse, error)
 
 	// GetRule retrieves a specific auto conversion rule by ID.
-	GetRule(ctx context.Context, customerID, ruleID string) (*RuleResponse, error)
+	GetRule(ctx context.Context, customerID svc.CustomerID, ruleID string) (*RuleResponse, error)
 
 	// GetRuleByIdempotencyKey retrieves an auto conversion rule by its idempotency key.
-	GetRuleByIdempotencyKey(ctx context.Context, customerID, idempotencyKey string) (*RuleResponse, error)
+	GetRuleByIdempotencyKey(ctx context.Context, customerID svc.CustomerID, idempotencyKey string) (*RuleResponse, error)
+
+	// UpdateRule updates a rule's destination, nickname, or minimum deposit amount in place,
+	// keeping its deposit instructions and reference code.
+	UpdateRule(ctx context.Context, customerID svc.CustomerID, ruleID string, req *UpdateRuleRequest) (*RuleResponse, error)
+
+	// PauseRule temporarily stops a rule from converting new deposits while keeping
+	// its deposit instructions and reference code. Unlike DeleteRule, it can be undone with ResumeRule.
+	PauseRule(ctx context.Context, customerID svc.CustomerID, ruleID string) (*RuleResponse, error)
+
+	// ResumeRule resumes a paused rule.
+	ResumeRule(ctx context.Context, customerID svc.CustomerID, ruleID string) (*RuleResponse, error)
 
 	// ListRules retrieves all auto conversion rules for a customer with pagination.
-	ListRules(ctx context.Context, customerID string, req *ListRulesRequest) (*ListRulesResponse, error)
+	ListRules(ctx context.Context, customerID svc.CustomerID, req *ListRulesRequest) (*ListRulesResponse, error)
 
 	// DeleteRule soft-deletes an auto conversion rule (marks as inactive).
-	DeleteRule(ctx context.Context, customerID, ruleID string) error
+	DeleteRule(ctx context.Context, customerID svc.CustomerID, ruleID string) error
 
 	// ListOrders retrieves the execution history (orders) for a specific auto conversion rule.
-	ListOrders(ctx context.Context, customerID, ruleID string, req *ListOrdersRequest) (*ListOrdersResponse, error)
+	ListOrders(ctx context.Context, customerID svc.CustomerID, ruleID string, req *ListOrdersRequest) (*ListOrdersResponse, error)
+
+	// ListAllOrders retrieves auto conversion orders across every rule of a customer.
+	ListAllOrders(ctx context.Context, customerID svc.CustomerID, req *ListAllOrdersRequest) (*ListOrdersResponse, error)
 
 	// GetOrder retrieves detailed information about a specific auto conversion order.
-	GetOrder(ctx context.Context, customerID, ruleID, orderID string) (*OrderResponse, error)
+	GetOrder(ctx context.Context, customerID svc.CustomerID, ruleID, orderID string) (*OrderResponse, error)
+
+	// RetryOrder retries an order stuck in Conversion Failed or Withdrawal Failed.
+	// The retryToken is sent as the idempotency key so repeated calls trigger a single retry;
+	// if empty, a fresh random key is generated, so every call is a new retry attempt.
+	RetryOrder(ctx context.Context, customerID svc.CustomerID, ruleID, orderID, retryToken string) (*OrderResponse, error)
+
+	// GetRuleStats retrieves conversion totals, fees, order counts by status, and average
+	// conversion time for a rule over a period.
+	GetRuleStats(ctx context.Context, customerID svc.CustomerID, ruleID string, period transactions.Period) (*RuleStatsResponse, error)
+
+	// GetOrderByDepositTransaction retrieves the auto conversion order triggered by a deposit transaction.
+	GetOrderByDepositTransaction(ctx context.Context, customerID svc.CustomerID, depositTransactionID svc.TransactionID) (*OrderResponse, error)
 }
 
 // Common types for asset and amount information.
@@ -238,7 +268,7 @@ type (
 		IdempotencyKey string `json:"idempotency_key"`
 		// Nickname is the auto-generated nickname based on source/destination.
 		Nickname string `json:"nickname"`
-		// Status is the rule status: ACTIVE or INACTIVE.
+		// Status is the rule status: PENDING, ACTIVE, PAUSED, or INACTIVE.
 		Status RuleStatus `json:"status"`
 		// Source is the source asset and network configuration.
 		Source SourceAssetInfo `json:"source"`
@@ -246,6 +276,8 @@ type (
 		Destination DestinationAssetInfo `json:"destination"`
 		// DepositInfoStatus indicates the status of the deposit info: PENDING, ACTIVE, or INACTIVE.
 		DepositInfoStatus DepositInfoStatus `json:"deposit_info_status,omitempty"`
+		// MinimumDepositAmount is the rule-specific minimum deposit override, if set.
+		MinimumDepositAmount *string `json:"minimum_deposit_amount,omitempty"`
 		// SourceDepositInfo contains deposit info (bank or wallet). Only included in retrieve responses.
 		SourceDepositInfo *SourceDepositInfo `json:"source_deposit_info,omitempty"`
 		// CreatedAt is the rule creation timestamp (ISO 8601).
@@ -255,6 +287,19 @@ type (
 	}
 )
 
+// UpdateRuleRequest represents the request body for updating an auto conversion rule.
+// Only non-nil fields are changed.
+type UpdateRuleRequest struct {
+	// Nickname is the new display name of the rule.
+	Nickname *string `json:"nickname,omitempty"`
+	// WalletAddress is the new external wallet address for automatic crypto withdrawal (fiat->crypto only).
+	WalletAddress *string `json:"wallet_address,omitempty"`
+	// ExternalAccountID is the new external account ID for automatic fiat withdrawal (crypto->fiat only).
+	ExternalAccountID *string `json:"external_account_id,omitempty"`
+	// MinimumDepositAmount overrides the minimum deposit amount that triggers a conversion.
+	MinimumDepositAmount *string `json:"minimum_deposit_amount,omitempty"`
+}
+
 // ListRules request and response types.
 type (
 	// ListRulesRequest represents the pagination parameters for listing auto conversion rules.
@@ -305,6 +350,12 @@ type (
 		Destination DestinationAssetInfo `json:"destination"`
 		// Receipt is the fee breakdown for this order.
 		Receipt OrderReceipt `json:"receipt"`
+		// DepositTransactionID is the deposit transaction that triggered this order.
+		DepositTransactionID svc.TransactionID `json:"deposit_transaction_id,omitempty"`
+		// ConversionTransactionID is the conversion transaction created by this order, once converted.
+		ConversionTransactionID svc.TransactionID `json:"conversion_transaction_id,omitempty"`
+		// WithdrawalTransactionID is the withdrawal transaction created by this order, if the rule withdraws.
+		WithdrawalTransactionID svc.TransactionID `json:"withdrawal_transaction_id,omitempty"`
 		// CreatedAt is the order creation timestamp (ISO 8601).
 		CreatedAt string `json:"created_at"`
 		// UpdatedAt is the last update timestamp (ISO 8601).
@@ -321,6 +372,20 @@ type (
 		Size int `json:"size,omitempty"`
 	}
 
+	// ListAllOrdersRequest represents the parameters for listing orders across all rules.
+	ListAllOrdersRequest struct {
+		// Status filters by order status (optional).
+		Status string `json:"status,omitempty"`
+		// CreatedAfter filters orders created after this timestamp (RFC3339/ISO 8601 format).
+		CreatedAfter string `json:"created_after,omitempty"`
+		// CreatedBefore filters orders created before this timestamp (RFC3339/ISO 8601 format).
+		CreatedBefore string `json:"created_before,omitempty"`
+		// Page is the page number (starts from 1, default: 1).
+		Page int `json:"page,omitempty"`
+		// Size is the number of items per page (1-100, default: 10).
+		Size int `json:"size,omitempty"`
+	}
+
 	// ListOrdersResponse represents the paginated response for listing auto conversion orders.
 	ListOrdersResponse struct {
 		// Total is the total number of orders matching the query.
@@ -330,6 +395,44 @@ type (
 	}
 )
 
+// GetRuleStats response types.
+type (
+	// OrderStatusCount represents the number of orders in one status.
+	OrderStatusCount struct {
+		// Status is the order status.
+		Status string `json:"status"`
+		// Count is the number of orders in this status.
+		Count int64 `json:"count"`
+	}
+
+	// RuleStatsResponse represents aggregate statistics for an auto conversion rule.
+	RuleStatsResponse struct {
+		// AutoConversionRuleID is the rule the statistics are for.
+		AutoConversionRuleID string `json:"auto_conversion_rule_id"`
+		// PeriodStart is the inclusive start of the period (ISO 8601).
+		PeriodStart string `json:"period_start"`
+		// PeriodEnd is the exclusive end of the period (ISO 8601).
+		PeriodEnd string `json:"period_end"`
+		// TotalDeposited is the total source amount received.
+		TotalDeposited AmountInfo `json:"total_deposited"`
+		// TotalConverted is the total destination amount produced.
+		TotalConverted AmountInfo `json:"total_converted"`
+		// TotalFees is the total of all fees charged.
+		TotalFees AmountInfo `json:"total_fees"`
+		// OrderCount is the total number of orders in the period.
+		OrderCount int64 `json:"order_count"`
+		// OrdersByStatus breaks down the orders by status.
+		OrdersByStatus []OrderStatusCount `json:"orders_by_status"`
+		// AverageConversionSeconds is the average time from deposit to completion, in seconds.
+		AverageConversionSeconds float64 `json:"average_conversion_seconds"`
+	}
+)
+
+// AverageConversionTime returns the average time from deposit to completion as a duration.
+func (r *RuleStatsResponse) AverageConversionTime() time.Duration {
+	return time.Duration(r.AverageConversionSeconds * float64(time.Second))
+}
+
 type serviceImpl struct {
 	*svc.BaseService
 }
@@ -344,7 +447,7 @@ func NewService(base *svc.BaseService) Service {
 // CreateRule creates a new auto conversion rule for a customer.
 func (s *serviceImpl) CreateRule(
 	ctx context.Context,
-	customerID string,
+	customerID svc.CustomerID,
 	req *CreateRuleRequest,
 ) (*RuleResponse, error) {
 	path := fmt.Sprintf("/v1/customers/%s/auto-conversion-rules", customerID)
@@ -365,7 +468,7 @@ func (s *serviceImpl) CreateRule(
 // GetRule retrieves a specific auto conversion rule by ID.
 func (s *serviceImpl) GetRule(
 	ctx context.Context,
-	customerID, ruleID string,
+	customerID svc.CustomerID, ruleID string,
 ) (*RuleResponse, error) {
 	path := fmt.Sprintf("/v1/customers/%s/auto-conversion-rules/%s", customerID, ruleID)
 	return svc.GetJSON[RuleResponse](ctx, s.BaseService, path)
@@ -374,7 +477,7 @@ func (s *serviceImpl) GetRule(
 // GetRuleByIdempotencyKey retrieves an auto conversion rule by its idempotency key.
 func (s *serviceImpl) GetRuleByIdempotencyKey(
 	ctx context.Context,
-	customerID, idempotencyKey string,
+	customerID svc.CustomerID, idempotencyKey string,
 ) (*RuleResponse, error) {
 	path := fmt.Sprintf("/v1/customers/%s/auto-conversion-rules", customerID)
 	params := map[string]string{
@@ -383,10 +486,38 @@ func (s *serviceImpl) GetRuleByIdempotencyKey(
 	return svc.GetJSONWithParams[RuleResponse](ctx, s.BaseService, path, params)
 }
 
+// UpdateRule updates a rule's destination, nickname, or minimum deposit amount in place.
+func (s *serviceImpl) UpdateRule(
+	ctx context.Context,
+	customerID svc.CustomerID, ruleID string,
+	req *UpdateRuleRequest,
+) (*RuleResponse, error) {
+	path := fmt.Sprintf("/v1/customers/%s/auto-conversion-rules/%s", customerID, ruleID)
+	return svc.PatchJSON[*UpdateRuleRequest, RuleResponse](ctx, s.BaseService, path, req)
+}
+
+// PauseRule temporarily stops a rule from converting new deposits.
+func (s *serviceImpl) PauseRule(
+	ctx context.Context,
+	customerID svc.CustomerID, ruleID string,
+) (*RuleResponse, error) {
+	path := fmt.Sprintf("/v1/customers/%s/auto-conversion-rules/%s/pause", customerID, ruleID)
+	return svc.PostJSON[any, RuleResponse](ctx, s.BaseService, path, nil)
+}
+
+// ResumeRule resumes a paused rule.
+func (s *serviceImpl) ResumeRule(
+	ctx context.Context,
+	customerID svc.CustomerID, ruleID string,
+) (*RuleResponse, error) {
+	path := fmt.Sprintf("/v1/customers/%s/auto-conversion-rules/%s/resume", customerID, ruleID)
+	return svc.PostJSON[any, RuleResponse](ctx, s.BaseService, path, nil)
+}
+
 // ListRules retrieves all auto conversion rules for a customer with pagination.
 func (s *serviceImpl) ListRules(
 	ctx context.Context,
-	customerID string,
+	customerID svc.CustomerID,
 	req *ListRulesRequest,
 ) (*ListRulesResponse, error) {
 	path := fmt.Sprintf("/v1/customers/%s/auto-conversion-rules/list", customerID)
@@ -407,7 +538,7 @@ func (s *serviceImpl) ListRules(
 // DeleteRule soft-deletes an auto conversion rule (marks as inactive).
 func (s *serviceImpl) DeleteRule(
 	ctx context.Context,
-	customerID, ruleID string,
+	customerID svc.CustomerID, ruleID string,
 ) error {
 	path := fmt.Sprintf("/v1/customers/%s/auto-conversion-rules/%s", customerID, ruleID)
 	_, err := svc.DeleteJSON[any](ctx, s.BaseService, path)
@@ -417,7 +548,7 @@ func (s *serviceImpl) DeleteRule(
 // ListOrders retrieves the execution history (orders) for a specific auto conversion rule.
 func (s *serviceImpl) ListOrders(
 	ctx context.Context,
-	customerID, ruleID string,
+	customerID svc.CustomerID, ruleID string,
 	req *ListOrdersRequest,
 ) (*ListOrdersResponse, error) {
 	path := fmt.Sprintf("/v1/customers/%s/auto-conversion-rules/%s/orders", customerID, ruleID)
@@ -438,11 +569,89 @@ func (s *serviceImpl) ListOrders(
 	return svc.GetJSONWithParams[ListOrdersResponse](ctx, s.BaseService, path, params)
 }
 
+// ListAllOrders retrieves auto conversion orders across every rule of a customer.
+func (s *serviceImpl) ListAllOrders(
+	ctx context.Context,
+	customerID svc.CustomerID,
+	req *ListAllOrdersRequest,
+) (*ListOrdersResponse, error) {
+	path := fmt.Sprintf("/v1/customers/%s/auto-conversion-rules/orders/list", customerID)
+
+	params := make(map[string]string)
+	if req != nil {
+		if req.Status != "" {
+			params["status"] = req.Status
+		}
+		if req.CreatedAfter != "" {
+			params["created_after"] = req.CreatedAfter
+		}
+		if req.CreatedBefore != "" {
+			params["created_before"] = req.CreatedBefore
+		}
+		if req.Page > 0 {
+			params["pagination[page]"] = fmt.Sprintf("%d", req.Page)
+		}
+		if req.Size > 0 {
+			params["pagination[size]"] = fmt.Sprintf("%d", req.Size)
+		}
+	}
+
+	return svc.GetJSONWithParams[ListOrdersResponse](ctx, s.BaseService, path, params)
+}
+
 // GetOrder retrieves detailed information about a specific auto conversion order.
 func (s *serviceImpl) GetOrder(
 	ctx context.Context,
-	customerID, ruleID, orderID string,
+	customerID svc.CustomerID, ruleID, orderID string,
 ) (*OrderResponse, error) {
 	path := fmt.Sprintf("/v1/customers/%s/auto-conversion-rules/%s/orders/%s", customerID, ruleID, orderID)
 	return svc.GetJSON[OrderResponse](ctx, s.BaseService, path)
 }
+
+// RetryOrder retries an order stuck in Conversion Failed or Withdrawal Failed.
+func (s *serviceImpl) RetryOrder(
+	ctx context.Context,
+	customerID svc.CustomerID, ruleID, orderID, retryToken string,
+) (*OrderResponse, error) {
+	path := fmt.Sprintf("/v1/customers/%s/auto-conversion-rules/%s/orders/%s/retry", customerID, ruleID, orderID)
+
+	if retryToken == "" {
+		retryToken = uuid.New().String()
+	}
+	headers := map[string]string{
+		"Idempotency-Key": retryToken,
+	}
+
+	return svc.PostJSONWithHeaders[any, OrderResponse](ctx, s.BaseService, path, nil, headers)
+}
+
+// GetRuleStats retrieves aggregate statistics for a rule over a period.
+func (s *serviceImpl) GetRuleStats(
+	ctx context.Context,
+	customerID svc.CustomerID, ruleID string,
+	period transactions.Period,
+) (*RuleStatsResponse, error) {
+	path := fmt.Sprintf("/v1/customers/%s/auto-conversion-rules/%s/stats", customerID, ruleID)
+
+	params := make(map[string]string)
+	if !period.Start.IsZero() {
+		params["period_start"] = period.Start.UTC().Format(time.RFC3339)
+	}
+	if !period.End.IsZero() {
+		params["period_end"] = period.End.UTC().Format(time.RFC3339)
+	}
+
+	return svc.GetJSONWithParams[RuleStatsResponse](ctx, s.BaseService, path, params)
+}
+
+// GetOrderByDepositTransaction retrieves the auto conversion order triggered by a deposit transaction.
+func (s *serviceImpl) GetOrderByDepositTransaction(
+	ctx context.Context,
+	customerID svc.CustomerID, depositTransactionID svc.TransactionID,
+) (*OrderResponse, error) {
+	path := fmt.Sprintf("/v1/customers/%s/auto-conversion-rules/orders", customerID)
+	params := map[string]string{
+		"deposit_transaction_id": string(depositTransactionID),
+	}
+	return svc.GetJSONWithParams[OrderResponse](ctx, s.BaseService, path, params)
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package conversions
+
+import (
+	"time"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/common"
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+)
+
+// AssetAmount returns the AssetInfo for an amount of money. Pass an empty network for fiat assets.
+func AssetAmount(amount common.Money, network WalletNetworkName) AssetInfo {
+	return AssetInfo{Amount: amount.Amount.String(), Asset: amount.Currency, Network: network}
+}
+
+// NewQuoteRequest returns a request to quote converting the given amount into the to asset.
+// Pass empty networks for fiat assets.
+func NewQuoteRequest(
+	from common.Money, fromNetwork WalletNetworkName, to assets.AssetName, toNetwork WalletNetworkName,
+) *CreateQuoteRequest {
+	return &CreateQuoteRequest{
+		FromAsset: AssetAmount(from, fromNetwork),
+		ToAsset:   AssetInfo{Asset: to, Network: toNetwork},
+	}
+}
+
+// Money returns the amount of the asset, or common.ErrInvalidAmount if no amount is set.
+func (a AssetInfo) Money() (common.Money, error) {
+	return common.NewMoney(a.Amount, a.Asset)
+}
+
+// PayMoney returns the amount the user will pay.
+func (r *QuoteResponse) PayMoney() (common.Money, error) {
+	return common.NewMoney(r.UserPayAmount, assets.AssetName(r.UserPayAsset))
+}
+
+// ObtainMoney returns the amount the user will receive.
+func (r *QuoteResponse) ObtainMoney() (common.Money, error) {
+	return common.NewMoney(r.UserObtainAmount, assets.AssetName(r.UserObtainAsset))
+}
+
+// PayMoney returns the amount the user paid.
+func (r *OrderResponse) PayMoney() (common.Money, error) {
+	return common.NewMoney(r.UserPayAmount, assets.AssetName(r.UserPayAsset))
+}
+
+// ObtainMoney returns the amount the user received.
+func (r *OrderResponse) ObtainMoney() (common.Money, error) {
+	return common.NewMoney(r.UserObtainAmount, assets.AssetName(r.UserObtainAsset))
+}
+
+// FeeMoney returns the fee charged for the order.
+func (r *OrderResponse) FeeMoney() (common.Money, error) {
+	return common.NewMoney(r.Fee, assets.AssetName(r.FeeCurrency))
+}
+
+// ValidUntilTime parses ValidUntilTimestamp into a time.Time.
+func (r *QuoteResponse) ValidUntilTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.ValidUntilTimestamp)
+}
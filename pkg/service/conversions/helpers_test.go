@@ -0,0 +1,73 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package conversions
+
+import (
+	"testing"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/common"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+)
+
+func TestNewQuoteRequest(t *testing.T) {
+	from := common.MustMoney("100.00", assets.AssetNameUSDT)
+	req := NewQuoteRequest(from, WalletNetworkNameETHEREUM, assets.AssetNameUSD, "")
+
+	want := CreateQuoteRequest{
+		FromAsset: AssetInfo{Amount: "100", Asset: assets.AssetNameUSDT, Network: WalletNetworkNameETHEREUM},
+		ToAsset:   AssetInfo{Asset: assets.AssetNameUSD},
+	}
+	if *req != want {
+		t.Fatalf("NewQuoteRequest() = %+v, want %+v", *req, want)
+	}
+	if got, err := req.FromAsset.Money(); err != nil || !got.Equal(from) {
+		t.Errorf("FromAsset.Money() = %s, %v, want %s", got, err, from)
+	}
+	if _, err := req.ToAsset.Money(); err == nil {
+		t.Error("ToAsset.Money() without an amount should fail")
+	}
+}
+
+func TestOrderResponse_Money(t *testing.T) {
+	order := &OrderResponse{
+		UserPayAmount:    "100.00",
+		UserPayAsset:     "USDT",
+		UserObtainAmount: "99.50",
+		UserObtainAsset:  "USD",
+		Fee:              "0.50",
+		FeeCurrency:      "USD",
+	}
+
+	pay, err := order.PayMoney()
+	if err != nil || pay.String() != "100 USDT" {
+		t.Errorf("PayMoney() = %s, %v", pay, err)
+	}
+	obtain, err := order.ObtainMoney()
+	if err != nil {
+		t.Fatalf("ObtainMoney() error = %v", err)
+	}
+	fee, err := order.FeeMoney()
+	if err != nil {
+		t.Fatalf("FeeMoney() error = %v", err)
+	}
+	if gross, err := obtain.Add(fee); err != nil || gross.Format(2) != "100.00 USD" {
+		t.Errorf("obtain + fee = %s, %v, want 100.00 USD", gross, err)
+	}
+	if _, err := pay.Sub(fee); err == nil {
+		t.Error("subtracting a USD fee from a USDT amount should fail")
+	}
+}
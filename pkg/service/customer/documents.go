@@ -0,0 +1,66 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package customer
+
+// registrationDocumentDescriptions names the registration document of each business type.
+var registrationDocumentDescriptions = map[BusinessType]string{
+	BusinessTypeCooperative:        "Certificate of Registration",
+	BusinessTypeCorporation:        "Certificate of Incorporation",
+	BusinessTypeLlc:                "Certificate of Formation",
+	BusinessTypePartnership:        "Partnership Registration",
+	BusinessTypeSoleProprietorship: "Business Registration",
+}
+
+// ownershipDocuments are the ownership and governance documents expected for each business type.
+var ownershipDocuments = map[BusinessType][]Document{
+	BusinessTypeCooperative: {
+		{DocType: DocumentTypeConstitutionalDocument, Description: "Articles of Association"},
+		{DocType: DocumentTypeDirectorsRegistry, Description: "Register of Directors"},
+	},
+	BusinessTypeCorporation: {
+		{DocType: DocumentTypeShareholderRegister, Description: "Ownership Structure"},
+		{DocType: DocumentTypeESignatureCertificate, Description: "Authorized Representative List"},
+		{DocType: DocumentTypeEvidenceOfGoodStanding, Description: "Evidence of Good Standing"},
+	},
+	BusinessTypeLlc: {
+		{DocType: DocumentTypeConstitutionalDocument, Description: "Operating Agreement"},
+		{DocType: DocumentTypeOwnershipInformation, Description: "Member Register"},
+		{DocType: DocumentTypeEvidenceOfGoodStanding, Description: "Evidence of Good Standing"},
+	},
+	BusinessTypePartnership: {
+		{DocType: DocumentTypeConstitutionalDocument, Description: "Partnership Agreement"},
+		{DocType: DocumentTypeOwnershipInformation, Description: "Partner Register"},
+	},
+}
+
+// RequiredDocuments returns the KYB documents expected for a business of the given type
+// registered in the given country (ISO 3166-1 alpha-3), in submission order.
+// The documents have a type and description; File must be set before submission.
+func RequiredDocuments(businessType BusinessType, country string) []Document {
+	taxDocument := "Tax Registration Certificate"
+	if country == "USA" {
+		taxDocument = "W9 Form"
+	}
+
+	docs := []Document{
+		{DocType: DocumentTypeFlowOfFunds, Description: "Proof of Funds"},
+		{DocType: DocumentTypeRegistrationDocument, Description: registrationDocumentDescriptions[businessType]},
+		{DocType: DocumentTypeProofOfTaxIdentification, Description: taxDocument},
+	}
+	docs = append(docs, ownershipDocuments[businessType]...)
+	return append(docs, Document{DocType: DocumentTypeProofOfAddress, Description: "Proof of Address"})
+}
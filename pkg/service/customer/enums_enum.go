@@ -1,131 +1,31 @@
-// Code generated by go-enum DO NOT EDIT.
-// Version: v0.9.2
-
-// Built By: go install
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by svcgen enums from enums.yaml. DO NOT EDIT.
 
 package customer
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 )
 
-const (
-	// AccountPurposeCharitableDonations is a AccountPurpose of type charitable_donations.
-	AccountPurposeCharitableDonations AccountPurpose = "charitable_donations"
-	// AccountPurposeEcommerceRetailPayments is a AccountPurpose of type ecommerce_retail_payments.
-	AccountPurposeEcommerceRetailPayments AccountPurpose = "ecommerce_retail_payments"
-	// AccountPurposeInvestmentPurposes is a AccountPurpose of type investment_purposes.
-	AccountPurposeInvestmentPurposes AccountPurpose = "investment_purposes"
-	// AccountPurposeOther is a AccountPurpose of type other.
-	AccountPurposeOther AccountPurpose = "other"
-	// AccountPurposePaymentsToFriendsOrFamilyAbroad is a AccountPurpose of type payments_to_friends_or_family_abroad.
-	AccountPurposePaymentsToFriendsOrFamilyAbroad AccountPurpose = "payments_to_friends_or_family_abroad"
-	// AccountPurposePayroll is a AccountPurpose of type payroll.
-	AccountPurposePayroll AccountPurpose = "payroll"
-	// AccountPurposePersonalOrLivingExpenses is a AccountPurpose of type personal_or_living_expenses.
-	AccountPurposePersonalOrLivingExpenses AccountPurpose = "personal_or_living_expenses"
-	// AccountPurposeProtectWealth is a AccountPurpose of type protect_wealth.
-	AccountPurposeProtectWealth AccountPurpose = "protect_wealth"
-	// AccountPurposePurchaseGoodsAndServices is a AccountPurpose of type purchase_goods_and_services.
-	AccountPurposePurchaseGoodsAndServices AccountPurpose = "purchase_goods_and_services"
-	// AccountPurposeReceivePaymentsForGoodsAndServices is a AccountPurpose of type receive_payments_for_goods_and_services.
-	AccountPurposeReceivePaymentsForGoodsAndServices AccountPurpose = "receive_payments_for_goods_and_services"
-	// AccountPurposeTaxOptimization is a AccountPurpose of type tax_optimization.
-	AccountPurposeTaxOptimization AccountPurpose = "tax_optimization"
-	// AccountPurposeThirdPartyMoneyTransmission is a AccountPurpose of type third_party_money_transmission.
-	AccountPurposeThirdPartyMoneyTransmission AccountPurpose = "third_party_money_transmission"
-	// AccountPurposeTreasuryManagement is a AccountPurpose of type treasury_management.
-	AccountPurposeTreasuryManagement AccountPurpose = "treasury_management"
-)
-
-var ErrInvalidAccountPurpose = fmt.Errorf("not a valid AccountPurpose, try [%s]", strings.Join(_AccountPurposeNames, ", "))
-
-var _AccountPurposeNames = []string{
-	string(AccountPurposeCharitableDonations),
-	string(AccountPurposeEcommerceRetailPayments),
-	string(AccountPurposeInvestmentPurposes),
-	string(AccountPurposeOther),
-	string(AccountPurposePaymentsToFriendsOrFamilyAbroad),
-	string(AccountPurposePayroll),
-	string(AccountPurposePersonalOrLivingExpenses),
-	string(AccountPurposeProtectWealth),
-	string(AccountPurposePurchaseGoodsAndServices),
-	string(AccountPurposeReceivePaymentsForGoodsAndServices),
-	string(AccountPurposeTaxOptimization),
-	string(AccountPurposeThirdPartyMoneyTransmission),
-	string(AccountPurposeTreasuryManagement),
-}
-
-// AccountPurposeNames returns a list of possible string values of AccountPurpose.
-func AccountPurposeNames() []string {
-	tmp := make([]string, len(_AccountPurposeNames))
-	copy(tmp, _AccountPurposeNames)
-	return tmp
-}
-
-// String implements the Stringer interface.
-func (x AccountPurpose) String() string {
-	return string(x)
-}
-
-// IsValid provides a quick way to determine if the typed value is
-// part of the allowed enumerated values
-func (x AccountPurpose) IsValid() bool {
-	_, err := ParseAccountPurpose(string(x))
-	return err == nil
-}
-
-var _AccountPurposeValue = map[string]AccountPurpose{
-	"charitable_donations":                    AccountPurposeCharitableDonations,
-	"ecommerce_retail_payments":               AccountPurposeEcommerceRetailPayments,
-	"investment_purposes":                     AccountPurposeInvestmentPurposes,
-	"other":                                   AccountPurposeOther,
-	"payments_to_friends_or_family_abroad":    AccountPurposePaymentsToFriendsOrFamilyAbroad,
-	"payroll":                                 AccountPurposePayroll,
-	"personal_or_living_expenses":             AccountPurposePersonalOrLivingExpenses,
-	"protect_wealth":                          AccountPurposeProtectWealth,
-	"purchase_goods_and_services":             AccountPurposePurchaseGoodsAndServices,
-	"receive_payments_for_goods_and_services": AccountPurposeReceivePaymentsForGoodsAndServices,
-	"tax_optimization":                        AccountPurposeTaxOptimization,
-	"third_party_money_transmission":          AccountPurposeThirdPartyMoneyTransmission,
-	"treasury_management":                     AccountPurposeTreasuryManagement,
-}
-
-// ParseAccountPurpose attempts to convert a string to a AccountPurpose.
-func ParseAccountPurpose(name string) (AccountPurpose, error) {
-	if x, ok := _AccountPurposeValue[name]; ok {
-		return x, nil
-	}
-	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
-	if x, ok := _AccountPurposeValue[strings.ToLower(name)]; ok {
-		return x, nil
-	}
-	return AccountPurpose(""), fmt.Errorf("%s is %w", name, ErrInvalidAccountPurpose)
-}
-
-// MarshalText implements the text marshaller method.
-func (x AccountPurpose) MarshalText() ([]byte, error) {
-	return []byte(string(x)), nil
-}
-
-// UnmarshalText implements the text unmarshaller method.
-func (x *AccountPurpose) UnmarshalText(text []byte) error {
-	tmp, err := ParseAccountPurpose(string(text))
-	if err != nil {
-		return err
-	}
-	*x = tmp
-	return nil
-}
-
-// AppendText appends the textual representation of itself to the end of b
-// (allocating a larger slice if necessary) and returns the updated slice.
-//
-// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
-func (x *AccountPurpose) AppendText(b []byte) ([]byte, error) {
-	return append(b, x.String()...), nil
-}
+// BusinessType represents the legal structure of a business entity.
+type BusinessType string
 
 const (
 	// BusinessTypeCooperative is a BusinessType of type cooperative.
@@ -162,8 +62,7 @@ func (x BusinessType) String() string {
 	return string(x)
 }
 
-// IsValid provides a quick way to determine if the typed value is
-// part of the allowed enumerated values
+// IsValid reports whether x is one of the enumerated values, ignoring case.
 func (x BusinessType) IsValid() bool {
 	_, err := ParseBusinessType(string(x))
 	return err == nil
@@ -177,24 +76,23 @@ var _BusinessTypeValue = map[string]BusinessType{
 	"sole_proprietorship": BusinessTypeSoleProprietorship,
 }
 
-// ParseBusinessType attempts to convert a string to a BusinessType.
+// ParseBusinessType converts a string to a BusinessType, ignoring case.
 func ParseBusinessType(name string) (BusinessType, error) {
 	if x, ok := _BusinessTypeValue[name]; ok {
 		return x, nil
 	}
-	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
 	if x, ok := _BusinessTypeValue[strings.ToLower(name)]; ok {
 		return x, nil
 	}
 	return BusinessType(""), fmt.Errorf("%s is %w", name, ErrInvalidBusinessType)
 }
 
-// MarshalText implements the text marshaller method.
+// MarshalText implements encoding.TextMarshaler.
 func (x BusinessType) MarshalText() ([]byte, error) {
 	return []byte(string(x)), nil
 }
 
-// UnmarshalText implements the text unmarshaller method.
+// UnmarshalText implements encoding.TextUnmarshaler. It rejects unknown values.
 func (x *BusinessType) UnmarshalText(text []byte) error {
 	tmp, err := ParseBusinessType(string(text))
 	if err != nil {
@@ -204,148 +102,83 @@ func (x *BusinessType) UnmarshalText(text []byte) error {
 	return nil
 }
 
-// AppendText appends the textual representation of itself to the end of b
-// (allocating a larger slice if necessary) and returns the updated slice.
-//
-// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+// AppendText implements encoding.TextAppender.
 func (x *BusinessType) AppendText(b []byte) ([]byte, error) {
 	return append(b, x.String()...), nil
 }
 
+// UnmarshalJSON implements json.Unmarshaler. It accepts only a JSON string holding one
+// of the enumerated values; null leaves x unchanged.
+func (x *BusinessType) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("%s is %w", data, ErrInvalidBusinessType)
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Gender represents the gender of an associated person.
+type Gender string
+
 const (
-	// DocumentTypeAmlComfortLetter is a DocumentType of type aml_comfort_letter.
-	DocumentTypeAmlComfortLetter DocumentType = "aml_comfort_letter"
-	// DocumentTypeConstitutionalDocument is a DocumentType of type constitutional_document.
-	DocumentTypeConstitutionalDocument DocumentType = "constitutional_document"
-	// DocumentTypeDirectorsRegistry is a DocumentType of type directors_registry.
-	DocumentTypeDirectorsRegistry DocumentType = "directors_registry"
-	// DocumentTypeESignatureCertificate is a DocumentType of type e_signature_certificate.
-	DocumentTypeESignatureCertificate DocumentType = "e_signature_certificate"
-	// DocumentTypeEvidenceOfGoodStanding is a DocumentType of type evidence_of_good_standing.
-	DocumentTypeEvidenceOfGoodStanding DocumentType = "evidence_of_good_standing"
-	// DocumentTypeFlowOfFunds is a DocumentType of type flow_of_funds.
-	DocumentTypeFlowOfFunds DocumentType = "flow_of_funds"
-	// DocumentTypeFormationDocument is a DocumentType of type formation_document.
-	DocumentTypeFormationDocument DocumentType = "formation_document"
-	// DocumentTypeMarketingMaterials is a DocumentType of type marketing_materials.
-	DocumentTypeMarketingMaterials DocumentType = "marketing_materials"
-	// DocumentTypeOther is a DocumentType of type other.
-	DocumentTypeOther DocumentType = "other"
-	// DocumentTypeOwnershipChart is a DocumentType of type ownership_chart.
-	DocumentTypeOwnershipChart DocumentType = "ownership_chart"
-	// DocumentTypeOwnershipInformation is a DocumentType of type ownership_information.
-	DocumentTypeOwnershipInformation DocumentType = "ownership_information"
-	// DocumentTypeProofOfAccountPurpose is a DocumentType of type proof_of_account_purpose.
-	DocumentTypeProofOfAccountPurpose DocumentType = "proof_of_account_purpose"
-	// DocumentTypeProofOfAddress is a DocumentType of type proof_of_address.
-	DocumentTypeProofOfAddress DocumentType = "proof_of_address"
-	// DocumentTypeProofOfEntityNameChange is a DocumentType of type proof_of_entity_name_change.
-	DocumentTypeProofOfEntityNameChange DocumentType = "proof_of_entity_name_change"
-	// DocumentTypeProofOfNatureOfBusiness is a DocumentType of type proof_of_nature_of_business.
-	DocumentTypeProofOfNatureOfBusiness DocumentType = "proof_of_nature_of_business"
-	// DocumentTypeProofOfSignatoryAuthority is a DocumentType of type proof_of_signatory_authority.
-	DocumentTypeProofOfSignatoryAuthority DocumentType = "proof_of_signatory_authority"
-	// DocumentTypeProofOfSourceOfFunds is a DocumentType of type proof_of_source_of_funds.
-	DocumentTypeProofOfSourceOfFunds DocumentType = "proof_of_source_of_funds"
-	// DocumentTypeProofOfSourceOfWealth is a DocumentType of type proof_of_source_of_wealth.
-	DocumentTypeProofOfSourceOfWealth DocumentType = "proof_of_source_of_wealth"
-	// DocumentTypeProofOfTaxIdentification is a DocumentType of type proof_of_tax_identification.
-	DocumentTypeProofOfTaxIdentification DocumentType = "proof_of_tax_identification"
-	// DocumentTypeRegistrationDocument is a DocumentType of type registration_document.
-	DocumentTypeRegistrationDocument DocumentType = "registration_document"
-	// DocumentTypeShareholderRegister is a DocumentType of type shareholder_register.
-	DocumentTypeShareholderRegister DocumentType = "shareholder_register"
+	// GenderMale is a Gender of type male.
+	GenderMale Gender = "male"
+	// GenderFemale is a Gender of type female.
+	GenderFemale Gender = "female"
 )
 
-var ErrInvalidDocumentType = fmt.Errorf("not a valid DocumentType, try [%s]", strings.Join(_DocumentTypeNames, ", "))
+var ErrInvalidGender = fmt.Errorf("not a valid Gender, try [%s]", strings.Join(_GenderNames, ", "))
 
-var _DocumentTypeNames = []string{
-	string(DocumentTypeAmlComfortLetter),
-	string(DocumentTypeConstitutionalDocument),
-	string(DocumentTypeDirectorsRegistry),
-	string(DocumentTypeESignatureCertificate),
-	string(DocumentTypeEvidenceOfGoodStanding),
-	string(DocumentTypeFlowOfFunds),
-	string(DocumentTypeFormationDocument),
-	string(DocumentTypeMarketingMaterials),
-	string(DocumentTypeOther),
-	string(DocumentTypeOwnershipChart),
-	string(DocumentTypeOwnershipInformation),
-	string(DocumentTypeProofOfAccountPurpose),
-	string(DocumentTypeProofOfAddress),
-	string(DocumentTypeProofOfEntityNameChange),
-	string(DocumentTypeProofOfNatureOfBusiness),
-	string(DocumentTypeProofOfSignatoryAuthority),
-	string(DocumentTypeProofOfSourceOfFunds),
-	string(DocumentTypeProofOfSourceOfWealth),
-	string(DocumentTypeProofOfTaxIdentification),
-	string(DocumentTypeRegistrationDocument),
-	string(DocumentTypeShareholderRegister),
+var _GenderNames = []string{
+	string(GenderMale),
+	string(GenderFemale),
 }
 
-// DocumentTypeNames returns a list of possible string values of DocumentType.
-func DocumentTypeNames() []string {
-	tmp := make([]string, len(_DocumentTypeNames))
-	copy(tmp, _DocumentTypeNames)
+// GenderNames returns a list of possible string values of Gender.
+func GenderNames() []string {
+	tmp := make([]string, len(_GenderNames))
+	copy(tmp, _GenderNames)
 	return tmp
 }
 
 // String implements the Stringer interface.
-func (x DocumentType) String() string {
+func (x Gender) String() string {
 	return string(x)
 }
 
-// IsValid provides a quick way to determine if the typed value is
-// part of the allowed enumerated values
-func (x DocumentType) IsValid() bool {
-	_, err := ParseDocumentType(string(x))
+// IsValid reports whether x is one of the enumerated values, ignoring case.
+func (x Gender) IsValid() bool {
+	_, err := ParseGender(string(x))
 	return err == nil
 }
 
-var _DocumentTypeValue = map[string]DocumentType{
-	"aml_comfort_letter":           DocumentTypeAmlComfortLetter,
-	"constitutional_document":      DocumentTypeConstitutionalDocument,
-	"directors_registry":           DocumentTypeDirectorsRegistry,
-	"e_signature_certificate":      DocumentTypeESignatureCertificate,
-	"evidence_of_good_standing":    DocumentTypeEvidenceOfGoodStanding,
-	"flow_of_funds":                DocumentTypeFlowOfFunds,
-	"formation_document":           DocumentTypeFormationDocument,
-	"marketing_materials":          DocumentTypeMarketingMaterials,
-	"other":                        DocumentTypeOther,
-	"ownership_chart":              DocumentTypeOwnershipChart,
-	"ownership_information":        DocumentTypeOwnershipInformation,
-	"proof_of_account_purpose":     DocumentTypeProofOfAccountPurpose,
-	"proof_of_address":             DocumentTypeProofOfAddress,
-	"proof_of_entity_name_change":  DocumentTypeProofOfEntityNameChange,
-	"proof_of_nature_of_business":  DocumentTypeProofOfNatureOfBusiness,
-	"proof_of_signatory_authority": DocumentTypeProofOfSignatoryAuthority,
-	"proof_of_source_of_funds":     DocumentTypeProofOfSourceOfFunds,
-	"proof_of_source_of_wealth":    DocumentTypeProofOfSourceOfWealth,
-	"proof_of_tax_identification":  DocumentTypeProofOfTaxIdentification,
-	"registration_document":        DocumentTypeRegistrationDocument,
-	"shareholder_register":         DocumentTypeShareholderRegister,
+var _GenderValue = map[string]Gender{
+	"male":   GenderMale,
+	"female": GenderFemale,
 }
 
-// ParseDocumentType attempts to convert a string to a DocumentType.
-func ParseDocumentType(name string) (DocumentType, error) {
-	if x, ok := _DocumentTypeValue[name]; ok {
+// ParseGender converts a string to a Gender, ignoring case.
+func ParseGender(name string) (Gender, error) {
+	if x, ok := _GenderValue[name]; ok {
 		return x, nil
 	}
-	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
-	if x, ok := _DocumentTypeValue[strings.ToLower(name)]; ok {
+	if x, ok := _GenderValue[strings.ToLower(name)]; ok {
 		return x, nil
 	}
-	return DocumentType(""), fmt.Errorf("%s is %w", name, ErrInvalidDocumentType)
+	return Gender(""), fmt.Errorf("%s is %w", name, ErrInvalidGender)
 }
 
-// MarshalText implements the text marshaller method.
-func (x DocumentType) MarshalText() ([]byte, error) {
+// MarshalText implements encoding.TextMarshaler.
+func (x Gender) MarshalText() ([]byte, error) {
 	return []byte(string(x)), nil
 }
 
-// UnmarshalText implements the text unmarshaller method.
-func (x *DocumentType) UnmarshalText(text []byte) error {
-	tmp, err := ParseDocumentType(string(text))
+// UnmarshalText implements encoding.TextUnmarshaler. It rejects unknown values.
+func (x *Gender) UnmarshalText(text []byte) error {
+	tmp, err := ParseGender(string(text))
 	if err != nil {
 		return err
 	}
@@ -353,100 +186,91 @@ func (x *DocumentType) UnmarshalText(text []byte) error {
 	return nil
 }
 
-// AppendText appends the textual representation of itself to the end of b
-// (allocating a larger slice if necessary) and returns the updated slice.
-//
-// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
-func (x *DocumentType) AppendText(b []byte) ([]byte, error) {
+// AppendText implements encoding.TextAppender.
+func (x *Gender) AppendText(b []byte) ([]byte, error) {
 	return append(b, x.String()...), nil
 }
 
+// UnmarshalJSON implements json.Unmarshaler. It accepts only a JSON string holding one
+// of the enumerated values; null leaves x unchanged.
+func (x *Gender) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("%s is %w", data, ErrInvalidGender)
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// IDType represents the type of identification document.
+type IDType string
+
 const (
-	// FileFormatJpeg is a FileFormat of type jpeg.
-	FileFormatJpeg FileFormat = "jpeg"
-	// FileFormatJpg is a FileFormat of type jpg.
-	FileFormatJpg FileFormat = "jpg"
-	// FileFormatPng is a FileFormat of type png.
-	FileFormatPng FileFormat = "png"
-	// FileFormatHeic is a FileFormat of type heic.
-	FileFormatHeic FileFormat = "heic"
-	// FileFormatTif is a FileFormat of type tif.
-	FileFormatTif FileFormat = "tif"
-	// FileFormatPdf is a FileFormat of type pdf.
-	FileFormatPdf FileFormat = "pdf"
-	// FileFormatCsv is a FileFormat of type csv.
-	FileFormatCsv FileFormat = "csv"
-	// FileFormatXls is a FileFormat of type xls.
-	FileFormatXls FileFormat = "xls"
-	// FileFormatXlsx is a FileFormat of type xlsx.
-	FileFormatXlsx FileFormat = "xlsx"
+	// IDTypeDriversLicense is an IDType of type drivers_license.
+	IDTypeDriversLicense IDType = "drivers_license"
+	// IDTypePassport is an IDType of type passport.
+	IDTypePassport IDType = "passport"
+	// IDTypeNationalId is an IDType of type national_id.
+	IDTypeNationalId IDType = "national_id"
+	// IDTypeStateId is an IDType of type state_id.
+	IDTypeStateId IDType = "state_id"
 )
 
-var ErrInvalidFileFormat = fmt.Errorf("not a valid FileFormat, try [%s]", strings.Join(_FileFormatNames, ", "))
+var ErrInvalidIDType = fmt.Errorf("not a valid IDType, try [%s]", strings.Join(_IDTypeNames, ", "))
 
-var _FileFormatNames = []string{
-	string(FileFormatJpeg),
-	string(FileFormatJpg),
-	string(FileFormatPng),
-	string(FileFormatHeic),
-	string(FileFormatTif),
-	string(FileFormatPdf),
-	string(FileFormatCsv),
-	string(FileFormatXls),
-	string(FileFormatXlsx),
+var _IDTypeNames = []string{
+	string(IDTypeDriversLicense),
+	string(IDTypePassport),
+	string(IDTypeNationalId),
+	string(IDTypeStateId),
 }
 
-// FileFormatNames returns a list of possible string values of FileFormat.
-func FileFormatNames() []string {
-	tmp := make([]string, len(_FileFormatNames))
-	copy(tmp, _FileFormatNames)
+// IDTypeNames returns a list of possible string values of IDType.
+func IDTypeNames() []string {
+	tmp := make([]string, len(_IDTypeNames))
+	copy(tmp, _IDTypeNames)
 	return tmp
 }
 
 // String implements the Stringer interface.
-func (x FileFormat) String() string {
+func (x IDType) String() string {
 	return string(x)
 }
 
-// IsValid provides a quick way to determine if the typed value is
-// part of the allowed enumerated values
-func (x FileFormat) IsValid() bool {
-	_, err := ParseFileFormat(string(x))
+// IsValid reports whether x is one of the enumerated values, ignoring case.
+func (x IDType) IsValid() bool {
+	_, err := ParseIDType(string(x))
 	return err == nil
 }
 
-var _FileFormatValue = map[string]FileFormat{
-	"jpeg": FileFormatJpeg,
-	"jpg":  FileFormatJpg,
-	"png":  FileFormatPng,
-	"heic": FileFormatHeic,
-	"tif":  FileFormatTif,
-	"pdf":  FileFormatPdf,
-	"csv":  FileFormatCsv,
-	"xls":  FileFormatXls,
-	"xlsx": FileFormatXlsx,
+var _IDTypeValue = map[string]IDType{
+	"drivers_license": IDTypeDriversLicense,
+	"passport":        IDTypePassport,
+	"national_id":     IDTypeNationalId,
+	"state_id":        IDTypeStateId,
 }
 
-// ParseFileFormat attempts to convert a string to a FileFormat.
-func ParseFileFormat(name string) (FileFormat, error) {
-	if x, ok := _FileFormatValue[name]; ok {
+// ParseIDType converts a string to a IDType, ignoring case.
+func ParseIDType(name string) (IDType, error) {
+	if x, ok := _IDTypeValue[name]; ok {
 		return x, nil
 	}
-	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
-	if x, ok := _FileFormatValue[strings.ToLower(name)]; ok {
+	if x, ok := _IDTypeValue[strings.ToLower(name)]; ok {
 		return x, nil
 	}
-	return FileFormat(""), fmt.Errorf("%s is %w", name, ErrInvalidFileFormat)
+	return IDType(""), fmt.Errorf("%s is %w", name, ErrInvalidIDType)
 }
 
-// MarshalText implements the text marshaller method.
-func (x FileFormat) MarshalText() ([]byte, error) {
+// MarshalText implements encoding.TextMarshaler.
+func (x IDType) MarshalText() ([]byte, error) {
 	return []byte(string(x)), nil
 }
 
-// UnmarshalText implements the text unmarshaller method.
-func (x *FileFormat) UnmarshalText(text []byte) error {
-	tmp, err := ParseFileFormat(string(text))
+// UnmarshalText implements encoding.TextUnmarshaler. It rejects unknown values.
+func (x *IDType) UnmarshalText(text []byte) error {
+	tmp, err := ParseIDType(string(text))
 	if err != nil {
 		return err
 	}
@@ -454,72 +278,127 @@ func (x *FileFormat) UnmarshalText(text []byte) error {
 	return nil
 }
 
-// AppendText appends the textual representation of itself to the end of b
-// (allocating a larger slice if necessary) and returns the updated slice.
-//
-// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
-func (x *FileFormat) AppendText(b []byte) ([]byte, error) {
+// AppendText implements encoding.TextAppender.
+func (x *IDType) AppendText(b []byte) ([]byte, error) {
 	return append(b, x.String()...), nil
 }
 
+// UnmarshalJSON implements json.Unmarshaler. It accepts only a JSON string holding one
+// of the enumerated values; null leaves x unchanged.
+func (x *IDType) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("%s is %w", data, ErrInvalidIDType)
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// AccountPurpose represents the primary purpose of the customer account.
+type AccountPurpose string
+
 const (
-	// GenderMale is a Gender of type male.
-	GenderMale Gender = "male"
-	// GenderFemale is a Gender of type female.
-	GenderFemale Gender = "female"
+	// AccountPurposeCharitableDonations is an AccountPurpose of type charitable_donations.
+	AccountPurposeCharitableDonations AccountPurpose = "charitable_donations"
+	// AccountPurposeEcommerceRetailPayments is an AccountPurpose of type ecommerce_retail_payments.
+	AccountPurposeEcommerceRetailPayments AccountPurpose = "ecommerce_retail_payments"
+	// AccountPurposeInvestmentPurposes is an AccountPurpose of type investment_purposes.
+	AccountPurposeInvestmentPurposes AccountPurpose = "investment_purposes"
+	// AccountPurposeOther is an AccountPurpose of type other.
+	AccountPurposeOther AccountPurpose = "other"
+	// AccountPurposePaymentsToFriendsOrFamilyAbroad is an AccountPurpose of type payments_to_friends_or_family_abroad.
+	AccountPurposePaymentsToFriendsOrFamilyAbroad AccountPurpose = "payments_to_friends_or_family_abroad"
+	// AccountPurposePayroll is an AccountPurpose of type payroll.
+	AccountPurposePayroll AccountPurpose = "payroll"
+	// AccountPurposePersonalOrLivingExpenses is an AccountPurpose of type personal_or_living_expenses.
+	AccountPurposePersonalOrLivingExpenses AccountPurpose = "personal_or_living_expenses"
+	// AccountPurposeProtectWealth is an AccountPurpose of type protect_wealth.
+	AccountPurposeProtectWealth AccountPurpose = "protect_wealth"
+	// AccountPurposePurchaseGoodsAndServices is an AccountPurpose of type purchase_goods_and_services.
+	AccountPurposePurchaseGoodsAndServices AccountPurpose = "purchase_goods_and_services"
+	// AccountPurposeReceivePaymentsForGoodsAndServices is an AccountPurpose of type receive_payments_for_goods_and_services.
+	AccountPurposeReceivePaymentsForGoodsAndServices AccountPurpose = "receive_payments_for_goods_and_services"
+	// AccountPurposeTaxOptimization is an AccountPurpose of type tax_optimization.
+	AccountPurposeTaxOptimization AccountPurpose = "tax_optimization"
+	// AccountPurposeThirdPartyMoneyTransmission is an AccountPurpose of type third_party_money_transmission.
+	AccountPurposeThirdPartyMoneyTransmission AccountPurpose = "third_party_money_transmission"
+	// AccountPurposeTreasuryManagement is an AccountPurpose of type treasury_management.
+	AccountPurposeTreasuryManagement AccountPurpose = "treasury_management"
 )
 
-var ErrInvalidGender = fmt.Errorf("not a valid Gender, try [%s]", strings.Join(_GenderNames, ", "))
+var ErrInvalidAccountPurpose = fmt.Errorf("not a valid AccountPurpose, try [%s]", strings.Join(_AccountPurposeNames, ", "))
 
-var _GenderNames = []string{
-	string(GenderMale),
-	string(GenderFemale),
+var _AccountPurposeNames = []string{
+	string(AccountPurposeCharitableDonations),
+	string(AccountPurposeEcommerceRetailPayments),
+	string(AccountPurposeInvestmentPurposes),
+	string(AccountPurposeOther),
+	string(AccountPurposePaymentsToFriendsOrFamilyAbroad),
+	string(AccountPurposePayroll),
+	string(AccountPurposePersonalOrLivingExpenses),
+	string(AccountPurposeProtectWealth),
+	string(AccountPurposePurchaseGoodsAndServices),
+	string(AccountPurposeReceivePaymentsForGoodsAndServices),
+	string(AccountPurposeTaxOptimization),
+	string(AccountPurposeThirdPartyMoneyTransmission),
+	string(AccountPurposeTreasuryManagement),
 }
 
-// GenderNames returns a list of possible string values of Gender.
-func GenderNames() []string {
-	tmp := make([]string, len(_GenderNames))
-	copy(tmp, _GenderNames)
+// AccountPurposeNames returns a list of possible string values of AccountPurpose.
+func AccountPurposeNames() []string {
+	tmp := make([]string, len(_AccountPurposeNames))
+	copy(tmp, _AccountPurposeNames)
 	return tmp
 }
 
 // String implements the Stringer interface.
-func (x Gender) String() string {
+func (x AccountPurpose) String() string {
 	return string(x)
 }
 
-// IsValid provides a quick way to determine if the typed value is
-// part of the allowed enumerated values
-func (x Gender) IsValid() bool {
-	_, err := ParseGender(string(x))
+// IsValid reports whether x is one of the enumerated values, ignoring case.
+func (x AccountPurpose) IsValid() bool {
+	_, err := ParseAccountPurpose(string(x))
 	return err == nil
 }
 
-var _GenderValue = map[string]Gender{
-	"male":   GenderMale,
-	"female": GenderFemale,
+var _AccountPurposeValue = map[string]AccountPurpose{
+	"charitable_donations":                    AccountPurposeCharitableDonations,
+	"ecommerce_retail_payments":               AccountPurposeEcommerceRetailPayments,
+	"investment_purposes":                     AccountPurposeInvestmentPurposes,
+	"other":                                   AccountPurposeOther,
+	"payments_to_friends_or_family_abroad":    AccountPurposePaymentsToFriendsOrFamilyAbroad,
+	"payroll":                                 AccountPurposePayroll,
+	"personal_or_living_expenses":             AccountPurposePersonalOrLivingExpenses,
+	"protect_wealth":                          AccountPurposeProtectWealth,
+	"purchase_goods_and_services":             AccountPurposePurchaseGoodsAndServices,
+	"receive_payments_for_goods_and_services": AccountPurposeReceivePaymentsForGoodsAndServices,
+	"tax_optimization":                        AccountPurposeTaxOptimization,
+	"third_party_money_transmission":          AccountPurposeThirdPartyMoneyTransmission,
+	"treasury_management":                     AccountPurposeTreasuryManagement,
 }
 
-// ParseGender attempts to convert a string to a Gender.
-func ParseGender(name string) (Gender, error) {
-	if x, ok := _GenderValue[name]; ok {
+// ParseAccountPurpose converts a string to a AccountPurpose, ignoring case.
+func ParseAccountPurpose(name string) (AccountPurpose, error) {
+	if x, ok := _AccountPurposeValue[name]; ok {
 		return x, nil
 	}
-	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
-	if x, ok := _GenderValue[strings.ToLower(name)]; ok {
+	if x, ok := _AccountPurposeValue[strings.ToLower(name)]; ok {
 		return x, nil
 	}
-	return Gender(""), fmt.Errorf("%s is %w", name, ErrInvalidGender)
+	return AccountPurpose(""), fmt.Errorf("%s is %w", name, ErrInvalidAccountPurpose)
 }
 
-// MarshalText implements the text marshaller method.
-func (x Gender) MarshalText() ([]byte, error) {
+// MarshalText implements encoding.TextMarshaler.
+func (x AccountPurpose) MarshalText() ([]byte, error) {
 	return []byte(string(x)), nil
 }
 
-// UnmarshalText implements the text unmarshaller method.
-func (x *Gender) UnmarshalText(text []byte) error {
-	tmp, err := ParseGender(string(text))
+// UnmarshalText implements encoding.TextUnmarshaler. It rejects unknown values.
+func (x *AccountPurpose) UnmarshalText(text []byte) error {
+	tmp, err := ParseAccountPurpose(string(text))
 	if err != nil {
 		return err
 	}
@@ -527,96 +406,95 @@ func (x *Gender) UnmarshalText(text []byte) error {
 	return nil
 }
 
-// AppendText appends the textual representation of itself to the end of b
-// (allocating a larger slice if necessary) and returns the updated slice.
-//
-// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
-func (x *Gender) AppendText(b []byte) ([]byte, error) {
+// AppendText implements encoding.TextAppender.
+func (x *AccountPurpose) AppendText(b []byte) ([]byte, error) {
 	return append(b, x.String()...), nil
 }
 
+// UnmarshalJSON implements json.Unmarshaler. It accepts only a JSON string holding one
+// of the enumerated values; null leaves x unchanged.
+func (x *AccountPurpose) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("%s is %w", data, ErrInvalidAccountPurpose)
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// MoneyRange represents a range of monetary amounts in USD.
+type MoneyRange string
+
 const (
-	// HighRiskActivityAdultEntertainment is a HighRiskActivity of type adult_entertainment.
-	HighRiskActivityAdultEntertainment HighRiskActivity = "adult_entertainment"
-	// HighRiskActivityCannabis is a HighRiskActivity of type cannabis.
-	HighRiskActivityCannabis HighRiskActivity = "cannabis"
-	// HighRiskActivityCryptocurrency is a HighRiskActivity of type cryptocurrency.
-	HighRiskActivityCryptocurrency HighRiskActivity = "cryptocurrency"
-	// HighRiskActivityGambling is a HighRiskActivity of type gambling.
-	HighRiskActivityGambling HighRiskActivity = "gambling"
-	// HighRiskActivityMoneyServices is a HighRiskActivity of type money_services.
-	HighRiskActivityMoneyServices HighRiskActivity = "money_services"
-	// HighRiskActivityPreciousMetals is a HighRiskActivity of type precious_metals.
-	HighRiskActivityPreciousMetals HighRiskActivity = "precious_metals"
-	// HighRiskActivityWeapons is a HighRiskActivity of type weapons.
-	HighRiskActivityWeapons HighRiskActivity = "weapons"
-	// HighRiskActivityNone is a HighRiskActivity of type none.
-	HighRiskActivityNone HighRiskActivity = "none"
+	// MoneyRange099999 is a MoneyRange of type 0_99999.
+	MoneyRange099999 MoneyRange = "0_99999"
+	// MoneyRange100000499999 is a MoneyRange of type 100000_499999.
+	MoneyRange100000499999 MoneyRange = "100000_499999"
+	// MoneyRange500000999999 is a MoneyRange of type 500000_999999.
+	MoneyRange500000999999 MoneyRange = "500000_999999"
+	// MoneyRange10000004999999 is a MoneyRange of type 1000000_4999999.
+	MoneyRange10000004999999 MoneyRange = "1000000_4999999"
+	// MoneyRange5000000Plus is a MoneyRange of type 5000000_plus.
+	MoneyRange5000000Plus MoneyRange = "5000000_plus"
 )
 
-var ErrInvalidHighRiskActivity = fmt.Errorf("not a valid HighRiskActivity, try [%s]", strings.Join(_HighRiskActivityNames, ", "))
+var ErrInvalidMoneyRange = fmt.Errorf("not a valid MoneyRange, try [%s]", strings.Join(_MoneyRangeNames, ", "))
 
-var _HighRiskActivityNames = []string{
-	string(HighRiskActivityAdultEntertainment),
-	string(HighRiskActivityCannabis),
-	string(HighRiskActivityCryptocurrency),
-	string(HighRiskActivityGambling),
-	string(HighRiskActivityMoneyServices),
-	string(HighRiskActivityPreciousMetals),
-	string(HighRiskActivityWeapons),
-	string(HighRiskActivityNone),
+var _MoneyRangeNames = []string{
+	string(MoneyRange099999),
+	string(MoneyRange100000499999),
+	string(MoneyRange500000999999),
+	string(MoneyRange10000004999999),
+	string(MoneyRange5000000Plus),
 }
 
-// HighRiskActivityNames returns a list of possible string values of HighRiskActivity.
-func HighRiskActivityNames() []string {
-	tmp := make([]string, len(_HighRiskActivityNames))
-	copy(tmp, _HighRiskActivityNames)
+// MoneyRangeNames returns a list of possible string values of MoneyRange.
+func MoneyRangeNames() []string {
+	tmp := make([]string, len(_MoneyRangeNames))
+	copy(tmp, _MoneyRangeNames)
 	return tmp
 }
 
 // String implements the Stringer interface.
-func (x HighRiskActivity) String() string {
+func (x MoneyRange) String() string {
 	return string(x)
 }
 
-// IsValid provides a quick way to determine if the typed value is
-// part of the allowed enumerated values
-func (x HighRiskActivity) IsValid() bool {
-	_, err := ParseHighRiskActivity(string(x))
+// IsValid reports whether x is one of the enumerated values, ignoring case.
+func (x MoneyRange) IsValid() bool {
+	_, err := ParseMoneyRange(string(x))
 	return err == nil
 }
 
-var _HighRiskActivityValue = map[string]HighRiskActivity{
-	"adult_entertainment": HighRiskActivityAdultEntertainment,
-	"cannabis":            HighRiskActivityCannabis,
-	"cryptocurrency":      HighRiskActivityCryptocurrency,
-	"gambling":            HighRiskActivityGambling,
-	"money_services":      HighRiskActivityMoneyServices,
-	"precious_metals":     HighRiskActivityPreciousMetals,
-	"weapons":             HighRiskActivityWeapons,
-	"none":                HighRiskActivityNone,
+var _MoneyRangeValue = map[string]MoneyRange{
+	"0_99999":         MoneyRange099999,
+	"100000_499999":   MoneyRange100000499999,
+	"500000_999999":   MoneyRange500000999999,
+	"1000000_4999999": MoneyRange10000004999999,
+	"5000000_plus":    MoneyRange5000000Plus,
 }
 
-// ParseHighRiskActivity attempts to convert a string to a HighRiskActivity.
-func ParseHighRiskActivity(name string) (HighRiskActivity, error) {
-	if x, ok := _HighRiskActivityValue[name]; ok {
+// ParseMoneyRange converts a string to a MoneyRange, ignoring case.
+func ParseMoneyRange(name string) (MoneyRange, error) {
+	if x, ok := _MoneyRangeValue[name]; ok {
 		return x, nil
 	}
-	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
-	if x, ok := _HighRiskActivityValue[strings.ToLower(name)]; ok {
+	if x, ok := _MoneyRangeValue[strings.ToLower(name)]; ok {
 		return x, nil
 	}
-	return HighRiskActivity(""), fmt.Errorf("%s is %w", name, ErrInvalidHighRiskActivity)
+	return MoneyRange(""), fmt.Errorf("%s is %w", name, ErrInvalidMoneyRange)
 }
 
-// MarshalText implements the text marshaller method.
-func (x HighRiskActivity) MarshalText() ([]byte, error) {
+// MarshalText implements encoding.TextMarshaler.
+func (x MoneyRange) MarshalText() ([]byte, error) {
 	return []byte(string(x)), nil
 }
 
-// UnmarshalText implements the text unmarshaller method.
-func (x *HighRiskActivity) UnmarshalText(text []byte) error {
-	tmp, err := ParseHighRiskActivity(string(text))
+// UnmarshalText implements encoding.TextUnmarshaler. It rejects unknown values.
+func (x *MoneyRange) UnmarshalText(text []byte) error {
+	tmp, err := ParseMoneyRange(string(text))
 	if err != nil {
 		return err
 	}
@@ -624,165 +502,159 @@ func (x *HighRiskActivity) UnmarshalText(text []byte) error {
 	return nil
 }
 
-// AppendText appends the textual representation of itself to the end of b
-// (allocating a larger slice if necessary) and returns the updated slice.
-//
-// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
-func (x *HighRiskActivity) AppendText(b []byte) ([]byte, error) {
+// AppendText implements encoding.TextAppender.
+func (x *MoneyRange) AppendText(b []byte) ([]byte, error) {
 	return append(b, x.String()...), nil
 }
 
-const (
-	// IDTypeDriversLicense is a IDType of type drivers_license.
-	IDTypeDriversLicense IDType = "drivers_license"
-	// IDTypePassport is a IDType of type passport.
-	IDTypePassport IDType = "passport"
-	// IDTypeNationalId is a IDType of type national_id.
-	IDTypeNationalId IDType = "national_id"
-	// IDTypeStateId is a IDType of type state_id.
-	IDTypeStateId IDType = "state_id"
-)
+// UnmarshalJSON implements json.Unmarshaler. It accepts only a JSON string holding one
+// of the enumerated values; null leaves x unchanged.
+func (x *MoneyRange) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("%s is %w", data, ErrInvalidMoneyRange)
+	}
+	return x.UnmarshalText([]byte(s))
+}
 
-var ErrInvalidIDType = fmt.Errorf("not a valid IDType, try [%s]", strings.Join(_IDTypeNames, ", "))
-
-var _IDTypeNames = []string{
-	string(IDTypeDriversLicense),
-	string(IDTypePassport),
-	string(IDTypeNationalId),
-	string(IDTypeStateId),
-}
-
-// IDTypeNames returns a list of possible string values of IDType.
-func IDTypeNames() []string {
-	tmp := make([]string, len(_IDTypeNames))
-	copy(tmp, _IDTypeNames)
-	return tmp
-}
-
-// String implements the Stringer interface.
-func (x IDType) String() string {
-	return string(x)
-}
-
-// IsValid provides a quick way to determine if the typed value is
-// part of the allowed enumerated values
-func (x IDType) IsValid() bool {
-	_, err := ParseIDType(string(x))
-	return err == nil
-}
-
-var _IDTypeValue = map[string]IDType{
-	"drivers_license": IDTypeDriversLicense,
-	"passport":        IDTypePassport,
-	"national_id":     IDTypeNationalId,
-	"state_id":        IDTypeStateId,
-}
-
-// ParseIDType attempts to convert a string to a IDType.
-func ParseIDType(name string) (IDType, error) {
-	if x, ok := _IDTypeValue[name]; ok {
-		return x, nil
-	}
-	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
-	if x, ok := _IDTypeValue[strings.ToLower(name)]; ok {
-		return x, nil
-	}
-	return IDType(""), fmt.Errorf("%s is %w", name, ErrInvalidIDType)
-}
-
-// MarshalText implements the text marshaller method.
-func (x IDType) MarshalText() ([]byte, error) {
-	return []byte(string(x)), nil
-}
-
-// UnmarshalText implements the text unmarshaller method.
-func (x *IDType) UnmarshalText(text []byte) error {
-	tmp, err := ParseIDType(string(text))
-	if err != nil {
-		return err
-	}
-	*x = tmp
-	return nil
-}
-
-// AppendText appends the textual representation of itself to the end of b
-// (allocating a larger slice if necessary) and returns the updated slice.
-//
-// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
-func (x *IDType) AppendText(b []byte) ([]byte, error) {
-	return append(b, x.String()...), nil
-}
+// DocumentType represents the type of business document.
+type DocumentType string
 
 const (
-	// ImageFormatJpeg is a ImageFormat of type jpeg.
-	ImageFormatJpeg ImageFormat = "jpeg"
-	// ImageFormatJpg is a ImageFormat of type jpg.
-	ImageFormatJpg ImageFormat = "jpg"
-	// ImageFormatPng is a ImageFormat of type png.
-	ImageFormatPng ImageFormat = "png"
-	// ImageFormatHeic is a ImageFormat of type heic.
-	ImageFormatHeic ImageFormat = "heic"
-	// ImageFormatTif is a ImageFormat of type tif.
-	ImageFormatTif ImageFormat = "tif"
+	// DocumentTypeAmlComfortLetter is a DocumentType of type aml_comfort_letter.
+	DocumentTypeAmlComfortLetter DocumentType = "aml_comfort_letter"
+	// DocumentTypeConstitutionalDocument is a DocumentType of type constitutional_document.
+	DocumentTypeConstitutionalDocument DocumentType = "constitutional_document"
+	// DocumentTypeDirectorsRegistry is a DocumentType of type directors_registry.
+	DocumentTypeDirectorsRegistry DocumentType = "directors_registry"
+	// DocumentTypeESignatureCertificate is a DocumentType of type e_signature_certificate.
+	DocumentTypeESignatureCertificate DocumentType = "e_signature_certificate"
+	// DocumentTypeEvidenceOfGoodStanding is a DocumentType of type evidence_of_good_standing.
+	DocumentTypeEvidenceOfGoodStanding DocumentType = "evidence_of_good_standing"
+	// DocumentTypeFlowOfFunds is a DocumentType of type flow_of_funds.
+	DocumentTypeFlowOfFunds DocumentType = "flow_of_funds"
+	// DocumentTypeFormationDocument is a DocumentType of type formation_document.
+	DocumentTypeFormationDocument DocumentType = "formation_document"
+	// DocumentTypeMarketingMaterials is a DocumentType of type marketing_materials.
+	DocumentTypeMarketingMaterials DocumentType = "marketing_materials"
+	// DocumentTypeOther is a DocumentType of type other.
+	DocumentTypeOther DocumentType = "other"
+	// DocumentTypeOwnershipChart is a DocumentType of type ownership_chart.
+	DocumentTypeOwnershipChart DocumentType = "ownership_chart"
+	// DocumentTypeOwnershipInformation is a DocumentType of type ownership_information.
+	DocumentTypeOwnershipInformation DocumentType = "ownership_information"
+	// DocumentTypeProofOfAccountPurpose is a DocumentType of type proof_of_account_purpose.
+	DocumentTypeProofOfAccountPurpose DocumentType = "proof_of_account_purpose"
+	// DocumentTypeProofOfAddress is a DocumentType of type proof_of_address.
+	DocumentTypeProofOfAddress DocumentType = "proof_of_address"
+	// DocumentTypeProofOfEntityNameChange is a DocumentType of type proof_of_entity_name_change.
+	DocumentTypeProofOfEntityNameChange DocumentType = "proof_of_entity_name_change"
+	// DocumentTypeProofOfNatureOfBusiness is a DocumentType of type proof_of_nature_of_business.
+	DocumentTypeProofOfNatureOfBusiness DocumentType = "proof_of_nature_of_business"
+	// DocumentTypeProofOfSignatoryAuthority is a DocumentType of type proof_of_signatory_authority.
+	DocumentTypeProofOfSignatoryAuthority DocumentType = "proof_of_signatory_authority"
+	// DocumentTypeProofOfSourceOfFunds is a DocumentType of type proof_of_source_of_funds.
+	DocumentTypeProofOfSourceOfFunds DocumentType = "proof_of_source_of_funds"
+	// DocumentTypeProofOfSourceOfWealth is a DocumentType of type proof_of_source_of_wealth.
+	DocumentTypeProofOfSourceOfWealth DocumentType = "proof_of_source_of_wealth"
+	// DocumentTypeProofOfTaxIdentification is a DocumentType of type proof_of_tax_identification.
+	DocumentTypeProofOfTaxIdentification DocumentType = "proof_of_tax_identification"
+	// DocumentTypeRegistrationDocument is a DocumentType of type registration_document.
+	DocumentTypeRegistrationDocument DocumentType = "registration_document"
+	// DocumentTypeShareholderRegister is a DocumentType of type shareholder_register.
+	DocumentTypeShareholderRegister DocumentType = "shareholder_register"
 )
 
-var ErrInvalidImageFormat = fmt.Errorf("not a valid ImageFormat, try [%s]", strings.Join(_ImageFormatNames, ", "))
+var ErrInvalidDocumentType = fmt.Errorf("not a valid DocumentType, try [%s]", strings.Join(_DocumentTypeNames, ", "))
 
-var _ImageFormatNames = []string{
-	string(ImageFormatJpeg),
-	string(ImageFormatJpg),
-	string(ImageFormatPng),
-	string(ImageFormatHeic),
-	string(ImageFormatTif),
+var _DocumentTypeNames = []string{
+	string(DocumentTypeAmlComfortLetter),
+	string(DocumentTypeConstitutionalDocument),
+	string(DocumentTypeDirectorsRegistry),
+	string(DocumentTypeESignatureCertificate),
+	string(DocumentTypeEvidenceOfGoodStanding),
+	string(DocumentTypeFlowOfFunds),
+	string(DocumentTypeFormationDocument),
+	string(DocumentTypeMarketingMaterials),
+	string(DocumentTypeOther),
+	string(DocumentTypeOwnershipChart),
+	string(DocumentTypeOwnershipInformation),
+	string(DocumentTypeProofOfAccountPurpose),
+	string(DocumentTypeProofOfAddress),
+	string(DocumentTypeProofOfEntityNameChange),
+	string(DocumentTypeProofOfNatureOfBusiness),
+	string(DocumentTypeProofOfSignatoryAuthority),
+	string(DocumentTypeProofOfSourceOfFunds),
+	string(DocumentTypeProofOfSourceOfWealth),
+	string(DocumentTypeProofOfTaxIdentification),
+	string(DocumentTypeRegistrationDocument),
+	string(DocumentTypeShareholderRegister),
 }
 
-// ImageFormatNames returns a list of possible string values of ImageFormat.
-func ImageFormatNames() []string {
-	tmp := make([]string, len(_ImageFormatNames))
-	copy(tmp, _ImageFormatNames)
+// DocumentTypeNames returns a list of possible string values of DocumentType.
+func DocumentTypeNames() []string {
+	tmp := make([]string, len(_DocumentTypeNames))
+	copy(tmp, _DocumentTypeNames)
 	return tmp
 }
 
 // String implements the Stringer interface.
-func (x ImageFormat) String() string {
+func (x DocumentType) String() string {
 	return string(x)
 }
 
-// IsValid provides a quick way to determine if the typed value is
-// part of the allowed enumerated values
-func (x ImageFormat) IsValid() bool {
-	_, err := ParseImageFormat(string(x))
+// IsValid reports whether x is one of the enumerated values, ignoring case.
+func (x DocumentType) IsValid() bool {
+	_, err := ParseDocumentType(string(x))
 	return err == nil
 }
 
-var _ImageFormatValue = map[string]ImageFormat{
-	"jpeg": ImageFormatJpeg,
-	"jpg":  ImageFormatJpg,
-	"png":  ImageFormatPng,
-	"heic": ImageFormatHeic,
-	"tif":  ImageFormatTif,
+var _DocumentTypeValue = map[string]DocumentType{
+	"aml_comfort_letter":           DocumentTypeAmlComfortLetter,
+	"constitutional_document":      DocumentTypeConstitutionalDocument,
+	"directors_registry":           DocumentTypeDirectorsRegistry,
+	"e_signature_certificate":      DocumentTypeESignatureCertificate,
+	"evidence_of_good_standing":    DocumentTypeEvidenceOfGoodStanding,
+	"flow_of_funds":                DocumentTypeFlowOfFunds,
+	"formation_document":           DocumentTypeFormationDocument,
+	"marketing_materials":          DocumentTypeMarketingMaterials,
+	"other":                        DocumentTypeOther,
+	"ownership_chart":              DocumentTypeOwnershipChart,
+	"ownership_information":        DocumentTypeOwnershipInformation,
+	"proof_of_account_purpose":     DocumentTypeProofOfAccountPurpose,
+	"proof_of_address":             DocumentTypeProofOfAddress,
+	"proof_of_entity_name_change":  DocumentTypeProofOfEntityNameChange,
+	"proof_of_nature_of_business":  DocumentTypeProofOfNatureOfBusiness,
+	"proof_of_signatory_authority": DocumentTypeProofOfSignatoryAuthority,
+	"proof_of_source_of_funds":     DocumentTypeProofOfSourceOfFunds,
+	"proof_of_source_of_wealth":    DocumentTypeProofOfSourceOfWealth,
+	"proof_of_tax_identification":  DocumentTypeProofOfTaxIdentification,
+	"registration_document":        DocumentTypeRegistrationDocument,
+	"shareholder_register":         DocumentTypeShareholderRegister,
 }
 
-// ParseImageFormat attempts to convert a string to a ImageFormat.
-func ParseImageFormat(name string) (ImageFormat, error) {
-	if x, ok := _ImageFormatValue[name]; ok {
+// ParseDocumentType converts a string to a DocumentType, ignoring case.
+func ParseDocumentType(name string) (DocumentType, error) {
+	if x, ok := _DocumentTypeValue[name]; ok {
 		return x, nil
 	}
-	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
-	if x, ok := _ImageFormatValue[strings.ToLower(name)]; ok {
+	if x, ok := _DocumentTypeValue[strings.ToLower(name)]; ok {
 		return x, nil
 	}
-	return ImageFormat(""), fmt.Errorf("%s is %w", name, ErrInvalidImageFormat)
+	return DocumentType(""), fmt.Errorf("%s is %w", name, ErrInvalidDocumentType)
 }
 
-// MarshalText implements the text marshaller method.
-func (x ImageFormat) MarshalText() ([]byte, error) {
+// MarshalText implements encoding.TextMarshaler.
+func (x DocumentType) MarshalText() ([]byte, error) {
 	return []byte(string(x)), nil
 }
 
-// UnmarshalText implements the text unmarshaller method.
-func (x *ImageFormat) UnmarshalText(text []byte) error {
-	tmp, err := ParseImageFormat(string(text))
+// UnmarshalText implements encoding.TextUnmarshaler. It rejects unknown values.
+func (x *DocumentType) UnmarshalText(text []byte) error {
+	tmp, err := ParseDocumentType(string(text))
 	if err != nil {
 		return err
 	}
@@ -790,181 +662,420 @@ func (x *ImageFormat) UnmarshalText(text []byte) error {
 	return nil
 }
 
-// AppendText appends the textual representation of itself to the end of b
-// (allocating a larger slice if necessary) and returns the updated slice.
-//
-// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
-func (x *ImageFormat) AppendText(b []byte) ([]byte, error) {
+// AppendText implements encoding.TextAppender.
+func (x *DocumentType) AppendText(b []byte) ([]byte, error) {
 	return append(b, x.String()...), nil
 }
 
-const (
-	// KybStatusInit is a KybStatus of type init.
-	KybStatusInit KybStatus = "init"
-	// KybStatusPendingReview is a KybStatus of type pending_review.
-	KybStatusPendingReview KybStatus = "pending_review"
-	// KybStatusUnderReview is a KybStatus of type under_review.
-	KybStatusUnderReview KybStatus = "under_review"
-	// KybStatusPendingResponse is a KybStatus of type pending_response.
-	KybStatusPendingResponse KybStatus = "pending_response"
-	// KybStatusEscalated is a KybStatus of type escalated.
-	KybStatusEscalated KybStatus = "escalated"
-	// KybStatusPendingApproval is a KybStatus of type pending_approval.
-	KybStatusPendingApproval KybStatus = "pending_approval"
-	// KybStatusRejected is a KybStatus of type rejected.
-	KybStatusRejected KybStatus = "rejected"
-	// KybStatusApproved is a KybStatus of type approved.
-	KybStatusApproved KybStatus = "approved"
-)
-
-var ErrInvalidKybStatus = fmt.Errorf("not a valid KybStatus, try [%s]", strings.Join(_KybStatusNames, ", "))
-
-var _KybStatusNames = []string{
-	string(KybStatusInit),
-	string(KybStatusPendingReview),
-	string(KybStatusUnderReview),
-	string(KybStatusPendingResponse),
-	string(KybStatusEscalated),
-	string(KybStatusPendingApproval),
-	string(KybStatusRejected),
-	string(KybStatusApproved),
-}
-
-// KybStatusNames returns a list of possible string values of KybStatus.
-func KybStatusNames() []string {
-	tmp := make([]string, len(_KybStatusNames))
-	copy(tmp, _KybStatusNames)
-	return tmp
-}
-
-// String implements the Stringer interface.
-func (x KybStatus) String() string {
-	return string(x)
-}
-
-// IsValid provides a quick way to determine if the typed value is
-// part of the allowed enumerated values
-func (x KybStatus) IsValid() bool {
-	_, err := ParseKybStatus(string(x))
-	return err == nil
-}
-
-var _KybStatusValue = map[string]KybStatus{
-	"init":             KybStatusInit,
-	"pending_review":   KybStatusPendingReview,
-	"under_review":     KybStatusUnderReview,
-	"pending_response": KybStatusPendingResponse,
-	"escalated":        KybStatusEscalated,
-	"pending_approval": KybStatusPendingApproval,
-	"rejected":         KybStatusRejected,
-	"approved":         KybStatusApproved,
-}
-
-// ParseKybStatus attempts to convert a string to a KybStatus.
-func ParseKybStatus(name string) (KybStatus, error) {
-	if x, ok := _KybStatusValue[name]; ok {
-		return x, nil
+// UnmarshalJSON implements json.Unmarshaler. It accepts only a JSON string holding one
+// of the enumerated values; null leaves x unchanged.
+func (x *DocumentType) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
 	}
-	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
-	if x, ok := _KybStatusValue[strings.ToLower(name)]; ok {
-		return x, nil
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("%s is %w", data, ErrInvalidDocumentType)
 	}
-	return KybStatus(""), fmt.Errorf("%s is %w", name, ErrInvalidKybStatus)
+	return x.UnmarshalText([]byte(s))
 }
 
-// MarshalText implements the text marshaller method.
-func (x KybStatus) MarshalText() ([]byte, error) {
-	return []byte(string(x)), nil
-}
-
-// UnmarshalText implements the text unmarshaller method.
-func (x *KybStatus) UnmarshalText(text []byte) error {
-	tmp, err := ParseKybStatus(string(text))
-	if err != nil {
-		return err
-	}
-	*x = tmp
-	return nil
-}
-
-// AppendText appends the textual representation of itself to the end of b
-// (allocating a larger slice if necessary) and returns the updated slice.
-//
-// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
-func (x *KybStatus) AppendText(b []byte) ([]byte, error) {
-	return append(b, x.String()...), nil
-}
+// TaxIDType represents the type of tax identification covering all supported countries.
+type TaxIDType string
 
 const (
-	// MoneyRange099999 is a MoneyRange of type 0_99999.
-	MoneyRange099999 MoneyRange = "0_99999"
-	// MoneyRange100000499999 is a MoneyRange of type 100000_499999.
-	MoneyRange100000499999 MoneyRange = "100000_499999"
-	// MoneyRange500000999999 is a MoneyRange of type 500000_999999.
-	MoneyRange500000999999 MoneyRange = "500000_999999"
-	// MoneyRange10000004999999 is a MoneyRange of type 1000000_4999999.
-	MoneyRange10000004999999 MoneyRange = "1000000_4999999"
-	// MoneyRange5000000Plus is a MoneyRange of type 5000000_plus.
-	MoneyRange5000000Plus MoneyRange = "5000000_plus"
+	// TaxIDTypeSSN is a TaxIDType of type SSN.
+	TaxIDTypeSSN TaxIDType = "SSN"
+	// TaxIDTypeEIN is a TaxIDType of type EIN.
+	TaxIDTypeEIN TaxIDType = "EIN"
+	// TaxIDTypeTFN is a TaxIDType of type TFN.
+	TaxIDTypeTFN TaxIDType = "TFN"
+	// TaxIDTypeABN is a TaxIDType of type ABN.
+	TaxIDTypeABN TaxIDType = "ABN"
+	// TaxIDTypeACN is a TaxIDType of type ACN.
+	TaxIDTypeACN TaxIDType = "ACN"
+	// TaxIDTypeUTR is a TaxIDType of type UTR.
+	TaxIDTypeUTR TaxIDType = "UTR"
+	// TaxIDTypeNINO is a TaxIDType of type NINO.
+	TaxIDTypeNINO TaxIDType = "NINO"
+	// TaxIDTypeNRIC is a TaxIDType of type NRIC.
+	TaxIDTypeNRIC TaxIDType = "NRIC"
+	// TaxIDTypeFIN is a TaxIDType of type FIN.
+	TaxIDTypeFIN TaxIDType = "FIN"
+	// TaxIDTypeASDG is a TaxIDType of type ASDG.
+	TaxIDTypeASDG TaxIDType = "ASDG"
+	// TaxIDTypeITR is a TaxIDType of type ITR.
+	TaxIDTypeITR TaxIDType = "ITR"
+	// TaxIDTypeNIF is a TaxIDType of type NIF.
+	TaxIDTypeNIF TaxIDType = "NIF"
+	// TaxIDTypeTIN is a TaxIDType of type TIN.
+	TaxIDTypeTIN TaxIDType = "TIN"
+	// TaxIDTypeVAT is a TaxIDType of type VAT.
+	TaxIDTypeVAT TaxIDType = "VAT"
+	// TaxIDTypeCUIL is a TaxIDType of type CUIL.
+	TaxIDTypeCUIL TaxIDType = "CUIL"
+	// TaxIDTypeCUIT is a TaxIDType of type CUIT.
+	TaxIDTypeCUIT TaxIDType = "CUIT"
+	// TaxIDTypeDNI is a TaxIDType of type DNI.
+	TaxIDTypeDNI TaxIDType = "DNI"
+	// TaxIDTypeBIN is a TaxIDType of type BIN.
+	TaxIDTypeBIN TaxIDType = "BIN"
+	// TaxIDTypeUNP is a TaxIDType of type UNP.
+	TaxIDTypeUNP TaxIDType = "UNP"
+	// TaxIDTypeRNPM is a TaxIDType of type RNPM.
+	TaxIDTypeRNPM TaxIDType = "RNPM"
+	// TaxIDTypeNIT is a TaxIDType of type NIT.
+	TaxIDTypeNIT TaxIDType = "NIT"
+	// TaxIDTypeCPF is a TaxIDType of type CPF.
+	TaxIDTypeCPF TaxIDType = "CPF"
+	// TaxIDTypeCNPJ is a TaxIDType of type CNPJ.
+	TaxIDTypeCNPJ TaxIDType = "CNPJ"
+	// TaxIDTypeNIRE is a TaxIDType of type NIRE.
+	TaxIDTypeNIRE TaxIDType = "NIRE"
+	// TaxIDTypeUCN is a TaxIDType of type UCN.
+	TaxIDTypeUCN TaxIDType = "UCN"
+	// TaxIDTypeUIC is a TaxIDType of type UIC.
+	TaxIDTypeUIC TaxIDType = "UIC"
+	// TaxIDTypeSIN is a TaxIDType of type SIN.
+	TaxIDTypeSIN TaxIDType = "SIN"
+	// TaxIDTypeBN is a TaxIDType of type BN.
+	TaxIDTypeBN TaxIDType = "BN"
+	// TaxIDTypeRUT is a TaxIDType of type RUT.
+	TaxIDTypeRUT TaxIDType = "RUT"
+	// TaxIDTypeIIN is a TaxIDType of type IIN.
+	TaxIDTypeIIN TaxIDType = "IIN"
+	// TaxIDTypeUSCC is a TaxIDType of type USCC.
+	TaxIDTypeUSCC TaxIDType = "USCC"
+	// TaxIDTypeCNOC is a TaxIDType of type CNOC.
+	TaxIDTypeCNOC TaxIDType = "CNOC"
+	// TaxIDTypeUSCN is a TaxIDType of type USCN.
+	TaxIDTypeUSCN TaxIDType = "USCN"
+	// TaxIDTypeITIN is a TaxIDType of type ITIN.
+	TaxIDTypeITIN TaxIDType = "ITIN"
+	// TaxIDTypeCPJ is a TaxIDType of type CPJ.
+	TaxIDTypeCPJ TaxIDType = "CPJ"
+	// TaxIDTypeOIB is a TaxIDType of type OIB.
+	TaxIDTypeOIB TaxIDType = "OIB"
+	// TaxIDTypeDIC is a TaxIDType of type DIC.
+	TaxIDTypeDIC TaxIDType = "DIC"
+	// TaxIDTypeCPR is a TaxIDType of type CPR.
+	TaxIDTypeCPR TaxIDType = "CPR"
+	// TaxIDTypeCVR is a TaxIDType of type CVR.
+	TaxIDTypeCVR TaxIDType = "CVR"
+	// TaxIDTypeCN is a TaxIDType of type CN.
+	TaxIDTypeCN TaxIDType = "CN"
+	// TaxIDTypeRNC is a TaxIDType of type RNC.
+	TaxIDTypeRNC TaxIDType = "RNC"
+	// TaxIDTypeRUC is a TaxIDType of type RUC.
+	TaxIDTypeRUC TaxIDType = "RUC"
+	// TaxIDTypeTN is a TaxIDType of type TN.
+	TaxIDTypeTN TaxIDType = "TN"
+	// TaxIDTypeHETU is a TaxIDType of type HETU.
+	TaxIDTypeHETU TaxIDType = "HETU"
+	// TaxIDTypeYT is a TaxIDType of type YT.
+	TaxIDTypeYT TaxIDType = "YT"
+	// TaxIDTypeALV is a TaxIDType of type ALV.
+	TaxIDTypeALV TaxIDType = "ALV"
+	// TaxIDTypeSIREN is a TaxIDType of type SIREN.
+	TaxIDTypeSIREN TaxIDType = "SIREN"
+	// TaxIDTypeIDNR is a TaxIDType of type IDNR.
+	TaxIDTypeIDNR TaxIDType = "IDNR"
+	// TaxIDTypeSTNR is a TaxIDType of type STNR.
+	TaxIDTypeSTNR TaxIDType = "STNR"
+	// TaxIDTypeVTA is a TaxIDType of type VTA.
+	TaxIDTypeVTA TaxIDType = "VTA"
+	// TaxIDTypeHKID is a TaxIDType of type HKID.
+	TaxIDTypeHKID TaxIDType = "HKID"
+	// TaxIDTypeAJ is a TaxIDType of type AJ.
+	TaxIDTypeAJ TaxIDType = "AJ"
+	// TaxIDTypeEN is a TaxIDType of type EN.
+	TaxIDTypeEN TaxIDType = "EN"
+	// TaxIDTypeKN is a TaxIDType of type KN.
+	TaxIDTypeKN TaxIDType = "KN"
+	// TaxIDTypeVSK is a TaxIDType of type VSK.
+	TaxIDTypeVSK TaxIDType = "VSK"
+	// TaxIDTypePAN is a TaxIDType of type PAN.
+	TaxIDTypePAN TaxIDType = "PAN"
+	// TaxIDTypeGSTN is a TaxIDType of type GSTN.
+	TaxIDTypeGSTN TaxIDType = "GSTN"
+	// TaxIDTypeNIK is a TaxIDType of type NIK.
+	TaxIDTypeNIK TaxIDType = "NIK"
+	// TaxIDTypeNPWP is a TaxIDType of type NPWP.
+	TaxIDTypeNPWP TaxIDType = "NPWP"
+	// TaxIDTypePPS is a TaxIDType of type PPS.
+	TaxIDTypePPS TaxIDType = "PPS"
+	// TaxIDTypeTRN is a TaxIDType of type TRN.
+	TaxIDTypeTRN TaxIDType = "TRN"
+	// TaxIDTypeCRO is a TaxIDType of type CRO.
+	TaxIDTypeCRO TaxIDType = "CRO"
+	// TaxIDTypeCHY is a TaxIDType of type CHY.
+	TaxIDTypeCHY TaxIDType = "CHY"
+	// TaxIDTypeCF is a TaxIDType of type CF.
+	TaxIDTypeCF TaxIDType = "CF"
+	// TaxIDTypeIVA is a TaxIDType of type IVA.
+	TaxIDTypeIVA TaxIDType = "IVA"
+	// TaxIDTypeIN is a TaxIDType of type IN.
+	TaxIDTypeIN TaxIDType = "IN"
+	// TaxIDTypeJCT is a TaxIDType of type JCT.
+	TaxIDTypeJCT TaxIDType = "JCT"
+	// TaxIDTypeEDRPOU is a TaxIDType of type EDRPOU.
+	TaxIDTypeEDRPOU TaxIDType = "EDRPOU"
+	// TaxIDTypeEID is a TaxIDType of type EID.
+	TaxIDTypeEID TaxIDType = "EID"
 )
 
-var ErrInvalidMoneyRange = fmt.Errorf("not a valid MoneyRange, try [%s]", strings.Join(_MoneyRangeNames, ", "))
+var ErrInvalidTaxIDType = fmt.Errorf("not a valid TaxIDType, try [%s]", strings.Join(_TaxIDTypeNames, ", "))
 
-var _MoneyRangeNames = []string{
-	string(MoneyRange099999),
-	string(MoneyRange100000499999),
-	string(MoneyRange500000999999),
-	string(MoneyRange10000004999999),
-	string(MoneyRange5000000Plus),
+var _TaxIDTypeNames = []string{
+	string(TaxIDTypeSSN),
+	string(TaxIDTypeEIN),
+	string(TaxIDTypeTFN),
+	string(TaxIDTypeABN),
+	string(TaxIDTypeACN),
+	string(TaxIDTypeUTR),
+	string(TaxIDTypeNINO),
+	string(TaxIDTypeNRIC),
+	string(TaxIDTypeFIN),
+	string(TaxIDTypeASDG),
+	string(TaxIDTypeITR),
+	string(TaxIDTypeNIF),
+	string(TaxIDTypeTIN),
+	string(TaxIDTypeVAT),
+	string(TaxIDTypeCUIL),
+	string(TaxIDTypeCUIT),
+	string(TaxIDTypeDNI),
+	string(TaxIDTypeBIN),
+	string(TaxIDTypeUNP),
+	string(TaxIDTypeRNPM),
+	string(TaxIDTypeNIT),
+	string(TaxIDTypeCPF),
+	string(TaxIDTypeCNPJ),
+	string(TaxIDTypeNIRE),
+	string(TaxIDTypeUCN),
+	string(TaxIDTypeUIC),
+	string(TaxIDTypeSIN),
+	string(TaxIDTypeBN),
+	string(TaxIDTypeRUT),
+	string(TaxIDTypeIIN),
+	string(TaxIDTypeUSCC),
+	string(TaxIDTypeCNOC),
+	string(TaxIDTypeUSCN),
+	string(TaxIDTypeITIN),
+	string(TaxIDTypeCPJ),
+	string(TaxIDTypeOIB),
+	string(TaxIDTypeDIC),
+	string(TaxIDTypeCPR),
+	string(TaxIDTypeCVR),
+	string(TaxIDTypeCN),
+	string(TaxIDTypeRNC),
+	string(TaxIDTypeRUC),
+	string(TaxIDTypeTN),
+	string(TaxIDTypeHETU),
+	string(TaxIDTypeYT),
+	string(TaxIDTypeALV),
+	string(TaxIDTypeSIREN),
+	string(TaxIDTypeIDNR),
+	string(TaxIDTypeSTNR),
+	string(TaxIDTypeVTA),
+	string(TaxIDTypeHKID),
+	string(TaxIDTypeAJ),
+	string(TaxIDTypeEN),
+	string(TaxIDTypeKN),
+	string(TaxIDTypeVSK),
+	string(TaxIDTypePAN),
+	string(TaxIDTypeGSTN),
+	string(TaxIDTypeNIK),
+	string(TaxIDTypeNPWP),
+	string(TaxIDTypePPS),
+	string(TaxIDTypeTRN),
+	string(TaxIDTypeCRO),
+	string(TaxIDTypeCHY),
+	string(TaxIDTypeCF),
+	string(TaxIDTypeIVA),
+	string(TaxIDTypeIN),
+	string(TaxIDTypeJCT),
+	string(TaxIDTypeEDRPOU),
+	string(TaxIDTypeEID),
 }
 
-// MoneyRangeNames returns a list of possible string values of MoneyRange.
-func MoneyRangeNames() []string {
-	tmp := make([]string, len(_MoneyRangeNames))
-	copy(tmp, _MoneyRangeNames)
+// TaxIDTypeNames returns a list of possible string values of TaxIDType.
+func TaxIDTypeNames() []string {
+	tmp := make([]string, len(_TaxIDTypeNames))
+	copy(tmp, _TaxIDTypeNames)
 	return tmp
 }
 
 // String implements the Stringer interface.
-func (x MoneyRange) String() string {
+func (x TaxIDType) String() string {
 	return string(x)
 }
 
-// IsValid provides a quick way to determine if the typed value is
-// part of the allowed enumerated values
-func (x MoneyRange) IsValid() bool {
-	_, err := ParseMoneyRange(string(x))
+// IsValid reports whether x is one of the enumerated values, ignoring case.
+func (x TaxIDType) IsValid() bool {
+	_, err := ParseTaxIDType(string(x))
 	return err == nil
 }
 
-var _MoneyRangeValue = map[string]MoneyRange{
-	"0_99999":         MoneyRange099999,
-	"100000_499999":   MoneyRange100000499999,
-	"500000_999999":   MoneyRange500000999999,
-	"1000000_4999999": MoneyRange10000004999999,
-	"5000000_plus":    MoneyRange5000000Plus,
+var _TaxIDTypeValue = map[string]TaxIDType{
+	"SSN":    TaxIDTypeSSN,
+	"ssn":    TaxIDTypeSSN,
+	"EIN":    TaxIDTypeEIN,
+	"ein":    TaxIDTypeEIN,
+	"TFN":    TaxIDTypeTFN,
+	"tfn":    TaxIDTypeTFN,
+	"ABN":    TaxIDTypeABN,
+	"abn":    TaxIDTypeABN,
+	"ACN":    TaxIDTypeACN,
+	"acn":    TaxIDTypeACN,
+	"UTR":    TaxIDTypeUTR,
+	"utr":    TaxIDTypeUTR,
+	"NINO":   TaxIDTypeNINO,
+	"nino":   TaxIDTypeNINO,
+	"NRIC":   TaxIDTypeNRIC,
+	"nric":   TaxIDTypeNRIC,
+	"FIN":    TaxIDTypeFIN,
+	"fin":    TaxIDTypeFIN,
+	"ASDG":   TaxIDTypeASDG,
+	"asdg":   TaxIDTypeASDG,
+	"ITR":    TaxIDTypeITR,
+	"itr":    TaxIDTypeITR,
+	"NIF":    TaxIDTypeNIF,
+	"nif":    TaxIDTypeNIF,
+	"TIN":    TaxIDTypeTIN,
+	"tin":    TaxIDTypeTIN,
+	"VAT":    TaxIDTypeVAT,
+	"vat":    TaxIDTypeVAT,
+	"CUIL":   TaxIDTypeCUIL,
+	"cuil":   TaxIDTypeCUIL,
+	"CUIT":   TaxIDTypeCUIT,
+	"cuit":   TaxIDTypeCUIT,
+	"DNI":    TaxIDTypeDNI,
+	"dni":    TaxIDTypeDNI,
+	"BIN":    TaxIDTypeBIN,
+	"bin":    TaxIDTypeBIN,
+	"UNP":    TaxIDTypeUNP,
+	"unp":    TaxIDTypeUNP,
+	"RNPM":   TaxIDTypeRNPM,
+	"rnpm":   TaxIDTypeRNPM,
+	"NIT":    TaxIDTypeNIT,
+	"nit":    TaxIDTypeNIT,
+	"CPF":    TaxIDTypeCPF,
+	"cpf":    TaxIDTypeCPF,
+	"CNPJ":   TaxIDTypeCNPJ,
+	"cnpj":   TaxIDTypeCNPJ,
+	"NIRE":   TaxIDTypeNIRE,
+	"nire":   TaxIDTypeNIRE,
+	"UCN":    TaxIDTypeUCN,
+	"ucn":    TaxIDTypeUCN,
+	"UIC":    TaxIDTypeUIC,
+	"uic":    TaxIDTypeUIC,
+	"SIN":    TaxIDTypeSIN,
+	"sin":    TaxIDTypeSIN,
+	"BN":     TaxIDTypeBN,
+	"bn":     TaxIDTypeBN,
+	"RUT":    TaxIDTypeRUT,
+	"rut":    TaxIDTypeRUT,
+	"IIN":    TaxIDTypeIIN,
+	"iin":    TaxIDTypeIIN,
+	"USCC":   TaxIDTypeUSCC,
+	"uscc":   TaxIDTypeUSCC,
+	"CNOC":   TaxIDTypeCNOC,
+	"cnoc":   TaxIDTypeCNOC,
+	"USCN":   TaxIDTypeUSCN,
+	"uscn":   TaxIDTypeUSCN,
+	"ITIN":   TaxIDTypeITIN,
+	"itin":   TaxIDTypeITIN,
+	"CPJ":    TaxIDTypeCPJ,
+	"cpj":    TaxIDTypeCPJ,
+	"OIB":    TaxIDTypeOIB,
+	"oib":    TaxIDTypeOIB,
+	"DIC":    TaxIDTypeDIC,
+	"dic":    TaxIDTypeDIC,
+	"CPR":    TaxIDTypeCPR,
+	"cpr":    TaxIDTypeCPR,
+	"CVR":    TaxIDTypeCVR,
+	"cvr":    TaxIDTypeCVR,
+	"CN":     TaxIDTypeCN,
+	"cn":     TaxIDTypeCN,
+	"RNC":    TaxIDTypeRNC,
+	"rnc":    TaxIDTypeRNC,
+	"RUC":    TaxIDTypeRUC,
+	"ruc":    TaxIDTypeRUC,
+	"TN":     TaxIDTypeTN,
+	"tn":     TaxIDTypeTN,
+	"HETU":   TaxIDTypeHETU,
+	"hetu":   TaxIDTypeHETU,
+	"YT":     TaxIDTypeYT,
+	"yt":     TaxIDTypeYT,
+	"ALV":    TaxIDTypeALV,
+	"alv":    TaxIDTypeALV,
+	"SIREN":  TaxIDTypeSIREN,
+	"siren":  TaxIDTypeSIREN,
+	"IDNR":   TaxIDTypeIDNR,
+	"idnr":   TaxIDTypeIDNR,
+	"STNR":   TaxIDTypeSTNR,
+	"stnr":   TaxIDTypeSTNR,
+	"VTA":    TaxIDTypeVTA,
+	"vta":    TaxIDTypeVTA,
+	"HKID":   TaxIDTypeHKID,
+	"hkid":   TaxIDTypeHKID,
+	"AJ":     TaxIDTypeAJ,
+	"aj":     TaxIDTypeAJ,
+	"EN":     TaxIDTypeEN,
+	"en":     TaxIDTypeEN,
+	"KN":     TaxIDTypeKN,
+	"kn":     TaxIDTypeKN,
+	"VSK":    TaxIDTypeVSK,
+	"vsk":    TaxIDTypeVSK,
+	"PAN":    TaxIDTypePAN,
+	"pan":    TaxIDTypePAN,
+	"GSTN":   TaxIDTypeGSTN,
+	"gstn":   TaxIDTypeGSTN,
+	"NIK":    TaxIDTypeNIK,
+	"nik":    TaxIDTypeNIK,
+	"NPWP":   TaxIDTypeNPWP,
+	"npwp":   TaxIDTypeNPWP,
+	"PPS":    TaxIDTypePPS,
+	"pps":    TaxIDTypePPS,
+	"TRN":    TaxIDTypeTRN,
+	"trn":    TaxIDTypeTRN,
+	"CRO":    TaxIDTypeCRO,
+	"cro":    TaxIDTypeCRO,
+	"CHY":    TaxIDTypeCHY,
+	"chy":    TaxIDTypeCHY,
+	"CF":     TaxIDTypeCF,
+	"cf":     TaxIDTypeCF,
+	"IVA":    TaxIDTypeIVA,
+	"iva":    TaxIDTypeIVA,
+	"IN":     TaxIDTypeIN,
+	"in":     TaxIDTypeIN,
+	"JCT":    TaxIDTypeJCT,
+	"jct":    TaxIDTypeJCT,
+	"EDRPOU": TaxIDTypeEDRPOU,
+	"edrpou": TaxIDTypeEDRPOU,
+	"EID":    TaxIDTypeEID,
+	"eid":    TaxIDTypeEID,
 }
 
-// ParseMoneyRange attempts to convert a string to a MoneyRange.
-func ParseMoneyRange(name string) (MoneyRange, error) {
-	if x, ok := _MoneyRangeValue[name]; ok {
+// ParseTaxIDType converts a string to a TaxIDType, ignoring case.
+func ParseTaxIDType(name string) (TaxIDType, error) {
+	if x, ok := _TaxIDTypeValue[name]; ok {
 		return x, nil
 	}
-	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
-	if x, ok := _MoneyRangeValue[strings.ToLower(name)]; ok {
+	if x, ok := _TaxIDTypeValue[strings.ToLower(name)]; ok {
 		return x, nil
 	}
-	return MoneyRange(""), fmt.Errorf("%s is %w", name, ErrInvalidMoneyRange)
+	return TaxIDType(""), fmt.Errorf("%s is %w", name, ErrInvalidTaxIDType)
 }
 
-// MarshalText implements the text marshaller method.
-func (x MoneyRange) MarshalText() ([]byte, error) {
+// MarshalText implements encoding.TextMarshaler.
+func (x TaxIDType) MarshalText() ([]byte, error) {
 	return []byte(string(x)), nil
 }
 
-// UnmarshalText implements the text unmarshaller method.
-func (x *MoneyRange) UnmarshalText(text []byte) error {
-	tmp, err := ParseMoneyRange(string(text))
+// UnmarshalText implements encoding.TextUnmarshaler. It rejects unknown values.
+func (x *TaxIDType) UnmarshalText(text []byte) error {
+	tmp, err := ParseTaxIDType(string(text))
 	if err != nil {
 		return err
 	}
@@ -972,14 +1083,27 @@ func (x *MoneyRange) UnmarshalText(text []byte) error {
 	return nil
 }
 
-// AppendText appends the textual representation of itself to the end of b
-// (allocating a larger slice if necessary) and returns the updated slice.
-//
-// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
-func (x *MoneyRange) AppendText(b []byte) ([]byte, error) {
+// AppendText implements encoding.TextAppender.
+func (x *TaxIDType) AppendText(b []byte) ([]byte, error) {
 	return append(b, x.String()...), nil
 }
 
+// UnmarshalJSON implements json.Unmarshaler. It accepts only a JSON string holding one
+// of the enumerated values; null leaves x unchanged.
+func (x *TaxIDType) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("%s is %w", data, ErrInvalidTaxIDType)
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// SourceOfFunds represents the origin of funds for business operations.
+type SourceOfFunds string
+
 const (
 	// SourceOfFundsBusinessLoans is a SourceOfFunds of type business_loans.
 	SourceOfFundsBusinessLoans SourceOfFunds = "business_loans"
@@ -1036,8 +1160,7 @@ func (x SourceOfFunds) String() string {
 	return string(x)
 }
 
-// IsValid provides a quick way to determine if the typed value is
-// part of the allowed enumerated values
+// IsValid reports whether x is one of the enumerated values, ignoring case.
 func (x SourceOfFunds) IsValid() bool {
 	_, err := ParseSourceOfFunds(string(x))
 	return err == nil
@@ -1058,24 +1181,23 @@ var _SourceOfFundsValue = map[string]SourceOfFunds{
 	"treasury_reserves":           SourceOfFundsTreasuryReserves,
 }
 
-// ParseSourceOfFunds attempts to convert a string to a SourceOfFunds.
+// ParseSourceOfFunds converts a string to a SourceOfFunds, ignoring case.
 func ParseSourceOfFunds(name string) (SourceOfFunds, error) {
 	if x, ok := _SourceOfFundsValue[name]; ok {
 		return x, nil
 	}
-	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
 	if x, ok := _SourceOfFundsValue[strings.ToLower(name)]; ok {
 		return x, nil
 	}
 	return SourceOfFunds(""), fmt.Errorf("%s is %w", name, ErrInvalidSourceOfFunds)
 }
 
-// MarshalText implements the text marshaller method.
+// MarshalText implements encoding.TextMarshaler.
 func (x SourceOfFunds) MarshalText() ([]byte, error) {
 	return []byte(string(x)), nil
 }
 
-// UnmarshalText implements the text unmarshaller method.
+// UnmarshalText implements encoding.TextUnmarshaler. It rejects unknown values.
 func (x *SourceOfFunds) UnmarshalText(text []byte) error {
 	tmp, err := ParseSourceOfFunds(string(text))
 	if err != nil {
@@ -1085,14 +1207,27 @@ func (x *SourceOfFunds) UnmarshalText(text []byte) error {
 	return nil
 }
 
-// AppendText appends the textual representation of itself to the end of b
-// (allocating a larger slice if necessary) and returns the updated slice.
-//
-// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+// AppendText implements encoding.TextAppender.
 func (x *SourceOfFunds) AppendText(b []byte) ([]byte, error) {
 	return append(b, x.String()...), nil
 }
 
+// UnmarshalJSON implements json.Unmarshaler. It accepts only a JSON string holding one
+// of the enumerated values; null leaves x unchanged.
+func (x *SourceOfFunds) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("%s is %w", data, ErrInvalidSourceOfFunds)
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// SourceOfWealth represents the origin of the business's accumulated wealth.
+type SourceOfWealth string
+
 const (
 	// SourceOfWealthBusinessDividendsOrProfits is a SourceOfWealth of type business_dividends_or_profits.
 	SourceOfWealthBusinessDividendsOrProfits SourceOfWealth = "business_dividends_or_profits"
@@ -1134,8 +1269,7 @@ func (x SourceOfWealth) String() string {
 	return string(x)
 }
 
-// IsValid provides a quick way to determine if the typed value is
-// part of the allowed enumerated values
+// IsValid reports whether x is one of the enumerated values, ignoring case.
 func (x SourceOfWealth) IsValid() bool {
 	_, err := ParseSourceOfWealth(string(x))
 	return err == nil
@@ -1151,24 +1285,23 @@ var _SourceOfWealthValue = map[string]SourceOfWealth{
 	"other":                         SourceOfWealthOther,
 }
 
-// ParseSourceOfWealth attempts to convert a string to a SourceOfWealth.
+// ParseSourceOfWealth converts a string to a SourceOfWealth, ignoring case.
 func ParseSourceOfWealth(name string) (SourceOfWealth, error) {
 	if x, ok := _SourceOfWealthValue[name]; ok {
 		return x, nil
 	}
-	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
 	if x, ok := _SourceOfWealthValue[strings.ToLower(name)]; ok {
 		return x, nil
 	}
 	return SourceOfWealth(""), fmt.Errorf("%s is %w", name, ErrInvalidSourceOfWealth)
 }
 
-// MarshalText implements the text marshaller method.
+// MarshalText implements encoding.TextMarshaler.
 func (x SourceOfWealth) MarshalText() ([]byte, error) {
 	return []byte(string(x)), nil
 }
 
-// UnmarshalText implements the text unmarshaller method.
+// UnmarshalText implements encoding.TextUnmarshaler. It rejects unknown values.
 func (x *SourceOfWealth) UnmarshalText(text []byte) error {
 	tmp, err := ParseSourceOfWealth(string(text))
 	if err != nil {
@@ -1178,409 +1311,425 @@ func (x *SourceOfWealth) UnmarshalText(text []byte) error {
 	return nil
 }
 
-// AppendText appends the textual representation of itself to the end of b
-// (allocating a larger slice if necessary) and returns the updated slice.
-//
-// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+// AppendText implements encoding.TextAppender.
 func (x *SourceOfWealth) AppendText(b []byte) ([]byte, error) {
 	return append(b, x.String()...), nil
 }
 
+// UnmarshalJSON implements json.Unmarshaler. It accepts only a JSON string holding one
+// of the enumerated values; null leaves x unchanged.
+func (x *SourceOfWealth) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("%s is %w", data, ErrInvalidSourceOfWealth)
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// HighRiskActivity represents potentially high-risk business activities.
+type HighRiskActivity string
+
 const (
-	// TaxIDTypeSSN is a TaxIDType of type SSN.
-	TaxIDTypeSSN TaxIDType = "SSN"
-	// TaxIDTypeEIN is a TaxIDType of type EIN.
-	TaxIDTypeEIN TaxIDType = "EIN"
-	// TaxIDTypeTFN is a TaxIDType of type TFN.
-	TaxIDTypeTFN TaxIDType = "TFN"
-	// TaxIDTypeABN is a TaxIDType of type ABN.
-	TaxIDTypeABN TaxIDType = "ABN"
-	// TaxIDTypeACN is a TaxIDType of type ACN.
-	TaxIDTypeACN TaxIDType = "ACN"
-	// TaxIDTypeUTR is a TaxIDType of type UTR.
-	TaxIDTypeUTR TaxIDType = "UTR"
-	// TaxIDTypeNINO is a TaxIDType of type NINO.
-	TaxIDTypeNINO TaxIDType = "NINO"
-	// TaxIDTypeNRIC is a TaxIDType of type NRIC.
-	TaxIDTypeNRIC TaxIDType = "NRIC"
-	// TaxIDTypeFIN is a TaxIDType of type FIN.
-	TaxIDTypeFIN TaxIDType = "FIN"
-	// TaxIDTypeASDG is a TaxIDType of type ASDG.
-	TaxIDTypeASDG TaxIDType = "ASDG"
-	// TaxIDTypeITR is a TaxIDType of type ITR.
-	TaxIDTypeITR TaxIDType = "ITR"
-	// TaxIDTypeNIF is a TaxIDType of type NIF.
-	TaxIDTypeNIF TaxIDType = "NIF"
-	// TaxIDTypeTIN is a TaxIDType of type TIN.
-	TaxIDTypeTIN TaxIDType = "TIN"
-	// TaxIDTypeVAT is a TaxIDType of type VAT.
-	TaxIDTypeVAT TaxIDType = "VAT"
-	// TaxIDTypeCUIL is a TaxIDType of type CUIL.
-	TaxIDTypeCUIL TaxIDType = "CUIL"
-	// TaxIDTypeCUIT is a TaxIDType of type CUIT.
-	TaxIDTypeCUIT TaxIDType = "CUIT"
-	// TaxIDTypeDNI is a TaxIDType of type DNI.
-	TaxIDTypeDNI TaxIDType = "DNI"
-	// TaxIDTypeBIN is a TaxIDType of type BIN.
-	TaxIDTypeBIN TaxIDType = "BIN"
-	// TaxIDTypeUNP is a TaxIDType of type UNP.
-	TaxIDTypeUNP TaxIDType = "UNP"
-	// TaxIDTypeRNPM is a TaxIDType of type RNPM.
-	TaxIDTypeRNPM TaxIDType = "RNPM"
-	// TaxIDTypeNIT is a TaxIDType of type NIT.
-	TaxIDTypeNIT TaxIDType = "NIT"
-	// TaxIDTypeCPF is a TaxIDType of type CPF.
-	TaxIDTypeCPF TaxIDType = "CPF"
-	// TaxIDTypeCNPJ is a TaxIDType of type CNPJ.
-	TaxIDTypeCNPJ TaxIDType = "CNPJ"
-	// TaxIDTypeNIRE is a TaxIDType of type NIRE.
-	TaxIDTypeNIRE TaxIDType = "NIRE"
-	// TaxIDTypeUCN is a TaxIDType of type UCN.
-	TaxIDTypeUCN TaxIDType = "UCN"
-	// TaxIDTypeUIC is a TaxIDType of type UIC.
-	TaxIDTypeUIC TaxIDType = "UIC"
-	// TaxIDTypeSIN is a TaxIDType of type SIN.
-	TaxIDTypeSIN TaxIDType = "SIN"
-	// TaxIDTypeBN is a TaxIDType of type BN.
-	TaxIDTypeBN TaxIDType = "BN"
-	// TaxIDTypeRUT is a TaxIDType of type RUT.
-	TaxIDTypeRUT TaxIDType = "RUT"
-	// TaxIDTypeIIN is a TaxIDType of type IIN.
-	TaxIDTypeIIN TaxIDType = "IIN"
-	// TaxIDTypeUSCC is a TaxIDType of type USCC.
-	TaxIDTypeUSCC TaxIDType = "USCC"
-	// TaxIDTypeCNOC is a TaxIDType of type CNOC.
-	TaxIDTypeCNOC TaxIDType = "CNOC"
-	// TaxIDTypeUSCN is a TaxIDType of type USCN.
-	TaxIDTypeUSCN TaxIDType = "USCN"
-	// TaxIDTypeITIN is a TaxIDType of type ITIN.
-	TaxIDTypeITIN TaxIDType = "ITIN"
-	// TaxIDTypeCPJ is a TaxIDType of type CPJ.
-	TaxIDTypeCPJ TaxIDType = "CPJ"
-	// TaxIDTypeOIB is a TaxIDType of type OIB.
-	TaxIDTypeOIB TaxIDType = "OIB"
-	// TaxIDTypeDIC is a TaxIDType of type DIC.
-	TaxIDTypeDIC TaxIDType = "DIC"
-	// TaxIDTypeCPR is a TaxIDType of type CPR.
-	TaxIDTypeCPR TaxIDType = "CPR"
-	// TaxIDTypeCVR is a TaxIDType of type CVR.
-	TaxIDTypeCVR TaxIDType = "CVR"
-	// TaxIDTypeCN is a TaxIDType of type CN.
-	TaxIDTypeCN TaxIDType = "CN"
-	// TaxIDTypeRNC is a TaxIDType of type RNC.
-	TaxIDTypeRNC TaxIDType = "RNC"
-	// TaxIDTypeRUC is a TaxIDType of type RUC.
-	TaxIDTypeRUC TaxIDType = "RUC"
-	// TaxIDTypeTN is a TaxIDType of type TN.
-	TaxIDTypeTN TaxIDType = "TN"
-	// TaxIDTypeHETU is a TaxIDType of type HETU.
-	TaxIDTypeHETU TaxIDType = "HETU"
-	// TaxIDTypeYT is a TaxIDType of type YT.
-	TaxIDTypeYT TaxIDType = "YT"
-	// TaxIDTypeALV is a TaxIDType of type ALV.
-	TaxIDTypeALV TaxIDType = "ALV"
-	// TaxIDTypeSIREN is a TaxIDType of type SIREN.
-	TaxIDTypeSIREN TaxIDType = "SIREN"
-	// TaxIDTypeIDNR is a TaxIDType of type IDNR.
-	TaxIDTypeIDNR TaxIDType = "IDNR"
-	// TaxIDTypeSTNR is a TaxIDType of type STNR.
-	TaxIDTypeSTNR TaxIDType = "STNR"
-	// TaxIDTypeVTA is a TaxIDType of type VTA.
-	TaxIDTypeVTA TaxIDType = "VTA"
-	// TaxIDTypeHKID is a TaxIDType of type HKID.
-	TaxIDTypeHKID TaxIDType = "HKID"
-	// TaxIDTypeAJ is a TaxIDType of type AJ.
-	TaxIDTypeAJ TaxIDType = "AJ"
-	// TaxIDTypeEN is a TaxIDType of type EN.
-	TaxIDTypeEN TaxIDType = "EN"
-	// TaxIDTypeKN is a TaxIDType of type KN.
-	TaxIDTypeKN TaxIDType = "KN"
-	// TaxIDTypeVSK is a TaxIDType of type VSK.
-	TaxIDTypeVSK TaxIDType = "VSK"
-	// TaxIDTypePAN is a TaxIDType of type PAN.
-	TaxIDTypePAN TaxIDType = "PAN"
-	// TaxIDTypeGSTN is a TaxIDType of type GSTN.
-	TaxIDTypeGSTN TaxIDType = "GSTN"
-	// TaxIDTypeNIK is a TaxIDType of type NIK.
-	TaxIDTypeNIK TaxIDType = "NIK"
-	// TaxIDTypeNPWP is a TaxIDType of type NPWP.
-	TaxIDTypeNPWP TaxIDType = "NPWP"
-	// TaxIDTypePPS is a TaxIDType of type PPS.
-	TaxIDTypePPS TaxIDType = "PPS"
-	// TaxIDTypeTRN is a TaxIDType of type TRN.
-	TaxIDTypeTRN TaxIDType = "TRN"
-	// TaxIDTypeCRO is a TaxIDType of type CRO.
-	TaxIDTypeCRO TaxIDType = "CRO"
-	// TaxIDTypeCHY is a TaxIDType of type CHY.
-	TaxIDTypeCHY TaxIDType = "CHY"
-	// TaxIDTypeCF is a TaxIDType of type CF.
-	TaxIDTypeCF TaxIDType = "CF"
-	// TaxIDTypeIVA is a TaxIDType of type IVA.
-	TaxIDTypeIVA TaxIDType = "IVA"
-	// TaxIDTypeIN is a TaxIDType of type IN.
-	TaxIDTypeIN TaxIDType = "IN"
-	// TaxIDTypeJCT is a TaxIDType of type JCT.
-	TaxIDTypeJCT TaxIDType = "JCT"
-	// TaxIDTypeEDRPOU is a TaxIDType of type EDRPOU.
-	TaxIDTypeEDRPOU TaxIDType = "EDRPOU"
-	// TaxIDTypeEID is a TaxIDType of type EID.
-	TaxIDTypeEID TaxIDType = "EID"
+	// HighRiskActivityAdultEntertainment is a HighRiskActivity of type adult_entertainment.
+	HighRiskActivityAdultEntertainment HighRiskActivity = "adult_entertainment"
+	// HighRiskActivityCannabis is a HighRiskActivity of type cannabis.
+	HighRiskActivityCannabis HighRiskActivity = "cannabis"
+	// HighRiskActivityCryptocurrency is a HighRiskActivity of type cryptocurrency.
+	HighRiskActivityCryptocurrency HighRiskActivity = "cryptocurrency"
+	// HighRiskActivityGambling is a HighRiskActivity of type gambling.
+	HighRiskActivityGambling HighRiskActivity = "gambling"
+	// HighRiskActivityMoneyServices is a HighRiskActivity of type money_services.
+	HighRiskActivityMoneyServices HighRiskActivity = "money_services"
+	// HighRiskActivityPreciousMetals is a HighRiskActivity of type precious_metals.
+	HighRiskActivityPreciousMetals HighRiskActivity = "precious_metals"
+	// HighRiskActivityWeapons is a HighRiskActivity of type weapons.
+	HighRiskActivityWeapons HighRiskActivity = "weapons"
+	// HighRiskActivityNone is a HighRiskActivity of type none.
+	HighRiskActivityNone HighRiskActivity = "none"
 )
 
-var ErrInvalidTaxIDType = fmt.Errorf("not a valid TaxIDType, try [%s]", strings.Join(_TaxIDTypeNames, ", "))
+var ErrInvalidHighRiskActivity = fmt.Errorf("not a valid HighRiskActivity, try [%s]", strings.Join(_HighRiskActivityNames, ", "))
 
-var _TaxIDTypeNames = []string{
-	string(TaxIDTypeSSN),
-	string(TaxIDTypeEIN),
-	string(TaxIDTypeTFN),
-	string(TaxIDTypeABN),
-	string(TaxIDTypeACN),
-	string(TaxIDTypeUTR),
-	string(TaxIDTypeNINO),
-	string(TaxIDTypeNRIC),
-	string(TaxIDTypeFIN),
-	string(TaxIDTypeASDG),
-	string(TaxIDTypeITR),
-	string(TaxIDTypeNIF),
-	string(TaxIDTypeTIN),
-	string(TaxIDTypeVAT),
-	string(TaxIDTypeCUIL),
-	string(TaxIDTypeCUIT),
-	string(TaxIDTypeDNI),
-	string(TaxIDTypeBIN),
-	string(TaxIDTypeUNP),
-	string(TaxIDTypeRNPM),
-	string(TaxIDTypeNIT),
-	string(TaxIDTypeCPF),
-	string(TaxIDTypeCNPJ),
-	string(TaxIDTypeNIRE),
-	string(TaxIDTypeUCN),
-	string(TaxIDTypeUIC),
-	string(TaxIDTypeSIN),
-	string(TaxIDTypeBN),
-	string(TaxIDTypeRUT),
-	string(TaxIDTypeIIN),
-	string(TaxIDTypeUSCC),
-	string(TaxIDTypeCNOC),
-	string(TaxIDTypeUSCN),
-	string(TaxIDTypeITIN),
-	string(TaxIDTypeCPJ),
-	string(TaxIDTypeOIB),
-	string(TaxIDTypeDIC),
-	string(TaxIDTypeCPR),
-	string(TaxIDTypeCVR),
-	string(TaxIDTypeCN),
-	string(TaxIDTypeRNC),
-	string(TaxIDTypeRUC),
-	string(TaxIDTypeTN),
-	string(TaxIDTypeHETU),
-	string(TaxIDTypeYT),
-	string(TaxIDTypeALV),
-	string(TaxIDTypeSIREN),
-	string(TaxIDTypeIDNR),
-	string(TaxIDTypeSTNR),
-	string(TaxIDTypeVTA),
-	string(TaxIDTypeHKID),
-	string(TaxIDTypeAJ),
-	string(TaxIDTypeEN),
-	string(TaxIDTypeKN),
-	string(TaxIDTypeVSK),
-	string(TaxIDTypePAN),
-	string(TaxIDTypeGSTN),
-	string(TaxIDTypeNIK),
-	string(TaxIDTypeNPWP),
-	string(TaxIDTypePPS),
-	string(TaxIDTypeTRN),
-	string(TaxIDTypeCRO),
-	string(TaxIDTypeCHY),
-	string(TaxIDTypeCF),
-	string(TaxIDTypeIVA),
-	string(TaxIDTypeIN),
-	string(TaxIDTypeJCT),
-	string(TaxIDTypeEDRPOU),
-	string(TaxIDTypeEID),
+var _HighRiskActivityNames = []string{
+	string(HighRiskActivityAdultEntertainment),
+	string(HighRiskActivityCannabis),
+	string(HighRiskActivityCryptocurrency),
+	string(HighRiskActivityGambling),
+	string(HighRiskActivityMoneyServices),
+	string(HighRiskActivityPreciousMetals),
+	string(HighRiskActivityWeapons),
+	string(HighRiskActivityNone),
+}
+
+// HighRiskActivityNames returns a list of possible string values of HighRiskActivity.
+func HighRiskActivityNames() []string {
+	tmp := make([]string, len(_HighRiskActivityNames))
+	copy(tmp, _HighRiskActivityNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x HighRiskActivity) String() string {
+	return string(x)
+}
+
+// IsValid reports whether x is one of the enumerated values, ignoring case.
+func (x HighRiskActivity) IsValid() bool {
+	_, err := ParseHighRiskActivity(string(x))
+	return err == nil
+}
+
+var _HighRiskActivityValue = map[string]HighRiskActivity{
+	"adult_entertainment": HighRiskActivityAdultEntertainment,
+	"cannabis":            HighRiskActivityCannabis,
+	"cryptocurrency":      HighRiskActivityCryptocurrency,
+	"gambling":            HighRiskActivityGambling,
+	"money_services":      HighRiskActivityMoneyServices,
+	"precious_metals":     HighRiskActivityPreciousMetals,
+	"weapons":             HighRiskActivityWeapons,
+	"none":                HighRiskActivityNone,
+}
+
+// ParseHighRiskActivity converts a string to a HighRiskActivity, ignoring case.
+func ParseHighRiskActivity(name string) (HighRiskActivity, error) {
+	if x, ok := _HighRiskActivityValue[name]; ok {
+		return x, nil
+	}
+	if x, ok := _HighRiskActivityValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return HighRiskActivity(""), fmt.Errorf("%s is %w", name, ErrInvalidHighRiskActivity)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (x HighRiskActivity) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It rejects unknown values.
+func (x *HighRiskActivity) UnmarshalText(text []byte) error {
+	tmp, err := ParseHighRiskActivity(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText implements encoding.TextAppender.
+func (x *HighRiskActivity) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts only a JSON string holding one
+// of the enumerated values; null leaves x unchanged.
+func (x *HighRiskActivity) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("%s is %w", data, ErrInvalidHighRiskActivity)
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// ImageFormat represents supported image formats for document uploads.
+type ImageFormat string
+
+const (
+	// ImageFormatJpeg is an ImageFormat of type jpeg.
+	ImageFormatJpeg ImageFormat = "jpeg"
+	// ImageFormatJpg is an ImageFormat of type jpg.
+	ImageFormatJpg ImageFormat = "jpg"
+	// ImageFormatPng is an ImageFormat of type png.
+	ImageFormatPng ImageFormat = "png"
+	// ImageFormatHeic is an ImageFormat of type heic.
+	ImageFormatHeic ImageFormat = "heic"
+	// ImageFormatTif is an ImageFormat of type tif.
+	ImageFormatTif ImageFormat = "tif"
+)
+
+var ErrInvalidImageFormat = fmt.Errorf("not a valid ImageFormat, try [%s]", strings.Join(_ImageFormatNames, ", "))
+
+var _ImageFormatNames = []string{
+	string(ImageFormatJpeg),
+	string(ImageFormatJpg),
+	string(ImageFormatPng),
+	string(ImageFormatHeic),
+	string(ImageFormatTif),
+}
+
+// ImageFormatNames returns a list of possible string values of ImageFormat.
+func ImageFormatNames() []string {
+	tmp := make([]string, len(_ImageFormatNames))
+	copy(tmp, _ImageFormatNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x ImageFormat) String() string {
+	return string(x)
+}
+
+// IsValid reports whether x is one of the enumerated values, ignoring case.
+func (x ImageFormat) IsValid() bool {
+	_, err := ParseImageFormat(string(x))
+	return err == nil
+}
+
+var _ImageFormatValue = map[string]ImageFormat{
+	"jpeg": ImageFormatJpeg,
+	"jpg":  ImageFormatJpg,
+	"png":  ImageFormatPng,
+	"heic": ImageFormatHeic,
+	"tif":  ImageFormatTif,
+}
+
+// ParseImageFormat converts a string to a ImageFormat, ignoring case.
+func ParseImageFormat(name string) (ImageFormat, error) {
+	if x, ok := _ImageFormatValue[name]; ok {
+		return x, nil
+	}
+	if x, ok := _ImageFormatValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return ImageFormat(""), fmt.Errorf("%s is %w", name, ErrInvalidImageFormat)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (x ImageFormat) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It rejects unknown values.
+func (x *ImageFormat) UnmarshalText(text []byte) error {
+	tmp, err := ParseImageFormat(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText implements encoding.TextAppender.
+func (x *ImageFormat) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts only a JSON string holding one
+// of the enumerated values; null leaves x unchanged.
+func (x *ImageFormat) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("%s is %w", data, ErrInvalidImageFormat)
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// FileFormat represents all supported file formats for document uploads.
+// This includes images, PDFs, and spreadsheet formats.
+type FileFormat string
+
+const (
+	// FileFormatJpeg is a FileFormat of type jpeg.
+	FileFormatJpeg FileFormat = "jpeg"
+	// FileFormatJpg is a FileFormat of type jpg.
+	FileFormatJpg FileFormat = "jpg"
+	// FileFormatPng is a FileFormat of type png.
+	FileFormatPng FileFormat = "png"
+	// FileFormatHeic is a FileFormat of type heic.
+	FileFormatHeic FileFormat = "heic"
+	// FileFormatTif is a FileFormat of type tif.
+	FileFormatTif FileFormat = "tif"
+	// FileFormatPdf is a FileFormat of type pdf.
+	FileFormatPdf FileFormat = "pdf"
+	// FileFormatCsv is a FileFormat of type csv.
+	FileFormatCsv FileFormat = "csv"
+	// FileFormatXls is a FileFormat of type xls.
+	FileFormatXls FileFormat = "xls"
+	// FileFormatXlsx is a FileFormat of type xlsx.
+	FileFormatXlsx FileFormat = "xlsx"
+)
+
+var ErrInvalidFileFormat = fmt.Errorf("not a valid FileFormat, try [%s]", strings.Join(_FileFormatNames, ", "))
+
+var _FileFormatNames = []string{
+	string(FileFormatJpeg),
+	string(FileFormatJpg),
+	string(FileFormatPng),
+	string(FileFormatHeic),
+	string(FileFormatTif),
+	string(FileFormatPdf),
+	string(FileFormatCsv),
+	string(FileFormatXls),
+	string(FileFormatXlsx),
+}
+
+// FileFormatNames returns a list of possible string values of FileFormat.
+func FileFormatNames() []string {
+	tmp := make([]string, len(_FileFormatNames))
+	copy(tmp, _FileFormatNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x FileFormat) String() string {
+	return string(x)
+}
+
+// IsValid reports whether x is one of the enumerated values, ignoring case.
+func (x FileFormat) IsValid() bool {
+	_, err := ParseFileFormat(string(x))
+	return err == nil
+}
+
+var _FileFormatValue = map[string]FileFormat{
+	"jpeg": FileFormatJpeg,
+	"jpg":  FileFormatJpg,
+	"png":  FileFormatPng,
+	"heic": FileFormatHeic,
+	"tif":  FileFormatTif,
+	"pdf":  FileFormatPdf,
+	"csv":  FileFormatCsv,
+	"xls":  FileFormatXls,
+	"xlsx": FileFormatXlsx,
 }
 
-// TaxIDTypeNames returns a list of possible string values of TaxIDType.
-func TaxIDTypeNames() []string {
-	tmp := make([]string, len(_TaxIDTypeNames))
-	copy(tmp, _TaxIDTypeNames)
+// ParseFileFormat converts a string to a FileFormat, ignoring case.
+func ParseFileFormat(name string) (FileFormat, error) {
+	if x, ok := _FileFormatValue[name]; ok {
+		return x, nil
+	}
+	if x, ok := _FileFormatValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return FileFormat(""), fmt.Errorf("%s is %w", name, ErrInvalidFileFormat)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (x FileFormat) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It rejects unknown values.
+func (x *FileFormat) UnmarshalText(text []byte) error {
+	tmp, err := ParseFileFormat(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText implements encoding.TextAppender.
+func (x *FileFormat) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts only a JSON string holding one
+// of the enumerated values; null leaves x unchanged.
+func (x *FileFormat) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("%s is %w", data, ErrInvalidFileFormat)
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// KybStatus represents the KYB (Know Your Business) verification status of a customer account.
+// This status tracks the progress and state of the KYB verification process.
+type KybStatus string
+
+const (
+	// KybStatusInit is a KybStatus of type init.
+	KybStatusInit KybStatus = "init"
+	// KybStatusPendingReview is a KybStatus of type pending_review.
+	KybStatusPendingReview KybStatus = "pending_review"
+	// KybStatusUnderReview is a KybStatus of type under_review.
+	KybStatusUnderReview KybStatus = "under_review"
+	// KybStatusPendingResponse is a KybStatus of type pending_response.
+	KybStatusPendingResponse KybStatus = "pending_response"
+	// KybStatusEscalated is a KybStatus of type escalated.
+	KybStatusEscalated KybStatus = "escalated"
+	// KybStatusPendingApproval is a KybStatus of type pending_approval.
+	KybStatusPendingApproval KybStatus = "pending_approval"
+	// KybStatusRejected is a KybStatus of type rejected.
+	KybStatusRejected KybStatus = "rejected"
+	// KybStatusApproved is a KybStatus of type approved.
+	KybStatusApproved KybStatus = "approved"
+)
+
+var ErrInvalidKybStatus = fmt.Errorf("not a valid KybStatus, try [%s]", strings.Join(_KybStatusNames, ", "))
+
+var _KybStatusNames = []string{
+	string(KybStatusInit),
+	string(KybStatusPendingReview),
+	string(KybStatusUnderReview),
+	string(KybStatusPendingResponse),
+	string(KybStatusEscalated),
+	string(KybStatusPendingApproval),
+	string(KybStatusRejected),
+	string(KybStatusApproved),
+}
+
+// KybStatusNames returns a list of possible string values of KybStatus.
+func KybStatusNames() []string {
+	tmp := make([]string, len(_KybStatusNames))
+	copy(tmp, _KybStatusNames)
 	return tmp
 }
 
 // String implements the Stringer interface.
-func (x TaxIDType) String() string {
+func (x KybStatus) String() string {
 	return string(x)
 }
 
-// IsValid provides a quick way to determine if the typed value is
-// part of the allowed enumerated values
-func (x TaxIDType) IsValid() bool {
-	_, err := ParseTaxIDType(string(x))
+// IsValid reports whether x is one of the enumerated values, ignoring case.
+func (x KybStatus) IsValid() bool {
+	_, err := ParseKybStatus(string(x))
 	return err == nil
 }
 
-var _TaxIDTypeValue = map[string]TaxIDType{
-	"SSN":    TaxIDTypeSSN,
-	"ssn":    TaxIDTypeSSN,
-	"EIN":    TaxIDTypeEIN,
-	"ein":    TaxIDTypeEIN,
-	"TFN":    TaxIDTypeTFN,
-	"tfn":    TaxIDTypeTFN,
-	"ABN":    TaxIDTypeABN,
-	"abn":    TaxIDTypeABN,
-	"ACN":    TaxIDTypeACN,
-	"acn":    TaxIDTypeACN,
-	"UTR":    TaxIDTypeUTR,
-	"utr":    TaxIDTypeUTR,
-	"NINO":   TaxIDTypeNINO,
-	"nino":   TaxIDTypeNINO,
-	"NRIC":   TaxIDTypeNRIC,
-	"nric":   TaxIDTypeNRIC,
-	"FIN":    TaxIDTypeFIN,
-	"fin":    TaxIDTypeFIN,
-	"ASDG":   TaxIDTypeASDG,
-	"asdg":   TaxIDTypeASDG,
-	"ITR":    TaxIDTypeITR,
-	"itr":    TaxIDTypeITR,
-	"NIF":    TaxIDTypeNIF,
-	"nif":    TaxIDTypeNIF,
-	"TIN":    TaxIDTypeTIN,
-	"tin":    TaxIDTypeTIN,
-	"VAT":    TaxIDTypeVAT,
-	"vat":    TaxIDTypeVAT,
-	"CUIL":   TaxIDTypeCUIL,
-	"cuil":   TaxIDTypeCUIL,
-	"CUIT":   TaxIDTypeCUIT,
-	"cuit":   TaxIDTypeCUIT,
-	"DNI":    TaxIDTypeDNI,
-	"dni":    TaxIDTypeDNI,
-	"BIN":    TaxIDTypeBIN,
-	"bin":    TaxIDTypeBIN,
-	"UNP":    TaxIDTypeUNP,
-	"unp":    TaxIDTypeUNP,
-	"RNPM":   TaxIDTypeRNPM,
-	"rnpm":   TaxIDTypeRNPM,
-	"NIT":    TaxIDTypeNIT,
-	"nit":    TaxIDTypeNIT,
-	"CPF":    TaxIDTypeCPF,
-	"cpf":    TaxIDTypeCPF,
-	"CNPJ":   TaxIDTypeCNPJ,
-	"cnpj":   TaxIDTypeCNPJ,
-	"NIRE":   TaxIDTypeNIRE,
-	"nire":   TaxIDTypeNIRE,
-	"UCN":    TaxIDTypeUCN,
-	"ucn":    TaxIDTypeUCN,
-	"UIC":    TaxIDTypeUIC,
-	"uic":    TaxIDTypeUIC,
-	"SIN":    TaxIDTypeSIN,
-	"sin":    TaxIDTypeSIN,
-	"BN":     TaxIDTypeBN,
-	"bn":     TaxIDTypeBN,
-	"RUT":    TaxIDTypeRUT,
-	"rut":    TaxIDTypeRUT,
-	"IIN":    TaxIDTypeIIN,
-	"iin":    TaxIDTypeIIN,
-	"USCC":   TaxIDTypeUSCC,
-	"uscc":   TaxIDTypeUSCC,
-	"CNOC":   TaxIDTypeCNOC,
-	"cnoc":   TaxIDTypeCNOC,
-	"USCN":   TaxIDTypeUSCN,
-	"uscn":   TaxIDTypeUSCN,
-	"ITIN":   TaxIDTypeITIN,
-	"itin":   TaxIDTypeITIN,
-	"CPJ":    TaxIDTypeCPJ,
-	"cpj":    TaxIDTypeCPJ,
-	"OIB":    TaxIDTypeOIB,
-	"oib":    TaxIDTypeOIB,
-	"DIC":    TaxIDTypeDIC,
-	"dic":    TaxIDTypeDIC,
-	"CPR":    TaxIDTypeCPR,
-	"cpr":    TaxIDTypeCPR,
-	"CVR":    TaxIDTypeCVR,
-	"cvr":    TaxIDTypeCVR,
-	"CN":     TaxIDTypeCN,
-	"cn":     TaxIDTypeCN,
-	"RNC":    TaxIDTypeRNC,
-	"rnc":    TaxIDTypeRNC,
-	"RUC":    TaxIDTypeRUC,
-	"ruc":    TaxIDTypeRUC,
-	"TN":     TaxIDTypeTN,
-	"tn":     TaxIDTypeTN,
-	"HETU":   TaxIDTypeHETU,
-	"hetu":   TaxIDTypeHETU,
-	"YT":     TaxIDTypeYT,
-	"yt":     TaxIDTypeYT,
-	"ALV":    TaxIDTypeALV,
-	"alv":    TaxIDTypeALV,
-	"SIREN":  TaxIDTypeSIREN,
-	"siren":  TaxIDTypeSIREN,
-	"IDNR":   TaxIDTypeIDNR,
-	"idnr":   TaxIDTypeIDNR,
-	"STNR":   TaxIDTypeSTNR,
-	"stnr":   TaxIDTypeSTNR,
-	"VTA":    TaxIDTypeVTA,
-	"vta":    TaxIDTypeVTA,
-	"HKID":   TaxIDTypeHKID,
-	"hkid":   TaxIDTypeHKID,
-	"AJ":     TaxIDTypeAJ,
-	"aj":     TaxIDTypeAJ,
-	"EN":     TaxIDTypeEN,
-	"en":     TaxIDTypeEN,
-	"KN":     TaxIDTypeKN,
-	"kn":     TaxIDTypeKN,
-	"VSK":    TaxIDTypeVSK,
-	"vsk":    TaxIDTypeVSK,
-	"PAN":    TaxIDTypePAN,
-	"pan":    TaxIDTypePAN,
-	"GSTN":   TaxIDTypeGSTN,
-	"gstn":   TaxIDTypeGSTN,
-	"NIK":    TaxIDTypeNIK,
-	"nik":    TaxIDTypeNIK,
-	"NPWP":   TaxIDTypeNPWP,
-	"npwp":   TaxIDTypeNPWP,
-	"PPS":    TaxIDTypePPS,
-	"pps":    TaxIDTypePPS,
-	"TRN":    TaxIDTypeTRN,
-	"trn":    TaxIDTypeTRN,
-	"CRO":    TaxIDTypeCRO,
-	"cro":    TaxIDTypeCRO,
-	"CHY":    TaxIDTypeCHY,
-	"chy":    TaxIDTypeCHY,
-	"CF":     TaxIDTypeCF,
-	"cf":     TaxIDTypeCF,
-	"IVA":    TaxIDTypeIVA,
-	"iva":    TaxIDTypeIVA,
-	"IN":     TaxIDTypeIN,
-	"in":     TaxIDTypeIN,
-	"JCT":    TaxIDTypeJCT,
-	"jct":    TaxIDTypeJCT,
-	"EDRPOU": TaxIDTypeEDRPOU,
-	"edrpou": TaxIDTypeEDRPOU,
-	"EID":    TaxIDTypeEID,
-	"eid":    TaxIDTypeEID,
+var _KybStatusValue = map[string]KybStatus{
+	"init":             KybStatusInit,
+	"pending_review":   KybStatusPendingReview,
+	"under_review":     KybStatusUnderReview,
+	"pending_response": KybStatusPendingResponse,
+	"escalated":        KybStatusEscalated,
+	"pending_approval": KybStatusPendingApproval,
+	"rejected":         KybStatusRejected,
+	"approved":         KybStatusApproved,
 }
 
-// ParseTaxIDType attempts to convert a string to a TaxIDType.
-func ParseTaxIDType(name string) (TaxIDType, error) {
-	if x, ok := _TaxIDTypeValue[name]; ok {
+// ParseKybStatus converts a string to a KybStatus, ignoring case.
+func ParseKybStatus(name string) (KybStatus, error) {
+	if x, ok := _KybStatusValue[name]; ok {
 		return x, nil
 	}
-	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
-	if x, ok := _TaxIDTypeValue[strings.ToLower(name)]; ok {
+	if x, ok := _KybStatusValue[strings.ToLower(name)]; ok {
 		return x, nil
 	}
-	return TaxIDType(""), fmt.Errorf("%s is %w", name, ErrInvalidTaxIDType)
+	return KybStatus(""), fmt.Errorf("%s is %w", name, ErrInvalidKybStatus)
 }
 
-// MarshalText implements the text marshaller method.
-func (x TaxIDType) MarshalText() ([]byte, error) {
+// MarshalText implements encoding.TextMarshaler.
+func (x KybStatus) MarshalText() ([]byte, error) {
 	return []byte(string(x)), nil
 }
 
-// UnmarshalText implements the text unmarshaller method.
-func (x *TaxIDType) UnmarshalText(text []byte) error {
-	tmp, err := ParseTaxIDType(string(text))
+// UnmarshalText implements encoding.TextUnmarshaler. It rejects unknown values.
+func (x *KybStatus) UnmarshalText(text []byte) error {
+	tmp, err := ParseKybStatus(string(text))
 	if err != nil {
 		return err
 	}
@@ -1588,10 +1737,20 @@ func (x *TaxIDType) UnmarshalText(text []byte) error {
 	return nil
 }
 
-// AppendText appends the textual representation of itself to the end of b
-// (allocating a larger slice if necessary) and returns the updated slice.
-//
-// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
-func (x *TaxIDType) AppendText(b []byte) ([]byte, error) {
+// AppendText implements encoding.TextAppender.
+func (x *KybStatus) AppendText(b []byte) ([]byte, error) {
 	return append(b, x.String()...), nil
 }
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts only a JSON string holding one
+// of the enumerated values; null leaves x unchanged.
+func (x *KybStatus) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("%s is %w", data, ErrInvalidKybStatus)
+	}
+	return x.UnmarshalText([]byte(s))
+}
@@ -17,7 +17,6 @@
 package customer
 
 import (
-	"context"
 	"encoding/base64"
 	"fmt"
 	"os"
@@ -25,12 +24,11 @@ import (
 	"strings"
 	"time"
 
-	"go.uber.org/zap"
-
-	"github.com/1Money-Co/1money-go-sdk/internal/utils"
 	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
 )
 
+//go:generate go run ../../../cmd/tools/svcgen waiters waiters.yaml
+
 // EncodeFileToDataURI reads a file and encodes it as a data-uri string.
 // The format parameter specifies the image format (jpeg, jpg, png, heic, tif).
 // If format is empty, it will be detected from the file extension.
@@ -202,91 +200,44 @@ func IsDataURI(s string) bool {
 	return false
 }
 
-// WaitOptions configures the polling behavior for wait functions.
-type WaitOptions struct {
-	// PollInterval is the interval between polling attempts. Default: 1s.
-	PollInterval time.Duration
-	// MaxWaitTime is the maximum duration to wait. Default: 60m.
-	MaxWaitTime time.Duration
-	// Logger is an optional zap logger for logging polling progress.
-	Logger *zap.Logger
-	// PrintProgress prints polling progress to stdout using standard log package.
-	// This is useful for examples and debugging when zap logger is not available.
-	PrintProgress bool
-}
+// fiatAccountWaitDuration is the delay for waiting on fiat account setup.
+const fiatAccountWaitDuration = 60 * time.Second
 
-// DefaultWaitOptions returns the default wait options.
-func DefaultWaitOptions() WaitOptions {
-	return WaitOptions{
-		PollInterval: 1 * time.Second,
-		MaxWaitTime:  60 * time.Minute,
-	}
+func WaitForFaitAccount() {
+	time.Sleep(fiatAccountWaitDuration)
 }
 
-// CustomerCondition is a function that checks if a customer meets a condition.
-type CustomerCondition func(*CustomerResponse) bool
-
-// WaitFor polls until the condition returns true.
-// Returns the customer response when condition is met, or an error on timeout/failure.
-func WaitFor(ctx context.Context,
-	service Service,
-	customerID svc.CustomerID,
-	condition CustomerCondition,
-	opts *WaitOptions,
-) (*CustomerResponse, error) {
-	defaults := DefaultWaitOptions()
-	if opts == nil {
-		opts = &defaults
-	}
-
-	utilOpts := &utils.WaitOptions{
-		PollInterval:  opts.PollInterval,
-		MaxWaitTime:   opts.MaxWaitTime,
-		Logger:        opts.Logger,
-		LogMessage:    "polling customer status",
-		PrintProgress: opts.PrintProgress,
-	}
-
-	return utils.WaitFor(
-		ctx,
-		func(ctx context.Context) (*CustomerResponse, error) {
-			return service.GetCustomer(ctx, customerID)
-		},
-		utils.Condition[CustomerResponse](condition),
-		func(c *CustomerResponse) string { return string(c.Status) },
-		"customer",
-		customerID,
-		utilOpts,
-	)
+// SubmittedTime parses SubmittedAt into a time.Time, returning the zero time if it is not set.
+func (r *CustomerResponse) SubmittedTime() (time.Time, error) {
+	return svc.ParseOptionalTimestamp(&r.SubmittedAt)
 }
 
-// WaitForKybApproved polls until the customer's KYB status becomes APPROVED.
-func WaitForKybApproved(ctx context.Context, service Service, customerID svc.CustomerID, opts *WaitOptions) (*CustomerResponse, error) {
-	return WaitFor(ctx, service, customerID, func(c *CustomerResponse) bool {
-		return c.Status == KybStatusApproved
-	}, opts)
+// CreatedTime parses CreatedAt into a time.Time.
+func (r *CustomerResponse) CreatedTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.CreatedAt)
 }
 
-// WaitForKybDecision polls until the customer's KYB status becomes APPROVED or REJECTED.
-// Returns the customer response and nil error if approved, or an error if rejected or timeout.
-func WaitForKybDecision(ctx context.Context, service Service, customerID svc.CustomerID, opts *WaitOptions) (*CustomerResponse, error) {
-	cust, err := WaitFor(ctx, service, customerID, func(c *CustomerResponse) bool {
-		return c.Status == KybStatusApproved || c.Status == KybStatusRejected
-	}, opts)
-	if err != nil {
-		return nil, err
-	}
+// UpdatedTime parses UpdatedAt into a time.Time.
+func (r *CustomerResponse) UpdatedTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.UpdatedAt)
+}
 
-	if cust.Status == KybStatusRejected {
-		return cust, fmt.Errorf("KYB rejected for customer %s", customerID)
-	}
+// CreatedTime parses CreatedAt into a time.Time.
+func (r *CustomerSummary) CreatedTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.CreatedAt)
+}
 
-	return cust, nil
+// UpdatedTime parses UpdatedAt into a time.Time.
+func (r *CustomerSummary) UpdatedTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.UpdatedAt)
 }
 
-// fiatAccountWaitDuration is the delay for waiting on fiat account setup.
-const fiatAccountWaitDuration = 60 * time.Second
+// CreatedTime parses CreatedAt into a time.Time.
+func (r *AssociatedPersonResponse) CreatedTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.CreatedAt)
+}
 
-func WaitForFaitAccount() {
-	time.Sleep(fiatAccountWaitDuration)
+// UpdatedTime parses UpdatedAt into a time.Time.
+func (r *AssociatedPersonResponse) UpdatedTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.UpdatedAt)
 }
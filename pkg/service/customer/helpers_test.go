@@ -19,6 +19,7 @@ package customer
 import (
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
 )
@@ -198,3 +199,45 @@ func TestIsDataURI(t *testing.T) {
 		})
 	}
 }
+
+func TestRequiredDocuments(t *testing.T) {
+	docTypes := func(docs []Document) []DocumentType {
+		types := make([]DocumentType, len(docs))
+		for i, doc := range docs {
+			types[i] = doc.DocType
+		}
+		return types
+	}
+
+	// Corporations in the US provide the same documents as the e2e suite and the examples.
+	corporation := RequiredDocuments(BusinessTypeCorporation, "USA")
+	want := []DocumentType{
+		DocumentTypeFlowOfFunds,
+		DocumentTypeRegistrationDocument,
+		DocumentTypeProofOfTaxIdentification,
+		DocumentTypeShareholderRegister,
+		DocumentTypeESignatureCertificate,
+		DocumentTypeEvidenceOfGoodStanding,
+		DocumentTypeProofOfAddress,
+	}
+	if got := docTypes(corporation); !slices.Equal(got, want) {
+		t.Errorf("RequiredDocuments(corporation, USA) = %v, want %v", got, want)
+	}
+	if corporation[2].Description != "W9 Form" {
+		t.Errorf("US tax document = %q, want W9 Form", corporation[2].Description)
+	}
+
+	for _, name := range BusinessTypeNames() {
+		businessType := BusinessType(name)
+		docs := RequiredDocuments(businessType, "DEU")
+		if len(docs) < 4 || docs[0].DocType != DocumentTypeFlowOfFunds ||
+			docs[len(docs)-1].DocType != DocumentTypeProofOfAddress {
+			t.Errorf("RequiredDocuments(%s, DEU) = %v", businessType, docTypes(docs))
+		}
+		for _, doc := range docs {
+			if doc.Description == "" {
+				t.Errorf("RequiredDocuments(%s, DEU): %s has no description", businessType, doc.DocType)
+			}
+		}
+	}
+}
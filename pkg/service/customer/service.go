@@ -344,7 +344,7 @@ type (
 	// This structure is used for customer creation, retrieval, and update operations.
 	CustomerResponse struct {
 		// CustomerID is the unique identifier of the customer.
-		CustomerID string `json:"customer_id"`
+		CustomerID svc.CustomerID `json:"customer_id"`
 		// Email is the primary contact email for the customer.
 		Email string `json:"email"`
 		// BusinessLegalName is the legal business name.
@@ -471,7 +471,7 @@ type (
 	// CustomerSummary represents a summary of a customer account in list responses.
 	CustomerSummary struct {
 		// CustomerID is the unique identifier of the customer.
-		CustomerID string `json:"customer_id"`
+		CustomerID svc.CustomerID `json:"customer_id"`
 		// Email is the primary contact email.
 		Email string `json:"email"`
 		// BusinessLegalName is the legal business name.
@@ -0,0 +1,123 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by svcgen waiters from waiters.yaml. DO NOT EDIT.
+
+package customer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/1Money-Co/1money-go-sdk/internal/utils"
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+)
+
+// WaitOptions configures the polling behavior for wait functions.
+type WaitOptions struct {
+	// PollInterval is the interval between polling attempts. Default: 1s.
+	PollInterval time.Duration
+	// MaxWaitTime is the maximum duration to wait. Default: 60m.
+	MaxWaitTime time.Duration
+	// Logger is an optional zap logger for logging polling progress.
+	Logger *zap.Logger
+	// PrintProgress prints polling progress to stdout using standard log package.
+	// This is useful for examples and debugging when zap logger is not available.
+	PrintProgress bool
+}
+
+// DefaultWaitOptions returns the default wait options.
+func DefaultWaitOptions() WaitOptions {
+	return WaitOptions{
+		PollInterval: time.Second,
+		MaxWaitTime:  60 * time.Minute,
+	}
+}
+
+// CustomerCondition is a function that checks if a customer meets a condition.
+type CustomerCondition func(*CustomerResponse) bool
+
+// WaitFor polls until the condition returns true.
+// Returns the customer response when condition is met, or an error on timeout/failure.
+func WaitFor(
+	ctx context.Context,
+	service Service,
+	customerID svc.CustomerID,
+	condition CustomerCondition,
+	opts *WaitOptions,
+) (*CustomerResponse, error) {
+	defaults := DefaultWaitOptions()
+	if opts == nil {
+		opts = &defaults
+	}
+
+	utilOpts := &utils.WaitOptions{
+		PollInterval:  opts.PollInterval,
+		MaxWaitTime:   opts.MaxWaitTime,
+		Logger:        opts.Logger,
+		LogMessage:    "polling customer status",
+		PrintProgress: opts.PrintProgress,
+	}
+
+	return utils.WaitFor(
+		ctx,
+		func(ctx context.Context) (*CustomerResponse, error) {
+			return service.GetCustomer(ctx, customerID)
+		},
+		utils.Condition[CustomerResponse](condition),
+		func(c *CustomerResponse) string { return string(c.Status) },
+		"customer",
+		string(customerID),
+		utilOpts,
+	)
+}
+
+// WaitForKybApproved polls until the customer's KYB status becomes APPROVED.
+func WaitForKybApproved(
+	ctx context.Context,
+	service Service,
+	customerID svc.CustomerID,
+	opts *WaitOptions,
+) (*CustomerResponse, error) {
+	return WaitFor(ctx, service, customerID, func(c *CustomerResponse) bool {
+		return c.Status == KybStatusApproved
+	}, opts)
+}
+
+// WaitForKybDecision polls until the customer's KYB status becomes APPROVED or REJECTED.
+// Returns the customer response and nil error if approved, or an error if rejected or timeout.
+func WaitForKybDecision(
+	ctx context.Context,
+	service Service,
+	customerID svc.CustomerID,
+	opts *WaitOptions,
+) (*CustomerResponse, error) {
+	c, err := WaitFor(ctx, service, customerID, func(c *CustomerResponse) bool {
+		return c.Status == KybStatusApproved || c.Status == KybStatusRejected
+	}, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	switch c.Status {
+	case KybStatusRejected:
+		return c, fmt.Errorf("KYB rejected for customer %s", customerID)
+	}
+	return c, nil
+}
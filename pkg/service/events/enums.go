@@ -0,0 +1,33 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package events
+
+//go:generate go tool go-enum -f=$GOFILE --marshal --names --nocase
+
+// EventType represents the kind of change an account event records.
+/* ENUM(
+BALANCE_CHANGED,
+TRANSACTION_CREATED,
+TRANSACTION_STATUS_CHANGED,
+KYB_STATUS_CHANGED,
+EXTERNAL_ACCOUNT_STATUS_CHANGED,
+AUTO_CONVERSION_ORDER_EXECUTED,
+SWEEP_RULE_EXECUTED,
+SCHEDULED_WITHDRAWAL_EXECUTED
+)
+*/
+type EventType string
@@ -0,0 +1,116 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: v0.9.2
+
+// Built By: go install
+
+package events
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// EventTypeBALANCECHANGED is a EventType of type BALANCE_CHANGED.
+	EventTypeBALANCECHANGED EventType = "BALANCE_CHANGED"
+	// EventTypeTRANSACTIONCREATED is a EventType of type TRANSACTION_CREATED.
+	EventTypeTRANSACTIONCREATED EventType = "TRANSACTION_CREATED"
+	// EventTypeTRANSACTIONSTATUSCHANGED is a EventType of type TRANSACTION_STATUS_CHANGED.
+	EventTypeTRANSACTIONSTATUSCHANGED EventType = "TRANSACTION_STATUS_CHANGED"
+	// EventTypeKYBSTATUSCHANGED is a EventType of type KYB_STATUS_CHANGED.
+	EventTypeKYBSTATUSCHANGED EventType = "KYB_STATUS_CHANGED"
+	// EventTypeEXTERNALACCOUNTSTATUSCHANGED is a EventType of type EXTERNAL_ACCOUNT_STATUS_CHANGED.
+	EventTypeEXTERNALACCOUNTSTATUSCHANGED EventType = "EXTERNAL_ACCOUNT_STATUS_CHANGED"
+	// EventTypeAUTOCONVERSIONORDEREXECUTED is a EventType of type AUTO_CONVERSION_ORDER_EXECUTED.
+	EventTypeAUTOCONVERSIONORDEREXECUTED EventType = "AUTO_CONVERSION_ORDER_EXECUTED"
+	// EventTypeSWEEPRULEEXECUTED is a EventType of type SWEEP_RULE_EXECUTED.
+	EventTypeSWEEPRULEEXECUTED EventType = "SWEEP_RULE_EXECUTED"
+	// EventTypeSCHEDULEDWITHDRAWALEXECUTED is a EventType of type SCHEDULED_WITHDRAWAL_EXECUTED.
+	EventTypeSCHEDULEDWITHDRAWALEXECUTED EventType = "SCHEDULED_WITHDRAWAL_EXECUTED"
+)
+
+var ErrInvalidEventType = fmt.Errorf("not a valid EventType, try [%s]", strings.Join(_EventTypeNames, ", "))
+
+var _EventTypeNames = []string{
+	string(EventTypeBALANCECHANGED),
+	string(EventTypeTRANSACTIONCREATED),
+	string(EventTypeTRANSACTIONSTATUSCHANGED),
+	string(EventTypeKYBSTATUSCHANGED),
+	string(EventTypeEXTERNALACCOUNTSTATUSCHANGED),
+	string(EventTypeAUTOCONVERSIONORDEREXECUTED),
+	string(EventTypeSWEEPRULEEXECUTED),
+	string(EventTypeSCHEDULEDWITHDRAWALEXECUTED),
+}
+
+// EventTypeNames returns a list of possible string values of EventType.
+func EventTypeNames() []string {
+	tmp := make([]string, len(_EventTypeNames))
+	copy(tmp, _EventTypeNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x EventType) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x EventType) IsValid() bool {
+	_, err := ParseEventType(string(x))
+	return err == nil
+}
+
+var _EventTypeValue = map[string]EventType{
+	"BALANCE_CHANGED":                 EventTypeBALANCECHANGED,
+	"balance_changed":                 EventTypeBALANCECHANGED,
+	"TRANSACTION_CREATED":             EventTypeTRANSACTIONCREATED,
+	"transaction_created":             EventTypeTRANSACTIONCREATED,
+	"TRANSACTION_STATUS_CHANGED":      EventTypeTRANSACTIONSTATUSCHANGED,
+	"transaction_status_changed":      EventTypeTRANSACTIONSTATUSCHANGED,
+	"KYB_STATUS_CHANGED":              EventTypeKYBSTATUSCHANGED,
+	"kyb_status_changed":              EventTypeKYBSTATUSCHANGED,
+	"EXTERNAL_ACCOUNT_STATUS_CHANGED": EventTypeEXTERNALACCOUNTSTATUSCHANGED,
+	"external_account_status_changed": EventTypeEXTERNALACCOUNTSTATUSCHANGED,
+	"AUTO_CONVERSION_ORDER_EXECUTED":  EventTypeAUTOCONVERSIONORDEREXECUTED,
+	"auto_conversion_order_executed":  EventTypeAUTOCONVERSIONORDEREXECUTED,
+	"SWEEP_RULE_EXECUTED":             EventTypeSWEEPRULEEXECUTED,
+	"sweep_rule_executed":             EventTypeSWEEPRULEEXECUTED,
+	"SCHEDULED_WITHDRAWAL_EXECUTED":   EventTypeSCHEDULEDWITHDRAWALEXECUTED,
+	"scheduled_withdrawal_executed":   EventTypeSCHEDULEDWITHDRAWALEXECUTED,
+}
+
+// ParseEventType attempts to convert a string to a EventType.
+func ParseEventType(name string) (EventType, error) {
+	if x, ok := _EventTypeValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _EventTypeValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return EventType(""), fmt.Errorf("%s is %w", name, ErrInvalidEventType)
+}
+
+// MarshalText implements the text marshaller method.
+func (x EventType) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *EventType) UnmarshalText(text []byte) error {
+	tmp, err := ParseEventType(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *EventType) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
@@ -0,0 +1,46 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+)
+
+// ErrNoData is returned by Decode when the event has no payload.
+var ErrNoData = errors.New("event has no data")
+
+// Decode unmarshals the event payload into v, e.g. a *transactions.TransactionResponse
+// for TRANSACTION_STATUS_CHANGED events.
+func (e *Event) Decode(v any) error {
+	if len(e.Data) == 0 || string(e.Data) == "null" {
+		return ErrNoData
+	}
+	if err := json.Unmarshal(e.Data, v); err != nil {
+		return fmt.Errorf("failed to decode %s event data: %w", e.Type, err)
+	}
+	return nil
+}
+
+// OccurredTime parses OccurredAt into a time.Time.
+func (e *Event) OccurredTime() (time.Time, error) {
+	return svc.ParseTimestamp(e.OccurredAt)
+}
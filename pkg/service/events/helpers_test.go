@@ -0,0 +1,39 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package events
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestEvent_Decode(t *testing.T) {
+	event := &Event{Type: EventTypeBALANCECHANGED, Data: []byte(`{"asset":"USD","available_amount":"10.00"}`)}
+	var data struct {
+		Asset           string `json:"asset"`
+		AvailableAmount string `json:"available_amount"`
+	}
+	if err := event.Decode(&data); err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if data.Asset != "USD" || data.AvailableAmount != "10.00" {
+		t.Errorf("Decode() = %+v", data)
+	}
+	if err := (&Event{}).Decode(&data); !errors.Is(err, ErrNoData) {
+		t.Errorf("Decode() without data error = %v, want ErrNoData", err)
+	}
+}
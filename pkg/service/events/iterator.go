@@ -0,0 +1,63 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+)
+
+// All iterates over every event after sinceCursor, oldest first.
+func (s *serviceImpl) All(ctx context.Context, id svc.CustomerID, sinceCursor string) iter.Seq2[*Event, error] {
+	return allEvents(ctx, s, id, sinceCursor)
+}
+
+func allEvents(ctx context.Context, service Service, id svc.CustomerID, cursor string) iter.Seq2[*Event, error] {
+	return func(yield func(*Event, error) bool) {
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(nil, err)
+				return
+			}
+
+			resp, err := service.List(ctx, id, cursor)
+			if err != nil {
+				yield(nil, fmt.Errorf("failed to list events after cursor %q: %w", cursor, err))
+				return
+			}
+
+			for i := range resp.Events {
+				if !yield(&resp.Events[i], nil) {
+					return
+				}
+			}
+
+			if !resp.HasMore {
+				return
+			}
+			if resp.NextCursor == "" || resp.NextCursor == cursor {
+				yield(nil, errors.New("event feed did not advance the cursor"))
+				return
+			}
+			cursor = resp.NextCursor
+		}
+	}
+}
@@ -0,0 +1,96 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package events
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+)
+
+// fakeFeedService serves a fixed feed in pages, using event positions as cursors.
+type fakeFeedService struct {
+	Service
+	events   []Event
+	pageSize int
+	cursors  []string
+}
+
+func (f *fakeFeedService) List(_ context.Context, _ svc.CustomerID, sinceCursor string) (*ListResponse, error) {
+	f.cursors = append(f.cursors, sinceCursor)
+	start := 0
+	if sinceCursor != "" {
+		n, err := strconv.Atoi(sinceCursor)
+		if err != nil {
+			return nil, err
+		}
+		start = n + 1
+	}
+	end := min(start+f.pageSize, len(f.events))
+	page := f.events[start:end]
+	resp := &ListResponse{Events: page, NextCursor: sinceCursor, HasMore: end < len(f.events)}
+	if len(page) > 0 {
+		resp.NextCursor = page[len(page)-1].Cursor
+	}
+	return resp, nil
+}
+
+func TestAllEvents(t *testing.T) {
+	feed := make([]Event, 25)
+	for i := range feed {
+		feed[i] = Event{EventID: fmt.Sprintf("ev-%02d", i), Cursor: strconv.Itoa(i)}
+	}
+	service := &fakeFeedService{events: feed, pageSize: 10}
+
+	var ids []string
+	for event, err := range allEvents(context.Background(), service, "cid", "4") {
+		if err != nil {
+			t.Fatalf("allEvents() error = %v", err)
+		}
+		ids = append(ids, event.EventID)
+	}
+
+	if len(ids) != 20 || ids[0] != "ev-05" || ids[len(ids)-1] != "ev-24" {
+		t.Fatalf("got %d events (%v), want ev-05..ev-24", len(ids), ids)
+	}
+	wantCursors := []string{"4", "14"}
+	if fmt.Sprint(service.cursors) != fmt.Sprint(wantCursors) {
+		t.Errorf("got cursors %v, want %v", service.cursors, wantCursors)
+	}
+}
+
+// stuckFeedService reports more events without advancing the cursor.
+type stuckFeedService struct {
+	Service
+}
+
+func (stuckFeedService) List(_ context.Context, _ svc.CustomerID, sinceCursor string) (*ListResponse, error) {
+	return &ListResponse{NextCursor: sinceCursor, HasMore: true}, nil
+}
+
+func TestAllEvents_StuckCursor(t *testing.T) {
+	var gotErr error
+	for _, err := range allEvents(context.Background(), stuckFeedService{}, "cid", "c1") {
+		gotErr = err
+	}
+	if gotErr == nil {
+		t.Error("allEvents() should fail when the cursor does not advance")
+	}
+}
@@ -0,0 +1,141 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package events provides a pull-based feed of account events.
+//
+// This package implements the events service client for the 1Money platform,
+// returning every change to a customer account (balance changes, status transitions,
+// rule executions) in a single ordered feed with a resumable cursor. It is an
+// alternative to webhooks for consumers that cannot receive inbound requests.
+//
+// # Basic Usage
+//
+//	import (
+//	    "context"
+//	    onemoney "github.com/1Money-Co/1money-go-sdk/pkg/onemoney"
+//	)
+//
+//	// Create client
+//	client, err := onemoney.NewClient(&onemoney.Config{
+//	    AccessKey: "your-access-key",
+//	    SecretKey: "your-secret-key",
+//	})
+//
+//	// Process every event after the stored cursor, persisting progress as you go
+//	for event, err := range client.Events.All(ctx, "customer-id", storedCursor) {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    handle(event)
+//	    storedCursor = event.Cursor
+//	}
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+)
+
+// Service defines the events service interface for reading the account event feed.
+type Service interface {
+	// List retrieves the next page of events after sinceCursor, oldest first.
+	// An empty cursor starts from the beginning of the retained feed.
+	List(ctx context.Context, id svc.CustomerID, sinceCursor string) (*ListResponse, error)
+	// All iterates over every event after sinceCursor, oldest first, following cursors automatically.
+	// The iteration ends when the feed is caught up.
+	All(ctx context.Context, id svc.CustomerID, sinceCursor string) iter.Seq2[*Event, error]
+	// Latest retrieves the newest event in the feed, or nil if the feed is empty.
+	// Pass its Cursor to List or All to read only the events that happen after it.
+	Latest(ctx context.Context, id svc.CustomerID) (*Event, error)
+}
+
+// Event response types.
+type (
+	// Event represents a single change to a customer account.
+	Event struct {
+		// EventID is the unique event identifier.
+		EventID string `json:"event_id"`
+		// Cursor is the position of the event in the feed; pass it to List to resume after this event.
+		Cursor string `json:"cursor"`
+		// Type is the kind of change.
+		Type EventType `json:"type"`
+		// ResourceType is the kind of resource that changed, e.g. transaction or external_account.
+		ResourceType string `json:"resource_type"`
+		// ResourceID is the ID of the resource that changed.
+		ResourceID string `json:"resource_id"`
+		// Data is the event payload; its shape depends on Type. Use Decode to unmarshal it.
+		Data json.RawMessage `json:"data,omitempty"`
+		// OccurredAt is when the change happened (ISO 8601 format).
+		OccurredAt string `json:"occurred_at"`
+	}
+
+	// ListResponse represents a page of the event feed.
+	ListResponse struct {
+		// Events are the events after the requested cursor, oldest first.
+		Events []Event `json:"events"`
+		// NextCursor is the cursor to request the following page; it equals the last event's cursor.
+		NextCursor string `json:"next_cursor"`
+		// HasMore reports whether more events are available after NextCursor.
+		HasMore bool `json:"has_more"`
+	}
+)
+
+type serviceImpl struct {
+	*svc.BaseService
+}
+
+// NewService creates a new events service instance with the given base service.
+func NewService(base *svc.BaseService) Service {
+	return &serviceImpl{
+		BaseService: base,
+	}
+}
+
+// List retrieves the next page of events after sinceCursor.
+func (s *serviceImpl) List(ctx context.Context, id svc.CustomerID, sinceCursor string) (*ListResponse, error) {
+	path := fmt.Sprintf("/v1/customers/%s/events", id)
+
+	params := make(map[string]string)
+	if sinceCursor != "" {
+		params["since"] = sinceCursor
+	}
+
+	return svc.GetJSONWithParams[ListResponse](ctx, s.BaseService, path, params)
+}
+
+// Latest retrieves the newest event in the feed by requesting a single event in descending order.
+func (s *serviceImpl) Latest(ctx context.Context, id svc.CustomerID) (*Event, error) {
+	path := fmt.Sprintf("/v1/customers/%s/events", id)
+
+	params := map[string]string{
+		"sort_order": string(assets.SortOrderDESC),
+		"size":       "1",
+	}
+
+	resp, err := svc.GetJSONWithParams[ListResponse](ctx, s.BaseService, path, params)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Events) == 0 {
+		return nil, nil
+	}
+	return &resp.Events[0], nil
+}
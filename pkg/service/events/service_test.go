@@ -0,0 +1,96 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package events_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/onemoney"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/events"
+)
+
+// newFeedClient returns a client of a server that answers event list requests with page
+// and records the query of each request.
+func newFeedClient(t *testing.T, page events.ListResponse, queries *[]map[string]string) *onemoney.Client {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := make(map[string]string)
+		for key := range r.URL.Query() {
+			query[key] = r.URL.Query().Get(key)
+		}
+		*queries = append(*queries, query)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(page)
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := onemoney.NewClient(&onemoney.Config{
+		BaseURL:   server.URL,
+		AccessKey: "test-access-key",
+		SecretKey: "test-secret-key",
+		Retry:     onemoney.NoRetryConfig(),
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	return client
+}
+
+func TestLatest(t *testing.T) {
+	tests := []struct {
+		name       string
+		page       events.ListResponse
+		wantCursor string
+	}{
+		{
+			name:       "newest event",
+			page:       events.ListResponse{Events: []events.Event{{EventID: "e9", Cursor: "c9", Type: events.EventTypeBALANCECHANGED}}, NextCursor: "c9", HasMore: true},
+			wantCursor: "c9",
+		},
+		{name: "empty feed", page: events.ListResponse{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var queries []map[string]string
+			client := newFeedClient(t, tt.page, &queries)
+
+			event, err := client.Events.Latest(context.Background(), "cid")
+			if err != nil {
+				t.Fatalf("Latest() error = %v", err)
+			}
+			if tt.wantCursor == "" {
+				if event != nil {
+					t.Errorf("Latest() = %+v, want nil", event)
+				}
+			} else if event == nil || event.Cursor != tt.wantCursor {
+				t.Errorf("Latest() = %+v, want cursor %q", event, tt.wantCursor)
+			}
+
+			if len(queries) != 1 {
+				t.Fatalf("got %d requests, want 1", len(queries))
+			}
+			if q := queries[0]; q["sort_order"] != "DESC" || q["size"] != "1" || q["since"] != "" {
+				t.Errorf("query = %v, want sort_order=DESC and size=1 without since", q)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package external_accounts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+
+	"github.com/1Money-Co/1money-go-sdk/internal/transport"
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+)
+
+// MaxVerificationDocumentSize is the largest verification document accepted for upload (10 MiB).
+const MaxVerificationDocumentSize = 10 << 20
+
+// Verification document errors.
+var (
+	// ErrDocumentTooLarge is returned when a verification document exceeds MaxVerificationDocumentSize.
+	ErrDocumentTooLarge = errors.New("verification document too large")
+	// ErrDocumentRequired is returned by WaitForApproved when the account is in DOCUMENT_REQUIRED status.
+	ErrDocumentRequired = errors.New("verification document required")
+)
+
+// VerificationDocument is a supporting document uploaded for external account verification.
+type VerificationDocument struct {
+	// Type is the kind of document (e.g., BANK_STATEMENT).
+	Type DocumentType
+	// FileName is the original file name, e.g. "statement-2025-01.pdf".
+	FileName string
+	// ContentType is the MIME type of the file (e.g., "application/pdf"). Default: application/octet-stream.
+	ContentType string
+	// Content is read until EOF and sent as the file body.
+	Content io.Reader
+}
+
+// UploadVerificationDocument uploads a supporting document for external account verification.
+// The content is streamed into a multipart body; requests are signed over the full body,
+// so it is buffered in memory and limited to MaxVerificationDocumentSize.
+func (s *serviceImpl) UploadVerificationDocument(
+	ctx context.Context,
+	id svc.CustomerID,
+	externalAccountID string,
+	doc *VerificationDocument,
+) (*Resp, error) {
+	body, contentType, err := encodeVerificationDocument(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/v1/customers/%s/external-accounts/%s/documents", id, externalAccountID)
+	resp, err := s.Do(ctx, &transport.Request{
+		Method: http.MethodPost,
+		Path:   path,
+		Body:   body,
+		Headers: map[string]string{
+			"Content-Type": contentType,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result Resp
+	if err := json.Unmarshal(resp.Body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// encodeVerificationDocument writes the document into a multipart/form-data body.
+func encodeVerificationDocument(doc *VerificationDocument) ([]byte, string, error) {
+	if doc == nil || doc.Content == nil {
+		return nil, "", errors.New("verification document content is required")
+	}
+	if !doc.Type.IsValid() {
+		return nil, "", fmt.Errorf("invalid verification document type %q", doc.Type)
+	}
+
+	fileName := doc.FileName
+	if fileName == "" {
+		fileName = "document"
+	}
+	contentType := doc.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	if err := mw.WriteField("document_type", string(doc.Type)); err != nil {
+		return nil, "", fmt.Errorf("failed to write document type: %w", err)
+	}
+
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", multipart.FileContentDisposition("file", fileName))
+	header.Set("Content-Type", contentType)
+	part, err := mw.CreatePart(header)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create file part: %w", err)
+	}
+
+	n, err := io.Copy(part, io.LimitReader(doc.Content, MaxVerificationDocumentSize+1))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read verification document: %w", err)
+	}
+	if n > MaxVerificationDocumentSize {
+		return nil, "", fmt.Errorf("%w: limit is %d bytes", ErrDocumentTooLarge, MaxVerificationDocumentSize)
+	}
+
+	if err := mw.Close(); err != nil {
+		return nil, "", fmt.Errorf("failed to finalize multipart body: %w", err)
+	}
+
+	return buf.Bytes(), mw.FormDataContentType(), nil
+}
@@ -0,0 +1,95 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package external_accounts
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"strings"
+	"testing"
+)
+
+func TestEncodeVerificationDocument(t *testing.T) {
+	doc := &VerificationDocument{
+		Type:        DocumentTypeBANKSTATEMENT,
+		FileName:    "statement.pdf",
+		ContentType: "application/pdf",
+		Content:     strings.NewReader("%PDF-1.7 statement"),
+	}
+
+	body, contentType, err := encodeVerificationDocument(doc)
+	if err != nil {
+		t.Fatalf("encodeVerificationDocument() error = %v", err)
+	}
+
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil || mediaType != "multipart/form-data" {
+		t.Fatalf("content type = %q, %v", contentType, err)
+	}
+
+	form, err := multipart.NewReader(bytes.NewReader(body), params["boundary"]).ReadForm(1 << 20)
+	if err != nil {
+		t.Fatalf("ReadForm() error = %v", err)
+	}
+	if got := form.Value["document_type"]; len(got) != 1 || got[0] != "BANK_STATEMENT" {
+		t.Errorf("document_type = %v", got)
+	}
+	files := form.File["file"]
+	if len(files) != 1 {
+		t.Fatalf("got %d files, want 1", len(files))
+	}
+	if files[0].Filename != "statement.pdf" || files[0].Header.Get("Content-Type") != "application/pdf" {
+		t.Errorf("file header = %+v", files[0].Header)
+	}
+	f, err := files[0].Open()
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	defer f.Close()
+	content, _ := io.ReadAll(f)
+	if string(content) != "%PDF-1.7 statement" {
+		t.Errorf("content = %q", content)
+	}
+}
+
+func TestEncodeVerificationDocument_Errors(t *testing.T) {
+	tooLarge := &VerificationDocument{
+		Type:    DocumentTypeBANKSTATEMENT,
+		Content: io.LimitReader(zeroReader{}, MaxVerificationDocumentSize+1),
+	}
+	if _, _, err := encodeVerificationDocument(tooLarge); !errors.Is(err, ErrDocumentTooLarge) {
+		t.Errorf("too large: error = %v, want ErrDocumentTooLarge", err)
+	}
+
+	if _, _, err := encodeVerificationDocument(&VerificationDocument{Type: "PAYSLIP", Content: strings.NewReader("x")}); err == nil {
+		t.Error("invalid type: expected error")
+	}
+	if _, _, err := encodeVerificationDocument(nil); err == nil {
+		t.Error("nil document: expected error")
+	}
+}
+
+// zeroReader is an endless reader of zero bytes.
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	clear(p)
+	return len(p), nil
+}
@@ -19,17 +19,24 @@ package external_accounts
 //go:generate go tool go-enum -f=$GOFILE --marshal --names --nocase
 
 // BankNetworkName represents the bank network type for external accounts.
-// ENUM(US_ACH, SWIFT, US_FEDWIRE)
+// ENUM(US_ACH, SWIFT, US_FEDWIRE, SEPA)
 type BankNetworkName string
 
 // Currency represents the supported currencies for external accounts.
-// ENUM(USD)
+// ENUM(USD, EUR)
 type Currency string
 
 // BankAccountStatus represents the status of an external bank account.
-// ENUM(PENDING, APPROVED, FAILED)
+// MICRO_DEPOSITS_PENDING means micro-deposits were sent and await confirmation;
+// MICRO_DEPOSITS_FAILED means the confirmed amounts did not match or attempts were exhausted.
+// DOCUMENT_REQUIRED means a verification document must be uploaded; UNDER_REVIEW means it is being reviewed.
+// ENUM(PENDING, APPROVED, FAILED, MICRO_DEPOSITS_PENDING, MICRO_DEPOSITS_FAILED, DOCUMENT_REQUIRED, UNDER_REVIEW)
 type BankAccountStatus string
 
+// DocumentType represents the type of a supporting document for external account verification.
+// ENUM(BANK_STATEMENT, VOID_CHEQUE, BANK_LETTER)
+type DocumentType string
+
 // CountryCode represents ISO 3166-1 alpha-3 country codes.
 // ENUM(AND, ARE, AFG, ATG, AIA, ALB, ARM, AGO, ARG, ASM, AUT, AUS, ABW, AZE, BIH, BRB, BGD, BEL, BFA, BGR, BHR, BDI, BEN, BLM, BMU, BRN, BOL, BRA, BHS, BTN, BWA, BLR, BLZ, CAN, CCK, COD, CAF, COG, CHE, CIV, COK, CHL, CMR, CHN, COL, CRI, CUB, CPV, CUW, CXR, CYP, CZE, DEU, DJI, DNK, DMA, DOM, DZA, ECU, EST, EGY, ESH, ERI, ESP, ETH, FIN, FJI, FLK, FSM, FRO, FRA, GAB, GBR, GRD, GEO, GUF, GGY, GHA, GIB, GRL, GMB, GIN, GLP, GNQ, GRC, SGS, GTM, GUM, GNB, GUY, HKG, HND, HRV, HTI, HUN, IDN, IRL, ISR, IMN, IND, IOT, IRQ, IRN, ISL, ITA, JEY, JAM, JOR, JPN, KEN, KGZ, KHM, KIR, COM, KNA, PRK, KOR, KWT, CYM, KAZ, LAO, LBN, LCA, LIE, LKA, LBR, LSO, LTU, LUX, LVA, LBY, MAR, MCO, MDA, MNE, MAF, MDG, MHL, MKD, MLI, MMR, MNG, MAC, MNP, MTQ, MRT, MSR, MLT, MUS, MDV, MWI, MEX, MYS, MOZ, NAM, NCL, NER, NFK, NGA, NIC, NLD, NOR, NPL, NRU, NIU, NZL, OMN, PAN, PER, PYF, PNG, PHL, PAK, POL, SPM, PCN, PRI, PSE, PRT, PLW, PRY, QAT, REU, ROU, SRB, RUS, RWA, SAU, SLB, SYC, SDN, SWE, SGP, SHN, SVN, SJM, SVK, SLE, SMR, SEN, SOM, SUR, SSD, STP, SLV, SXM, SYR, SWZ, TCA, TCD, TGO, THA, TJK, TKL, TLS, TKM, TUN, TON, TUR, TTO, TUV, TWN, TZA, UKR, UGA, USA, URY, UZB, VAT, VCT, VEN, VGB, VIR, VNM, VUT, WLF, WSM, YEM, MYT, ZAF, ZMB, ZWE)
 //
@@ -17,6 +17,14 @@ const (
 	BankAccountStatusAPPROVED BankAccountStatus = "APPROVED"
 	// BankAccountStatusFAILED is a BankAccountStatus of type FAILED.
 	BankAccountStatusFAILED BankAccountStatus = "FAILED"
+	// BankAccountStatusMICRODEPOSITSPENDING is a BankAccountStatus of type MICRO_DEPOSITS_PENDING.
+	BankAccountStatusMICRODEPOSITSPENDING BankAccountStatus = "MICRO_DEPOSITS_PENDING"
+	// BankAccountStatusMICRODEPOSITSFAILED is a BankAccountStatus of type MICRO_DEPOSITS_FAILED.
+	BankAccountStatusMICRODEPOSITSFAILED BankAccountStatus = "MICRO_DEPOSITS_FAILED"
+	// BankAccountStatusDOCUMENTREQUIRED is a BankAccountStatus of type DOCUMENT_REQUIRED.
+	BankAccountStatusDOCUMENTREQUIRED BankAccountStatus = "DOCUMENT_REQUIRED"
+	// BankAccountStatusUNDERREVIEW is a BankAccountStatus of type UNDER_REVIEW.
+	BankAccountStatusUNDERREVIEW BankAccountStatus = "UNDER_REVIEW"
 )
 
 var ErrInvalidBankAccountStatus = fmt.Errorf("not a valid BankAccountStatus, try [%s]", strings.Join(_BankAccountStatusNames, ", "))
@@ -25,6 +33,10 @@ var _BankAccountStatusNames = []string{
 	string(BankAccountStatusPENDING),
 	string(BankAccountStatusAPPROVED),
 	string(BankAccountStatusFAILED),
+	string(BankAccountStatusMICRODEPOSITSPENDING),
+	string(BankAccountStatusMICRODEPOSITSFAILED),
+	string(BankAccountStatusDOCUMENTREQUIRED),
+	string(BankAccountStatusUNDERREVIEW),
 }
 
 // BankAccountStatusNames returns a list of possible string values of BankAccountStatus.
@@ -47,12 +59,20 @@ func (x BankAccountStatus) IsValid() bool {
 }
 
 var _BankAccountStatusValue = map[string]BankAccountStatus{
-	"PENDING":  BankAccountStatusPENDING,
-	"pending":  BankAccountStatusPENDING,
-	"APPROVED": BankAccountStatusAPPROVED,
-	"approved": BankAccountStatusAPPROVED,
-	"FAILED":   BankAccountStatusFAILED,
-	"failed":   BankAccountStatusFAILED,
+	"PENDING":                BankAccountStatusPENDING,
+	"pending":                BankAccountStatusPENDING,
+	"APPROVED":               BankAccountStatusAPPROVED,
+	"approved":               BankAccountStatusAPPROVED,
+	"FAILED":                 BankAccountStatusFAILED,
+	"failed":                 BankAccountStatusFAILED,
+	"MICRO_DEPOSITS_PENDING": BankAccountStatusMICRODEPOSITSPENDING,
+	"micro_deposits_pending": BankAccountStatusMICRODEPOSITSPENDING,
+	"MICRO_DEPOSITS_FAILED":  BankAccountStatusMICRODEPOSITSFAILED,
+	"micro_deposits_failed":  BankAccountStatusMICRODEPOSITSFAILED,
+	"DOCUMENT_REQUIRED":      BankAccountStatusDOCUMENTREQUIRED,
+	"document_required":      BankAccountStatusDOCUMENTREQUIRED,
+	"UNDER_REVIEW":           BankAccountStatusUNDERREVIEW,
+	"under_review":           BankAccountStatusUNDERREVIEW,
 }
 
 // ParseBankAccountStatus attempts to convert a string to a BankAccountStatus.
@@ -97,6 +117,8 @@ const (
 	BankNetworkNameSWIFT BankNetworkName = "SWIFT"
 	// BankNetworkNameUSFEDWIRE is a BankNetworkName of type US_FEDWIRE.
 	BankNetworkNameUSFEDWIRE BankNetworkName = "US_FEDWIRE"
+	// BankNetworkNameSEPA is a BankNetworkName of type SEPA.
+	BankNetworkNameSEPA BankNetworkName = "SEPA"
 )
 
 var ErrInvalidBankNetworkName = fmt.Errorf("not a valid BankNetworkName, try [%s]", strings.Join(_BankNetworkNameNames, ", "))
@@ -105,6 +127,7 @@ var _BankNetworkNameNames = []string{
 	string(BankNetworkNameUSACH),
 	string(BankNetworkNameSWIFT),
 	string(BankNetworkNameUSFEDWIRE),
+	string(BankNetworkNameSEPA),
 }
 
 // BankNetworkNameNames returns a list of possible string values of BankNetworkName.
@@ -133,6 +156,8 @@ var _BankNetworkNameValue = map[string]BankNetworkName{
 	"swift":      BankNetworkNameSWIFT,
 	"US_FEDWIRE": BankNetworkNameUSFEDWIRE,
 	"us_fedwire": BankNetworkNameUSFEDWIRE,
+	"SEPA":       BankNetworkNameSEPA,
+	"sepa":       BankNetworkNameSEPA,
 }
 
 // ParseBankNetworkName attempts to convert a string to a BankNetworkName.
@@ -1448,12 +1473,15 @@ func (x *CountryCode) AppendText(b []byte) ([]byte, error) {
 const (
 	// CurrencyUSD is a Currency of type USD.
 	CurrencyUSD Currency = "USD"
+	// CurrencyEUR is a Currency of type EUR.
+	CurrencyEUR Currency = "EUR"
 )
 
 var ErrInvalidCurrency = fmt.Errorf("not a valid Currency, try [%s]", strings.Join(_CurrencyNames, ", "))
 
 var _CurrencyNames = []string{
 	string(CurrencyUSD),
+	string(CurrencyEUR),
 }
 
 // CurrencyNames returns a list of possible string values of Currency.
@@ -1478,6 +1506,8 @@ func (x Currency) IsValid() bool {
 var _CurrencyValue = map[string]Currency{
 	"USD": CurrencyUSD,
 	"usd": CurrencyUSD,
+	"EUR": CurrencyEUR,
+	"eur": CurrencyEUR,
 }
 
 // ParseCurrency attempts to convert a string to a Currency.
@@ -1514,3 +1544,83 @@ func (x *Currency) UnmarshalText(text []byte) error {
 func (x *Currency) AppendText(b []byte) ([]byte, error) {
 	return append(b, x.String()...), nil
 }
+
+const (
+	// DocumentTypeBANKSTATEMENT is a DocumentType of type BANK_STATEMENT.
+	DocumentTypeBANKSTATEMENT DocumentType = "BANK_STATEMENT"
+	// DocumentTypeVOIDCHEQUE is a DocumentType of type VOID_CHEQUE.
+	DocumentTypeVOIDCHEQUE DocumentType = "VOID_CHEQUE"
+	// DocumentTypeBANKLETTER is a DocumentType of type BANK_LETTER.
+	DocumentTypeBANKLETTER DocumentType = "BANK_LETTER"
+)
+
+var ErrInvalidDocumentType = fmt.Errorf("not a valid DocumentType, try [%s]", strings.Join(_DocumentTypeNames, ", "))
+
+var _DocumentTypeNames = []string{
+	string(DocumentTypeBANKSTATEMENT),
+	string(DocumentTypeVOIDCHEQUE),
+	string(DocumentTypeBANKLETTER),
+}
+
+// DocumentTypeNames returns a list of possible string values of DocumentType.
+func DocumentTypeNames() []string {
+	tmp := make([]string, len(_DocumentTypeNames))
+	copy(tmp, _DocumentTypeNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x DocumentType) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x DocumentType) IsValid() bool {
+	_, err := ParseDocumentType(string(x))
+	return err == nil
+}
+
+var _DocumentTypeValue = map[string]DocumentType{
+	"BANK_STATEMENT": DocumentTypeBANKSTATEMENT,
+	"bank_statement": DocumentTypeBANKSTATEMENT,
+	"VOID_CHEQUE":    DocumentTypeVOIDCHEQUE,
+	"void_cheque":    DocumentTypeVOIDCHEQUE,
+	"BANK_LETTER":    DocumentTypeBANKLETTER,
+	"bank_letter":    DocumentTypeBANKLETTER,
+}
+
+// ParseDocumentType attempts to convert a string to a DocumentType.
+func ParseDocumentType(name string) (DocumentType, error) {
+	if x, ok := _DocumentTypeValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _DocumentTypeValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return DocumentType(""), fmt.Errorf("%s is %w", name, ErrInvalidDocumentType)
+}
+
+// MarshalText implements the text marshaller method.
+func (x DocumentType) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *DocumentType) UnmarshalText(text []byte) error {
+	tmp, err := ParseDocumentType(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *DocumentType) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
@@ -18,15 +18,133 @@ package external_accounts
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"go.uber.org/zap"
 
 	"github.com/1Money-Co/1money-go-sdk/internal/utils"
+	"github.com/1Money-Co/1money-go-sdk/pkg/common"
 	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
 )
 
+// External account errors.
+var (
+	// ErrInvalidRequest is returned when a create request is missing fields required by its network.
+	ErrInvalidRequest = errors.New("invalid external account request")
+	// ErrMicroDepositsPending is returned by WaitForApproved when the account is in MICRO_DEPOSITS_PENDING
+	// status and waits for the amounts to be confirmed with ConfirmMicroDeposits.
+	ErrMicroDepositsPending = errors.New("micro-deposit confirmation required")
+)
+
+// Validate checks the request against the rules of the selected network before submission.
+// US_ACH and US_FEDWIRE require a valid ABA routing number. SWIFT requires a valid BIC and the
+// account holder's name and address. SEPA requires EUR, an IBAN and the account holder's name.
+// Any IBAN must pass its checksum. The IBAN and BIC countries may differ, as with
+// correspondent banking and non-resident accounts; use ValidateIBANCountry to require them to match.
+func (r *CreateReq) Validate() error {
+	if r == nil {
+		return fmt.Errorf("%w: request is nil", ErrInvalidRequest)
+	}
+
+	switch r.Network {
+	case BankNetworkNameUSACH, BankNetworkNameUSFEDWIRE:
+		if err := common.ValidateABARouting(r.InstitutionID); err != nil {
+			return fmt.Errorf("institution_id: %w", err)
+		}
+	case BankNetworkNameSWIFT:
+		if err := common.ValidateBIC(r.InstitutionID); err != nil {
+			return fmt.Errorf("institution_id: %w", err)
+		}
+		if r.AccountHolderName == nil || *r.AccountHolderName == "" {
+			return fmt.Errorf("%w: account_holder_name is required for SWIFT", ErrInvalidRequest)
+		}
+		if r.AccountHolderAddress == nil {
+			return fmt.Errorf("%w: account_holder_address is required for SWIFT", ErrInvalidRequest)
+		}
+	case BankNetworkNameSEPA:
+		if r.Currency != CurrencyEUR {
+			return fmt.Errorf("%w: SEPA accounts must use EUR, got %q", ErrInvalidRequest, r.Currency)
+		}
+		if r.iban() == "" {
+			return fmt.Errorf("%w: iban is required for SEPA", ErrInvalidRequest)
+		}
+		if r.AccountHolderName == nil || *r.AccountHolderName == "" {
+			return fmt.Errorf("%w: account_holder_name is required for SEPA", ErrInvalidRequest)
+		}
+	}
+
+	if r.BIC != nil && *r.BIC != "" {
+		if err := common.ValidateBIC(*r.BIC); err != nil {
+			return fmt.Errorf("bic: %w", err)
+		}
+	}
+	if iban := r.iban(); iban != "" {
+		if err := common.ValidateIBAN(iban); err != nil {
+			return fmt.Errorf("iban: %w", err)
+		}
+	}
+
+	if r.IntermediaryBank != nil {
+		id := r.IntermediaryBank.InstitutionID
+		var err error
+		if common.IsABARouting(id) {
+			err = common.ValidateABARouting(id)
+		} else {
+			err = common.ValidateBIC(id)
+		}
+		if err != nil {
+			return fmt.Errorf("intermediary_bank.institution_id: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ValidateIBANCountry is an opt-in check that the IBAN and the BIC belong to the same country,
+// for accounts that are expected to be domestic. It passes when the request has no IBAN or BIC,
+// and should be called after Validate.
+func (r *CreateReq) ValidateIBANCountry() error {
+	iban, bic := r.iban(), r.bic()
+	if iban == "" || bic == "" {
+		return nil
+	}
+	if err := common.ValidateIBANCountry(iban, bic); err != nil {
+		return fmt.Errorf("iban: %w", err)
+	}
+	return nil
+}
+
+// iban returns the IBAN from the IBAN field, or from AccountNumber when it is formatted as one.
+func (r *CreateReq) iban() string {
+	if r.IBAN != nil && *r.IBAN != "" {
+		return *r.IBAN
+	}
+	if looksLikeIBAN(r.AccountNumber) {
+		return r.AccountNumber
+	}
+	return ""
+}
+
+// bic returns the BIC from the BIC field, or from InstitutionID on SWIFT and SEPA.
+func (r *CreateReq) bic() string {
+	if r.BIC != nil && *r.BIC != "" {
+		return *r.BIC
+	}
+	if r.Network == BankNetworkNameSWIFT || r.Network == BankNetworkNameSEPA {
+		return r.InstitutionID
+	}
+	return ""
+}
+
+// looksLikeIBAN reports whether an account number starts with a two-letter country code,
+// which distinguishes IBANs from domestic account numbers.
+func looksLikeIBAN(accountNumber string) bool {
+	n := common.NormalizeIBAN(accountNumber)
+	return len(n) > 2 && n[0] >= 'A' && n[0] <= 'Z' && n[1] >= 'A' && n[1] <= 'Z'
+}
+
 // WaitOptions configures the polling behavior for wait functions.
 type WaitOptions struct {
 	// PollInterval is the interval between polling attempts. Default: 2s.
@@ -88,7 +206,9 @@ func WaitFor(
 }
 
 // WaitForApproved polls until the external account's status becomes APPROVED.
-// Returns an error if the status becomes FAILED or timeout occurs.
+// Returns an error if the status becomes FAILED or MICRO_DEPOSITS_FAILED, or timeout occurs.
+// If the account needs a verification document, it returns the account with ErrDocumentRequired;
+// if it waits for micro-deposit confirmation, it returns the account with ErrMicroDepositsPending.
 func WaitForApproved(
 	ctx context.Context,
 	service Service,
@@ -97,15 +217,55 @@ func WaitForApproved(
 	opts *WaitOptions,
 ) (*Resp, error) {
 	account, err := WaitFor(ctx, service, customerID, externalAccountID, func(a *Resp) bool {
-		return a.Status == string(BankAccountStatusAPPROVED) || a.Status == string(BankAccountStatusFAILED)
+		return a.IsTerminal()
 	}, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	if account.Status == string(BankAccountStatusFAILED) {
+	if account.RequiresDocument() {
+		return account, fmt.Errorf("external account %s: %w", externalAccountID, ErrDocumentRequired)
+	}
+	if account.RequiresMicroDepositConfirmation() {
+		return account, fmt.Errorf("external account %s: %w", externalAccountID, ErrMicroDepositsPending)
+	}
+
+	if isFailedStatus(account.Status) {
 		return account, fmt.Errorf("external account %s approval failed", externalAccountID)
 	}
 
 	return account, nil
 }
+
+// RequiresDocument reports whether the account is waiting for a verification document upload.
+func (r *Resp) RequiresDocument() bool {
+	return r.Status == string(BankAccountStatusDOCUMENTREQUIRED)
+}
+
+// RequiresMicroDepositConfirmation reports whether the account is waiting for the micro-deposit
+// amounts to be confirmed with ConfirmMicroDeposits.
+func (r *Resp) RequiresMicroDepositConfirmation() bool {
+	return r.Status == string(BankAccountStatusMICRODEPOSITSPENDING)
+}
+
+// IsTerminal reports whether polling alone will not change the account's status: it is APPROVED,
+// has failed, or is waiting for a verification document or micro-deposit confirmation.
+func (r *Resp) IsTerminal() bool {
+	return r.Status == string(BankAccountStatusAPPROVED) || isFailedStatus(r.Status) ||
+		r.RequiresDocument() || r.RequiresMicroDepositConfirmation()
+}
+
+// isFailedStatus reports whether the status is a terminal failure.
+func isFailedStatus(status string) bool {
+	return status == string(BankAccountStatusFAILED) || status == string(BankAccountStatusMICRODEPOSITSFAILED)
+}
+
+// CreatedTime parses CreatedAt into a time.Time.
+func (r *Resp) CreatedTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.CreatedAt)
+}
+
+// ModifiedTime parses ModifiedAt into a time.Time.
+func (r *Resp) ModifiedTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.ModifiedAt)
+}
@@ -0,0 +1,206 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package external_accounts
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/common"
+)
+
+func TestCreateReq_Validate(t *testing.T) {
+	holder := "Erika Mustermann"
+	address := &BankAddress{StreetLine1: "Hauptstrasse 1", City: "Berlin", PostalCode: "10115", CountryCode: CountryCodeDEU}
+	iban := "DE89370400440532013000"
+	badIBAN := "DE89370400440532013001"
+	badBIC := "COBADEF"
+
+	swift := func(accountNumber, institutionID string) *CreateReq {
+		return &CreateReq{
+			Network:              BankNetworkNameSWIFT,
+			AccountNumber:        accountNumber,
+			InstitutionID:        institutionID,
+			AccountHolderName:    &holder,
+			AccountHolderAddress: address,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		req     *CreateReq
+		wantErr error
+	}{
+		{
+			name: "valid ach",
+			req:  &CreateReq{Network: BankNetworkNameUSACH, AccountNumber: "5097935393", InstitutionID: "021000021"},
+		},
+		{
+			name:    "ach bad routing checksum",
+			req:     &CreateReq{Network: BankNetworkNameUSACH, AccountNumber: "5097935393", InstitutionID: "021000022"},
+			wantErr: common.ErrInvalidABARouting,
+		},
+		{
+			name:    "fedwire routing too short",
+			req:     &CreateReq{Network: BankNetworkNameUSFEDWIRE, AccountNumber: "5097935393", InstitutionID: "02100002"},
+			wantErr: common.ErrInvalidABARouting,
+		},
+		{
+			name: "valid swift with iban",
+			req:  swift("DE89 3704 0044 0532 0130 00", "COBADEFFXXX"),
+		},
+		{
+			name: "valid swift with domestic account number",
+			req:  swift("123456789", "CHASUS33"),
+		},
+		{
+			name:    "swift bad bic",
+			req:     swift("123456789", "CHASUS3"),
+			wantErr: common.ErrInvalidBIC,
+		},
+		{
+			name:    "swift bad iban checksum",
+			req:     swift(badIBAN, "COBADEFF"),
+			wantErr: common.ErrInvalidIBAN,
+		},
+		{
+			name: "swift iban country differs from bic",
+			req:  swift(iban, "BNPAFRPP"),
+		},
+		{
+			name: "bad intermediary routing",
+			req: func() *CreateReq {
+				r := swift("123456789", "CHASUS33")
+				r.IntermediaryBank = &IntermediaryBank{InstitutionID: "021000022"}
+				return r
+			}(),
+			wantErr: common.ErrInvalidABARouting,
+		},
+		{
+			name: "swift missing holder address",
+			req: func() *CreateReq {
+				r := swift("123456789", "CHASUS33")
+				r.AccountHolderAddress = nil
+				return r
+			}(),
+			wantErr: ErrInvalidRequest,
+		},
+		{
+			name: "valid sepa with iban field",
+			req:  &CreateReq{Network: BankNetworkNameSEPA, Currency: CurrencyEUR, IBAN: &iban, AccountHolderName: &holder},
+		},
+		{
+			name:    "sepa requires eur",
+			req:     &CreateReq{Network: BankNetworkNameSEPA, Currency: CurrencyUSD, IBAN: &iban, AccountHolderName: &holder},
+			wantErr: ErrInvalidRequest,
+		},
+		{
+			name:    "sepa requires iban",
+			req:     &CreateReq{Network: BankNetworkNameSEPA, Currency: CurrencyEUR, AccountNumber: "123456789", AccountHolderName: &holder},
+			wantErr: ErrInvalidRequest,
+		},
+		{
+			name: "sepa bad bic field",
+			req: &CreateReq{
+				Network: BankNetworkNameSEPA, Currency: CurrencyEUR, IBAN: &iban, BIC: &badBIC, AccountHolderName: &holder,
+			},
+			wantErr: common.ErrInvalidBIC,
+		},
+		{
+			name:    "nil request",
+			req:     nil,
+			wantErr: ErrInvalidRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("Validate() unexpected error: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCreateReq_ValidateIBANCountry(t *testing.T) {
+	iban := "DE89370400440532013000"
+	frenchBIC := "BNPAFRPP"
+
+	tests := []struct {
+		name    string
+		req     *CreateReq
+		wantErr error
+	}{
+		{
+			name: "swift same country",
+			req:  &CreateReq{Network: BankNetworkNameSWIFT, AccountNumber: iban, InstitutionID: "COBADEFFXXX"},
+		},
+		{
+			name:    "swift different countries",
+			req:     &CreateReq{Network: BankNetworkNameSWIFT, AccountNumber: iban, InstitutionID: frenchBIC},
+			wantErr: common.ErrInvalidIBAN,
+		},
+		{
+			name:    "sepa bic field from another country",
+			req:     &CreateReq{Network: BankNetworkNameSEPA, IBAN: &iban, BIC: &frenchBIC},
+			wantErr: common.ErrInvalidIBAN,
+		},
+		{
+			name: "sepa without bic",
+			req:  &CreateReq{Network: BankNetworkNameSEPA, IBAN: &iban},
+		},
+		{
+			name: "domestic account number",
+			req:  &CreateReq{Network: BankNetworkNameSWIFT, AccountNumber: "123456789", InstitutionID: frenchBIC},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.ValidateIBANCountry()
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("ValidateIBANCountry() unexpected error: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ValidateIBANCountry() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestResp_IsTerminal(t *testing.T) {
+	for _, status := range BankAccountStatusNames() {
+		want := status == BankAccountStatusAPPROVED.String() ||
+			status == BankAccountStatusFAILED.String() ||
+			status == BankAccountStatusMICRODEPOSITSFAILED.String() ||
+			status == BankAccountStatusDOCUMENTREQUIRED.String() ||
+			status == BankAccountStatusMICRODEPOSITSPENDING.String()
+		if got := (&Resp{Status: status}).IsTerminal(); got != want {
+			t.Errorf("IsTerminal() for %s = %v, want %v", status, got, want)
+		}
+	}
+}
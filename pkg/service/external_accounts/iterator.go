@@ -0,0 +1,72 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package external_accounts
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+)
+
+// listPageSize is the page size used when iterating over external accounts.
+const listPageSize = 100
+
+// All iterates over every external account matching the filter, paginating automatically.
+func (s *serviceImpl) All(ctx context.Context, id svc.CustomerID, filter *ListReq) iter.Seq2[*Resp, error] {
+	return allExternalAccounts(ctx, s, id, filter)
+}
+
+func allExternalAccounts(
+	ctx context.Context,
+	service Service,
+	id svc.CustomerID,
+	filter *ListReq,
+) iter.Seq2[*Resp, error] {
+	return func(yield func(*Resp, error) bool) {
+		req := ListReq{}
+		if filter != nil {
+			req = *filter
+		}
+		req.Size = listPageSize
+
+		for page := 1; ; page++ {
+			if err := ctx.Err(); err != nil {
+				yield(nil, err)
+				return
+			}
+
+			req.Page = page
+			accounts, err := service.ListExternalAccounts(ctx, id, &req)
+			if err != nil {
+				yield(nil, fmt.Errorf("failed to list external accounts (page %d): %w", page, err))
+				return
+			}
+
+			for i := range accounts {
+				if !yield(&accounts[i], nil) {
+					return
+				}
+			}
+
+			if len(accounts) < listPageSize {
+				return
+			}
+		}
+	}
+}
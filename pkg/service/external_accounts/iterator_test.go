@@ -0,0 +1,74 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package external_accounts
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+)
+
+// fakeListService serves a fixed set of external accounts page by page.
+type fakeListService struct {
+	Service
+	accounts []Resp
+	requests []ListReq
+}
+
+func (f *fakeListService) ListExternalAccounts(_ context.Context, _ svc.CustomerID, req *ListReq) ([]Resp, error) {
+	f.requests = append(f.requests, *req)
+	start := (req.Page - 1) * req.Size
+	if start >= len(f.accounts) {
+		return []Resp{}, nil
+	}
+	end := min(start+req.Size, len(f.accounts))
+	return f.accounts[start:end], nil
+}
+
+func TestAllExternalAccounts(t *testing.T) {
+	accounts := make([]Resp, 230)
+	for i := range accounts {
+		accounts[i] = Resp{ExternalAccountID: fmt.Sprintf("ea-%03d", i)}
+	}
+	service := &fakeListService{accounts: accounts}
+	filter := &ListReq{Status: BankAccountStatusAPPROVED, Page: 7, Size: 3}
+
+	var ids []string
+	for account, err := range allExternalAccounts(context.Background(), service, "cid", filter) {
+		if err != nil {
+			t.Fatalf("allExternalAccounts() error = %v", err)
+		}
+		ids = append(ids, account.ExternalAccountID)
+	}
+
+	if len(ids) != len(accounts) {
+		t.Fatalf("got %d accounts, want %d", len(ids), len(accounts))
+	}
+	if ids[0] != "ea-000" || ids[len(ids)-1] != "ea-229" {
+		t.Errorf("got first %s, last %s", ids[0], ids[len(ids)-1])
+	}
+	if len(service.requests) != 3 {
+		t.Fatalf("got %d list calls, want 3", len(service.requests))
+	}
+	for i, req := range service.requests {
+		if req.Page != i+1 || req.Size != listPageSize || req.Status != BankAccountStatusAPPROVED {
+			t.Errorf("request %d = %+v", i, req)
+		}
+	}
+}
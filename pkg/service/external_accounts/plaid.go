@@ -0,0 +1,78 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package external_accounts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+)
+
+// PlaidMeta carries optional details from Plaid Link alongside a processor token.
+type PlaidMeta struct {
+	// IdempotencyKey is a unique key to ensure idempotent creation.
+	// This is sent as a header, not in the body.
+	IdempotencyKey string `json:"-"`
+	// PlaidAccountID is the account ID selected by the user in Plaid Link (optional).
+	PlaidAccountID string `json:"plaid_account_id,omitempty"`
+	// InstitutionName is the institution name reported by Plaid Link (optional).
+	InstitutionName string `json:"institution_name,omitempty"`
+	// Nickname is a user-defined label for the account (optional).
+	Nickname *string `json:"nickname,omitempty"`
+}
+
+// plaidTokenReq is the request body for linking an external account from a Plaid processor token.
+type plaidTokenReq struct {
+	// ProcessorToken is the Plaid processor token issued for 1Money.
+	ProcessorToken string `json:"processor_token"`
+	// PlaidAccountID is the account ID selected in Plaid Link.
+	PlaidAccountID string `json:"plaid_account_id,omitempty"`
+	// InstitutionName is the institution name reported by Plaid Link.
+	InstitutionName string `json:"institution_name,omitempty"`
+	// Nickname is a user-defined label for the account.
+	Nickname *string `json:"nickname,omitempty"`
+}
+
+// CreateFromPlaidToken links a US bank account using a Plaid processor token.
+// Accounts linked this way are verified instantly, so no routing or account numbers are needed.
+func (s *serviceImpl) CreateFromPlaidToken(
+	ctx context.Context,
+	id svc.CustomerID,
+	processorToken string,
+	meta *PlaidMeta,
+) (*Resp, error) {
+	if processorToken == "" {
+		return nil, errors.New("plaid processor token is required")
+	}
+
+	path := fmt.Sprintf("/v1/customers/%s/external-accounts/plaid", id)
+
+	req := &plaidTokenReq{ProcessorToken: processorToken}
+	headers := make(map[string]string)
+	if meta != nil {
+		req.PlaidAccountID = meta.PlaidAccountID
+		req.InstitutionName = meta.InstitutionName
+		req.Nickname = meta.Nickname
+		if meta.IdempotencyKey != "" {
+			headers["Idempotency-Key"] = meta.IdempotencyKey
+		}
+	}
+
+	return svc.PostJSONWithHeaders[*plaidTokenReq, Resp](ctx, s.BaseService, path, req, headers)
+}
@@ -48,8 +48,11 @@ package external_accounts
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"iter"
 	"net/http"
+	"strconv"
 
 	"github.com/1Money-Co/1money-go-sdk/internal/transport"
 	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
@@ -58,14 +61,35 @@ import (
 // Service defines the external accounts service interface for managing customer external bank accounts.
 type Service interface {
 	// CreateExternalAccount creates a new external bank account for a customer.
-	// The IdempotencyKey in the request is used to ensure idempotent creation.
+	// The IdempotencyKey in the request is used to ensure idempotent creation: if the key
+	// was already used, the previously registered account is returned instead of an error.
 	CreateExternalAccount(ctx context.Context, id svc.CustomerID, req *CreateReq) (*Resp, error)
+	// CreateFromPlaidToken links a US bank account using a Plaid processor token with instant verification.
+	CreateFromPlaidToken(ctx context.Context, id svc.CustomerID, processorToken string, meta *PlaidMeta) (*Resp, error)
 	// GetExternalAccount retrieves a specific external account by ID.
 	GetExternalAccount(ctx context.Context, id svc.CustomerID, externalAccountID string) (*Resp, error)
 	// GetExternalAccountByIdempotencyKey retrieves an external account by its idempotency key.
 	GetExternalAccountByIdempotencyKey(ctx context.Context, id svc.CustomerID, idempotencyKey string) (*Resp, error)
-	// ListExternalAccounts retrieves all external accounts for a customer.
+	// ListExternalAccounts retrieves external accounts for a customer matching the filters.
+	// When Page and Size are unset, the whole list is returned.
 	ListExternalAccounts(ctx context.Context, id svc.CustomerID, req *ListReq) ([]Resp, error)
+	// All iterates over every external account matching the filter, paginating automatically.
+	// Page and Size in the filter are ignored.
+	All(ctx context.Context, id svc.CustomerID, filter *ListReq) iter.Seq2[*Resp, error]
+	// UpdateExternalAccount updates the mutable fields of an external account.
+	// Only nickname and institution name can be changed; banking details are immutable.
+	UpdateExternalAccount(ctx context.Context, id svc.CustomerID, externalAccountID string, req *UpdateReq) (*Resp, error)
+	// StartMicroDepositVerification sends micro-deposits to an external account
+	// for banks that require penny-drop verification before approval.
+	StartMicroDepositVerification(ctx context.Context, id svc.CustomerID, externalAccountID string) (*Resp, error)
+	// ConfirmMicroDeposits confirms the micro-deposit amounts received on the external account.
+	// Amounts are decimal strings, e.g. "0.32", in any order.
+	ConfirmMicroDeposits(ctx context.Context, id svc.CustomerID, externalAccountID string, amounts []string) (*Resp, error)
+	// UploadVerificationDocument uploads a supporting document, such as a bank statement,
+	// for an account in DOCUMENT_REQUIRED status. The account moves to UNDER_REVIEW on success.
+	UploadVerificationDocument(
+		ctx context.Context, id svc.CustomerID, externalAccountID string, doc *VerificationDocument,
+	) (*Resp, error)
 	// RemoveExternalAccount deletes an external bank account.
 	RemoveExternalAccount(ctx context.Context, id svc.CustomerID, externalAccountID string) error
 }
@@ -78,6 +102,22 @@ type IntermediaryBank struct {
 	InstitutionName *string `json:"institution_name,omitempty"`
 }
 
+// BankAddress represents a postal address of a bank or an account holder.
+type BankAddress struct {
+	// StreetLine1 is the primary street address.
+	StreetLine1 string `json:"street_line_1"`
+	// StreetLine2 is the secondary address line (optional).
+	StreetLine2 string `json:"street_line_2,omitempty"`
+	// City is the city name.
+	City string `json:"city"`
+	// State is the state, province or region (optional outside the US).
+	State string `json:"state,omitempty"`
+	// PostalCode is the postal or ZIP code.
+	PostalCode string `json:"postal_code"`
+	// CountryCode is the ISO 3166-1 alpha-3 country code.
+	CountryCode CountryCode `json:"country_code"`
+}
+
 // CreateExternalAccount request and response types.
 type (
 	// CreateReq represents the request body for creating an external bank account.
@@ -85,14 +125,15 @@ type (
 		// IdempotencyKey is a unique key to ensure idempotent creation.
 		// This is sent as a header, not in the body.
 		IdempotencyKey string `json:"-"`
-		// Network is the bank network type (US_ACH, SWIFT, US_FEDWIRE).
+		// Network is the bank network type (US_ACH, SWIFT, US_FEDWIRE, SEPA).
 		Network BankNetworkName `json:"network"`
-		// Currency is the currency of the account (USD).
+		// Currency is the currency of the account (USD, or EUR for SEPA).
 		Currency Currency `json:"currency"`
 		// CountryCode is the ISO 3166-1 alpha-3 country code where the bank account is held.
 		CountryCode CountryCode `json:"country_code"`
 		// AccountNumber is the bank account number or IBAN.
-		AccountNumber string `json:"account_number"`
+		// For SEPA it may be left empty when IBAN is set.
+		AccountNumber string `json:"account_number,omitempty"`
 		// InstitutionID is the routing identifier (ABA routing number or SWIFT/BIC code).
 		InstitutionID string `json:"institution_id"`
 		// InstitutionName is the full legal name of the bank.
@@ -103,6 +144,16 @@ type (
 		InstitutionClearingCode *string `json:"institution_clearing_code,omitempty"`
 		// IntermediaryBank contains intermediary bank details for international transfers (optional).
 		IntermediaryBank *IntermediaryBank `json:"intermediary_bank,omitempty"`
+		// IBAN is the International Bank Account Number (required for SEPA unless AccountNumber is an IBAN).
+		IBAN *string `json:"iban,omitempty"`
+		// BIC is the SWIFT/BIC code of the bank, if different from InstitutionID (optional).
+		BIC *string `json:"bic,omitempty"`
+		// BankAddress is the postal address of the bank (optional).
+		BankAddress *BankAddress `json:"bank_address,omitempty"`
+		// AccountHolderName is the full legal name of the account holder (required for SWIFT and SEPA).
+		AccountHolderName *string `json:"account_holder_name,omitempty"`
+		// AccountHolderAddress is the postal address of the account holder (required for SWIFT).
+		AccountHolderAddress *BankAddress `json:"account_holder_address,omitempty"`
 	}
 
 	// Resp represents the response data for an external bank account.
@@ -112,7 +163,7 @@ type (
 		// IdempotencyKey is the idempotency key associated with the account creation.
 		IdempotencyKey string `json:"idempotency_key"`
 		// CustomerID is the ID of the customer who owns this account.
-		CustomerID string `json:"customer_id"`
+		CustomerID svc.CustomerID `json:"customer_id"`
 		// Status is the current status of the external account.
 		Status string `json:"status"`
 		// Network is the bank network type.
@@ -135,6 +186,14 @@ type (
 		InstitutionClearingCode *string `json:"institution_clearing_code,omitempty"`
 		// IntermediaryBank contains intermediary bank details (optional).
 		IntermediaryBank *IntermediaryBank `json:"intermediary_bank,omitempty"`
+		// IBAN is the International Bank Account Number (optional).
+		IBAN *string `json:"iban,omitempty"`
+		// BIC is the SWIFT/BIC code of the bank (optional).
+		BIC *string `json:"bic,omitempty"`
+		// BankAddress is the postal address of the bank (optional).
+		BankAddress *BankAddress `json:"bank_address,omitempty"`
+		// AccountHolderAddress is the postal address of the account holder (optional).
+		AccountHolderAddress *BankAddress `json:"account_holder_address,omitempty"`
 		// ReferenceCode is a reference code for wire transfers (optional).
 		ReferenceCode *string `json:"reference_code,omitempty"`
 		// CreatedAt is the timestamp when the account was created (ISO 8601 format).
@@ -144,12 +203,33 @@ type (
 	}
 )
 
+// UpdateReq represents the request body for updating an external bank account.
+// Nil fields are left unchanged.
+type UpdateReq struct {
+	// Nickname is a user-defined label for the account, e.g. "Payroll account".
+	Nickname *string `json:"nickname,omitempty"`
+	// InstitutionName corrects the full legal name of the bank.
+	InstitutionName *string `json:"institution_name,omitempty"`
+}
+
+// ConfirmMicroDepositsReq represents the request body for confirming micro-deposit amounts.
+type ConfirmMicroDepositsReq struct {
+	// Amounts are the micro-deposit amounts received on the account.
+	Amounts []string `json:"amounts"`
+}
+
 // ListReq represents optional query parameters for listing external accounts.
 type ListReq struct {
 	// Currency filters by currency code (e.g., USD).
 	Currency Currency `json:"currency,omitempty"`
-	// Network filters by bank network type (US_ACH, SWIFT, US_FEDWIRE).
+	// Network filters by bank network type (US_ACH, SWIFT, US_FEDWIRE, SEPA).
 	Network BankNetworkName `json:"network,omitempty"`
+	// Status filters by account status (e.g., APPROVED).
+	Status BankAccountStatus `json:"status,omitempty"`
+	// Page is the page number (starts from 1).
+	Page int `json:"page,omitempty"`
+	// Size is the number of items per page (1-100).
+	Size int `json:"size,omitempty"`
 }
 
 type serviceImpl struct {
@@ -169,6 +249,10 @@ func (s *serviceImpl) CreateExternalAccount(
 	id svc.CustomerID,
 	req *CreateReq,
 ) (*Resp, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("/v1/customers/%s/external-accounts", id)
 
 	body, err := json.Marshal(req)
@@ -188,6 +272,13 @@ func (s *serviceImpl) CreateExternalAccount(
 		Headers: headers,
 	})
 	if err != nil {
+		if apiErr, ok := transport.IsAPIError(err); ok && apiErr.IsConflictError() && req.IdempotencyKey != "" {
+			existing, getErr := s.GetExternalAccountByIdempotencyKey(ctx, id, req.IdempotencyKey)
+			if getErr != nil {
+				return nil, errors.Join(err, fmt.Errorf("failed to look up existing external account: %w", getErr))
+			}
+			return existing, nil
+		}
 		return nil, err
 	}
 
@@ -222,7 +313,7 @@ func (s *serviceImpl) GetExternalAccountByIdempotencyKey(
 	return svc.GetJSONWithParams[Resp](ctx, s.BaseService, path, params)
 }
 
-// ListExternalAccounts retrieves all external accounts for a customer.
+// ListExternalAccounts retrieves external accounts for a customer matching the filters.
 func (s *serviceImpl) ListExternalAccounts(
 	ctx context.Context,
 	id svc.CustomerID,
@@ -238,6 +329,15 @@ func (s *serviceImpl) ListExternalAccounts(
 		if req.Network != "" {
 			params["network"] = string(req.Network)
 		}
+		if req.Status != "" {
+			params["status"] = string(req.Status)
+		}
+		if req.Page > 0 {
+			params["page"] = strconv.Itoa(req.Page)
+		}
+		if req.Size > 0 {
+			params["size"] = strconv.Itoa(req.Size)
+		}
 	}
 
 	result, err := svc.GetJSONWithParams[[]Resp](ctx, s.BaseService, path, params)
@@ -247,6 +347,43 @@ func (s *serviceImpl) ListExternalAccounts(
 	return *result, nil
 }
 
+// UpdateExternalAccount updates the mutable fields of an external account.
+func (s *serviceImpl) UpdateExternalAccount(
+	ctx context.Context,
+	id svc.CustomerID,
+	externalAccountID string,
+	req *UpdateReq,
+) (*Resp, error) {
+	path := fmt.Sprintf("/v1/customers/%s/external-accounts/%s", id, externalAccountID)
+	return svc.PatchJSON[*UpdateReq, Resp](ctx, s.BaseService, path, req)
+}
+
+// StartMicroDepositVerification sends micro-deposits to an external account.
+func (s *serviceImpl) StartMicroDepositVerification(
+	ctx context.Context,
+	id svc.CustomerID,
+	externalAccountID string,
+) (*Resp, error) {
+	path := fmt.Sprintf("/v1/customers/%s/external-accounts/%s/micro-deposits", id, externalAccountID)
+	return svc.PostJSON[any, Resp](ctx, s.BaseService, path, nil)
+}
+
+// ConfirmMicroDeposits confirms the micro-deposit amounts received on the external account.
+func (s *serviceImpl) ConfirmMicroDeposits(
+	ctx context.Context,
+	id svc.CustomerID,
+	externalAccountID string,
+	amounts []string,
+) (*Resp, error) {
+	if len(amounts) == 0 {
+		return nil, errors.New("at least one micro-deposit amount is required")
+	}
+
+	path := fmt.Sprintf("/v1/customers/%s/external-accounts/%s/micro-deposits/confirm", id, externalAccountID)
+	req := &ConfirmMicroDepositsReq{Amounts: amounts}
+	return svc.PostJSON[*ConfirmMicroDepositsReq, Resp](ctx, s.BaseService, path, req)
+}
+
 // RemoveExternalAccount deletes an external bank account.
 func (s *serviceImpl) RemoveExternalAccount(
 	ctx context.Context,
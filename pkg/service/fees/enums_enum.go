@@ -0,0 +1,91 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: v0.9.2
+
+// Built By: go install
+
+package fees
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// FeeCategoryDEPOSIT is a FeeCategory of type DEPOSIT.
+	FeeCategoryDEPOSIT FeeCategory = "DEPOSIT"
+	// FeeCategoryWITHDRAWAL is a FeeCategory of type WITHDRAWAL.
+	FeeCategoryWITHDRAWAL FeeCategory = "WITHDRAWAL"
+	// FeeCategoryCONVERSION is a FeeCategory of type CONVERSION.
+	FeeCategoryCONVERSION FeeCategory = "CONVERSION"
+)
+
+var ErrInvalidFeeCategory = fmt.Errorf("not a valid FeeCategory, try [%s]", strings.Join(_FeeCategoryNames, ", "))
+
+var _FeeCategoryNames = []string{
+	string(FeeCategoryDEPOSIT),
+	string(FeeCategoryWITHDRAWAL),
+	string(FeeCategoryCONVERSION),
+}
+
+// FeeCategoryNames returns a list of possible string values of FeeCategory.
+func FeeCategoryNames() []string {
+	tmp := make([]string, len(_FeeCategoryNames))
+	copy(tmp, _FeeCategoryNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x FeeCategory) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x FeeCategory) IsValid() bool {
+	_, err := ParseFeeCategory(string(x))
+	return err == nil
+}
+
+var _FeeCategoryValue = map[string]FeeCategory{
+	"DEPOSIT":    FeeCategoryDEPOSIT,
+	"deposit":    FeeCategoryDEPOSIT,
+	"WITHDRAWAL": FeeCategoryWITHDRAWAL,
+	"withdrawal": FeeCategoryWITHDRAWAL,
+	"CONVERSION": FeeCategoryCONVERSION,
+	"conversion": FeeCategoryCONVERSION,
+}
+
+// ParseFeeCategory attempts to convert a string to a FeeCategory.
+func ParseFeeCategory(name string) (FeeCategory, error) {
+	if x, ok := _FeeCategoryValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _FeeCategoryValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return FeeCategory(""), fmt.Errorf("%s is %w", name, ErrInvalidFeeCategory)
+}
+
+// MarshalText implements the text marshaller method.
+func (x FeeCategory) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *FeeCategory) UnmarshalText(text []byte) error {
+	tmp, err := ParseFeeCategory(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *FeeCategory) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
@@ -0,0 +1,178 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fees
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/common"
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+)
+
+// ErrNoFeeTier is returned when the schedule has no tier covering the requested amount.
+var ErrNoFeeTier = errors.New("no fee tier applies")
+
+// Tiers returns the fee tiers for a category, asset and network, preferring a negotiated
+// override over the standard schedule. Pass an empty network for conversions.
+func (r *FeeScheduleResponse) Tiers(category FeeCategory, asset assets.AssetName, network assets.NetworkName) []FeeTier {
+	for i := range r.Overrides {
+		if r.Overrides[i].matches(category, asset, network) {
+			return r.Overrides[i].Tiers
+		}
+	}
+	for i := range r.Fees {
+		if r.Fees[i].matches(category, asset, network) {
+			return r.Fees[i].Tiers
+		}
+	}
+	return nil
+}
+
+// Calculate returns the fee for a transaction amount, using the tier whose range contains it.
+func (r *FeeScheduleResponse) Calculate(
+	category FeeCategory, asset assets.AssetName, network assets.NetworkName, amount string,
+) (string, error) {
+	value, ok := new(big.Rat).SetString(amount)
+	if !ok {
+		return "", fmt.Errorf("invalid amount %q", amount)
+	}
+
+	tier, err := r.tier(category, asset, network, value)
+	if err != nil {
+		return "", err
+	}
+	if tier == nil {
+		return "", fmt.Errorf("%w: %s %s %s amount %s", ErrNoFeeTier, category, asset, network, amount)
+	}
+	return tier.fee(value)
+}
+
+// CalculateMoney returns the fee for a transaction amount in the tier's fee asset,
+// or in the amount's currency when the tier does not name one. Pass an empty network for conversions.
+func (r *FeeScheduleResponse) CalculateMoney(
+	category FeeCategory, network assets.NetworkName, amount common.Money,
+) (common.Money, error) {
+	value := amount.Amount.Rat()
+	tier, err := r.tier(category, amount.Currency, network, value)
+	if err != nil {
+		return common.Money{}, err
+	}
+	if tier == nil {
+		return common.Money{}, fmt.Errorf("%w: %s %s %s amount %s", ErrNoFeeTier, category, amount.Currency, network, amount.Amount)
+	}
+	fee, err := tier.fee(value)
+	if err != nil {
+		return common.Money{}, err
+	}
+	currency := amount.Currency
+	if tier.FeeAsset != "" {
+		currency = assets.AssetName(tier.FeeAsset)
+	}
+	return common.NewMoney(fee, currency)
+}
+
+// tier returns the tier whose range contains the amount, or nil if none does.
+func (r *FeeScheduleResponse) tier(
+	category FeeCategory, asset assets.AssetName, network assets.NetworkName, amount *big.Rat,
+) (*FeeTier, error) {
+	tiers := r.Tiers(category, asset, network)
+	for i := range tiers {
+		in, err := tiers[i].contains(amount)
+		if err != nil {
+			return nil, err
+		}
+		if in {
+			return &tiers[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// matches reports whether the entry applies to the category, asset and network.
+func (e *FeeEntry) matches(category FeeCategory, asset assets.AssetName, network assets.NetworkName) bool {
+	return e.Category == category &&
+		strings.EqualFold(e.Asset, string(asset)) &&
+		strings.EqualFold(e.Network, string(network))
+}
+
+// contains reports whether the amount falls within [MinAmount, MaxAmount).
+func (t *FeeTier) contains(amount *big.Rat) (bool, error) {
+	minAmount, err := parseAmount("min_amount", t.MinAmount)
+	if err != nil {
+		return false, err
+	}
+	if amount.Cmp(minAmount) < 0 {
+		return false, nil
+	}
+	if t.MaxAmount == nil {
+		return true, nil
+	}
+	maxAmount, err := parseAmount("max_amount", *t.MaxAmount)
+	if err != nil {
+		return false, err
+	}
+	return amount.Cmp(maxAmount) < 0, nil
+}
+
+// fee returns FixedFee plus PercentageFee percent of the amount, rounded to 2 decimals.
+func (t *FeeTier) fee(amount *big.Rat) (string, error) {
+	fixed, err := parseAmount("fixed_fee", t.FixedFee)
+	if err != nil {
+		return "", err
+	}
+	pct, err := parseAmount("percentage_fee", t.PercentageFee)
+	if err != nil {
+		return "", err
+	}
+
+	total := new(big.Rat).Mul(amount, pct)
+	total.Quo(total, big.NewRat(100, 1))
+	total.Add(total, fixed)
+	return total.FloatString(2), nil
+}
+
+// parseAmount parses a decimal field, treating an empty value as zero.
+func parseAmount(field, value string) (*big.Rat, error) {
+	if value == "" {
+		return new(big.Rat), nil
+	}
+	r, ok := new(big.Rat).SetString(value)
+	if !ok {
+		return nil, fmt.Errorf("invalid %s %q", field, value)
+	}
+	return r, nil
+}
+
+// EffectiveFromTime parses EffectiveFrom into a time.Time.
+func (r *FeeOverride) EffectiveFromTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.EffectiveFrom)
+}
+
+// EffectiveUntilTime parses EffectiveUntil into a time.Time, returning the zero time if it is not set.
+func (r *FeeOverride) EffectiveUntilTime() (time.Time, error) {
+	return svc.ParseOptionalTimestamp(r.EffectiveUntil)
+}
+
+// ModifiedTime parses ModifiedAt into a time.Time.
+func (r *FeeScheduleResponse) ModifiedTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.ModifiedAt)
+}
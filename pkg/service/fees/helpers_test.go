@@ -0,0 +1,128 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fees
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/common"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+)
+
+func TestFeeScheduleResponse_Calculate(t *testing.T) {
+	thousand := "1000"
+	schedule := &FeeScheduleResponse{
+		Fees: []FeeEntry{
+			{
+				Category: FeeCategoryWITHDRAWAL,
+				Asset:    "USD",
+				Network:  "US_ACH",
+				Tiers: []FeeTier{
+					{MinAmount: "0", MaxAmount: &thousand, FixedFee: "1.00", PercentageFee: "0"},
+					{MinAmount: "1000", FixedFee: "0", PercentageFee: "0.1"},
+				},
+			},
+			{
+				Category: FeeCategoryCONVERSION,
+				Asset:    "USDT",
+				Tiers:    []FeeTier{{MinAmount: "0", PercentageFee: "0.25"}},
+			},
+			{
+				Category: FeeCategoryDEPOSIT,
+				Asset:    "USD",
+				Network:  "US_FEDWIRE",
+				Tiers:    []FeeTier{{MinAmount: "0", FixedFee: "15.00"}},
+			},
+		},
+		Overrides: []FeeOverride{
+			{
+				FeeEntry: FeeEntry{
+					Category: FeeCategoryDEPOSIT,
+					Asset:    "USD",
+					Network:  "US_FEDWIRE",
+					Tiers:    []FeeTier{{MinAmount: "0", FixedFee: "5.00"}},
+				},
+			},
+		},
+	}
+	tests := []struct {
+		name     string
+		category FeeCategory
+		asset    assets.AssetName
+		network  assets.NetworkName
+		amount   string
+		want     string
+		wantErr  error
+	}{
+		{"lower tier", FeeCategoryWITHDRAWAL, assets.AssetNameUSD, assets.NetworkNameUSACH, "999.99", "1.00", nil},
+		{"upper tier boundary", FeeCategoryWITHDRAWAL, assets.AssetNameUSD, assets.NetworkNameUSACH, "1000", "1.00", nil},
+		{"upper tier", FeeCategoryWITHDRAWAL, assets.AssetNameUSD, assets.NetworkNameUSACH, "25000", "25.00", nil},
+		{"conversion without network", FeeCategoryCONVERSION, assets.AssetNameUSDT, "", "200", "0.50", nil},
+		{"override wins", FeeCategoryDEPOSIT, assets.AssetNameUSD, assets.NetworkNameUSFEDWIRE, "10", "5.00", nil},
+		{"missing entry", FeeCategoryDEPOSIT, assets.AssetNameUSDC, assets.NetworkNameSOLANA, "10", "", ErrNoFeeTier},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := schedule.Calculate(tt.category, tt.asset, tt.network, tt.amount)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Calculate() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Calculate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := schedule.Calculate(FeeCategoryWITHDRAWAL, assets.AssetNameUSD, assets.NetworkNameUSACH, "abc"); err == nil {
+		t.Error("Calculate() with invalid amount should fail")
+	}
+}
+
+func TestFeeScheduleResponse_CalculateMoney(t *testing.T) {
+	schedule := &FeeScheduleResponse{
+		Fees: []FeeEntry{
+			{
+				Category: FeeCategoryWITHDRAWAL,
+				Asset:    "USD",
+				Network:  "US_ACH",
+				Tiers:    []FeeTier{{MinAmount: "0", FixedFee: "1.00", PercentageFee: "0.1"}},
+			},
+			{
+				Category: FeeCategoryCONVERSION,
+				Asset:    "USDT",
+				Tiers:    []FeeTier{{MinAmount: "0", PercentageFee: "0.25", FeeAsset: "USD"}},
+			},
+		},
+	}
+
+	fee, err := schedule.CalculateMoney(FeeCategoryWITHDRAWAL, assets.NetworkNameUSACH, common.MustMoney("1000", assets.AssetNameUSD))
+	if err != nil || !fee.Equal(common.MustMoney("2.00", assets.AssetNameUSD)) {
+		t.Errorf("CalculateMoney() = %s, %v, want 2 USD", fee, err)
+	}
+
+	fee, err = schedule.CalculateMoney(FeeCategoryCONVERSION, "", common.MustMoney("200", assets.AssetNameUSDT))
+	if err != nil || !fee.Equal(common.MustMoney("0.50", assets.AssetNameUSD)) {
+		t.Errorf("CalculateMoney() = %s, %v, want 0.5 USD in the tier's fee asset", fee, err)
+	}
+
+	_, err = schedule.CalculateMoney(FeeCategoryWITHDRAWAL, assets.NetworkNameUSACH, common.MustMoney("1000", assets.AssetNameUSDC))
+	if !errors.Is(err, ErrNoFeeTier) {
+		t.Errorf("CalculateMoney() error = %v, want ErrNoFeeTier", err)
+	}
+}
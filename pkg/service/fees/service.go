@@ -0,0 +1,127 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package fees provides fee schedule retrieval for customer accounts.
+//
+// This package implements the fees service client for the 1Money platform,
+// exposing the deposit, withdrawal and conversion fee tiers that apply to a customer,
+// including any negotiated overrides.
+//
+// # Basic Usage
+//
+//	import (
+//	    "context"
+//	    onemoney "github.com/1Money-Co/1money-go-sdk/pkg/onemoney"
+//	    "github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+//	    "github.com/1Money-Co/1money-go-sdk/pkg/service/fees"
+//	)
+//
+//	// Create client
+//	client, err := onemoney.NewClient(&onemoney.Config{
+//	    AccessKey: "your-access-key",
+//	    SecretKey: "your-secret-key",
+//	})
+//
+//	// Get the fee schedule
+//	schedule, err := client.Fees.GetFeeSchedule(ctx, "customer-id")
+//
+//	// Calculate the fee for a withdrawal
+//	fee, err := schedule.Calculate(fees.FeeCategoryWITHDRAWAL, assets.AssetNameUSD, assets.NetworkNameUSACH, "1000.00")
+package fees
+
+import (
+	"context"
+	"fmt"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+)
+
+// Service defines the fees service interface for retrieving customer fee schedules.
+type Service interface {
+	// GetFeeSchedule retrieves the fee tiers that apply to a customer for each category,
+	// asset and network, together with any negotiated overrides.
+	GetFeeSchedule(ctx context.Context, id svc.CustomerID) (*FeeScheduleResponse, error)
+}
+
+// Fee schedule types.
+type (
+	// FeeTier represents the fee charged for amounts within a range.
+	// The fee is FixedFee plus PercentageFee percent of the amount.
+	FeeTier struct {
+		// MinAmount is the inclusive lower bound of the tier.
+		MinAmount string `json:"min_amount"`
+		// MaxAmount is the exclusive upper bound of the tier (nil when unbounded).
+		MaxAmount *string `json:"max_amount,omitempty"`
+		// FixedFee is the flat fee per transaction.
+		FixedFee string `json:"fixed_fee"`
+		// PercentageFee is the fee as a percentage of the amount, e.g. "0.25" for 0.25%.
+		PercentageFee string `json:"percentage_fee"`
+		// FeeAsset is the asset the fee is charged in.
+		FeeAsset string `json:"fee_asset"`
+	}
+
+	// FeeEntry represents the standard fee tiers for one category, asset and network.
+	FeeEntry struct {
+		// Category is the transaction category the fee applies to.
+		Category FeeCategory `json:"category"`
+		// Asset is the asset name (e.g., "USD", "USDT").
+		Asset string `json:"asset"`
+		// Network is the network name (empty for conversions).
+		Network string `json:"network,omitempty"`
+		// Tiers are the fee tiers, ordered by MinAmount.
+		Tiers []FeeTier `json:"tiers"`
+	}
+
+	// FeeOverride represents negotiated pricing that replaces the standard tiers.
+	FeeOverride struct {
+		FeeEntry
+		// EffectiveFrom is when the override took effect (ISO 8601 format).
+		EffectiveFrom string `json:"effective_from"`
+		// EffectiveUntil is when the override expires (ISO 8601 format, nil when open-ended).
+		EffectiveUntil *string `json:"effective_until,omitempty"`
+		// Note is a description of the agreement (optional).
+		Note string `json:"note,omitempty"`
+	}
+
+	// FeeScheduleResponse represents the fee schedule of a customer.
+	FeeScheduleResponse struct {
+		// CustomerID is the unique identifier of the customer.
+		CustomerID svc.CustomerID `json:"customer_id"`
+		// Fees are the standard fee tiers.
+		Fees []FeeEntry `json:"fees"`
+		// Overrides are the negotiated fee tiers currently in effect for the customer.
+		Overrides []FeeOverride `json:"overrides"`
+		// ModifiedAt is the timestamp of the last schedule change (ISO 8601 format).
+		ModifiedAt string `json:"modified_at"`
+	}
+)
+
+type serviceImpl struct {
+	*svc.BaseService
+}
+
+// NewService creates a new fees service instance with the given base service.
+func NewService(base *svc.BaseService) Service {
+	return &serviceImpl{
+		BaseService: base,
+	}
+}
+
+// GetFeeSchedule retrieves the fee schedule of a customer.
+func (s *serviceImpl) GetFeeSchedule(ctx context.Context, id svc.CustomerID) (*FeeScheduleResponse, error) {
+	path := fmt.Sprintf("/v1/customers/%s/fees", id)
+	return svc.GetJSON[FeeScheduleResponse](ctx, s.BaseService, path)
+}
@@ -0,0 +1,200 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package instructions
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+)
+
+// DefaultCacheTTL is the cache lifetime used by NewCachedService when ttl is zero.
+const DefaultCacheTTL = 15 * time.Minute
+
+// CachedService is an instructions Service that caches deposit instructions in memory.
+// Deposit addresses and bank details rarely change, so UI backends can call it on every
+// page view. Errors are never cached. It is safe for concurrent use.
+//
+// Enable it on a client with onemoney.WithInstructionCache, then invalidate entries with:
+//
+//	if cache, ok := client.Instructions.(*instructions.CachedService); ok {
+//	    cache.InvalidateCustomer(customerID)
+//	}
+type CachedService struct {
+	next Service
+	ttl  time.Duration
+	now  func() time.Time
+
+	mu      sync.Mutex
+	entries map[cacheKey]cacheEntry
+}
+
+// cacheKey identifies a cached instruction; an empty asset and network denote the list for a customer.
+type cacheKey struct {
+	customerID svc.CustomerID
+	asset      assets.AssetName
+	network    assets.NetworkName
+}
+
+type cacheEntry struct {
+	expiresAt    time.Time
+	instructions []InstructionResponse
+}
+
+// NewCachedService wraps next with a cache whose entries expire after ttl.
+func NewCachedService(next Service, ttl time.Duration) *CachedService {
+	if ttl <= 0 {
+		ttl = DefaultCacheTTL
+	}
+	return &CachedService{
+		next:    next,
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[cacheKey]cacheEntry),
+	}
+}
+
+// GetDepositInstruction returns the cached instruction, fetching it on a miss or after expiry.
+func (c *CachedService) GetDepositInstruction(
+	ctx context.Context,
+	id svc.CustomerID,
+	asset assets.AssetName,
+	network assets.NetworkName,
+) (*InstructionResponse, error) {
+	key := cacheKey{customerID: id, asset: asset, network: network}
+	if cached, ok := c.get(key); ok {
+		return &cached[0], nil
+	}
+
+	resp, err := c.next.GetDepositInstruction(ctx, id, asset, network)
+	if err != nil {
+		return nil, err
+	}
+	c.put(key, []InstructionResponse{*resp})
+	return resp, nil
+}
+
+// ListDepositInstructions returns the cached list, fetching it on a miss or after expiry.
+func (c *CachedService) ListDepositInstructions(ctx context.Context, id svc.CustomerID) ([]InstructionResponse, error) {
+	key := cacheKey{customerID: id}
+	if cached, ok := c.get(key); ok {
+		return cached, nil
+	}
+
+	resp, err := c.next.ListDepositInstructions(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	c.put(key, resp)
+	return resp, nil
+}
+
+// RenderPDF renders the cached instruction, fetching it on a miss or after expiry.
+func (c *CachedService) RenderPDF(
+	ctx context.Context,
+	id svc.CustomerID,
+	asset assets.AssetName,
+	network assets.NetworkName,
+	branding *Branding,
+) ([]byte, error) {
+	return renderPDF(ctx, c, id, asset, network, branding)
+}
+
+// Invalidate removes the cached instruction for one asset and network.
+func (c *CachedService) Invalidate(id svc.CustomerID, asset assets.AssetName, network assets.NetworkName) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, cacheKey{customerID: id, asset: asset, network: network})
+	delete(c.entries, cacheKey{customerID: id})
+}
+
+// InvalidateCustomer removes every cached instruction for the customer.
+func (c *CachedService) InvalidateCustomer(id svc.CustomerID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.entries {
+		if key.customerID == id {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// InvalidateAll empties the cache.
+func (c *CachedService) InvalidateAll() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.entries)
+}
+
+// get returns a copy of the cached instructions, evicting the entry if it has expired.
+func (c *CachedService) get(key cacheKey) ([]InstructionResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !c.now().Before(entry.expiresAt) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return cloneInstructions(entry.instructions), true
+}
+
+func (c *CachedService) put(key cacheKey, instructions []InstructionResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = cacheEntry{
+		expiresAt:    c.now().Add(c.ttl),
+		instructions: cloneInstructions(instructions),
+	}
+}
+
+// cloneInstructions deep-copies instructions so callers cannot modify cached entries.
+func cloneInstructions(instructions []InstructionResponse) []InstructionResponse {
+	clones := make([]InstructionResponse, len(instructions))
+	for i, instruction := range instructions {
+		if instruction.BankInstruction != nil {
+			bank := *instruction.BankInstruction
+			bank.Address = clonePtr(bank.Address)
+			bank.BankAddress = clonePtr(bank.BankAddress)
+			bank.ReferenceRequirement = clonePtr(bank.ReferenceRequirement)
+			if bank.CorrespondentBank != nil {
+				correspondent := *bank.CorrespondentBank
+				correspondent.Address = clonePtr(correspondent.Address)
+				bank.CorrespondentBank = &correspondent
+			}
+			instruction.BankInstruction = &bank
+		}
+		instruction.WalletInstruction = clonePtr(instruction.WalletInstruction)
+		clones[i] = instruction
+	}
+	return clones
+}
+
+// clonePtr returns a pointer to a copy of *p, or nil if p is nil.
+func clonePtr[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
@@ -0,0 +1,147 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package instructions
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+)
+
+// countingService counts calls to the underlying API.
+type countingService struct {
+	gets, lists int
+	fail        bool
+}
+
+func (c *countingService) GetDepositInstruction(
+	_ context.Context, _ svc.CustomerID, asset assets.AssetName, network assets.NetworkName,
+) (*InstructionResponse, error) {
+	c.gets++
+	if c.fail {
+		return nil, errors.New("unavailable")
+	}
+	return &InstructionResponse{
+		Asset:   string(asset),
+		Network: string(network),
+		BankInstruction: &BankInstruction{
+			AccountNumber:     "123456789",
+			CorrespondentBank: &CorrespondentBank{BankName: "Correspondent", Address: &AddressDetails{City: "New York"}},
+		},
+		WalletInstruction: &WalletInstruction{WalletAddress: "0xabc"},
+	}, nil
+}
+
+func (c *countingService) ListDepositInstructions(_ context.Context, _ svc.CustomerID) ([]InstructionResponse, error) {
+	c.lists++
+	return []InstructionResponse{{Asset: "USD"}, {Asset: "USDC"}}, nil
+}
+
+func (c *countingService) RenderPDF(
+	_ context.Context, _ svc.CustomerID, _ assets.AssetName, _ assets.NetworkName, _ *Branding,
+) ([]byte, error) {
+	return nil, errors.New("not implemented")
+}
+
+func TestCachedService(t *testing.T) {
+	ctx := context.Background()
+	next := &countingService{}
+	cache := NewCachedService(next, time.Minute)
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache.now = func() time.Time { return now }
+
+	for range 3 {
+		if _, err := cache.GetDepositInstruction(ctx, "cid", assets.AssetNameUSD, assets.NetworkNameUSACH); err != nil {
+			t.Fatalf("GetDepositInstruction() error = %v", err)
+		}
+	}
+	if next.gets != 1 {
+		t.Errorf("gets = %d after repeated calls, want 1", next.gets)
+	}
+
+	if _, err := cache.GetDepositInstruction(ctx, "other", assets.AssetNameUSD, assets.NetworkNameUSACH); err != nil {
+		t.Fatal(err)
+	}
+	if next.gets != 2 {
+		t.Errorf("gets = %d for another customer, want 2", next.gets)
+	}
+
+	now = now.Add(time.Minute)
+	if _, err := cache.GetDepositInstruction(ctx, "cid", assets.AssetNameUSD, assets.NetworkNameUSACH); err != nil {
+		t.Fatal(err)
+	}
+	if next.gets != 3 {
+		t.Errorf("gets = %d after expiry, want 3", next.gets)
+	}
+
+	got, err := cache.GetDepositInstruction(ctx, "cid", assets.AssetNameUSD, assets.NetworkNameUSACH)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got.BankInstruction.AccountNumber = "mutated"
+	got.BankInstruction.CorrespondentBank.Address.City = "mutated"
+	got.WalletInstruction.WalletAddress = "mutated"
+	got, _ = cache.GetDepositInstruction(ctx, "cid", assets.AssetNameUSD, assets.NetworkNameUSACH)
+	if got.BankInstruction.AccountNumber != "123456789" || got.BankInstruction.CorrespondentBank.Address.City != "New York" ||
+		got.WalletInstruction.WalletAddress != "0xabc" {
+		t.Errorf("cached instruction = %+v, want an unmodified cache", got)
+	}
+
+	cache.Invalidate("cid", assets.AssetNameUSD, assets.NetworkNameUSACH)
+	if _, err := cache.GetDepositInstruction(ctx, "cid", assets.AssetNameUSD, assets.NetworkNameUSACH); err != nil {
+		t.Fatal(err)
+	}
+	if next.gets != 4 {
+		t.Errorf("gets = %d after Invalidate, want 4", next.gets)
+	}
+
+	list, err := cache.ListDepositInstructions(ctx, "cid")
+	if err != nil || len(list) != 2 {
+		t.Fatalf("ListDepositInstructions() = %v, %v", list, err)
+	}
+	list[0].Asset = "mutated"
+	list, _ = cache.ListDepositInstructions(ctx, "cid")
+	if next.lists != 1 || list[0].Asset != "USD" {
+		t.Errorf("lists = %d, first asset = %q; want 1 call and an unmodified cache", next.lists, list[0].Asset)
+	}
+
+	cache.InvalidateCustomer("cid")
+	if _, err := cache.ListDepositInstructions(ctx, "cid"); err != nil {
+		t.Fatal(err)
+	}
+	if next.lists != 2 {
+		t.Errorf("lists = %d after InvalidateCustomer, want 2", next.lists)
+	}
+}
+
+func TestCachedService_DoesNotCacheErrors(t *testing.T) {
+	next := &countingService{fail: true}
+	cache := NewCachedService(next, 0)
+
+	for range 2 {
+		if _, err := cache.GetDepositInstruction(context.Background(), "cid", assets.AssetNameUSD, assets.NetworkNameUSACH); err == nil {
+			t.Fatal("GetDepositInstruction() expected error")
+		}
+	}
+	if next.gets != 2 {
+		t.Errorf("gets = %d, want 2", next.gets)
+	}
+}
@@ -0,0 +1,312 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package instructions
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+
+	"github.com/skip2/go-qrcode"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+)
+
+// Instruction rendering errors.
+var (
+	// ErrNotWalletInstruction is returned when a crypto-only helper is used on a fiat instruction.
+	ErrNotWalletInstruction = errors.New("instruction has no wallet details")
+	// ErrNotBankInstruction is returned when a fiat-only helper is used on a crypto instruction.
+	ErrNotBankInstruction = errors.New("instruction has no bank details")
+	// ErrTokenAddressRequired is returned when a payment URI is requested without a token address.
+	// Without one, the URI would request the network's native coin instead of the deposit asset.
+	ErrTokenAddressRequired = errors.New("token address is required for a payment URI")
+)
+
+// EVMChainIDs maps EVM networks to their mainnet chain IDs, used in EIP-681 payment URIs.
+// Override with PaymentURIOptions.ChainID for test networks.
+var EVMChainIDs = map[assets.NetworkName]uint64{
+	assets.NetworkNameETHEREUM:  1,
+	assets.NetworkNameBNBCHAIN:  56,
+	assets.NetworkNamePOLYGON:   137,
+	assets.NetworkNameBASE:      8453,
+	assets.NetworkNameARBITRUM:  42161,
+	assets.NetworkNameAVALANCHE: 43114,
+}
+
+// TokenDecimals maps networks and stablecoins to their token decimals, used to encode EIP-681 amounts.
+// Set PaymentURIOptions.Decimals for tokens not listed here.
+var TokenDecimals = map[assets.NetworkName]map[assets.AssetName]int{
+	assets.NetworkNameETHEREUM: {
+		assets.AssetNameUSDC: 6, assets.AssetNameUSDT: 6, assets.AssetNamePYUSD: 6, assets.AssetNameEURC: 6,
+	},
+	assets.NetworkNamePOLYGON:   {assets.AssetNameUSDC: 6, assets.AssetNameUSDT: 6},
+	assets.NetworkNameBASE:      {assets.AssetNameUSDC: 6, assets.AssetNameEURC: 6},
+	assets.NetworkNameARBITRUM:  {assets.AssetNameUSDC: 6, assets.AssetNameUSDT: 6},
+	assets.NetworkNameAVALANCHE: {assets.AssetNameUSDC: 6, assets.AssetNameUSDT: 6, assets.AssetNameEURC: 6},
+	assets.NetworkNameBNBCHAIN:  {assets.AssetNameUSDC: 18, assets.AssetNameUSDT: 18},
+}
+
+// PaymentURIOptions configures the payment URI for a crypto deposit instruction.
+type PaymentURIOptions struct {
+	// Amount is the requested deposit amount as a decimal string (optional).
+	Amount string
+	// TokenAddress is the token contract (EVM) or mint (Solana) address of the deposit asset (required).
+	TokenAddress string
+	// Decimals is the number of token decimals used to encode EVM amounts.
+	// Default: the value in TokenDecimals; required when Amount is set for an unlisted token.
+	Decimals int
+	// ChainID overrides the EVM chain ID, e.g. for test networks (optional).
+	ChainID uint64
+	// Label is a payee label shown by Solana Pay wallets (optional).
+	Label string
+	// Message is a payment description shown by Solana Pay wallets (optional).
+	Message string
+}
+
+// PaymentURI renders a crypto deposit instruction as a wallet payment URI for the deposit token:
+// an EIP-681 URI on EVM networks, or a Solana Pay URI on Solana. opts.TokenAddress is required,
+// since a URI without a token requests the network's native coin.
+func (r *InstructionResponse) PaymentURI(opts *PaymentURIOptions) (string, error) {
+	if r.WalletInstruction == nil || r.WalletInstruction.WalletAddress == "" {
+		return "", ErrNotWalletInstruction
+	}
+	if opts == nil || opts.TokenAddress == "" {
+		return "", ErrTokenAddressRequired
+	}
+	address := r.WalletInstruction.WalletAddress
+	network := assets.NetworkName(strings.ToUpper(r.Network))
+
+	if network == assets.NetworkNameSOLANA {
+		return solanaPayURI(address, opts), nil
+	}
+
+	chainID := opts.ChainID
+	if chainID == 0 {
+		var ok bool
+		if chainID, ok = EVMChainIDs[network]; !ok {
+			return "", fmt.Errorf("payment URIs are not supported on network %q", r.Network)
+		}
+	}
+	return eip681URI(address, chainID, assets.AssetName(strings.ToUpper(r.Asset)), network, opts)
+}
+
+// eip681URI builds an EIP-681 URI encoding an ERC-20 transfer of the token to the address.
+func eip681URI(
+	address string, chainID uint64, asset assets.AssetName, network assets.NetworkName, opts *PaymentURIOptions,
+) (string, error) {
+	uri := fmt.Sprintf("ethereum:%s@%d/transfer?address=%s", opts.TokenAddress, chainID, address)
+	if opts.Amount != "" {
+		decimals := opts.Decimals
+		if decimals == 0 {
+			var ok bool
+			if decimals, ok = TokenDecimals[network][asset]; !ok {
+				return "", fmt.Errorf("token decimals of %s on %s are unknown; set PaymentURIOptions.Decimals", asset, network)
+			}
+		}
+		units, err := toBaseUnits(opts.Amount, decimals)
+		if err != nil {
+			return "", err
+		}
+		uri += "&uint256=" + units
+	}
+	return uri, nil
+}
+
+// solanaPayURI builds a Solana Pay transfer request URI.
+func solanaPayURI(address string, opts *PaymentURIOptions) string {
+	params := url.Values{}
+	if opts.Amount != "" {
+		params.Set("amount", opts.Amount)
+	}
+	params.Set("spl-token", opts.TokenAddress)
+	if opts.Label != "" {
+		params.Set("label", opts.Label)
+	}
+	if opts.Message != "" {
+		params.Set("message", opts.Message)
+	}
+
+	return "solana:" + address + "?" + params.Encode()
+}
+
+// toBaseUnits converts a decimal amount into integer token base units, e.g. "1.5" with 6 decimals is "1500000".
+func toBaseUnits(amount string, decimals int) (string, error) {
+	r, ok := new(big.Rat).SetString(amount)
+	if !ok || r.Sign() < 0 {
+		return "", fmt.Errorf("invalid amount %q", amount)
+	}
+	r.Mul(r, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)))
+	if !r.IsInt() {
+		return "", fmt.Errorf("amount %q has more than %d decimals", amount, decimals)
+	}
+	return r.Num().String(), nil
+}
+
+// QRContent returns the content of a deposit QR code: the payment URI when opts sets a token
+// address, or the plain wallet address otherwise.
+func (r *InstructionResponse) QRContent(opts *PaymentURIOptions) (string, error) {
+	if r.WalletInstruction == nil || r.WalletInstruction.WalletAddress == "" {
+		return "", ErrNotWalletInstruction
+	}
+	if opts == nil || opts.TokenAddress == "" {
+		return r.WalletInstruction.WalletAddress, nil
+	}
+	return r.PaymentURI(opts)
+}
+
+// QRCodePNG renders the instruction's QR content (see QRContent) as a PNG QR code of size x size pixels.
+func (r *InstructionResponse) QRCodePNG(opts *PaymentURIOptions, size int) ([]byte, error) {
+	uri, err := r.QRContent(opts)
+	if err != nil {
+		return nil, err
+	}
+	png, err := qrcode.Encode(uri, qrcode.Medium, size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode QR code: %w", err)
+	}
+	return png, nil
+}
+
+// QRCodeSVG renders the instruction's QR content (see QRContent) as an SVG QR code of size x size user units.
+func (r *InstructionResponse) QRCodeSVG(opts *PaymentURIOptions, size int) (string, error) {
+	uri, err := r.QRContent(opts)
+	if err != nil {
+		return "", err
+	}
+	qr, err := qrcode.New(uri, qrcode.Medium)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode QR code: %w", err)
+	}
+
+	bitmap := qr.Bitmap()
+	modules := len(bitmap)
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" shape-rendering="crispEdges">`,
+		size, size, modules, modules)
+	fmt.Fprintf(&b, `<rect width="%d" height="%d" fill="#fff"/><path fill="#000" d="`, modules, modules)
+	for y, row := range bitmap {
+		for x, dark := range row {
+			if dark {
+				fmt.Fprintf(&b, "M%d %dh1v1h-1z", x, y)
+			}
+		}
+	}
+	b.WriteString(`"/></svg>`)
+	return b.String(), nil
+}
+
+// WireSheet renders a fiat deposit instruction as a plain-text sheet of labelled fields,
+// suitable for display or for sending to a payer.
+func (r *InstructionResponse) WireSheet() (string, error) {
+	if r.BankInstruction == nil {
+		return "", ErrNotBankInstruction
+	}
+
+	rows := r.fields()
+	width := 0
+	for _, row := range rows {
+		width = max(width, len(row[0]))
+	}
+
+	var b strings.Builder
+	b.WriteString("Deposit Instructions\n")
+	for _, row := range rows {
+		b.WriteString(row[0] + ":" + strings.Repeat(" ", width-len(row[0])+1) + row[1] + "\n")
+	}
+	return b.String(), nil
+}
+
+// fields returns the non-empty labelled fields of the instruction, in display order.
+func (r *InstructionResponse) fields() [][2]string {
+	rows := [][2]string{
+		{"Asset", r.Asset},
+		{"Network", r.Network},
+	}
+
+	if wallet := r.WalletInstruction; wallet != nil {
+		rows = append(rows, [2]string{"Wallet Address", wallet.WalletAddress})
+		if wallet.TransactionFee.Value != "" {
+			rows = append(rows, [2]string{"Fee", wallet.TransactionFee.Value + " " + wallet.TransactionFee.Asset})
+		}
+	}
+
+	if bank := r.BankInstruction; bank != nil {
+		rows = append(rows,
+			[2]string{"Bank Name", bank.BankName},
+			[2]string{"Routing Number", bank.RoutingNumber},
+			[2]string{"SWIFT/BIC", bank.BICCode},
+			[2]string{"Account Holder", bank.AccountHolder},
+			[2]string{"Account Number", bank.AccountNumber},
+			[2]string{"IBAN", bank.IBAN},
+			[2]string{"Account Identifier", bank.AccountIdentifier},
+			[2]string{"Address", bank.Address.String()},
+			[2]string{"Bank Address", bank.BankAddress.String()},
+		)
+		if cb := bank.CorrespondentBank; cb != nil {
+			rows = append(rows,
+				[2]string{"Correspondent Bank", cb.BankName},
+				[2]string{"Correspondent BIC", cb.BICCode},
+				[2]string{"Correspondent Routing", cb.RoutingNumber},
+				[2]string{"Correspondent Account", cb.AccountNumber},
+				[2]string{"Correspondent Address", cb.Address.String()},
+			)
+		}
+		if ref := bank.ReferenceRequirement; ref != nil {
+			reference := ref.Reference
+			if reference == "" {
+				reference = ref.Format
+			}
+			if ref.Required && reference != "" {
+				reference += " (required)"
+			}
+			rows = append(rows, [2]string{"Reference", reference})
+		}
+		if bank.TransactionFee.Value != "" {
+			rows = append(rows, [2]string{"Fee", bank.TransactionFee.Value + " " + bank.TransactionFee.Asset})
+		}
+	}
+
+	out := rows[:0]
+	for _, row := range rows {
+		if row[1] != "" {
+			out = append(out, row)
+		}
+	}
+	return out
+}
+
+// String formats the address on a single line, skipping empty parts.
+func (a *AddressDetails) String() string {
+	if a == nil {
+		return ""
+	}
+	cityLine := strings.TrimSpace(strings.Join(nonEmpty(a.City, a.State), ", ") + " " + a.PostalCode)
+	return strings.Join(nonEmpty(a.StreetLine1, a.StreetLine2, cityLine, a.Country), ", ")
+}
+
+func nonEmpty(parts ...string) []string {
+	out := parts[:0]
+	for _, p := range parts {
+		if p != "" {
+			out = append(out, p)
+		}
+	}
+	return out
+}
@@ -0,0 +1,222 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package instructions
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// errAny matches any error in table tests.
+var errAny = errors.New("any error")
+
+func TestInstructionResponse_PaymentURI(t *testing.T) {
+	evm := &InstructionResponse{
+		Asset:             "USDC",
+		Network:           "ETHEREUM",
+		WalletInstruction: &WalletInstruction{WalletAddress: "0xabc"},
+	}
+	bnb := &InstructionResponse{
+		Asset:             "USDT",
+		Network:           "BNBCHAIN",
+		WalletInstruction: &WalletInstruction{WalletAddress: "0xabc"},
+	}
+	unlisted := &InstructionResponse{
+		Asset:             "MXNB",
+		Network:           "ARBITRUM",
+		WalletInstruction: &WalletInstruction{WalletAddress: "0xabc"},
+	}
+	sol := &InstructionResponse{
+		Asset:             "USDC",
+		Network:           "SOLANA",
+		WalletInstruction: &WalletInstruction{WalletAddress: "SoLaddr"},
+	}
+
+	tests := []struct {
+		name    string
+		instr   *InstructionResponse
+		opts    *PaymentURIOptions
+		want    string
+		wantErr error
+	}{
+		{name: "evm without token", instr: evm, wantErr: ErrTokenAddressRequired},
+		{name: "evm amount without token", instr: evm, opts: &PaymentURIOptions{Amount: "1"}, wantErr: ErrTokenAddressRequired},
+		{
+			name:  "evm token transfer",
+			instr: evm,
+			opts:  &PaymentURIOptions{TokenAddress: "0xtoken", Amount: "12.5"},
+			want:  "ethereum:0xtoken@1/transfer?address=0xabc&uint256=12500000",
+		},
+		{
+			name:  "bnb chain uses 18 decimals",
+			instr: bnb,
+			opts:  &PaymentURIOptions{TokenAddress: "0xtoken", Amount: "12.5"},
+			want:  "ethereum:0xtoken@56/transfer?address=0xabc&uint256=12500000000000000000",
+		},
+		{
+			name:  "evm chain override",
+			instr: evm,
+			opts:  &PaymentURIOptions{TokenAddress: "0xtoken", ChainID: 11155111},
+			want:  "ethereum:0xtoken@11155111/transfer?address=0xabc",
+		},
+		{
+			name:    "unlisted token amount without decimals",
+			instr:   unlisted,
+			opts:    &PaymentURIOptions{TokenAddress: "0xtoken", Amount: "1"},
+			wantErr: errAny,
+		},
+		{
+			name:  "unlisted token with decimals",
+			instr: unlisted,
+			opts:  &PaymentURIOptions{TokenAddress: "0xtoken", Amount: "1", Decimals: 6},
+			want:  "ethereum:0xtoken@42161/transfer?address=0xabc&uint256=1000000",
+		},
+		{
+			name:    "evm too many decimals",
+			instr:   evm,
+			opts:    &PaymentURIOptions{TokenAddress: "0xtoken", Amount: "0.0000001"},
+			wantErr: errAny,
+		},
+		{
+			name:  "solana pay",
+			instr: sol,
+			opts:  &PaymentURIOptions{Amount: "5", TokenAddress: "mint", Label: "Acme"},
+			want:  "solana:SoLaddr?amount=5&label=Acme&spl-token=mint",
+		},
+		{name: "solana without token", instr: sol, opts: &PaymentURIOptions{Amount: "5"}, wantErr: ErrTokenAddressRequired},
+		{
+			name:    "unsupported network",
+			instr:   &InstructionResponse{Network: "US_ACH", WalletInstruction: &WalletInstruction{WalletAddress: "x"}},
+			opts:    &PaymentURIOptions{TokenAddress: "0xtoken"},
+			wantErr: errAny,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.instr.PaymentURI(tt.opts)
+			if (err != nil) != (tt.wantErr != nil) || (tt.wantErr != errAny && !errors.Is(err, tt.wantErr)) {
+				t.Fatalf("PaymentURI() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("PaymentURI() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := (&InstructionResponse{}).PaymentURI(nil); !errors.Is(err, ErrNotWalletInstruction) {
+		t.Errorf("fiat PaymentURI() error = %v, want ErrNotWalletInstruction", err)
+	}
+}
+
+func TestInstructionResponse_QRCode(t *testing.T) {
+	instr := &InstructionResponse{
+		Network:           "POLYGON",
+		WalletInstruction: &WalletInstruction{WalletAddress: "0xabc"},
+	}
+
+	png, err := instr.QRCodePNG(nil, 256)
+	if err != nil {
+		t.Fatalf("QRCodePNG() error = %v", err)
+	}
+	if !bytes.HasPrefix(png, []byte("\x89PNG")) {
+		t.Error("QRCodePNG() did not return a PNG image")
+	}
+
+	if content, err := instr.QRContent(nil); err != nil || content != "0xabc" {
+		t.Errorf("QRContent(nil) = %q, %v, want the plain wallet address", content, err)
+	}
+	opts := &PaymentURIOptions{TokenAddress: "0xtoken"}
+	if content, err := instr.QRContent(opts); err != nil || !strings.HasPrefix(content, "ethereum:0xtoken@137/") {
+		t.Errorf("QRContent() = %q, %v, want a token payment URI", content, err)
+	}
+
+	svg, err := instr.QRCodeSVG(nil, 256)
+	if err != nil {
+		t.Fatalf("QRCodeSVG() error = %v", err)
+	}
+	if !strings.HasPrefix(svg, "<svg") || !strings.Contains(svg, `width="256"`) {
+		t.Errorf("QRCodeSVG() = %.80s...", svg)
+	}
+}
+
+func TestInstructionResponse_WireSheet(t *testing.T) {
+	instr := &InstructionResponse{
+		Asset:   "USD",
+		Network: "US_FEDWIRE",
+		BankInstruction: &BankInstruction{
+			BankName:      "Test Bank",
+			RoutingNumber: "021000021",
+			AccountHolder: "Acme Corp",
+			AccountNumber: "123456789",
+			Address:       &AddressDetails{StreetLine1: "1 Main St", City: "New York", State: "NY", PostalCode: "10001", Country: "USA"},
+		},
+	}
+
+	got, err := instr.WireSheet()
+	if err != nil {
+		t.Fatalf("WireSheet() error = %v", err)
+	}
+	want := "Deposit Instructions\n" +
+		"Asset:          USD\n" +
+		"Network:        US_FEDWIRE\n" +
+		"Bank Name:      Test Bank\n" +
+		"Routing Number: 021000021\n" +
+		"Account Holder: Acme Corp\n" +
+		"Account Number: 123456789\n" +
+		"Address:        1 Main St, New York, NY 10001, USA\n"
+	if got != want {
+		t.Errorf("WireSheet() =\n%s\nwant\n%s", got, want)
+	}
+
+	swift := &InstructionResponse{
+		Asset:   "USD",
+		Network: "SWIFT",
+		BankInstruction: &BankInstruction{
+			BankName:    "Test Bank",
+			BICCode:     "TESTUS33",
+			IBAN:        "DE89370400440532013000",
+			BankAddress: &AddressDetails{City: "New York", Country: "USA"},
+			CorrespondentBank: &CorrespondentBank{
+				BankName: "Correspondent AG",
+				BICCode:  "CORRDEFF",
+			},
+			ReferenceRequirement: &ReferenceRequirement{Required: true, Reference: "1M-12345678"},
+		},
+	}
+	got, err = swift.WireSheet()
+	if err != nil {
+		t.Fatalf("WireSheet() error = %v", err)
+	}
+	for _, line := range []string{
+		"IBAN:               DE89370400440532013000\n",
+		"Bank Address:       New York, USA\n",
+		"Correspondent Bank: Correspondent AG\n",
+		"Correspondent BIC:  CORRDEFF\n",
+		"Reference:          1M-12345678 (required)\n",
+	} {
+		if !strings.Contains(got, line) {
+			t.Errorf("WireSheet() missing %q in\n%s", line, got)
+		}
+	}
+
+	if _, err := (&InstructionResponse{}).WireSheet(); !errors.Is(err, ErrNotBankInstruction) {
+		t.Errorf("crypto WireSheet() error = %v, want ErrNotBankInstruction", err)
+	}
+}
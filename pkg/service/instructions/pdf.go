@@ -0,0 +1,332 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package instructions
+
+import (
+	"bytes"
+	"compress/zlib"
+	"context"
+	"fmt"
+	"image"
+	"image/png"
+	"strconv"
+	"strings"
+
+	"github.com/skip2/go-qrcode"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+)
+
+// Page geometry of rendered documents, in PDF points (US Letter).
+const (
+	pdfPageWidth  = 612
+	pdfPageHeight = 792
+	pdfMargin     = 50
+	pdfValueX     = 200
+	pdfLineHeight = 16
+	// pdfValueChars is the number of value characters that fit on one line before wrapping.
+	pdfValueChars = 64
+	pdfQRSize     = 160
+)
+
+// defaultAccentColor is the header color used when Branding.AccentColor is unset.
+const defaultAccentColor = "#0B2545"
+
+// Branding customises the header and footer of a rendered deposit instruction document.
+type Branding struct {
+	// CompanyName is shown in the header bar, e.g. "Acme Payments".
+	CompanyName string
+	// Title is the document title. Default: "Deposit Instructions".
+	Title string
+	// AccentColor is the header bar color as "#RRGGBB". Default: #0B2545.
+	AccentColor string
+	// Footer is a note printed at the bottom of the page, e.g. support contact details (optional).
+	Footer string
+	// LogoPNG is a PNG logo drawn in the header bar (optional).
+	LogoPNG []byte
+	// Payment configures the QR code of crypto deposits. Without a token address, the QR code
+	// encodes the plain wallet address (optional).
+	Payment *PaymentURIOptions
+}
+
+// RenderPDF fetches deposit instructions for an asset and network and renders them as a
+// single-page, customer-facing PDF document.
+func (s *serviceImpl) RenderPDF(
+	ctx context.Context,
+	id svc.CustomerID,
+	asset assets.AssetName,
+	network assets.NetworkName,
+	branding *Branding,
+) ([]byte, error) {
+	return renderPDF(ctx, s, id, asset, network, branding)
+}
+
+// renderPDF fetches an instruction through service and renders it.
+func renderPDF(
+	ctx context.Context,
+	service Service,
+	id svc.CustomerID,
+	asset assets.AssetName,
+	network assets.NetworkName,
+	branding *Branding,
+) ([]byte, error) {
+	instr, err := service.GetDepositInstruction(ctx, id, asset, network)
+	if err != nil {
+		return nil, err
+	}
+	return instr.RenderPDF(branding)
+}
+
+// RenderPDF renders the instruction as a single-page PDF document listing the bank details
+// for fiat deposits, or the wallet address and a payment QR code for crypto deposits.
+func (r *InstructionResponse) RenderPDF(branding *Branding) ([]byte, error) {
+	if branding == nil {
+		branding = &Branding{}
+	}
+	title := branding.Title
+	if title == "" {
+		title = "Deposit Instructions"
+	}
+	accent := branding.AccentColor
+	if accent == "" {
+		accent = defaultAccentColor
+	}
+	red, green, blue, err := parseHexColor(accent)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := &pdfDocument{}
+	var content bytes.Buffer
+
+	// Header bar with company name and optional logo.
+	fmt.Fprintf(&content, "%s %s %s rg 0 %d %d 80 re f\n", red, green, blue, pdfPageHeight-80, pdfPageWidth)
+	writeText(&content, "F2", 20, pdfMargin, pdfPageHeight-48, "1 1 1", branding.CompanyName)
+
+	if len(branding.LogoPNG) > 0 {
+		img, err := png.Decode(bytes.NewReader(branding.LogoPNG))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode logo: %w", err)
+		}
+		w, h := fitBox(img.Bounds().Dx(), img.Bounds().Dy(), 60)
+		fmt.Fprintf(&content, "q %s 0 0 %s %s %s cm /Im1 Do Q\n",
+			formatFloat(w), formatFloat(h), formatFloat(pdfPageWidth-pdfMargin-w), formatFloat(pdfPageHeight-70))
+		doc.image = encodeImage(img)
+	}
+
+	// Title and field rows.
+	y := pdfPageHeight - 120
+	writeText(&content, "F2", 16, pdfMargin, y, "0 0 0", title)
+	y -= 2 * pdfLineHeight
+	for _, row := range r.fields() {
+		writeText(&content, "F2", 10, pdfMargin, y, "0.3 0.3 0.3", row[0])
+		for _, line := range wrapText(row[1], pdfValueChars) {
+			writeText(&content, "F1", 10, pdfValueX, y, "0 0 0", line)
+			y -= pdfLineHeight
+		}
+	}
+
+	// Payment QR code for crypto deposits, drawn as filled modules.
+	if wallet := r.WalletInstruction; wallet != nil && wallet.WalletAddress != "" {
+		qrContent, err := r.QRContent(branding.Payment)
+		if err != nil {
+			return nil, err
+		}
+		qr, err := qrcode.New(qrContent, qrcode.Medium)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode QR code: %w", err)
+		}
+		bitmap := qr.Bitmap()
+		module := float64(pdfQRSize) / float64(len(bitmap))
+		top := float64(y - pdfLineHeight)
+		content.WriteString("0 0 0 rg\n")
+		for row, modules := range bitmap {
+			for col, dark := range modules {
+				if dark {
+					fmt.Fprintf(&content, "%s %s %s %s re\n",
+						formatFloat(pdfValueX+float64(col)*module), formatFloat(top-float64(row+1)*module),
+						formatFloat(module), formatFloat(module))
+				}
+			}
+		}
+		content.WriteString("f\n")
+	}
+
+	if branding.Footer != "" {
+		content.WriteString("0.8 0.8 0.8 RG 0.5 w\n")
+		fmt.Fprintf(&content, "%d %d m %d %d l S\n", pdfMargin, 60, pdfPageWidth-pdfMargin, 60)
+		fy := 45
+		for _, line := range wrapText(branding.Footer, 100) {
+			writeText(&content, "F1", 8, pdfMargin, fy, "0.4 0.4 0.4", line)
+			fy -= 10
+		}
+	}
+
+	doc.content = content.Bytes()
+	return doc.bytes(), nil
+}
+
+// pdfDocument holds the parts of a single-page PDF and serialises them with a cross-reference table.
+type pdfDocument struct {
+	content []byte
+	image   *pdfImage
+}
+
+// pdfImage is an RGB image XObject with Flate-compressed samples.
+type pdfImage struct {
+	width, height int
+	data          []byte
+}
+
+// bytes serialises the document. Object numbers are fixed: 1 catalog, 2 pages, 3 page,
+// 4-5 fonts, 6 content stream and 7 the optional logo image.
+func (d *pdfDocument) bytes() []byte {
+	resources := "/Font << /F1 4 0 R /F2 5 0 R >>"
+	if d.image != nil {
+		resources += " /XObject << /Im1 7 0 R >>"
+	}
+
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << %s >> /Contents 6 0 R >>",
+			pdfPageWidth, pdfPageHeight, resources),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>",
+		fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(d.content), d.content),
+	}
+	if d.image != nil {
+		objects = append(objects, fmt.Sprintf(
+			"<< /Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /DeviceRGB "+
+				"/BitsPerComponent 8 /Filter /FlateDecode /Length %d >>\nstream\n%s\nendstream",
+			d.image.width, d.image.height, len(d.image.data), d.image.data))
+	}
+
+	var b bytes.Buffer
+	b.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = b.Len()
+		fmt.Fprintf(&b, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+	xref := b.Len()
+	fmt.Fprintf(&b, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&b, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&b, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return b.Bytes()
+}
+
+// encodeImage converts an image to Flate-compressed 8-bit RGB samples, compositing
+// transparent pixels onto white.
+func encodeImage(img image.Image) *pdfImage {
+	bounds := img.Bounds()
+	var buf bytes.Buffer
+	zw := zlib.NewWriter(&buf)
+	row := make([]byte, 0, 3*bounds.Dx())
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		row = row[:0]
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			// RGBA returns alpha-premultiplied values, so adding the uncovered part of white composites.
+			r, g, b, a := img.At(x, y).RGBA()
+			white := 0xffff - a
+			row = append(row, byte((r+white)>>8), byte((g+white)>>8), byte((b+white)>>8))
+		}
+		_, _ = zw.Write(row)
+	}
+	_ = zw.Close()
+	return &pdfImage{width: bounds.Dx(), height: bounds.Dy(), data: buf.Bytes()}
+}
+
+// writeText appends a single line of text at (x, y) in the given font, size and RGB fill color.
+func writeText(b *bytes.Buffer, font string, size, x, y int, color, text string) {
+	if text == "" {
+		return
+	}
+	fmt.Fprintf(b, "BT %s rg /%s %d Tf %d %d Td (%s) Tj ET\n", color, font, size, x, y, escapePDFText(text))
+}
+
+// escapePDFText escapes a string for a PDF literal and maps it to WinAnsi, replacing
+// characters outside Latin-1 with '?'.
+func escapePDFText(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '\\' || r == '(' || r == ')':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r >= 0x20 && r < 0x7f:
+			b.WriteRune(r)
+		case r >= 0xa0 && r <= 0xff:
+			fmt.Fprintf(&b, "\\%03o", r)
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return b.String()
+}
+
+// wrapText splits text into lines of at most width characters, breaking on spaces
+// and hard-breaking words that are longer than a line.
+func wrapText(text string, width int) []string {
+	var lines []string
+	line := ""
+	for _, word := range strings.Fields(text) {
+		for len(word) > width {
+			if line != "" {
+				lines = append(lines, line)
+				line = ""
+			}
+			lines = append(lines, word[:width])
+			word = word[width:]
+		}
+		switch {
+		case line == "":
+			line = word
+		case len(line)+1+len(word) <= width:
+			line += " " + word
+		default:
+			lines = append(lines, line)
+			line = word
+		}
+	}
+	return append(lines, line)
+}
+
+// parseHexColor parses "#RRGGBB" into PDF color components in the range 0-1.
+func parseHexColor(color string) (r, g, b string, err error) {
+	hex := strings.TrimPrefix(color, "#")
+	v, parseErr := strconv.ParseUint(hex, 16, 32)
+	if len(hex) != 6 || parseErr != nil {
+		return "", "", "", fmt.Errorf("invalid accent color %q: expected #RRGGBB", color)
+	}
+	component := func(shift uint) string { return formatFloat(float64((v>>shift)&0xff) / 255) }
+	return component(16), component(8), component(0), nil
+}
+
+// fitBox scales width x height to fit within a square box of the given size, keeping the aspect ratio.
+func fitBox(width, height int, box float64) (w, h float64) {
+	scale := box / float64(max(width, height))
+	return float64(width) * scale, float64(height) * scale
+}
+
+// formatFloat formats a number for a content stream without exponent notation.
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 32)
+}
@@ -0,0 +1,129 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package instructions
+
+import (
+	"bytes"
+	"compress/zlib"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestInstructionResponse_RenderPDF(t *testing.T) {
+	fiat := &InstructionResponse{
+		Asset:   "USD",
+		Network: "US_FEDWIRE",
+		BankInstruction: &BankInstruction{
+			BankName:      "Test Bank (NY)",
+			RoutingNumber: "021000021",
+			AccountHolder: "Société Générale",
+			AccountNumber: "123456789",
+		},
+	}
+
+	var logo bytes.Buffer
+	img := image.NewRGBA(image.Rect(0, 0, 4, 2))
+	img.Set(0, 0, color.RGBA{R: 255, A: 255})
+	if err := png.Encode(&logo, img); err != nil {
+		t.Fatalf("png.Encode() error = %v", err)
+	}
+
+	pdf, err := fiat.RenderPDF(&Branding{CompanyName: "Acme", Footer: "Questions? support@acme.test", LogoPNG: logo.Bytes()})
+	if err != nil {
+		t.Fatalf("RenderPDF() error = %v", err)
+	}
+	out := string(pdf)
+	if !strings.HasPrefix(out, "%PDF-") || !strings.HasSuffix(out, "%%EOF\n") {
+		t.Errorf("RenderPDF() is not a well-formed PDF:\n%s", out)
+	}
+	for _, want := range []string{
+		"(Acme) Tj",
+		"(Deposit Instructions) Tj",
+		"(Test Bank \\(NY\\)) Tj",
+		"(Soci\\351t\\351 G\\351n\\351rale) Tj",
+		"(Questions? support@acme.test) Tj",
+		"/Subtype /Image /Width 4 /Height 2",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("RenderPDF() missing %q", want)
+		}
+	}
+
+	crypto := &InstructionResponse{
+		Asset:             "USDC",
+		Network:           "ETHEREUM",
+		WalletInstruction: &WalletInstruction{WalletAddress: "0x1234567890abcdef1234567890abcdef12345678"},
+	}
+	pdf, err = crypto.RenderPDF(nil)
+	if err != nil {
+		t.Fatalf("crypto RenderPDF() error = %v", err)
+	}
+	if !strings.Contains(string(pdf), "(0x1234567890abcdef1234567890abcdef12345678) Tj") {
+		t.Error("crypto RenderPDF() missing wallet address")
+	}
+	if !strings.Contains(string(pdf), " re\n") {
+		t.Error("crypto RenderPDF() missing QR code")
+	}
+
+	if _, err := fiat.RenderPDF(&Branding{AccentColor: "blue"}); err == nil {
+		t.Error("RenderPDF() with invalid accent color should fail")
+	}
+}
+
+func TestWrapText(t *testing.T) {
+	tests := []struct {
+		text  string
+		width int
+		want  []string
+	}{
+		{"short", 10, []string{"short"}},
+		{"1 Main St, New York, NY", 12, []string{"1 Main St,", "New York, NY"}},
+		{"abcdefghij", 4, []string{"abcd", "efgh", "ij"}},
+	}
+	for _, tt := range tests {
+		got := wrapText(tt.text, tt.width)
+		if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+			t.Errorf("wrapText(%q, %d) = %q, want %q", tt.text, tt.width, got, tt.want)
+		}
+	}
+}
+
+func TestEncodeImage_CompositesAlphaOntoWhite(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 3, 1))
+	img.SetNRGBA(0, 0, color.NRGBA{A: 0})
+	img.SetNRGBA(1, 0, color.NRGBA{R: 255, A: 255})
+	img.SetNRGBA(2, 0, color.NRGBA{A: 128})
+
+	encoded := encodeImage(img)
+	zr, err := zlib.NewReader(bytes.NewReader(encoded.data))
+	if err != nil {
+		t.Fatalf("zlib.NewReader() error = %v", err)
+	}
+	got, err := io.ReadAll(zr)
+	if err != nil {
+		t.Fatalf("ReadAll() error = %v", err)
+	}
+
+	want := []byte{255, 255, 255, 255, 0, 0, 127, 127, 127}
+	if !bytes.Equal(got, want) {
+		t.Errorf("encodeImage() samples = %v, want %v", got, want)
+	}
+}
@@ -26,6 +26,7 @@
 //	    "context"
 //	    onemoney "github.com/1Money-Co/1money-go-sdk/pkg/onemoney"
 //	    "github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+//	    "github.com/1Money-Co/1money-go-sdk/pkg/service/instructions"
 //	)
 //
 //	// Create client
@@ -36,6 +37,13 @@
 //
 //	// Get deposit instructions
 //	instruction, err := client.Instructions.GetDepositInstruction(ctx, "customer-id", assets.AssetNameUSD, assets.NetworkNameUSACH)
+//
+//	// Get deposit instructions for every provisioned asset and network
+//	all, err := client.Instructions.ListDepositInstructions(ctx, "customer-id")
+//
+//	// Render a PDF to send to a payer
+//	pdf, err := client.Instructions.RenderPDF(ctx, "customer-id", assets.AssetNameUSD, assets.NetworkNameUSACH,
+//	    &instructions.Branding{CompanyName: "Acme Corp"})
 package instructions
 
 import (
@@ -52,6 +60,14 @@ type Service interface {
 	GetDepositInstruction(
 		ctx context.Context, id svc.CustomerID, asset assets.AssetName, network assets.NetworkName,
 	) (*InstructionResponse, error)
+	// ListDepositInstructions retrieves deposit instructions for every asset and network
+	// the customer is provisioned for.
+	ListDepositInstructions(ctx context.Context, id svc.CustomerID) ([]InstructionResponse, error)
+	// RenderPDF renders deposit instructions for an asset and network as a customer-facing
+	// PDF document, with optional branding. Pass nil for the default layout.
+	RenderPDF(
+		ctx context.Context, id svc.CustomerID, asset assets.AssetName, network assets.NetworkName, branding *Branding,
+	) ([]byte, error)
 }
 
 // Instruction detail types.
@@ -74,6 +90,34 @@ type (
 		Asset string `json:"asset"`
 	}
 
+	// CorrespondentBank represents the intermediary bank that routes an international wire.
+	CorrespondentBank struct {
+		// BankName is the name of the correspondent bank.
+		BankName string `json:"bank_name,omitempty"`
+		// BICCode is the SWIFT/BIC code of the correspondent bank.
+		BICCode string `json:"bic_code,omitempty"`
+		// RoutingNumber is the ABA routing number of the correspondent bank, for USD wires.
+		RoutingNumber string `json:"routing_number,omitempty"`
+		// AccountNumber is the account the beneficiary bank holds at the correspondent bank.
+		AccountNumber string `json:"account_number,omitempty"`
+		// Address is the postal address of the correspondent bank.
+		Address *AddressDetails `json:"address,omitempty"`
+	}
+
+	// ReferenceRequirement describes the payment reference the payer must include.
+	ReferenceRequirement struct {
+		// Required indicates whether deposits without the reference are returned.
+		Required bool `json:"required"`
+		// Reference is the exact reference to use, when it is fixed for the customer.
+		Reference string `json:"reference,omitempty"`
+		// Format describes the reference format, e.g. "1M-" followed by 8 digits.
+		Format string `json:"format,omitempty"`
+		// Field is the payment field the reference goes in, e.g. "remittance_information".
+		Field string `json:"field,omitempty"`
+		// MaxLength is the maximum length of the reference field on the rail.
+		MaxLength int `json:"max_length,omitempty"`
+	}
+
 	// BankInstruction represents bank account details for fiat deposits.
 	BankInstruction struct {
 		// BankName is the name of the bank that holds custody over the account.
@@ -88,8 +132,16 @@ type (
 		AccountIdentifier string `json:"account_identifier,omitempty"`
 		// BICCode is the SWIFT/BIC code.
 		BICCode string `json:"bic_code,omitempty"`
+		// IBAN is the International Bank Account Number, for SWIFT and SEPA deposits.
+		IBAN string `json:"iban,omitempty"`
 		// Address contains address details for the instruction.
 		Address *AddressDetails `json:"address,omitempty"`
+		// BankAddress is the postal address of the beneficiary bank, for international wires.
+		BankAddress *AddressDetails `json:"bank_address,omitempty"`
+		// CorrespondentBank is the intermediary bank for international wires (optional).
+		CorrespondentBank *CorrespondentBank `json:"correspondent_bank,omitempty"`
+		// ReferenceRequirement describes the payment reference the payer must include (optional).
+		ReferenceRequirement *ReferenceRequirement `json:"reference_requirement,omitempty"`
 		// TransactionFee is the fee for the transaction.
 		TransactionFee TransactionFee `json:"transaction_fee"`
 	}
@@ -146,3 +198,13 @@ func (s *serviceImpl) GetDepositInstruction(
 	}
 	return svc.GetJSONWithParams[InstructionResponse](ctx, s.BaseService, path, params)
 }
+
+// ListDepositInstructions retrieves deposit instructions for every provisioned asset and network.
+func (s *serviceImpl) ListDepositInstructions(ctx context.Context, id svc.CustomerID) ([]InstructionResponse, error) {
+	path := fmt.Sprintf("/v1/customers/%s/deposit_instructions/list", id)
+	result, err := svc.GetJSON[[]InstructionResponse](ctx, s.BaseService, path)
+	if err != nil {
+		return nil, err
+	}
+	return *result, nil
+}
@@ -0,0 +1,101 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: v0.9.2
+
+// Built By: go install
+
+package invoices
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// InvoiceStatusOPEN is a InvoiceStatus of type OPEN.
+	InvoiceStatusOPEN InvoiceStatus = "OPEN"
+	// InvoiceStatusPARTIALLYPAID is a InvoiceStatus of type PARTIALLY_PAID.
+	InvoiceStatusPARTIALLYPAID InvoiceStatus = "PARTIALLY_PAID"
+	// InvoiceStatusPAID is a InvoiceStatus of type PAID.
+	InvoiceStatusPAID InvoiceStatus = "PAID"
+	// InvoiceStatusEXPIRED is a InvoiceStatus of type EXPIRED.
+	InvoiceStatusEXPIRED InvoiceStatus = "EXPIRED"
+	// InvoiceStatusCANCELLED is a InvoiceStatus of type CANCELLED.
+	InvoiceStatusCANCELLED InvoiceStatus = "CANCELLED"
+)
+
+var ErrInvalidInvoiceStatus = fmt.Errorf("not a valid InvoiceStatus, try [%s]", strings.Join(_InvoiceStatusNames, ", "))
+
+var _InvoiceStatusNames = []string{
+	string(InvoiceStatusOPEN),
+	string(InvoiceStatusPARTIALLYPAID),
+	string(InvoiceStatusPAID),
+	string(InvoiceStatusEXPIRED),
+	string(InvoiceStatusCANCELLED),
+}
+
+// InvoiceStatusNames returns a list of possible string values of InvoiceStatus.
+func InvoiceStatusNames() []string {
+	tmp := make([]string, len(_InvoiceStatusNames))
+	copy(tmp, _InvoiceStatusNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x InvoiceStatus) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x InvoiceStatus) IsValid() bool {
+	_, err := ParseInvoiceStatus(string(x))
+	return err == nil
+}
+
+var _InvoiceStatusValue = map[string]InvoiceStatus{
+	"OPEN":           InvoiceStatusOPEN,
+	"open":           InvoiceStatusOPEN,
+	"PARTIALLY_PAID": InvoiceStatusPARTIALLYPAID,
+	"partially_paid": InvoiceStatusPARTIALLYPAID,
+	"PAID":           InvoiceStatusPAID,
+	"paid":           InvoiceStatusPAID,
+	"EXPIRED":        InvoiceStatusEXPIRED,
+	"expired":        InvoiceStatusEXPIRED,
+	"CANCELLED":      InvoiceStatusCANCELLED,
+	"cancelled":      InvoiceStatusCANCELLED,
+}
+
+// ParseInvoiceStatus attempts to convert a string to a InvoiceStatus.
+func ParseInvoiceStatus(name string) (InvoiceStatus, error) {
+	if x, ok := _InvoiceStatusValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _InvoiceStatusValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return InvoiceStatus(""), fmt.Errorf("%s is %w", name, ErrInvalidInvoiceStatus)
+}
+
+// MarshalText implements the text marshaller method.
+func (x InvoiceStatus) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *InvoiceStatus) UnmarshalText(text []byte) error {
+	tmp, err := ParseInvoiceStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *InvoiceStatus) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
@@ -0,0 +1,89 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package invoices
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"net/mail"
+	"time"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+)
+
+//go:generate go run ../../../cmd/tools/svcgen waiters waiters.yaml
+
+// ErrInvalidInvoice is returned when an invoice request fails validation.
+var ErrInvalidInvoice = errors.New("invalid invoice request")
+
+// Validate checks that the request has a positive amount, an asset and network,
+// a future RFC 3339 expiry and, if set, a valid payer email address.
+func (r *CreateInvoiceRequest) Validate() error {
+	if r == nil {
+		return fmt.Errorf("%w: request is nil", ErrInvalidInvoice)
+	}
+
+	amount, ok := new(big.Rat).SetString(r.Amount)
+	if !ok || amount.Sign() <= 0 {
+		return fmt.Errorf("%w: amount must be a positive decimal, got %q", ErrInvalidInvoice, r.Amount)
+	}
+	if r.Asset == "" || r.Network == "" {
+		return fmt.Errorf("%w: asset and network are required", ErrInvalidInvoice)
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, r.ExpiresAt)
+	if err != nil {
+		return fmt.Errorf("%w: expires_at must be RFC 3339, got %q", ErrInvalidInvoice, r.ExpiresAt)
+	}
+	if !expiresAt.After(time.Now()) {
+		return fmt.Errorf("%w: expires_at %s is in the past", ErrInvalidInvoice, r.ExpiresAt)
+	}
+
+	if r.PayerEmail != "" {
+		if _, err := mail.ParseAddress(r.PayerEmail); err != nil {
+			return fmt.Errorf("%w: payer_email %q: %w", ErrInvalidInvoice, r.PayerEmail, err)
+		}
+	}
+
+	return nil
+}
+
+// IsTerminal reports whether the invoice no longer accepts payment.
+func (s InvoiceStatus) IsTerminal() bool {
+	return s == InvoiceStatusPAID || s == InvoiceStatusEXPIRED || s == InvoiceStatusCANCELLED
+}
+
+// ExpiryTime parses ExpiresAt into a time.Time.
+func (r *InvoiceResponse) ExpiryTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.ExpiresAt)
+}
+
+// PaidTime parses PaidAt into a time.Time, returning the zero time if it is not set.
+func (r *InvoiceResponse) PaidTime() (time.Time, error) {
+	return svc.ParseOptionalTimestamp(&r.PaidAt)
+}
+
+// CreatedTime parses CreatedAt into a time.Time.
+func (r *InvoiceResponse) CreatedTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.CreatedAt)
+}
+
+// ModifiedTime parses ModifiedAt into a time.Time.
+func (r *InvoiceResponse) ModifiedTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.ModifiedAt)
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package invoices
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+)
+
+func TestCreateInvoiceRequest_Validate(t *testing.T) {
+	valid := func() *CreateInvoiceRequest {
+		return &CreateInvoiceRequest{
+			Amount:    "250.00",
+			Asset:     assets.AssetNameUSDC,
+			Network:   assets.NetworkNameETHEREUM,
+			ExpiresAt: time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
+		}
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(r *CreateInvoiceRequest)
+		wantErr bool
+	}{
+		{"valid", func(*CreateInvoiceRequest) {}, false},
+		{"with payer email", func(r *CreateInvoiceRequest) { r.PayerEmail = "ap@example.com" }, false},
+		{"invalid payer email", func(r *CreateInvoiceRequest) { r.PayerEmail = "ap" }, true},
+		{"zero amount", func(r *CreateInvoiceRequest) { r.Amount = "0" }, true},
+		{"negative amount", func(r *CreateInvoiceRequest) { r.Amount = "-5" }, true},
+		{"missing asset", func(r *CreateInvoiceRequest) { r.Asset = "" }, true},
+		{"missing expiry", func(r *CreateInvoiceRequest) { r.ExpiresAt = "" }, true},
+		{"past expiry", func(r *CreateInvoiceRequest) {
+			r.ExpiresAt = time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)
+		}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := valid()
+			tt.modify(req)
+			err := req.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidInvoice) {
+				t.Errorf("Validate() error = %v, want ErrInvalidInvoice", err)
+			}
+		})
+	}
+}
+
+func TestInvoiceStatus_IsTerminal(t *testing.T) {
+	for _, name := range InvoiceStatusNames() {
+		status := InvoiceStatus(name)
+		want := status != InvoiceStatusOPEN && status != InvoiceStatusPARTIALLYPAID
+		if got := status.IsTerminal(); got != want {
+			t.Errorf("%s.IsTerminal() = %v, want %v", status, got, want)
+		}
+	}
+}
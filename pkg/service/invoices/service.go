@@ -0,0 +1,224 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package invoices provides payment requests for customer accounts.
+//
+// This package implements the invoices service client for the 1Money platform,
+// enabling a customer to request a payment of a fixed amount. Each invoice gets dedicated
+// deposit instructions and a reference code, so incoming deposits are matched to it
+// automatically and its status moves to PAID or EXPIRED.
+//
+// # Basic Usage
+//
+//	import (
+//	    "context"
+//	    "time"
+//
+//	    onemoney "github.com/1Money-Co/1money-go-sdk/pkg/onemoney"
+//	    "github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+//	    "github.com/1Money-Co/1money-go-sdk/pkg/service/invoices"
+//	)
+//
+//	// Create client
+//	client, err := onemoney.NewClient(&onemoney.Config{
+//	    AccessKey: "your-access-key",
+//	    SecretKey: "your-secret-key",
+//	})
+//
+//	// Request 250 USDC on Ethereum, payable within a week
+//	invoice, err := client.Invoices.CreateInvoice(ctx, "customer-id", &invoices.CreateInvoiceRequest{
+//	    IdempotencyKey: "inv-1001",
+//	    Amount:         "250.00",
+//	    Asset:          assets.AssetNameUSDC,
+//	    Network:        assets.NetworkNameETHEREUM,
+//	    Memo:           "Invoice #1001",
+//	    ExpiresAt:      time.Now().Add(7 * 24 * time.Hour).UTC().Format(time.RFC3339),
+//	})
+//
+//	// Share invoice.DepositInstruction with the payer, then wait for payment
+//	invoice, err = invoices.WaitForPaid(ctx, client.Invoices, "customer-id", invoice.InvoiceID, nil)
+package invoices
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/instructions"
+)
+
+// Service defines the invoices service interface for managing payment requests.
+type Service interface {
+	// CreateInvoice creates a payment request with dedicated deposit instructions.
+	CreateInvoice(ctx context.Context, id svc.CustomerID, req *CreateInvoiceRequest) (*InvoiceResponse, error)
+	// GetInvoice retrieves an invoice, including the amount paid so far.
+	GetInvoice(ctx context.Context, id svc.CustomerID, invoiceID string) (*InvoiceResponse, error)
+	// ListInvoices retrieves invoices with optional filters.
+	ListInvoices(ctx context.Context, id svc.CustomerID, req *ListInvoicesRequest) (*ListInvoicesResponse, error)
+	// CancelInvoice cancels an open invoice. Deposits received afterwards are credited
+	// to the customer's balance without being matched to the invoice.
+	CancelInvoice(ctx context.Context, id svc.CustomerID, invoiceID string) (*InvoiceResponse, error)
+}
+
+// Invoice request and response types.
+type (
+	// CreateInvoiceRequest represents the request body for creating an invoice.
+	CreateInvoiceRequest struct {
+		// IdempotencyKey is a unique key to ensure idempotent creation.
+		// This is sent as a header, not in the body.
+		IdempotencyKey string `json:"-"`
+		// Amount is the amount requested.
+		Amount string `json:"amount"`
+		// Asset is the asset to be paid in.
+		Asset assets.AssetName `json:"asset"`
+		// Network is the network to be paid on.
+		Network assets.NetworkName `json:"network"`
+		// Memo is a description shown to the payer, e.g. an invoice number (optional).
+		Memo string `json:"memo,omitempty"`
+		// ExpiresAt is when the invoice stops accepting payment (RFC 3339 format).
+		ExpiresAt string `json:"expires_at"`
+		// PayerName is the name of the expected payer (optional).
+		PayerName string `json:"payer_name,omitempty"`
+		// PayerEmail is the email address of the expected payer (optional).
+		PayerEmail string `json:"payer_email,omitempty"`
+	}
+
+	// InvoiceResponse represents an invoice.
+	InvoiceResponse struct {
+		// InvoiceID is the unique identifier of the invoice.
+		InvoiceID string `json:"invoice_id"`
+		// IdempotencyKey is the idempotency key the invoice was created with.
+		IdempotencyKey string `json:"idempotency_key"`
+		// Status is the payment status of the invoice.
+		Status InvoiceStatus `json:"status"`
+		// Amount is the amount requested.
+		Amount string `json:"amount"`
+		// AmountPaid is the total of the deposits matched to the invoice.
+		AmountPaid string `json:"amount_paid"`
+		// Asset is the asset to be paid in.
+		Asset string `json:"asset"`
+		// Network is the network to be paid on.
+		Network string `json:"network"`
+		// Memo is the description shown to the payer.
+		Memo string `json:"memo,omitempty"`
+		// ReferenceCode is the payment reference the payer must include for fiat payments.
+		ReferenceCode string `json:"reference_code,omitempty"`
+		// DepositInstruction contains the dedicated deposit details for the invoice.
+		DepositInstruction *instructions.InstructionResponse `json:"deposit_instruction,omitempty"`
+		// TransactionIDs are the deposits matched to the invoice.
+		TransactionIDs []string `json:"transaction_ids,omitempty"`
+		// ExpiresAt is when the invoice stops accepting payment (ISO 8601 format).
+		ExpiresAt string `json:"expires_at"`
+		// PaidAt is when the invoice was paid in full (ISO 8601 format, optional).
+		PaidAt string `json:"paid_at,omitempty"`
+		// CreatedAt is the invoice creation timestamp (ISO 8601 format).
+		CreatedAt string `json:"created_at"`
+		// ModifiedAt is the invoice last modification timestamp (ISO 8601 format).
+		ModifiedAt string `json:"modified_at"`
+	}
+
+	// ListInvoicesRequest represents optional query parameters for listing invoices.
+	ListInvoicesRequest struct {
+		// Status filters by invoice status.
+		Status InvoiceStatus `json:"status,omitempty"`
+		// Asset filters by asset name.
+		Asset assets.AssetName `json:"asset,omitempty"`
+		// Page is the page number (starts from 1).
+		Page int `json:"page,omitempty"`
+		// Size is the number of items per page (1-100).
+		Size int `json:"size,omitempty"`
+	}
+
+	// ListInvoicesResponse represents a page of invoices.
+	ListInvoicesResponse struct {
+		// List contains the invoices.
+		List []InvoiceResponse `json:"list"`
+		// Total is the total number of matching invoices.
+		Total int `json:"total,omitempty"`
+	}
+)
+
+type serviceImpl struct {
+	*svc.BaseService
+}
+
+// NewService creates a new invoices service instance with the given base service.
+func NewService(base *svc.BaseService) Service {
+	return &serviceImpl{
+		BaseService: base,
+	}
+}
+
+// CreateInvoice creates a payment request.
+func (s *serviceImpl) CreateInvoice(
+	ctx context.Context,
+	id svc.CustomerID,
+	req *CreateInvoiceRequest,
+) (*InvoiceResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/v1/customers/%s/invoices", id)
+
+	headers := make(map[string]string)
+	if req.IdempotencyKey != "" {
+		headers["Idempotency-Key"] = req.IdempotencyKey
+	}
+
+	return svc.PostJSONWithHeaders[*CreateInvoiceRequest, InvoiceResponse](ctx, s.BaseService, path, req, headers)
+}
+
+// GetInvoice retrieves an invoice.
+func (s *serviceImpl) GetInvoice(ctx context.Context, id svc.CustomerID, invoiceID string) (*InvoiceResponse, error) {
+	path := fmt.Sprintf("/v1/customers/%s/invoices/%s", id, invoiceID)
+	return svc.GetJSON[InvoiceResponse](ctx, s.BaseService, path)
+}
+
+// ListInvoices retrieves invoices with optional filters.
+func (s *serviceImpl) ListInvoices(
+	ctx context.Context,
+	id svc.CustomerID,
+	req *ListInvoicesRequest,
+) (*ListInvoicesResponse, error) {
+	path := fmt.Sprintf("/v1/customers/%s/invoices/list", id)
+
+	params := make(map[string]string)
+	if req != nil {
+		if req.Status != "" {
+			params["status"] = string(req.Status)
+		}
+		if req.Asset != "" {
+			params["asset"] = string(req.Asset)
+		}
+		if req.Page > 0 {
+			params["page"] = strconv.Itoa(req.Page)
+		}
+		if req.Size > 0 {
+			params["size"] = strconv.Itoa(req.Size)
+		}
+	}
+
+	return svc.GetJSONWithParams[ListInvoicesResponse](ctx, s.BaseService, path, params)
+}
+
+// CancelInvoice cancels an open invoice.
+func (s *serviceImpl) CancelInvoice(ctx context.Context, id svc.CustomerID, invoiceID string) (*InvoiceResponse, error) {
+	path := fmt.Sprintf("/v1/customers/%s/invoices/%s/cancel", id, invoiceID)
+	return svc.PostJSON[any, InvoiceResponse](ctx, s.BaseService, path, nil)
+}
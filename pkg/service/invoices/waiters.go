@@ -0,0 +1,113 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by svcgen waiters from waiters.yaml. DO NOT EDIT.
+
+package invoices
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/1Money-Co/1money-go-sdk/internal/utils"
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+)
+
+// WaitOptions configures the polling behavior for wait functions.
+type WaitOptions struct {
+	// PollInterval is the interval between polling attempts. Default: 10s.
+	PollInterval time.Duration
+	// MaxWaitTime is the maximum duration to wait. Default: 1h.
+	MaxWaitTime time.Duration
+	// Logger is an optional zap logger for logging polling progress.
+	Logger *zap.Logger
+	// PrintProgress prints polling progress to stdout using standard log package.
+	// This is useful for examples and debugging when zap logger is not available.
+	PrintProgress bool
+}
+
+// DefaultWaitOptions returns the default wait options.
+func DefaultWaitOptions() WaitOptions {
+	return WaitOptions{
+		PollInterval: 10 * time.Second,
+		MaxWaitTime:  time.Hour,
+	}
+}
+
+// InvoiceCondition is a function that checks if an invoice meets a condition.
+type InvoiceCondition func(*InvoiceResponse) bool
+
+// WaitFor polls until the condition returns true.
+// Returns the invoice response when condition is met, or an error on timeout/failure.
+func WaitFor(
+	ctx context.Context,
+	service Service,
+	customerID svc.CustomerID,
+	invoiceID string,
+	condition InvoiceCondition,
+	opts *WaitOptions,
+) (*InvoiceResponse, error) {
+	defaults := DefaultWaitOptions()
+	if opts == nil {
+		opts = &defaults
+	}
+
+	utilOpts := &utils.WaitOptions{
+		PollInterval:  opts.PollInterval,
+		MaxWaitTime:   opts.MaxWaitTime,
+		Logger:        opts.Logger,
+		LogMessage:    "polling invoice status",
+		PrintProgress: opts.PrintProgress,
+	}
+
+	return utils.WaitFor(
+		ctx,
+		func(ctx context.Context) (*InvoiceResponse, error) {
+			return service.GetInvoice(ctx, customerID, invoiceID)
+		},
+		utils.Condition[InvoiceResponse](condition),
+		func(i *InvoiceResponse) string { return string(i.Status) },
+		"invoice",
+		invoiceID,
+		utilOpts,
+	)
+}
+
+// WaitForPaid polls until the invoice is paid in full.
+// Returns the invoice with an error if it EXPIRED or was CANCELLED first.
+func WaitForPaid(
+	ctx context.Context,
+	service Service,
+	customerID svc.CustomerID,
+	invoiceID string,
+	opts *WaitOptions,
+) (*InvoiceResponse, error) {
+	i, err := WaitFor(ctx, service, customerID, invoiceID, func(i *InvoiceResponse) bool {
+		return i.Status == InvoiceStatusPAID || i.Status == InvoiceStatusEXPIRED || i.Status == InvoiceStatusCANCELLED
+	}, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	switch i.Status {
+	case InvoiceStatusEXPIRED, InvoiceStatusCANCELLED:
+		return i, fmt.Errorf("invoice %s was not paid: %s", invoiceID, i.Status)
+	}
+	return i, nil
+}
@@ -0,0 +1,86 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: v0.9.2
+
+// Built By: go install
+
+package ledger
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// EntryDirectionDEBIT is a EntryDirection of type DEBIT.
+	EntryDirectionDEBIT EntryDirection = "DEBIT"
+	// EntryDirectionCREDIT is a EntryDirection of type CREDIT.
+	EntryDirectionCREDIT EntryDirection = "CREDIT"
+)
+
+var ErrInvalidEntryDirection = fmt.Errorf("not a valid EntryDirection, try [%s]", strings.Join(_EntryDirectionNames, ", "))
+
+var _EntryDirectionNames = []string{
+	string(EntryDirectionDEBIT),
+	string(EntryDirectionCREDIT),
+}
+
+// EntryDirectionNames returns a list of possible string values of EntryDirection.
+func EntryDirectionNames() []string {
+	tmp := make([]string, len(_EntryDirectionNames))
+	copy(tmp, _EntryDirectionNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x EntryDirection) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x EntryDirection) IsValid() bool {
+	_, err := ParseEntryDirection(string(x))
+	return err == nil
+}
+
+var _EntryDirectionValue = map[string]EntryDirection{
+	"DEBIT":  EntryDirectionDEBIT,
+	"debit":  EntryDirectionDEBIT,
+	"CREDIT": EntryDirectionCREDIT,
+	"credit": EntryDirectionCREDIT,
+}
+
+// ParseEntryDirection attempts to convert a string to a EntryDirection.
+func ParseEntryDirection(name string) (EntryDirection, error) {
+	if x, ok := _EntryDirectionValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _EntryDirectionValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return EntryDirection(""), fmt.Errorf("%s is %w", name, ErrInvalidEntryDirection)
+}
+
+// MarshalText implements the text marshaller method.
+func (x EntryDirection) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *EntryDirection) UnmarshalText(text []byte) error {
+	tmp, err := ParseEntryDirection(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *EntryDirection) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
@@ -0,0 +1,42 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ledger
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+)
+
+// SignedAmount returns the amount as a signed decimal: positive for credits, negative for debits.
+func (e *Entry) SignedAmount() (*big.Rat, error) {
+	amount, ok := new(big.Rat).SetString(e.Amount)
+	if !ok {
+		return nil, fmt.Errorf("entry %s: invalid amount %q", e.EntryID, e.Amount)
+	}
+	if e.Direction == EntryDirectionDEBIT {
+		amount.Neg(amount)
+	}
+	return amount, nil
+}
+
+// PostedTime parses PostedAt into a time.Time.
+func (e *Entry) PostedTime() (time.Time, error) {
+	return svc.ParseTimestamp(e.PostedAt)
+}
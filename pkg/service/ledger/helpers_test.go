@@ -0,0 +1,41 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ledger
+
+import "testing"
+
+func TestEntry_SignedAmount(t *testing.T) {
+	tests := []struct {
+		entry   Entry
+		want    string
+		wantErr bool
+	}{
+		{Entry{Direction: EntryDirectionCREDIT, Amount: "10.50"}, "21/2", false},
+		{Entry{Direction: EntryDirectionDEBIT, Amount: "10.50"}, "-21/2", false},
+		{Entry{Direction: EntryDirectionDEBIT, Amount: "abc"}, "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.entry.SignedAmount()
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("SignedAmount() error = %v, wantErr %v", err, tt.wantErr)
+		}
+		if err == nil && got.String() != tt.want {
+			t.Errorf("SignedAmount() = %s, want %s", got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ledger
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+)
+
+// listPageSize is the page size used when iterating over ledger entries.
+const listPageSize = 100
+
+// All iterates over every ledger entry matching the filter, oldest first.
+func (s *serviceImpl) All(ctx context.Context, id svc.CustomerID, filter *ListEntriesRequest) iter.Seq2[*Entry, error] {
+	return allEntries(ctx, s, id, filter)
+}
+
+func allEntries(
+	ctx context.Context,
+	service Service,
+	id svc.CustomerID,
+	filter *ListEntriesRequest,
+) iter.Seq2[*Entry, error] {
+	return func(yield func(*Entry, error) bool) {
+		req := ListEntriesRequest{}
+		if filter != nil {
+			req = *filter
+		}
+		req.SortOrder = assets.SortOrderASC
+		req.Size = listPageSize
+
+		for page := 1; ; page++ {
+			if err := ctx.Err(); err != nil {
+				yield(nil, err)
+				return
+			}
+
+			req.Page = page
+			resp, err := service.ListEntries(ctx, id, &req)
+			if err != nil {
+				yield(nil, fmt.Errorf("failed to list ledger entries (page %d): %w", page, err))
+				return
+			}
+
+			for i := range resp.List {
+				if !yield(&resp.List[i], nil) {
+					return
+				}
+			}
+
+			if len(resp.List) < listPageSize {
+				return
+			}
+		}
+	}
+}
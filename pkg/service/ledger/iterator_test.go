@@ -0,0 +1,73 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ledger
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+)
+
+// fakeListService serves a fixed set of ledger entries page by page.
+type fakeListService struct {
+	Service
+	entries  []Entry
+	requests []ListEntriesRequest
+}
+
+func (f *fakeListService) ListEntries(_ context.Context, _ svc.CustomerID, req *ListEntriesRequest) (*ListEntriesResponse, error) {
+	f.requests = append(f.requests, *req)
+	start := min((req.Page-1)*req.Size, len(f.entries))
+	end := min(start+req.Size, len(f.entries))
+	return &ListEntriesResponse{List: f.entries[start:end], Total: len(f.entries)}, nil
+}
+
+func TestAllEntries(t *testing.T) {
+	entries := make([]Entry, 200)
+	for i := range entries {
+		entries[i] = Entry{EntryID: fmt.Sprintf("le-%03d", i)}
+	}
+	service := &fakeListService{entries: entries}
+	filter := &ListEntriesRequest{Asset: assets.AssetNameUSD, SortOrder: assets.SortOrderDESC, Page: 2, Size: 5}
+
+	count := 0
+	for entry, err := range allEntries(context.Background(), service, "cid", filter) {
+		if err != nil {
+			t.Fatalf("allEntries() error = %v", err)
+		}
+		if want := fmt.Sprintf("le-%03d", count); entry.EntryID != want {
+			t.Fatalf("entry %d = %s, want %s", count, entry.EntryID, want)
+		}
+		count++
+	}
+
+	if count != len(entries) {
+		t.Fatalf("got %d entries, want %d", count, len(entries))
+	}
+	// A full last page needs one more, empty, page to detect the end.
+	if len(service.requests) != 3 {
+		t.Fatalf("got %d list calls, want 3", len(service.requests))
+	}
+	for i, req := range service.requests {
+		if req.Page != i+1 || req.Size != listPageSize || req.SortOrder != assets.SortOrderASC || req.Asset != assets.AssetNameUSD {
+			t.Errorf("request %d = %+v", i, req)
+		}
+	}
+}
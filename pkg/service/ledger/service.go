@@ -0,0 +1,180 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package ledger provides access to the double-entry ledger of customer accounts.
+//
+// This package implements the ledger service client for the 1Money platform,
+// exposing the individual debit and credit lines behind every balance change, so an
+// accounting system can mirror the ledger exactly instead of inferring it from transactions.
+//
+// # Basic Usage
+//
+//	import (
+//	    "context"
+//	    onemoney "github.com/1Money-Co/1money-go-sdk/pkg/onemoney"
+//	    "github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+//	    "github.com/1Money-Co/1money-go-sdk/pkg/service/ledger"
+//	)
+//
+//	// Create client
+//	client, err := onemoney.NewClient(&onemoney.Config{
+//	    AccessKey: "your-access-key",
+//	    SecretKey: "your-secret-key",
+//	})
+//
+//	// List USD ledger lines for a transaction
+//	entries, err := client.Ledger.ListEntries(ctx, "customer-id", &ledger.ListEntriesRequest{
+//	    Asset:         assets.AssetNameUSD,
+//	    TransactionID: "transaction-id",
+//	})
+//
+//	// Iterate over every ledger line, oldest first
+//	for entry, err := range client.Ledger.All(ctx, "customer-id", nil) {
+//	    ...
+//	}
+package ledger
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strconv"
+	"time"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+)
+
+// Service defines the ledger service interface for querying ledger entries.
+type Service interface {
+	// ListEntries retrieves ledger entries matching the filter.
+	ListEntries(ctx context.Context, id svc.CustomerID, filter *ListEntriesRequest) (*ListEntriesResponse, error)
+	// All iterates over every ledger entry matching the filter, oldest first, paginating
+	// automatically. Page, Size and SortOrder in the filter are ignored.
+	All(ctx context.Context, id svc.CustomerID, filter *ListEntriesRequest) iter.Seq2[*Entry, error]
+}
+
+// Ledger request and response types.
+type (
+	// ListEntriesRequest represents optional query parameters for listing ledger entries.
+	ListEntriesRequest struct {
+		// Asset filters by asset name.
+		Asset assets.AssetName `json:"asset,omitempty"`
+		// Account filters by ledger account (e.g., "available", "pending").
+		Account string `json:"account,omitempty"`
+		// TransactionID filters by the transaction that produced the entries.
+		TransactionID svc.TransactionID `json:"transaction_id,omitempty"`
+		// Direction filters by debit or credit.
+		Direction EntryDirection `json:"direction,omitempty"`
+		// StartTime filters entries posted at or after this time.
+		StartTime time.Time `json:"-"`
+		// EndTime filters entries posted before this time.
+		EndTime time.Time `json:"-"`
+		// SortOrder orders results by posting time (ASC or DESC). Defaults to newest first.
+		SortOrder assets.SortOrder `json:"sort_order,omitempty"`
+		// Page is the page number (starts from 1).
+		Page int `json:"page,omitempty"`
+		// Size is the number of items per page (1-100).
+		Size int `json:"size,omitempty"`
+	}
+
+	// Entry represents a single ledger line.
+	Entry struct {
+		// EntryID is the unique identifier of the entry.
+		EntryID string `json:"entry_id"`
+		// JournalID groups the lines of one balanced posting; its debits equal its credits.
+		JournalID string `json:"journal_id"`
+		// TransactionID is the transaction that produced the entry (optional for adjustments).
+		TransactionID svc.TransactionID `json:"transaction_id,omitempty"`
+		// Account is the ledger account the line is posted to.
+		Account string `json:"account"`
+		// Asset is the asset name.
+		Asset string `json:"asset"`
+		// Network is the network name (optional, empty for fiat).
+		Network string `json:"network,omitempty"`
+		// Direction is the side of the line.
+		Direction EntryDirection `json:"direction"`
+		// Amount is the unsigned amount of the line.
+		Amount string `json:"amount"`
+		// BalanceAfter is the account balance after the line was posted.
+		BalanceAfter string `json:"balance_after"`
+		// Description describes the posting (e.g., "Withdrawal fee").
+		Description string `json:"description,omitempty"`
+		// PostedAt is when the line was posted (ISO 8601 format).
+		PostedAt string `json:"posted_at"`
+	}
+
+	// ListEntriesResponse represents a page of ledger entries.
+	ListEntriesResponse struct {
+		// List contains the ledger entries.
+		List []Entry `json:"list"`
+		// Total is the total number of matching entries.
+		Total int `json:"total,omitempty"`
+	}
+)
+
+type serviceImpl struct {
+	*svc.BaseService
+}
+
+// NewService creates a new ledger service instance with the given base service.
+func NewService(base *svc.BaseService) Service {
+	return &serviceImpl{
+		BaseService: base,
+	}
+}
+
+// ListEntries retrieves ledger entries matching the filter.
+func (s *serviceImpl) ListEntries(
+	ctx context.Context,
+	id svc.CustomerID,
+	filter *ListEntriesRequest,
+) (*ListEntriesResponse, error) {
+	path := fmt.Sprintf("/v1/customers/%s/ledger/entries", id)
+
+	params := make(map[string]string)
+	if filter != nil {
+		if filter.Asset != "" {
+			params["asset"] = string(filter.Asset)
+		}
+		if filter.Account != "" {
+			params["account"] = filter.Account
+		}
+		if filter.TransactionID != "" {
+			params["transaction_id"] = string(filter.TransactionID)
+		}
+		if filter.Direction != "" {
+			params["direction"] = string(filter.Direction)
+		}
+		if !filter.StartTime.IsZero() {
+			params["start_time"] = filter.StartTime.UTC().Format(time.RFC3339)
+		}
+		if !filter.EndTime.IsZero() {
+			params["end_time"] = filter.EndTime.UTC().Format(time.RFC3339)
+		}
+		if filter.SortOrder != "" {
+			params["sort_order"] = string(filter.SortOrder)
+		}
+		if filter.Page > 0 {
+			params["page"] = strconv.Itoa(filter.Page)
+		}
+		if filter.Size > 0 {
+			params["size"] = strconv.Itoa(filter.Size)
+		}
+	}
+
+	return svc.GetJSONWithParams[ListEntriesResponse](ctx, s.BaseService, path, params)
+}
@@ -0,0 +1,27 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package limits
+
+//go:generate go tool go-enum -f=$GOFILE --marshal --names --nocase
+
+// LimitType represents the kind of activity a limit applies to.
+// ENUM(DEPOSIT, WITHDRAWAL, CONVERSION)
+type LimitType string
+
+// LimitPeriod represents the window a limit is measured over.
+// ENUM(TRANSACTION, DAILY, WEEKLY, MONTHLY)
+type LimitPeriod string
@@ -0,0 +1,176 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: v0.9.2
+
+// Built By: go install
+
+package limits
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// LimitPeriodTRANSACTION is a LimitPeriod of type TRANSACTION.
+	LimitPeriodTRANSACTION LimitPeriod = "TRANSACTION"
+	// LimitPeriodDAILY is a LimitPeriod of type DAILY.
+	LimitPeriodDAILY LimitPeriod = "DAILY"
+	// LimitPeriodWEEKLY is a LimitPeriod of type WEEKLY.
+	LimitPeriodWEEKLY LimitPeriod = "WEEKLY"
+	// LimitPeriodMONTHLY is a LimitPeriod of type MONTHLY.
+	LimitPeriodMONTHLY LimitPeriod = "MONTHLY"
+)
+
+var ErrInvalidLimitPeriod = fmt.Errorf("not a valid LimitPeriod, try [%s]", strings.Join(_LimitPeriodNames, ", "))
+
+var _LimitPeriodNames = []string{
+	string(LimitPeriodTRANSACTION),
+	string(LimitPeriodDAILY),
+	string(LimitPeriodWEEKLY),
+	string(LimitPeriodMONTHLY),
+}
+
+// LimitPeriodNames returns a list of possible string values of LimitPeriod.
+func LimitPeriodNames() []string {
+	tmp := make([]string, len(_LimitPeriodNames))
+	copy(tmp, _LimitPeriodNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x LimitPeriod) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x LimitPeriod) IsValid() bool {
+	_, err := ParseLimitPeriod(string(x))
+	return err == nil
+}
+
+var _LimitPeriodValue = map[string]LimitPeriod{
+	"TRANSACTION": LimitPeriodTRANSACTION,
+	"transaction": LimitPeriodTRANSACTION,
+	"DAILY":       LimitPeriodDAILY,
+	"daily":       LimitPeriodDAILY,
+	"WEEKLY":      LimitPeriodWEEKLY,
+	"weekly":      LimitPeriodWEEKLY,
+	"MONTHLY":     LimitPeriodMONTHLY,
+	"monthly":     LimitPeriodMONTHLY,
+}
+
+// ParseLimitPeriod attempts to convert a string to a LimitPeriod.
+func ParseLimitPeriod(name string) (LimitPeriod, error) {
+	if x, ok := _LimitPeriodValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _LimitPeriodValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return LimitPeriod(""), fmt.Errorf("%s is %w", name, ErrInvalidLimitPeriod)
+}
+
+// MarshalText implements the text marshaller method.
+func (x LimitPeriod) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *LimitPeriod) UnmarshalText(text []byte) error {
+	tmp, err := ParseLimitPeriod(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *LimitPeriod) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+const (
+	// LimitTypeDEPOSIT is a LimitType of type DEPOSIT.
+	LimitTypeDEPOSIT LimitType = "DEPOSIT"
+	// LimitTypeWITHDRAWAL is a LimitType of type WITHDRAWAL.
+	LimitTypeWITHDRAWAL LimitType = "WITHDRAWAL"
+	// LimitTypeCONVERSION is a LimitType of type CONVERSION.
+	LimitTypeCONVERSION LimitType = "CONVERSION"
+)
+
+var ErrInvalidLimitType = fmt.Errorf("not a valid LimitType, try [%s]", strings.Join(_LimitTypeNames, ", "))
+
+var _LimitTypeNames = []string{
+	string(LimitTypeDEPOSIT),
+	string(LimitTypeWITHDRAWAL),
+	string(LimitTypeCONVERSION),
+}
+
+// LimitTypeNames returns a list of possible string values of LimitType.
+func LimitTypeNames() []string {
+	tmp := make([]string, len(_LimitTypeNames))
+	copy(tmp, _LimitTypeNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x LimitType) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x LimitType) IsValid() bool {
+	_, err := ParseLimitType(string(x))
+	return err == nil
+}
+
+var _LimitTypeValue = map[string]LimitType{
+	"DEPOSIT":    LimitTypeDEPOSIT,
+	"deposit":    LimitTypeDEPOSIT,
+	"WITHDRAWAL": LimitTypeWITHDRAWAL,
+	"withdrawal": LimitTypeWITHDRAWAL,
+	"CONVERSION": LimitTypeCONVERSION,
+	"conversion": LimitTypeCONVERSION,
+}
+
+// ParseLimitType attempts to convert a string to a LimitType.
+func ParseLimitType(name string) (LimitType, error) {
+	if x, ok := _LimitTypeValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _LimitTypeValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return LimitType(""), fmt.Errorf("%s is %w", name, ErrInvalidLimitType)
+}
+
+// MarshalText implements the text marshaller method.
+func (x LimitType) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *LimitType) UnmarshalText(text []byte) error {
+	tmp, err := ParseLimitType(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *LimitType) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package limits
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+)
+
+// ErrLimitExceeded is returned by CheckAmount when an amount does not fit in a limit.
+var ErrLimitExceeded = errors.New("limit exceeded")
+
+// CheckAmount checks that amount fits in the remaining amount of every utilization entry
+// that applies to the activity, asset and network. Entries without an asset or network
+// apply to all of them. Returns ErrLimitExceeded naming the first limit that would be hit.
+func CheckAmount(
+	utilization []Utilization,
+	typ LimitType,
+	asset assets.AssetName,
+	network assets.NetworkName,
+	amount string,
+) error {
+	value, ok := new(big.Rat).SetString(amount)
+	if !ok {
+		return fmt.Errorf("invalid amount %q", amount)
+	}
+
+	for i := range utilization {
+		u := &utilization[i]
+		if !u.appliesTo(typ, asset, network) {
+			continue
+		}
+		remaining, ok := new(big.Rat).SetString(u.RemainingAmount)
+		if !ok {
+			return fmt.Errorf("invalid remaining amount %q for %s %s limit", u.RemainingAmount, u.Period, u.Type)
+		}
+		if value.Cmp(remaining) > 0 {
+			return fmt.Errorf("%w: %s %s limit has %s remaining, need %s",
+				ErrLimitExceeded, u.Period, u.Type, u.RemainingAmount, amount)
+		}
+		if u.MaxCount > 0 && u.UsedCount >= u.MaxCount {
+			return fmt.Errorf("%w: %s %s limit of %d transactions reached",
+				ErrLimitExceeded, u.Period, u.Type, u.MaxCount)
+		}
+	}
+	return nil
+}
+
+// UsedFraction returns the used share of the limit's maximum amount, from 0 to 1.
+// Returns 0 if the amounts cannot be parsed or the maximum is zero.
+func (u *Utilization) UsedFraction() float64 {
+	used, ok1 := new(big.Rat).SetString(u.UsedAmount)
+	maxAmount, ok2 := new(big.Rat).SetString(u.MaxAmount)
+	if !ok1 || !ok2 || maxAmount.Sign() == 0 {
+		return 0
+	}
+	f, _ := new(big.Rat).Quo(used, maxAmount).Float64()
+	return min(f, 1)
+}
+
+// appliesTo reports whether the limit covers the activity, asset and network.
+func (l *Limit) appliesTo(typ LimitType, asset assets.AssetName, network assets.NetworkName) bool {
+	return l.Type == typ &&
+		(l.Asset == "" || strings.EqualFold(l.Asset, string(asset))) &&
+		(l.Network == "" || strings.EqualFold(l.Network, string(network)))
+}
+
+// ResetTime parses ResetsAt into a time.Time, returning the zero time if it is not set.
+func (u *Utilization) ResetTime() (time.Time, error) {
+	return svc.ParseOptionalTimestamp(&u.ResetsAt)
+}
@@ -0,0 +1,81 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package limits
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+)
+
+func TestCheckAmount(t *testing.T) {
+	usage := []Utilization{
+		{
+			Limit:           Limit{Type: LimitTypeWITHDRAWAL, Period: LimitPeriodDAILY, MaxAmount: "50000"},
+			UsedAmount:      "30000",
+			RemainingAmount: "20000",
+		},
+		{
+			Limit: Limit{
+				Type: LimitTypeWITHDRAWAL, Period: LimitPeriodTRANSACTION,
+				Asset: "USD", Network: "US_ACH", MaxAmount: "10000",
+			},
+			RemainingAmount: "10000",
+		},
+		{
+			Limit:           Limit{Type: LimitTypeDEPOSIT, Period: LimitPeriodDAILY, MaxAmount: "100", MaxCount: 3},
+			UsedCount:       3,
+			RemainingAmount: "100",
+		},
+	}
+
+	tests := []struct {
+		name    string
+		typ     LimitType
+		asset   assets.AssetName
+		network assets.NetworkName
+		amount  string
+		wantErr error
+	}{
+		{"within limits", LimitTypeWITHDRAWAL, assets.AssetNameUSD, assets.NetworkNameUSACH, "5000", nil},
+		{"per-transaction limit", LimitTypeWITHDRAWAL, assets.AssetNameUSD, assets.NetworkNameUSACH, "15000", ErrLimitExceeded},
+		{"per-transaction limit for other network", LimitTypeWITHDRAWAL, assets.AssetNameUSD, assets.NetworkNameUSFEDWIRE, "15000", nil},
+		{"daily limit", LimitTypeWITHDRAWAL, assets.AssetNameUSDC, assets.NetworkNameSOLANA, "20000.01", ErrLimitExceeded},
+		{"count limit", LimitTypeDEPOSIT, assets.AssetNameUSD, assets.NetworkNameUSACH, "1", ErrLimitExceeded},
+		{"no applicable limit", LimitTypeCONVERSION, assets.AssetNameUSD, "", "1000000", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckAmount(usage, tt.typ, tt.asset, tt.network, tt.amount)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("CheckAmount() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUtilization_UsedFraction(t *testing.T) {
+	u := &Utilization{Limit: Limit{MaxAmount: "200"}, UsedAmount: "50"}
+	if got := u.UsedFraction(); got != 0.25 {
+		t.Errorf("UsedFraction() = %v, want 0.25", got)
+	}
+	if got := (&Utilization{Limit: Limit{MaxAmount: "0"}, UsedAmount: "5"}).UsedFraction(); got != 0 {
+		t.Errorf("UsedFraction() with zero maximum = %v, want 0", got)
+	}
+}
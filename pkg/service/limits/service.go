@@ -0,0 +1,130 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package limits provides transaction limits and their utilization for customer accounts.
+//
+// This package implements the limits service client for the 1Money platform,
+// returning the configured limits per activity, asset and period together with how much
+// of each has been used, so applications can warn users before they hit a cap.
+//
+// # Basic Usage
+//
+//	import (
+//	    "context"
+//	    onemoney "github.com/1Money-Co/1money-go-sdk/pkg/onemoney"
+//	    "github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+//	    "github.com/1Money-Co/1money-go-sdk/pkg/service/limits"
+//	)
+//
+//	// Create client
+//	client, err := onemoney.NewClient(&onemoney.Config{
+//	    AccessKey: "your-access-key",
+//	    SecretKey: "your-secret-key",
+//	})
+//
+//	// Get today's utilization and check a batch total fits
+//	usage, err := client.Limits.ListUtilization(ctx, "customer-id", limits.LimitPeriodDAILY)
+//	err = limits.CheckAmount(usage, limits.LimitTypeWITHDRAWAL, assets.AssetNameUSD, assets.NetworkNameUSACH, "25000.00")
+package limits
+
+import (
+	"context"
+	"fmt"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+)
+
+// Service defines the limits service interface for retrieving limits and utilization.
+type Service interface {
+	// Get retrieves the limits configured for a customer.
+	Get(ctx context.Context, id svc.CustomerID) (*LimitsResponse, error)
+	// ListUtilization retrieves the current usage of each limit measured over the period.
+	// Pass an empty period for every period.
+	ListUtilization(ctx context.Context, id svc.CustomerID, period LimitPeriod) ([]Utilization, error)
+}
+
+// Limit response types.
+type (
+	// Limit represents a configured limit.
+	Limit struct {
+		// Type is the activity the limit applies to.
+		Type LimitType `json:"type"`
+		// Period is the window the limit is measured over.
+		Period LimitPeriod `json:"period"`
+		// Asset is the asset name (empty when the limit applies to every asset, in USD).
+		Asset string `json:"asset,omitempty"`
+		// Network is the network name (empty when the limit applies to every network).
+		Network string `json:"network,omitempty"`
+		// MaxAmount is the maximum total amount in the period.
+		MaxAmount string `json:"max_amount"`
+		// MaxCount is the maximum number of transactions in the period (0 when unlimited).
+		MaxCount int `json:"max_count,omitempty"`
+	}
+
+	// LimitsResponse represents the limits configured for a customer.
+	LimitsResponse struct {
+		// CustomerID is the ID of the customer.
+		CustomerID svc.CustomerID `json:"customer_id"`
+		// Limits are the configured limits.
+		Limits []Limit `json:"limits"`
+	}
+
+	// Utilization represents the current usage of a limit.
+	Utilization struct {
+		Limit
+		// UsedAmount is the total amount used in the current period.
+		UsedAmount string `json:"used_amount"`
+		// RemainingAmount is the amount left in the current period.
+		RemainingAmount string `json:"remaining_amount"`
+		// UsedCount is the number of transactions in the current period.
+		UsedCount int `json:"used_count"`
+		// ResetsAt is when the current period ends (ISO 8601 format, empty for TRANSACTION limits).
+		ResetsAt string `json:"resets_at,omitempty"`
+	}
+)
+
+type serviceImpl struct {
+	*svc.BaseService
+}
+
+// NewService creates a new limits service instance with the given base service.
+func NewService(base *svc.BaseService) Service {
+	return &serviceImpl{
+		BaseService: base,
+	}
+}
+
+// Get retrieves the limits configured for a customer.
+func (s *serviceImpl) Get(ctx context.Context, id svc.CustomerID) (*LimitsResponse, error) {
+	path := fmt.Sprintf("/v1/customers/%s/limits", id)
+	return svc.GetJSON[LimitsResponse](ctx, s.BaseService, path)
+}
+
+// ListUtilization retrieves the current usage of each limit measured over the period.
+func (s *serviceImpl) ListUtilization(ctx context.Context, id svc.CustomerID, period LimitPeriod) ([]Utilization, error) {
+	path := fmt.Sprintf("/v1/customers/%s/limits/utilization", id)
+
+	params := make(map[string]string)
+	if period != "" {
+		params["period"] = string(period)
+	}
+
+	result, err := svc.GetJSONWithParams[[]Utilization](ctx, s.BaseService, path, params)
+	if err != nil {
+		return nil, err
+	}
+	return *result, nil
+}
@@ -0,0 +1,96 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: v0.9.2
+
+// Built By: go install
+
+package notifications
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// EventCategoryDEPOSITS is a EventCategory of type DEPOSITS.
+	EventCategoryDEPOSITS EventCategory = "DEPOSITS"
+	// EventCategoryWITHDRAWALS is a EventCategory of type WITHDRAWALS.
+	EventCategoryWITHDRAWALS EventCategory = "WITHDRAWALS"
+	// EventCategoryKYB is a EventCategory of type KYB.
+	EventCategoryKYB EventCategory = "KYB"
+	// EventCategoryCOMPLIANCEHOLDS is a EventCategory of type COMPLIANCE_HOLDS.
+	EventCategoryCOMPLIANCEHOLDS EventCategory = "COMPLIANCE_HOLDS"
+)
+
+var ErrInvalidEventCategory = fmt.Errorf("not a valid EventCategory, try [%s]", strings.Join(_EventCategoryNames, ", "))
+
+var _EventCategoryNames = []string{
+	string(EventCategoryDEPOSITS),
+	string(EventCategoryWITHDRAWALS),
+	string(EventCategoryKYB),
+	string(EventCategoryCOMPLIANCEHOLDS),
+}
+
+// EventCategoryNames returns a list of possible string values of EventCategory.
+func EventCategoryNames() []string {
+	tmp := make([]string, len(_EventCategoryNames))
+	copy(tmp, _EventCategoryNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x EventCategory) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x EventCategory) IsValid() bool {
+	_, err := ParseEventCategory(string(x))
+	return err == nil
+}
+
+var _EventCategoryValue = map[string]EventCategory{
+	"DEPOSITS":         EventCategoryDEPOSITS,
+	"deposits":         EventCategoryDEPOSITS,
+	"WITHDRAWALS":      EventCategoryWITHDRAWALS,
+	"withdrawals":      EventCategoryWITHDRAWALS,
+	"KYB":              EventCategoryKYB,
+	"kyb":              EventCategoryKYB,
+	"COMPLIANCE_HOLDS": EventCategoryCOMPLIANCEHOLDS,
+	"compliance_holds": EventCategoryCOMPLIANCEHOLDS,
+}
+
+// ParseEventCategory attempts to convert a string to a EventCategory.
+func ParseEventCategory(name string) (EventCategory, error) {
+	if x, ok := _EventCategoryValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _EventCategoryValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return EventCategory(""), fmt.Errorf("%s is %w", name, ErrInvalidEventCategory)
+}
+
+// MarshalText implements the text marshaller method.
+func (x EventCategory) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *EventCategory) UnmarshalText(text []byte) error {
+	tmp, err := ParseEventCategory(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *EventCategory) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
@@ -0,0 +1,79 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package notifications
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"time"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+)
+
+// ErrInvalidRequest is returned when an update request fails validation.
+var ErrInvalidRequest = errors.New("invalid notification preferences request")
+
+// Validate checks that categories are known and listed once, email recipients are valid
+// addresses, and the webhook URL, if set, is an absolute HTTPS URL.
+func (r *UpdatePreferencesRequest) Validate() error {
+	if r == nil {
+		return fmt.Errorf("%w: request is nil", ErrInvalidRequest)
+	}
+
+	if r.WebhookURL != nil && *r.WebhookURL != "" {
+		u, err := url.Parse(*r.WebhookURL)
+		if err != nil || u.Scheme != "https" || u.Host == "" {
+			return fmt.Errorf("%w: webhook_url must be an absolute https URL, got %q", ErrInvalidRequest, *r.WebhookURL)
+		}
+	}
+
+	seen := make(map[EventCategory]bool, len(r.Preferences))
+	for _, pref := range r.Preferences {
+		if !pref.Category.IsValid() {
+			return fmt.Errorf("%w: unknown category %q", ErrInvalidRequest, pref.Category)
+		}
+		if seen[pref.Category] {
+			return fmt.Errorf("%w: category %s listed more than once", ErrInvalidRequest, pref.Category)
+		}
+		seen[pref.Category] = true
+
+		for _, addr := range pref.EmailRecipients {
+			if _, err := mail.ParseAddress(addr); err != nil {
+				return fmt.Errorf("%w: %s email recipient %q: %w", ErrInvalidRequest, pref.Category, addr, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Preference returns the preference for an event category, or nil if none is configured.
+func (r *PreferencesResponse) Preference(category EventCategory) *CategoryPreference {
+	for i := range r.Preferences {
+		if r.Preferences[i].Category == category {
+			return &r.Preferences[i]
+		}
+	}
+	return nil
+}
+
+// ModifiedTime parses ModifiedAt into a time.Time.
+func (r *PreferencesResponse) ModifiedTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.ModifiedAt)
+}
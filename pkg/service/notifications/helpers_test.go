@@ -0,0 +1,77 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package notifications
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestUpdatePreferencesRequest_Validate(t *testing.T) {
+	httpsURL := "https://hooks.example.com/1money"
+	httpURL := "http://hooks.example.com/1money"
+
+	tests := []struct {
+		name    string
+		req     *UpdatePreferencesRequest
+		wantErr bool
+	}{
+		{"nil request", nil, true},
+		{"empty request", &UpdatePreferencesRequest{}, false},
+		{"https webhook", &UpdatePreferencesRequest{WebhookURL: &httpsURL}, false},
+		{"http webhook", &UpdatePreferencesRequest{WebhookURL: &httpURL}, true},
+		{
+			name: "valid preferences",
+			req: &UpdatePreferencesRequest{Preferences: []CategoryPreference{
+				{Category: EventCategoryDEPOSITS, Email: true, EmailRecipients: []string{"finance@example.com"}},
+				{Category: EventCategoryCOMPLIANCEHOLDS, Webhook: true},
+			}},
+		},
+		{
+			name:    "unknown category",
+			req:     &UpdatePreferencesRequest{Preferences: []CategoryPreference{{Category: "REFUNDS"}}},
+			wantErr: true,
+		},
+		{
+			name: "duplicate category",
+			req: &UpdatePreferencesRequest{Preferences: []CategoryPreference{
+				{Category: EventCategoryKYB, Email: true},
+				{Category: EventCategoryKYB, Webhook: true},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "invalid email",
+			req: &UpdatePreferencesRequest{Preferences: []CategoryPreference{
+				{Category: EventCategoryWITHDRAWALS, Email: true, EmailRecipients: []string{"finance"}},
+			}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidRequest) {
+				t.Errorf("Validate() error = %v, want ErrInvalidRequest", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package notifications provides notification preference management for customer accounts.
+//
+// This package implements the notifications service client for the 1Money platform,
+// enabling configuration of email and webhook notifications per event category
+// (deposits, withdrawals, KYB and compliance holds).
+//
+// # Basic Usage
+//
+//	import (
+//	    "context"
+//	    onemoney "github.com/1Money-Co/1money-go-sdk/pkg/onemoney"
+//	    "github.com/1Money-Co/1money-go-sdk/pkg/service/notifications"
+//	)
+//
+//	// Create client
+//	client, err := onemoney.NewClient(&onemoney.Config{
+//	    AccessKey: "your-access-key",
+//	    SecretKey: "your-secret-key",
+//	})
+//
+//	// Get notification preferences
+//	prefs, err := client.Notifications.GetPreferences(ctx, "customer-id")
+//
+//	// Email finance about deposits, leaving other categories unchanged
+//	prefs, err = client.Notifications.UpdatePreferences(ctx, "customer-id", &notifications.UpdatePreferencesRequest{
+//	    Preferences: []notifications.CategoryPreference{{
+//	        Category:        notifications.EventCategoryDEPOSITS,
+//	        Email:           true,
+//	        EmailRecipients: []string{"finance@example.com"},
+//	    }},
+//	})
+package notifications
+
+import (
+	"context"
+	"fmt"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+)
+
+// Service defines the notifications service interface for managing notification preferences.
+type Service interface {
+	// GetPreferences retrieves the notification preferences of a customer.
+	GetPreferences(ctx context.Context, id svc.CustomerID) (*PreferencesResponse, error)
+	// UpdatePreferences updates the notification preferences of a customer.
+	// Only the categories included in the request are changed.
+	UpdatePreferences(ctx context.Context, id svc.CustomerID, req *UpdatePreferencesRequest) (*PreferencesResponse, error)
+}
+
+// Notification preference types.
+type (
+	// CategoryPreference represents the notification channels enabled for an event category.
+	CategoryPreference struct {
+		// Category is the event category.
+		Category EventCategory `json:"category"`
+		// Email enables email notifications for the category.
+		Email bool `json:"email"`
+		// Webhook enables webhook notifications for the category.
+		Webhook bool `json:"webhook"`
+		// EmailRecipients are the addresses notified by email.
+		// When empty, the customer's primary email is used.
+		EmailRecipients []string `json:"email_recipients,omitempty"`
+	}
+
+	// UpdatePreferencesRequest represents the request body for updating notification preferences.
+	UpdatePreferencesRequest struct {
+		// WebhookURL is the HTTPS endpoint webhook notifications are sent to (nil leaves it unchanged).
+		WebhookURL *string `json:"webhook_url,omitempty"`
+		// Preferences are the category preferences to replace.
+		Preferences []CategoryPreference `json:"preferences,omitempty"`
+	}
+
+	// PreferencesResponse represents the notification preferences of a customer.
+	PreferencesResponse struct {
+		// CustomerID is the ID of the customer.
+		CustomerID svc.CustomerID `json:"customer_id"`
+		// WebhookURL is the endpoint webhook notifications are sent to (optional).
+		WebhookURL string `json:"webhook_url,omitempty"`
+		// Preferences are the preferences for each event category.
+		Preferences []CategoryPreference `json:"preferences"`
+		// ModifiedAt is the timestamp of the last change (ISO 8601 format).
+		ModifiedAt string `json:"modified_at"`
+	}
+)
+
+type serviceImpl struct {
+	*svc.BaseService
+}
+
+// NewService creates a new notifications service instance with the given base service.
+func NewService(base *svc.BaseService) Service {
+	return &serviceImpl{
+		BaseService: base,
+	}
+}
+
+// GetPreferences retrieves the notification preferences of a customer.
+func (s *serviceImpl) GetPreferences(ctx context.Context, id svc.CustomerID) (*PreferencesResponse, error) {
+	path := fmt.Sprintf("/v1/customers/%s/notification-preferences", id)
+	return svc.GetJSON[PreferencesResponse](ctx, s.BaseService, path)
+}
+
+// UpdatePreferences updates the notification preferences of a customer.
+func (s *serviceImpl) UpdatePreferences(
+	ctx context.Context,
+	id svc.CustomerID,
+	req *UpdatePreferencesRequest,
+) (*PreferencesResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("/v1/customers/%s/notification-preferences", id)
+	return svc.PatchJSON[*UpdatePreferencesRequest, PreferencesResponse](ctx, s.BaseService, path, req)
+}
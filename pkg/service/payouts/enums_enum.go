@@ -0,0 +1,196 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: v0.9.2
+
+// Built By: go install
+
+package payouts
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// BatchStatusPENDING is a BatchStatus of type PENDING.
+	BatchStatusPENDING BatchStatus = "PENDING"
+	// BatchStatusPROCESSING is a BatchStatus of type PROCESSING.
+	BatchStatusPROCESSING BatchStatus = "PROCESSING"
+	// BatchStatusCOMPLETED is a BatchStatus of type COMPLETED.
+	BatchStatusCOMPLETED BatchStatus = "COMPLETED"
+	// BatchStatusPARTIALLYCOMPLETED is a BatchStatus of type PARTIALLY_COMPLETED.
+	BatchStatusPARTIALLYCOMPLETED BatchStatus = "PARTIALLY_COMPLETED"
+	// BatchStatusFAILED is a BatchStatus of type FAILED.
+	BatchStatusFAILED BatchStatus = "FAILED"
+	// BatchStatusCANCELLED is a BatchStatus of type CANCELLED.
+	BatchStatusCANCELLED BatchStatus = "CANCELLED"
+)
+
+var ErrInvalidBatchStatus = fmt.Errorf("not a valid BatchStatus, try [%s]", strings.Join(_BatchStatusNames, ", "))
+
+var _BatchStatusNames = []string{
+	string(BatchStatusPENDING),
+	string(BatchStatusPROCESSING),
+	string(BatchStatusCOMPLETED),
+	string(BatchStatusPARTIALLYCOMPLETED),
+	string(BatchStatusFAILED),
+	string(BatchStatusCANCELLED),
+}
+
+// BatchStatusNames returns a list of possible string values of BatchStatus.
+func BatchStatusNames() []string {
+	tmp := make([]string, len(_BatchStatusNames))
+	copy(tmp, _BatchStatusNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x BatchStatus) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x BatchStatus) IsValid() bool {
+	_, err := ParseBatchStatus(string(x))
+	return err == nil
+}
+
+var _BatchStatusValue = map[string]BatchStatus{
+	"PENDING":             BatchStatusPENDING,
+	"pending":             BatchStatusPENDING,
+	"PROCESSING":          BatchStatusPROCESSING,
+	"processing":          BatchStatusPROCESSING,
+	"COMPLETED":           BatchStatusCOMPLETED,
+	"completed":           BatchStatusCOMPLETED,
+	"PARTIALLY_COMPLETED": BatchStatusPARTIALLYCOMPLETED,
+	"partially_completed": BatchStatusPARTIALLYCOMPLETED,
+	"FAILED":              BatchStatusFAILED,
+	"failed":              BatchStatusFAILED,
+	"CANCELLED":           BatchStatusCANCELLED,
+	"cancelled":           BatchStatusCANCELLED,
+}
+
+// ParseBatchStatus attempts to convert a string to a BatchStatus.
+func ParseBatchStatus(name string) (BatchStatus, error) {
+	if x, ok := _BatchStatusValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _BatchStatusValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return BatchStatus(""), fmt.Errorf("%s is %w", name, ErrInvalidBatchStatus)
+}
+
+// MarshalText implements the text marshaller method.
+func (x BatchStatus) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *BatchStatus) UnmarshalText(text []byte) error {
+	tmp, err := ParseBatchStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *BatchStatus) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+const (
+	// ItemStatusPENDING is a ItemStatus of type PENDING.
+	ItemStatusPENDING ItemStatus = "PENDING"
+	// ItemStatusPROCESSING is a ItemStatus of type PROCESSING.
+	ItemStatusPROCESSING ItemStatus = "PROCESSING"
+	// ItemStatusCOMPLETED is a ItemStatus of type COMPLETED.
+	ItemStatusCOMPLETED ItemStatus = "COMPLETED"
+	// ItemStatusFAILED is a ItemStatus of type FAILED.
+	ItemStatusFAILED ItemStatus = "FAILED"
+	// ItemStatusRETURNED is a ItemStatus of type RETURNED.
+	ItemStatusRETURNED ItemStatus = "RETURNED"
+)
+
+var ErrInvalidItemStatus = fmt.Errorf("not a valid ItemStatus, try [%s]", strings.Join(_ItemStatusNames, ", "))
+
+var _ItemStatusNames = []string{
+	string(ItemStatusPENDING),
+	string(ItemStatusPROCESSING),
+	string(ItemStatusCOMPLETED),
+	string(ItemStatusFAILED),
+	string(ItemStatusRETURNED),
+}
+
+// ItemStatusNames returns a list of possible string values of ItemStatus.
+func ItemStatusNames() []string {
+	tmp := make([]string, len(_ItemStatusNames))
+	copy(tmp, _ItemStatusNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x ItemStatus) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x ItemStatus) IsValid() bool {
+	_, err := ParseItemStatus(string(x))
+	return err == nil
+}
+
+var _ItemStatusValue = map[string]ItemStatus{
+	"PENDING":    ItemStatusPENDING,
+	"pending":    ItemStatusPENDING,
+	"PROCESSING": ItemStatusPROCESSING,
+	"processing": ItemStatusPROCESSING,
+	"COMPLETED":  ItemStatusCOMPLETED,
+	"completed":  ItemStatusCOMPLETED,
+	"FAILED":     ItemStatusFAILED,
+	"failed":     ItemStatusFAILED,
+	"RETURNED":   ItemStatusRETURNED,
+	"returned":   ItemStatusRETURNED,
+}
+
+// ParseItemStatus attempts to convert a string to a ItemStatus.
+func ParseItemStatus(name string) (ItemStatus, error) {
+	if x, ok := _ItemStatusValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _ItemStatusValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return ItemStatus(""), fmt.Errorf("%s is %w", name, ErrInvalidItemStatus)
+}
+
+// MarshalText implements the text marshaller method.
+func (x ItemStatus) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *ItemStatus) UnmarshalText(text []byte) error {
+	tmp, err := ParseItemStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *ItemStatus) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package payouts
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/withdraws"
+)
+
+//go:generate go run ../../../cmd/tools/svcgen waiters waiters.yaml
+
+// MaxBatchItems is the maximum number of items in a payout batch.
+const MaxBatchItems = 1000
+
+// ErrInvalidBatch is returned when a payout batch fails validation.
+var ErrInvalidBatch = errors.New("invalid payout batch")
+
+// Validate checks that the batch has between 1 and MaxBatchItems items, that item references
+// are unique, and that each item has a positive amount and a valid withdrawal destination.
+func (r *CreateBatchRequest) Validate() error {
+	if r == nil {
+		return fmt.Errorf("%w: request is nil", ErrInvalidBatch)
+	}
+	if len(r.Items) == 0 {
+		return fmt.Errorf("%w: at least one item is required", ErrInvalidBatch)
+	}
+	if len(r.Items) > MaxBatchItems {
+		return fmt.Errorf("%w: %d items exceeds the maximum of %d", ErrInvalidBatch, len(r.Items), MaxBatchItems)
+	}
+
+	seen := make(map[string]int, len(r.Items))
+	for i := range r.Items {
+		item := &r.Items[i]
+		if item.Reference == "" {
+			return fmt.Errorf("%w: item %d: reference is required", ErrInvalidBatch, i)
+		}
+		if prev, ok := seen[item.Reference]; ok {
+			return fmt.Errorf("%w: item %d: reference %q duplicates item %d", ErrInvalidBatch, i, item.Reference, prev)
+		}
+		seen[item.Reference] = i
+
+		if err := item.Validate(); err != nil {
+			return fmt.Errorf("item %d (%s): %w", i, item.Reference, err)
+		}
+	}
+
+	return nil
+}
+
+// Validate checks that the item has a positive amount, an asset and network, and exactly
+// one destination, using the same rules as withdraws.CreateWithdrawalRequest.
+func (i *Item) Validate() error {
+	amount, ok := new(big.Rat).SetString(i.Amount)
+	if !ok || amount.Sign() <= 0 {
+		return fmt.Errorf("%w: amount must be a positive decimal, got %q", ErrInvalidBatch, i.Amount)
+	}
+	if i.Asset == "" || i.Network == "" {
+		return fmt.Errorf("%w: asset and network are required", ErrInvalidBatch)
+	}
+	return i.withdrawal().Validate()
+}
+
+// withdrawal returns the withdrawal request the item is executed as.
+func (i *Item) withdrawal() *withdraws.CreateWithdrawalRequest {
+	return &withdraws.CreateWithdrawalRequest{
+		Amount:                   i.Amount,
+		Asset:                    i.Asset,
+		Network:                  i.Network,
+		WalletAddress:            i.WalletAddress,
+		ExternalAccountID:        i.ExternalAccountID,
+		RecipientID:              i.RecipientID,
+		RecipientBankAccountID:   i.RecipientBankAccountID,
+		RecipientWalletAddressID: i.RecipientWalletAddressID,
+	}
+}
+
+// IsTerminal reports whether the batch has finished processing.
+func (s BatchStatus) IsTerminal() bool {
+	switch s {
+	case BatchStatusCOMPLETED, BatchStatusPARTIALLYCOMPLETED, BatchStatusFAILED, BatchStatusCANCELLED:
+		return true
+	default:
+		return false
+	}
+}
+
+// CreatedTime parses CreatedAt into a time.Time.
+func (r *BatchResponse) CreatedTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.CreatedAt)
+}
+
+// ModifiedTime parses ModifiedAt into a time.Time.
+func (r *BatchResponse) ModifiedTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.ModifiedAt)
+}
+
+// ModifiedTime parses ModifiedAt into a time.Time.
+func (r *ItemResponse) ModifiedTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.ModifiedAt)
+}
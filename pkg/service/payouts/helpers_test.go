@@ -0,0 +1,99 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package payouts
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/withdraws"
+)
+
+func TestCreateBatchRequest_Validate(t *testing.T) {
+	fiat := Item{
+		Reference: "emp-1", Amount: "1500.00", Asset: assets.AssetNameUSD,
+		Network: assets.NetworkNameUSACH, ExternalAccountID: "ea-1",
+	}
+	crypto := Item{
+		Reference: "emp-2", Amount: "900", Asset: assets.AssetNameUSDC,
+		Network: assets.NetworkNameSOLANA, WalletAddress: "wallet",
+	}
+
+	tooMany := make([]Item, MaxBatchItems+1)
+	for i := range tooMany {
+		tooMany[i] = fiat
+		tooMany[i].Reference = fmt.Sprintf("ref-%d", i)
+	}
+
+	with := func(item Item, modify func(*Item)) Item {
+		modify(&item)
+		return item
+	}
+
+	tests := []struct {
+		name    string
+		req     *CreateBatchRequest
+		wantErr error
+	}{
+		{"mixed rails", &CreateBatchRequest{Items: []Item{fiat, crypto}}, nil},
+		{"nil request", nil, ErrInvalidBatch},
+		{"empty batch", &CreateBatchRequest{}, ErrInvalidBatch},
+		{"too many items", &CreateBatchRequest{Items: tooMany}, ErrInvalidBatch},
+		{"duplicate reference", &CreateBatchRequest{Items: []Item{fiat, fiat}}, ErrInvalidBatch},
+		{"missing reference", &CreateBatchRequest{Items: []Item{with(fiat, func(i *Item) { i.Reference = "" })}}, ErrInvalidBatch},
+		{"zero amount", &CreateBatchRequest{Items: []Item{with(fiat, func(i *Item) { i.Amount = "0" })}}, ErrInvalidBatch},
+		{"invalid amount", &CreateBatchRequest{Items: []Item{with(fiat, func(i *Item) { i.Amount = "ten" })}}, ErrInvalidBatch},
+		{"missing network", &CreateBatchRequest{Items: []Item{with(fiat, func(i *Item) { i.Network = "" })}}, ErrInvalidBatch},
+		{
+			name:    "two destinations",
+			req:     &CreateBatchRequest{Items: []Item{with(fiat, func(i *Item) { i.WalletAddress = "wallet" })}},
+			wantErr: withdraws.ErrInvalidDestination,
+		},
+		{
+			name:    "no destination",
+			req:     &CreateBatchRequest{Items: []Item{with(crypto, func(i *Item) { i.WalletAddress = "" })}},
+			wantErr: withdraws.ErrInvalidDestination,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBatchStatus_IsTerminal(t *testing.T) {
+	for _, name := range BatchStatusNames() {
+		status := BatchStatus(name)
+		want := status != BatchStatusPENDING && status != BatchStatusPROCESSING
+		if got := status.IsTerminal(); got != want {
+			t.Errorf("%s.IsTerminal() = %v, want %v", status, got, want)
+		}
+	}
+}
@@ -0,0 +1,322 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package payouts provides mass payouts for customer accounts.
+//
+// This package implements the payouts service client for the 1Money platform,
+// enabling a customer to pay many recipients in one batch across fiat and crypto rails.
+// Each item in a batch is executed as a withdrawal; the batch tracks their overall status.
+//
+// # Basic Usage
+//
+//	import (
+//	    "context"
+//	    onemoney "github.com/1Money-Co/1money-go-sdk/pkg/onemoney"
+//	    "github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+//	    "github.com/1Money-Co/1money-go-sdk/pkg/service/payouts"
+//	)
+//
+//	// Create client
+//	client, err := onemoney.NewClient(&onemoney.Config{
+//	    AccessKey: "your-access-key",
+//	    SecretKey: "your-secret-key",
+//	})
+//
+//	req := &payouts.CreateBatchRequest{
+//	    IdempotencyKey: "payroll-2025-01",
+//	    Items: []payouts.Item{
+//	        {Reference: "emp-1", Amount: "1500.00", Asset: assets.AssetNameUSD,
+//	            Network: assets.NetworkNameUSACH, ExternalAccountID: "external-account-id"},
+//	        {Reference: "emp-2", Amount: "900.00", Asset: assets.AssetNameUSDC,
+//	            Network: assets.NetworkNameSOLANA, WalletAddress: "wallet-address"},
+//	    },
+//	}
+//
+//	// Check the balance covers the batch, including fees
+//	funding, err := client.Payouts.CheckFunding(ctx, "customer-id", req)
+//
+//	// Submit the batch and wait for every item to finish
+//	batch, err := client.Payouts.CreateBatch(ctx, "customer-id", req)
+//	batch, err = payouts.WaitForCompleted(ctx, client.Payouts, "customer-id", batch.BatchID, nil)
+package payouts
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+)
+
+// Service defines the payouts service interface for managing payout batches.
+type Service interface {
+	// CreateBatch submits a payout batch. Items are validated locally before submission.
+	CreateBatch(ctx context.Context, id svc.CustomerID, req *CreateBatchRequest) (*BatchResponse, error)
+	// GetBatch retrieves a payout batch and its progress counters.
+	GetBatch(ctx context.Context, id svc.CustomerID, batchID string) (*BatchResponse, error)
+	// ListBatches retrieves payout batches with optional filters.
+	ListBatches(ctx context.Context, id svc.CustomerID, req *ListBatchesRequest) (*ListBatchesResponse, error)
+	// ListItems retrieves the per-item results of a payout batch.
+	ListItems(ctx context.Context, id svc.CustomerID, batchID string, req *ListItemsRequest) (*ListItemsResponse, error)
+	// CheckFunding reports whether the available balance covers a batch, including fees,
+	// without submitting it.
+	CheckFunding(ctx context.Context, id svc.CustomerID, req *CreateBatchRequest) (*FundingCheckResponse, error)
+}
+
+// Item represents a single payout in a batch.
+// Destinations follow the same rules as withdrawals: exactly one of WalletAddress,
+// ExternalAccountID, or RecipientID with a recipient bank account or wallet address.
+type Item struct {
+	// Reference is a caller-assigned identifier, unique within the batch.
+	Reference string `json:"reference"`
+	// Amount is the amount to pay.
+	Amount string `json:"amount"`
+	// Asset is the asset to pay in.
+	Asset assets.AssetName `json:"asset"`
+	// Network is the network to pay on.
+	Network assets.NetworkName `json:"network"`
+	// WalletAddress is the wallet address for crypto payouts.
+	WalletAddress string `json:"wallet_address,omitempty"`
+	// ExternalAccountID is the external account ID for fiat payouts.
+	ExternalAccountID string `json:"external_account_id,omitempty"`
+	// RecipientID is the ID of a saved recipient to pay out to.
+	RecipientID svc.RecipientID `json:"recipient_id,omitempty"`
+	// RecipientBankAccountID is the recipient's bank account ID for fiat payouts.
+	RecipientBankAccountID string `json:"recipient_bank_account_id,omitempty"`
+	// RecipientWalletAddressID is the recipient's wallet address ID for crypto payouts.
+	RecipientWalletAddressID string `json:"recipient_wallet_address_id,omitempty"`
+	// Memo is a note shown to the recipient where the rail supports it (optional).
+	Memo string `json:"memo,omitempty"`
+}
+
+// Payout request and response types.
+type (
+	// CreateBatchRequest represents the request body for creating a payout batch.
+	CreateBatchRequest struct {
+		// IdempotencyKey is a unique key to ensure idempotent creation.
+		// This is sent as a header, not in the body.
+		IdempotencyKey string `json:"-"`
+		// Description is a label for the batch, e.g. "January payroll" (optional).
+		Description string `json:"description,omitempty"`
+		// Items are the payouts in the batch.
+		Items []Item `json:"items"`
+	}
+
+	// AssetTotal represents the total amount of a batch for one asset and network.
+	AssetTotal struct {
+		// Asset is the asset name.
+		Asset string `json:"asset"`
+		// Network is the network name.
+		Network string `json:"network"`
+		// Amount is the total amount.
+		Amount string `json:"amount"`
+		// Fee is the total estimated fee.
+		Fee string `json:"fee"`
+	}
+
+	// BatchResponse represents a payout batch.
+	BatchResponse struct {
+		// BatchID is the unique identifier of the batch.
+		BatchID string `json:"batch_id"`
+		// IdempotencyKey is the idempotency key the batch was created with.
+		IdempotencyKey string `json:"idempotency_key"`
+		// Description is the label of the batch.
+		Description string `json:"description,omitempty"`
+		// Status is the overall status of the batch.
+		Status BatchStatus `json:"status"`
+		// TotalItems is the number of items in the batch.
+		TotalItems int `json:"total_items"`
+		// CompletedItems is the number of items that completed.
+		CompletedItems int `json:"completed_items"`
+		// FailedItems is the number of items that failed or were returned.
+		FailedItems int `json:"failed_items"`
+		// Totals are the batch totals per asset and network.
+		Totals []AssetTotal `json:"totals"`
+		// CreatedAt is the batch creation timestamp (ISO 8601 format).
+		CreatedAt string `json:"created_at"`
+		// ModifiedAt is the batch last modification timestamp (ISO 8601 format).
+		ModifiedAt string `json:"modified_at"`
+	}
+
+	// ListBatchesRequest represents optional query parameters for listing payout batches.
+	ListBatchesRequest struct {
+		// Status filters by batch status.
+		Status BatchStatus `json:"status,omitempty"`
+		// Page is the page number (starts from 1).
+		Page int `json:"page,omitempty"`
+		// Size is the number of items per page (1-100).
+		Size int `json:"size,omitempty"`
+	}
+
+	// ListBatchesResponse represents a page of payout batches.
+	ListBatchesResponse struct {
+		// List contains the payout batches.
+		List []BatchResponse `json:"list"`
+		// Total is the total number of matching batches.
+		Total int `json:"total,omitempty"`
+	}
+
+	// ItemResponse represents the result of a single payout in a batch.
+	ItemResponse struct {
+		Item
+		// ItemID is the unique identifier of the item.
+		ItemID string `json:"item_id"`
+		// Status is the status of the item.
+		Status ItemStatus `json:"status"`
+		// TransactionID is the withdrawal transaction created for the item (empty until submitted).
+		TransactionID svc.TransactionID `json:"transaction_id,omitempty"`
+		// FailureReason explains why the item failed or was returned (optional).
+		FailureReason string `json:"failure_reason,omitempty"`
+		// ModifiedAt is the item last modification timestamp (ISO 8601 format).
+		ModifiedAt string `json:"modified_at"`
+	}
+
+	// ListItemsRequest represents optional query parameters for listing payout items.
+	ListItemsRequest struct {
+		// Status filters by item status.
+		Status ItemStatus `json:"status,omitempty"`
+		// Page is the page number (starts from 1).
+		Page int `json:"page,omitempty"`
+		// Size is the number of items per page (1-100).
+		Size int `json:"size,omitempty"`
+	}
+
+	// ListItemsResponse represents a page of payout items.
+	ListItemsResponse struct {
+		// List contains the payout items.
+		List []ItemResponse `json:"list"`
+		// Total is the total number of matching items.
+		Total int `json:"total,omitempty"`
+	}
+
+	// FundingRequirement represents the funds a batch needs for one asset and network.
+	FundingRequirement struct {
+		// Asset is the asset name.
+		Asset string `json:"asset"`
+		// Network is the network name.
+		Network string `json:"network"`
+		// Required is the total payout amount plus fees.
+		Required string `json:"required"`
+		// Available is the available balance.
+		Available string `json:"available"`
+		// Shortfall is the missing amount, "0" when the balance is sufficient.
+		Shortfall string `json:"shortfall"`
+	}
+
+	// FundingCheckResponse represents the result of a funding check.
+	FundingCheckResponse struct {
+		// Sufficient is true when every requirement is covered by the available balance.
+		Sufficient bool `json:"sufficient"`
+		// Requirements are the funding requirements per asset and network.
+		Requirements []FundingRequirement `json:"requirements"`
+	}
+)
+
+type serviceImpl struct {
+	*svc.BaseService
+}
+
+// NewService creates a new payouts service instance with the given base service.
+func NewService(base *svc.BaseService) Service {
+	return &serviceImpl{
+		BaseService: base,
+	}
+}
+
+// CreateBatch submits a payout batch.
+func (s *serviceImpl) CreateBatch(ctx context.Context, id svc.CustomerID, req *CreateBatchRequest) (*BatchResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/v1/customers/%s/payouts", id)
+
+	headers := make(map[string]string)
+	if req.IdempotencyKey != "" {
+		headers["Idempotency-Key"] = req.IdempotencyKey
+	}
+
+	return svc.PostJSONWithHeaders[*CreateBatchRequest, BatchResponse](ctx, s.BaseService, path, req, headers)
+}
+
+// GetBatch retrieves a payout batch.
+func (s *serviceImpl) GetBatch(ctx context.Context, id svc.CustomerID, batchID string) (*BatchResponse, error) {
+	path := fmt.Sprintf("/v1/customers/%s/payouts/%s", id, batchID)
+	return svc.GetJSON[BatchResponse](ctx, s.BaseService, path)
+}
+
+// ListBatches retrieves payout batches with optional filters.
+func (s *serviceImpl) ListBatches(
+	ctx context.Context,
+	id svc.CustomerID,
+	req *ListBatchesRequest,
+) (*ListBatchesResponse, error) {
+	path := fmt.Sprintf("/v1/customers/%s/payouts/list", id)
+
+	params := make(map[string]string)
+	if req != nil {
+		if req.Status != "" {
+			params["status"] = string(req.Status)
+		}
+		if req.Page > 0 {
+			params["page"] = strconv.Itoa(req.Page)
+		}
+		if req.Size > 0 {
+			params["size"] = strconv.Itoa(req.Size)
+		}
+	}
+
+	return svc.GetJSONWithParams[ListBatchesResponse](ctx, s.BaseService, path, params)
+}
+
+// ListItems retrieves the per-item results of a payout batch.
+func (s *serviceImpl) ListItems(
+	ctx context.Context,
+	id svc.CustomerID,
+	batchID string,
+	req *ListItemsRequest,
+) (*ListItemsResponse, error) {
+	path := fmt.Sprintf("/v1/customers/%s/payouts/%s/items", id, batchID)
+
+	params := make(map[string]string)
+	if req != nil {
+		if req.Status != "" {
+			params["status"] = string(req.Status)
+		}
+		if req.Page > 0 {
+			params["page"] = strconv.Itoa(req.Page)
+		}
+		if req.Size > 0 {
+			params["size"] = strconv.Itoa(req.Size)
+		}
+	}
+
+	return svc.GetJSONWithParams[ListItemsResponse](ctx, s.BaseService, path, params)
+}
+
+// CheckFunding reports whether the available balance covers a batch.
+func (s *serviceImpl) CheckFunding(
+	ctx context.Context,
+	id svc.CustomerID,
+	req *CreateBatchRequest,
+) (*FundingCheckResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("/v1/customers/%s/payouts/funding-check", id)
+	return svc.PostJSON[*CreateBatchRequest, FundingCheckResponse](ctx, s.BaseService, path, req)
+}
@@ -0,0 +1,74 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platform
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/transactions"
+)
+
+// listPageSize is the page size used when iterating over search results.
+const listPageSize = 100
+
+// AllTransactions iterates over every transaction matching the search.
+func (s *serviceImpl) AllTransactions(
+	ctx context.Context,
+	req *SearchTransactionsRequest,
+) iter.Seq2[*transactions.TransactionResponse, error] {
+	return allTransactions(ctx, s, req)
+}
+
+func allTransactions(
+	ctx context.Context,
+	service Service,
+	filter *SearchTransactionsRequest,
+) iter.Seq2[*transactions.TransactionResponse, error] {
+	return func(yield func(*transactions.TransactionResponse, error) bool) {
+		req := SearchTransactionsRequest{}
+		if filter != nil {
+			req = *filter
+		}
+		req.Size = listPageSize
+
+		for page := 1; ; page++ {
+			if err := ctx.Err(); err != nil {
+				yield(nil, err)
+				return
+			}
+
+			req.Page = page
+			resp, err := service.SearchTransactions(ctx, &req)
+			if err != nil {
+				yield(nil, fmt.Errorf("failed to search transactions (page %d): %w", page, err))
+				return
+			}
+
+			for i := range resp.List {
+				if !yield(&resp.List[i], nil) {
+					return
+				}
+			}
+
+			if len(resp.List) < listPageSize {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,78 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platform
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/transactions"
+)
+
+// fakeSearchService serves a fixed set of transactions page by page.
+type fakeSearchService struct {
+	Service
+	transactions []transactions.TransactionResponse
+	requests     []SearchTransactionsRequest
+}
+
+func (f *fakeSearchService) SearchTransactions(
+	_ context.Context, req *SearchTransactionsRequest,
+) (*transactions.ListTransactionsResponse, error) {
+	f.requests = append(f.requests, *req)
+	start := min((req.Page-1)*req.Size, len(f.transactions))
+	end := min(start+req.Size, len(f.transactions))
+	return &transactions.ListTransactionsResponse{List: f.transactions[start:end]}, nil
+}
+
+func TestAllTransactions(t *testing.T) {
+	txs := make([]transactions.TransactionResponse, 200)
+	for i := range txs {
+		txs[i] = transactions.TransactionResponse{
+			CustomerID:    svc.CustomerID(fmt.Sprintf("cust-%d", i%7)),
+			TransactionID: svc.TransactionID(fmt.Sprintf("tx-%03d", i)),
+		}
+	}
+	service := &fakeSearchService{transactions: txs}
+	filter := &SearchTransactionsRequest{Status: transactions.TransactionStatusFAILED, Page: 5, Size: 20}
+
+	var ids []string
+	for tx, err := range allTransactions(context.Background(), service, filter) {
+		if err != nil {
+			t.Fatalf("allTransactions() error = %v", err)
+		}
+		ids = append(ids, tx.TransactionID)
+	}
+
+	if len(ids) != len(txs) || ids[0] != "tx-000" || ids[len(ids)-1] != "tx-199" {
+		t.Fatalf("got %d transactions (first %v), want %d", len(ids), ids[:1], len(txs))
+	}
+	// 200 items fill two full pages, so a third, empty page confirms the end.
+	if len(service.requests) != 3 {
+		t.Fatalf("got %d search calls, want 3", len(service.requests))
+	}
+	for i, req := range service.requests {
+		if req.Page != i+1 || req.Size != listPageSize || req.Status != transactions.TransactionStatusFAILED {
+			t.Errorf("request %d = %+v", i, req)
+		}
+	}
+	if filter.Page != 5 || filter.Size != 20 {
+		t.Errorf("filter was modified: %+v", filter)
+	}
+}
@@ -0,0 +1,269 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package platform provides partner-level views across all customers of a platform.
+//
+// This package implements the platform service client for the 1Money platform,
+// enabling platform operators to read aggregate balances, search transactions across
+// customers, and summarize KYB statuses in a single call instead of looping over
+// every customer.
+//
+// # Basic Usage
+//
+//	import (
+//	    "context"
+//	    onemoney "github.com/1Money-Co/1money-go-sdk/pkg/onemoney"
+//	    "github.com/1Money-Co/1money-go-sdk/pkg/service/customer"
+//	    "github.com/1Money-Co/1money-go-sdk/pkg/service/platform"
+//	    "github.com/1Money-Co/1money-go-sdk/pkg/service/transactions"
+//	)
+//
+//	// Create client
+//	client, err := onemoney.NewClient(&onemoney.Config{
+//	    AccessKey: "your-access-key",
+//	    SecretKey: "your-secret-key",
+//	})
+//
+//	// Total balances held across all customers
+//	balances, err := client.Platform.GetAggregateBalances(ctx, nil)
+//
+//	// Every failed withdrawal across all customers
+//	for tx, err := range client.Platform.AllTransactions(ctx, &platform.SearchTransactionsRequest{
+//	    TransactionAction: transactions.TransactionActionWITHDRAWAL,
+//	    Status:            transactions.TransactionStatusFAILED,
+//	}) {
+//	    // ...
+//	}
+//
+//	// Customers waiting on KYB review
+//	pending, err := client.Platform.ListKYBStatuses(ctx, &platform.ListKYBStatusesRequest{
+//	    Status: customer.KybStatusPendingReview,
+//	})
+package platform
+
+import (
+	"context"
+	"iter"
+	"strconv"
+	"time"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/customer"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/transactions"
+)
+
+// Service defines the platform service interface for cross-customer operations.
+type Service interface {
+	// GetAggregateBalances retrieves balances summed across all customers, per asset and network.
+	GetAggregateBalances(ctx context.Context, req *AggregateBalancesRequest) (*AggregateBalancesResponse, error)
+	// SearchTransactions searches transactions across all customers, or the listed ones.
+	SearchTransactions(
+		ctx context.Context, req *SearchTransactionsRequest,
+	) (*transactions.ListTransactionsResponse, error)
+	// AllTransactions iterates over every transaction matching the search, paginating automatically.
+	// Page and Size in the request are ignored.
+	AllTransactions(
+		ctx context.Context, req *SearchTransactionsRequest,
+	) iter.Seq2[*transactions.TransactionResponse, error]
+	// GetKYBSummary retrieves the number of customers in each KYB status.
+	GetKYBSummary(ctx context.Context) (*KYBSummaryResponse, error)
+	// ListKYBStatuses retrieves the KYB status of customers, optionally filtered by status.
+	ListKYBStatuses(ctx context.Context, req *ListKYBStatusesRequest) (*ListKYBStatusesResponse, error)
+}
+
+// Aggregate balance request and response types.
+type (
+	// AggregateBalancesRequest represents optional query parameters for aggregate balances.
+	AggregateBalancesRequest struct {
+		// Asset filters by asset name.
+		Asset assets.AssetName `json:"asset,omitempty"`
+		// Network filters by network name.
+		Network assets.NetworkName `json:"network,omitempty"`
+	}
+
+	// AggregateBalance represents the balance of an asset summed across customers.
+	AggregateBalance struct {
+		// Asset is the asset name.
+		Asset string `json:"asset"`
+		// Network is the network name (nil for fiat).
+		Network *string `json:"network,omitempty"`
+		// AvailableAmount is the total available balance.
+		AvailableAmount string `json:"available_amount"`
+		// UnavailableAmount is the total unavailable/locked balance.
+		UnavailableAmount string `json:"unavailable_amount"`
+		// CustomerCount is the number of customers holding a non-zero balance.
+		CustomerCount int `json:"customer_count"`
+	}
+
+	// AggregateBalancesResponse represents balances summed across all customers.
+	AggregateBalancesResponse struct {
+		// Balances are the aggregate balances per asset and network.
+		Balances []AggregateBalance `json:"balances"`
+		// AsOf is when the balances were computed (ISO 8601 format).
+		AsOf string `json:"as_of"`
+	}
+)
+
+// SearchTransactionsRequest represents the request body for a cross-customer transaction search.
+// All filters are optional.
+type SearchTransactionsRequest struct {
+	// CustomerIDs restricts the search to these customers. Empty searches all customers.
+	CustomerIDs []svc.CustomerID `json:"customer_ids,omitempty"`
+	// TransactionID filters by specific transaction ID.
+	TransactionID svc.TransactionID `json:"transaction_id,omitempty"`
+	// IdempotencyKey filters by the idempotency key the transaction was created with.
+	IdempotencyKey string `json:"idempotency_key,omitempty"`
+	// Asset filters by asset name.
+	Asset assets.AssetName `json:"asset,omitempty"`
+	// Network filters by transaction network.
+	Network assets.NetworkName `json:"network,omitempty"`
+	// TransactionAction filters by transaction type (DEPOSIT, WITHDRAWAL, CONVERSION).
+	TransactionAction transactions.TransactionAction `json:"transaction_action,omitempty"`
+	// Status filters by transaction status.
+	Status transactions.TransactionStatus `json:"status,omitempty"`
+	// Direction filters by fund flow direction (INBOUND or OUTBOUND).
+	Direction transactions.TransactionDirection `json:"direction,omitempty"`
+	// Tag filters by a tag attached with UpdateMetadata.
+	Tag string `json:"tag,omitempty"`
+	// StartTime filters transactions created at or after this time.
+	StartTime time.Time `json:"created_after,omitzero"`
+	// EndTime filters transactions created before this time.
+	EndTime time.Time `json:"created_before,omitzero"`
+	// SortOrder orders results by creation time (ASC or DESC). Defaults to newest first.
+	SortOrder assets.SortOrder `json:"sort_order,omitempty"`
+	// Page is the page number (starts from 1).
+	Page int `json:"page,omitempty"`
+	// Size is the number of items per page (1-100).
+	Size int `json:"size,omitempty"`
+}
+
+// KYB status types.
+type (
+	// KYBStatusCount represents the number of customers in one KYB status.
+	KYBStatusCount struct {
+		// Status is the KYB status.
+		Status customer.KybStatus `json:"status"`
+		// Count is the number of customers in this status.
+		Count int `json:"count"`
+	}
+
+	// KYBSummaryResponse represents the number of customers in each KYB status.
+	KYBSummaryResponse struct {
+		// Total is the total number of customers.
+		Total int `json:"total"`
+		// ByStatus breaks down the customers by KYB status.
+		ByStatus []KYBStatusCount `json:"by_status"`
+	}
+
+	// ListKYBStatusesRequest represents optional query parameters for listing customer KYB statuses.
+	ListKYBStatusesRequest struct {
+		// Status filters by KYB status.
+		Status customer.KybStatus `json:"status,omitempty"`
+		// Page is the page number (starts from 1).
+		Page int `json:"page,omitempty"`
+		// Size is the number of items per page (1-100).
+		Size int `json:"size,omitempty"`
+	}
+
+	// CustomerKYBStatus represents the KYB status of one customer.
+	CustomerKYBStatus struct {
+		// CustomerID is the ID of the customer.
+		CustomerID svc.CustomerID `json:"customer_id"`
+		// BusinessLegalName is the legal name of the customer's business.
+		BusinessLegalName string `json:"business_legal_name"`
+		// Status is the KYB status.
+		Status customer.KybStatus `json:"status"`
+		// ModifiedAt is when the status last changed (ISO 8601 format).
+		ModifiedAt string `json:"modified_at"`
+	}
+
+	// ListKYBStatusesResponse represents the response for listing customer KYB statuses.
+	ListKYBStatusesResponse struct {
+		// List is the list of customer KYB statuses.
+		List []CustomerKYBStatus `json:"list"`
+		// Total is the total number of customers matching the filters.
+		Total int `json:"total,omitempty"`
+	}
+)
+
+type serviceImpl struct {
+	*svc.BaseService
+}
+
+// NewService creates a new platform service instance with the given base service.
+func NewService(base *svc.BaseService) Service {
+	return &serviceImpl{
+		BaseService: base,
+	}
+}
+
+// GetAggregateBalances retrieves balances summed across all customers.
+func (s *serviceImpl) GetAggregateBalances(
+	ctx context.Context,
+	req *AggregateBalancesRequest,
+) (*AggregateBalancesResponse, error) {
+	params := make(map[string]string)
+	if req != nil {
+		if req.Asset != "" {
+			params["asset"] = string(req.Asset)
+		}
+		if req.Network != "" {
+			params["network"] = string(req.Network)
+		}
+	}
+
+	return svc.GetJSONWithParams[AggregateBalancesResponse](ctx, s.BaseService, "/v1/platform/balances", params)
+}
+
+// SearchTransactions searches transactions across customers.
+func (s *serviceImpl) SearchTransactions(
+	ctx context.Context,
+	req *SearchTransactionsRequest,
+) (*transactions.ListTransactionsResponse, error) {
+	if req == nil {
+		req = &SearchTransactionsRequest{}
+	}
+	return svc.PostJSON[*SearchTransactionsRequest, transactions.ListTransactionsResponse](
+		ctx, s.BaseService, "/v1/platform/transactions/search", req,
+	)
+}
+
+// GetKYBSummary retrieves the number of customers in each KYB status.
+func (s *serviceImpl) GetKYBSummary(ctx context.Context) (*KYBSummaryResponse, error) {
+	return svc.GetJSON[KYBSummaryResponse](ctx, s.BaseService, "/v1/platform/kyb/summary")
+}
+
+// ListKYBStatuses retrieves the KYB status of customers.
+func (s *serviceImpl) ListKYBStatuses(
+	ctx context.Context,
+	req *ListKYBStatusesRequest,
+) (*ListKYBStatusesResponse, error) {
+	params := make(map[string]string)
+	if req != nil {
+		if req.Status != "" {
+			params["status"] = string(req.Status)
+		}
+		if req.Page > 0 {
+			params["page"] = strconv.Itoa(req.Page)
+		}
+		if req.Size > 0 {
+			params["size"] = strconv.Itoa(req.Size)
+		}
+	}
+
+	return svc.GetJSONWithParams[ListKYBStatusesResponse](ctx, s.BaseService, "/v1/platform/kyb/list", params)
+}
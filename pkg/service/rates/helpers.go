@@ -0,0 +1,82 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rates
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+)
+
+// ErrInvalidRateRequest is returned when a pair or period cannot be used to look up rates.
+var ErrInvalidRateRequest = errors.New("invalid rate request")
+
+// ParsePair parses a pair written as "BASE/QUOTE", e.g. "USDT/USD".
+func ParsePair(s string) (Pair, error) {
+	base, quote, ok := strings.Cut(s, "/")
+	if !ok {
+		return Pair{}, fmt.Errorf("%w: pair %q must be written as BASE/QUOTE", ErrInvalidRateRequest, s)
+	}
+	pair := Pair{
+		Base:  assets.AssetName(strings.ToUpper(strings.TrimSpace(base))),
+		Quote: assets.AssetName(strings.ToUpper(strings.TrimSpace(quote))),
+	}
+	if err := pair.Validate(); err != nil {
+		return Pair{}, err
+	}
+	return pair, nil
+}
+
+// String returns the pair as "BASE/QUOTE".
+func (p Pair) String() string {
+	return string(p.Base) + "/" + string(p.Quote)
+}
+
+// Validate checks that both assets are set and differ.
+func (p Pair) Validate() error {
+	if p.Base == "" || p.Quote == "" {
+		return fmt.Errorf("%w: pair %q needs a base and a quote asset", ErrInvalidRateRequest, p)
+	}
+	if p.Base == p.Quote {
+		return fmt.Errorf("%w: pair %q has the same base and quote asset", ErrInvalidRateRequest, p)
+	}
+	return nil
+}
+
+// Value converts an amount of the base asset into the quote asset at this rate,
+// rounded to the given number of decimals.
+func (r *RateResponse) Value(amount string, decimals int) (string, error) {
+	value, ok := new(big.Rat).SetString(amount)
+	if !ok {
+		return "", fmt.Errorf("invalid amount %q", amount)
+	}
+	rate, ok := new(big.Rat).SetString(r.Rate)
+	if !ok {
+		return "", fmt.Errorf("invalid rate %q", r.Rate)
+	}
+	return value.Mul(value, rate).FloatString(decimals), nil
+}
+
+// EffectiveTime parses EffectiveAt into a time.Time.
+func (r *RateResponse) EffectiveTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.EffectiveAt)
+}
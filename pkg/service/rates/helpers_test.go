@@ -0,0 +1,84 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rates
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParsePair(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    Pair
+		wantErr bool
+	}{
+		{"USDT/USD", Pair{Base: "USDT", Quote: "USD"}, false},
+		{" usdc / eur ", Pair{Base: "USDC", Quote: "EUR"}, false},
+		{"USDT-USD", Pair{}, true},
+		{"USDT/", Pair{}, true},
+		{"USD/USD", Pair{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParsePair(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParsePair() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidRateRequest) {
+				t.Errorf("ParsePair() error = %v, want ErrInvalidRateRequest", err)
+			}
+			if got != tt.want {
+				t.Errorf("ParsePair() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got := (Pair{Base: "USDT", Quote: "USD"}).String(); got != "USDT/USD" {
+		t.Errorf("String() = %q, want USDT/USD", got)
+	}
+}
+
+func TestRateResponse_Value(t *testing.T) {
+	tests := []struct {
+		name     string
+		rate     string
+		amount   string
+		decimals int
+		want     string
+		wantErr  bool
+	}{
+		{"par", "1", "1250.00", 2, "1250.00", false},
+		{"rounded to decimals", "0.9995", "10.01", 2, "10.00", false},
+		{"more decimals", "1.08345", "100", 4, "108.3450", false},
+		{"invalid amount", "1", "abc", 2, "", true},
+		{"invalid rate", "", "1", 2, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := (&RateResponse{Rate: tt.rate}).Value(tt.amount, tt.decimals)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Value() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Value() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package rates provides historical conversion rates between assets.
+//
+// This package implements the rates service client for the 1Money platform,
+// returning the rate in effect at a point in time and daily rate summaries, so past
+// transactions can be valued at their execution-time rate for accounting and tax reporting.
+//
+// # Basic Usage
+//
+//	import (
+//	    "context"
+//	    onemoney "github.com/1Money-Co/1money-go-sdk/pkg/onemoney"
+//	    "github.com/1Money-Co/1money-go-sdk/pkg/service/rates"
+//	    "github.com/1Money-Co/1money-go-sdk/pkg/service/transactions"
+//	)
+//
+//	// Create client
+//	client, err := onemoney.NewClient(&onemoney.Config{
+//	    AccessKey: "your-access-key",
+//	    SecretKey: "your-secret-key",
+//	})
+//
+//	// Value a past transaction at the rate in effect when it executed
+//	pair, err := rates.ParsePair("USDT/USD")
+//	rate, err := client.Rates.GetHistoricalRate(ctx, pair, executedAt)
+//	value, err := rate.Value("1250.00", 2)
+//
+//	// Daily rates for a month
+//	daily, err := client.Rates.ListDailyRates(ctx, pair, transactions.MonthPeriod(2025, time.March, nil))
+package rates
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/transactions"
+)
+
+// Service defines the rates service interface for retrieving historical rates.
+type Service interface {
+	// GetHistoricalRate retrieves the rate for a pair that was in effect at the timestamp.
+	GetHistoricalRate(ctx context.Context, pair Pair, timestamp time.Time) (*RateResponse, error)
+	// ListDailyRates retrieves the daily rate summary for a pair for each UTC day in the period.
+	ListDailyRates(ctx context.Context, pair Pair, period transactions.Period) ([]DailyRate, error)
+}
+
+// Pair identifies a conversion rate: the amount of Quote for one unit of Base.
+type Pair struct {
+	// Base is the asset being priced.
+	Base assets.AssetName
+	// Quote is the asset the price is expressed in.
+	Quote assets.AssetName
+}
+
+// Rate response types.
+type (
+	// RateResponse represents the rate in effect at a point in time.
+	RateResponse struct {
+		// Base is the asset being priced.
+		Base string `json:"base"`
+		// Quote is the asset the price is expressed in.
+		Quote string `json:"quote"`
+		// Rate is the amount of Quote for one unit of Base.
+		Rate string `json:"rate"`
+		// EffectiveAt is when the rate took effect, at or before the requested timestamp (ISO 8601 format).
+		EffectiveAt string `json:"effective_at"`
+	}
+
+	// DailyRate represents the rate summary for one UTC day.
+	DailyRate struct {
+		// Date is the UTC day (YYYY-MM-DD format).
+		Date string `json:"date"`
+		// Open is the first rate of the day.
+		Open string `json:"open"`
+		// High is the highest rate of the day.
+		High string `json:"high"`
+		// Low is the lowest rate of the day.
+		Low string `json:"low"`
+		// Close is the last rate of the day, commonly used for end-of-day valuation.
+		Close string `json:"close"`
+	}
+)
+
+type serviceImpl struct {
+	*svc.BaseService
+}
+
+// NewService creates a new rates service instance with the given base service.
+func NewService(base *svc.BaseService) Service {
+	return &serviceImpl{
+		BaseService: base,
+	}
+}
+
+// GetHistoricalRate retrieves the rate for a pair that was in effect at the timestamp.
+func (s *serviceImpl) GetHistoricalRate(ctx context.Context, pair Pair, timestamp time.Time) (*RateResponse, error) {
+	if err := pair.Validate(); err != nil {
+		return nil, err
+	}
+
+	params := map[string]string{
+		"base":      string(pair.Base),
+		"quote":     string(pair.Quote),
+		"timestamp": timestamp.UTC().Format(time.RFC3339),
+	}
+	return svc.GetJSONWithParams[RateResponse](ctx, s.BaseService, "/v1/rates/historical", params)
+}
+
+// ListDailyRates retrieves the daily rate summary for a pair for each UTC day in the period.
+func (s *serviceImpl) ListDailyRates(
+	ctx context.Context,
+	pair Pair,
+	period transactions.Period,
+) ([]DailyRate, error) {
+	if err := pair.Validate(); err != nil {
+		return nil, err
+	}
+	if period.Start.IsZero() || period.End.IsZero() || !period.Start.Before(period.End) {
+		return nil, fmt.Errorf("%w: period must have a start before its end", ErrInvalidRateRequest)
+	}
+
+	params := map[string]string{
+		"base":       string(pair.Base),
+		"quote":      string(pair.Quote),
+		"start_time": period.Start.UTC().Format(time.RFC3339),
+		"end_time":   period.End.UTC().Format(time.RFC3339),
+	}
+
+	result, err := svc.GetJSONWithParams[[]DailyRate](ctx, s.BaseService, "/v1/rates/daily", params)
+	if err != nil {
+		return nil, err
+	}
+	return *result, nil
+}
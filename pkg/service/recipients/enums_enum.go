@@ -0,0 +1,86 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: v0.9.2
+
+// Built By: go install
+
+package recipients
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// RecipientTypeINDIVIDUAL is a RecipientType of type INDIVIDUAL.
+	RecipientTypeINDIVIDUAL RecipientType = "INDIVIDUAL"
+	// RecipientTypeBUSINESS is a RecipientType of type BUSINESS.
+	RecipientTypeBUSINESS RecipientType = "BUSINESS"
+)
+
+var ErrInvalidRecipientType = fmt.Errorf("not a valid RecipientType, try [%s]", strings.Join(_RecipientTypeNames, ", "))
+
+var _RecipientTypeNames = []string{
+	string(RecipientTypeINDIVIDUAL),
+	string(RecipientTypeBUSINESS),
+}
+
+// RecipientTypeNames returns a list of possible string values of RecipientType.
+func RecipientTypeNames() []string {
+	tmp := make([]string, len(_RecipientTypeNames))
+	copy(tmp, _RecipientTypeNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x RecipientType) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x RecipientType) IsValid() bool {
+	_, err := ParseRecipientType(string(x))
+	return err == nil
+}
+
+var _RecipientTypeValue = map[string]RecipientType{
+	"INDIVIDUAL": RecipientTypeINDIVIDUAL,
+	"individual": RecipientTypeINDIVIDUAL,
+	"BUSINESS":   RecipientTypeBUSINESS,
+	"business":   RecipientTypeBUSINESS,
+}
+
+// ParseRecipientType attempts to convert a string to a RecipientType.
+func ParseRecipientType(name string) (RecipientType, error) {
+	if x, ok := _RecipientTypeValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _RecipientTypeValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return RecipientType(""), fmt.Errorf("%s is %w", name, ErrInvalidRecipientType)
+}
+
+// MarshalText implements the text marshaller method.
+func (x RecipientType) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *RecipientType) UnmarshalText(text []byte) error {
+	tmp, err := ParseRecipientType(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *RecipientType) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
@@ -0,0 +1,30 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package recipients
+
+import (
+	"testing"
+
+	"github.com/1Money-Co/1money-go-sdk/internal/golden"
+)
+
+func TestGolden(t *testing.T) {
+	golden.RoundTrip[RecipientResponse](t, "recipient_response")
+	golden.RoundTrip[ListRecipientsResponse](t, "list_recipients_response")
+	golden.RoundTrip[ListBankAccountsResponse](t, "list_bank_accounts_response")
+	golden.RoundTrip[ListWalletAddressesResponse](t, "list_wallet_addresses_response")
+}
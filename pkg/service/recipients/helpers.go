@@ -0,0 +1,131 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package recipients
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"time"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/common"
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/external_accounts"
+)
+
+// ErrInvalidRecipient is returned when a recipient request fails validation.
+var ErrInvalidRecipient = errors.New("invalid recipient request")
+
+// Validate checks that the request has a known type, a name and, if set, a valid email address.
+func (r *CreateRecipientRequest) Validate() error {
+	if r == nil {
+		return fmt.Errorf("%w: request is nil", ErrInvalidRecipient)
+	}
+	if !r.Type.IsValid() {
+		return fmt.Errorf("%w: invalid type %q", ErrInvalidRecipient, r.Type)
+	}
+	if r.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidRecipient)
+	}
+	return validateEmail(r.Email)
+}
+
+// Validate checks that the request changes at least one field, does not clear the name
+// and, if set, has a valid email address.
+func (r *UpdateRecipientRequest) Validate() error {
+	if r == nil {
+		return fmt.Errorf("%w: request is nil", ErrInvalidRecipient)
+	}
+	if r.Name == nil && r.Nickname == nil && r.Email == nil && r.CountryCode == nil {
+		return fmt.Errorf("%w: no fields to update", ErrInvalidRecipient)
+	}
+	if r.Name != nil && *r.Name == "" {
+		return fmt.Errorf("%w: name cannot be empty", ErrInvalidRecipient)
+	}
+	if r.Email != nil {
+		return validateEmail(*r.Email)
+	}
+	return nil
+}
+
+// validateEmail checks that a non-empty email is a valid address.
+func validateEmail(email string) error {
+	if email == "" {
+		return nil
+	}
+	if _, err := mail.ParseAddress(email); err != nil {
+		return fmt.Errorf("%w: email %q: %w", ErrInvalidRecipient, email, err)
+	}
+	return nil
+}
+
+// Validate checks the institution ID against the network: an ABA routing number for US_ACH
+// and US_FEDWIRE, a BIC for SWIFT and SEPA. SEPA accounts must also be in EUR with an IBAN
+// as the account number.
+func (r *AddBankAccountRequest) Validate() error {
+	if r == nil {
+		return fmt.Errorf("%w: request is nil", ErrInvalidRecipient)
+	}
+	if r.AccountNumber == "" {
+		return fmt.Errorf("%w: account_number is required", ErrInvalidRecipient)
+	}
+
+	switch r.Network {
+	case external_accounts.BankNetworkNameUSACH, external_accounts.BankNetworkNameUSFEDWIRE:
+		if err := common.ValidateABARouting(r.InstitutionID); err != nil {
+			return fmt.Errorf("institution_id: %w", err)
+		}
+	case external_accounts.BankNetworkNameSWIFT:
+		if err := common.ValidateBIC(r.InstitutionID); err != nil {
+			return fmt.Errorf("institution_id: %w", err)
+		}
+	case external_accounts.BankNetworkNameSEPA:
+		if r.Currency != external_accounts.CurrencyEUR {
+			return fmt.Errorf("%w: SEPA accounts must use EUR, got %q", ErrInvalidRecipient, r.Currency)
+		}
+		if err := common.ValidateBIC(r.InstitutionID); err != nil {
+			return fmt.Errorf("institution_id: %w", err)
+		}
+		if err := common.ValidateIBAN(r.AccountNumber); err != nil {
+			return fmt.Errorf("account_number: %w", err)
+		}
+	default:
+		return fmt.Errorf("%w: invalid network %q", ErrInvalidRecipient, r.Network)
+	}
+	return nil
+}
+
+// Validate checks that the request has a network and an address.
+func (r *AddWalletAddressRequest) Validate() error {
+	if r == nil {
+		return fmt.Errorf("%w: request is nil", ErrInvalidRecipient)
+	}
+	if r.Network == "" || r.Address == "" {
+		return fmt.Errorf("%w: network and address are required", ErrInvalidRecipient)
+	}
+	return nil
+}
+
+// CreatedTime parses CreatedAt into a time.Time.
+func (r *RecipientResponse) CreatedTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.CreatedAt)
+}
+
+// ModifiedTime parses ModifiedAt into a time.Time.
+func (r *RecipientResponse) ModifiedTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.ModifiedAt)
+}
@@ -0,0 +1,225 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package recipients
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/external_accounts"
+)
+
+func TestCreateRecipientRequest_Validate(t *testing.T) {
+	valid := func() *CreateRecipientRequest {
+		return &CreateRecipientRequest{
+			Type: RecipientTypeBUSINESS,
+			Name: "Acme Supplies Ltd",
+		}
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(r *CreateRecipientRequest)
+		wantErr bool
+	}{
+		{"valid business", func(*CreateRecipientRequest) {}, false},
+		{"valid individual with email", func(r *CreateRecipientRequest) {
+			r.Type = RecipientTypeINDIVIDUAL
+			r.Email = "jane@example.com"
+		}, false},
+		{"missing type", func(r *CreateRecipientRequest) { r.Type = "" }, true},
+		{"unknown type", func(r *CreateRecipientRequest) { r.Type = "TRUST" }, true},
+		{"missing name", func(r *CreateRecipientRequest) { r.Name = "" }, true},
+		{"invalid email", func(r *CreateRecipientRequest) { r.Email = "not-an-email" }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := valid()
+			tt.modify(req)
+			err := req.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidRecipient) {
+				t.Errorf("Validate() error = %v, want ErrInvalidRecipient", err)
+			}
+		})
+	}
+
+	if err := (*CreateRecipientRequest)(nil).Validate(); !errors.Is(err, ErrInvalidRecipient) {
+		t.Errorf("Validate() on nil request error = %v, want ErrInvalidRecipient", err)
+	}
+}
+
+func TestUpdateRecipientRequest_Validate(t *testing.T) {
+	name, empty, email, badEmail := "Acme Ltd", "", "ops@acme.example", "acme"
+
+	tests := []struct {
+		name    string
+		req     *UpdateRecipientRequest
+		wantErr bool
+	}{
+		{"name", &UpdateRecipientRequest{Name: &name}, false},
+		{"email", &UpdateRecipientRequest{Email: &email}, false},
+		{"clear email", &UpdateRecipientRequest{Email: &empty}, false},
+		{"clear nickname", &UpdateRecipientRequest{Nickname: &empty}, false},
+		{"nil", nil, true},
+		{"no fields", &UpdateRecipientRequest{}, true},
+		{"clear name", &UpdateRecipientRequest{Name: &empty}, true},
+		{"invalid email", &UpdateRecipientRequest{Email: &badEmail}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidRecipient) {
+				t.Errorf("Validate() error = %v, want ErrInvalidRecipient", err)
+			}
+		})
+	}
+}
+
+func TestAddBankAccountRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *AddBankAccountRequest
+		wantErr bool
+	}{
+		{
+			name: "valid ACH",
+			req: &AddBankAccountRequest{
+				Network:       external_accounts.BankNetworkNameUSACH,
+				Currency:      external_accounts.CurrencyUSD,
+				AccountNumber: "123456789",
+				InstitutionID: "021000021",
+			},
+		},
+		{
+			name: "valid SWIFT",
+			req: &AddBankAccountRequest{
+				Network:       external_accounts.BankNetworkNameSWIFT,
+				Currency:      external_accounts.CurrencyUSD,
+				AccountNumber: "123456789",
+				InstitutionID: "DEUTDEFF",
+			},
+		},
+		{
+			name: "valid SEPA",
+			req: &AddBankAccountRequest{
+				Network:       external_accounts.BankNetworkNameSEPA,
+				Currency:      external_accounts.CurrencyEUR,
+				AccountNumber: "DE89370400440532013000",
+				InstitutionID: "DEUTDEFF",
+			},
+		},
+		{name: "nil", req: nil, wantErr: true},
+		{
+			name: "missing account number",
+			req: &AddBankAccountRequest{
+				Network:       external_accounts.BankNetworkNameUSACH,
+				InstitutionID: "021000021",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid routing number",
+			req: &AddBankAccountRequest{
+				Network:       external_accounts.BankNetworkNameUSFEDWIRE,
+				AccountNumber: "123456789",
+				InstitutionID: "021000022",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid BIC",
+			req: &AddBankAccountRequest{
+				Network:       external_accounts.BankNetworkNameSWIFT,
+				AccountNumber: "123456789",
+				InstitutionID: "DEUT",
+			},
+			wantErr: true,
+		},
+		{
+			name: "SEPA in USD",
+			req: &AddBankAccountRequest{
+				Network:       external_accounts.BankNetworkNameSEPA,
+				Currency:      external_accounts.CurrencyUSD,
+				AccountNumber: "DE89370400440532013000",
+				InstitutionID: "DEUTDEFF",
+			},
+			wantErr: true,
+		},
+		{
+			name: "SEPA without IBAN",
+			req: &AddBankAccountRequest{
+				Network:       external_accounts.BankNetworkNameSEPA,
+				Currency:      external_accounts.CurrencyEUR,
+				AccountNumber: "123456789",
+				InstitutionID: "DEUTDEFF",
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown network",
+			req: &AddBankAccountRequest{
+				Network:       "BACS",
+				AccountNumber: "12345678",
+				InstitutionID: "400515",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAddWalletAddressRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *AddWalletAddressRequest
+		wantErr bool
+	}{
+		{"valid", &AddWalletAddressRequest{Network: assets.NetworkNameETHEREUM, Address: "0xabc"}, false},
+		{"nil", nil, true},
+		{"missing network", &AddWalletAddressRequest{Address: "0xabc"}, true},
+		{"missing address", &AddWalletAddressRequest{Network: assets.NetworkNameETHEREUM}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidRecipient) {
+				t.Errorf("Validate() error = %v, want ErrInvalidRecipient", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,442 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package recipients provides saved payout recipient management.
+//
+// This package implements the recipients service client for the 1Money platform.
+// A recipient is a third party a customer pays out to. Each recipient holds the bank
+// accounts and wallet addresses it can be paid at, so that a withdrawal only needs the
+// recipient ID and one of its destination IDs.
+//
+// # Basic Usage
+//
+//	import (
+//	    "context"
+//	    onemoney "github.com/1Money-Co/1money-go-sdk/pkg/onemoney"
+//	    "github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+//	    "github.com/1Money-Co/1money-go-sdk/pkg/service/recipients"
+//	)
+//
+//	// Create client
+//	client, err := onemoney.NewClient(&onemoney.Config{
+//	    AccessKey: "your-access-key",
+//	    SecretKey: "your-secret-key",
+//	})
+//
+//	// Save a supplier and the wallet it is paid at
+//	recipient, err := client.Recipients.CreateRecipient(ctx, "customer-id", &recipients.CreateRecipientRequest{
+//	    IdempotencyKey: "unique-key",
+//	    Type:           recipients.RecipientTypeBUSINESS,
+//	    Name:           "Acme Supplies Ltd",
+//	})
+//	wallet, err := client.Recipients.AddWalletAddress(ctx, "customer-id", recipient.RecipientID,
+//	    &recipients.AddWalletAddressRequest{
+//	        IdempotencyKey: "another-unique-key",
+//	        Network:        assets.NetworkNameETHEREUM,
+//	        Address:        "0x...",
+//	    })
+package recipients
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/external_accounts"
+)
+
+// Service defines the recipients service interface for managing saved payout recipients.
+type Service interface {
+	// CreateRecipient creates a new recipient for a customer.
+	CreateRecipient(ctx context.Context, id svc.CustomerID, req *CreateRecipientRequest) (*RecipientResponse, error)
+	// GetRecipient retrieves a specific recipient by ID.
+	GetRecipient(ctx context.Context, id svc.CustomerID, recipientID svc.RecipientID) (*RecipientResponse, error)
+	// ListRecipients retrieves recipients for a customer with optional filters and pagination.
+	ListRecipients(ctx context.Context, id svc.CustomerID, req *ListRecipientsRequest) (*ListRecipientsResponse, error)
+	// UpdateRecipient updates the details of a recipient. Only the fields that are set are changed.
+	UpdateRecipient(
+		ctx context.Context, id svc.CustomerID, recipientID svc.RecipientID, req *UpdateRecipientRequest,
+	) (*RecipientResponse, error)
+	// DeleteRecipient deletes a recipient together with its bank accounts and wallet addresses.
+	DeleteRecipient(ctx context.Context, id svc.CustomerID, recipientID svc.RecipientID) error
+
+	// AddBankAccount adds a bank account a recipient can be paid at.
+	AddBankAccount(
+		ctx context.Context, id svc.CustomerID, recipientID svc.RecipientID, req *AddBankAccountRequest,
+	) (*BankAccountResponse, error)
+	// ListBankAccounts retrieves the bank accounts of a recipient.
+	ListBankAccounts(ctx context.Context, id svc.CustomerID, recipientID svc.RecipientID) (*ListBankAccountsResponse, error)
+	// DeleteBankAccount removes a bank account from a recipient.
+	DeleteBankAccount(ctx context.Context, id svc.CustomerID, recipientID svc.RecipientID, bankAccountID string) error
+
+	// AddWalletAddress adds a wallet address a recipient can be paid at.
+	AddWalletAddress(
+		ctx context.Context, id svc.CustomerID, recipientID svc.RecipientID, req *AddWalletAddressRequest,
+	) (*WalletAddressResponse, error)
+	// ListWalletAddresses retrieves the wallet addresses of a recipient.
+	ListWalletAddresses(
+		ctx context.Context, id svc.CustomerID, recipientID svc.RecipientID,
+	) (*ListWalletAddressesResponse, error)
+	// DeleteWalletAddress removes a wallet address from a recipient.
+	DeleteWalletAddress(ctx context.Context, id svc.CustomerID, recipientID svc.RecipientID, walletAddressID string) error
+}
+
+// Recipient request and response types.
+type (
+	// CreateRecipientRequest represents the request body for creating a recipient.
+	CreateRecipientRequest struct {
+		// IdempotencyKey is a unique key to ensure idempotent creation.
+		// This is sent as a header, not in the body.
+		IdempotencyKey string `json:"-"`
+		// Type is whether the recipient is a person or a business.
+		Type RecipientType `json:"type"`
+		// Name is the legal name of the recipient.
+		Name string `json:"name"`
+		// Nickname is a display name for the recipient (optional).
+		Nickname string `json:"nickname,omitempty"`
+		// Email is the contact email address of the recipient (optional).
+		Email string `json:"email,omitempty"`
+		// CountryCode is the country the recipient is based in (optional).
+		CountryCode external_accounts.CountryCode `json:"country_code,omitempty"`
+	}
+
+	// UpdateRecipientRequest represents the request body for updating a recipient.
+	// Only the non-nil fields are changed.
+	UpdateRecipientRequest struct {
+		// Name is the legal name of the recipient.
+		Name *string `json:"name,omitempty"`
+		// Nickname is a display name for the recipient.
+		Nickname *string `json:"nickname,omitempty"`
+		// Email is the contact email address of the recipient.
+		Email *string `json:"email,omitempty"`
+		// CountryCode is the country the recipient is based in.
+		CountryCode *external_accounts.CountryCode `json:"country_code,omitempty"`
+	}
+
+	// RecipientResponse represents a recipient.
+	RecipientResponse struct {
+		// RecipientID is the unique recipient identifier.
+		RecipientID svc.RecipientID `json:"recipient_id"`
+		// IdempotencyKey is the idempotency key used when the recipient was created.
+		IdempotencyKey string `json:"idempotency_key"`
+		// Type is whether the recipient is a person or a business.
+		Type RecipientType `json:"type"`
+		// Name is the legal name of the recipient.
+		Name string `json:"name"`
+		// Nickname is the display name of the recipient.
+		Nickname string `json:"nickname,omitempty"`
+		// Email is the contact email address of the recipient.
+		Email string `json:"email,omitempty"`
+		// CountryCode is the country the recipient is based in.
+		CountryCode string `json:"country_code,omitempty"`
+		// CreatedAt is the recipient creation timestamp (ISO 8601 format).
+		CreatedAt string `json:"created_at"`
+		// ModifiedAt is the last modification timestamp (ISO 8601 format).
+		ModifiedAt string `json:"modified_at"`
+	}
+
+	// ListRecipientsRequest represents optional query parameters for listing recipients.
+	ListRecipientsRequest struct {
+		// Type filters by recipient type.
+		Type RecipientType `json:"type,omitempty"`
+		// Page is the page number (starts from 1).
+		Page int `json:"page,omitempty"`
+		// Size is the number of items per page (1-100).
+		Size int `json:"size,omitempty"`
+	}
+
+	// ListRecipientsResponse represents the response for listing recipients.
+	ListRecipientsResponse struct {
+		// List is the list of recipients.
+		List []RecipientResponse `json:"list"`
+		// Total is the total number of recipients matching the filters.
+		Total int `json:"total,omitempty"`
+	}
+)
+
+// Recipient bank account types.
+type (
+	// AddBankAccountRequest represents the request body for adding a bank account to a recipient.
+	AddBankAccountRequest struct {
+		// IdempotencyKey is a unique key to ensure idempotent creation.
+		// This is sent as a header, not in the body.
+		IdempotencyKey string `json:"-"`
+		// Network is the bank network payouts are sent over.
+		Network external_accounts.BankNetworkName `json:"network"`
+		// Currency is the currency of the account.
+		Currency external_accounts.Currency `json:"currency"`
+		// CountryCode is the country of the bank.
+		CountryCode external_accounts.CountryCode `json:"country_code"`
+		// AccountNumber is the account number, or the IBAN for SEPA.
+		AccountNumber string `json:"account_number"`
+		// InstitutionID is the ABA routing number for US_ACH and US_FEDWIRE, or the BIC for SWIFT and SEPA.
+		InstitutionID string `json:"institution_id"`
+		// InstitutionName is the name of the bank (optional).
+		InstitutionName string `json:"institution_name,omitempty"`
+		// Nickname is a display name for the account (optional).
+		Nickname string `json:"nickname,omitempty"`
+	}
+
+	// BankAccountResponse represents a recipient bank account.
+	BankAccountResponse struct {
+		// RecipientBankAccountID is the unique bank account identifier, used as
+		// withdraws.CreateWithdrawalRequest.RecipientBankAccountID.
+		RecipientBankAccountID string `json:"recipient_bank_account_id"`
+		// RecipientID is the recipient the account belongs to.
+		RecipientID svc.RecipientID `json:"recipient_id"`
+		// IdempotencyKey is the idempotency key used when the account was added.
+		IdempotencyKey string `json:"idempotency_key"`
+		// Network is the bank network payouts are sent over.
+		Network string `json:"network"`
+		// Currency is the currency of the account.
+		Currency string `json:"currency"`
+		// CountryCode is the country of the bank.
+		CountryCode string `json:"country_code"`
+		// AccountNumber is the account number, masked except for the last four digits.
+		AccountNumber string `json:"account_number"`
+		// InstitutionID is the routing number or BIC of the bank.
+		InstitutionID string `json:"institution_id"`
+		// InstitutionName is the name of the bank.
+		InstitutionName string `json:"institution_name,omitempty"`
+		// Nickname is the display name of the account.
+		Nickname string `json:"nickname,omitempty"`
+		// CreatedAt is when the account was added (ISO 8601 format).
+		CreatedAt string `json:"created_at"`
+	}
+
+	// ListBankAccountsResponse represents the response for listing recipient bank accounts.
+	ListBankAccountsResponse struct {
+		// List is the list of bank accounts.
+		List []BankAccountResponse `json:"list"`
+		// Total is the total number of bank accounts.
+		Total int `json:"total,omitempty"`
+	}
+)
+
+// Recipient wallet address types.
+type (
+	// AddWalletAddressRequest represents the request body for adding a wallet address to a recipient.
+	AddWalletAddressRequest struct {
+		// IdempotencyKey is a unique key to ensure idempotent creation.
+		// This is sent as a header, not in the body.
+		IdempotencyKey string `json:"-"`
+		// Network is the blockchain network of the address.
+		Network assets.NetworkName `json:"network"`
+		// Address is the wallet address.
+		Address string `json:"address"`
+		// Nickname is a display name for the address (optional).
+		Nickname string `json:"nickname,omitempty"`
+	}
+
+	// WalletAddressResponse represents a recipient wallet address.
+	WalletAddressResponse struct {
+		// RecipientWalletAddressID is the unique wallet address identifier, used as
+		// withdraws.CreateWithdrawalRequest.RecipientWalletAddressID.
+		RecipientWalletAddressID string `json:"recipient_wallet_address_id"`
+		// RecipientID is the recipient the address belongs to.
+		RecipientID svc.RecipientID `json:"recipient_id"`
+		// IdempotencyKey is the idempotency key used when the address was added.
+		IdempotencyKey string `json:"idempotency_key"`
+		// Network is the blockchain network of the address.
+		Network string `json:"network"`
+		// Address is the wallet address.
+		Address string `json:"address"`
+		// Nickname is the display name of the address.
+		Nickname string `json:"nickname,omitempty"`
+		// CreatedAt is when the address was added (ISO 8601 format).
+		CreatedAt string `json:"created_at"`
+	}
+
+	// ListWalletAddressesResponse represents the response for listing recipient wallet addresses.
+	ListWalletAddressesResponse struct {
+		// List is the list of wallet addresses.
+		List []WalletAddressResponse `json:"list"`
+		// Total is the total number of wallet addresses.
+		Total int `json:"total,omitempty"`
+	}
+)
+
+type serviceImpl struct {
+	*svc.BaseService
+}
+
+// NewService creates a new recipients service instance with the given base service.
+func NewService(base *svc.BaseService) Service {
+	return &serviceImpl{
+		BaseService: base,
+	}
+}
+
+// idempotencyHeaders returns the Idempotency-Key header for a create request, if a key is set.
+func idempotencyHeaders(key string) map[string]string {
+	headers := make(map[string]string)
+	if key != "" {
+		headers["Idempotency-Key"] = key
+	}
+	return headers
+}
+
+// CreateRecipient creates a new recipient for a customer.
+func (s *serviceImpl) CreateRecipient(
+	ctx context.Context,
+	id svc.CustomerID,
+	req *CreateRecipientRequest,
+) (*RecipientResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/v1/customers/%s/recipients", id)
+	return svc.PostJSONWithHeaders[*CreateRecipientRequest, RecipientResponse](
+		ctx, s.BaseService, path, req, idempotencyHeaders(req.IdempotencyKey),
+	)
+}
+
+// GetRecipient retrieves a specific recipient by ID.
+func (s *serviceImpl) GetRecipient(
+	ctx context.Context,
+	id svc.CustomerID,
+	recipientID svc.RecipientID,
+) (*RecipientResponse, error) {
+	path := fmt.Sprintf("/v1/customers/%s/recipients/%s", id, recipientID)
+	return svc.GetJSON[RecipientResponse](ctx, s.BaseService, path)
+}
+
+// ListRecipients retrieves recipients for a customer with optional filters and pagination.
+func (s *serviceImpl) ListRecipients(
+	ctx context.Context,
+	id svc.CustomerID,
+	req *ListRecipientsRequest,
+) (*ListRecipientsResponse, error) {
+	path := fmt.Sprintf("/v1/customers/%s/recipients/list", id)
+
+	params := make(map[string]string)
+	if req != nil {
+		if req.Type != "" {
+			params["type"] = string(req.Type)
+		}
+		if req.Page > 0 {
+			params["page"] = strconv.Itoa(req.Page)
+		}
+		if req.Size > 0 {
+			params["size"] = strconv.Itoa(req.Size)
+		}
+	}
+
+	return svc.GetJSONWithParams[ListRecipientsResponse](ctx, s.BaseService, path, params)
+}
+
+// UpdateRecipient updates the details of a recipient.
+func (s *serviceImpl) UpdateRecipient(
+	ctx context.Context,
+	id svc.CustomerID,
+	recipientID svc.RecipientID,
+	req *UpdateRecipientRequest,
+) (*RecipientResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/v1/customers/%s/recipients/%s", id, recipientID)
+	return svc.PatchJSON[*UpdateRecipientRequest, RecipientResponse](ctx, s.BaseService, path, req)
+}
+
+// DeleteRecipient deletes a recipient.
+func (s *serviceImpl) DeleteRecipient(ctx context.Context, id svc.CustomerID, recipientID svc.RecipientID) error {
+	path := fmt.Sprintf("/v1/customers/%s/recipients/%s", id, recipientID)
+	_, err := svc.DeleteJSON[any](ctx, s.BaseService, path)
+	return err
+}
+
+// AddBankAccount adds a bank account a recipient can be paid at.
+func (s *serviceImpl) AddBankAccount(
+	ctx context.Context,
+	id svc.CustomerID,
+	recipientID svc.RecipientID,
+	req *AddBankAccountRequest,
+) (*BankAccountResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/v1/customers/%s/recipients/%s/bank-accounts", id, recipientID)
+	return svc.PostJSONWithHeaders[*AddBankAccountRequest, BankAccountResponse](
+		ctx, s.BaseService, path, req, idempotencyHeaders(req.IdempotencyKey),
+	)
+}
+
+// ListBankAccounts retrieves the bank accounts of a recipient.
+func (s *serviceImpl) ListBankAccounts(
+	ctx context.Context,
+	id svc.CustomerID,
+	recipientID svc.RecipientID,
+) (*ListBankAccountsResponse, error) {
+	path := fmt.Sprintf("/v1/customers/%s/recipients/%s/bank-accounts/list", id, recipientID)
+	return svc.GetJSON[ListBankAccountsResponse](ctx, s.BaseService, path)
+}
+
+// DeleteBankAccount removes a bank account from a recipient.
+func (s *serviceImpl) DeleteBankAccount(
+	ctx context.Context,
+	id svc.CustomerID,
+	recipientID svc.RecipientID,
+	bankAccountID string,
+) error {
+	path := fmt.Sprintf("/v1/customers/%s/recipients/%s/bank-accounts/%s", id, recipientID, bankAccountID)
+	_, err := svc.DeleteJSON[any](ctx, s.BaseService, path)
+	return err
+}
+
+// AddWalletAddress adds a wallet address a recipient can be paid at.
+func (s *serviceImpl) AddWalletAddress(
+	ctx context.Context,
+	id svc.CustomerID,
+	recipientID svc.RecipientID,
+	req *AddWalletAddressRequest,
+) (*WalletAddressResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/v1/customers/%s/recipients/%s/wallet-addresses", id, recipientID)
+	return svc.PostJSONWithHeaders[*AddWalletAddressRequest, WalletAddressResponse](
+		ctx, s.BaseService, path, req, idempotencyHeaders(req.IdempotencyKey),
+	)
+}
+
+// ListWalletAddresses retrieves the wallet addresses of a recipient.
+func (s *serviceImpl) ListWalletAddresses(
+	ctx context.Context,
+	id svc.CustomerID,
+	recipientID svc.RecipientID,
+) (*ListWalletAddressesResponse, error) {
+	path := fmt.Sprintf("/v1/customers/%s/recipients/%s/wallet-addresses/list", id, recipientID)
+	return svc.GetJSON[ListWalletAddressesResponse](ctx, s.BaseService, path)
+}
+
+// DeleteWalletAddress removes a wallet address from a recipient.
+func (s *serviceImpl) DeleteWalletAddress(
+	ctx context.Context,
+	id svc.CustomerID,
+	recipientID svc.RecipientID,
+	walletAddressID string,
+) error {
+	path := fmt.Sprintf("/v1/customers/%s/recipients/%s/wallet-addresses/%s", id, recipientID, walletAddressID)
+	_, err := svc.DeleteJSON[any](ctx, s.BaseService, path)
+	return err
+}
@@ -0,0 +1,43 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package screening
+
+//go:generate go tool go-enum -f=$GOFILE --marshal --names --nocase
+
+// RiskLevel represents the overall risk level of a screened counterparty.
+// ENUM(LOW, MEDIUM, HIGH, SEVERE)
+type RiskLevel string
+
+// Decision represents the recommended action for a screened counterparty.
+// ENUM(ALLOW, REVIEW, BLOCK)
+type Decision string
+
+// RiskCategory represents a category of risk found during screening.
+/* ENUM(
+SANCTIONS
+TERRORIST_FINANCING
+DARKNET_MARKET
+MIXER
+RANSOMWARE
+SCAM
+STOLEN_FUNDS
+GAMBLING
+PEP
+ADVERSE_MEDIA
+)
+*/
+type RiskCategory string
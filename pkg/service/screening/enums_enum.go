@@ -0,0 +1,291 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: v0.9.2
+
+// Built By: go install
+
+package screening
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// DecisionALLOW is a Decision of type ALLOW.
+	DecisionALLOW Decision = "ALLOW"
+	// DecisionREVIEW is a Decision of type REVIEW.
+	DecisionREVIEW Decision = "REVIEW"
+	// DecisionBLOCK is a Decision of type BLOCK.
+	DecisionBLOCK Decision = "BLOCK"
+)
+
+var ErrInvalidDecision = fmt.Errorf("not a valid Decision, try [%s]", strings.Join(_DecisionNames, ", "))
+
+var _DecisionNames = []string{
+	string(DecisionALLOW),
+	string(DecisionREVIEW),
+	string(DecisionBLOCK),
+}
+
+// DecisionNames returns a list of possible string values of Decision.
+func DecisionNames() []string {
+	tmp := make([]string, len(_DecisionNames))
+	copy(tmp, _DecisionNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x Decision) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Decision) IsValid() bool {
+	_, err := ParseDecision(string(x))
+	return err == nil
+}
+
+var _DecisionValue = map[string]Decision{
+	"ALLOW":  DecisionALLOW,
+	"allow":  DecisionALLOW,
+	"REVIEW": DecisionREVIEW,
+	"review": DecisionREVIEW,
+	"BLOCK":  DecisionBLOCK,
+	"block":  DecisionBLOCK,
+}
+
+// ParseDecision attempts to convert a string to a Decision.
+func ParseDecision(name string) (Decision, error) {
+	if x, ok := _DecisionValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _DecisionValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return Decision(""), fmt.Errorf("%s is %w", name, ErrInvalidDecision)
+}
+
+// MarshalText implements the text marshaller method.
+func (x Decision) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *Decision) UnmarshalText(text []byte) error {
+	tmp, err := ParseDecision(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *Decision) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+const (
+	// RiskCategorySANCTIONS is a RiskCategory of type SANCTIONS.
+	RiskCategorySANCTIONS RiskCategory = "SANCTIONS"
+	// RiskCategoryTERRORISTFINANCING is a RiskCategory of type TERRORIST_FINANCING.
+	RiskCategoryTERRORISTFINANCING RiskCategory = "TERRORIST_FINANCING"
+	// RiskCategoryDARKNETMARKET is a RiskCategory of type DARKNET_MARKET.
+	RiskCategoryDARKNETMARKET RiskCategory = "DARKNET_MARKET"
+	// RiskCategoryMIXER is a RiskCategory of type MIXER.
+	RiskCategoryMIXER RiskCategory = "MIXER"
+	// RiskCategoryRANSOMWARE is a RiskCategory of type RANSOMWARE.
+	RiskCategoryRANSOMWARE RiskCategory = "RANSOMWARE"
+	// RiskCategorySCAM is a RiskCategory of type SCAM.
+	RiskCategorySCAM RiskCategory = "SCAM"
+	// RiskCategorySTOLENFUNDS is a RiskCategory of type STOLEN_FUNDS.
+	RiskCategorySTOLENFUNDS RiskCategory = "STOLEN_FUNDS"
+	// RiskCategoryGAMBLING is a RiskCategory of type GAMBLING.
+	RiskCategoryGAMBLING RiskCategory = "GAMBLING"
+	// RiskCategoryPEP is a RiskCategory of type PEP.
+	RiskCategoryPEP RiskCategory = "PEP"
+	// RiskCategoryADVERSEMEDIA is a RiskCategory of type ADVERSE_MEDIA.
+	RiskCategoryADVERSEMEDIA RiskCategory = "ADVERSE_MEDIA"
+)
+
+var ErrInvalidRiskCategory = fmt.Errorf("not a valid RiskCategory, try [%s]", strings.Join(_RiskCategoryNames, ", "))
+
+var _RiskCategoryNames = []string{
+	string(RiskCategorySANCTIONS),
+	string(RiskCategoryTERRORISTFINANCING),
+	string(RiskCategoryDARKNETMARKET),
+	string(RiskCategoryMIXER),
+	string(RiskCategoryRANSOMWARE),
+	string(RiskCategorySCAM),
+	string(RiskCategorySTOLENFUNDS),
+	string(RiskCategoryGAMBLING),
+	string(RiskCategoryPEP),
+	string(RiskCategoryADVERSEMEDIA),
+}
+
+// RiskCategoryNames returns a list of possible string values of RiskCategory.
+func RiskCategoryNames() []string {
+	tmp := make([]string, len(_RiskCategoryNames))
+	copy(tmp, _RiskCategoryNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x RiskCategory) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x RiskCategory) IsValid() bool {
+	_, err := ParseRiskCategory(string(x))
+	return err == nil
+}
+
+var _RiskCategoryValue = map[string]RiskCategory{
+	"SANCTIONS":           RiskCategorySANCTIONS,
+	"sanctions":           RiskCategorySANCTIONS,
+	"TERRORIST_FINANCING": RiskCategoryTERRORISTFINANCING,
+	"terrorist_financing": RiskCategoryTERRORISTFINANCING,
+	"DARKNET_MARKET":      RiskCategoryDARKNETMARKET,
+	"darknet_market":      RiskCategoryDARKNETMARKET,
+	"MIXER":               RiskCategoryMIXER,
+	"mixer":               RiskCategoryMIXER,
+	"RANSOMWARE":          RiskCategoryRANSOMWARE,
+	"ransomware":          RiskCategoryRANSOMWARE,
+	"SCAM":                RiskCategorySCAM,
+	"scam":                RiskCategorySCAM,
+	"STOLEN_FUNDS":        RiskCategorySTOLENFUNDS,
+	"stolen_funds":        RiskCategorySTOLENFUNDS,
+	"GAMBLING":            RiskCategoryGAMBLING,
+	"gambling":            RiskCategoryGAMBLING,
+	"PEP":                 RiskCategoryPEP,
+	"pep":                 RiskCategoryPEP,
+	"ADVERSE_MEDIA":       RiskCategoryADVERSEMEDIA,
+	"adverse_media":       RiskCategoryADVERSEMEDIA,
+}
+
+// ParseRiskCategory attempts to convert a string to a RiskCategory.
+func ParseRiskCategory(name string) (RiskCategory, error) {
+	if x, ok := _RiskCategoryValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _RiskCategoryValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return RiskCategory(""), fmt.Errorf("%s is %w", name, ErrInvalidRiskCategory)
+}
+
+// MarshalText implements the text marshaller method.
+func (x RiskCategory) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *RiskCategory) UnmarshalText(text []byte) error {
+	tmp, err := ParseRiskCategory(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *RiskCategory) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+const (
+	// RiskLevelLOW is a RiskLevel of type LOW.
+	RiskLevelLOW RiskLevel = "LOW"
+	// RiskLevelMEDIUM is a RiskLevel of type MEDIUM.
+	RiskLevelMEDIUM RiskLevel = "MEDIUM"
+	// RiskLevelHIGH is a RiskLevel of type HIGH.
+	RiskLevelHIGH RiskLevel = "HIGH"
+	// RiskLevelSEVERE is a RiskLevel of type SEVERE.
+	RiskLevelSEVERE RiskLevel = "SEVERE"
+)
+
+var ErrInvalidRiskLevel = fmt.Errorf("not a valid RiskLevel, try [%s]", strings.Join(_RiskLevelNames, ", "))
+
+var _RiskLevelNames = []string{
+	string(RiskLevelLOW),
+	string(RiskLevelMEDIUM),
+	string(RiskLevelHIGH),
+	string(RiskLevelSEVERE),
+}
+
+// RiskLevelNames returns a list of possible string values of RiskLevel.
+func RiskLevelNames() []string {
+	tmp := make([]string, len(_RiskLevelNames))
+	copy(tmp, _RiskLevelNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x RiskLevel) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x RiskLevel) IsValid() bool {
+	_, err := ParseRiskLevel(string(x))
+	return err == nil
+}
+
+var _RiskLevelValue = map[string]RiskLevel{
+	"LOW":    RiskLevelLOW,
+	"low":    RiskLevelLOW,
+	"MEDIUM": RiskLevelMEDIUM,
+	"medium": RiskLevelMEDIUM,
+	"HIGH":   RiskLevelHIGH,
+	"high":   RiskLevelHIGH,
+	"SEVERE": RiskLevelSEVERE,
+	"severe": RiskLevelSEVERE,
+}
+
+// ParseRiskLevel attempts to convert a string to a RiskLevel.
+func ParseRiskLevel(name string) (RiskLevel, error) {
+	if x, ok := _RiskLevelValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _RiskLevelValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return RiskLevel(""), fmt.Errorf("%s is %w", name, ErrInvalidRiskLevel)
+}
+
+// MarshalText implements the text marshaller method.
+func (x RiskLevel) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *RiskLevel) UnmarshalText(text []byte) error {
+	tmp, err := ParseRiskLevel(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *RiskLevel) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
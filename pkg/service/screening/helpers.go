@@ -0,0 +1,95 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package screening
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+)
+
+// Screening errors.
+var (
+	// ErrInvalidRequest is returned when a screening request is missing required fields.
+	ErrInvalidRequest = errors.New("invalid screening request")
+	// ErrCounterpartyBlocked is returned by Result.Err when the counterparty must not be paid.
+	ErrCounterpartyBlocked = errors.New("counterparty blocked by screening")
+	// ErrReviewRequired is returned by Result.Err when the counterparty needs manual review.
+	ErrReviewRequired = errors.New("counterparty requires compliance review")
+)
+
+// Validate checks that the address and network are set.
+func (r *WalletAddressRequest) Validate() error {
+	if r == nil || r.Address == "" || r.Network == "" {
+		return fmt.Errorf("%w: address and network are required", ErrInvalidRequest)
+	}
+	return nil
+}
+
+// Validate checks that either an external account or a name and country are set.
+func (r *BankCounterpartyRequest) Validate() error {
+	if r == nil {
+		return fmt.Errorf("%w: request is nil", ErrInvalidRequest)
+	}
+	if r.ExternalAccountID != "" {
+		return nil
+	}
+	if r.Name == "" || r.CountryCode == "" {
+		return fmt.Errorf("%w: name and country_code are required without external_account_id", ErrInvalidRequest)
+	}
+	return nil
+}
+
+// Err returns ErrCounterpartyBlocked or ErrReviewRequired according to the decision,
+// or nil when the counterparty is allowed.
+func (r *Result) Err() error {
+	switch r.Decision {
+	case DecisionALLOW:
+		return nil
+	case DecisionREVIEW:
+		return fmt.Errorf("%w: screening %s, risk score %d", ErrReviewRequired, r.ScreeningID, r.RiskScore)
+	default:
+		return fmt.Errorf("%w: screening %s, risk score %d, categories %v",
+			ErrCounterpartyBlocked, r.ScreeningID, r.RiskScore, r.Categories)
+	}
+}
+
+// HasCategory reports whether the screening found the given risk category.
+func (r *Result) HasCategory(category RiskCategory) bool {
+	return slices.Contains(r.Categories, category)
+}
+
+// IsSanctioned reports whether the counterparty matched a sanction or terrorist-financing list.
+func (r *Result) IsSanctioned() bool {
+	if r.HasCategory(RiskCategorySANCTIONS) || r.HasCategory(RiskCategoryTERRORISTFINANCING) {
+		return true
+	}
+	for _, hit := range r.Hits {
+		if hit.Category == RiskCategorySANCTIONS || hit.Category == RiskCategoryTERRORISTFINANCING {
+			return true
+		}
+	}
+	return false
+}
+
+// ScreenedTime parses ScreenedAt into a time.Time.
+func (r *Result) ScreenedTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.ScreenedAt)
+}
@@ -0,0 +1,81 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package screening
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestResult_Err(t *testing.T) {
+	tests := []struct {
+		decision Decision
+		want     error
+	}{
+		{DecisionALLOW, nil},
+		{DecisionREVIEW, ErrReviewRequired},
+		{DecisionBLOCK, ErrCounterpartyBlocked},
+		// Unknown decisions fail closed.
+		{"", ErrCounterpartyBlocked},
+	}
+
+	for _, tt := range tests {
+		err := (&Result{Decision: tt.decision}).Err()
+		if !errors.Is(err, tt.want) || (tt.want == nil && err != nil) {
+			t.Errorf("Err() for %q = %v, want %v", tt.decision, err, tt.want)
+		}
+	}
+}
+
+func TestResult_IsSanctioned(t *testing.T) {
+	tests := []struct {
+		name   string
+		result Result
+		want   bool
+	}{
+		{"clean", Result{}, false},
+		{"mixer only", Result{Categories: []RiskCategory{RiskCategoryMIXER}}, false},
+		{"sanctions category", Result{Categories: []RiskCategory{RiskCategorySANCTIONS}}, true},
+		{"sanctions hit", Result{Hits: []ListHit{{List: "OFAC SDN", Category: RiskCategorySANCTIONS}}}, true},
+		{"pep hit", Result{Hits: []ListHit{{List: "PEP", Category: RiskCategoryPEP}}}, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.result.IsSanctioned(); got != tt.want {
+			t.Errorf("%s: IsSanctioned() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestBankCounterpartyRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *BankCounterpartyRequest
+		wantErr bool
+	}{
+		{"nil", nil, true},
+		{"name and country", &BankCounterpartyRequest{Name: "Acme Corp", CountryCode: "USA"}, false},
+		{"external account", &BankCounterpartyRequest{ExternalAccountID: "ea-1"}, false},
+		{"missing country", &BankCounterpartyRequest{Name: "Acme Corp"}, true},
+	}
+
+	for _, tt := range tests {
+		if err := tt.req.Validate(); (err != nil) != tt.wantErr {
+			t.Errorf("%s: Validate() error = %v, wantErr %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package screening provides counterparty compliance screening for customer accounts.
+//
+// This package implements the screening service client for the 1Money platform,
+// returning risk scores and sanction-list hits for wallet addresses and bank counterparties,
+// so compliance checks can run before a withdrawal is sent.
+//
+// # Basic Usage
+//
+//	import (
+//	    "context"
+//	    onemoney "github.com/1Money-Co/1money-go-sdk/pkg/onemoney"
+//	    "github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+//	    "github.com/1Money-Co/1money-go-sdk/pkg/service/screening"
+//	)
+//
+//	// Create client
+//	client, err := onemoney.NewClient(&onemoney.Config{
+//	    AccessKey: "your-access-key",
+//	    SecretKey: "your-secret-key",
+//	})
+//
+//	// Screen a wallet before withdrawing to it
+//	result, err := client.Screening.ScreenWalletAddress(ctx, "customer-id", &screening.WalletAddressRequest{
+//	    Address: "0x...",
+//	    Network: assets.NetworkNameETHEREUM,
+//	})
+//	if err := result.Err(); err != nil {
+//	    // blocked, or needs manual review
+//	}
+package screening
+
+import (
+	"context"
+	"fmt"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+)
+
+// Service defines the screening service interface for screening counterparties.
+type Service interface {
+	// ScreenWalletAddress screens a wallet address against blockchain analytics and sanction lists.
+	ScreenWalletAddress(ctx context.Context, id svc.CustomerID, req *WalletAddressRequest) (*Result, error)
+	// ScreenBankCounterparty screens the holder of a bank account against sanction and PEP lists.
+	ScreenBankCounterparty(ctx context.Context, id svc.CustomerID, req *BankCounterpartyRequest) (*Result, error)
+}
+
+// Screening request and response types.
+type (
+	// WalletAddressRequest represents the request body for screening a wallet address.
+	WalletAddressRequest struct {
+		// Address is the wallet address to screen.
+		Address string `json:"address"`
+		// Network is the network the address is on.
+		Network assets.NetworkName `json:"network"`
+	}
+
+	// BankCounterpartyRequest represents the request body for screening a bank counterparty.
+	BankCounterpartyRequest struct {
+		// Name is the full legal name of the account holder.
+		Name string `json:"name"`
+		// CountryCode is the ISO 3166-1 alpha-3 country code of the account holder.
+		CountryCode string `json:"country_code"`
+		// AccountNumber is the bank account number or IBAN (optional).
+		AccountNumber string `json:"account_number,omitempty"`
+		// InstitutionID is the ABA routing number or SWIFT/BIC code of the bank (optional).
+		InstitutionID string `json:"institution_id,omitempty"`
+		// ExternalAccountID screens the holder of a registered external account instead of
+		// the fields above (optional).
+		ExternalAccountID string `json:"external_account_id,omitempty"`
+	}
+
+	// ListHit represents a match against a sanction, PEP or watch list.
+	ListHit struct {
+		// List is the name of the list, e.g. "OFAC SDN".
+		List string `json:"list"`
+		// EntryID is the identifier of the matched list entry.
+		EntryID string `json:"entry_id"`
+		// EntryName is the name on the matched list entry.
+		EntryName string `json:"entry_name"`
+		// MatchScore is the match confidence from 0 to 100.
+		MatchScore int `json:"match_score"`
+		// Category is the risk category of the list.
+		Category RiskCategory `json:"category"`
+	}
+
+	// Result represents the outcome of a screening.
+	Result struct {
+		// ScreeningID is the unique identifier of the screening, for audit purposes.
+		ScreeningID string `json:"screening_id"`
+		// RiskScore is the overall risk score from 0 (no risk) to 100.
+		RiskScore int `json:"risk_score"`
+		// RiskLevel is the overall risk level.
+		RiskLevel RiskLevel `json:"risk_level"`
+		// Decision is the recommended action.
+		Decision Decision `json:"decision"`
+		// Categories are the risk categories found.
+		Categories []RiskCategory `json:"categories,omitempty"`
+		// Hits are the sanction, PEP and watch-list matches.
+		Hits []ListHit `json:"hits,omitempty"`
+		// ScreenedAt is when the screening was performed (ISO 8601 format).
+		ScreenedAt string `json:"screened_at"`
+	}
+)
+
+type serviceImpl struct {
+	*svc.BaseService
+}
+
+// NewService creates a new screening service instance with the given base service.
+func NewService(base *svc.BaseService) Service {
+	return &serviceImpl{
+		BaseService: base,
+	}
+}
+
+// ScreenWalletAddress screens a wallet address.
+func (s *serviceImpl) ScreenWalletAddress(
+	ctx context.Context,
+	id svc.CustomerID,
+	req *WalletAddressRequest,
+) (*Result, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("/v1/customers/%s/screening/wallet-addresses", id)
+	return svc.PostJSON[*WalletAddressRequest, Result](ctx, s.BaseService, path, req)
+}
+
+// ScreenBankCounterparty screens the holder of a bank account.
+func (s *serviceImpl) ScreenBankCounterparty(
+	ctx context.Context,
+	id svc.CustomerID,
+	req *BankCounterpartyRequest,
+) (*Result, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("/v1/customers/%s/screening/bank-counterparties", id)
+	return svc.PostJSON[*BankCounterpartyRequest, Result](ctx, s.BaseService, path, req)
+}
@@ -33,3 +33,13 @@ SOLANA
 )
 */
 type WalletNetworkName string
+
+// WithdrawalSimulationStatus represents the target outcome of a simulated withdrawal.
+// SETTLED completes the withdrawal, RETURNED reverses it as if the receiving bank returned
+// the funds, and FAILED fails it before settlement.
+// ENUM(SETTLED, RETURNED, FAILED)
+type WithdrawalSimulationStatus string
+
+// StepAction represents the action performed by a scenario step.
+// ENUM(DEPOSIT, WAIT, WITHDRAW, WITHDRAWAL_STATUS, WAIT_SETTLED)
+type StepAction string
@@ -10,6 +10,96 @@ import (
 	"strings"
 )
 
+const (
+	// StepActionDEPOSIT is a StepAction of type DEPOSIT.
+	StepActionDEPOSIT StepAction = "DEPOSIT"
+	// StepActionWAIT is a StepAction of type WAIT.
+	StepActionWAIT StepAction = "WAIT"
+	// StepActionWITHDRAW is a StepAction of type WITHDRAW.
+	StepActionWITHDRAW StepAction = "WITHDRAW"
+	// StepActionWITHDRAWALSTATUS is a StepAction of type WITHDRAWAL_STATUS.
+	StepActionWITHDRAWALSTATUS StepAction = "WITHDRAWAL_STATUS"
+	// StepActionWAITSETTLED is a StepAction of type WAIT_SETTLED.
+	StepActionWAITSETTLED StepAction = "WAIT_SETTLED"
+)
+
+var ErrInvalidStepAction = fmt.Errorf("not a valid StepAction, try [%s]", strings.Join(_StepActionNames, ", "))
+
+var _StepActionNames = []string{
+	string(StepActionDEPOSIT),
+	string(StepActionWAIT),
+	string(StepActionWITHDRAW),
+	string(StepActionWITHDRAWALSTATUS),
+	string(StepActionWAITSETTLED),
+}
+
+// StepActionNames returns a list of possible string values of StepAction.
+func StepActionNames() []string {
+	tmp := make([]string, len(_StepActionNames))
+	copy(tmp, _StepActionNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x StepAction) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x StepAction) IsValid() bool {
+	_, err := ParseStepAction(string(x))
+	return err == nil
+}
+
+var _StepActionValue = map[string]StepAction{
+	"DEPOSIT":           StepActionDEPOSIT,
+	"deposit":           StepActionDEPOSIT,
+	"WAIT":              StepActionWAIT,
+	"wait":              StepActionWAIT,
+	"WITHDRAW":          StepActionWITHDRAW,
+	"withdraw":          StepActionWITHDRAW,
+	"WITHDRAWAL_STATUS": StepActionWITHDRAWALSTATUS,
+	"withdrawal_status": StepActionWITHDRAWALSTATUS,
+	"WAIT_SETTLED":      StepActionWAITSETTLED,
+	"wait_settled":      StepActionWAITSETTLED,
+}
+
+// ParseStepAction attempts to convert a string to a StepAction.
+func ParseStepAction(name string) (StepAction, error) {
+	if x, ok := _StepActionValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _StepActionValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return StepAction(""), fmt.Errorf("%s is %w", name, ErrInvalidStepAction)
+}
+
+// MarshalText implements the text marshaller method.
+func (x StepAction) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *StepAction) UnmarshalText(text []byte) error {
+	tmp, err := ParseStepAction(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *StepAction) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
 const (
 	// WalletNetworkNameUSACH is a WalletNetworkName of type US_ACH.
 	WalletNetworkNameUSACH WalletNetworkName = "US_ACH"
@@ -124,3 +214,83 @@ func (x *WalletNetworkName) UnmarshalText(text []byte) error {
 func (x *WalletNetworkName) AppendText(b []byte) ([]byte, error) {
 	return append(b, x.String()...), nil
 }
+
+const (
+	// WithdrawalSimulationStatusSETTLED is a WithdrawalSimulationStatus of type SETTLED.
+	WithdrawalSimulationStatusSETTLED WithdrawalSimulationStatus = "SETTLED"
+	// WithdrawalSimulationStatusRETURNED is a WithdrawalSimulationStatus of type RETURNED.
+	WithdrawalSimulationStatusRETURNED WithdrawalSimulationStatus = "RETURNED"
+	// WithdrawalSimulationStatusFAILED is a WithdrawalSimulationStatus of type FAILED.
+	WithdrawalSimulationStatusFAILED WithdrawalSimulationStatus = "FAILED"
+)
+
+var ErrInvalidWithdrawalSimulationStatus = fmt.Errorf("not a valid WithdrawalSimulationStatus, try [%s]", strings.Join(_WithdrawalSimulationStatusNames, ", "))
+
+var _WithdrawalSimulationStatusNames = []string{
+	string(WithdrawalSimulationStatusSETTLED),
+	string(WithdrawalSimulationStatusRETURNED),
+	string(WithdrawalSimulationStatusFAILED),
+}
+
+// WithdrawalSimulationStatusNames returns a list of possible string values of WithdrawalSimulationStatus.
+func WithdrawalSimulationStatusNames() []string {
+	tmp := make([]string, len(_WithdrawalSimulationStatusNames))
+	copy(tmp, _WithdrawalSimulationStatusNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x WithdrawalSimulationStatus) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x WithdrawalSimulationStatus) IsValid() bool {
+	_, err := ParseWithdrawalSimulationStatus(string(x))
+	return err == nil
+}
+
+var _WithdrawalSimulationStatusValue = map[string]WithdrawalSimulationStatus{
+	"SETTLED":  WithdrawalSimulationStatusSETTLED,
+	"settled":  WithdrawalSimulationStatusSETTLED,
+	"RETURNED": WithdrawalSimulationStatusRETURNED,
+	"returned": WithdrawalSimulationStatusRETURNED,
+	"FAILED":   WithdrawalSimulationStatusFAILED,
+	"failed":   WithdrawalSimulationStatusFAILED,
+}
+
+// ParseWithdrawalSimulationStatus attempts to convert a string to a WithdrawalSimulationStatus.
+func ParseWithdrawalSimulationStatus(name string) (WithdrawalSimulationStatus, error) {
+	if x, ok := _WithdrawalSimulationStatusValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _WithdrawalSimulationStatusValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return WithdrawalSimulationStatus(""), fmt.Errorf("%s is %w", name, ErrInvalidWithdrawalSimulationStatus)
+}
+
+// MarshalText implements the text marshaller method.
+func (x WithdrawalSimulationStatus) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *WithdrawalSimulationStatus) UnmarshalText(text []byte) error {
+	tmp, err := ParseWithdrawalSimulationStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *WithdrawalSimulationStatus) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
@@ -18,6 +18,7 @@ package simulations
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"go.uber.org/zap"
@@ -46,7 +47,7 @@ func WaitForSettled(
 	ctx context.Context,
 	txService transactions.Service,
 	customerID svc.CustomerID,
-	simulationID string,
+	simulationID svc.TransactionID,
 	opts *WaitOptions,
 ) (*transactions.TransactionResponse, error) {
 	txOpts := toTransactionWaitOptions(opts)
@@ -59,13 +60,29 @@ func WaitForCompleted(
 	ctx context.Context,
 	txService transactions.Service,
 	customerID svc.CustomerID,
-	simulationID string,
+	simulationID svc.TransactionID,
 	opts *WaitOptions,
 ) (*transactions.TransactionResponse, error) {
 	txOpts := toTransactionWaitOptions(opts)
 	return transactions.WaitForCompleted(ctx, txService, customerID, simulationID, txOpts)
 }
 
+// WaitForConfirmations polls until the simulated deposit has observed at least the given number
+// of block confirmations, or has settled.
+func WaitForConfirmations(
+	ctx context.Context,
+	txService transactions.Service,
+	customerID svc.CustomerID,
+	simulationID svc.TransactionID,
+	confirmations uint64,
+	opts *WaitOptions,
+) (*transactions.TransactionResponse, error) {
+	txOpts := toTransactionWaitOptions(opts)
+	return transactions.WaitFor(ctx, txService, customerID, simulationID, func(tx *transactions.TransactionResponse) bool {
+		return tx.Confirmations >= confirmations || tx.Status != transactions.TransactionStatusPENDING
+	}, txOpts)
+}
+
 func toTransactionWaitOptions(opts *WaitOptions) *transactions.WaitOptions {
 	if opts == nil {
 		return nil
@@ -77,3 +94,43 @@ func toTransactionWaitOptions(opts *WaitOptions) *transactions.WaitOptions {
 		PrintProgress: opts.PrintProgress,
 	}
 }
+
+// bankNetworks are the simulation networks that carry fiat deposits.
+var bankNetworks = map[WalletNetworkName]bool{
+	WalletNetworkNameUSACH:     true,
+	WalletNetworkNameSWIFT:     true,
+	WalletNetworkNameUSFEDWIRE: true,
+}
+
+// validateOriginator checks that the originator fields match the deposit rail:
+// bank details for fiat networks, a wallet address for crypto networks.
+func (r *SimulateDepositRequest) validateOriginator() error {
+	o := r.Originator
+	if o == nil {
+		return nil
+	}
+
+	isBank := r.Network == "" || bankNetworks[r.Network]
+	if isBank && o.WalletAddress != "" {
+		return fmt.Errorf("originator wallet_address is only valid for crypto deposits, got network %q", r.Network)
+	}
+	if !isBank && (o.BankName != "" || o.AccountNumber != "") {
+		return fmt.Errorf("originator bank details are only valid for fiat deposits, got network %q", r.Network)
+	}
+	return nil
+}
+
+// CreatedTime parses CreatedAt into a time.Time.
+func (r *SimulateDepositResponse) CreatedTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.CreatedAt)
+}
+
+// ModifiedTime parses ModifiedAt into a time.Time.
+func (r *SimulateDepositResponse) ModifiedTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.ModifiedAt)
+}
+
+// ModifiedTime parses ModifiedAt into a time.Time.
+func (r *SimulateWithdrawalStatusResponse) ModifiedTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.ModifiedAt)
+}
@@ -0,0 +1,79 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package simulations
+
+import (
+	"testing"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/transactions"
+)
+
+func TestSimulateDepositRequest_ValidateOriginator(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     SimulateDepositRequest
+		wantErr bool
+	}{
+		{
+			name: "no originator",
+			req:  SimulateDepositRequest{Asset: assets.AssetNameUSD, Network: WalletNetworkNameUSACH},
+		},
+		{
+			name: "fiat with bank details",
+			req: SimulateDepositRequest{
+				Asset:      assets.AssetNameUSD,
+				Network:    WalletNetworkNameUSFEDWIRE,
+				Originator: &transactions.Originator{Name: "Acme Corp", BankName: "First Bank", AccountNumber: "123456789"},
+			},
+		},
+		{
+			name: "crypto with wallet",
+			req: SimulateDepositRequest{
+				Asset:      assets.AssetNameUSDC,
+				Network:    WalletNetworkNameETHEREUM,
+				Originator: &transactions.Originator{Name: "Acme Corp", WalletAddress: "0xabc"},
+			},
+		},
+		{
+			name: "fiat with wallet",
+			req: SimulateDepositRequest{
+				Asset:      assets.AssetNameUSD,
+				Originator: &transactions.Originator{WalletAddress: "0xabc"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "crypto with bank",
+			req: SimulateDepositRequest{
+				Asset:      assets.AssetNameUSDC,
+				Network:    WalletNetworkNameSOLANA,
+				Originator: &transactions.Originator{BankName: "First Bank"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.validateOriginator()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateOriginator() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
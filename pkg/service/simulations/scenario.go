@@ -0,0 +1,220 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package simulations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/transactions"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/withdraws"
+)
+
+// ErrInvalidScenario is returned when a scenario step is missing the fields its action needs.
+var ErrInvalidScenario = errors.New("invalid scenario")
+
+// Scenario types.
+type (
+	// Scenario is an ordered list of sandbox simulation steps, such as deposits, pauses,
+	// withdrawals and forced withdrawal outcomes. Scenarios can be built in Go with the
+	// step constructors or loaded from JSON.
+	Scenario struct {
+		// Name is a human-readable label for the scenario.
+		Name string `json:"name,omitempty"`
+		// Steps are executed in order; execution stops at the first failing step.
+		Steps []Step `json:"steps"`
+		// WaitOptions configures WAIT_SETTLED steps (optional).
+		WaitOptions *WaitOptions `json:"-"`
+	}
+
+	// Step is a single scenario action.
+	Step struct {
+		// Action is the action performed by the step.
+		Action StepAction `json:"action"`
+		// Deposit is the deposit to simulate, for DEPOSIT steps.
+		Deposit *SimulateDepositRequest `json:"deposit,omitempty"`
+		// Withdrawal is the withdrawal to create, for WITHDRAW steps.
+		// A random idempotency key is used when none is set.
+		Withdrawal *withdraws.CreateWithdrawalRequest `json:"withdrawal,omitempty"`
+		// WithdrawalStatus is the outcome applied to the most recent withdrawal, for WITHDRAWAL_STATUS steps.
+		WithdrawalStatus WithdrawalSimulationStatus `json:"withdrawal_status,omitempty"`
+		// Reason is the ACH return code or failure reason, for WITHDRAWAL_STATUS steps.
+		Reason string `json:"reason,omitempty"`
+		// Wait is the pause duration in time.ParseDuration format (e.g., "5s"), for WAIT steps.
+		Wait string `json:"wait,omitempty"`
+	}
+
+	// StepResult is the outcome of an executed step.
+	StepResult struct {
+		// Step is the executed step.
+		Step Step `json:"step"`
+		// TransactionID is the deposit or withdrawal transaction affected by the step, if any.
+		TransactionID svc.TransactionID `json:"transaction_id,omitempty"`
+		// Status is the resulting transaction status, if any.
+		Status transactions.TransactionStatus `json:"status,omitempty"`
+	}
+
+	// ScenarioServices are the services a scenario uses.
+	ScenarioServices struct {
+		// Simulations simulates deposits and withdrawal outcomes.
+		Simulations Service
+		// Transactions is used by WAIT_SETTLED steps.
+		Transactions transactions.Service
+		// Withdrawals creates withdrawals for WITHDRAW steps.
+		Withdrawals withdraws.Service
+	}
+)
+
+// DepositStep returns a step that simulates a deposit.
+func DepositStep(req SimulateDepositRequest) Step {
+	return Step{Action: StepActionDEPOSIT, Deposit: &req}
+}
+
+// WaitStep returns a step that pauses for d.
+func WaitStep(d time.Duration) Step {
+	return Step{Action: StepActionWAIT, Wait: d.String()}
+}
+
+// WithdrawStep returns a step that creates a withdrawal.
+func WithdrawStep(req withdraws.CreateWithdrawalRequest) Step {
+	return Step{Action: StepActionWITHDRAW, Withdrawal: &req}
+}
+
+// WithdrawalStatusStep returns a step that drives the most recent withdrawal to the target outcome.
+func WithdrawalStatusStep(status WithdrawalSimulationStatus, reason string) Step {
+	return Step{Action: StepActionWITHDRAWALSTATUS, WithdrawalStatus: status, Reason: reason}
+}
+
+// WaitForSettledStep returns a step that waits until the most recent transaction is no longer PENDING.
+func WaitForSettledStep() Step {
+	return Step{Action: StepActionWAITSETTLED}
+}
+
+// Validate checks that every step has the fields its action needs.
+func (sc *Scenario) Validate() error {
+	for i := range sc.Steps {
+		if err := sc.Steps[i].validate(); err != nil {
+			return fmt.Errorf("%w: step %d (%s): %w", ErrInvalidScenario, i, sc.Steps[i].Action, err)
+		}
+	}
+	return nil
+}
+
+func (st *Step) validate() error {
+	switch st.Action {
+	case StepActionDEPOSIT:
+		if st.Deposit == nil {
+			return errors.New("deposit is required")
+		}
+	case StepActionWAIT:
+		if _, err := time.ParseDuration(st.Wait); err != nil {
+			return fmt.Errorf("invalid wait duration: %w", err)
+		}
+	case StepActionWITHDRAW:
+		if st.Withdrawal == nil {
+			return errors.New("withdrawal is required")
+		}
+	case StepActionWITHDRAWALSTATUS:
+		if !st.WithdrawalStatus.IsValid() {
+			return fmt.Errorf("invalid withdrawal status %q", st.WithdrawalStatus)
+		}
+	case StepActionWAITSETTLED:
+	default:
+		return fmt.Errorf("unknown action %q", st.Action)
+	}
+	return nil
+}
+
+// Run validates the scenario and executes its steps in order for the customer.
+// It returns the results of the steps executed so far, including when a step fails.
+func (sc *Scenario) Run(ctx context.Context, services ScenarioServices, id svc.CustomerID) ([]StepResult, error) {
+	if err := sc.Validate(); err != nil {
+		return nil, err
+	}
+
+	var (
+		results          []StepResult
+		lastTransaction  svc.TransactionID
+		lastWithdrawalID svc.TransactionID
+	)
+	for i := range sc.Steps {
+		step := sc.Steps[i]
+		result := StepResult{Step: step}
+
+		switch step.Action {
+		case StepActionDEPOSIT:
+			resp, err := services.Simulations.SimulateDeposit(ctx, id, step.Deposit)
+			if err != nil {
+				return results, fmt.Errorf("step %d: failed to simulate deposit: %w", i, err)
+			}
+			result.TransactionID, result.Status = resp.SimulationID, resp.Status
+			lastTransaction = resp.SimulationID
+
+		case StepActionWAIT:
+			d, _ := time.ParseDuration(step.Wait)
+			timer := time.NewTimer(d)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return results, ctx.Err()
+			case <-timer.C:
+			}
+
+		case StepActionWITHDRAW:
+			req := *step.Withdrawal
+			if req.IdempotencyKey == "" {
+				req.IdempotencyKey = uuid.New().String()
+			}
+			resp, err := services.Withdrawals.CreateWithdrawal(ctx, id, &req)
+			if err != nil {
+				return results, fmt.Errorf("step %d: failed to create withdrawal: %w", i, err)
+			}
+			result.TransactionID, result.Status = resp.TransactionID, transactions.TransactionStatus(resp.Status)
+			lastTransaction, lastWithdrawalID = resp.TransactionID, resp.TransactionID
+
+		case StepActionWITHDRAWALSTATUS:
+			if lastWithdrawalID == "" {
+				return results, fmt.Errorf("step %d: no withdrawal to update", i)
+			}
+			resp, err := services.Simulations.SimulateWithdrawalStatus(
+				ctx, id, lastWithdrawalID, step.WithdrawalStatus, step.Reason)
+			if err != nil {
+				return results, fmt.Errorf("step %d: failed to simulate withdrawal status: %w", i, err)
+			}
+			result.TransactionID, result.Status = resp.TransactionID, resp.Status
+
+		case StepActionWAITSETTLED:
+			if lastTransaction == "" {
+				return results, fmt.Errorf("step %d: no transaction to wait for", i)
+			}
+			tx, err := WaitForSettled(ctx, services.Transactions, id, lastTransaction, sc.WaitOptions)
+			if err != nil {
+				return results, fmt.Errorf("step %d: failed to wait for transaction %s: %w", i, lastTransaction, err)
+			}
+			result.TransactionID, result.Status = tx.TransactionID, tx.Status
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,149 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package simulations
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/transactions"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/withdraws"
+)
+
+// fakeScenarioService records the simulation calls made by a scenario.
+type fakeScenarioService struct {
+	Service
+	calls []string
+}
+
+func (f *fakeScenarioService) SimulateDeposit(
+	_ context.Context, _ svc.CustomerID, req *SimulateDepositRequest,
+) (*SimulateDepositResponse, error) {
+	f.calls = append(f.calls, "deposit "+req.Amount+" "+req.ReferenceCode)
+	return &SimulateDepositResponse{SimulationID: svc.TransactionID("dep-" + req.Amount), Status: transactions.TransactionStatusPENDING}, nil
+}
+
+func (f *fakeScenarioService) SimulateWithdrawalStatus(
+	_ context.Context, _ svc.CustomerID, transactionID svc.TransactionID, status WithdrawalSimulationStatus, reason string,
+) (*SimulateWithdrawalStatusResponse, error) {
+	f.calls = append(f.calls, "status "+string(transactionID)+" "+string(status)+" "+reason)
+	return &SimulateWithdrawalStatusResponse{TransactionID: transactionID, Status: transactions.TransactionStatusFAILED}, nil
+}
+
+// fakeWithdrawalService creates withdrawals with a fixed ID.
+type fakeWithdrawalService struct {
+	withdraws.Service
+	keys []string
+}
+
+func (f *fakeWithdrawalService) CreateWithdrawal(
+	_ context.Context, _ svc.CustomerID, req *withdraws.CreateWithdrawalRequest,
+) (*withdraws.WithdrawalResponse, error) {
+	f.keys = append(f.keys, req.IdempotencyKey)
+	return &withdraws.WithdrawalResponse{TransactionID: "wd-1", Status: withdraws.TransactionStatusPENDING}, nil
+}
+
+func TestScenario_Run(t *testing.T) {
+	sims := &fakeScenarioService{}
+	wds := &fakeWithdrawalService{}
+	scenario := &Scenario{
+		Name: "deposits then failed withdrawal",
+		Steps: []Step{
+			DepositStep(SimulateDepositRequest{Asset: assets.AssetNameUSD, Amount: "500.00", ReferenceCode: "REF1"}),
+			WaitStep(time.Millisecond),
+			DepositStep(SimulateDepositRequest{Asset: assets.AssetNameUSDC, Network: WalletNetworkNameETHEREUM, Amount: "100.00"}),
+			WithdrawStep(withdraws.CreateWithdrawalRequest{Asset: assets.AssetNameUSDC, Amount: "10.00"}),
+			WithdrawalStatusStep(WithdrawalSimulationStatusFAILED, "rejected"),
+		},
+	}
+
+	results, err := scenario.Run(context.Background(), ScenarioServices{Simulations: sims, Withdrawals: wds}, "cid")
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	want := []string{"deposit 500.00 REF1", "deposit 100.00 ", "status wd-1 FAILED rejected"}
+	if len(sims.calls) != len(want) {
+		t.Fatalf("calls = %v, want %v", sims.calls, want)
+	}
+	for i := range want {
+		if sims.calls[i] != want[i] {
+			t.Errorf("call %d = %q, want %q", i, sims.calls[i], want[i])
+		}
+	}
+	if len(wds.keys) != 1 || wds.keys[0] == "" {
+		t.Errorf("withdrawal idempotency keys = %v, want one generated key", wds.keys)
+	}
+	if len(results) != len(scenario.Steps) {
+		t.Fatalf("got %d results, want %d", len(results), len(scenario.Steps))
+	}
+	if results[4].Status != transactions.TransactionStatusFAILED {
+		t.Errorf("last result status = %s, want FAILED", results[4].Status)
+	}
+}
+
+func TestScenario_Validate(t *testing.T) {
+	tests := []struct {
+		name string
+		step Step
+	}{
+		{name: "deposit without request", step: Step{Action: StepActionDEPOSIT}},
+		{name: "bad wait", step: Step{Action: StepActionWAIT, Wait: "soon"}},
+		{name: "withdraw without request", step: Step{Action: StepActionWITHDRAW}},
+		{name: "bad status", step: Step{Action: StepActionWITHDRAWALSTATUS, WithdrawalStatus: "LOST"}},
+		{name: "unknown action", step: Step{Action: "TELEPORT"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sc := &Scenario{Steps: []Step{tt.step}}
+			if err := sc.Validate(); !errors.Is(err, ErrInvalidScenario) {
+				t.Errorf("Validate() error = %v, want ErrInvalidScenario", err)
+			}
+		})
+	}
+}
+
+func TestScenario_RunWithoutWithdrawal(t *testing.T) {
+	scenario := &Scenario{Steps: []Step{WithdrawalStatusStep(WithdrawalSimulationStatusSETTLED, "")}}
+	if _, err := scenario.Run(context.Background(), ScenarioServices{}, "cid"); err == nil {
+		t.Error("Run() expected error when no withdrawal precedes a status step")
+	}
+}
+
+func TestScenario_JSON(t *testing.T) {
+	data := []byte(`{"name":"ach","steps":[
+		{"action":"DEPOSIT","deposit":{"asset":"USD","amount":"500.00","reference_code":"REF1"}},
+		{"action":"WAIT","wait":"5s"},
+		{"action":"WITHDRAWAL_STATUS","withdrawal_status":"RETURNED","reason":"R01"}]}`)
+
+	var sc Scenario
+	if err := json.Unmarshal(data, &sc); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if err := sc.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	if len(sc.Steps) != 3 || sc.Steps[0].Deposit.ReferenceCode != "REF1" || sc.Steps[1].Wait != "5s" {
+		t.Errorf("unexpected scenario: %+v", sc)
+	}
+}
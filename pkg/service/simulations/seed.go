@@ -0,0 +1,102 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package simulations
+
+import (
+	"context"
+	"fmt"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/external_accounts"
+)
+
+// Seed scenario and result types.
+type (
+	// SeedScenario describes the sandbox data to create for a customer.
+	SeedScenario struct {
+		// Balances are deposits used to fund the customer's balances, one per asset and network.
+		Balances []SimulateDepositRequest
+		// Transactions is the number of additional small USD deposits to create, for list and
+		// pagination tests.
+		Transactions int
+		// ExternalAccount, when set, is created and forced to APPROVED.
+		ExternalAccount *external_accounts.CreateReq
+	}
+
+	// SeedResult holds the data created by Seed.
+	SeedResult struct {
+		// Deposits are the simulated deposits, balances first and then additional transactions.
+		Deposits []SimulateDepositResponse
+		// ExternalAccount is the approved external account, if the scenario requested one.
+		ExternalAccount *external_accounts.Resp
+	}
+)
+
+// seedTransactionAmount is the amount of each additional deposit created by Seed.
+const seedTransactionAmount = "1.00"
+
+// Seed populates the customer's sandbox data according to the scenario.
+func (s *serviceImpl) Seed(ctx context.Context, id svc.CustomerID, scenario *SeedScenario) (*SeedResult, error) {
+	return seed(ctx, s, external_accounts.NewService(s.BaseService), id, scenario)
+}
+
+func seed(
+	ctx context.Context,
+	sims Service,
+	accounts external_accounts.Service,
+	id svc.CustomerID,
+	scenario *SeedScenario,
+) (*SeedResult, error) {
+	result := &SeedResult{}
+	if scenario == nil {
+		return result, nil
+	}
+
+	deposits := make([]SimulateDepositRequest, 0, len(scenario.Balances)+scenario.Transactions)
+	deposits = append(deposits, scenario.Balances...)
+	for range scenario.Transactions {
+		deposits = append(deposits, SimulateDepositRequest{
+			Asset:   assets.AssetNameUSD,
+			Network: WalletNetworkNameUSACH,
+			Amount:  seedTransactionAmount,
+		})
+	}
+
+	for i := range deposits {
+		resp, err := sims.SimulateDeposit(ctx, id, &deposits[i])
+		if err != nil {
+			return result, fmt.Errorf("failed to seed %s deposit %d: %w", deposits[i].Asset, i, err)
+		}
+		result.Deposits = append(result.Deposits, *resp)
+	}
+
+	if scenario.ExternalAccount != nil {
+		account, err := accounts.CreateExternalAccount(ctx, id, scenario.ExternalAccount)
+		if err != nil {
+			return result, fmt.Errorf("failed to seed external account: %w", err)
+		}
+		approved, err := sims.SetExternalAccountStatus(
+			ctx, id, account.ExternalAccountID, external_accounts.BankAccountStatusAPPROVED)
+		if err != nil {
+			return result, fmt.Errorf("failed to approve seeded external account: %w", err)
+		}
+		result.ExternalAccount = approved
+	}
+
+	return result, nil
+}
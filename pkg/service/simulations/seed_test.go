@@ -0,0 +1,104 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package simulations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/external_accounts"
+)
+
+// fakeSeedService records simulated deposits and account status changes.
+type fakeSeedService struct {
+	Service
+	deposits   []SimulateDepositRequest
+	statuses   map[string]external_accounts.BankAccountStatus
+	failAtCall int
+}
+
+func (f *fakeSeedService) SimulateDeposit(
+	_ context.Context, _ svc.CustomerID, req *SimulateDepositRequest,
+) (*SimulateDepositResponse, error) {
+	f.deposits = append(f.deposits, *req)
+	if f.failAtCall == len(f.deposits) {
+		return nil, errors.New("boom")
+	}
+	return &SimulateDepositResponse{SimulationID: svc.TransactionID(fmt.Sprintf("sim-%d", len(f.deposits)))}, nil
+}
+
+func (f *fakeSeedService) SetExternalAccountStatus(
+	_ context.Context, _ svc.CustomerID, externalAccountID string, status external_accounts.BankAccountStatus,
+) (*external_accounts.Resp, error) {
+	f.statuses[externalAccountID] = status
+	return &external_accounts.Resp{ExternalAccountID: externalAccountID, Status: string(status)}, nil
+}
+
+// fakeAccountService creates external accounts with a fixed ID.
+type fakeAccountService struct {
+	external_accounts.Service
+}
+
+func (fakeAccountService) CreateExternalAccount(
+	_ context.Context, _ svc.CustomerID, _ *external_accounts.CreateReq,
+) (*external_accounts.Resp, error) {
+	return &external_accounts.Resp{ExternalAccountID: "ea-1", Status: string(external_accounts.BankAccountStatusPENDING)}, nil
+}
+
+func TestSeed(t *testing.T) {
+	sims := &fakeSeedService{statuses: map[string]external_accounts.BankAccountStatus{}}
+	scenario := &SeedScenario{
+		Balances: []SimulateDepositRequest{
+			{Asset: assets.AssetNameUSD, Network: WalletNetworkNameUSACH, Amount: "500.00"},
+			{Asset: assets.AssetNameUSDC, Network: WalletNetworkNameETHEREUM, Amount: "100.00"},
+		},
+		Transactions:    3,
+		ExternalAccount: &external_accounts.CreateReq{},
+	}
+
+	result, err := seed(context.Background(), sims, fakeAccountService{}, "cid", scenario)
+	if err != nil {
+		t.Fatalf("seed() error = %v", err)
+	}
+
+	if len(result.Deposits) != 5 || len(sims.deposits) != 5 {
+		t.Fatalf("got %d deposits, want 5", len(result.Deposits))
+	}
+	if sims.deposits[1].Amount != "100.00" || sims.deposits[4].Amount != seedTransactionAmount {
+		t.Errorf("unexpected deposits: %+v", sims.deposits)
+	}
+	if result.ExternalAccount == nil || result.ExternalAccount.Status != string(external_accounts.BankAccountStatusAPPROVED) {
+		t.Errorf("external account = %+v, want approved", result.ExternalAccount)
+	}
+}
+
+func TestSeed_StopsOnError(t *testing.T) {
+	sims := &fakeSeedService{failAtCall: 2}
+	scenario := &SeedScenario{Transactions: 4}
+
+	result, err := seed(context.Background(), sims, fakeAccountService{}, "cid", scenario)
+	if err == nil {
+		t.Fatal("seed() expected error")
+	}
+	if len(result.Deposits) != 1 || len(sims.deposits) != 2 {
+		t.Errorf("got %d results after %d calls, want 1 after 2", len(result.Deposits), len(sims.deposits))
+	}
+}
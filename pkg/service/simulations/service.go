@@ -17,7 +17,8 @@
 // Package simulations provides transaction simulation functionality.
 //
 // This package implements the simulations service client for the 1Money platform,
-// enabling simulation of deposit transactions for testing purposes.
+// enabling simulation of deposit transactions, withdrawal outcomes, KYB status transitions
+// and external account reviews for testing purposes.
 // NOTE: This service is only available in non-production environments.
 //
 // # Basic Usage
@@ -51,10 +52,13 @@ package simulations
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
 	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/customer"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/external_accounts"
 	"github.com/1Money-Co/1money-go-sdk/pkg/service/transactions"
 )
 
@@ -63,6 +67,51 @@ type Service interface {
 	// SimulateDeposit simulates a deposit transaction for testing purposes.
 	// Only available in non-production environments.
 	SimulateDeposit(ctx context.Context, id svc.CustomerID, req *SimulateDepositRequest) (*SimulateDepositResponse, error)
+	// SimulateWithdrawalStatus drives a pending withdrawal to SETTLED, RETURNED or FAILED.
+	// The reason is required for RETURNED and should be an ACH return code (e.g., "R01");
+	// for FAILED it is an optional free-text failure reason.
+	// Only available in non-production environments.
+	SimulateWithdrawalStatus(
+		ctx context.Context,
+		id svc.CustomerID,
+		transactionID svc.TransactionID,
+		targetStatus WithdrawalSimulationStatus,
+		reason string,
+	) (*SimulateWithdrawalStatusResponse, error)
+	// SetKybStatus forces a customer's KYB status, bypassing sandbox auto-approval timing.
+	// Rejection reasons may only be given with the rejected status.
+	// Only available in non-production environments.
+	SetKybStatus(
+		ctx context.Context,
+		id svc.CustomerID,
+		status customer.KybStatus,
+		rejectionReasons []string,
+	) (*customer.CustomerResponse, error)
+	// SetExternalAccountStatus forces the review outcome of an external account (e.g., APPROVED or FAILED).
+	// Only available in non-production environments.
+	SetExternalAccountStatus(
+		ctx context.Context,
+		id svc.CustomerID,
+		externalAccountID string,
+		status external_accounts.BankAccountStatus,
+	) (*external_accounts.Resp, error)
+	// SimulateConfirmations advances the confirmation count of a pending crypto deposit.
+	// The deposit completes once its required confirmations are reached.
+	// Only available in non-production environments.
+	SimulateConfirmations(
+		ctx context.Context,
+		id svc.CustomerID,
+		transactionID svc.TransactionID,
+		confirmations uint64,
+	) (*transactions.TransactionResponse, error)
+	// ResetCustomerData removes the customer's sandbox transactions, balances and external accounts
+	// so tests start from a known state. The customer and its KYB status are kept.
+	// Only available in non-production environments.
+	ResetCustomerData(ctx context.Context, id svc.CustomerID) error
+	// Seed populates the customer's sandbox data according to the scenario: funded balances,
+	// additional deposit transactions and an approved external account.
+	// Only available in non-production environments.
+	Seed(ctx context.Context, id svc.CustomerID, scenario *SeedScenario) (*SeedResult, error)
 }
 
 // SimulateDeposit request and response types.
@@ -79,12 +128,21 @@ type (
 		Amount string `json:"amount"`
 		// ReferenceCode is an optional reference code for the simulated deposit, for triggering specific scenarios(like auto conversional rules).
 		ReferenceCode string `json:"reference_code,omitempty"`
+		// RequiredConfirmations is the number of block confirmations the crypto deposit needs before
+		// it completes (optional). While below this count the deposit stays PENDING.
+		RequiredConfirmations uint64 `json:"required_confirmations,omitempty"`
+		// ConfirmationIntervalSeconds is the delay between simulated block confirmations (optional).
+		// When zero, confirmations only advance through SimulateConfirmations.
+		ConfirmationIntervalSeconds int `json:"confirmation_interval_seconds,omitempty"`
+		// Originator is the named counterparty sending the deposit (optional). Set BankName and
+		// AccountNumber for fiat deposits, or WalletAddress for crypto deposits.
+		Originator *transactions.Originator `json:"originator,omitempty"`
 	}
 
 	// SimulateDepositResponse represents the response for a simulated deposit.
 	SimulateDepositResponse struct {
 		// SimulationID is the unique identifier for the simulation.
-		SimulationID string `json:"simulation_id"`
+		SimulationID svc.TransactionID `json:"simulation_id"`
 		// Status is the transaction status (SUCCESS or REVERSED for simulated deposits).
 		Status transactions.TransactionStatus `json:"status"`
 		// CreatedAt is the transaction creation timestamp.
@@ -94,6 +152,49 @@ type (
 	}
 )
 
+// SimulateWithdrawalStatus request and response types.
+type (
+	// SimulateWithdrawalStatusRequest represents the request body for simulating a withdrawal outcome.
+	SimulateWithdrawalStatusRequest struct {
+		// Status is the target outcome of the withdrawal.
+		Status WithdrawalSimulationStatus `json:"status"`
+		// Reason is the ACH return code for RETURNED, or a failure reason for FAILED (optional).
+		Reason string `json:"reason,omitempty"`
+	}
+
+	// SimulateWithdrawalStatusResponse represents the response for a simulated withdrawal outcome.
+	SimulateWithdrawalStatusResponse struct {
+		// TransactionID is the ID of the withdrawal transaction.
+		TransactionID svc.TransactionID `json:"transaction_id"`
+		// Status is the resulting transaction status (COMPLETED, REVERSED or FAILED).
+		Status transactions.TransactionStatus `json:"status"`
+		// Reason is the return code or failure reason recorded on the withdrawal.
+		Reason string `json:"reason,omitempty"`
+		// ModifiedAt is the transaction last modification timestamp.
+		ModifiedAt string `json:"modified_at"`
+	}
+)
+
+// SetKybStatusRequest represents the request body for simulating a KYB status transition.
+type SetKybStatusRequest struct {
+	// Status is the target KYB status.
+	Status customer.KybStatus `json:"status"`
+	// RejectionReasons are the reasons shown to the customer when the status is rejected (optional).
+	RejectionReasons []string `json:"rejection_reasons,omitempty"`
+}
+
+// SetExternalAccountStatusRequest represents the request body for simulating an external account review outcome.
+type SetExternalAccountStatusRequest struct {
+	// Status is the target external account status.
+	Status external_accounts.BankAccountStatus `json:"status"`
+}
+
+// SimulateConfirmationsRequest represents the request body for advancing deposit confirmations.
+type SimulateConfirmationsRequest struct {
+	// Confirmations is the number of confirmations to add.
+	Confirmations uint64 `json:"confirmations"`
+}
+
 type serviceImpl struct {
 	*svc.BaseService
 }
@@ -111,6 +212,88 @@ func (s *serviceImpl) SimulateDeposit(
 	id svc.CustomerID,
 	req *SimulateDepositRequest,
 ) (*SimulateDepositResponse, error) {
+	if err := req.validateOriginator(); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("/v1/customers/%s/simulate-transactions", id)
 	return svc.PostJSON[SimulateDepositRequest, SimulateDepositResponse](ctx, s.BaseService, path, *req)
 }
+
+// SimulateWithdrawalStatus drives a pending withdrawal to SETTLED, RETURNED or FAILED.
+func (s *serviceImpl) SimulateWithdrawalStatus(
+	ctx context.Context,
+	id svc.CustomerID,
+	transactionID svc.TransactionID,
+	targetStatus WithdrawalSimulationStatus,
+	reason string,
+) (*SimulateWithdrawalStatusResponse, error) {
+	if !targetStatus.IsValid() {
+		return nil, fmt.Errorf("invalid withdrawal simulation status %q", targetStatus)
+	}
+	if targetStatus == WithdrawalSimulationStatusRETURNED && reason == "" {
+		return nil, errors.New("an ACH return code is required to simulate a returned withdrawal")
+	}
+
+	path := fmt.Sprintf("/v1/customers/%s/simulate-withdrawals/%s", id, transactionID)
+	req := &SimulateWithdrawalStatusRequest{Status: targetStatus, Reason: reason}
+	return svc.PostJSON[*SimulateWithdrawalStatusRequest, SimulateWithdrawalStatusResponse](ctx, s.BaseService, path, req)
+}
+
+// SetKybStatus forces a customer's KYB status, bypassing sandbox auto-approval timing.
+func (s *serviceImpl) SetKybStatus(
+	ctx context.Context,
+	id svc.CustomerID,
+	status customer.KybStatus,
+	rejectionReasons []string,
+) (*customer.CustomerResponse, error) {
+	if !status.IsValid() {
+		return nil, fmt.Errorf("invalid KYB status %q", status)
+	}
+	if len(rejectionReasons) > 0 && status != customer.KybStatusRejected {
+		return nil, fmt.Errorf("rejection reasons are only allowed with status %s", customer.KybStatusRejected)
+	}
+
+	path := fmt.Sprintf("/v1/customers/%s/simulate-kyb-status", id)
+	req := &SetKybStatusRequest{Status: status, RejectionReasons: rejectionReasons}
+	return svc.PostJSON[*SetKybStatusRequest, customer.CustomerResponse](ctx, s.BaseService, path, req)
+}
+
+// SetExternalAccountStatus forces the review outcome of an external account.
+func (s *serviceImpl) SetExternalAccountStatus(
+	ctx context.Context,
+	id svc.CustomerID,
+	externalAccountID string,
+	status external_accounts.BankAccountStatus,
+) (*external_accounts.Resp, error) {
+	if !status.IsValid() {
+		return nil, fmt.Errorf("invalid external account status %q", status)
+	}
+
+	path := fmt.Sprintf("/v1/customers/%s/simulate-external-accounts/%s", id, externalAccountID)
+	req := &SetExternalAccountStatusRequest{Status: status}
+	return svc.PostJSON[*SetExternalAccountStatusRequest, external_accounts.Resp](ctx, s.BaseService, path, req)
+}
+
+// SimulateConfirmations advances the confirmation count of a pending crypto deposit.
+func (s *serviceImpl) SimulateConfirmations(
+	ctx context.Context,
+	id svc.CustomerID,
+	transactionID svc.TransactionID,
+	confirmations uint64,
+) (*transactions.TransactionResponse, error) {
+	if confirmations == 0 {
+		return nil, errors.New("confirmations must be greater than zero")
+	}
+
+	path := fmt.Sprintf("/v1/customers/%s/simulate-transactions/%s/confirmations", id, transactionID)
+	req := &SimulateConfirmationsRequest{Confirmations: confirmations}
+	return svc.PostJSON[*SimulateConfirmationsRequest, transactions.TransactionResponse](ctx, s.BaseService, path, req)
+}
+
+// ResetCustomerData removes the customer's sandbox transactions, balances and external accounts.
+func (s *serviceImpl) ResetCustomerData(ctx context.Context, id svc.CustomerID) error {
+	path := fmt.Sprintf("/v1/customers/%s/simulate-reset", id)
+	_, err := svc.PostJSON[any, any](ctx, s.BaseService, path, nil)
+	return err
+}
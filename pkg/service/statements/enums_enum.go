@@ -0,0 +1,166 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: v0.9.2
+
+// Built By: go install
+
+package statements
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// StatementFormatPDF is a StatementFormat of type PDF.
+	StatementFormatPDF StatementFormat = "PDF"
+	// StatementFormatCSV is a StatementFormat of type CSV.
+	StatementFormatCSV StatementFormat = "CSV"
+)
+
+var ErrInvalidStatementFormat = fmt.Errorf("not a valid StatementFormat, try [%s]", strings.Join(_StatementFormatNames, ", "))
+
+var _StatementFormatNames = []string{
+	string(StatementFormatPDF),
+	string(StatementFormatCSV),
+}
+
+// StatementFormatNames returns a list of possible string values of StatementFormat.
+func StatementFormatNames() []string {
+	tmp := make([]string, len(_StatementFormatNames))
+	copy(tmp, _StatementFormatNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x StatementFormat) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x StatementFormat) IsValid() bool {
+	_, err := ParseStatementFormat(string(x))
+	return err == nil
+}
+
+var _StatementFormatValue = map[string]StatementFormat{
+	"PDF": StatementFormatPDF,
+	"pdf": StatementFormatPDF,
+	"CSV": StatementFormatCSV,
+	"csv": StatementFormatCSV,
+}
+
+// ParseStatementFormat attempts to convert a string to a StatementFormat.
+func ParseStatementFormat(name string) (StatementFormat, error) {
+	if x, ok := _StatementFormatValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _StatementFormatValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return StatementFormat(""), fmt.Errorf("%s is %w", name, ErrInvalidStatementFormat)
+}
+
+// MarshalText implements the text marshaller method.
+func (x StatementFormat) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *StatementFormat) UnmarshalText(text []byte) error {
+	tmp, err := ParseStatementFormat(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *StatementFormat) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+const (
+	// StatementStatusPENDING is a StatementStatus of type PENDING.
+	StatementStatusPENDING StatementStatus = "PENDING"
+	// StatementStatusREADY is a StatementStatus of type READY.
+	StatementStatusREADY StatementStatus = "READY"
+	// StatementStatusFAILED is a StatementStatus of type FAILED.
+	StatementStatusFAILED StatementStatus = "FAILED"
+)
+
+var ErrInvalidStatementStatus = fmt.Errorf("not a valid StatementStatus, try [%s]", strings.Join(_StatementStatusNames, ", "))
+
+var _StatementStatusNames = []string{
+	string(StatementStatusPENDING),
+	string(StatementStatusREADY),
+	string(StatementStatusFAILED),
+}
+
+// StatementStatusNames returns a list of possible string values of StatementStatus.
+func StatementStatusNames() []string {
+	tmp := make([]string, len(_StatementStatusNames))
+	copy(tmp, _StatementStatusNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x StatementStatus) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x StatementStatus) IsValid() bool {
+	_, err := ParseStatementStatus(string(x))
+	return err == nil
+}
+
+var _StatementStatusValue = map[string]StatementStatus{
+	"PENDING": StatementStatusPENDING,
+	"pending": StatementStatusPENDING,
+	"READY":   StatementStatusREADY,
+	"ready":   StatementStatusREADY,
+	"FAILED":  StatementStatusFAILED,
+	"failed":  StatementStatusFAILED,
+}
+
+// ParseStatementStatus attempts to convert a string to a StatementStatus.
+func ParseStatementStatus(name string) (StatementStatus, error) {
+	if x, ok := _StatementStatusValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _StatementStatusValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return StatementStatus(""), fmt.Errorf("%s is %w", name, ErrInvalidStatementStatus)
+}
+
+// MarshalText implements the text marshaller method.
+func (x StatementStatus) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *StatementStatus) UnmarshalText(text []byte) error {
+	tmp, err := ParseStatementStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *StatementStatus) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
@@ -0,0 +1,58 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package statements
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+)
+
+// periodLayout is the time layout of statement periods.
+const periodLayout = "2006-01"
+
+// ErrInvalidPeriod is returned when a statement period is not a valid "YYYY-MM" month.
+var ErrInvalidPeriod = errors.New("invalid statement period")
+
+// Period returns the statement period ("YYYY-MM") containing t, in UTC.
+func Period(t time.Time) string {
+	return t.UTC().Format(periodLayout)
+}
+
+// ValidatePeriod checks that period is a "YYYY-MM" month that is not in the future.
+func ValidatePeriod(period string) error {
+	month, err := time.Parse(periodLayout, period)
+	if err != nil {
+		return fmt.Errorf("%w: %q, expected YYYY-MM", ErrInvalidPeriod, period)
+	}
+	if month.After(time.Now().UTC()) {
+		return fmt.Errorf("%w: %q is in the future", ErrInvalidPeriod, period)
+	}
+	return nil
+}
+
+// GeneratedTime parses GeneratedAt into a time.Time, returning the zero time if it is not set.
+func (r *StatementResponse) GeneratedTime() (time.Time, error) {
+	return svc.ParseOptionalTimestamp(&r.GeneratedAt)
+}
+
+// CreatedTime parses CreatedAt into a time.Time.
+func (r *StatementResponse) CreatedTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.CreatedAt)
+}
@@ -0,0 +1,58 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package statements
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestValidatePeriod(t *testing.T) {
+	tests := []struct {
+		name    string
+		period  string
+		wantErr bool
+	}{
+		{"valid month", "2025-01", false},
+		{"current month", Period(time.Now()), false},
+		{"future month", Period(time.Now().AddDate(0, 2, 0)), true},
+		{"missing leading zero", "2025-1", true},
+		{"invalid month", "2025-13", true},
+		{"full date", "2025-01-31", true},
+		{"empty", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePeriod(tt.period)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidatePeriod(%q) error = %v, wantErr %v", tt.period, err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidPeriod) {
+				t.Errorf("ValidatePeriod(%q) error = %v, want ErrInvalidPeriod", tt.period, err)
+			}
+		})
+	}
+}
+
+func TestPeriod(t *testing.T) {
+	got := Period(time.Date(2025, 3, 31, 23, 0, 0, 0, time.FixedZone("UTC-5", -5*3600)))
+	if got != "2025-04" {
+		t.Errorf("Period() = %q, want %q", got, "2025-04")
+	}
+}
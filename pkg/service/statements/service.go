@@ -0,0 +1,191 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package statements provides monthly account statements for customer accounts.
+//
+// This package implements the statements service client for the 1Money platform,
+// enabling finance teams to list, generate and download official monthly statements
+// in PDF or CSV format.
+//
+// # Basic Usage
+//
+//	import (
+//	    "context"
+//	    onemoney "github.com/1Money-Co/1money-go-sdk/pkg/onemoney"
+//	    "github.com/1Money-Co/1money-go-sdk/pkg/service/statements"
+//	)
+//
+//	// Create client
+//	client, err := onemoney.NewClient(&onemoney.Config{
+//	    AccessKey: "your-access-key",
+//	    SecretKey: "your-secret-key",
+//	})
+//
+//	// List available statements
+//	list, err := client.Statements.ListStatements(ctx, "customer-id")
+//
+//	// Download the statement for January 2025 as CSV
+//	doc, err := client.Statements.DownloadStatement(ctx, "customer-id", "2025-01", statements.StatementFormatCSV)
+package statements
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/1Money-Co/1money-go-sdk/internal/transport"
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+)
+
+// Service defines the statements service interface for managing customer statements.
+type Service interface {
+	// ListStatements retrieves the statements available for a customer, newest first.
+	ListStatements(ctx context.Context, id svc.CustomerID) ([]StatementResponse, error)
+	// GenerateStatement requests generation of the statement for a period. Generation is
+	// asynchronous: the statement is returned as PENDING and becomes READY once available.
+	GenerateStatement(ctx context.Context, id svc.CustomerID, req *GenerateStatementRequest) (*StatementResponse, error)
+	// DownloadStatement downloads the statement for a period ("YYYY-MM") in the given format.
+	DownloadStatement(
+		ctx context.Context, id svc.CustomerID, period string, format StatementFormat,
+	) (*StatementDocument, error)
+}
+
+// Statement request and response types.
+type (
+	// GenerateStatementRequest represents the request body for generating a statement.
+	GenerateStatementRequest struct {
+		// IdempotencyKey is a unique key to ensure idempotent generation.
+		// This is sent as a header, not in the body.
+		IdempotencyKey string `json:"-"`
+		// Period is the statement month in "YYYY-MM" format.
+		Period string `json:"period"`
+	}
+
+	// StatementResponse represents a customer statement.
+	StatementResponse struct {
+		// StatementID is the unique identifier of the statement.
+		StatementID string `json:"statement_id"`
+		// CustomerID is the ID of the customer the statement belongs to.
+		CustomerID svc.CustomerID `json:"customer_id"`
+		// Period is the statement month in "YYYY-MM" format.
+		Period string `json:"period"`
+		// Status is the generation status of the statement.
+		Status StatementStatus `json:"status"`
+		// Formats are the formats the statement can be downloaded in.
+		Formats []StatementFormat `json:"formats"`
+		// GeneratedAt is the timestamp when the statement became available (ISO 8601 format).
+		GeneratedAt string `json:"generated_at,omitempty"`
+		// CreatedAt is the timestamp when the statement was requested (ISO 8601 format).
+		CreatedAt string `json:"created_at"`
+	}
+
+	// StatementDocument represents a downloaded statement.
+	StatementDocument struct {
+		// Period is the statement month in "YYYY-MM" format.
+		Period string
+		// Format is the format of the document.
+		Format StatementFormat
+		// ContentType is the MIME type returned by the server.
+		ContentType string
+		// Content is the raw statement document.
+		Content []byte
+	}
+)
+
+type serviceImpl struct {
+	*svc.BaseService
+}
+
+// NewService creates a new statements service instance with the given base service.
+func NewService(base *svc.BaseService) Service {
+	return &serviceImpl{
+		BaseService: base,
+	}
+}
+
+// ListStatements retrieves the statements available for a customer.
+func (s *serviceImpl) ListStatements(ctx context.Context, id svc.CustomerID) ([]StatementResponse, error) {
+	path := fmt.Sprintf("/v1/customers/%s/statements/list", id)
+	result, err := svc.GetJSON[[]StatementResponse](ctx, s.BaseService, path)
+	if err != nil {
+		return nil, err
+	}
+	return *result, nil
+}
+
+// GenerateStatement requests generation of the statement for a period.
+func (s *serviceImpl) GenerateStatement(
+	ctx context.Context,
+	id svc.CustomerID,
+	req *GenerateStatementRequest,
+) (*StatementResponse, error) {
+	if req == nil {
+		return nil, errors.New("generate statement request is required")
+	}
+	if err := ValidatePeriod(req.Period); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/v1/customers/%s/statements", id)
+
+	headers := make(map[string]string)
+	if req.IdempotencyKey != "" {
+		headers["Idempotency-Key"] = req.IdempotencyKey
+	}
+
+	return svc.PostJSONWithHeaders[*GenerateStatementRequest, StatementResponse](
+		ctx, s.BaseService, path, req, headers,
+	)
+}
+
+// DownloadStatement downloads the statement for a period in the given format.
+func (s *serviceImpl) DownloadStatement(
+	ctx context.Context,
+	id svc.CustomerID,
+	period string,
+	format StatementFormat,
+) (*StatementDocument, error) {
+	if err := ValidatePeriod(period); err != nil {
+		return nil, err
+	}
+	accept, ok := map[StatementFormat]string{
+		StatementFormatPDF: "application/pdf",
+		StatementFormatCSV: "text/csv",
+	}[format]
+	if !ok {
+		return nil, fmt.Errorf("unsupported statement format: %q", format)
+	}
+
+	path := fmt.Sprintf("/v1/customers/%s/statements/%s/download", id, period)
+	resp, err := s.Do(ctx, &transport.Request{
+		Method:      http.MethodGet,
+		Path:        path,
+		Headers:     map[string]string{"Accept": accept},
+		QueryParams: map[string]string{"format": strings.ToLower(format.String())},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &StatementDocument{
+		Period:      period,
+		Format:      format,
+		ContentType: resp.Headers.Get("Content-Type"),
+		Content:     resp.Body,
+	}, nil
+}
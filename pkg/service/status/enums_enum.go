@@ -0,0 +1,176 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: v0.9.2
+
+// Built By: go install
+
+package status
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// RailStateOPERATIONAL is a RailState of type OPERATIONAL.
+	RailStateOPERATIONAL RailState = "OPERATIONAL"
+	// RailStateDEGRADED is a RailState of type DEGRADED.
+	RailStateDEGRADED RailState = "DEGRADED"
+	// RailStatePARTIALOUTAGE is a RailState of type PARTIAL_OUTAGE.
+	RailStatePARTIALOUTAGE RailState = "PARTIAL_OUTAGE"
+	// RailStateMAJOROUTAGE is a RailState of type MAJOR_OUTAGE.
+	RailStateMAJOROUTAGE RailState = "MAJOR_OUTAGE"
+	// RailStateMAINTENANCE is a RailState of type MAINTENANCE.
+	RailStateMAINTENANCE RailState = "MAINTENANCE"
+)
+
+var ErrInvalidRailState = fmt.Errorf("not a valid RailState, try [%s]", strings.Join(_RailStateNames, ", "))
+
+var _RailStateNames = []string{
+	string(RailStateOPERATIONAL),
+	string(RailStateDEGRADED),
+	string(RailStatePARTIALOUTAGE),
+	string(RailStateMAJOROUTAGE),
+	string(RailStateMAINTENANCE),
+}
+
+// RailStateNames returns a list of possible string values of RailState.
+func RailStateNames() []string {
+	tmp := make([]string, len(_RailStateNames))
+	copy(tmp, _RailStateNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x RailState) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x RailState) IsValid() bool {
+	_, err := ParseRailState(string(x))
+	return err == nil
+}
+
+var _RailStateValue = map[string]RailState{
+	"OPERATIONAL":    RailStateOPERATIONAL,
+	"operational":    RailStateOPERATIONAL,
+	"DEGRADED":       RailStateDEGRADED,
+	"degraded":       RailStateDEGRADED,
+	"PARTIAL_OUTAGE": RailStatePARTIALOUTAGE,
+	"partial_outage": RailStatePARTIALOUTAGE,
+	"MAJOR_OUTAGE":   RailStateMAJOROUTAGE,
+	"major_outage":   RailStateMAJOROUTAGE,
+	"MAINTENANCE":    RailStateMAINTENANCE,
+	"maintenance":    RailStateMAINTENANCE,
+}
+
+// ParseRailState attempts to convert a string to a RailState.
+func ParseRailState(name string) (RailState, error) {
+	if x, ok := _RailStateValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _RailStateValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return RailState(""), fmt.Errorf("%s is %w", name, ErrInvalidRailState)
+}
+
+// MarshalText implements the text marshaller method.
+func (x RailState) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *RailState) UnmarshalText(text []byte) error {
+	tmp, err := ParseRailState(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *RailState) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+const (
+	// SourceAPI is a Source of type API.
+	SourceAPI Source = "API"
+	// SourceSTATUSPAGE is a Source of type STATUS_PAGE.
+	SourceSTATUSPAGE Source = "STATUS_PAGE"
+)
+
+var ErrInvalidSource = fmt.Errorf("not a valid Source, try [%s]", strings.Join(_SourceNames, ", "))
+
+var _SourceNames = []string{
+	string(SourceAPI),
+	string(SourceSTATUSPAGE),
+}
+
+// SourceNames returns a list of possible string values of Source.
+func SourceNames() []string {
+	tmp := make([]string, len(_SourceNames))
+	copy(tmp, _SourceNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x Source) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Source) IsValid() bool {
+	_, err := ParseSource(string(x))
+	return err == nil
+}
+
+var _SourceValue = map[string]Source{
+	"API":         SourceAPI,
+	"api":         SourceAPI,
+	"STATUS_PAGE": SourceSTATUSPAGE,
+	"status_page": SourceSTATUSPAGE,
+}
+
+// ParseSource attempts to convert a string to a Source.
+func ParseSource(name string) (Source, error) {
+	if x, ok := _SourceValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _SourceValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return Source(""), fmt.Errorf("%s is %w", name, ErrInvalidSource)
+}
+
+// MarshalText implements the text marshaller method.
+func (x Source) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *Source) UnmarshalText(text []byte) error {
+	tmp, err := ParseSource(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *Source) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
@@ -0,0 +1,162 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package status
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+)
+
+// ErrRailUnavailable is returned by CheckRail when a rail is in a major outage or under maintenance.
+var ErrRailUnavailable = errors.New("rail unavailable")
+
+// IsAvailable reports whether the rail is accepting transactions, possibly with delays.
+func (r *RailStatus) IsAvailable() bool {
+	return r.State != RailStateMAJOROUTAGE && r.State != RailStateMAINTENANCE
+}
+
+// Rail returns the status of the rail for the network.
+func (r *StatusResponse) Rail(network assets.NetworkName) (*RailStatus, bool) {
+	for i := range r.Rails {
+		if strings.EqualFold(r.Rails[i].Network, string(network)) {
+			return &r.Rails[i], true
+		}
+	}
+	return nil, false
+}
+
+// CheckRail returns an error wrapping ErrRailUnavailable if the rail for the network is unavailable.
+// Rails missing from the report are assumed to be available.
+func (r *StatusResponse) CheckRail(network assets.NetworkName) error {
+	rail, ok := r.Rail(network)
+	if !ok || rail.IsAvailable() {
+		return nil
+	}
+	if rail.Message != "" {
+		return fmt.Errorf("%w: %s is %s: %s", ErrRailUnavailable, network, rail.State, rail.Message)
+	}
+	return fmt.Errorf("%w: %s is %s", ErrRailUnavailable, network, rail.State)
+}
+
+// statusPage is the subset of a status page summary document used to build a StatusResponse.
+type statusPage struct {
+	Page struct {
+		UpdatedAt string `json:"updated_at"`
+	} `json:"page"`
+	Status struct {
+		Indicator string `json:"indicator"`
+	} `json:"status"`
+	Components []struct {
+		Name        string  `json:"name"`
+		Status      string  `json:"status"`
+		Description *string `json:"description"`
+		UpdatedAt   string  `json:"updated_at"`
+		Group       bool    `json:"group"`
+	} `json:"components"`
+}
+
+// componentStates maps status page component statuses to rail states.
+var componentStates = map[string]RailState{
+	"operational":          RailStateOPERATIONAL,
+	"degraded_performance": RailStateDEGRADED,
+	"partial_outage":       RailStatePARTIALOUTAGE,
+	"major_outage":         RailStateMAJOROUTAGE,
+	"under_maintenance":    RailStateMAINTENANCE,
+}
+
+// indicatorStates maps the status page overall indicator to a platform state.
+var indicatorStates = map[string]RailState{
+	"none":        RailStateOPERATIONAL,
+	"minor":       RailStateDEGRADED,
+	"major":       RailStatePARTIALOUTAGE,
+	"critical":    RailStateMAJOROUTAGE,
+	"maintenance": RailStateMAINTENANCE,
+}
+
+// railAliases maps status page component names that differ from network names.
+var railAliases = map[string]assets.NetworkName{
+	"ACH":       assets.NetworkNameUSACH,
+	"WIRE":      assets.NetworkNameUSFEDWIRE,
+	"WIRES":     assets.NetworkNameUSFEDWIRE,
+	"FEDWIRE":   assets.NetworkNameUSFEDWIRE,
+	"BNB":       assets.NetworkNameBNBCHAIN,
+	"BNB_CHAIN": assets.NetworkNameBNBCHAIN,
+}
+
+// parseStatusPage builds a StatusResponse from a status page summary document.
+// Components that are not a known network, and component groups, are skipped.
+// Unknown component statuses are reported as DEGRADED.
+func parseStatusPage(body []byte) (*StatusResponse, error) {
+	var page statusPage
+	if err := json.Unmarshal(body, &page); err != nil {
+		return nil, fmt.Errorf("failed to parse status page: %w", err)
+	}
+
+	state, ok := indicatorStates[page.Status.Indicator]
+	if !ok {
+		return nil, fmt.Errorf("unknown status page indicator %q", page.Status.Indicator)
+	}
+
+	resp := &StatusResponse{
+		State:     state,
+		Rails:     []RailStatus{},
+		UpdatedAt: page.Page.UpdatedAt,
+		Source:    SourceSTATUSPAGE,
+	}
+	for _, c := range page.Components {
+		network, ok := railNetwork(c.Name)
+		if c.Group || !ok {
+			continue
+		}
+		state, ok := componentStates[c.Status]
+		if !ok {
+			state = RailStateDEGRADED
+		}
+		rail := RailStatus{Network: string(network), State: state, UpdatedAt: c.UpdatedAt}
+		if c.Description != nil {
+			rail.Message = *c.Description
+		}
+		resp.Rails = append(resp.Rails, rail)
+	}
+	return resp, nil
+}
+
+// railNetwork resolves a status page component name such as "US ACH" or "Ethereum" to a network.
+func railNetwork(name string) (assets.NetworkName, bool) {
+	normalized := strings.ToUpper(strings.NewReplacer(" ", "_", "-", "_").Replace(strings.TrimSpace(name)))
+	if network, ok := railAliases[normalized]; ok {
+		return network, true
+	}
+	network := assets.NetworkName(normalized)
+	return network, network.IsValid()
+}
+
+// UpdatedTime parses UpdatedAt into a time.Time, returning the zero time if it is not set.
+func (r *RailStatus) UpdatedTime() (time.Time, error) {
+	return svc.ParseOptionalTimestamp(&r.UpdatedAt)
+}
+
+// UpdatedTime parses UpdatedAt into a time.Time, returning the zero time if it is not set.
+func (r *StatusResponse) UpdatedTime() (time.Time, error) {
+	return svc.ParseOptionalTimestamp(&r.UpdatedAt)
+}
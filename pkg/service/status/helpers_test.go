@@ -0,0 +1,119 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package status
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+)
+
+const summaryJSON = `{
+  "page": {"updated_at": "2025-06-02T14:00:00Z"},
+  "status": {"indicator": "major", "description": "Partial System Outage"},
+  "components": [
+    {"name": "Payment Rails", "status": "major_outage", "group": true},
+    {"name": "ACH", "status": "operational", "updated_at": "2025-06-02T13:00:00Z"},
+    {"name": "US Fedwire", "status": "under_maintenance", "description": "Fed holiday"},
+    {"name": "Ethereum", "status": "major_outage"},
+    {"name": "Polygon", "status": "degraded_performance"},
+    {"name": "Dashboard", "status": "operational"}
+  ]
+}`
+
+func TestParseStatusPage(t *testing.T) {
+	resp, err := parseStatusPage([]byte(summaryJSON))
+	if err != nil {
+		t.Fatalf("parseStatusPage() error = %v", err)
+	}
+	if resp.State != RailStatePARTIALOUTAGE || resp.Source != SourceSTATUSPAGE {
+		t.Errorf("got state %s from %s, want PARTIAL_OUTAGE from STATUS_PAGE", resp.State, resp.Source)
+	}
+
+	want := map[string]RailState{
+		"US_ACH":     RailStateOPERATIONAL,
+		"US_FEDWIRE": RailStateMAINTENANCE,
+		"ETHEREUM":   RailStateMAJOROUTAGE,
+		"POLYGON":    RailStateDEGRADED,
+	}
+	if len(resp.Rails) != len(want) {
+		t.Fatalf("got %d rails, want %d: %+v", len(resp.Rails), len(want), resp.Rails)
+	}
+	for _, rail := range resp.Rails {
+		if want[rail.Network] != rail.State {
+			t.Errorf("rail %s state = %s, want %s", rail.Network, rail.State, want[rail.Network])
+		}
+	}
+
+	if _, err := parseStatusPage([]byte(`{"status": {"indicator": "unknown"}}`)); err == nil {
+		t.Error("parseStatusPage() with unknown indicator should fail")
+	}
+}
+
+func TestStatusResponse_CheckRail(t *testing.T) {
+	resp, err := parseStatusPage([]byte(summaryJSON))
+	if err != nil {
+		t.Fatalf("parseStatusPage() error = %v", err)
+	}
+
+	tests := []struct {
+		network assets.NetworkName
+		wantErr bool
+	}{
+		{assets.NetworkNameUSACH, false},
+		{assets.NetworkNamePOLYGON, false},
+		{assets.NetworkNameUSFEDWIRE, true},
+		{assets.NetworkNameETHEREUM, true},
+		{assets.NetworkNameSOLANA, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.network), func(t *testing.T) {
+			err := resp.CheckRail(tt.network)
+			if tt.wantErr != errors.Is(err, ErrRailUnavailable) {
+				t.Errorf("CheckRail() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetStatusPage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(summaryJSON))
+	}))
+	defer server.Close()
+
+	s := &serviceImpl{statusPageURL: server.URL, httpClient: server.Client()}
+	resp, err := s.getStatusPage(context.Background())
+	if err != nil {
+		t.Fatalf("getStatusPage() error = %v", err)
+	}
+	if len(resp.Rails) != 4 {
+		t.Errorf("got %d rails, want 4", len(resp.Rails))
+	}
+
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	if _, err := s.getStatusPage(context.Background()); err == nil {
+		t.Error("getStatusPage() with 503 should fail")
+	}
+}
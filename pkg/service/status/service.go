@@ -0,0 +1,163 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package status provides platform health and per-rail availability.
+//
+// This package implements the status service client for the 1Money platform.
+// It reads the platform status endpoint and, if that is unreachable, falls back to the
+// public status page, so applications can stop submitting withdrawals on a rail that is down.
+//
+// # Basic Usage
+//
+//	import (
+//	    "context"
+//	    onemoney "github.com/1Money-Co/1money-go-sdk/pkg/onemoney"
+//	    "github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+//	)
+//
+//	// Create client
+//	client, err := onemoney.NewClient(&onemoney.Config{
+//	    AccessKey: "your-access-key",
+//	    SecretKey: "your-secret-key",
+//	})
+//
+//	// Skip ACH withdrawals while the rail is down
+//	report, err := client.Status.Get(ctx)
+//	if err := report.CheckRail(assets.NetworkNameUSACH); err != nil {
+//	    return err // wraps status.ErrRailUnavailable
+//	}
+package status
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+)
+
+// DefaultStatusPageURL is the public status page summary used when the status endpoint is unreachable.
+const DefaultStatusPageURL = "https://status.1money.com/api/v2/summary.json"
+
+// statusPageTimeout bounds the status page request when no HTTP client is provided.
+const statusPageTimeout = 10 * time.Second
+
+// Service defines the status service interface for checking platform and rail availability.
+type Service interface {
+	// Get retrieves the platform status and the availability of each rail.
+	// If the status endpoint fails, the public status page is read instead.
+	Get(ctx context.Context) (*StatusResponse, error)
+}
+
+// Status response types.
+type (
+	// RailStatus represents the availability of a single payment rail.
+	RailStatus struct {
+		// Network is the network name of the rail, e.g. US_ACH, US_FEDWIRE, ETHEREUM.
+		Network string `json:"network"`
+		// State is the current availability of the rail.
+		State RailState `json:"state"`
+		// Message describes an ongoing incident or maintenance (optional).
+		Message string `json:"message,omitempty"`
+		// UpdatedAt is when the state last changed (ISO 8601 format).
+		UpdatedAt string `json:"updated_at,omitempty"`
+	}
+
+	// StatusResponse represents the platform status.
+	StatusResponse struct {
+		// State is the overall platform state.
+		State RailState `json:"state"`
+		// Rails is the availability of each rail.
+		Rails []RailStatus `json:"rails"`
+		// UpdatedAt is when the report was produced (ISO 8601 format).
+		UpdatedAt string `json:"updated_at,omitempty"`
+		// Source is where the report was read from.
+		Source Source `json:"-"`
+	}
+)
+
+type serviceImpl struct {
+	*svc.BaseService
+	statusPageURL string
+	httpClient    *http.Client
+}
+
+// NewService creates a new status service instance with the given base service.
+// It falls back to DefaultStatusPageURL.
+func NewService(base *svc.BaseService) Service {
+	return NewServiceWithStatusPage(base, "", nil)
+}
+
+// NewServiceWithStatusPage creates a new status service that falls back to the given status page URL,
+// fetched with httpClient. An empty URL uses DefaultStatusPageURL and a nil client uses a default client.
+func NewServiceWithStatusPage(base *svc.BaseService, statusPageURL string, httpClient *http.Client) Service {
+	if statusPageURL == "" {
+		statusPageURL = DefaultStatusPageURL
+	}
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: statusPageTimeout}
+	}
+	return &serviceImpl{
+		BaseService:   base,
+		statusPageURL: statusPageURL,
+		httpClient:    httpClient,
+	}
+}
+
+// Get retrieves the platform status, falling back to the public status page.
+func (s *serviceImpl) Get(ctx context.Context) (*StatusResponse, error) {
+	resp, apiErr := svc.GetJSON[StatusResponse](ctx, s.BaseService, "/v1/status")
+	if apiErr == nil {
+		resp.Source = SourceAPI
+		return resp, nil
+	}
+
+	resp, pageErr := s.getStatusPage(ctx)
+	if pageErr != nil {
+		return nil, errors.Join(
+			fmt.Errorf("status endpoint: %w", apiErr),
+			fmt.Errorf("status page: %w", pageErr),
+		)
+	}
+	return resp, nil
+}
+
+// getStatusPage fetches and parses the public status page.
+func (s *serviceImpl) getStatusPage(ctx context.Context) (*StatusResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.statusPageURL, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return parseStatusPage(body)
+}
@@ -0,0 +1,33 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sweep_rules
+
+//go:generate go tool go-enum -f=$GOFILE --marshal --names --nocase
+
+// RuleStatus represents the status of a sweep rule.
+// PAUSED rules are kept but not executed until resumed.
+// ENUM(ACTIVE, PAUSED, DELETED)
+type RuleStatus string
+
+// Frequency represents how often a sweep rule is evaluated.
+// ENUM(DAILY, WEEKLY, MONTHLY)
+type Frequency string
+
+// ExecutionStatus represents the outcome of a sweep rule execution.
+// SKIPPED executions found no excess above the target balance, or less than the minimum sweep amount.
+// ENUM(PENDING, COMPLETED, SKIPPED, FAILED)
+type ExecutionStatus string
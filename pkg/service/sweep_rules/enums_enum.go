@@ -0,0 +1,256 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: v0.9.2
+
+// Built By: go install
+
+package sweep_rules
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// ExecutionStatusPENDING is a ExecutionStatus of type PENDING.
+	ExecutionStatusPENDING ExecutionStatus = "PENDING"
+	// ExecutionStatusCOMPLETED is a ExecutionStatus of type COMPLETED.
+	ExecutionStatusCOMPLETED ExecutionStatus = "COMPLETED"
+	// ExecutionStatusSKIPPED is a ExecutionStatus of type SKIPPED.
+	ExecutionStatusSKIPPED ExecutionStatus = "SKIPPED"
+	// ExecutionStatusFAILED is a ExecutionStatus of type FAILED.
+	ExecutionStatusFAILED ExecutionStatus = "FAILED"
+)
+
+var ErrInvalidExecutionStatus = fmt.Errorf("not a valid ExecutionStatus, try [%s]", strings.Join(_ExecutionStatusNames, ", "))
+
+var _ExecutionStatusNames = []string{
+	string(ExecutionStatusPENDING),
+	string(ExecutionStatusCOMPLETED),
+	string(ExecutionStatusSKIPPED),
+	string(ExecutionStatusFAILED),
+}
+
+// ExecutionStatusNames returns a list of possible string values of ExecutionStatus.
+func ExecutionStatusNames() []string {
+	tmp := make([]string, len(_ExecutionStatusNames))
+	copy(tmp, _ExecutionStatusNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x ExecutionStatus) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x ExecutionStatus) IsValid() bool {
+	_, err := ParseExecutionStatus(string(x))
+	return err == nil
+}
+
+var _ExecutionStatusValue = map[string]ExecutionStatus{
+	"PENDING":   ExecutionStatusPENDING,
+	"pending":   ExecutionStatusPENDING,
+	"COMPLETED": ExecutionStatusCOMPLETED,
+	"completed": ExecutionStatusCOMPLETED,
+	"SKIPPED":   ExecutionStatusSKIPPED,
+	"skipped":   ExecutionStatusSKIPPED,
+	"FAILED":    ExecutionStatusFAILED,
+	"failed":    ExecutionStatusFAILED,
+}
+
+// ParseExecutionStatus attempts to convert a string to a ExecutionStatus.
+func ParseExecutionStatus(name string) (ExecutionStatus, error) {
+	if x, ok := _ExecutionStatusValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _ExecutionStatusValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return ExecutionStatus(""), fmt.Errorf("%s is %w", name, ErrInvalidExecutionStatus)
+}
+
+// MarshalText implements the text marshaller method.
+func (x ExecutionStatus) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *ExecutionStatus) UnmarshalText(text []byte) error {
+	tmp, err := ParseExecutionStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *ExecutionStatus) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+const (
+	// FrequencyDAILY is a Frequency of type DAILY.
+	FrequencyDAILY Frequency = "DAILY"
+	// FrequencyWEEKLY is a Frequency of type WEEKLY.
+	FrequencyWEEKLY Frequency = "WEEKLY"
+	// FrequencyMONTHLY is a Frequency of type MONTHLY.
+	FrequencyMONTHLY Frequency = "MONTHLY"
+)
+
+var ErrInvalidFrequency = fmt.Errorf("not a valid Frequency, try [%s]", strings.Join(_FrequencyNames, ", "))
+
+var _FrequencyNames = []string{
+	string(FrequencyDAILY),
+	string(FrequencyWEEKLY),
+	string(FrequencyMONTHLY),
+}
+
+// FrequencyNames returns a list of possible string values of Frequency.
+func FrequencyNames() []string {
+	tmp := make([]string, len(_FrequencyNames))
+	copy(tmp, _FrequencyNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x Frequency) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Frequency) IsValid() bool {
+	_, err := ParseFrequency(string(x))
+	return err == nil
+}
+
+var _FrequencyValue = map[string]Frequency{
+	"DAILY":   FrequencyDAILY,
+	"daily":   FrequencyDAILY,
+	"WEEKLY":  FrequencyWEEKLY,
+	"weekly":  FrequencyWEEKLY,
+	"MONTHLY": FrequencyMONTHLY,
+	"monthly": FrequencyMONTHLY,
+}
+
+// ParseFrequency attempts to convert a string to a Frequency.
+func ParseFrequency(name string) (Frequency, error) {
+	if x, ok := _FrequencyValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _FrequencyValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return Frequency(""), fmt.Errorf("%s is %w", name, ErrInvalidFrequency)
+}
+
+// MarshalText implements the text marshaller method.
+func (x Frequency) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *Frequency) UnmarshalText(text []byte) error {
+	tmp, err := ParseFrequency(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *Frequency) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+const (
+	// RuleStatusACTIVE is a RuleStatus of type ACTIVE.
+	RuleStatusACTIVE RuleStatus = "ACTIVE"
+	// RuleStatusPAUSED is a RuleStatus of type PAUSED.
+	RuleStatusPAUSED RuleStatus = "PAUSED"
+	// RuleStatusDELETED is a RuleStatus of type DELETED.
+	RuleStatusDELETED RuleStatus = "DELETED"
+)
+
+var ErrInvalidRuleStatus = fmt.Errorf("not a valid RuleStatus, try [%s]", strings.Join(_RuleStatusNames, ", "))
+
+var _RuleStatusNames = []string{
+	string(RuleStatusACTIVE),
+	string(RuleStatusPAUSED),
+	string(RuleStatusDELETED),
+}
+
+// RuleStatusNames returns a list of possible string values of RuleStatus.
+func RuleStatusNames() []string {
+	tmp := make([]string, len(_RuleStatusNames))
+	copy(tmp, _RuleStatusNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x RuleStatus) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x RuleStatus) IsValid() bool {
+	_, err := ParseRuleStatus(string(x))
+	return err == nil
+}
+
+var _RuleStatusValue = map[string]RuleStatus{
+	"ACTIVE":  RuleStatusACTIVE,
+	"active":  RuleStatusACTIVE,
+	"PAUSED":  RuleStatusPAUSED,
+	"paused":  RuleStatusPAUSED,
+	"DELETED": RuleStatusDELETED,
+	"deleted": RuleStatusDELETED,
+}
+
+// ParseRuleStatus attempts to convert a string to a RuleStatus.
+func ParseRuleStatus(name string) (RuleStatus, error) {
+	if x, ok := _RuleStatusValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _RuleStatusValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return RuleStatus(""), fmt.Errorf("%s is %w", name, ErrInvalidRuleStatus)
+}
+
+// MarshalText implements the text marshaller method.
+func (x RuleStatus) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *RuleStatus) UnmarshalText(text []byte) error {
+	tmp, err := ParseRuleStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *RuleStatus) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
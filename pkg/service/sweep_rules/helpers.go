@@ -0,0 +1,149 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sweep_rules
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+)
+
+// ErrInvalidRule is returned when a create request has inconsistent fields.
+var ErrInvalidRule = errors.New("invalid sweep rule")
+
+// maxDayOfMonth is the last day a MONTHLY rule can run on, so that it runs every month.
+const maxDayOfMonth = 28
+
+// Validate checks that the request has a non-negative target balance, exactly one destination,
+// and a schedule whose fields match its frequency.
+func (r *CreateRuleRequest) Validate() error {
+	if r == nil {
+		return fmt.Errorf("%w: request is nil", ErrInvalidRule)
+	}
+	if r.Asset == "" || r.Network == "" {
+		return fmt.Errorf("%w: asset and network are required", ErrInvalidRule)
+	}
+	if target, ok := new(big.Rat).SetString(r.TargetBalance); !ok || target.Sign() < 0 {
+		return fmt.Errorf("%w: invalid target_balance %q", ErrInvalidRule, r.TargetBalance)
+	}
+	if r.MinimumSweepAmount != "" {
+		if minimum, ok := new(big.Rat).SetString(r.MinimumSweepAmount); !ok || minimum.Sign() <= 0 {
+			return fmt.Errorf("%w: invalid minimum_sweep_amount %q", ErrInvalidRule, r.MinimumSweepAmount)
+		}
+	}
+	if (r.WalletAddress == "") == (r.ExternalAccountID == "") {
+		return fmt.Errorf("%w: exactly one of wallet_address or external_account_id is required", ErrInvalidRule)
+	}
+	return r.Schedule.Validate()
+}
+
+// Validate checks that the schedule fields match its frequency.
+func (s *Schedule) Validate() error {
+	if _, err := time.Parse("15:04", s.TimeOfDay); err != nil {
+		return fmt.Errorf("%w: invalid time_of_day %q, expected HH:MM", ErrInvalidRule, s.TimeOfDay)
+	}
+
+	switch s.Frequency {
+	case FrequencyDAILY:
+		if s.DayOfWeek != "" || s.DayOfMonth != 0 {
+			return fmt.Errorf("%w: day_of_week and day_of_month cannot be set for DAILY rules", ErrInvalidRule)
+		}
+	case FrequencyWEEKLY:
+		if !s.DayOfWeek.IsValid() {
+			return fmt.Errorf("%w: invalid day_of_week %q", ErrInvalidRule, s.DayOfWeek)
+		}
+		if s.DayOfMonth != 0 {
+			return fmt.Errorf("%w: day_of_month cannot be set for WEEKLY rules", ErrInvalidRule)
+		}
+	case FrequencyMONTHLY:
+		if s.DayOfMonth < 1 || s.DayOfMonth > maxDayOfMonth {
+			return fmt.Errorf("%w: day_of_month must be between 1 and %d", ErrInvalidRule, maxDayOfMonth)
+		}
+		if s.DayOfWeek != "" {
+			return fmt.Errorf("%w: day_of_week cannot be set for MONTHLY rules", ErrInvalidRule)
+		}
+	default:
+		return fmt.Errorf("%w: invalid frequency %q", ErrInvalidRule, s.Frequency)
+	}
+	return nil
+}
+
+// SweepAmount returns the amount the rule would sweep from the given available balance:
+// the excess above the target balance, or "0" when there is no excess or it is below
+// the minimum sweep amount. It can be used to preview the next execution.
+func (r *RuleResponse) SweepAmount(balance string) (string, error) {
+	available, ok := new(big.Rat).SetString(balance)
+	if !ok {
+		return "", fmt.Errorf("invalid balance %q", balance)
+	}
+	target, ok := new(big.Rat).SetString(r.TargetBalance)
+	if !ok {
+		return "", fmt.Errorf("invalid target balance %q", r.TargetBalance)
+	}
+
+	excess := new(big.Rat).Sub(available, target)
+	if excess.Sign() <= 0 {
+		return "0", nil
+	}
+	if r.MinimumSweepAmount != "" {
+		minimum, ok := new(big.Rat).SetString(r.MinimumSweepAmount)
+		if !ok {
+			return "", fmt.Errorf("invalid minimum sweep amount %q", r.MinimumSweepAmount)
+		}
+		if excess.Cmp(minimum) < 0 {
+			return "0", nil
+		}
+	}
+	return excess.FloatString(decimalPlaces(balance, r.TargetBalance)), nil
+}
+
+// decimalPlaces returns the largest number of fractional digits among the amounts.
+func decimalPlaces(amounts ...string) int {
+	places := 0
+	for _, amount := range amounts {
+		for i := range len(amount) {
+			if amount[i] == '.' {
+				places = max(places, len(amount)-i-1)
+				break
+			}
+		}
+	}
+	return places
+}
+
+// NextExecutionTime parses NextExecutionAt into a time.Time, returning the zero time if it is not set.
+func (r *RuleResponse) NextExecutionTime() (time.Time, error) {
+	return svc.ParseOptionalTimestamp(&r.NextExecutionAt)
+}
+
+// CreatedTime parses CreatedAt into a time.Time.
+func (r *RuleResponse) CreatedTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.CreatedAt)
+}
+
+// ModifiedTime parses ModifiedAt into a time.Time.
+func (r *RuleResponse) ModifiedTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.ModifiedAt)
+}
+
+// ExecutedTime parses ExecutedAt into a time.Time.
+func (r *ExecutionResponse) ExecutedTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.ExecutedAt)
+}
@@ -0,0 +1,116 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sweep_rules
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/withdraws"
+)
+
+func TestCreateRuleRequest_Validate(t *testing.T) {
+	valid := func() *CreateRuleRequest {
+		return &CreateRuleRequest{
+			Asset:             assets.AssetNameUSD,
+			Network:           assets.NetworkNameUSACH,
+			TargetBalance:     "50000",
+			ExternalAccountID: "ea-1",
+			Schedule: Schedule{
+				Frequency: FrequencyWEEKLY,
+				DayOfWeek: withdraws.WeekdayFRIDAY,
+				TimeOfDay: "17:00",
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(r *CreateRuleRequest)
+		wantErr bool
+	}{
+		{"valid weekly", func(*CreateRuleRequest) {}, false},
+		{"valid daily", func(r *CreateRuleRequest) {
+			r.Schedule = Schedule{Frequency: FrequencyDAILY, TimeOfDay: "00:00"}
+		}, false},
+		{"valid monthly", func(r *CreateRuleRequest) {
+			r.Schedule = Schedule{Frequency: FrequencyMONTHLY, DayOfMonth: 28, TimeOfDay: "09:30"}
+		}, false},
+		{"zero target", func(r *CreateRuleRequest) { r.TargetBalance = "0" }, false},
+		{"missing asset", func(r *CreateRuleRequest) { r.Asset = "" }, true},
+		{"negative target", func(r *CreateRuleRequest) { r.TargetBalance = "-1" }, true},
+		{"invalid target", func(r *CreateRuleRequest) { r.TargetBalance = "50k" }, true},
+		{"zero minimum", func(r *CreateRuleRequest) { r.MinimumSweepAmount = "0" }, true},
+		{"two destinations", func(r *CreateRuleRequest) { r.WalletAddress = "0xabc" }, true},
+		{"no destination", func(r *CreateRuleRequest) { r.ExternalAccountID = "" }, true},
+		{"invalid time", func(r *CreateRuleRequest) { r.Schedule.TimeOfDay = "5pm" }, true},
+		{"weekly without day", func(r *CreateRuleRequest) { r.Schedule.DayOfWeek = "" }, true},
+		{"daily with day", func(r *CreateRuleRequest) { r.Schedule.Frequency = FrequencyDAILY }, true},
+		{"monthly day 31", func(r *CreateRuleRequest) {
+			r.Schedule = Schedule{Frequency: FrequencyMONTHLY, DayOfMonth: 31, TimeOfDay: "09:30"}
+		}, true},
+		{"unknown frequency", func(r *CreateRuleRequest) { r.Schedule.Frequency = "HOURLY" }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := valid()
+			tt.modify(req)
+			err := req.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidRule) {
+				t.Errorf("Validate() error = %v, want ErrInvalidRule", err)
+			}
+		})
+	}
+}
+
+func TestRuleResponse_SweepAmount(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  string
+		minimum string
+		balance string
+		want    string
+	}{
+		{"excess", "50000", "", "62500.25", "12500.25"},
+		{"below target", "50000", "", "49000", "0"},
+		{"at target", "50000.00", "", "50000", "0"},
+		{"below minimum", "50000", "1000", "50500", "0"},
+		{"at minimum", "50000", "1000", "51000", "1000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := &RuleResponse{TargetBalance: tt.target, MinimumSweepAmount: tt.minimum}
+			got, err := rule.SweepAmount(tt.balance)
+			if err != nil {
+				t.Fatalf("SweepAmount() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("SweepAmount(%q) = %q, want %q", tt.balance, got, tt.want)
+			}
+		})
+	}
+
+	if _, err := (&RuleResponse{TargetBalance: "1"}).SweepAmount("abc"); err == nil {
+		t.Error("SweepAmount() with invalid balance should fail")
+	}
+}
@@ -0,0 +1,327 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package sweep_rules provides balance-threshold sweep rule management for treasury operations.
+//
+// This package implements the sweep rules service client for the 1Money platform.
+// A sweep rule caps the balance kept on the platform: on each scheduled run, any balance
+// above the target is withdrawn to an external account or wallet.
+//
+// Unlike auto conversion rules, which act on every incoming deposit, sweep rules act
+// on the resulting balance at fixed times.
+//
+// # Basic Usage
+//
+//	import (
+//	    "context"
+//	    onemoney "github.com/1Money-Co/1money-go-sdk/pkg/onemoney"
+//	    "github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+//	    "github.com/1Money-Co/1money-go-sdk/pkg/service/sweep_rules"
+//	    "github.com/1Money-Co/1money-go-sdk/pkg/service/withdraws"
+//	)
+//
+//	// Create client
+//	client, err := onemoney.NewClient(&onemoney.Config{
+//	    AccessKey: "your-access-key",
+//	    SecretKey: "your-secret-key",
+//	})
+//
+//	// Keep at most 50k USD, sweeping the excess to a bank account every Friday
+//	rule, err := client.SweepRules.CreateRule(ctx, "customer-id", &sweep_rules.CreateRuleRequest{
+//	    IdempotencyKey:    "unique-key",
+//	    Asset:             assets.AssetNameUSD,
+//	    Network:           assets.NetworkNameUSACH,
+//	    TargetBalance:     "50000",
+//	    ExternalAccountID: "external-account-id",
+//	    Schedule: sweep_rules.Schedule{
+//	        Frequency: sweep_rules.FrequencyWEEKLY,
+//	        DayOfWeek: withdraws.WeekdayFRIDAY,
+//	        TimeOfDay: "17:00",
+//	    },
+//	})
+//
+//	// Review past sweeps
+//	executions, err := client.SweepRules.ListExecutions(ctx, "customer-id", rule.SweepRuleID, nil)
+package sweep_rules
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/withdraws"
+)
+
+// Service defines the sweep rules service interface for managing balance-threshold sweeps.
+type Service interface {
+	// CreateRule creates a new sweep rule for a customer.
+	CreateRule(ctx context.Context, id svc.CustomerID, req *CreateRuleRequest) (*RuleResponse, error)
+	// GetRule retrieves a specific sweep rule by ID.
+	GetRule(ctx context.Context, id svc.CustomerID, ruleID string) (*RuleResponse, error)
+	// ListRules retrieves sweep rules for a customer with optional filters and pagination.
+	ListRules(ctx context.Context, id svc.CustomerID, req *ListRulesRequest) (*ListRulesResponse, error)
+	// PauseRule stops a rule from executing until it is resumed.
+	PauseRule(ctx context.Context, id svc.CustomerID, ruleID string) (*RuleResponse, error)
+	// ResumeRule resumes a paused rule from its next scheduled time.
+	ResumeRule(ctx context.Context, id svc.CustomerID, ruleID string) (*RuleResponse, error)
+	// DeleteRule deletes a sweep rule. Its execution history is kept.
+	DeleteRule(ctx context.Context, id svc.CustomerID, ruleID string) error
+	// ListExecutions retrieves the execution history of a sweep rule, most recent first.
+	ListExecutions(
+		ctx context.Context, id svc.CustomerID, ruleID string, req *ListExecutionsRequest,
+	) (*ListExecutionsResponse, error)
+}
+
+// Schedule defines when a sweep rule is evaluated.
+type Schedule struct {
+	// Frequency is how often the rule is evaluated.
+	Frequency Frequency `json:"frequency"`
+	// DayOfWeek is the day a WEEKLY rule is evaluated.
+	DayOfWeek withdraws.Weekday `json:"day_of_week,omitempty"`
+	// DayOfMonth is the day (1-28) a MONTHLY rule is evaluated.
+	DayOfMonth int `json:"day_of_month,omitempty"`
+	// TimeOfDay is the evaluation time in UTC (HH:MM format).
+	TimeOfDay string `json:"time_of_day"`
+}
+
+// Sweep rule request and response types.
+type (
+	// CreateRuleRequest represents the request body for creating a sweep rule.
+	CreateRuleRequest struct {
+		// IdempotencyKey is a unique key to ensure idempotent creation.
+		// This is sent as a header, not in the body.
+		IdempotencyKey string `json:"-"`
+		// Nickname is a display name for the rule (optional).
+		Nickname string `json:"nickname,omitempty"`
+		// Asset is the asset whose balance is swept.
+		Asset assets.AssetName `json:"asset"`
+		// Network is the network the excess is withdrawn over.
+		Network assets.NetworkName `json:"network"`
+		// TargetBalance is the balance to keep; anything above it is swept.
+		TargetBalance string `json:"target_balance"`
+		// MinimumSweepAmount skips executions whose excess is below this amount (optional).
+		MinimumSweepAmount string `json:"minimum_sweep_amount,omitempty"`
+		// WalletAddress is the destination wallet address for crypto sweeps.
+		// Cannot be provided together with ExternalAccountID.
+		WalletAddress string `json:"wallet_address,omitempty"`
+		// ExternalAccountID is the destination external account for fiat sweeps.
+		// Cannot be provided together with WalletAddress.
+		ExternalAccountID string `json:"external_account_id,omitempty"`
+		// Schedule is when the rule is evaluated.
+		Schedule Schedule `json:"schedule"`
+	}
+
+	// RuleResponse represents a sweep rule.
+	RuleResponse struct {
+		// SweepRuleID is the unique sweep rule identifier.
+		SweepRuleID string `json:"sweep_rule_id"`
+		// IdempotencyKey is the idempotency key used when the rule was created.
+		IdempotencyKey string `json:"idempotency_key"`
+		// Nickname is the display name of the rule.
+		Nickname string `json:"nickname,omitempty"`
+		// Status is the current status of the rule.
+		Status RuleStatus `json:"status"`
+		// Asset is the asset whose balance is swept.
+		Asset string `json:"asset"`
+		// Network is the network the excess is withdrawn over.
+		Network string `json:"network"`
+		// TargetBalance is the balance to keep.
+		TargetBalance string `json:"target_balance"`
+		// MinimumSweepAmount is the smallest excess that is swept (optional).
+		MinimumSweepAmount string `json:"minimum_sweep_amount,omitempty"`
+		// WalletAddress is the destination wallet address for crypto sweeps.
+		WalletAddress string `json:"wallet_address,omitempty"`
+		// ExternalAccountID is the destination external account for fiat sweeps.
+		ExternalAccountID string `json:"external_account_id,omitempty"`
+		// Schedule is when the rule is evaluated.
+		Schedule Schedule `json:"schedule"`
+		// NextExecutionAt is the next planned evaluation time (ISO 8601 format, empty when paused).
+		NextExecutionAt string `json:"next_execution_at,omitempty"`
+		// CreatedAt is the rule creation timestamp (ISO 8601 format).
+		CreatedAt string `json:"created_at"`
+		// ModifiedAt is the last modification timestamp (ISO 8601 format).
+		ModifiedAt string `json:"modified_at"`
+	}
+)
+
+// ListRules request and response types.
+type (
+	// ListRulesRequest represents optional query parameters for listing sweep rules.
+	ListRulesRequest struct {
+		// Status filters by rule status.
+		Status RuleStatus `json:"status,omitempty"`
+		// Page is the page number (starts from 1).
+		Page int `json:"page,omitempty"`
+		// Size is the number of items per page (1-100).
+		Size int `json:"size,omitempty"`
+	}
+
+	// ListRulesResponse represents the response for listing sweep rules.
+	ListRulesResponse struct {
+		// List is the list of sweep rules.
+		List []RuleResponse `json:"list"`
+		// Total is the total number of sweep rules matching the filters.
+		Total int `json:"total,omitempty"`
+	}
+)
+
+// Execution history types.
+type (
+	// ExecutionResponse represents a single evaluation of a sweep rule.
+	ExecutionResponse struct {
+		// ExecutionID is the unique execution identifier.
+		ExecutionID string `json:"execution_id"`
+		// SweepRuleID is the rule that was evaluated.
+		SweepRuleID string `json:"sweep_rule_id"`
+		// Status is the outcome of the execution.
+		Status ExecutionStatus `json:"status"`
+		// BalanceBefore is the available balance when the rule was evaluated.
+		BalanceBefore string `json:"balance_before"`
+		// SweptAmount is the amount withdrawn (empty when SKIPPED).
+		SweptAmount string `json:"swept_amount,omitempty"`
+		// WithdrawalTransactionID is the withdrawal created by the execution, if any.
+		WithdrawalTransactionID svc.TransactionID `json:"withdrawal_transaction_id,omitempty"`
+		// FailureReason explains a FAILED or SKIPPED execution.
+		FailureReason string `json:"failure_reason,omitempty"`
+		// ExecutedAt is when the rule was evaluated (ISO 8601 format).
+		ExecutedAt string `json:"executed_at"`
+	}
+
+	// ListExecutionsRequest represents optional query parameters for listing sweep executions.
+	ListExecutionsRequest struct {
+		// Status filters by execution status.
+		Status ExecutionStatus `json:"status,omitempty"`
+		// Page is the page number (starts from 1).
+		Page int `json:"page,omitempty"`
+		// Size is the number of items per page (1-100).
+		Size int `json:"size,omitempty"`
+	}
+
+	// ListExecutionsResponse represents the response for listing sweep executions.
+	ListExecutionsResponse struct {
+		// List is the list of executions.
+		List []ExecutionResponse `json:"list"`
+		// Total is the total number of executions matching the filters.
+		Total int `json:"total,omitempty"`
+	}
+)
+
+type serviceImpl struct {
+	*svc.BaseService
+}
+
+// NewService creates a new sweep rules service instance with the given base service.
+func NewService(base *svc.BaseService) Service {
+	return &serviceImpl{
+		BaseService: base,
+	}
+}
+
+// CreateRule creates a new sweep rule for a customer.
+func (s *serviceImpl) CreateRule(
+	ctx context.Context,
+	id svc.CustomerID,
+	req *CreateRuleRequest,
+) (*RuleResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/v1/customers/%s/sweep-rules", id)
+
+	headers := make(map[string]string)
+	if req.IdempotencyKey != "" {
+		headers["Idempotency-Key"] = req.IdempotencyKey
+	}
+
+	return svc.PostJSONWithHeaders[*CreateRuleRequest, RuleResponse](ctx, s.BaseService, path, req, headers)
+}
+
+// GetRule retrieves a specific sweep rule by ID.
+func (s *serviceImpl) GetRule(ctx context.Context, id svc.CustomerID, ruleID string) (*RuleResponse, error) {
+	path := fmt.Sprintf("/v1/customers/%s/sweep-rules/%s", id, ruleID)
+	return svc.GetJSON[RuleResponse](ctx, s.BaseService, path)
+}
+
+// ListRules retrieves sweep rules for a customer with optional filters and pagination.
+func (s *serviceImpl) ListRules(
+	ctx context.Context,
+	id svc.CustomerID,
+	req *ListRulesRequest,
+) (*ListRulesResponse, error) {
+	path := fmt.Sprintf("/v1/customers/%s/sweep-rules/list", id)
+
+	params := make(map[string]string)
+	if req != nil {
+		if req.Status != "" {
+			params["status"] = string(req.Status)
+		}
+		if req.Page > 0 {
+			params["page"] = strconv.Itoa(req.Page)
+		}
+		if req.Size > 0 {
+			params["size"] = strconv.Itoa(req.Size)
+		}
+	}
+
+	return svc.GetJSONWithParams[ListRulesResponse](ctx, s.BaseService, path, params)
+}
+
+// PauseRule stops a rule from executing until it is resumed.
+func (s *serviceImpl) PauseRule(ctx context.Context, id svc.CustomerID, ruleID string) (*RuleResponse, error) {
+	path := fmt.Sprintf("/v1/customers/%s/sweep-rules/%s/pause", id, ruleID)
+	return svc.PostJSON[any, RuleResponse](ctx, s.BaseService, path, nil)
+}
+
+// ResumeRule resumes a paused rule from its next scheduled time.
+func (s *serviceImpl) ResumeRule(ctx context.Context, id svc.CustomerID, ruleID string) (*RuleResponse, error) {
+	path := fmt.Sprintf("/v1/customers/%s/sweep-rules/%s/resume", id, ruleID)
+	return svc.PostJSON[any, RuleResponse](ctx, s.BaseService, path, nil)
+}
+
+// DeleteRule deletes a sweep rule.
+func (s *serviceImpl) DeleteRule(ctx context.Context, id svc.CustomerID, ruleID string) error {
+	path := fmt.Sprintf("/v1/customers/%s/sweep-rules/%s", id, ruleID)
+	_, err := svc.DeleteJSON[any](ctx, s.BaseService, path)
+	return err
+}
+
+// ListExecutions retrieves the execution history of a sweep rule.
+func (s *serviceImpl) ListExecutions(
+	ctx context.Context,
+	id svc.CustomerID,
+	ruleID string,
+	req *ListExecutionsRequest,
+) (*ListExecutionsResponse, error) {
+	path := fmt.Sprintf("/v1/customers/%s/sweep-rules/%s/executions", id, ruleID)
+
+	params := make(map[string]string)
+	if req != nil {
+		if req.Status != "" {
+			params["status"] = string(req.Status)
+		}
+		if req.Page > 0 {
+			params["page"] = strconv.Itoa(req.Page)
+		}
+		if req.Size > 0 {
+			params["size"] = strconv.Itoa(req.Size)
+		}
+	}
+
+	return svc.GetJSONWithParams[ListExecutionsResponse](ctx, s.BaseService, path, params)
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseTimestamp(t *testing.T) {
+	want := time.Date(2025, 3, 14, 15, 9, 26, 0, time.UTC)
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{in: "2025-03-14T15:09:26Z", want: want},
+		{in: "2025-03-14T15:09:26.535Z", want: want.Add(535 * time.Millisecond)},
+		{in: "2025-03-14T17:09:26+02:00", want: want},
+		{in: "2025-03-14T17:09:26+0200", want: want},
+		{in: "2025-03-14T15:09:26", want: want},
+		{in: "2025-03-14T15:09:26.123456", want: want.Add(123456 * time.Microsecond)},
+		{in: "2025-03-14 15:09:26", want: want},
+		{in: "2025-03-14 17:09:26+02:00", want: want},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseTimestamp(tt.in)
+			if err != nil {
+				t.Fatalf("ParseTimestamp(%q) error = %v", tt.in, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ParseTimestamp(%q) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+
+	for _, in := range []string{"", "2025-03-14", "14/03/2025 15:09", "yesterday"} {
+		if _, err := ParseTimestamp(in); err == nil {
+			t.Errorf("ParseTimestamp(%q) should fail", in)
+		}
+	}
+}
+
+func TestParseOptionalTimestamp(t *testing.T) {
+	empty := ""
+	for _, value := range []*string{nil, &empty} {
+		got, err := ParseOptionalTimestamp(value)
+		if err != nil || !got.IsZero() {
+			t.Errorf("ParseOptionalTimestamp(%v) = %v, %v, want the zero time", value, got, err)
+		}
+	}
+
+	value := "2025-03-14T15:09:26Z"
+	if got, err := ParseOptionalTimestamp(&value); err != nil || got.IsZero() {
+		t.Errorf("ParseOptionalTimestamp(%q) = %v, %v", value, got, err)
+	}
+	invalid := "soon"
+	if _, err := ParseOptionalTimestamp(&invalid); err == nil {
+		t.Errorf("ParseOptionalTimestamp(%q) should fail", invalid)
+	}
+}
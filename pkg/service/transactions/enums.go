@@ -25,3 +25,23 @@ type TransactionStatus string
 // TransactionAction represents the type of transaction action.
 // ENUM(DEPOSIT, WITHDRAWAL, CONVERSION)
 type TransactionAction string
+
+// TransactionDirection represents whether funds moved into or out of the customer account.
+// ENUM(INBOUND, OUTBOUND)
+type TransactionDirection string
+
+// ExportFormat represents the output format of a transaction export.
+// ENUM(CSV, JSONL)
+type ExportFormat string
+
+// ReceiptFormat represents the format of a transaction receipt.
+// ENUM(PDF, JSON)
+type ReceiptFormat string
+
+// RelationType represents how a related transaction is linked to a transaction.
+// ENUM(PARENT, CHILD, DEBIT_LEG, CREDIT_LEG)
+type RelationType string
+
+// HoldReason represents why a pending transaction has not settled yet.
+// ENUM(COMPLIANCE_REVIEW, AWAITING_CONFIRMATIONS, ACH_WINDOW, WIRE_CUTOFF, BANK_PROCESSING, INSUFFICIENT_FUNDS)
+type HoldReason string
@@ -10,6 +10,336 @@ import (
 	"strings"
 )
 
+const (
+	// ExportFormatCSV is a ExportFormat of type CSV.
+	ExportFormatCSV ExportFormat = "CSV"
+	// ExportFormatJSONL is a ExportFormat of type JSONL.
+	ExportFormatJSONL ExportFormat = "JSONL"
+)
+
+var ErrInvalidExportFormat = fmt.Errorf("not a valid ExportFormat, try [%s]", strings.Join(_ExportFormatNames, ", "))
+
+var _ExportFormatNames = []string{
+	string(ExportFormatCSV),
+	string(ExportFormatJSONL),
+}
+
+// ExportFormatNames returns a list of possible string values of ExportFormat.
+func ExportFormatNames() []string {
+	tmp := make([]string, len(_ExportFormatNames))
+	copy(tmp, _ExportFormatNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x ExportFormat) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x ExportFormat) IsValid() bool {
+	_, err := ParseExportFormat(string(x))
+	return err == nil
+}
+
+var _ExportFormatValue = map[string]ExportFormat{
+	"CSV":   ExportFormatCSV,
+	"csv":   ExportFormatCSV,
+	"JSONL": ExportFormatJSONL,
+	"jsonl": ExportFormatJSONL,
+}
+
+// ParseExportFormat attempts to convert a string to a ExportFormat.
+func ParseExportFormat(name string) (ExportFormat, error) {
+	if x, ok := _ExportFormatValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _ExportFormatValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return ExportFormat(""), fmt.Errorf("%s is %w", name, ErrInvalidExportFormat)
+}
+
+// MarshalText implements the text marshaller method.
+func (x ExportFormat) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *ExportFormat) UnmarshalText(text []byte) error {
+	tmp, err := ParseExportFormat(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *ExportFormat) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+const (
+	// HoldReasonCOMPLIANCEREVIEW is a HoldReason of type COMPLIANCE_REVIEW.
+	HoldReasonCOMPLIANCEREVIEW HoldReason = "COMPLIANCE_REVIEW"
+	// HoldReasonAWAITINGCONFIRMATIONS is a HoldReason of type AWAITING_CONFIRMATIONS.
+	HoldReasonAWAITINGCONFIRMATIONS HoldReason = "AWAITING_CONFIRMATIONS"
+	// HoldReasonACHWINDOW is a HoldReason of type ACH_WINDOW.
+	HoldReasonACHWINDOW HoldReason = "ACH_WINDOW"
+	// HoldReasonWIRECUTOFF is a HoldReason of type WIRE_CUTOFF.
+	HoldReasonWIRECUTOFF HoldReason = "WIRE_CUTOFF"
+	// HoldReasonBANKPROCESSING is a HoldReason of type BANK_PROCESSING.
+	HoldReasonBANKPROCESSING HoldReason = "BANK_PROCESSING"
+	// HoldReasonINSUFFICIENTFUNDS is a HoldReason of type INSUFFICIENT_FUNDS.
+	HoldReasonINSUFFICIENTFUNDS HoldReason = "INSUFFICIENT_FUNDS"
+)
+
+var ErrInvalidHoldReason = fmt.Errorf("not a valid HoldReason, try [%s]", strings.Join(_HoldReasonNames, ", "))
+
+var _HoldReasonNames = []string{
+	string(HoldReasonCOMPLIANCEREVIEW),
+	string(HoldReasonAWAITINGCONFIRMATIONS),
+	string(HoldReasonACHWINDOW),
+	string(HoldReasonWIRECUTOFF),
+	string(HoldReasonBANKPROCESSING),
+	string(HoldReasonINSUFFICIENTFUNDS),
+}
+
+// HoldReasonNames returns a list of possible string values of HoldReason.
+func HoldReasonNames() []string {
+	tmp := make([]string, len(_HoldReasonNames))
+	copy(tmp, _HoldReasonNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x HoldReason) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x HoldReason) IsValid() bool {
+	_, err := ParseHoldReason(string(x))
+	return err == nil
+}
+
+var _HoldReasonValue = map[string]HoldReason{
+	"COMPLIANCE_REVIEW":      HoldReasonCOMPLIANCEREVIEW,
+	"compliance_review":      HoldReasonCOMPLIANCEREVIEW,
+	"AWAITING_CONFIRMATIONS": HoldReasonAWAITINGCONFIRMATIONS,
+	"awaiting_confirmations": HoldReasonAWAITINGCONFIRMATIONS,
+	"ACH_WINDOW":             HoldReasonACHWINDOW,
+	"ach_window":             HoldReasonACHWINDOW,
+	"WIRE_CUTOFF":            HoldReasonWIRECUTOFF,
+	"wire_cutoff":            HoldReasonWIRECUTOFF,
+	"BANK_PROCESSING":        HoldReasonBANKPROCESSING,
+	"bank_processing":        HoldReasonBANKPROCESSING,
+	"INSUFFICIENT_FUNDS":     HoldReasonINSUFFICIENTFUNDS,
+	"insufficient_funds":     HoldReasonINSUFFICIENTFUNDS,
+}
+
+// ParseHoldReason attempts to convert a string to a HoldReason.
+func ParseHoldReason(name string) (HoldReason, error) {
+	if x, ok := _HoldReasonValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _HoldReasonValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return HoldReason(""), fmt.Errorf("%s is %w", name, ErrInvalidHoldReason)
+}
+
+// MarshalText implements the text marshaller method.
+func (x HoldReason) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *HoldReason) UnmarshalText(text []byte) error {
+	tmp, err := ParseHoldReason(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *HoldReason) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+const (
+	// ReceiptFormatPDF is a ReceiptFormat of type PDF.
+	ReceiptFormatPDF ReceiptFormat = "PDF"
+	// ReceiptFormatJSON is a ReceiptFormat of type JSON.
+	ReceiptFormatJSON ReceiptFormat = "JSON"
+)
+
+var ErrInvalidReceiptFormat = fmt.Errorf("not a valid ReceiptFormat, try [%s]", strings.Join(_ReceiptFormatNames, ", "))
+
+var _ReceiptFormatNames = []string{
+	string(ReceiptFormatPDF),
+	string(ReceiptFormatJSON),
+}
+
+// ReceiptFormatNames returns a list of possible string values of ReceiptFormat.
+func ReceiptFormatNames() []string {
+	tmp := make([]string, len(_ReceiptFormatNames))
+	copy(tmp, _ReceiptFormatNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x ReceiptFormat) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x ReceiptFormat) IsValid() bool {
+	_, err := ParseReceiptFormat(string(x))
+	return err == nil
+}
+
+var _ReceiptFormatValue = map[string]ReceiptFormat{
+	"PDF":  ReceiptFormatPDF,
+	"pdf":  ReceiptFormatPDF,
+	"JSON": ReceiptFormatJSON,
+	"json": ReceiptFormatJSON,
+}
+
+// ParseReceiptFormat attempts to convert a string to a ReceiptFormat.
+func ParseReceiptFormat(name string) (ReceiptFormat, error) {
+	if x, ok := _ReceiptFormatValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _ReceiptFormatValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return ReceiptFormat(""), fmt.Errorf("%s is %w", name, ErrInvalidReceiptFormat)
+}
+
+// MarshalText implements the text marshaller method.
+func (x ReceiptFormat) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *ReceiptFormat) UnmarshalText(text []byte) error {
+	tmp, err := ParseReceiptFormat(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *ReceiptFormat) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+const (
+	// RelationTypePARENT is a RelationType of type PARENT.
+	RelationTypePARENT RelationType = "PARENT"
+	// RelationTypeCHILD is a RelationType of type CHILD.
+	RelationTypeCHILD RelationType = "CHILD"
+	// RelationTypeDEBITLEG is a RelationType of type DEBIT_LEG.
+	RelationTypeDEBITLEG RelationType = "DEBIT_LEG"
+	// RelationTypeCREDITLEG is a RelationType of type CREDIT_LEG.
+	RelationTypeCREDITLEG RelationType = "CREDIT_LEG"
+)
+
+var ErrInvalidRelationType = fmt.Errorf("not a valid RelationType, try [%s]", strings.Join(_RelationTypeNames, ", "))
+
+var _RelationTypeNames = []string{
+	string(RelationTypePARENT),
+	string(RelationTypeCHILD),
+	string(RelationTypeDEBITLEG),
+	string(RelationTypeCREDITLEG),
+}
+
+// RelationTypeNames returns a list of possible string values of RelationType.
+func RelationTypeNames() []string {
+	tmp := make([]string, len(_RelationTypeNames))
+	copy(tmp, _RelationTypeNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x RelationType) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x RelationType) IsValid() bool {
+	_, err := ParseRelationType(string(x))
+	return err == nil
+}
+
+var _RelationTypeValue = map[string]RelationType{
+	"PARENT":     RelationTypePARENT,
+	"parent":     RelationTypePARENT,
+	"CHILD":      RelationTypeCHILD,
+	"child":      RelationTypeCHILD,
+	"DEBIT_LEG":  RelationTypeDEBITLEG,
+	"debit_leg":  RelationTypeDEBITLEG,
+	"CREDIT_LEG": RelationTypeCREDITLEG,
+	"credit_leg": RelationTypeCREDITLEG,
+}
+
+// ParseRelationType attempts to convert a string to a RelationType.
+func ParseRelationType(name string) (RelationType, error) {
+	if x, ok := _RelationTypeValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _RelationTypeValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return RelationType(""), fmt.Errorf("%s is %w", name, ErrInvalidRelationType)
+}
+
+// MarshalText implements the text marshaller method.
+func (x RelationType) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *RelationType) UnmarshalText(text []byte) error {
+	tmp, err := ParseRelationType(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *RelationType) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
 const (
 	// TransactionActionDEPOSIT is a TransactionAction of type DEPOSIT.
 	TransactionActionDEPOSIT TransactionAction = "DEPOSIT"
@@ -90,6 +420,81 @@ func (x *TransactionAction) AppendText(b []byte) ([]byte, error) {
 	return append(b, x.String()...), nil
 }
 
+const (
+	// TransactionDirectionINBOUND is a TransactionDirection of type INBOUND.
+	TransactionDirectionINBOUND TransactionDirection = "INBOUND"
+	// TransactionDirectionOUTBOUND is a TransactionDirection of type OUTBOUND.
+	TransactionDirectionOUTBOUND TransactionDirection = "OUTBOUND"
+)
+
+var ErrInvalidTransactionDirection = fmt.Errorf("not a valid TransactionDirection, try [%s]", strings.Join(_TransactionDirectionNames, ", "))
+
+var _TransactionDirectionNames = []string{
+	string(TransactionDirectionINBOUND),
+	string(TransactionDirectionOUTBOUND),
+}
+
+// TransactionDirectionNames returns a list of possible string values of TransactionDirection.
+func TransactionDirectionNames() []string {
+	tmp := make([]string, len(_TransactionDirectionNames))
+	copy(tmp, _TransactionDirectionNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x TransactionDirection) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x TransactionDirection) IsValid() bool {
+	_, err := ParseTransactionDirection(string(x))
+	return err == nil
+}
+
+var _TransactionDirectionValue = map[string]TransactionDirection{
+	"INBOUND":  TransactionDirectionINBOUND,
+	"inbound":  TransactionDirectionINBOUND,
+	"OUTBOUND": TransactionDirectionOUTBOUND,
+	"outbound": TransactionDirectionOUTBOUND,
+}
+
+// ParseTransactionDirection attempts to convert a string to a TransactionDirection.
+func ParseTransactionDirection(name string) (TransactionDirection, error) {
+	if x, ok := _TransactionDirectionValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _TransactionDirectionValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return TransactionDirection(""), fmt.Errorf("%s is %w", name, ErrInvalidTransactionDirection)
+}
+
+// MarshalText implements the text marshaller method.
+func (x TransactionDirection) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *TransactionDirection) UnmarshalText(text []byte) error {
+	tmp, err := ParseTransactionDirection(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *TransactionDirection) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
 const (
 	// TransactionStatusPENDING is a TransactionStatus of type PENDING.
 	TransactionStatusPENDING TransactionStatus = "PENDING"
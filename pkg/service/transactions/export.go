@@ -0,0 +1,145 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transactions
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+)
+
+// exportPageSize is the page size used when paginating through transactions for export.
+const exportPageSize = 100
+
+// ExportColumns is the stable column order of CSV exports.
+var ExportColumns = []string{
+	"transaction_id",
+	"idempotency_key",
+	"customer_id",
+	"transaction_action",
+	"status",
+	"amount",
+	"asset",
+	"network",
+	"fee_value",
+	"fee_asset",
+	"source_address_id",
+	"destination_address_id",
+	"created_at",
+	"modified_at",
+}
+
+// Export streams all transactions matching the filter to w in the given format.
+func (s *serviceImpl) Export(
+	ctx context.Context,
+	id svc.CustomerID,
+	filter *ListTransactionsRequest,
+	format ExportFormat,
+	w io.Writer,
+) error {
+	return exportTransactions(ctx, s, id, filter, format, w)
+}
+
+func exportTransactions(
+	ctx context.Context,
+	service Service,
+	id svc.CustomerID,
+	filter *ListTransactionsRequest,
+	format ExportFormat,
+	w io.Writer,
+) error {
+	var write func(*TransactionResponse) error
+	var flush func() error
+
+	switch format {
+	case ExportFormatCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(ExportColumns); err != nil {
+			return fmt.Errorf("failed to write csv header: %w", err)
+		}
+		write = func(tx *TransactionResponse) error { return cw.Write(tx.CSVRecord()) }
+		flush = func() error {
+			cw.Flush()
+			return cw.Error()
+		}
+	case ExportFormatJSONL:
+		enc := json.NewEncoder(w)
+		write = func(tx *TransactionResponse) error { return enc.Encode(tx) }
+		flush = func() error { return nil }
+	default:
+		return fmt.Errorf("unsupported export format: %q", format)
+	}
+
+	req := ListTransactionsRequest{}
+	if filter != nil {
+		req = *filter
+	}
+	// Page oldest first, so transactions created during the export are appended to the
+	// last page instead of shifting earlier pages.
+	req.SortOrder = assets.SortOrderASC
+	req.Size = exportPageSize
+
+	written := 0
+	for page := 1; ; page++ {
+		req.Page = page
+		resp, err := service.ListTransactions(ctx, id, &req)
+		if err != nil {
+			return fmt.Errorf("failed to list transactions (page %d): %w", page, err)
+		}
+
+		for i := range resp.List {
+			if err := write(&resp.List[i]); err != nil {
+				return fmt.Errorf("failed to write transaction %s: %w", resp.List[i].TransactionID, err)
+			}
+		}
+		written += len(resp.List)
+
+		if len(resp.List) < exportPageSize || (resp.Total > 0 && written >= resp.Total) {
+			break
+		}
+	}
+
+	if err := flush(); err != nil {
+		return fmt.Errorf("failed to flush export: %w", err)
+	}
+	return nil
+}
+
+// CSVRecord returns the transaction fields in ExportColumns order.
+func (tx *TransactionResponse) CSVRecord() []string {
+	return []string{
+		tx.TransactionID,
+		tx.IdempotencyKey,
+		tx.CustomerID,
+		tx.TransactionAction,
+		tx.Status.String(),
+		tx.Amount,
+		tx.Asset,
+		tx.Network,
+		tx.TransactionFee.Value,
+		tx.TransactionFee.Asset,
+		tx.Source.AddressID,
+		tx.Destination.AddressID,
+		tx.CreatedAt,
+		tx.ModifiedAt,
+	}
+}
@@ -0,0 +1,137 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transactions
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+)
+
+// fakeListService serves ListTransactions from an in-memory slice.
+type fakeListService struct {
+	Service
+	txs      []TransactionResponse
+	calls    int
+	requests []ListTransactionsRequest
+}
+
+func (f *fakeListService) ListTransactions(
+	_ context.Context, _ svc.CustomerID, req *ListTransactionsRequest,
+) (*ListTransactionsResponse, error) {
+	f.calls++
+	f.requests = append(f.requests, *req)
+	start := (req.Page - 1) * req.Size
+	if start > len(f.txs) {
+		start = len(f.txs)
+	}
+	end := min(start+req.Size, len(f.txs))
+	return &ListTransactionsResponse{List: f.txs[start:end], Total: len(f.txs)}, nil
+}
+
+func fakeTransactions(n int) []TransactionResponse {
+	txs := make([]TransactionResponse, n)
+	for i := range txs {
+		txs[i] = TransactionResponse{
+			TransactionID:     svc.TransactionID(fmt.Sprintf("tx-%d", i)),
+			TransactionAction: "DEPOSIT",
+			Amount:            "1.00",
+			Asset:             "USD",
+			Status:            TransactionStatusCOMPLETED,
+		}
+	}
+	return txs
+}
+
+func TestExportTransactions(t *testing.T) {
+	tests := []struct {
+		name      string
+		count     int
+		format    ExportFormat
+		wantLines int
+		wantCalls int
+		wantErr   bool
+	}{
+		{name: "csv multiple pages", count: 250, format: ExportFormatCSV, wantLines: 251, wantCalls: 3},
+		{name: "csv exact page", count: 100, format: ExportFormatCSV, wantLines: 101, wantCalls: 1},
+		{name: "jsonl empty", count: 0, format: ExportFormatJSONL, wantLines: 0, wantCalls: 1},
+		{name: "jsonl", count: 3, format: ExportFormatJSONL, wantLines: 3, wantCalls: 1},
+		{name: "unsupported format", format: "XML", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &fakeListService{txs: fakeTransactions(tt.count)}
+			var buf bytes.Buffer
+
+			err := exportTransactions(context.Background(), service, "cid", nil, tt.format, &buf)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("exportTransactions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			lines := strings.Count(buf.String(), "\n")
+			if lines != tt.wantLines {
+				t.Errorf("exportTransactions() wrote %d lines, want %d", lines, tt.wantLines)
+			}
+			if service.calls != tt.wantCalls {
+				t.Errorf("exportTransactions() made %d list calls, want %d", service.calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestExportTransactions_CSVHeader(t *testing.T) {
+	service := &fakeListService{txs: fakeTransactions(1)}
+	var buf bytes.Buffer
+
+	if err := exportTransactions(context.Background(), service, "cid", nil, ExportFormatCSV, &buf); err != nil {
+		t.Fatalf("exportTransactions() error = %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if got, want := lines[0], strings.Join(ExportColumns, ","); got != want {
+		t.Errorf("header = %q, want %q", got, want)
+	}
+	if !strings.HasPrefix(lines[1], "tx-0,") {
+		t.Errorf("first record = %q, want prefix %q", lines[1], "tx-0,")
+	}
+}
+
+func TestExportTransactions_SortsAscending(t *testing.T) {
+	service := &fakeListService{txs: fakeTransactions(150)}
+	filter := &ListTransactionsRequest{SortOrder: assets.SortOrderDESC}
+
+	if err := exportTransactions(context.Background(), service, "cid", filter, ExportFormatJSONL, &bytes.Buffer{}); err != nil {
+		t.Fatalf("exportTransactions() error = %v", err)
+	}
+	for i, req := range service.requests {
+		if req.SortOrder != assets.SortOrderASC {
+			t.Errorf("request %d SortOrder = %q, want %q", i, req.SortOrder, assets.SortOrderASC)
+		}
+	}
+	if filter.SortOrder != assets.SortOrderDESC {
+		t.Errorf("filter SortOrder = %q, want it unchanged", filter.SortOrder)
+	}
+}
@@ -17,112 +17,77 @@
 package transactions
 
 import (
-	"context"
-	"fmt"
 	"time"
 
-	"go.uber.org/zap"
-
-	"github.com/1Money-Co/1money-go-sdk/internal/utils"
 	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
 )
 
-// WaitOptions configures the polling behavior for wait functions.
-type WaitOptions struct {
-	// PollInterval is the interval between polling attempts. Default: 5s.
-	PollInterval time.Duration
-	// MaxWaitTime is the maximum duration to wait. Default: 10m.
-	MaxWaitTime time.Duration
-	// Logger is an optional zap logger for logging polling progress.
-	Logger *zap.Logger
-	// PrintProgress prints polling progress to stdout using standard log package.
-	// This is useful for examples and debugging when zap logger is not available.
-	PrintProgress bool
-}
+//go:generate go run ../../../cmd/tools/svcgen waiters waiters.yaml
 
-// DefaultWaitOptions returns the default wait options.
-func DefaultWaitOptions() WaitOptions {
-	return WaitOptions{
-		PollInterval: 5 * time.Second,
-		MaxWaitTime:  10 * time.Minute,
+// Action returns the transaction action as a typed enum.
+// Returns an empty TransactionAction if the raw value is not recognized.
+func (tx *TransactionResponse) Action() TransactionAction {
+	action, err := ParseTransactionAction(tx.TransactionAction)
+	if err != nil {
+		return ""
 	}
+	return action
 }
 
-// TransactionCondition is a function that checks if a transaction meets a condition.
-type TransactionCondition func(*TransactionResponse) bool
+// NetworkName returns the transaction network as a typed asset network name.
+func (tx *TransactionResponse) NetworkName() assets.NetworkName {
+	return assets.NetworkName(tx.Network)
+}
 
-// WaitFor polls until the condition returns true.
-// Returns the transaction response when condition is met, or an error on timeout/failure.
-func WaitFor(
-	ctx context.Context,
-	service Service,
-	customerID svc.CustomerID,
-	transactionID string,
-	condition TransactionCondition,
-	opts *WaitOptions,
-) (*TransactionResponse, error) {
-	defaults := DefaultWaitOptions()
-	if opts == nil {
-		opts = &defaults
-	}
+// AssetName returns the transaction asset as a typed asset name.
+func (tx *TransactionResponse) AssetName() assets.AssetName {
+	return assets.AssetName(tx.Asset)
+}
 
-	utilOpts := &utils.WaitOptions{
-		PollInterval:  opts.PollInterval,
-		MaxWaitTime:   opts.MaxWaitTime,
-		Logger:        opts.Logger,
-		LogMessage:    "polling transaction status",
-		PrintProgress: opts.PrintProgress,
-	}
+// CreatedTime parses CreatedAt into a time.Time.
+func (tx *TransactionResponse) CreatedTime() (time.Time, error) {
+	return svc.ParseTimestamp(tx.CreatedAt)
+}
 
-	return utils.WaitFor(
-		ctx,
-		func(ctx context.Context) (*TransactionResponse, error) {
-			return service.GetTransaction(ctx, customerID, transactionID)
-		},
-		utils.Condition[TransactionResponse](condition),
-		func(tx *TransactionResponse) string { return tx.Status.String() },
-		"transaction",
-		transactionID,
-		utilOpts,
-	)
+// ModifiedTime parses ModifiedAt into a time.Time.
+func (tx *TransactionResponse) ModifiedTime() (time.Time, error) {
+	return svc.ParseTimestamp(tx.ModifiedAt)
 }
 
-// WaitForSettled polls until the transaction status is no longer PENDING.
-// Returns the transaction response when settled (COMPLETED, FAILED, or REVERSED).
-func WaitForSettled(
-	ctx context.Context,
-	service Service,
-	customerID svc.CustomerID,
-	transactionID string,
-	opts *WaitOptions,
-) (*TransactionResponse, error) {
-	return WaitFor(ctx, service, customerID, transactionID, func(tx *TransactionResponse) bool {
-		return tx.Status != TransactionStatusPENDING
-	}, opts)
+// IsOnHold reports whether the transaction is pending with a known hold reason.
+func (tx *TransactionResponse) IsOnHold() bool {
+	return tx.Status == TransactionStatusPENDING && tx.HoldReason != ""
 }
 
-// WaitForCompleted polls until the transaction status becomes COMPLETED.
-// Returns an error if the status becomes FAILED or REVERSED.
-func WaitForCompleted(
-	ctx context.Context,
-	service Service,
-	customerID svc.CustomerID,
-	transactionID string,
-	opts *WaitOptions,
-) (*TransactionResponse, error) {
-	tx, err := WaitFor(ctx, service, customerID, transactionID, func(tx *TransactionResponse) bool {
-		return tx.Status != TransactionStatusPENDING
-	}, opts)
-	if err != nil {
-		return nil, err
+// SettlementWindowTimes parses the expected settlement window.
+// Returns ok=false if no window is available or it cannot be parsed.
+func (tx *TransactionResponse) SettlementWindowTimes() (earliest, latest time.Time, ok bool) {
+	if tx.ExpectedSettlement == nil {
+		return time.Time{}, time.Time{}, false
 	}
-
-	if tx.Status == TransactionStatusFAILED {
-		return tx, fmt.Errorf("transaction %s failed", transactionID)
+	earliest, err := svc.ParseTimestamp(tx.ExpectedSettlement.EarliestAt)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
 	}
-	if tx.Status == TransactionStatusREVERSED {
-		return tx, fmt.Errorf("transaction %s was reversed", transactionID)
+	latest, err = svc.ParseTimestamp(tx.ExpectedSettlement.LatestAt)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
 	}
+	return earliest, latest, true
+}
+
+// CompletedTime parses CompletedAt into a time.Time, returning the zero time if it is not set.
+func (r *Receipt) CompletedTime() (time.Time, error) {
+	return svc.ParseOptionalTimestamp(&r.CompletedAt)
+}
+
+// IssuedTime parses IssuedAt into a time.Time.
+func (r *Receipt) IssuedTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.IssuedAt)
+}
 
-	return tx, nil
+// GeneratedTime parses GeneratedAt into a time.Time.
+func (r *ReconciliationSummary) GeneratedTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.GeneratedAt)
 }
@@ -0,0 +1,198 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transactions
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTransactionResponse_Action(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want TransactionAction
+	}{
+		{raw: "DEPOSIT", want: TransactionActionDEPOSIT},
+		{raw: "withdrawal", want: TransactionActionWITHDRAWAL},
+		{raw: "CONVERSION", want: TransactionActionCONVERSION},
+		{raw: "UNKNOWN", want: ""},
+		{raw: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			tx := &TransactionResponse{TransactionAction: tt.raw}
+			if got := tx.Action(); got != tt.want {
+				t.Errorf("Action() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTransactionResponse_CreatedTime(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    time.Time
+		wantErr bool
+	}{
+		{
+			name: "RFC3339 with zone",
+			raw:  "2025-01-02T03:04:05Z",
+			want: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		},
+		{
+			name: "fractional seconds with offset",
+			raw:  "2025-01-02T03:04:05.123+08:00",
+			want: time.Date(2025, 1, 1, 19, 4, 5, 123000000, time.UTC),
+		},
+		{
+			name: "no zone",
+			raw:  "2025-01-02T03:04:05.5",
+			want: time.Date(2025, 1, 2, 3, 4, 5, 500000000, time.UTC),
+		},
+		{
+			name: "space separated",
+			raw:  "2025-01-02 03:04:05",
+			want: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		},
+		{
+			name:    "invalid",
+			raw:     "yesterday",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &TransactionResponse{CreatedAt: tt.raw}
+			got, err := tx.CreatedTime()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CreatedTime() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !got.Equal(tt.want) {
+				t.Errorf("CreatedTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTransactionResponse_ExplorerURL(t *testing.T) {
+	tests := []struct {
+		name string
+		tx   TransactionResponse
+		want string
+	}{
+		{
+			name: "ethereum",
+			tx:   TransactionResponse{Network: "ETHEREUM", TransactionHash: "0xabc"},
+			want: "https://etherscan.io/tx/0xabc",
+		},
+		{
+			name: "polygon",
+			tx:   TransactionResponse{Network: "POLYGON", TransactionHash: "0xdef"},
+			want: "https://polygonscan.com/tx/0xdef",
+		},
+		{
+			name: "not broadcast",
+			tx:   TransactionResponse{Network: "ETHEREUM"},
+			want: "",
+		},
+		{
+			name: "fiat",
+			tx:   TransactionResponse{Network: "US_ACH", TransactionHash: "trace"},
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tx.ExplorerURL(); got != tt.want {
+				t.Errorf("ExplorerURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMonthPeriod(t *testing.T) {
+	tests := []struct {
+		name      string
+		year      int
+		month     time.Month
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{
+			name:      "january",
+			year:      2025,
+			month:     time.January,
+			wantStart: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "december rolls over year",
+			year:      2025,
+			month:     time.December,
+			wantStart: time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MonthPeriod(tt.year, tt.month, nil)
+			if !got.Start.Equal(tt.wantStart) || !got.End.Equal(tt.wantEnd) {
+				t.Errorf("MonthPeriod() = [%v, %v), want [%v, %v)", got.Start, got.End, tt.wantStart, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestTransactionResponse_SettlementWindowTimes(t *testing.T) {
+	tests := []struct {
+		name   string
+		window *SettlementWindow
+		wantOK bool
+	}{
+		{
+			name:   "valid window",
+			window: &SettlementWindow{EarliestAt: "2025-01-02T00:00:00Z", LatestAt: "2025-01-03T00:00:00Z"},
+			wantOK: true,
+		},
+		{
+			name:   "no window",
+			window: nil,
+		},
+		{
+			name:   "invalid latest",
+			window: &SettlementWindow{EarliestAt: "2025-01-02T00:00:00Z", LatestAt: "soon"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &TransactionResponse{Status: TransactionStatusPENDING, ExpectedSettlement: tt.window}
+			earliest, latest, ok := tx.SettlementWindowTimes()
+			if ok != tt.wantOK {
+				t.Fatalf("SettlementWindowTimes() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && !latest.After(earliest) {
+				t.Errorf("SettlementWindowTimes() = [%v, %v], want latest after earliest", earliest, latest)
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transactions
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+	"time"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+)
+
+// ErrInvalidCheckpoint is returned by ParseCheckpoint for malformed tokens.
+var ErrInvalidCheckpoint = errors.New("invalid checkpoint token")
+
+// checkpointOverlap is how far before a checkpoint a resumed listing starts, so that transactions
+// sharing the checkpoint's timestamp are fetched again whether or not created_after is inclusive.
+const checkpointOverlap = time.Second
+
+// Checkpoint marks a position in the oldest-first transaction stream.
+// The zero value starts from the beginning.
+//
+// The server orders transactions by creation time only, so a checkpoint records every processed
+// transaction sharing the latest creation time rather than relying on an order between them.
+type Checkpoint struct {
+	// CreatedAt is the creation time of the last processed transaction.
+	CreatedAt time.Time
+	// TransactionIDs are the IDs of the processed transactions created at CreatedAt.
+	TransactionIDs []svc.TransactionID
+}
+
+// IsZero reports whether the checkpoint is the start of the stream.
+func (c Checkpoint) IsZero() bool {
+	return c.CreatedAt.IsZero() && len(c.TransactionIDs) == 0
+}
+
+// Token encodes the checkpoint as an opaque string suitable for persisting.
+func (c Checkpoint) Token() string {
+	if c.IsZero() {
+		return ""
+	}
+	raw := c.CreatedAt.UTC().Format(time.RFC3339Nano) + "|" + strings.Join(c.TransactionIDs, ",")
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// ParseCheckpoint decodes a token produced by Checkpoint.Token.
+// An empty token yields the zero checkpoint.
+func ParseCheckpoint(token string) (Checkpoint, error) {
+	if token == "" {
+		return Checkpoint{}, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return Checkpoint{}, fmt.Errorf("%w: %w", ErrInvalidCheckpoint, err)
+	}
+	createdAt, ids, ok := strings.Cut(string(raw), "|")
+	if !ok || ids == "" {
+		return Checkpoint{}, ErrInvalidCheckpoint
+	}
+	t, err := time.Parse(time.RFC3339Nano, createdAt)
+	if err != nil {
+		return Checkpoint{}, fmt.Errorf("%w: %w", ErrInvalidCheckpoint, err)
+	}
+	c := Checkpoint{CreatedAt: t}
+	for id := range strings.SplitSeq(ids, ",") {
+		if id == "" {
+			return Checkpoint{}, ErrInvalidCheckpoint
+		}
+		c.TransactionIDs = append(c.TransactionIDs, svc.TransactionID(id))
+	}
+	return c, nil
+}
+
+// Advance returns the checkpoint positioned just after tx, which must have been yielded by All
+// after every transaction already covered by c.
+func (c Checkpoint) Advance(tx *TransactionResponse) (Checkpoint, error) {
+	createdAt, err := tx.CreatedTime()
+	if err != nil {
+		return c, err
+	}
+	switch {
+	case createdAt.After(c.CreatedAt):
+		return Checkpoint{CreatedAt: createdAt, TransactionIDs: []svc.TransactionID{tx.TransactionID}}, nil
+	case createdAt.Equal(c.CreatedAt) && !c.covers(createdAt, tx.TransactionID):
+		ids := append(slices.Clip(c.TransactionIDs), tx.TransactionID)
+		return Checkpoint{CreatedAt: c.CreatedAt, TransactionIDs: ids}, nil
+	default:
+		return c, nil
+	}
+}
+
+// covers reports whether a transaction created at t with the given ID was already processed.
+func (c Checkpoint) covers(t time.Time, id svc.TransactionID) bool {
+	if c.IsZero() {
+		return false
+	}
+	if !t.Equal(c.CreatedAt) {
+		return t.Before(c.CreatedAt)
+	}
+	return slices.Contains(c.TransactionIDs, id)
+}
+
+// All iterates over every transaction matching the filter, oldest first, starting after the checkpoint.
+func (s *serviceImpl) All(
+	ctx context.Context,
+	id svc.CustomerID,
+	filter *ListTransactionsRequest,
+	after Checkpoint,
+) iter.Seq2[*TransactionResponse, error] {
+	return allTransactions(ctx, s, id, filter, after)
+}
+
+func allTransactions(
+	ctx context.Context,
+	service Service,
+	id svc.CustomerID,
+	filter *ListTransactionsRequest,
+	after Checkpoint,
+) iter.Seq2[*TransactionResponse, error] {
+	return func(yield func(*TransactionResponse, error) bool) {
+		req := ListTransactionsRequest{}
+		if filter != nil {
+			req = *filter
+		}
+		req.SortOrder = assets.SortOrderASC
+		req.Size = exportPageSize
+		if !after.IsZero() {
+			req.CreatedAfter = after.CreatedAt.Add(-checkpointOverlap).UTC().Format(time.RFC3339Nano)
+			req.StartTime = time.Time{}
+		}
+
+		for page := 1; ; page++ {
+			if err := ctx.Err(); err != nil {
+				yield(nil, err)
+				return
+			}
+
+			req.Page = page
+			resp, err := service.ListTransactions(ctx, id, &req)
+			if err != nil {
+				yield(nil, fmt.Errorf("failed to list transactions (page %d): %w", page, err))
+				return
+			}
+
+			for i := range resp.List {
+				tx := &resp.List[i]
+				createdAt, err := tx.CreatedTime()
+				if err != nil {
+					if !yield(nil, fmt.Errorf("transaction %s: %w", tx.TransactionID, err)) {
+						return
+					}
+					continue
+				}
+				if after.covers(createdAt, tx.TransactionID) {
+					continue
+				}
+				if !yield(tx, nil) {
+					return
+				}
+			}
+
+			if len(resp.List) < exportPageSize {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,167 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transactions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"testing"
+	"time"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+)
+
+func TestCheckpoint_Token(t *testing.T) {
+	cp := Checkpoint{CreatedAt: time.Date(2025, 1, 2, 3, 4, 5, 6, time.UTC), TransactionIDs: []svc.TransactionID{"tx-1", "tx-0"}}
+
+	got, err := ParseCheckpoint(cp.Token())
+	if err != nil {
+		t.Fatalf("ParseCheckpoint() error = %v", err)
+	}
+	if !got.CreatedAt.Equal(cp.CreatedAt) || !slices.Equal(got.TransactionIDs, cp.TransactionIDs) {
+		t.Errorf("ParseCheckpoint() = %+v, want %+v", got, cp)
+	}
+
+	if zero, err := ParseCheckpoint(""); err != nil || !zero.IsZero() {
+		t.Errorf("ParseCheckpoint(\"\") = %+v, %v, want zero checkpoint", zero, err)
+	}
+	if _, err := ParseCheckpoint("not-a-token"); !errors.Is(err, ErrInvalidCheckpoint) {
+		t.Errorf("ParseCheckpoint() error = %v, want ErrInvalidCheckpoint", err)
+	}
+}
+
+func TestAllTransactions_Resume(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	txs := make([]TransactionResponse, 230)
+	for i := range txs {
+		txs[i] = TransactionResponse{
+			TransactionID: svc.TransactionID(fmt.Sprintf("tx-%03d", i)),
+			CreatedAt:     base.Add(time.Duration(i/2) * time.Second).Format(time.RFC3339Nano),
+		}
+	}
+	service := &fakeListService{txs: txs}
+
+	var ids []string
+	var checkpoint Checkpoint
+	for tx, err := range allTransactions(context.Background(), service, "cid", nil, Checkpoint{}) {
+		if err != nil {
+			t.Fatalf("allTransactions() error = %v", err)
+		}
+		ids = append(ids, tx.TransactionID)
+		checkpoint, err = checkpoint.Advance(tx)
+		if err != nil {
+			t.Fatalf("Advance() error = %v", err)
+		}
+		if len(ids) == 101 {
+			break
+		}
+	}
+
+	resumed, err := ParseCheckpoint(checkpoint.Token())
+	if err != nil {
+		t.Fatalf("ParseCheckpoint() error = %v", err)
+	}
+	for tx, err := range allTransactions(context.Background(), service, "cid", nil, resumed) {
+		if err != nil {
+			t.Fatalf("allTransactions() error = %v", err)
+		}
+		ids = append(ids, tx.TransactionID)
+	}
+
+	if len(ids) != len(txs) {
+		t.Fatalf("allTransactions() yielded %d transactions, want %d", len(ids), len(txs))
+	}
+	for i, id := range ids {
+		if want := fmt.Sprintf("tx-%03d", i); id != want {
+			t.Fatalf("transaction %d = %q, want %q", i, id, want)
+		}
+	}
+}
+
+// fakeTimeOrderedService serves transactions sorted by creation time only, keeping the given
+// order between transactions created at the same time, and applies created_after exclusively.
+type fakeTimeOrderedService struct {
+	Service
+	txs []TransactionResponse
+}
+
+func (f *fakeTimeOrderedService) ListTransactions(
+	_ context.Context, _ svc.CustomerID, req *ListTransactionsRequest,
+) (*ListTransactionsResponse, error) {
+	var after time.Time
+	if req.CreatedAfter != "" {
+		after, _ = time.Parse(time.RFC3339Nano, req.CreatedAfter)
+	}
+	var matches []TransactionResponse
+	for _, tx := range f.txs {
+		if created, _ := tx.CreatedTime(); created.After(after) {
+			matches = append(matches, tx)
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		a, _ := matches[i].CreatedTime()
+		b, _ := matches[j].CreatedTime()
+		return a.Before(b)
+	})
+	start := min((req.Page-1)*req.Size, len(matches))
+	end := min(start+req.Size, len(matches))
+	return &ListTransactionsResponse{List: matches[start:end], Total: len(matches)}, nil
+}
+
+func TestAllTransactions_ResumeEqualTimestamps(t *testing.T) {
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC).Format(time.RFC3339Nano)
+	later := time.Date(2025, 1, 1, 0, 0, 1, 0, time.UTC).Format(time.RFC3339Nano)
+	service := &fakeTimeOrderedService{txs: []TransactionResponse{
+		{TransactionID: "tx-c", CreatedAt: created},
+		{TransactionID: "tx-a", CreatedAt: created},
+		{TransactionID: "tx-b", CreatedAt: created},
+		{TransactionID: "tx-0", CreatedAt: later},
+	}}
+
+	var ids []string
+	var checkpoint Checkpoint
+	for tx, err := range allTransactions(context.Background(), service, "cid", nil, Checkpoint{}) {
+		if err != nil {
+			t.Fatalf("allTransactions() error = %v", err)
+		}
+		ids = append(ids, tx.TransactionID)
+		if checkpoint, err = checkpoint.Advance(tx); err != nil {
+			t.Fatalf("Advance() error = %v", err)
+		}
+		if len(ids) == 2 {
+			break
+		}
+	}
+
+	resumed, err := ParseCheckpoint(checkpoint.Token())
+	if err != nil {
+		t.Fatalf("ParseCheckpoint() error = %v", err)
+	}
+	for tx, err := range allTransactions(context.Background(), service, "cid", nil, resumed) {
+		if err != nil {
+			t.Fatalf("allTransactions() error = %v", err)
+		}
+		ids = append(ids, tx.TransactionID)
+	}
+
+	if want := []string{"tx-c", "tx-a", "tx-b", "tx-0"}; !slices.Equal(ids, want) {
+		t.Errorf("allTransactions() yielded %v, want %v", ids, want)
+	}
+}
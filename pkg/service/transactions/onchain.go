@@ -0,0 +1,54 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transactions
+
+import (
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+)
+
+// ExplorerTxURLs maps crypto networks to the block-explorer URL prefix for a transaction hash.
+// Callers targeting testnets can override entries.
+var ExplorerTxURLs = map[assets.NetworkName]string{
+	assets.NetworkNameARBITRUM:  "https://arbiscan.io/tx/",
+	assets.NetworkNameAVALANCHE: "https://snowtrace.io/tx/",
+	assets.NetworkNameBASE:      "https://basescan.org/tx/",
+	assets.NetworkNameBNBCHAIN:  "https://bscscan.com/tx/",
+	assets.NetworkNameETHEREUM:  "https://etherscan.io/tx/",
+	assets.NetworkNamePOLYGON:   "https://polygonscan.com/tx/",
+	assets.NetworkNameSOLANA:    "https://solscan.io/tx/",
+}
+
+// ExplorerURL returns the block-explorer URL for a transaction hash on the given network.
+// Returns an empty string for fiat networks, unknown networks, or an empty hash.
+func ExplorerURL(network assets.NetworkName, txHash string) string {
+	prefix, ok := ExplorerTxURLs[network]
+	if !ok || txHash == "" {
+		return ""
+	}
+	return prefix + txHash
+}
+
+// ExplorerURL returns the block-explorer URL for the transaction, or an empty string
+// if it is not an on-chain transaction or has not been broadcast yet.
+func (tx *TransactionResponse) ExplorerURL() string {
+	return ExplorerURL(tx.NetworkName(), tx.TransactionHash)
+}
+
+// IsOnChain reports whether the transaction has been broadcast to a blockchain.
+func (tx *TransactionResponse) IsOnChain() bool {
+	return tx.TransactionHash != ""
+}
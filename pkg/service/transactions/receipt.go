@@ -0,0 +1,115 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transactions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/1Money-Co/1money-go-sdk/internal/transport"
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+)
+
+// GetReceipt response types.
+type (
+	// Receipt represents the structured data of a transaction receipt.
+	Receipt struct {
+		// ReceiptNumber is the unique receipt number.
+		ReceiptNumber string `json:"receipt_number"`
+		// TransactionID is the transaction the receipt is for.
+		TransactionID svc.TransactionID `json:"transaction_id"`
+		// TransactionAction is the transaction type (DEPOSIT, WITHDRAWAL, CONVERSION).
+		TransactionAction string `json:"transaction_action"`
+		// Status is the transaction status at the time the receipt was issued.
+		Status TransactionStatus `json:"status"`
+		// Amount is the transaction amount.
+		Amount string `json:"amount"`
+		// Asset is the transaction asset.
+		Asset string `json:"asset"`
+		// Network is the transaction network.
+		Network string `json:"network,omitempty"`
+		// TransactionFee contains the fee information.
+		TransactionFee TransactionFee `json:"transaction_fee"`
+		// Source contains the transaction source details.
+		Source TransactionEndpoint `json:"source"`
+		// Destination contains the transaction destination details.
+		Destination TransactionEndpoint `json:"destination"`
+		// Reference is the external settlement reference (on-chain hash or bank trace number).
+		Reference string `json:"reference,omitempty"`
+		// CompletedAt is the timestamp when the transaction completed (ISO 8601 format).
+		CompletedAt string `json:"completed_at,omitempty"`
+		// IssuedAt is the timestamp when the receipt was issued (ISO 8601 format).
+		IssuedAt string `json:"issued_at"`
+	}
+
+	// ReceiptResponse represents a downloaded receipt.
+	ReceiptResponse struct {
+		// Format is the format of the receipt.
+		Format ReceiptFormat
+		// ContentType is the MIME type returned by the server.
+		ContentType string
+		// Content is the raw receipt document (the PDF bytes for PDF receipts).
+		Content []byte
+		// Receipt is the structured receipt data; only set for JSON receipts.
+		Receipt *Receipt
+	}
+)
+
+// GetReceipt retrieves a proof-of-payment receipt for a transaction.
+func (s *serviceImpl) GetReceipt(
+	ctx context.Context,
+	id svc.CustomerID,
+	transactionID svc.TransactionID,
+	format ReceiptFormat,
+) (*ReceiptResponse, error) {
+	accept, ok := map[ReceiptFormat]string{
+		ReceiptFormatPDF:  "application/pdf",
+		ReceiptFormatJSON: "application/json",
+	}[format]
+	if !ok {
+		return nil, fmt.Errorf("unsupported receipt format: %q", format)
+	}
+
+	path := fmt.Sprintf("/v1/customers/%s/transactions/%s/receipt", id, transactionID)
+	resp, err := s.Do(ctx, &transport.Request{
+		Method:      http.MethodGet,
+		Path:        path,
+		Headers:     map[string]string{"Accept": accept},
+		QueryParams: map[string]string{"format": strings.ToLower(format.String())},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ReceiptResponse{
+		Format:      format,
+		ContentType: resp.Headers.Get("Content-Type"),
+		Content:     resp.Body,
+	}
+	if format == ReceiptFormatJSON {
+		var receipt Receipt
+		if err := json.Unmarshal(resp.Body, &receipt); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+		result.Receipt = &receipt
+	}
+
+	return result, nil
+}
@@ -0,0 +1,107 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transactions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+)
+
+// Period is a half-open time range [Start, End).
+type Period struct {
+	// Start is the inclusive start of the period.
+	Start time.Time
+	// End is the exclusive end of the period.
+	End time.Time
+}
+
+// MonthPeriod returns the period covering the given calendar month in loc.
+// If loc is nil, UTC is used.
+func MonthPeriod(year int, month time.Month, loc *time.Location) Period {
+	if loc == nil {
+		loc = time.UTC
+	}
+	start := time.Date(year, month, 1, 0, 0, 0, 0, loc)
+	return Period{Start: start, End: start.AddDate(0, 1, 0)}
+}
+
+// GetReconciliationSummary response types.
+type (
+	// ActionTotal represents the total volume of one transaction action and direction.
+	ActionTotal struct {
+		// TransactionAction is the transaction type (DEPOSIT, WITHDRAWAL, CONVERSION).
+		TransactionAction TransactionAction `json:"transaction_action"`
+		// Direction is the fund flow direction (INBOUND or OUTBOUND).
+		Direction TransactionDirection `json:"direction"`
+		// Amount is the total amount moved.
+		Amount string `json:"amount"`
+		// Count is the number of transactions.
+		Count int `json:"count"`
+	}
+
+	// AssetReconciliation represents the reconciliation of one asset over the period.
+	AssetReconciliation struct {
+		// Asset is the asset being reconciled.
+		Asset string `json:"asset"`
+		// OpeningBalance is the balance at the start of the period.
+		OpeningBalance string `json:"opening_balance"`
+		// ClosingBalance is the balance at the end of the period.
+		ClosingBalance string `json:"closing_balance"`
+		// TotalIn is the total inflow during the period.
+		TotalIn string `json:"total_in"`
+		// TotalOut is the total outflow during the period, excluding fees.
+		TotalOut string `json:"total_out"`
+		// TotalFees is the total fees charged during the period.
+		TotalFees string `json:"total_fees"`
+		// ByAction breaks down the inflows and outflows by transaction action.
+		ByAction []ActionTotal `json:"by_action"`
+	}
+
+	// ReconciliationSummary represents a reconciliation report for a statement period.
+	ReconciliationSummary struct {
+		// PeriodStart is the inclusive start of the period (ISO 8601 format).
+		PeriodStart string `json:"period_start"`
+		// PeriodEnd is the exclusive end of the period (ISO 8601 format).
+		PeriodEnd string `json:"period_end"`
+		// Assets contains the reconciliation for each asset with activity or a balance.
+		Assets []AssetReconciliation `json:"assets"`
+		// GeneratedAt is the timestamp when the report was generated (ISO 8601 format).
+		GeneratedAt string `json:"generated_at"`
+	}
+)
+
+// GetReconciliationSummary retrieves a reconciliation report for a statement period.
+func (s *serviceImpl) GetReconciliationSummary(
+	ctx context.Context,
+	id svc.CustomerID,
+	period Period,
+) (*ReconciliationSummary, error) {
+	if period.Start.IsZero() || period.End.IsZero() || !period.End.After(period.Start) {
+		return nil, errors.New("period must have a start before its end")
+	}
+
+	path := fmt.Sprintf("/v1/customers/%s/transactions/reconciliation", id)
+	params := map[string]string{
+		"period_start": period.Start.UTC().Format(time.RFC3339),
+		"period_end":   period.End.UTC().Format(time.RFC3339),
+	}
+	return svc.GetJSONWithParams[ReconciliationSummary](ctx, s.BaseService, path, params)
+}
@@ -43,7 +43,11 @@ package transactions
 import (
 	"context"
 	"fmt"
+	"io"
+	"iter"
+	"time"
 
+	"github.com/1Money-Co/1money-go-sdk/internal/transport"
 	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
 	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
 )
@@ -53,7 +57,42 @@ type Service interface {
 	// ListTransactions retrieves a list of transactions for a customer.
 	ListTransactions(ctx context.Context, id svc.CustomerID, req *ListTransactionsRequest) (*ListTransactionsResponse, error)
 	// GetTransaction retrieves a specific transaction by ID.
-	GetTransaction(ctx context.Context, id svc.CustomerID, transactionID string) (*TransactionResponse, error)
+	GetTransaction(ctx context.Context, id svc.CustomerID, transactionID svc.TransactionID) (*TransactionResponse, error)
+	// GetTransactionByIdempotencyKey retrieves a transaction by its idempotency key.
+	// Returns an error satisfying onemoney.IsNotFoundError if no transaction matches.
+	GetTransactionByIdempotencyKey(
+		ctx context.Context, id svc.CustomerID, idempotencyKey string,
+	) (*TransactionResponse, error)
+	// Export streams all transactions matching the filter to w in the given format,
+	// paginating automatically oldest first. Page, Size and SortOrder in the filter are ignored.
+	Export(
+		ctx context.Context, id svc.CustomerID, filter *ListTransactionsRequest, format ExportFormat, w io.Writer,
+	) error
+	// GetReceipt retrieves a proof-of-payment receipt for a transaction as a PDF document or structured data.
+	GetReceipt(
+		ctx context.Context, id svc.CustomerID, transactionID svc.TransactionID, format ReceiptFormat,
+	) (*ReceiptResponse, error)
+	// Watch polls for transactions matching the filter that are created after opts.Since, or after
+	// the newest existing transaction if Since is unset, and delivers each newly observed
+	// transaction once on the returned channel, oldest first. The channel is closed when ctx is done.
+	Watch(ctx context.Context, id svc.CustomerID, filter *ListTransactionsRequest, opts *WatchOptions) <-chan WatchEvent
+	// UpdateMetadata replaces the notes and tags attached to a transaction.
+	UpdateMetadata(
+		ctx context.Context, id svc.CustomerID, transactionID svc.TransactionID, notes string, tags []string,
+	) (*TransactionResponse, error)
+	// All iterates over every transaction matching the filter, oldest first, starting after the
+	// given checkpoint. Advance the checkpoint with each yielded transaction to persist progress.
+	All(
+		ctx context.Context, id svc.CustomerID, filter *ListTransactionsRequest, after Checkpoint,
+	) iter.Seq2[*TransactionResponse, error]
+	// GetChain retrieves every transaction linked to the given one (e.g., a conversion's debit and
+	// credit legs, or an auto-conversion deposit → conversion → withdrawal chain), oldest first.
+	GetChain(ctx context.Context, id svc.CustomerID, transactionID svc.TransactionID) (*TransactionChain, error)
+	// GetReconciliationSummary retrieves per-asset opening/closing balances, inflow and outflow
+	// totals by action, and fee totals for a statement period.
+	GetReconciliationSummary(
+		ctx context.Context, id svc.CustomerID, period Period,
+	) (*ReconciliationSummary, error)
 }
 
 // Common types for transaction operations.
@@ -78,12 +117,60 @@ type (
 		AddressID string `json:"address_id"`
 	}
 
+	// SettlementWindow represents the expected settlement window of a pending transaction.
+	SettlementWindow struct {
+		// EarliestAt is the earliest expected settlement time (ISO 8601 format).
+		EarliestAt string `json:"earliest_at"`
+		// LatestAt is the latest expected settlement time (ISO 8601 format).
+		LatestAt string `json:"latest_at"`
+	}
+
+	// RelatedTransaction represents a link from a transaction to a related transaction.
+	RelatedTransaction struct {
+		// TransactionID is the related transaction identifier.
+		TransactionID svc.TransactionID `json:"transaction_id"`
+		// Relation describes the related transaction relative to this one.
+		Relation RelationType `json:"relation"`
+		// TransactionAction is the related transaction type (DEPOSIT, WITHDRAWAL, CONVERSION).
+		TransactionAction string `json:"transaction_action"`
+	}
+
+	// Originator identifies the sender of an incoming deposit.
+	Originator struct {
+		// Name is the sender's full legal name.
+		Name string `json:"name,omitempty"`
+		// BankName is the sending bank, for fiat deposits.
+		BankName string `json:"bank_name,omitempty"`
+		// AccountNumber is the sender's bank account number or IBAN, for fiat deposits.
+		AccountNumber string `json:"account_number,omitempty"`
+		// Address is the sender's postal address.
+		Address *OriginatorAddress `json:"address,omitempty"`
+		// WalletAddress is the originating wallet address, for crypto deposits.
+		WalletAddress string `json:"wallet_address,omitempty"`
+	}
+
+	// OriginatorAddress represents the postal address of a deposit originator.
+	OriginatorAddress struct {
+		// StreetLine1 is the primary street address.
+		StreetLine1 string `json:"street_line_1,omitempty"`
+		// StreetLine2 is the secondary address line.
+		StreetLine2 string `json:"street_line_2,omitempty"`
+		// City is the city name.
+		City string `json:"city,omitempty"`
+		// State is the state or province.
+		State string `json:"state,omitempty"`
+		// PostalCode is the postal or ZIP code.
+		PostalCode string `json:"postal_code,omitempty"`
+		// Country is the ISO 3166-1 alpha-3 country code.
+		Country string `json:"country,omitempty"`
+	}
+
 	// TransactionResponse represents a transaction.
 	TransactionResponse struct {
 		// CustomerID is the customer ID.
-		CustomerID string `json:"customer_id"`
+		CustomerID svc.CustomerID `json:"customer_id"`
 		// TransactionID is the unique transaction identifier.
-		TransactionID string `json:"transaction_id"`
+		TransactionID svc.TransactionID `json:"transaction_id"`
 		// IdempotencyKey is the external transaction identifier.
 		IdempotencyKey string `json:"idempotency_key"`
 		// TransactionAction is the transaction type (DEPOSIT, WITHDRAWAL, CONVERSION).
@@ -100,8 +187,30 @@ type (
 		Source TransactionEndpoint `json:"source"`
 		// Destination contains the transaction destination details.
 		Destination TransactionEndpoint `json:"destination"`
+		// Originator identifies the sender of a deposit, when known.
+		Originator *Originator `json:"originator,omitempty"`
 		// Status is the current transaction status: PENDING, COMPLETED, FAILED, or REVERSED.
 		Status TransactionStatus `json:"status"`
+		// ExpectedSettlement is the expected settlement window; only set while PENDING.
+		ExpectedSettlement *SettlementWindow `json:"expected_settlement,omitempty"`
+		// HoldReason is the machine-readable reason a PENDING transaction has not settled yet.
+		HoldReason HoldReason `json:"hold_reason,omitempty"`
+		// HoldDetail is a human-readable explanation of the hold (optional).
+		HoldDetail string `json:"hold_detail,omitempty"`
+		// TransactionHash is the on-chain transaction hash for crypto transactions.
+		TransactionHash string `json:"transaction_hash,omitempty"`
+		// BlockNumber is the block the crypto transaction was included in; 0 if not yet mined.
+		BlockNumber uint64 `json:"block_number,omitempty"`
+		// Confirmations is the number of block confirmations observed for the crypto transaction.
+		Confirmations uint64 `json:"confirmations,omitempty"`
+		// ParentTransactionID is the transaction that triggered this one, if any.
+		ParentTransactionID svc.TransactionID `json:"parent_transaction_id,omitempty"`
+		// RelatedTransactions lists the directly linked transactions.
+		RelatedTransactions []RelatedTransaction `json:"related_transactions,omitempty"`
+		// Notes is the free-form note attached to the transaction.
+		Notes string `json:"notes,omitempty"`
+		// Tags are the labels attached to the transaction.
+		Tags []string `json:"tags,omitempty"`
 		// CreatedAt is the transaction creation timestamp.
 		CreatedAt string `json:"created_at"`
 		// ModifiedAt is the transaction last modification timestamp.
@@ -114,13 +223,33 @@ type (
 	// ListTransactionsRequest represents optional query parameters for listing transactions.
 	ListTransactionsRequest struct {
 		// TransactionID filters by specific transaction ID.
-		TransactionID string `json:"transaction_id,omitempty"`
+		TransactionID svc.TransactionID `json:"transaction_id,omitempty"`
+		// IdempotencyKey filters by the idempotency key the transaction was created with.
+		IdempotencyKey string `json:"idempotency_key,omitempty"`
 		// Asset filters by asset name.
 		Asset assets.AssetName `json:"asset,omitempty"`
 		// CreatedAfter filters transactions created after this timestamp (RFC3339/ISO 8601 format).
 		CreatedAfter string `json:"created_after,omitempty"`
 		// CreatedBefore filters transactions created before this timestamp (RFC3339/ISO 8601 format).
 		CreatedBefore string `json:"created_before,omitempty"`
+		// StartTime filters transactions created at or after this time.
+		// It is a typed alternative to CreatedAfter and is ignored if CreatedAfter is set.
+		StartTime time.Time `json:"-"`
+		// EndTime filters transactions created before this time.
+		// It is a typed alternative to CreatedBefore and is ignored if CreatedBefore is set.
+		EndTime time.Time `json:"-"`
+		// TransactionAction filters by transaction type (DEPOSIT, WITHDRAWAL, CONVERSION).
+		TransactionAction TransactionAction `json:"transaction_action,omitempty"`
+		// Status filters by transaction status.
+		Status TransactionStatus `json:"status,omitempty"`
+		// Network filters by transaction network.
+		Network assets.NetworkName `json:"network,omitempty"`
+		// Direction filters by fund flow direction (INBOUND or OUTBOUND).
+		Direction TransactionDirection `json:"direction,omitempty"`
+		// Tag filters by a tag attached with UpdateMetadata.
+		Tag string `json:"tag,omitempty"`
+		// SortOrder orders results by creation time (ASC or DESC). Defaults to the server order (newest first).
+		SortOrder assets.SortOrder `json:"sort_order,omitempty"`
 		// Page is the page number (starts from 1).
 		Page int `json:"page,omitempty"`
 		// Size is the number of items per page (1-100).
@@ -136,6 +265,22 @@ type (
 	}
 )
 
+// TransactionChain represents a group of linked transactions.
+type TransactionChain struct {
+	// RootTransactionID is the transaction that started the chain.
+	RootTransactionID svc.TransactionID `json:"root_transaction_id"`
+	// Transactions contains every transaction in the chain, oldest first.
+	Transactions []TransactionResponse `json:"transactions"`
+}
+
+// UpdateMetadataRequest represents the request body for updating transaction metadata.
+type UpdateMetadataRequest struct {
+	// Notes is the free-form note to attach; an empty string clears it.
+	Notes string `json:"notes"`
+	// Tags are the labels to attach; an empty list clears them.
+	Tags []string `json:"tags"`
+}
+
 type serviceImpl struct {
 	*svc.BaseService
 }
@@ -158,16 +303,41 @@ func (s *serviceImpl) ListTransactions(
 	params := make(map[string]string)
 	if req != nil {
 		if req.TransactionID != "" {
-			params["transaction_id"] = req.TransactionID
+			params["transaction_id"] = string(req.TransactionID)
+		}
+		if req.IdempotencyKey != "" {
+			params["idempotency_key"] = req.IdempotencyKey
 		}
 		if req.Asset != "" {
 			params["asset"] = string(req.Asset)
 		}
 		if req.CreatedAfter != "" {
 			params["created_after"] = req.CreatedAfter
+		} else if !req.StartTime.IsZero() {
+			params["created_after"] = req.StartTime.UTC().Format(time.RFC3339)
 		}
 		if req.CreatedBefore != "" {
 			params["created_before"] = req.CreatedBefore
+		} else if !req.EndTime.IsZero() {
+			params["created_before"] = req.EndTime.UTC().Format(time.RFC3339)
+		}
+		if req.TransactionAction != "" {
+			params["transaction_action"] = string(req.TransactionAction)
+		}
+		if req.Status != "" {
+			params["status"] = string(req.Status)
+		}
+		if req.Network != "" {
+			params["network"] = string(req.Network)
+		}
+		if req.Direction != "" {
+			params["direction"] = string(req.Direction)
+		}
+		if req.Tag != "" {
+			params["tag"] = req.Tag
+		}
+		if req.SortOrder != "" {
+			params["sort_order"] = string(req.SortOrder)
 		}
 		if req.Page > 0 {
 			params["page"] = fmt.Sprintf("%d", req.Page)
@@ -184,8 +354,57 @@ func (s *serviceImpl) ListTransactions(
 func (s *serviceImpl) GetTransaction(
 	ctx context.Context,
 	id svc.CustomerID,
-	transactionID string,
+	transactionID svc.TransactionID,
 ) (*TransactionResponse, error) {
 	path := fmt.Sprintf("/v1/customers/%s/transactions/%s", id, transactionID)
 	return svc.GetJSON[TransactionResponse](ctx, s.BaseService, path)
 }
+
+// GetTransactionByIdempotencyKey retrieves a transaction by its idempotency key.
+func (s *serviceImpl) GetTransactionByIdempotencyKey(
+	ctx context.Context,
+	id svc.CustomerID,
+	idempotencyKey string,
+) (*TransactionResponse, error) {
+	resp, err := s.ListTransactions(ctx, id, &ListTransactionsRequest{
+		IdempotencyKey: idempotencyKey,
+		Page:           1,
+		Size:           1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.List) == 0 {
+		return nil, fmt.Errorf("transaction with idempotency key %s: %w", idempotencyKey, transport.ErrNotFound)
+	}
+	return &resp.List[0], nil
+}
+
+// GetChain retrieves every transaction linked to the given one, oldest first.
+func (s *serviceImpl) GetChain(
+	ctx context.Context,
+	id svc.CustomerID,
+	transactionID svc.TransactionID,
+) (*TransactionChain, error) {
+	path := fmt.Sprintf("/v1/customers/%s/transactions/%s/chain", id, transactionID)
+	return svc.GetJSON[TransactionChain](ctx, s.BaseService, path)
+}
+
+// UpdateMetadata replaces the notes and tags attached to a transaction.
+func (s *serviceImpl) UpdateMetadata(
+	ctx context.Context,
+	id svc.CustomerID,
+	transactionID svc.TransactionID,
+	notes string,
+	tags []string,
+) (*TransactionResponse, error) {
+	path := fmt.Sprintf("/v1/customers/%s/transactions/%s/metadata", id, transactionID)
+	if tags == nil {
+		tags = []string{}
+	}
+	req := &UpdateMetadataRequest{
+		Notes: notes,
+		Tags:  tags,
+	}
+	return svc.PatchJSON[*UpdateMetadataRequest, TransactionResponse](ctx, s.BaseService, path, req)
+}
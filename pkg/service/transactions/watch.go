@@ -0,0 +1,217 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transactions
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+)
+
+// WatchOptions configures Watch.
+type WatchOptions struct {
+	// PollInterval is the interval between polls. Default: 5s.
+	PollInterval time.Duration
+	// Since is the point in time after which transactions are reported. Default: the creation time
+	// of the newest matching transaction when Watch starts, as reported by the server, so that
+	// clock skew between the client and the server does not hide new transactions.
+	Since time.Time
+}
+
+// WatchEvent is delivered by Watch for each newly observed transaction or polling error.
+type WatchEvent struct {
+	// Transaction is the newly observed transaction; nil if Err is set.
+	Transaction *TransactionResponse
+	// Err is a polling error. Watching continues after errors.
+	Err error
+}
+
+// Watch polls for new transactions and delivers them on the returned channel.
+func (s *serviceImpl) Watch(
+	ctx context.Context,
+	id svc.CustomerID,
+	filter *ListTransactionsRequest,
+	opts *WatchOptions,
+) <-chan WatchEvent {
+	return watchTransactions(ctx, s, id, filter, opts)
+}
+
+func watchTransactions(
+	ctx context.Context,
+	service Service,
+	id svc.CustomerID,
+	filter *ListTransactionsRequest,
+	opts *WatchOptions,
+) <-chan WatchEvent {
+	interval := 5 * time.Second
+	w := &watcher{
+		service: service,
+		id:      id,
+	}
+	if opts != nil {
+		if opts.PollInterval > 0 {
+			interval = opts.PollInterval
+		}
+		if !opts.Since.IsZero() {
+			w.cursor = Checkpoint{CreatedAt: opts.Since}
+			w.started = true
+		}
+	}
+	if filter != nil {
+		w.filter = *filter
+	}
+	w.filter.StartTime = time.Time{}
+	w.filter.Size = exportPageSize
+
+	events := make(chan WatchEvent)
+	go func() {
+		defer close(events)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			if !w.poll(ctx, events) {
+				return
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return events
+}
+
+// watcher tracks the position of a Watch call in the oldest-first transaction stream.
+type watcher struct {
+	service Service
+	id      svc.CustomerID
+	filter  ListTransactionsRequest
+	cursor  Checkpoint
+	// started reports whether the cursor has been positioned at the server's newest transaction
+	// or at WatchOptions.Since.
+	started bool
+}
+
+type observedTransaction struct {
+	tx        *TransactionResponse
+	createdAt time.Time
+}
+
+// start positions the cursor after the newest matching transaction on the server.
+func (w *watcher) start(ctx context.Context) error {
+	req := w.filter
+	req.SortOrder = assets.SortOrderDESC
+	req.Page = 1
+	resp, err := w.service.ListTransactions(ctx, w.id, &req)
+	if err != nil {
+		return fmt.Errorf("failed to list newest transactions: %w", err)
+	}
+
+	var cursor Checkpoint
+	for i := range resp.List {
+		createdAt, err := resp.List[i].CreatedTime()
+		if err != nil {
+			return fmt.Errorf("transaction %s: %w", resp.List[i].TransactionID, err)
+		}
+		if createdAt.Before(cursor.CreatedAt) {
+			break
+		}
+		if cursor, err = cursor.Advance(&resp.List[i]); err != nil {
+			return err
+		}
+	}
+	w.cursor = cursor
+	w.started = true
+	return nil
+}
+
+// poll fetches transactions created since the cursor and emits the unseen ones.
+// Returns false if ctx is done.
+func (w *watcher) poll(ctx context.Context, events chan<- WatchEvent) bool {
+	if !w.started {
+		if err := w.start(ctx); err != nil {
+			return emit(ctx, events, WatchEvent{Err: err})
+		}
+		return true
+	}
+
+	// Page oldest first and start slightly before the cursor; covered transactions are skipped by ID.
+	req := w.filter
+	req.SortOrder = assets.SortOrderASC
+	if !w.cursor.IsZero() {
+		req.CreatedAfter = w.cursor.CreatedAt.Add(-checkpointOverlap).UTC().Format(time.RFC3339Nano)
+	}
+
+	var observed []observedTransaction
+	for page := 1; ; page++ {
+		req.Page = page
+		resp, err := w.service.ListTransactions(ctx, w.id, &req)
+		if err != nil {
+			return emit(ctx, events, WatchEvent{Err: fmt.Errorf("failed to list transactions: %w", err)})
+		}
+
+		for i := range resp.List {
+			tx := &resp.List[i]
+			createdAt, err := tx.CreatedTime()
+			if err != nil {
+				if !emit(ctx, events, WatchEvent{Err: fmt.Errorf("transaction %s: %w", tx.TransactionID, err)}) {
+					return false
+				}
+				continue
+			}
+			if w.cursor.covers(createdAt, tx.TransactionID) {
+				continue
+			}
+			observed = append(observed, observedTransaction{tx: tx, createdAt: createdAt})
+		}
+
+		if len(resp.List) < exportPageSize {
+			break
+		}
+	}
+
+	sort.SliceStable(observed, func(i, j int) bool {
+		return observed[i].createdAt.Before(observed[j].createdAt)
+	})
+
+	for _, o := range observed {
+		if !emit(ctx, events, WatchEvent{Transaction: o.tx}) {
+			return false
+		}
+		w.cursor, _ = w.cursor.Advance(o.tx)
+	}
+
+	return true
+}
+
+// emit sends an event unless ctx is done. Returns false if ctx is done.
+func emit(ctx context.Context, events chan<- WatchEvent, event WatchEvent) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case events <- event:
+		return true
+	}
+}
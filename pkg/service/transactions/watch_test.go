@@ -0,0 +1,194 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transactions
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+)
+
+// fakeFeedService serves a growing, unfiltered transaction feed.
+type fakeFeedService struct {
+	Service
+	mu       sync.Mutex
+	txs      []TransactionResponse
+	requests []ListTransactionsRequest
+}
+
+func (f *fakeFeedService) add(id string, createdAt time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.txs = append(f.txs, TransactionResponse{TransactionID: svc.TransactionID(id), CreatedAt: createdAt.Format(time.RFC3339Nano)})
+}
+
+// ListTransactions sorts the feed by creation time as requested, ascending by default, and pages it.
+// Like the server, it breaks no ties between equal timestamps.
+func (f *fakeFeedService) ListTransactions(
+	_ context.Context, _ svc.CustomerID, req *ListTransactionsRequest,
+) (*ListTransactionsResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests = append(f.requests, *req)
+
+	list := append([]TransactionResponse(nil), f.txs...)
+	sort.SliceStable(list, func(i, j int) bool {
+		a, _ := list[i].CreatedTime()
+		b, _ := list[j].CreatedTime()
+		if req.SortOrder == assets.SortOrderDESC {
+			return a.After(b)
+		}
+		return a.Before(b)
+	})
+	start := min((max(req.Page, 1)-1)*req.Size, len(list))
+	end := min(start+req.Size, len(list))
+	return &ListTransactionsResponse{List: list[start:end], Total: len(list)}, nil
+}
+
+func TestWatchTransactions(t *testing.T) {
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	service := &fakeFeedService{}
+	service.add("old", since.Add(-time.Minute))
+	service.add("b", since.Add(2*time.Second))
+	service.add("a", since.Add(time.Second))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	events := watchTransactions(ctx, service, "cid", nil, &WatchOptions{
+		PollInterval: 10 * time.Millisecond,
+		Since:        since,
+	})
+
+	next := func() string {
+		t.Helper()
+		select {
+		case ev := <-events:
+			if ev.Err != nil {
+				t.Fatalf("unexpected watch error: %v", ev.Err)
+			}
+			return ev.Transaction.TransactionID
+		case <-ctx.Done():
+			t.Fatal("timed out waiting for watch event")
+			return ""
+		}
+	}
+
+	if got := next(); got != "a" {
+		t.Errorf("first event = %q, want %q", got, "a")
+	}
+	if got := next(); got != "b" {
+		t.Errorf("second event = %q, want %q", got, "b")
+	}
+
+	// A transaction sharing the cursor timestamp must still be delivered exactly once.
+	service.add("c", since.Add(2*time.Second))
+	service.add("d", since.Add(3*time.Second))
+	if got := next(); got != "c" {
+		t.Errorf("third event = %q, want %q", got, "c")
+	}
+	if got := next(); got != "d" {
+		t.Errorf("fourth event = %q, want %q", got, "d")
+	}
+
+	select {
+	case ev := <-events:
+		t.Errorf("unexpected extra event: %+v", ev)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	cancel()
+	for range events {
+	}
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	for i, req := range service.requests {
+		if req.SortOrder != assets.SortOrderASC {
+			t.Errorf("request %d SortOrder = %q, want %q", i, req.SortOrder, assets.SortOrderASC)
+		}
+	}
+}
+
+func TestWatchTransactions_StartsAtServerNewest(t *testing.T) {
+	// The server clock is far ahead of the local clock, so a local-time cursor would miss everything.
+	newest := time.Now().Add(24 * time.Hour).UTC()
+	service := &fakeFeedService{}
+	service.add("old", newest.Add(-time.Minute))
+	service.add("newest-1", newest)
+	service.add("newest-2", newest)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	events := watchTransactions(ctx, service, "cid", nil, &WatchOptions{PollInterval: 10 * time.Millisecond})
+
+	// Wait until the watcher has positioned itself before adding new transactions.
+	for {
+		service.mu.Lock()
+		started := len(service.requests) > 0
+		service.mu.Unlock()
+		if started {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	service.add("same-second", newest)
+	service.add("new", newest.Add(time.Second))
+
+	var got []string
+	for len(got) < 2 {
+		select {
+		case ev := <-events:
+			if ev.Err != nil {
+				t.Fatalf("unexpected watch error: %v", ev.Err)
+			}
+			got = append(got, ev.Transaction.TransactionID)
+		case <-ctx.Done():
+			t.Fatalf("timed out after events %v", got)
+		}
+	}
+	if got[0] != "same-second" || got[1] != "new" {
+		t.Errorf("events = %v, want [same-second new]", got)
+	}
+
+	select {
+	case ev := <-events:
+		t.Errorf("unexpected extra event: %+v", ev)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	cancel()
+	for range events {
+	}
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	if first := service.requests[0]; first.SortOrder != assets.SortOrderDESC {
+		t.Errorf("first request SortOrder = %q, want %q", first.SortOrder, assets.SortOrderDESC)
+	}
+	for i, req := range service.requests[1:] {
+		if req.SortOrder != assets.SortOrderASC || req.CreatedAfter == "" {
+			t.Errorf("poll %d = {SortOrder: %q, CreatedAfter: %q}, want ASC after the cursor", i, req.SortOrder, req.CreatedAfter)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package travel_rule
+
+//go:generate go tool go-enum -f=$GOFILE --marshal --names --nocase
+
+// PacketDirection represents whether a travel-rule packet was sent or received.
+// ENUM(OUTBOUND, INBOUND)
+type PacketDirection string
+
+// PacketStatus represents the delivery status of a travel-rule packet.
+// PENDING packets have not been confirmed by the counterparty VASP yet.
+// ENUM(PENDING, ACCEPTED, REJECTED)
+type PacketStatus string
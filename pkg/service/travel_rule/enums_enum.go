@@ -0,0 +1,166 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: v0.9.2
+
+// Built By: go install
+
+package travel_rule
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// PacketDirectionOUTBOUND is a PacketDirection of type OUTBOUND.
+	PacketDirectionOUTBOUND PacketDirection = "OUTBOUND"
+	// PacketDirectionINBOUND is a PacketDirection of type INBOUND.
+	PacketDirectionINBOUND PacketDirection = "INBOUND"
+)
+
+var ErrInvalidPacketDirection = fmt.Errorf("not a valid PacketDirection, try [%s]", strings.Join(_PacketDirectionNames, ", "))
+
+var _PacketDirectionNames = []string{
+	string(PacketDirectionOUTBOUND),
+	string(PacketDirectionINBOUND),
+}
+
+// PacketDirectionNames returns a list of possible string values of PacketDirection.
+func PacketDirectionNames() []string {
+	tmp := make([]string, len(_PacketDirectionNames))
+	copy(tmp, _PacketDirectionNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x PacketDirection) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x PacketDirection) IsValid() bool {
+	_, err := ParsePacketDirection(string(x))
+	return err == nil
+}
+
+var _PacketDirectionValue = map[string]PacketDirection{
+	"OUTBOUND": PacketDirectionOUTBOUND,
+	"outbound": PacketDirectionOUTBOUND,
+	"INBOUND":  PacketDirectionINBOUND,
+	"inbound":  PacketDirectionINBOUND,
+}
+
+// ParsePacketDirection attempts to convert a string to a PacketDirection.
+func ParsePacketDirection(name string) (PacketDirection, error) {
+	if x, ok := _PacketDirectionValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _PacketDirectionValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return PacketDirection(""), fmt.Errorf("%s is %w", name, ErrInvalidPacketDirection)
+}
+
+// MarshalText implements the text marshaller method.
+func (x PacketDirection) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *PacketDirection) UnmarshalText(text []byte) error {
+	tmp, err := ParsePacketDirection(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *PacketDirection) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+const (
+	// PacketStatusPENDING is a PacketStatus of type PENDING.
+	PacketStatusPENDING PacketStatus = "PENDING"
+	// PacketStatusACCEPTED is a PacketStatus of type ACCEPTED.
+	PacketStatusACCEPTED PacketStatus = "ACCEPTED"
+	// PacketStatusREJECTED is a PacketStatus of type REJECTED.
+	PacketStatusREJECTED PacketStatus = "REJECTED"
+)
+
+var ErrInvalidPacketStatus = fmt.Errorf("not a valid PacketStatus, try [%s]", strings.Join(_PacketStatusNames, ", "))
+
+var _PacketStatusNames = []string{
+	string(PacketStatusPENDING),
+	string(PacketStatusACCEPTED),
+	string(PacketStatusREJECTED),
+}
+
+// PacketStatusNames returns a list of possible string values of PacketStatus.
+func PacketStatusNames() []string {
+	tmp := make([]string, len(_PacketStatusNames))
+	copy(tmp, _PacketStatusNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x PacketStatus) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x PacketStatus) IsValid() bool {
+	_, err := ParsePacketStatus(string(x))
+	return err == nil
+}
+
+var _PacketStatusValue = map[string]PacketStatus{
+	"PENDING":  PacketStatusPENDING,
+	"pending":  PacketStatusPENDING,
+	"ACCEPTED": PacketStatusACCEPTED,
+	"accepted": PacketStatusACCEPTED,
+	"REJECTED": PacketStatusREJECTED,
+	"rejected": PacketStatusREJECTED,
+}
+
+// ParsePacketStatus attempts to convert a string to a PacketStatus.
+func ParsePacketStatus(name string) (PacketStatus, error) {
+	if x, ok := _PacketStatusValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _PacketStatusValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return PacketStatus(""), fmt.Errorf("%s is %w", name, ErrInvalidPacketStatus)
+}
+
+// MarshalText implements the text marshaller method.
+func (x PacketStatus) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *PacketStatus) UnmarshalText(text []byte) error {
+	tmp, err := ParsePacketStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *PacketStatus) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
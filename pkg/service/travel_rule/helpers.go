@@ -0,0 +1,64 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package travel_rule
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+)
+
+// ErrInvalidPacket is returned when a travel-rule packet is incomplete.
+var ErrInvalidPacket = errors.New("invalid travel rule packet")
+
+// Validate checks that the packet is linked to a transaction and that both parties are complete.
+// Party errors also wrap withdraws.ErrInvalidTravelRule.
+func (r *SubmitPacketRequest) Validate() error {
+	if r == nil {
+		return fmt.Errorf("%w: request is nil", ErrInvalidPacket)
+	}
+	if r.TransactionID == "" {
+		return fmt.Errorf("%w: transaction_id is required", ErrInvalidPacket)
+	}
+	if err := r.Originator.Validate(); err != nil {
+		return fmt.Errorf("%w: originator: %w", ErrInvalidPacket, err)
+	}
+	if err := r.Beneficiary.Validate(); err != nil {
+		return fmt.Errorf("%w: beneficiary: %w", ErrInvalidPacket, err)
+	}
+	if r.BeneficiaryVASP != nil && r.BeneficiaryVASP.Name == "" {
+		return fmt.Errorf("%w: beneficiary_vasp.name is required", ErrInvalidPacket)
+	}
+	return nil
+}
+
+// IsTerminal reports whether the counterparty has accepted or rejected the packet.
+func (p *PacketResponse) IsTerminal() bool {
+	return p.Status == PacketStatusACCEPTED || p.Status == PacketStatusREJECTED
+}
+
+// CreatedTime parses CreatedAt into a time.Time.
+func (p *PacketResponse) CreatedTime() (time.Time, error) {
+	return svc.ParseTimestamp(p.CreatedAt)
+}
+
+// ModifiedTime parses ModifiedAt into a time.Time.
+func (p *PacketResponse) ModifiedTime() (time.Time, error) {
+	return svc.ParseTimestamp(p.ModifiedAt)
+}
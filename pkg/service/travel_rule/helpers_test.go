@@ -0,0 +1,74 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package travel_rule
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/withdraws"
+)
+
+func TestSubmitPacketRequest_Validate(t *testing.T) {
+	valid := func() *SubmitPacketRequest {
+		return &SubmitPacketRequest{
+			TransactionID: "tx-1",
+			Originator:    withdraws.TravelRuleParty{Type: withdraws.PartyTypeLEGALPERSON, LegalName: "Acme Corp"},
+			Beneficiary: withdraws.TravelRuleParty{
+				Type: withdraws.PartyTypeNATURALPERSON, FirstName: "Jane", LastName: "Doe",
+			},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		modify       func(r *SubmitPacketRequest)
+		wantErr      bool
+		wantPartyErr bool
+	}{
+		{"valid", func(*SubmitPacketRequest) {}, false, false},
+		{"valid with VASP", func(r *SubmitPacketRequest) {
+			r.BeneficiaryVASP = &withdraws.VASPInfo{Name: "Other Exchange", Country: "SG"}
+		}, false, false},
+		{"missing transaction", func(r *SubmitPacketRequest) { r.TransactionID = "" }, true, false},
+		{"incomplete originator", func(r *SubmitPacketRequest) { r.Originator.LegalName = "" }, true, true},
+		{"missing beneficiary type", func(r *SubmitPacketRequest) { r.Beneficiary.Type = "" }, true, true},
+		{"unnamed VASP", func(r *SubmitPacketRequest) { r.BeneficiaryVASP = &withdraws.VASPInfo{} }, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := valid()
+			tt.modify(req)
+			err := req.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidPacket) {
+				t.Errorf("Validate() error = %v, want ErrInvalidPacket", err)
+			}
+			if errors.Is(err, withdraws.ErrInvalidTravelRule) != tt.wantPartyErr {
+				t.Errorf("Validate() error = %v, want ErrInvalidTravelRule %v", err, tt.wantPartyErr)
+			}
+		})
+	}
+
+	var nilReq *SubmitPacketRequest
+	if err := nilReq.Validate(); !errors.Is(err, ErrInvalidPacket) {
+		t.Errorf("Validate() on nil request error = %v, want ErrInvalidPacket", err)
+	}
+}
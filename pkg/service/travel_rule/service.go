@@ -0,0 +1,207 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package travel_rule provides travel-rule information exchange linked to transactions.
+//
+// This package implements the travel rule service client for the 1Money platform,
+// enabling submission of originator and beneficiary data packets for a transaction
+// and retrieval of packets received from counterparty VASPs. It is used in
+// jurisdictions that require the exchange separately from the withdrawal itself;
+// withdraws.CreateWithdrawalRequest also accepts the same data inline.
+//
+// # Basic Usage
+//
+//	import (
+//	    "context"
+//	    onemoney "github.com/1Money-Co/1money-go-sdk/pkg/onemoney"
+//	    "github.com/1Money-Co/1money-go-sdk/pkg/service/travel_rule"
+//	    "github.com/1Money-Co/1money-go-sdk/pkg/service/withdraws"
+//	)
+//
+//	// Create client
+//	client, err := onemoney.NewClient(&onemoney.Config{
+//	    AccessKey: "your-access-key",
+//	    SecretKey: "your-secret-key",
+//	})
+//
+//	// Submit the packet for a withdrawal
+//	packet, err := client.TravelRule.SubmitPacket(ctx, "customer-id", &travel_rule.SubmitPacketRequest{
+//	    IdempotencyKey: "unique-key",
+//	    TransactionID:  "transaction-id",
+//	    Originator:     withdraws.TravelRuleParty{Type: withdraws.PartyTypeLEGALPERSON, LegalName: "Acme Corp"},
+//	    Beneficiary:    withdraws.TravelRuleParty{Type: withdraws.PartyTypeNATURALPERSON, FirstName: "Jane", LastName: "Doe"},
+//	})
+//
+//	// Packets received for incoming deposits
+//	inbound, err := client.TravelRule.ListPackets(ctx, "customer-id", &travel_rule.ListPacketsRequest{
+//	    Direction: travel_rule.PacketDirectionINBOUND,
+//	})
+package travel_rule
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/withdraws"
+)
+
+// Service defines the travel rule service interface for exchanging originator and beneficiary data.
+type Service interface {
+	// SubmitPacket submits the travel-rule data for a transaction to the counterparty VASP.
+	SubmitPacket(ctx context.Context, id svc.CustomerID, req *SubmitPacketRequest) (*PacketResponse, error)
+	// GetPacket retrieves a sent or received packet by ID.
+	GetPacket(ctx context.Context, id svc.CustomerID, packetID string) (*PacketResponse, error)
+	// ListPackets retrieves sent and received packets with optional filters and pagination.
+	ListPackets(ctx context.Context, id svc.CustomerID, req *ListPacketsRequest) (*ListPacketsResponse, error)
+}
+
+// Travel-rule packet request and response types.
+type (
+	// SubmitPacketRequest represents the request body for submitting a travel-rule packet.
+	SubmitPacketRequest struct {
+		// IdempotencyKey is a unique key to ensure idempotent submission.
+		// This is sent as a header, not in the body.
+		IdempotencyKey string `json:"-"`
+		// TransactionID is the transaction the packet describes.
+		TransactionID svc.TransactionID `json:"transaction_id"`
+		// Originator is the sender of the transfer.
+		Originator withdraws.TravelRuleParty `json:"originator"`
+		// Beneficiary is the recipient of the transfer.
+		Beneficiary withdraws.TravelRuleParty `json:"beneficiary"`
+		// BeneficiaryVASP identifies the VASP hosting the beneficiary wallet (optional).
+		BeneficiaryVASP *withdraws.VASPInfo `json:"beneficiary_vasp,omitempty"`
+	}
+
+	// PacketResponse represents a sent or received travel-rule packet.
+	PacketResponse struct {
+		// PacketID is the unique packet identifier.
+		PacketID string `json:"packet_id"`
+		// TransactionID is the transaction the packet describes.
+		TransactionID svc.TransactionID `json:"transaction_id"`
+		// Direction is whether the packet was sent or received.
+		Direction PacketDirection `json:"direction"`
+		// Status is the delivery status of the packet.
+		Status PacketStatus `json:"status"`
+		// Originator is the sender of the transfer.
+		Originator withdraws.TravelRuleParty `json:"originator"`
+		// Beneficiary is the recipient of the transfer.
+		Beneficiary withdraws.TravelRuleParty `json:"beneficiary"`
+		// OriginatorVASP identifies the VASP that sent the transfer.
+		OriginatorVASP *withdraws.VASPInfo `json:"originator_vasp,omitempty"`
+		// BeneficiaryVASP identifies the VASP hosting the beneficiary wallet.
+		BeneficiaryVASP *withdraws.VASPInfo `json:"beneficiary_vasp,omitempty"`
+		// RejectionReason explains why the counterparty rejected the packet (REJECTED only).
+		RejectionReason string `json:"rejection_reason,omitempty"`
+		// CreatedAt is the packet creation timestamp (ISO 8601 format).
+		CreatedAt string `json:"created_at"`
+		// ModifiedAt is the packet last modification timestamp (ISO 8601 format).
+		ModifiedAt string `json:"modified_at"`
+	}
+)
+
+// ListPackets request and response types.
+type (
+	// ListPacketsRequest represents optional query parameters for listing travel-rule packets.
+	ListPacketsRequest struct {
+		// TransactionID filters by the linked transaction.
+		TransactionID svc.TransactionID `json:"transaction_id,omitempty"`
+		// Direction filters by direction.
+		Direction PacketDirection `json:"direction,omitempty"`
+		// Status filters by delivery status.
+		Status PacketStatus `json:"status,omitempty"`
+		// Page is the page number (starts from 1).
+		Page int `json:"page,omitempty"`
+		// Size is the number of items per page (1-100).
+		Size int `json:"size,omitempty"`
+	}
+
+	// ListPacketsResponse represents the response for listing travel-rule packets.
+	ListPacketsResponse struct {
+		// List is the list of packets.
+		List []PacketResponse `json:"list"`
+		// Total is the total number of packets matching the filters.
+		Total int `json:"total,omitempty"`
+	}
+)
+
+type serviceImpl struct {
+	*svc.BaseService
+}
+
+// NewService creates a new travel rule service instance with the given base service.
+func NewService(base *svc.BaseService) Service {
+	return &serviceImpl{
+		BaseService: base,
+	}
+}
+
+// SubmitPacket submits the travel-rule data for a transaction.
+func (s *serviceImpl) SubmitPacket(
+	ctx context.Context,
+	id svc.CustomerID,
+	req *SubmitPacketRequest,
+) (*PacketResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/v1/customers/%s/travel-rule/packets", id)
+
+	headers := make(map[string]string)
+	if req.IdempotencyKey != "" {
+		headers["Idempotency-Key"] = req.IdempotencyKey
+	}
+
+	return svc.PostJSONWithHeaders[*SubmitPacketRequest, PacketResponse](ctx, s.BaseService, path, req, headers)
+}
+
+// GetPacket retrieves a sent or received packet by ID.
+func (s *serviceImpl) GetPacket(ctx context.Context, id svc.CustomerID, packetID string) (*PacketResponse, error) {
+	path := fmt.Sprintf("/v1/customers/%s/travel-rule/packets/%s", id, packetID)
+	return svc.GetJSON[PacketResponse](ctx, s.BaseService, path)
+}
+
+// ListPackets retrieves sent and received packets with optional filters and pagination.
+func (s *serviceImpl) ListPackets(
+	ctx context.Context,
+	id svc.CustomerID,
+	req *ListPacketsRequest,
+) (*ListPacketsResponse, error) {
+	path := fmt.Sprintf("/v1/customers/%s/travel-rule/packets/list", id)
+
+	params := make(map[string]string)
+	if req != nil {
+		if req.TransactionID != "" {
+			params["transaction_id"] = string(req.TransactionID)
+		}
+		if req.Direction != "" {
+			params["direction"] = string(req.Direction)
+		}
+		if req.Status != "" {
+			params["status"] = string(req.Status)
+		}
+		if req.Page > 0 {
+			params["page"] = strconv.Itoa(req.Page)
+		}
+		if req.Size > 0 {
+			params["size"] = strconv.Itoa(req.Size)
+		}
+	}
+
+	return svc.GetJSONWithParams[ListPacketsResponse](ctx, s.BaseService, path, params)
+}
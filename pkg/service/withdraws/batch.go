@@ -0,0 +1,235 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package withdraws
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+)
+
+// defaultBatchConcurrency is the number of withdrawals submitted in parallel when
+// BatchOptions.Concurrency is not set.
+const defaultBatchConcurrency = 5
+
+// ErrInsufficientBalance is returned by CreateBatch when the pre-flight balance check
+// finds that the batch principal exceeds the available balance for an asset.
+var ErrInsufficientBalance = errors.New("insufficient balance for batch")
+
+// BatchOptions configures CreateBatch.
+type BatchOptions struct {
+	// Concurrency is the maximum number of withdrawals submitted in parallel. Default: 5.
+	Concurrency int
+	// IdempotencyKeyPrefix derives idempotency keys for requests without an IdempotencyKey
+	// from the prefix and a hash of each request's destination, asset, network and amount,
+	// so a re-run of the same batch is deduplicated server-side even if items are reordered,
+	// filtered or inserted. Identical requests are numbered in input order to keep their keys distinct.
+	// If empty, a random UUID is generated per request instead.
+	IdempotencyKeyPrefix string
+	// SkipBalanceCheck disables the pre-flight check that the batch principal per asset and
+	// network does not exceed the available balance. The check is advisory only: fees are not
+	// included and the balance may change during submission, so withdrawals that pass it can
+	// still fail individually for insufficient funds.
+	SkipBalanceCheck bool
+}
+
+// BatchResult is the outcome of a single withdrawal in a batch.
+type BatchResult struct {
+	// Index is the position of the request in the input slice.
+	Index int
+	// IdempotencyKey is the idempotency key the request was submitted with.
+	IdempotencyKey string
+	// Response is the created withdrawal, or nil if the request failed.
+	Response *WithdrawalResponse
+	// Err is the validation or API error for this request, if any.
+	Err error
+}
+
+// CreateBatch submits many withdrawals with bounded concurrency and returns a result per request.
+// Requests failing validation are reported in their BatchResult and not submitted.
+// A non-nil error is only returned when the batch is rejected before any submission.
+func (s *serviceImpl) CreateBatch(
+	ctx context.Context,
+	id svc.CustomerID,
+	reqs []CreateWithdrawalRequest,
+	opts *BatchOptions,
+) ([]BatchResult, error) {
+	if opts == nil {
+		opts = &BatchOptions{}
+	}
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultBatchConcurrency
+	}
+
+	results := make([]BatchResult, len(reqs))
+	prepared := withBatchIdempotencyKeys(reqs, opts.IdempotencyKeyPrefix)
+	for i := range prepared {
+		results[i] = BatchResult{
+			Index:          i,
+			IdempotencyKey: prepared[i].IdempotencyKey,
+			Err:            prepared[i].Validate(),
+		}
+	}
+
+	if !opts.SkipBalanceCheck {
+		balances, err := assets.NewService(s.BaseService).ListAssets(ctx, id, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list balances: %w", err)
+		}
+		if err := checkBatchBalance(prepared, results, balances); err != nil {
+			return nil, err
+		}
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for i := range prepared {
+		if results[i].Err != nil {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			results[i].Err = err
+			continue
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i].Response, results[i].Err = s.CreateWithdrawal(ctx, id, &prepared[i])
+		}(i)
+	}
+	wg.Wait()
+
+	return results, nil
+}
+
+// withBatchIdempotencyKeys returns a copy of reqs with an idempotency key set on every
+// request that has none. See BatchOptions.IdempotencyKeyPrefix.
+func withBatchIdempotencyKeys(reqs []CreateWithdrawalRequest, prefix string) []CreateWithdrawalRequest {
+	prepared := make([]CreateWithdrawalRequest, len(reqs))
+	occurrences := make(map[[sha256.Size]byte]int)
+	for i := range reqs {
+		prepared[i] = reqs[i]
+		if prepared[i].IdempotencyKey != "" {
+			continue
+		}
+		if prefix == "" {
+			prepared[i].IdempotencyKey = uuid.New().String()
+			continue
+		}
+		sum := batchRequestHash(&prepared[i])
+		occurrences[sum]++
+		prepared[i].IdempotencyKey = batchIdempotencyKey(prefix, sum, occurrences[sum])
+	}
+	return prepared
+}
+
+// batchRequestHash hashes the fields that identify where a withdrawal sends money and how much.
+func batchRequestHash(req *CreateWithdrawalRequest) [sha256.Size]byte {
+	fields := []string{
+		string(req.Asset), string(req.Network), req.Amount,
+		req.WalletAddress, req.ExternalAccountID,
+		string(req.RecipientID), req.RecipientBankAccountID, req.RecipientWalletAddressID,
+		req.Code,
+	}
+	return sha256.Sum256([]byte(strings.Join(fields, "\x00")))
+}
+
+// batchIdempotencyKey formats the key of the nth request in a batch with the given content hash.
+func batchIdempotencyKey(prefix string, sum [sha256.Size]byte, occurrence int) string {
+	key := fmt.Sprintf("%s-%x", prefix, sum[:16])
+	if occurrence > 1 {
+		key += fmt.Sprintf("-%d", occurrence)
+	}
+	return key
+}
+
+// checkBatchBalance sums the principal of the valid requests per asset and network and
+// compares the totals against the available balances. Fees are not included.
+func checkBatchBalance(reqs []CreateWithdrawalRequest, results []BatchResult, balances []assets.AssetResponse) error {
+	totals := make(map[string]*big.Rat)
+	for i := range reqs {
+		if results[i].Err != nil {
+			continue
+		}
+		amount, ok := new(big.Rat).SetString(reqs[i].Amount)
+		if !ok {
+			results[i].Err = fmt.Errorf("invalid amount %q", reqs[i].Amount)
+			continue
+		}
+		key := balanceKey(string(reqs[i].Asset), string(reqs[i].Network), balances)
+		if totals[key] == nil {
+			totals[key] = new(big.Rat)
+		}
+		totals[key].Add(totals[key], amount)
+	}
+
+	available := make(map[string]*big.Rat)
+	for i := range balances {
+		network := ""
+		if balances[i].Network != nil {
+			network = *balances[i].Network
+		}
+		amount, ok := new(big.Rat).SetString(balances[i].AvailableAmount)
+		if !ok {
+			continue
+		}
+		available[balances[i].Asset+"/"+network] = amount
+	}
+
+	var shortfalls []string
+	for key, total := range totals {
+		have := available[key]
+		if have == nil {
+			have = new(big.Rat)
+		}
+		if total.Cmp(have) > 0 {
+			shortfalls = append(shortfalls, fmt.Sprintf("%s needs %s, available %s",
+				key, total.FloatString(2), have.FloatString(2)))
+		}
+	}
+	if len(shortfalls) > 0 {
+		sort.Strings(shortfalls)
+		return fmt.Errorf("%w: %s", ErrInsufficientBalance, strings.Join(shortfalls, "; "))
+	}
+
+	return nil
+}
+
+// balanceKey returns the balance lookup key for an asset and network. Fiat balances are
+// reported without a network, so the network is dropped when no network-specific balance exists.
+func balanceKey(asset, network string, balances []assets.AssetResponse) string {
+	for i := range balances {
+		if balances[i].Asset == asset && balances[i].Network != nil && *balances[i].Network == network {
+			return asset + "/" + network
+		}
+	}
+	return asset + "/"
+}
@@ -0,0 +1,71 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package withdraws
+
+import "testing"
+
+func TestWithBatchIdempotencyKeys(t *testing.T) {
+	alice := CreateWithdrawalRequest{Amount: "100", Asset: "USDC", Network: "POLYGON", WalletAddress: "0xalice"}
+	bob := CreateWithdrawalRequest{Amount: "100", Asset: "USDC", Network: "POLYGON", WalletAddress: "0xbob"}
+	carol := CreateWithdrawalRequest{Amount: "250", Asset: "USD", Network: "US_ACH", ExternalAccountID: "ea-carol"}
+
+	keys := func(reqs ...CreateWithdrawalRequest) map[string]string {
+		out := make(map[string]string)
+		for _, req := range withBatchIdempotencyKeys(reqs, "payroll") {
+			if _, dup := out[req.IdempotencyKey]; dup {
+				t.Fatalf("duplicate idempotency key %q", req.IdempotencyKey)
+			}
+			out[req.IdempotencyKey] = req.WalletAddress + req.ExternalAccountID
+		}
+		return out
+	}
+
+	first := keys(alice, bob, bob, carol)
+	rerun := keys(carol, bob, alice, bob)
+	if len(first) != 4 || len(rerun) != 4 {
+		t.Fatalf("got %d and %d keys, want 4", len(first), len(rerun))
+	}
+	for key, dest := range rerun {
+		if first[key] != dest {
+			t.Errorf("key %q maps to %q after reordering, want %q", key, dest, first[key])
+		}
+	}
+	for key, dest := range keys(bob, carol) {
+		if first[key] != dest {
+			t.Errorf("key %q maps to %q after filtering, want %q", key, dest, first[key])
+		}
+	}
+
+	changed := alice
+	changed.Amount = "101"
+	if key := withBatchIdempotencyKeys([]CreateWithdrawalRequest{changed}, "payroll")[0].IdempotencyKey; first[key] != "" {
+		t.Errorf("changed amount reused key %q", key)
+	}
+
+	own := alice
+	own.IdempotencyKey = "own-key"
+	reqs := []CreateWithdrawalRequest{own}
+	if got := withBatchIdempotencyKeys(reqs, "payroll")[0].IdempotencyKey; got != "own-key" {
+		t.Errorf("IdempotencyKey = %q, want own-key", got)
+	}
+	if got := withBatchIdempotencyKeys([]CreateWithdrawalRequest{alice}, "")[0].IdempotencyKey; got == "" {
+		t.Error("IdempotencyKey is empty without a prefix, want a random key")
+	}
+	if reqs[0].IdempotencyKey != "own-key" || alice.IdempotencyKey != "" {
+		t.Error("withBatchIdempotencyKeys modified its input")
+	}
+}
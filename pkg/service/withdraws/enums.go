@@ -21,3 +21,19 @@ package withdraws
 // TransactionStatus represents the status of a transaction.
 // ENUM(PENDING, COMPLETED, FAILED, REVERSED)
 type TransactionStatus string
+
+// ScheduleType represents how a scheduled withdrawal recurs.
+// ENUM(ONE_TIME, WEEKLY)
+type ScheduleType string
+
+// ScheduleStatus represents the status of a scheduled withdrawal.
+// ENUM(ACTIVE, EXECUTED, CANCELLED, FAILED)
+type ScheduleStatus string
+
+// Weekday represents a day of the week for recurring schedules.
+// ENUM(MONDAY, TUESDAY, WEDNESDAY, THURSDAY, FRIDAY, SATURDAY, SUNDAY)
+type Weekday string
+
+// PartyType represents the kind of travel-rule party (IVMS 101 person type).
+// ENUM(NATURAL_PERSON, LEGAL_PERSON)
+type PartyType string
@@ -10,6 +10,241 @@ import (
 	"strings"
 )
 
+const (
+	// PartyTypeNATURALPERSON is a PartyType of type NATURAL_PERSON.
+	PartyTypeNATURALPERSON PartyType = "NATURAL_PERSON"
+	// PartyTypeLEGALPERSON is a PartyType of type LEGAL_PERSON.
+	PartyTypeLEGALPERSON PartyType = "LEGAL_PERSON"
+)
+
+var ErrInvalidPartyType = fmt.Errorf("not a valid PartyType, try [%s]", strings.Join(_PartyTypeNames, ", "))
+
+var _PartyTypeNames = []string{
+	string(PartyTypeNATURALPERSON),
+	string(PartyTypeLEGALPERSON),
+}
+
+// PartyTypeNames returns a list of possible string values of PartyType.
+func PartyTypeNames() []string {
+	tmp := make([]string, len(_PartyTypeNames))
+	copy(tmp, _PartyTypeNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x PartyType) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x PartyType) IsValid() bool {
+	_, err := ParsePartyType(string(x))
+	return err == nil
+}
+
+var _PartyTypeValue = map[string]PartyType{
+	"NATURAL_PERSON": PartyTypeNATURALPERSON,
+	"natural_person": PartyTypeNATURALPERSON,
+	"LEGAL_PERSON":   PartyTypeLEGALPERSON,
+	"legal_person":   PartyTypeLEGALPERSON,
+}
+
+// ParsePartyType attempts to convert a string to a PartyType.
+func ParsePartyType(name string) (PartyType, error) {
+	if x, ok := _PartyTypeValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _PartyTypeValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return PartyType(""), fmt.Errorf("%s is %w", name, ErrInvalidPartyType)
+}
+
+// MarshalText implements the text marshaller method.
+func (x PartyType) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *PartyType) UnmarshalText(text []byte) error {
+	tmp, err := ParsePartyType(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *PartyType) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+const (
+	// ScheduleStatusACTIVE is a ScheduleStatus of type ACTIVE.
+	ScheduleStatusACTIVE ScheduleStatus = "ACTIVE"
+	// ScheduleStatusEXECUTED is a ScheduleStatus of type EXECUTED.
+	ScheduleStatusEXECUTED ScheduleStatus = "EXECUTED"
+	// ScheduleStatusCANCELLED is a ScheduleStatus of type CANCELLED.
+	ScheduleStatusCANCELLED ScheduleStatus = "CANCELLED"
+	// ScheduleStatusFAILED is a ScheduleStatus of type FAILED.
+	ScheduleStatusFAILED ScheduleStatus = "FAILED"
+)
+
+var ErrInvalidScheduleStatus = fmt.Errorf("not a valid ScheduleStatus, try [%s]", strings.Join(_ScheduleStatusNames, ", "))
+
+var _ScheduleStatusNames = []string{
+	string(ScheduleStatusACTIVE),
+	string(ScheduleStatusEXECUTED),
+	string(ScheduleStatusCANCELLED),
+	string(ScheduleStatusFAILED),
+}
+
+// ScheduleStatusNames returns a list of possible string values of ScheduleStatus.
+func ScheduleStatusNames() []string {
+	tmp := make([]string, len(_ScheduleStatusNames))
+	copy(tmp, _ScheduleStatusNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x ScheduleStatus) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x ScheduleStatus) IsValid() bool {
+	_, err := ParseScheduleStatus(string(x))
+	return err == nil
+}
+
+var _ScheduleStatusValue = map[string]ScheduleStatus{
+	"ACTIVE":    ScheduleStatusACTIVE,
+	"active":    ScheduleStatusACTIVE,
+	"EXECUTED":  ScheduleStatusEXECUTED,
+	"executed":  ScheduleStatusEXECUTED,
+	"CANCELLED": ScheduleStatusCANCELLED,
+	"cancelled": ScheduleStatusCANCELLED,
+	"FAILED":    ScheduleStatusFAILED,
+	"failed":    ScheduleStatusFAILED,
+}
+
+// ParseScheduleStatus attempts to convert a string to a ScheduleStatus.
+func ParseScheduleStatus(name string) (ScheduleStatus, error) {
+	if x, ok := _ScheduleStatusValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _ScheduleStatusValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return ScheduleStatus(""), fmt.Errorf("%s is %w", name, ErrInvalidScheduleStatus)
+}
+
+// MarshalText implements the text marshaller method.
+func (x ScheduleStatus) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *ScheduleStatus) UnmarshalText(text []byte) error {
+	tmp, err := ParseScheduleStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *ScheduleStatus) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+const (
+	// ScheduleTypeONETIME is a ScheduleType of type ONE_TIME.
+	ScheduleTypeONETIME ScheduleType = "ONE_TIME"
+	// ScheduleTypeWEEKLY is a ScheduleType of type WEEKLY.
+	ScheduleTypeWEEKLY ScheduleType = "WEEKLY"
+)
+
+var ErrInvalidScheduleType = fmt.Errorf("not a valid ScheduleType, try [%s]", strings.Join(_ScheduleTypeNames, ", "))
+
+var _ScheduleTypeNames = []string{
+	string(ScheduleTypeONETIME),
+	string(ScheduleTypeWEEKLY),
+}
+
+// ScheduleTypeNames returns a list of possible string values of ScheduleType.
+func ScheduleTypeNames() []string {
+	tmp := make([]string, len(_ScheduleTypeNames))
+	copy(tmp, _ScheduleTypeNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x ScheduleType) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x ScheduleType) IsValid() bool {
+	_, err := ParseScheduleType(string(x))
+	return err == nil
+}
+
+var _ScheduleTypeValue = map[string]ScheduleType{
+	"ONE_TIME": ScheduleTypeONETIME,
+	"one_time": ScheduleTypeONETIME,
+	"WEEKLY":   ScheduleTypeWEEKLY,
+	"weekly":   ScheduleTypeWEEKLY,
+}
+
+// ParseScheduleType attempts to convert a string to a ScheduleType.
+func ParseScheduleType(name string) (ScheduleType, error) {
+	if x, ok := _ScheduleTypeValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _ScheduleTypeValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return ScheduleType(""), fmt.Errorf("%s is %w", name, ErrInvalidScheduleType)
+}
+
+// MarshalText implements the text marshaller method.
+func (x ScheduleType) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *ScheduleType) UnmarshalText(text []byte) error {
+	tmp, err := ParseScheduleType(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *ScheduleType) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
 const (
 	// TransactionStatusPENDING is a TransactionStatus of type PENDING.
 	TransactionStatusPENDING TransactionStatus = "PENDING"
@@ -94,3 +329,103 @@ func (x *TransactionStatus) UnmarshalText(text []byte) error {
 func (x *TransactionStatus) AppendText(b []byte) ([]byte, error) {
 	return append(b, x.String()...), nil
 }
+
+const (
+	// WeekdayMONDAY is a Weekday of type MONDAY.
+	WeekdayMONDAY Weekday = "MONDAY"
+	// WeekdayTUESDAY is a Weekday of type TUESDAY.
+	WeekdayTUESDAY Weekday = "TUESDAY"
+	// WeekdayWEDNESDAY is a Weekday of type WEDNESDAY.
+	WeekdayWEDNESDAY Weekday = "WEDNESDAY"
+	// WeekdayTHURSDAY is a Weekday of type THURSDAY.
+	WeekdayTHURSDAY Weekday = "THURSDAY"
+	// WeekdayFRIDAY is a Weekday of type FRIDAY.
+	WeekdayFRIDAY Weekday = "FRIDAY"
+	// WeekdaySATURDAY is a Weekday of type SATURDAY.
+	WeekdaySATURDAY Weekday = "SATURDAY"
+	// WeekdaySUNDAY is a Weekday of type SUNDAY.
+	WeekdaySUNDAY Weekday = "SUNDAY"
+)
+
+var ErrInvalidWeekday = fmt.Errorf("not a valid Weekday, try [%s]", strings.Join(_WeekdayNames, ", "))
+
+var _WeekdayNames = []string{
+	string(WeekdayMONDAY),
+	string(WeekdayTUESDAY),
+	string(WeekdayWEDNESDAY),
+	string(WeekdayTHURSDAY),
+	string(WeekdayFRIDAY),
+	string(WeekdaySATURDAY),
+	string(WeekdaySUNDAY),
+}
+
+// WeekdayNames returns a list of possible string values of Weekday.
+func WeekdayNames() []string {
+	tmp := make([]string, len(_WeekdayNames))
+	copy(tmp, _WeekdayNames)
+	return tmp
+}
+
+// String implements the Stringer interface.
+func (x Weekday) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Weekday) IsValid() bool {
+	_, err := ParseWeekday(string(x))
+	return err == nil
+}
+
+var _WeekdayValue = map[string]Weekday{
+	"MONDAY":    WeekdayMONDAY,
+	"monday":    WeekdayMONDAY,
+	"TUESDAY":   WeekdayTUESDAY,
+	"tuesday":   WeekdayTUESDAY,
+	"WEDNESDAY": WeekdayWEDNESDAY,
+	"wednesday": WeekdayWEDNESDAY,
+	"THURSDAY":  WeekdayTHURSDAY,
+	"thursday":  WeekdayTHURSDAY,
+	"FRIDAY":    WeekdayFRIDAY,
+	"friday":    WeekdayFRIDAY,
+	"SATURDAY":  WeekdaySATURDAY,
+	"saturday":  WeekdaySATURDAY,
+	"SUNDAY":    WeekdaySUNDAY,
+	"sunday":    WeekdaySUNDAY,
+}
+
+// ParseWeekday attempts to convert a string to a Weekday.
+func ParseWeekday(name string) (Weekday, error) {
+	if x, ok := _WeekdayValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _WeekdayValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	return Weekday(""), fmt.Errorf("%s is %w", name, ErrInvalidWeekday)
+}
+
+// MarshalText implements the text marshaller method.
+func (x Weekday) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *Weekday) UnmarshalText(text []byte) error {
+	tmp, err := ParseWeekday(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *Weekday) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
@@ -0,0 +1,32 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package withdraws
+
+import (
+	"testing"
+
+	"github.com/1Money-Co/1money-go-sdk/internal/golden"
+)
+
+func TestGolden(t *testing.T) {
+	golden.RoundTrip[WithdrawalResponse](t, "withdrawal_response")
+	golden.RoundTrip[ListWithdrawalsResponse](t, "list_withdrawals_response")
+	golden.RoundTrip[FeeEstimateResponse](t, "fee_estimate_response")
+	golden.RoundTrip[LimitsResponse](t, "limits_response")
+	golden.RoundTrip[ScheduledWithdrawalResponse](t, "scheduled_withdrawal_response")
+	golden.RoundTrip[ListScheduledWithdrawalsResponse](t, "list_scheduled_withdrawals_response")
+}
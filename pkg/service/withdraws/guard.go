@@ -0,0 +1,135 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package withdraws
+
+import (
+	"context"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+)
+
+// AddressGuard checks the destination wallet address of a crypto withdrawal before it is submitted.
+// The address allowlist service implements it.
+type AddressGuard interface {
+	// CheckAddress returns an error if withdrawals to the address on the network are not allowed.
+	CheckAddress(ctx context.Context, network assets.NetworkName, address string) error
+}
+
+// GuardedService is a withdrawals Service that rejects withdrawals to wallet addresses refused
+// by an AddressGuard without sending them to the API.
+// Only WalletAddress destinations are checked; saved recipient wallets are enforced server-side.
+//
+// Enable it on a client with onemoney.WithAddressAllowlist.
+type GuardedService struct {
+	Service
+	guard AddressGuard
+}
+
+// NewGuardedService wraps next so that wallet addresses are checked by guard before submission.
+func NewGuardedService(next Service, guard AddressGuard) *GuardedService {
+	return &GuardedService{
+		Service: next,
+		guard:   guard,
+	}
+}
+
+// CreateWithdrawal checks the wallet address, then creates the withdrawal.
+func (g *GuardedService) CreateWithdrawal(
+	ctx context.Context,
+	id svc.CustomerID,
+	req *CreateWithdrawalRequest,
+) (*WithdrawalResponse, error) {
+	if req != nil && req.WalletAddress != "" {
+		if err := g.guard.CheckAddress(ctx, req.Network, req.WalletAddress); err != nil {
+			return nil, err
+		}
+	}
+	return g.Service.CreateWithdrawal(ctx, id, req)
+}
+
+// CreateBatch checks the wallet address of every request and submits only the accepted ones.
+// Rejected requests are reported in their BatchResult with the guard's error.
+func (g *GuardedService) CreateBatch(
+	ctx context.Context,
+	id svc.CustomerID,
+	reqs []CreateWithdrawalRequest,
+	opts *BatchOptions,
+) ([]BatchResult, error) {
+	if opts == nil {
+		opts = &BatchOptions{}
+	}
+
+	type destination struct {
+		network assets.NetworkName
+		address string
+	}
+	checked := make(map[destination]error)
+
+	// Derive keys from the whole batch so that duplicates are numbered as in an unguarded run.
+	prepared := withBatchIdempotencyKeys(reqs, opts.IdempotencyKeyPrefix)
+	results := make([]BatchResult, len(reqs))
+	var accepted []CreateWithdrawalRequest
+	var indexes []int
+	for i := range prepared {
+		req := prepared[i]
+		results[i] = BatchResult{Index: i, IdempotencyKey: req.IdempotencyKey}
+
+		if req.WalletAddress != "" {
+			dest := destination{req.Network, req.WalletAddress}
+			err, ok := checked[dest]
+			if !ok {
+				err = g.guard.CheckAddress(ctx, req.Network, req.WalletAddress)
+				checked[dest] = err
+			}
+			if err != nil {
+				results[i].Err = err
+				continue
+			}
+		}
+		accepted = append(accepted, req)
+		indexes = append(indexes, i)
+	}
+
+	if len(accepted) == 0 {
+		return results, nil
+	}
+
+	submitted, err := g.Service.CreateBatch(ctx, id, accepted, opts)
+	if err != nil {
+		return nil, err
+	}
+	for j, result := range submitted {
+		result.Index = indexes[j]
+		results[indexes[j]] = result
+	}
+	return results, nil
+}
+
+// CreateScheduledWithdrawal checks the wallet address, then schedules the withdrawal.
+func (g *GuardedService) CreateScheduledWithdrawal(
+	ctx context.Context,
+	id svc.CustomerID,
+	req *CreateScheduledWithdrawalRequest,
+) (*ScheduledWithdrawalResponse, error) {
+	if req != nil && req.WalletAddress != "" {
+		if err := g.guard.CheckAddress(ctx, req.Network, req.WalletAddress); err != nil {
+			return nil, err
+		}
+	}
+	return g.Service.CreateScheduledWithdrawal(ctx, id, req)
+}
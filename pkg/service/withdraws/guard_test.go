@@ -0,0 +1,130 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package withdraws
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+)
+
+var errBlocked = errors.New("blocked")
+
+// fakeGuard rejects a fixed set of addresses and counts checks.
+type fakeGuard struct {
+	blocked map[string]bool
+	checks  int
+}
+
+func (f *fakeGuard) CheckAddress(_ context.Context, _ assets.NetworkName, address string) error {
+	f.checks++
+	if f.blocked[address] {
+		return errBlocked
+	}
+	return nil
+}
+
+// fakeCreateService records the requests submitted to it.
+type fakeCreateService struct {
+	Service
+	created []CreateWithdrawalRequest
+}
+
+func (f *fakeCreateService) CreateWithdrawal(
+	_ context.Context, _ svc.CustomerID, req *CreateWithdrawalRequest,
+) (*WithdrawalResponse, error) {
+	f.created = append(f.created, *req)
+	return &WithdrawalResponse{TransactionID: svc.TransactionID("tx-" + req.WalletAddress)}, nil
+}
+
+func (f *fakeCreateService) CreateBatch(
+	ctx context.Context, id svc.CustomerID, reqs []CreateWithdrawalRequest, _ *BatchOptions,
+) ([]BatchResult, error) {
+	results := make([]BatchResult, len(reqs))
+	for i := range reqs {
+		results[i] = BatchResult{Index: i, IdempotencyKey: reqs[i].IdempotencyKey}
+		results[i].Response, results[i].Err = f.CreateWithdrawal(ctx, id, &reqs[i])
+	}
+	return results, nil
+}
+
+func TestGuardedService_CreateWithdrawal(t *testing.T) {
+	next := &fakeCreateService{}
+	guarded := NewGuardedService(next, &fakeGuard{blocked: map[string]bool{"0xbad": true}})
+
+	_, err := guarded.CreateWithdrawal(context.Background(), "cid", &CreateWithdrawalRequest{WalletAddress: "0xbad"})
+	if !errors.Is(err, errBlocked) {
+		t.Errorf("CreateWithdrawal() error = %v, want %v", err, errBlocked)
+	}
+	if _, err := guarded.CreateWithdrawal(context.Background(), "cid", &CreateWithdrawalRequest{WalletAddress: "0xok"}); err != nil {
+		t.Errorf("CreateWithdrawal() error = %v", err)
+	}
+	if _, err := guarded.CreateWithdrawal(context.Background(), "cid", &CreateWithdrawalRequest{ExternalAccountID: "ea"}); err != nil {
+		t.Errorf("CreateWithdrawal() to external account error = %v", err)
+	}
+	if len(next.created) != 2 {
+		t.Errorf("got %d submitted withdrawals, want 2", len(next.created))
+	}
+}
+
+func TestGuardedService_CreateBatch(t *testing.T) {
+	next := &fakeCreateService{}
+	guard := &fakeGuard{blocked: map[string]bool{"0xbad": true}}
+	guarded := NewGuardedService(next, guard)
+
+	reqs := []CreateWithdrawalRequest{
+		{WalletAddress: "0xok"},
+		{WalletAddress: "0xbad"},
+		{ExternalAccountID: "ea"},
+		{WalletAddress: "0xbad"},
+		{WalletAddress: "0xok", IdempotencyKey: "own-key"},
+	}
+	results, err := guarded.CreateBatch(context.Background(), "cid", reqs, &BatchOptions{IdempotencyKeyPrefix: "run"})
+	if err != nil {
+		t.Fatalf("CreateBatch() error = %v", err)
+	}
+
+	wantKeys := []string{
+		batchIdempotencyKey("run", batchRequestHash(&reqs[0]), 1),
+		batchIdempotencyKey("run", batchRequestHash(&reqs[1]), 1),
+		batchIdempotencyKey("run", batchRequestHash(&reqs[2]), 1),
+		batchIdempotencyKey("run", batchRequestHash(&reqs[3]), 2),
+		"own-key",
+	}
+	for i, result := range results {
+		if result.Index != i || result.IdempotencyKey != wantKeys[i] {
+			t.Errorf("result %d = {Index: %d, IdempotencyKey: %q}, want {%d, %q}",
+				i, result.Index, result.IdempotencyKey, i, wantKeys[i])
+		}
+		blocked := reqs[i].WalletAddress == "0xbad"
+		if blocked != errors.Is(result.Err, errBlocked) {
+			t.Errorf("result %d error = %v", i, result.Err)
+		}
+		if blocked != (result.Response == nil) {
+			t.Errorf("result %d response = %v", i, result.Response)
+		}
+	}
+	if len(next.created) != 3 {
+		t.Errorf("got %d submitted withdrawals, want 3", len(next.created))
+	}
+	if guard.checks != 2 {
+		t.Errorf("got %d address checks, want 2 (one per distinct address)", guard.checks)
+	}
+}
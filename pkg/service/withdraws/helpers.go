@@ -0,0 +1,215 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package withdraws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/1Money-Co/1money-go-sdk/internal/transport"
+	"github.com/1Money-Co/1money-go-sdk/pkg/common"
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+)
+
+//go:generate go run ../../../cmd/tools/svcgen waiters waiters.yaml
+
+// ErrInvalidDestination is returned when a withdrawal request does not specify
+// exactly one destination style.
+var ErrInvalidDestination = errors.New("invalid withdrawal destination")
+
+// Validate checks that the request specifies exactly one destination style:
+// a raw WalletAddress, an ExternalAccountID, or a RecipientID paired with exactly one
+// of RecipientBankAccountID or RecipientWalletAddressID. Travel-rule parties, when set,
+// must also be complete.
+func (r *CreateWithdrawalRequest) Validate() error {
+	if r == nil {
+		return fmt.Errorf("%w: request is nil", ErrInvalidDestination)
+	}
+
+	styles := 0
+	if r.WalletAddress != "" {
+		styles++
+	}
+	if r.ExternalAccountID != "" {
+		styles++
+	}
+	if r.RecipientID != "" || r.RecipientBankAccountID != "" || r.RecipientWalletAddressID != "" {
+		styles++
+	}
+
+	switch {
+	case styles == 0:
+		return fmt.Errorf("%w: one of wallet_address, external_account_id, or recipient_id is required",
+			ErrInvalidDestination)
+	case styles > 1:
+		return fmt.Errorf("%w: wallet_address, external_account_id, and recipient_id are mutually exclusive",
+			ErrInvalidDestination)
+	}
+
+	if r.RecipientID == "" && (r.RecipientBankAccountID != "" || r.RecipientWalletAddressID != "") {
+		return fmt.Errorf("%w: recipient_id is required with a recipient destination", ErrInvalidDestination)
+	}
+	if r.RecipientID != "" && (r.RecipientBankAccountID == "") == (r.RecipientWalletAddressID == "") {
+		return fmt.Errorf("%w: exactly one of recipient_bank_account_id or recipient_wallet_address_id is required",
+			ErrInvalidDestination)
+	}
+
+	if r.Originator != nil {
+		if err := r.Originator.Validate(); err != nil {
+			return fmt.Errorf("originator: %w", err)
+		}
+	}
+	if r.Beneficiary != nil {
+		if err := r.Beneficiary.Validate(); err != nil {
+			return fmt.Errorf("beneficiary: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Reference returns the external settlement reference for the withdrawal: the on-chain
+// transaction hash for crypto withdrawals or the trace number for fiat withdrawals.
+// Returns an empty string if neither is available yet.
+func (r *WithdrawalResponse) Reference() string {
+	if r.TransactionHash != "" {
+		return r.TransactionHash
+	}
+	return r.TraceNumber
+}
+
+// FindByIdempotencyKey looks up a withdrawal by its idempotency key.
+// Unlike GetWithdrawalByIdempotencyKey, it returns (nil, nil) when no withdrawal exists,
+// so a recovering worker can tell "never submitted" apart from a lookup failure.
+func FindByIdempotencyKey(
+	ctx context.Context,
+	service Service,
+	customerID svc.CustomerID,
+	idempotencyKey string,
+) (*WithdrawalResponse, error) {
+	w, err := service.GetWithdrawalByIdempotencyKey(ctx, customerID, idempotencyKey)
+	if err != nil {
+		if transport.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return w, nil
+}
+
+// CreateOrRecover returns the withdrawal previously submitted with req.IdempotencyKey,
+// or creates it if none exists. Use it when retrying after a crash or timeout to
+// avoid double payouts.
+func CreateOrRecover(
+	ctx context.Context,
+	service Service,
+	customerID svc.CustomerID,
+	req *CreateWithdrawalRequest,
+) (*WithdrawalResponse, error) {
+	if req == nil || req.IdempotencyKey == "" {
+		return nil, errors.New("idempotency key is required to recover a withdrawal")
+	}
+
+	existing, err := FindByIdempotencyKey(ctx, service, customerID, req.IdempotencyKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up withdrawal by idempotency key: %w", err)
+	}
+	if existing != nil {
+		return existing, nil
+	}
+
+	return service.CreateWithdrawal(ctx, customerID, req)
+}
+
+// NewWithdrawalRequest returns a request to withdraw the given amount on a network.
+// Set exactly one destination on the result before submitting it.
+func NewWithdrawalRequest(amount common.Money, network assets.NetworkName) *CreateWithdrawalRequest {
+	return &CreateWithdrawalRequest{
+		Amount:  amount.Amount.String(),
+		Asset:   amount.Currency,
+		Network: network,
+	}
+}
+
+// Money returns the amount to withdraw.
+func (r *CreateWithdrawalRequest) Money() (common.Money, error) {
+	return common.NewMoney(r.Amount, r.Asset)
+}
+
+// Money returns the withdrawal amount.
+func (r *WithdrawalResponse) Money() (common.Money, error) {
+	return common.NewMoney(r.Amount, assets.AssetName(r.Asset))
+}
+
+// Money returns the fee amount.
+func (m FeeMeta) Money() (common.Money, error) {
+	return common.NewMoney(m.Value, assets.AssetName(m.Asset))
+}
+
+// NetMoney returns the amount the recipient is expected to receive after fees.
+func (r *FeeEstimateResponse) NetMoney() (common.Money, error) {
+	return common.NewMoney(r.NetAmount, assets.AssetName(r.Asset))
+}
+
+// EstimateFeeFor estimates the fees for withdrawing the given amount on a network.
+func EstimateFeeFor(
+	ctx context.Context,
+	service Service,
+	customerID svc.CustomerID,
+	network assets.NetworkName,
+	amount common.Money,
+) (*FeeEstimateResponse, error) {
+	return service.EstimateFee(ctx, customerID, amount.Currency, network, amount.Amount.String())
+}
+
+// ExecuteTime parses ExecuteAt into a time.Time, returning the zero time if it is not set.
+func (r *ScheduledWithdrawalResponse) ExecuteTime() (time.Time, error) {
+	return svc.ParseOptionalTimestamp(&r.ExecuteAt)
+}
+
+// NextExecutionTime parses NextExecutionAt into a time.Time, returning the zero time if it is not set.
+func (r *ScheduledWithdrawalResponse) NextExecutionTime() (time.Time, error) {
+	return svc.ParseOptionalTimestamp(&r.NextExecutionAt)
+}
+
+// CreatedTime parses CreatedAt into a time.Time.
+func (r *ScheduledWithdrawalResponse) CreatedTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.CreatedAt)
+}
+
+// ModifiedTime parses ModifiedAt into a time.Time.
+func (r *ScheduledWithdrawalResponse) ModifiedTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.ModifiedAt)
+}
+
+// CreatedTime parses CreatedAt into a time.Time.
+func (r *WithdrawalResponse) CreatedTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.CreatedAt)
+}
+
+// ModifiedTime parses ModifiedAt into a time.Time.
+func (r *WithdrawalResponse) ModifiedTime() (time.Time, error) {
+	return svc.ParseTimestamp(r.ModifiedAt)
+}
+
+// DailyResetTime parses DailyResetAt into a time.Time, returning the zero time if it is not set.
+func (r *LimitsResponse) DailyResetTime() (time.Time, error) {
+	return svc.ParseOptionalTimestamp(&r.DailyResetAt)
+}
@@ -0,0 +1,364 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package withdraws
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/common"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+)
+
+func TestCreateWithdrawalRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *CreateWithdrawalRequest
+		wantErr bool
+	}{
+		{
+			name: "wallet address",
+			req:  &CreateWithdrawalRequest{WalletAddress: "0xabc"},
+		},
+		{
+			name: "external account",
+			req:  &CreateWithdrawalRequest{ExternalAccountID: "ea-1"},
+		},
+		{
+			name: "recipient bank account",
+			req:  &CreateWithdrawalRequest{RecipientID: "r-1", RecipientBankAccountID: "rb-1"},
+		},
+		{
+			name: "recipient wallet address",
+			req:  &CreateWithdrawalRequest{RecipientID: "r-1", RecipientWalletAddressID: "rw-1"},
+		},
+		{
+			name:    "no destination",
+			req:     &CreateWithdrawalRequest{},
+			wantErr: true,
+		},
+		{
+			name:    "wallet and external account",
+			req:     &CreateWithdrawalRequest{WalletAddress: "0xabc", ExternalAccountID: "ea-1"},
+			wantErr: true,
+		},
+		{
+			name:    "external account and recipient",
+			req:     &CreateWithdrawalRequest{ExternalAccountID: "ea-1", RecipientID: "r-1", RecipientBankAccountID: "rb-1"},
+			wantErr: true,
+		},
+		{
+			name:    "recipient without sub-destination",
+			req:     &CreateWithdrawalRequest{RecipientID: "r-1"},
+			wantErr: true,
+		},
+		{
+			name: "recipient with both sub-destinations",
+			req: &CreateWithdrawalRequest{
+				RecipientID: "r-1", RecipientBankAccountID: "rb-1", RecipientWalletAddressID: "rw-1",
+			},
+			wantErr: true,
+		},
+		{
+			name:    "recipient sub-destination without recipient",
+			req:     &CreateWithdrawalRequest{RecipientBankAccountID: "rb-1"},
+			wantErr: true,
+		},
+		{
+			name:    "nil request",
+			req:     nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidDestination) {
+				t.Errorf("Validate() error = %v, want ErrInvalidDestination", err)
+			}
+		})
+	}
+}
+
+func TestCreateWithdrawalRequest_ValidateTravelRule(t *testing.T) {
+	tests := []struct {
+		name        string
+		originator  *TravelRuleParty
+		beneficiary *TravelRuleParty
+		wantErr     bool
+	}{
+		{
+			name:        "natural person originator and legal person beneficiary",
+			originator:  &TravelRuleParty{Type: PartyTypeNATURALPERSON, FirstName: "Ada", LastName: "Lovelace"},
+			beneficiary: &TravelRuleParty{Type: PartyTypeLEGALPERSON, LegalName: "Acme Ltd"},
+		},
+		{
+			name: "no travel rule data",
+		},
+		{
+			name:       "natural person missing last name",
+			originator: &TravelRuleParty{Type: PartyTypeNATURALPERSON, FirstName: "Ada"},
+			wantErr:    true,
+		},
+		{
+			name:        "legal person missing legal name",
+			beneficiary: &TravelRuleParty{Type: PartyTypeLEGALPERSON},
+			wantErr:     true,
+		},
+		{
+			name:        "missing party type",
+			beneficiary: &TravelRuleParty{LegalName: "Acme Ltd"},
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &CreateWithdrawalRequest{
+				WalletAddress: "0xabc",
+				Originator:    tt.originator,
+				Beneficiary:   tt.beneficiary,
+			}
+			err := req.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidTravelRule) {
+				t.Errorf("Validate() error = %v, want ErrInvalidTravelRule", err)
+			}
+		})
+	}
+}
+
+func TestCheckBatchBalance(t *testing.T) {
+	ethereum := "ETHEREUM"
+	balances := []assets.AssetResponse{
+		{Asset: "USD", AvailableAmount: "100.00"},
+		{Asset: "USDT", Network: &ethereum, AvailableAmount: "50"},
+	}
+
+	tests := []struct {
+		name    string
+		reqs    []CreateWithdrawalRequest
+		wantErr bool
+	}{
+		{
+			name: "within balance",
+			reqs: []CreateWithdrawalRequest{
+				{Amount: "60.00", Asset: assets.AssetNameUSD, Network: assets.NetworkNameUSACH},
+				{Amount: "40.00", Asset: assets.AssetNameUSD, Network: assets.NetworkNameUSACH},
+				{Amount: "50", Asset: assets.AssetNameUSDT, Network: assets.NetworkNameETHEREUM},
+			},
+		},
+		{
+			name: "fiat total exceeds balance",
+			reqs: []CreateWithdrawalRequest{
+				{Amount: "60.00", Asset: assets.AssetNameUSD, Network: assets.NetworkNameUSACH},
+				{Amount: "40.01", Asset: assets.AssetNameUSD, Network: assets.NetworkNameUSACH},
+			},
+			wantErr: true,
+		},
+		{
+			name: "no balance on network",
+			reqs: []CreateWithdrawalRequest{
+				{Amount: "1", Asset: assets.AssetNameUSDT, Network: assets.NetworkNamePOLYGON},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := make([]BatchResult, len(tt.reqs))
+			err := checkBatchBalance(tt.reqs, results, balances)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("checkBatchBalance() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInsufficientBalance) {
+				t.Errorf("checkBatchBalance() error = %v, want ErrInsufficientBalance", err)
+			}
+		})
+	}
+}
+
+func TestCreateScheduledWithdrawalRequest_Validate(t *testing.T) {
+	weekly := &WeeklySchedule{DayOfWeek: WeekdayFRIDAY, TimeOfDay: "17:00"}
+
+	tests := []struct {
+		name    string
+		req     *CreateScheduledWithdrawalRequest
+		wantErr bool
+	}{
+		{
+			name: "one time",
+			req: &CreateScheduledWithdrawalRequest{
+				Type: ScheduleTypeONETIME, ExecuteAt: "2030-01-01T00:00:00Z", Amount: "10", WalletAddress: "0xabc",
+			},
+		},
+		{
+			name: "weekly sweep without amount",
+			req:  &CreateScheduledWithdrawalRequest{Type: ScheduleTypeWEEKLY, Weekly: weekly, ExternalAccountID: "ea-1"},
+		},
+		{
+			name:    "nil request",
+			req:     nil,
+			wantErr: true,
+		},
+		{
+			name: "one time missing execute_at",
+			req: &CreateScheduledWithdrawalRequest{
+				Type: ScheduleTypeONETIME, Amount: "10", WalletAddress: "0xabc",
+			},
+			wantErr: true,
+		},
+		{
+			name: "one time missing amount",
+			req: &CreateScheduledWithdrawalRequest{
+				Type: ScheduleTypeONETIME, ExecuteAt: "2030-01-01T00:00:00Z", WalletAddress: "0xabc",
+			},
+			wantErr: true,
+		},
+		{
+			name:    "weekly missing recurrence",
+			req:     &CreateScheduledWithdrawalRequest{Type: ScheduleTypeWEEKLY, ExternalAccountID: "ea-1"},
+			wantErr: true,
+		},
+		{
+			name: "weekly invalid day",
+			req: &CreateScheduledWithdrawalRequest{
+				Type: ScheduleTypeWEEKLY, Weekly: &WeeklySchedule{DayOfWeek: "FUNDAY"}, ExternalAccountID: "ea-1",
+			},
+			wantErr: true,
+		},
+		{
+			name: "one time malformed execute_at",
+			req: &CreateScheduledWithdrawalRequest{
+				Type: ScheduleTypeONETIME, ExecuteAt: "next friday", Amount: "10", WalletAddress: "0xabc",
+			},
+			wantErr: true,
+		},
+		{
+			name: "one time execute_at in the past",
+			req: &CreateScheduledWithdrawalRequest{
+				Type: ScheduleTypeONETIME, ExecuteAt: "2020-01-01T00:00:00Z", Amount: "10", WalletAddress: "0xabc",
+			},
+			wantErr: true,
+		},
+		{
+			name: "one time with weekly",
+			req: &CreateScheduledWithdrawalRequest{
+				Type: ScheduleTypeONETIME, ExecuteAt: "2030-01-01T00:00:00Z", Amount: "10", WalletAddress: "0xabc",
+				Weekly: weekly,
+			},
+			wantErr: true,
+		},
+		{
+			name: "weekly with execute_at",
+			req: &CreateScheduledWithdrawalRequest{
+				Type: ScheduleTypeWEEKLY, Weekly: weekly, ExecuteAt: "2030-01-01T00:00:00Z", ExternalAccountID: "ea-1",
+			},
+			wantErr: true,
+		},
+		{
+			name: "weekly missing time_of_day",
+			req: &CreateScheduledWithdrawalRequest{
+				Type: ScheduleTypeWEEKLY, Weekly: &WeeklySchedule{DayOfWeek: WeekdayFRIDAY}, ExternalAccountID: "ea-1",
+			},
+			wantErr: true,
+		},
+		{
+			name: "weekly time_of_day out of range",
+			req: &CreateScheduledWithdrawalRequest{
+				Type: ScheduleTypeWEEKLY, Weekly: &WeeklySchedule{DayOfWeek: WeekdayFRIDAY, TimeOfDay: "25:00"},
+				ExternalAccountID: "ea-1",
+			},
+			wantErr: true,
+		},
+		{
+			name: "weekly time_of_day without leading zero",
+			req: &CreateScheduledWithdrawalRequest{
+				Type: ScheduleTypeWEEKLY, Weekly: &WeeklySchedule{DayOfWeek: WeekdayFRIDAY, TimeOfDay: "9:00"},
+				ExternalAccountID: "ea-1",
+			},
+			wantErr: true,
+		},
+		{
+			name: "weekly time_of_day with seconds",
+			req: &CreateScheduledWithdrawalRequest{
+				Type: ScheduleTypeWEEKLY, Weekly: &WeeklySchedule{DayOfWeek: WeekdayFRIDAY, TimeOfDay: "17:00:00"},
+				ExternalAccountID: "ea-1",
+			},
+			wantErr: true,
+		},
+		{
+			name:    "missing destination",
+			req:     &CreateScheduledWithdrawalRequest{Type: ScheduleTypeWEEKLY, Weekly: weekly},
+			wantErr: true,
+		},
+		{
+			name:    "unknown type",
+			req:     &CreateScheduledWithdrawalRequest{Type: "DAILY", ExternalAccountID: "ea-1"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewWithdrawalRequest(t *testing.T) {
+	amount := common.MustMoney("250.00", assets.AssetNameUSDC)
+	req := NewWithdrawalRequest(amount, assets.NetworkNamePOLYGON)
+	req.WalletAddress = "0x0000000000000000000000000000000000000001"
+
+	if req.Amount != "250" || req.Asset != assets.AssetNameUSDC || req.Network != assets.NetworkNamePOLYGON {
+		t.Fatalf("NewWithdrawalRequest() = %+v", req)
+	}
+	if err := req.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+	if got, err := req.Money(); err != nil || !got.Equal(amount) {
+		t.Errorf("Money() = %s, %v, want %s", got, err, amount)
+	}
+
+	estimate := &FeeEstimateResponse{
+		Asset:     "USDC",
+		NetAmount: "249.10",
+		TotalFee:  FeeMeta{Value: "0.90", Asset: "USDC"},
+	}
+	net, err := estimate.NetMoney()
+	if err != nil {
+		t.Fatalf("NetMoney() error = %v", err)
+	}
+	fee, err := estimate.TotalFee.Money()
+	if err != nil {
+		t.Fatalf("Money() error = %v", err)
+	}
+	if total, err := net.Add(fee); err != nil || !total.Equal(amount) {
+		t.Errorf("net + fee = %s, %v, want %s", total, err, amount)
+	}
+}
@@ -0,0 +1,235 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package withdraws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+)
+
+// ErrInvalidSchedule is returned when a scheduled withdrawal request has an
+// inconsistent schedule definition.
+var ErrInvalidSchedule = errors.New("invalid withdrawal schedule")
+
+// timeOfDayLayout is the HH:MM layout of WeeklySchedule.TimeOfDay.
+const timeOfDayLayout = "15:04"
+
+// WeeklySchedule defines when a recurring weekly withdrawal executes.
+type WeeklySchedule struct {
+	// DayOfWeek is the day of the week the withdrawal executes.
+	DayOfWeek Weekday `json:"day_of_week"`
+	// TimeOfDay is the execution time in UTC (HH:MM format).
+	TimeOfDay string `json:"time_of_day"`
+}
+
+// CreateScheduledWithdrawal request and response types.
+type (
+	// CreateScheduledWithdrawalRequest represents the request body for scheduling a withdrawal.
+	CreateScheduledWithdrawalRequest struct {
+		// IdempotencyKey is a unique key to ensure idempotent creation.
+		// This is sent as a header, not in the body.
+		IdempotencyKey string `json:"-"`
+		// Type is the schedule type (ONE_TIME or WEEKLY).
+		Type ScheduleType `json:"type"`
+		// ExecuteAt is when a ONE_TIME withdrawal executes (ISO 8601 format).
+		ExecuteAt string `json:"execute_at,omitempty"`
+		// Weekly is the recurrence for a WEEKLY withdrawal.
+		Weekly *WeeklySchedule `json:"weekly,omitempty"`
+		// Amount is the amount to withdraw. For WEEKLY schedules it may be left empty
+		// to sweep the full available balance on each execution.
+		Amount string `json:"amount,omitempty"`
+		// Asset is the asset to withdraw.
+		Asset assets.AssetName `json:"asset"`
+		// Network is the network for the withdrawal.
+		Network assets.NetworkName `json:"network"`
+		// WalletAddress is the wallet address for crypto withdrawals.
+		WalletAddress string `json:"wallet_address,omitempty"`
+		// ExternalAccountID is the external account ID for fiat withdrawals.
+		ExternalAccountID string `json:"external_account_id,omitempty"`
+	}
+
+	// ScheduledWithdrawalResponse represents a scheduled withdrawal.
+	ScheduledWithdrawalResponse struct {
+		// ScheduleID is the unique schedule identifier.
+		ScheduleID string `json:"schedule_id"`
+		// IdempotencyKey is the idempotency key used when the schedule was created.
+		IdempotencyKey string `json:"idempotency_key"`
+		// Type is the schedule type.
+		Type ScheduleType `json:"type"`
+		// Status is the current status of the schedule.
+		Status ScheduleStatus `json:"status"`
+		// ExecuteAt is when a ONE_TIME withdrawal executes (ISO 8601 format).
+		ExecuteAt string `json:"execute_at,omitempty"`
+		// Weekly is the recurrence for a WEEKLY withdrawal.
+		Weekly *WeeklySchedule `json:"weekly,omitempty"`
+		// NextExecutionAt is the next planned execution time (ISO 8601 format).
+		NextExecutionAt string `json:"next_execution_at,omitempty"`
+		// LastTransactionID is the withdrawal transaction created by the most recent execution.
+		LastTransactionID svc.TransactionID `json:"last_transaction_id,omitempty"`
+		// Amount is the amount to withdraw; empty means the full available balance.
+		Amount string `json:"amount,omitempty"`
+		// Asset is the asset to withdraw.
+		Asset string `json:"asset"`
+		// Network is the network for the withdrawal.
+		Network string `json:"network"`
+		// WalletAddress is the destination wallet address for crypto withdrawals.
+		WalletAddress string `json:"wallet_address,omitempty"`
+		// ExternalAccountID is the destination external account for fiat withdrawals.
+		ExternalAccountID string `json:"external_account_id,omitempty"`
+		// CreatedAt is the timestamp when the schedule was created (ISO 8601 format).
+		CreatedAt string `json:"created_at"`
+		// ModifiedAt is the timestamp when the schedule was last modified (ISO 8601 format).
+		ModifiedAt string `json:"modified_at"`
+	}
+)
+
+// ListScheduledWithdrawals request and response types.
+type (
+	// ListScheduledWithdrawalsRequest represents optional query parameters for listing scheduled withdrawals.
+	ListScheduledWithdrawalsRequest struct {
+		// Status filters by schedule status.
+		Status ScheduleStatus `json:"status,omitempty"`
+		// Type filters by schedule type.
+		Type ScheduleType `json:"type,omitempty"`
+		// Page is the page number for pagination.
+		Page int `json:"page,omitempty"`
+		// Size is the page size for pagination.
+		Size int `json:"size,omitempty"`
+	}
+
+	// ListScheduledWithdrawalsResponse represents the response for listing scheduled withdrawals.
+	ListScheduledWithdrawalsResponse struct {
+		// List is the list of scheduled withdrawals.
+		List []ScheduledWithdrawalResponse `json:"list"`
+		// Total is the total number of scheduled withdrawals matching the filters.
+		Total int `json:"total,omitempty"`
+	}
+)
+
+// Validate checks that the schedule fields match the schedule type and that
+// exactly one destination is set. ONE_TIME schedules need an ISO 8601 execute_at in the
+// future; WEEKLY schedules need a time_of_day in HH:MM format.
+func (r *CreateScheduledWithdrawalRequest) Validate() error {
+	if r == nil {
+		return fmt.Errorf("%w: request is nil", ErrInvalidSchedule)
+	}
+	if (r.WalletAddress == "") == (r.ExternalAccountID == "") {
+		return fmt.Errorf("%w: exactly one of wallet_address or external_account_id is required",
+			ErrInvalidDestination)
+	}
+
+	switch r.Type {
+	case ScheduleTypeONETIME:
+		if r.ExecuteAt == "" {
+			return fmt.Errorf("%w: execute_at is required for ONE_TIME schedules", ErrInvalidSchedule)
+		}
+		executeAt, err := svc.ParseTimestamp(r.ExecuteAt)
+		if err != nil {
+			return fmt.Errorf("%w: execute_at: %w", ErrInvalidSchedule, err)
+		}
+		if !executeAt.After(time.Now()) {
+			return fmt.Errorf("%w: execute_at %s is not in the future", ErrInvalidSchedule, r.ExecuteAt)
+		}
+		if r.Amount == "" {
+			return fmt.Errorf("%w: amount is required for ONE_TIME schedules", ErrInvalidSchedule)
+		}
+		if r.Weekly != nil {
+			return fmt.Errorf("%w: weekly cannot be set for ONE_TIME schedules", ErrInvalidSchedule)
+		}
+	case ScheduleTypeWEEKLY:
+		if r.Weekly == nil {
+			return fmt.Errorf("%w: weekly is required for WEEKLY schedules", ErrInvalidSchedule)
+		}
+		if !r.Weekly.DayOfWeek.IsValid() {
+			return fmt.Errorf("%w: invalid day_of_week %q", ErrInvalidSchedule, r.Weekly.DayOfWeek)
+		}
+		if _, err := time.Parse(timeOfDayLayout, r.Weekly.TimeOfDay); err != nil || len(r.Weekly.TimeOfDay) != len(timeOfDayLayout) {
+			return fmt.Errorf("%w: time_of_day %q is not in HH:MM format", ErrInvalidSchedule, r.Weekly.TimeOfDay)
+		}
+		if r.ExecuteAt != "" {
+			return fmt.Errorf("%w: execute_at cannot be set for WEEKLY schedules", ErrInvalidSchedule)
+		}
+	default:
+		return fmt.Errorf("%w: invalid type %q", ErrInvalidSchedule, r.Type)
+	}
+
+	return nil
+}
+
+// CreateScheduledWithdrawal schedules a withdrawal for a future time or as a standing weekly sweep.
+func (s *serviceImpl) CreateScheduledWithdrawal(
+	ctx context.Context,
+	id svc.CustomerID,
+	req *CreateScheduledWithdrawalRequest,
+) (*ScheduledWithdrawalResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/v1/customers/%s/withdrawals/scheduled", id)
+
+	headers := make(map[string]string)
+	if req.IdempotencyKey != "" {
+		headers["Idempotency-Key"] = req.IdempotencyKey
+	}
+
+	return svc.PostJSONWithHeaders[*CreateScheduledWithdrawalRequest, ScheduledWithdrawalResponse](
+		ctx, s.BaseService, path, req, headers,
+	)
+}
+
+// ListScheduledWithdrawals retrieves scheduled withdrawals for a customer with optional filters.
+func (s *serviceImpl) ListScheduledWithdrawals(
+	ctx context.Context,
+	id svc.CustomerID,
+	req *ListScheduledWithdrawalsRequest,
+) (*ListScheduledWithdrawalsResponse, error) {
+	path := fmt.Sprintf("/v1/customers/%s/withdrawals/scheduled/list", id)
+
+	params := make(map[string]string)
+	if req != nil {
+		if req.Status != "" {
+			params["status"] = string(req.Status)
+		}
+		if req.Type != "" {
+			params["type"] = string(req.Type)
+		}
+		if req.Page > 0 {
+			params["page"] = fmt.Sprintf("%d", req.Page)
+		}
+		if req.Size > 0 {
+			params["size"] = fmt.Sprintf("%d", req.Size)
+		}
+	}
+
+	return svc.GetJSONWithParams[ListScheduledWithdrawalsResponse](ctx, s.BaseService, path, params)
+}
+
+// CancelScheduledWithdrawal cancels a scheduled withdrawal so that it no longer executes.
+func (s *serviceImpl) CancelScheduledWithdrawal(
+	ctx context.Context,
+	id svc.CustomerID,
+	scheduleID string,
+) (*ScheduledWithdrawalResponse, error) {
+	path := fmt.Sprintf("/v1/customers/%s/withdrawals/scheduled/%s/cancel", id, scheduleID)
+	return svc.PostJSON[any, ScheduledWithdrawalResponse](ctx, s.BaseService, path, nil)
+}
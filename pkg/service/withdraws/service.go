@@ -43,6 +43,11 @@
 //	    Network:           assets.NetworkNameUSACH,
 //	    ExternalAccountID: "external-account-id",
 //	})
+//
+//	// List pending withdrawals
+//	list, err := client.Withdrawals.ListWithdrawals(ctx, "customer-id", &withdraws.ListWithdrawalsRequest{
+//	    Status: withdraws.TransactionStatusPENDING,
+//	})
 package withdraws
 
 import (
@@ -50,6 +55,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/1Money-Co/1money-go-sdk/internal/transport"
 	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
@@ -63,11 +69,36 @@ type Service interface {
 		ctx context.Context, id svc.CustomerID, req *CreateWithdrawalRequest,
 	) (*WithdrawalResponse, error)
 	// GetWithdrawal retrieves a specific withdrawal by ID.
-	GetWithdrawal(ctx context.Context, id svc.CustomerID, transactionID string) (*WithdrawalResponse, error)
+	GetWithdrawal(ctx context.Context, id svc.CustomerID, transactionID svc.TransactionID) (*WithdrawalResponse, error)
 	// GetWithdrawalByIdempotencyKey retrieves a withdrawal by its idempotency key.
 	GetWithdrawalByIdempotencyKey(
 		ctx context.Context, id svc.CustomerID, idempotencyKey string,
 	) (*WithdrawalResponse, error)
+	// ListWithdrawals retrieves withdrawals for a customer with optional filters and pagination.
+	ListWithdrawals(ctx context.Context, id svc.CustomerID, req *ListWithdrawalsRequest) (*ListWithdrawalsResponse, error)
+	// EstimateFee estimates the fees, net amount, and settlement window for a withdrawal before it is submitted.
+	EstimateFee(
+		ctx context.Context, id svc.CustomerID, asset assets.AssetName, network assets.NetworkName, amount string,
+	) (*FeeEstimateResponse, error)
+	// CreateBatch submits many withdrawals with bounded concurrency and returns a result per request.
+	// See BatchOptions for idempotency key and balance check behavior.
+	CreateBatch(
+		ctx context.Context, id svc.CustomerID, reqs []CreateWithdrawalRequest, opts *BatchOptions,
+	) ([]BatchResult, error)
+	// CreateScheduledWithdrawal schedules a withdrawal for a future time or as a standing weekly sweep.
+	CreateScheduledWithdrawal(
+		ctx context.Context, id svc.CustomerID, req *CreateScheduledWithdrawalRequest,
+	) (*ScheduledWithdrawalResponse, error)
+	// ListScheduledWithdrawals retrieves scheduled withdrawals for a customer with optional filters.
+	ListScheduledWithdrawals(
+		ctx context.Context, id svc.CustomerID, req *ListScheduledWithdrawalsRequest,
+	) (*ListScheduledWithdrawalsResponse, error)
+	// CancelScheduledWithdrawal cancels a scheduled withdrawal so that it no longer executes.
+	CancelScheduledWithdrawal(
+		ctx context.Context, id svc.CustomerID, scheduleID string,
+	) (*ScheduledWithdrawalResponse, error)
+	// GetLimits retrieves amount limits, remaining daily limits, and rail cutoff times for a network.
+	GetLimits(ctx context.Context, id svc.CustomerID, network assets.NetworkName) (*LimitsResponse, error)
 }
 
 // FeeMeta represents fee information for a transaction.
@@ -92,21 +123,33 @@ type (
 		// Network is the network for the withdrawal.
 		Network assets.NetworkName `json:"network"`
 		// WalletAddress is the wallet address for crypto withdrawals.
-		// Required for crypto asset withdrawals (e.g., USDC, USDT).
-		// Cannot be provided together with ExternalAccountID.
+		// Cannot be provided together with ExternalAccountID or RecipientID.
 		WalletAddress string `json:"wallet_address,omitempty"`
 		// ExternalAccountID is the external account ID for fiat withdrawals.
-		// Required for fiat currency withdrawals (e.g., USD).
-		// Cannot be provided together with WalletAddress.
+		// Cannot be provided together with WalletAddress or RecipientID.
 		ExternalAccountID string `json:"external_account_id,omitempty"`
+		// RecipientID is the ID of a saved recipient to pay out to.
+		// Requires exactly one of RecipientBankAccountID or RecipientWalletAddressID.
+		RecipientID svc.RecipientID `json:"recipient_id,omitempty"`
+		// RecipientBankAccountID is the recipient's bank account ID for fiat withdrawals.
+		RecipientBankAccountID string `json:"recipient_bank_account_id,omitempty"`
+		// RecipientWalletAddressID is the recipient's wallet address ID for crypto withdrawals.
+		RecipientWalletAddressID string `json:"recipient_wallet_address_id,omitempty"`
 		// Code is the localized payment code.
 		Code string `json:"code,omitempty"`
+		// Originator is the travel-rule originator information (optional).
+		Originator *TravelRuleParty `json:"originator,omitempty"`
+		// Beneficiary is the travel-rule beneficiary information (optional).
+		// Counterparty VASPs may reject crypto withdrawals without it.
+		Beneficiary *TravelRuleParty `json:"beneficiary,omitempty"`
+		// BeneficiaryVASP identifies the VASP hosting the beneficiary wallet (optional).
+		BeneficiaryVASP *VASPInfo `json:"beneficiary_vasp,omitempty"`
 	}
 
 	// WithdrawalResponse represents the response for a withdrawal transaction.
 	WithdrawalResponse struct {
 		// TransactionID is the unique transaction identifier.
-		TransactionID string `json:"transaction_id"`
+		TransactionID svc.TransactionID `json:"transaction_id"`
 		// IdempotencyKey is the idempotency key used for creation.
 		IdempotencyKey string `json:"idempotency_key"`
 		// Amount is the withdrawal amount.
@@ -119,14 +162,30 @@ type (
 		WalletAddress string `json:"wallet_address,omitempty"`
 		// ExternalAccountID is the external account ID for fiat withdrawals.
 		ExternalAccountID string `json:"external_account_id,omitempty"`
+		// RecipientID is the saved recipient ID, if the withdrawal was sent to a recipient.
+		RecipientID svc.RecipientID `json:"recipient_id,omitempty"`
+		// RecipientBankAccountID is the recipient's bank account ID for fiat withdrawals.
+		RecipientBankAccountID string `json:"recipient_bank_account_id,omitempty"`
+		// RecipientWalletAddressID is the recipient's wallet address ID for crypto withdrawals.
+		RecipientWalletAddressID string `json:"recipient_wallet_address_id,omitempty"`
 		// Code is the localized payment code.
 		Code string `json:"code,omitempty"`
-		// Status is the current status of the withdrawal.
-		Status string `json:"status"`
+		// Status is the current status of the withdrawal: PENDING, COMPLETED, FAILED, or REVERSED.
+		Status TransactionStatus `json:"status"`
 		// TransactionFee contains the fee information.
 		TransactionFee FeeMeta `json:"transaction_fee"`
 		// TransactionAction is the transaction action (always "WITHDRAWAL").
 		TransactionAction string `json:"transaction_action"`
+		// Originator is the travel-rule originator information, if provided.
+		Originator *TravelRuleParty `json:"originator,omitempty"`
+		// Beneficiary is the travel-rule beneficiary information, if provided.
+		Beneficiary *TravelRuleParty `json:"beneficiary,omitempty"`
+		// BeneficiaryVASP identifies the VASP hosting the beneficiary wallet, if provided.
+		BeneficiaryVASP *VASPInfo `json:"beneficiary_vasp,omitempty"`
+		// TransactionHash is the on-chain transaction hash for crypto withdrawals, once broadcast.
+		TransactionHash string `json:"transaction_hash,omitempty"`
+		// TraceNumber is the ACH trace number (or wire reference) for fiat withdrawals, once submitted.
+		TraceNumber string `json:"trace_number,omitempty"`
 		// CreatedAt is the withdrawal creation timestamp.
 		CreatedAt string `json:"created_at"`
 		// ModifiedAt is the withdrawal last modification timestamp.
@@ -134,6 +193,111 @@ type (
 	}
 )
 
+// ListWithdrawals request and response types.
+type (
+	// ListWithdrawalsRequest represents optional query parameters for listing withdrawals.
+	ListWithdrawalsRequest struct {
+		// Status filters by withdrawal status.
+		Status TransactionStatus `json:"status,omitempty"`
+		// Asset filters by asset name.
+		Asset assets.AssetName `json:"asset,omitempty"`
+		// Network filters by network name.
+		Network assets.NetworkName `json:"network,omitempty"`
+		// CreatedAfter filters withdrawals created after this timestamp (RFC3339/ISO 8601 format).
+		CreatedAfter string `json:"created_after,omitempty"`
+		// CreatedBefore filters withdrawals created before this timestamp (RFC3339/ISO 8601 format).
+		CreatedBefore string `json:"created_before,omitempty"`
+		// Page is the page number (starts from 1).
+		Page int `json:"page,omitempty"`
+		// Size is the number of items per page (1-100).
+		Size int `json:"size,omitempty"`
+	}
+
+	// ListWithdrawalsResponse represents the response for listing withdrawals.
+	ListWithdrawalsResponse struct {
+		// List contains the list of withdrawals.
+		List []WithdrawalResponse `json:"list"`
+		// Total is the total number of withdrawals matching the query.
+		Total int `json:"total,omitempty"`
+	}
+)
+
+// EstimateFee response types.
+type (
+	// ETAWindow represents the expected settlement window for a withdrawal.
+	ETAWindow struct {
+		// MinSeconds is the earliest expected settlement time, in seconds after submission.
+		MinSeconds int64 `json:"min_seconds"`
+		// MaxSeconds is the latest expected settlement time, in seconds after submission.
+		MaxSeconds int64 `json:"max_seconds"`
+	}
+
+	// FeeEstimateResponse represents the estimated cost of a withdrawal.
+	FeeEstimateResponse struct {
+		// Amount is the requested withdrawal amount.
+		Amount string `json:"amount"`
+		// Asset is the asset being withdrawn.
+		Asset string `json:"asset"`
+		// Network is the network used for the withdrawal.
+		Network string `json:"network"`
+		// NetworkFee is the fee charged by the underlying rail or blockchain.
+		NetworkFee FeeMeta `json:"network_fee"`
+		// PlatformFee is the fee charged by the 1Money platform.
+		PlatformFee FeeMeta `json:"platform_fee"`
+		// TotalFee is the sum of all fees.
+		TotalFee FeeMeta `json:"total_fee"`
+		// NetAmount is the amount the recipient is expected to receive after fees.
+		NetAmount string `json:"net_amount"`
+		// ETA is the expected settlement window.
+		ETA ETAWindow `json:"eta"`
+	}
+)
+
+// Min returns the earliest expected settlement time as a duration.
+func (w ETAWindow) Min() time.Duration {
+	return time.Duration(w.MinSeconds) * time.Second
+}
+
+// Max returns the latest expected settlement time as a duration.
+func (w ETAWindow) Max() time.Duration {
+	return time.Duration(w.MaxSeconds) * time.Second
+}
+
+// GetLimits response types.
+type (
+	// CutoffTime represents a daily submission cutoff for a fiat rail.
+	CutoffTime struct {
+		// Description describes what the cutoff applies to (e.g., "same-day ACH").
+		Description string `json:"description"`
+		// Time is the cutoff time of day (HH:MM format).
+		Time string `json:"time"`
+		// TimeZone is the IANA time zone of the cutoff (e.g., America/New_York).
+		TimeZone string `json:"time_zone"`
+		// BusinessDaysOnly indicates whether the cutoff only applies on business days.
+		BusinessDaysOnly bool `json:"business_days_only"`
+	}
+
+	// LimitsResponse represents withdrawal limits and cutoff times for a network.
+	LimitsResponse struct {
+		// Network is the network the limits apply to.
+		Network string `json:"network"`
+		// Asset is the asset the limits are denominated in.
+		Asset string `json:"asset"`
+		// MinAmount is the minimum amount for a single withdrawal.
+		MinAmount string `json:"min_amount"`
+		// MaxAmount is the maximum amount for a single withdrawal.
+		MaxAmount string `json:"max_amount"`
+		// DailyLimit is the maximum total amount that can be withdrawn per day.
+		DailyLimit string `json:"daily_limit"`
+		// DailyRemaining is the amount still available to withdraw today.
+		DailyRemaining string `json:"daily_remaining"`
+		// DailyResetAt is when the daily limit resets (ISO 8601 format).
+		DailyResetAt string `json:"daily_reset_at,omitempty"`
+		// Cutoffs lists the submission cutoff times for fiat rails; empty for crypto networks.
+		Cutoffs []CutoffTime `json:"cutoffs,omitempty"`
+	}
+)
+
 type serviceImpl struct {
 	*svc.BaseService
 }
@@ -151,6 +315,10 @@ func (s *serviceImpl) CreateWithdrawal(
 	id svc.CustomerID,
 	req *CreateWithdrawalRequest,
 ) (*WithdrawalResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("/v1/customers/%s/withdrawals", id)
 
 	body, err := json.Marshal(req)
@@ -185,7 +353,7 @@ func (s *serviceImpl) CreateWithdrawal(
 func (s *serviceImpl) GetWithdrawal(
 	ctx context.Context,
 	id svc.CustomerID,
-	withdrawalID string,
+	withdrawalID svc.TransactionID,
 ) (*WithdrawalResponse, error) {
 	path := fmt.Sprintf("/v1/customers/%s/withdrawals/%s", id, withdrawalID)
 	return svc.GetJSON[WithdrawalResponse](ctx, s.BaseService, path)
@@ -203,3 +371,69 @@ func (s *serviceImpl) GetWithdrawalByIdempotencyKey(
 	}
 	return svc.GetJSONWithParams[WithdrawalResponse](ctx, s.BaseService, path, params)
 }
+
+// ListWithdrawals retrieves withdrawals for a customer with optional filters and pagination.
+func (s *serviceImpl) ListWithdrawals(
+	ctx context.Context,
+	id svc.CustomerID,
+	req *ListWithdrawalsRequest,
+) (*ListWithdrawalsResponse, error) {
+	path := fmt.Sprintf("/v1/customers/%s/withdrawals/list", id)
+
+	params := make(map[string]string)
+	if req != nil {
+		if req.Status != "" {
+			params["status"] = string(req.Status)
+		}
+		if req.Asset != "" {
+			params["asset"] = string(req.Asset)
+		}
+		if req.Network != "" {
+			params["network"] = string(req.Network)
+		}
+		if req.CreatedAfter != "" {
+			params["created_after"] = req.CreatedAfter
+		}
+		if req.CreatedBefore != "" {
+			params["created_before"] = req.CreatedBefore
+		}
+		if req.Page > 0 {
+			params["page"] = fmt.Sprintf("%d", req.Page)
+		}
+		if req.Size > 0 {
+			params["size"] = fmt.Sprintf("%d", req.Size)
+		}
+	}
+
+	return svc.GetJSONWithParams[ListWithdrawalsResponse](ctx, s.BaseService, path, params)
+}
+
+// EstimateFee estimates the fees, net amount, and settlement window for a withdrawal before it is submitted.
+func (s *serviceImpl) EstimateFee(
+	ctx context.Context,
+	id svc.CustomerID,
+	asset assets.AssetName,
+	network assets.NetworkName,
+	amount string,
+) (*FeeEstimateResponse, error) {
+	path := fmt.Sprintf("/v1/customers/%s/withdrawals/fee-estimate", id)
+	params := map[string]string{
+		"asset":   string(asset),
+		"network": string(network),
+		"amount":  amount,
+	}
+	return svc.GetJSONWithParams[FeeEstimateResponse](ctx, s.BaseService, path, params)
+}
+
+// GetLimits retrieves amount limits, remaining daily limits, and rail cutoff times for a network.
+func (s *serviceImpl) GetLimits(
+	ctx context.Context,
+	id svc.CustomerID,
+	network assets.NetworkName,
+) (*LimitsResponse, error) {
+	path := fmt.Sprintf("/v1/customers/%s/withdrawals/limits", id)
+	params := map[string]string{
+		"network": string(network),
+	}
+	return svc.GetJSONWithParams[LimitsResponse](ctx, s.BaseService, path, params)
+}
@@ -0,0 +1,106 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package withdraws
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidTravelRule is returned when travel-rule originator or beneficiary
+// information on a withdrawal request is incomplete.
+var ErrInvalidTravelRule = errors.New("invalid travel rule information")
+
+// Travel-rule types modeled on the IVMS 101 data standard.
+type (
+	// TravelRuleAddress represents a geographic address of a travel-rule party.
+	TravelRuleAddress struct {
+		// StreetName is the street name.
+		StreetName string `json:"street_name,omitempty"`
+		// BuildingNumber is the building number.
+		BuildingNumber string `json:"building_number,omitempty"`
+		// PostCode is the postal code.
+		PostCode string `json:"post_code,omitempty"`
+		// TownName is the town or city name.
+		TownName string `json:"town_name"`
+		// CountrySubDivision is the state, province, or region.
+		CountrySubDivision string `json:"country_sub_division,omitempty"`
+		// Country is the ISO 3166-1 alpha-2 country code.
+		Country string `json:"country"`
+	}
+
+	// NationalIdentification represents a national identifier of a travel-rule party.
+	NationalIdentification struct {
+		// Type is the identifier type (e.g., PASSPORT, NATIONAL_ID, LEI, TAX_ID).
+		Type string `json:"type"`
+		// Number is the identifier value.
+		Number string `json:"number"`
+		// IssuingCountry is the ISO 3166-1 alpha-2 code of the issuing country.
+		IssuingCountry string `json:"issuing_country,omitempty"`
+	}
+
+	// TravelRuleParty represents the originator or beneficiary of a transfer.
+	TravelRuleParty struct {
+		// Type is the party type (NATURAL_PERSON or LEGAL_PERSON).
+		Type PartyType `json:"type"`
+		// FirstName is the first name of a natural person.
+		FirstName string `json:"first_name,omitempty"`
+		// LastName is the last name of a natural person.
+		LastName string `json:"last_name,omitempty"`
+		// LegalName is the registered name of a legal person.
+		LegalName string `json:"legal_name,omitempty"`
+		// DateOfBirth is the date of birth of a natural person (YYYY-MM-DD format).
+		DateOfBirth string `json:"date_of_birth,omitempty"`
+		// PlaceOfBirth is the place of birth of a natural person.
+		PlaceOfBirth string `json:"place_of_birth,omitempty"`
+		// Address is the geographic address of the party.
+		Address *TravelRuleAddress `json:"address,omitempty"`
+		// NationalIdentification is a national identifier of the party.
+		NationalIdentification *NationalIdentification `json:"national_identification,omitempty"`
+		// AccountNumber is the party's account reference, usually the wallet address.
+		AccountNumber string `json:"account_number,omitempty"`
+	}
+
+	// VASPInfo identifies the virtual asset service provider hosting the beneficiary wallet.
+	VASPInfo struct {
+		// Name is the legal name of the VASP.
+		Name string `json:"name"`
+		// LEI is the Legal Entity Identifier of the VASP (optional).
+		LEI string `json:"lei,omitempty"`
+		// DID is the decentralized identifier of the VASP (optional).
+		DID string `json:"did,omitempty"`
+		// Country is the ISO 3166-1 alpha-2 country code of the VASP.
+		Country string `json:"country,omitempty"`
+	}
+)
+
+// Validate checks that the party has a valid type and the name fields required for it.
+func (p *TravelRuleParty) Validate() error {
+	switch p.Type {
+	case PartyTypeNATURALPERSON:
+		if p.FirstName == "" || p.LastName == "" {
+			return fmt.Errorf("%w: first_name and last_name are required for a natural person", ErrInvalidTravelRule)
+		}
+	case PartyTypeLEGALPERSON:
+		if p.LegalName == "" {
+			return fmt.Errorf("%w: legal_name is required for a legal person", ErrInvalidTravelRule)
+		}
+	default:
+		return fmt.Errorf("%w: invalid party type %q", ErrInvalidTravelRule, p.Type)
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package contract checks that the SDK response types decode every field the API sends.
+//
+// The responses come from two sources. TestOpenAPIContract synthesizes a response for
+// every endpoint from the OpenAPI specification named by ONEMONEY_OPENAPI_SPEC, a file or
+// URL. TestRecordedContract checks the responses recorded in the e2e cassettes. Both fail
+// for fields the SDK would silently drop and for type mismatches.
+package contract
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/1Money-Co/1money-go-sdk/internal/contract"
+)
+
+const (
+	// specEnv names the OpenAPI specification to check the endpoints against.
+	specEnv = "ONEMONEY_OPENAPI_SPEC"
+	// cassetteDir holds the cassettes recorded by the e2e suites.
+	cassetteDir = "../e2e/testdata/cassettes"
+)
+
+func TestOpenAPIContract(t *testing.T) {
+	location := os.Getenv(specEnv)
+	if location == "" {
+		t.Skipf("set %s to the OpenAPI specification file or URL", specEnv)
+	}
+	spec, err := contract.LoadSpec(context.Background(), location)
+	require.NoError(t, err)
+
+	for _, ep := range endpoints {
+		t.Run(ep.Method+" "+ep.Path, func(t *testing.T) {
+			sample, err := spec.ResponseSample(ep.Method, ep.Path)
+			if errors.Is(err, contract.ErrNoOperation) {
+				t.Errorf("the specification does not describe the endpoint")
+				return
+			}
+			require.NoError(t, err)
+			if sample == nil {
+				t.Errorf("the specification describes no JSON response")
+				return
+			}
+			check(t, sample, ep.Response)
+		})
+	}
+}
+
+// cassette is the part of the onemoneytest.Recorder file format read here.
+type cassette struct {
+	Interactions []struct {
+		Request struct {
+			Method string `json:"method"`
+			URL    string `json:"url"`
+		} `json:"request"`
+		Response struct {
+			Status       int    `json:"status"`
+			Body         string `json:"body"`
+			BodyEncoding string `json:"body_encoding"`
+		} `json:"response"`
+	} `json:"interactions"`
+}
+
+func TestRecordedContract(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join(cassetteDir, "*.json"))
+	require.NoError(t, err)
+	if len(files) == 0 {
+		t.Skipf("no cassettes in %s; record them with `just test-e2e-record`", cassetteDir)
+	}
+
+	for _, file := range files {
+		t.Run(strings.TrimSuffix(filepath.Base(file), ".json"), func(t *testing.T) {
+			data, err := os.ReadFile(file)
+			require.NoError(t, err)
+			var c cassette
+			require.NoError(t, json.Unmarshal(data, &c))
+
+			for i, it := range c.Interactions {
+				if it.Response.Status/100 != 2 || it.Response.Body == "" || it.Response.BodyEncoding != "" {
+					continue
+				}
+				u, err := url.Parse(it.Request.URL)
+				require.NoError(t, err)
+				ep, ok := match(it.Request.Method, u.Path)
+				if !ok {
+					t.Logf("interaction %d: no endpoint for %s %s", i, it.Request.Method, u.Path)
+					continue
+				}
+				t.Run(fmt.Sprintf("%d %s %s", i, ep.Method, ep.Path), func(t *testing.T) {
+					check(t, []byte(it.Response.Body), ep.Response)
+				})
+			}
+		})
+	}
+}
+
+// check decodes a response into a new value of the type of response and reports
+// the fields that are not decoded.
+func check(t *testing.T, data []byte, response any) {
+	t.Helper()
+	v := reflect.New(reflect.TypeOf(response)).Interface()
+	unknown, err := contract.UnknownFields(data, v)
+	if err != nil {
+		t.Errorf("failed to decode %T: %v", response, err)
+		return
+	}
+	for _, path := range unknown {
+		t.Errorf("%T does not decode field %q", response, path)
+	}
+}
+
+// match finds the endpoint of a request path. Literal segments take precedence over
+// parameters, so "/withdrawals/list" is not taken for "/withdrawals/{withdrawal_id}".
+func match(method, path string) (endpoint, bool) {
+	segments := strings.Split(path, "/")
+	best, bestLiterals := endpoint{}, -1
+	for _, ep := range endpoints {
+		pattern := strings.Split(ep.Path, "/")
+		if ep.Method != method || len(pattern) != len(segments) {
+			continue
+		}
+		literals := 0
+		for i, seg := range pattern {
+			if strings.HasPrefix(seg, "{") {
+				continue
+			}
+			if seg != segments[i] {
+				literals = -1
+				break
+			}
+			literals++
+		}
+		if literals > bestLiterals {
+			best, bestLiterals = ep, literals
+		}
+	}
+	return best, bestLiterals >= 0
+}
+
+// TestMatch checks that recorded paths resolve to the most specific endpoint.
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		method, path, want string
+	}{
+		{"GET", "/v1/customers/c1/withdrawals/list", "/v1/customers/{customer_id}/withdrawals/list"},
+		{"GET", "/v1/customers/c1/withdrawals/w1", "/v1/customers/{customer_id}/withdrawals/{withdrawal_id}"},
+		{"GET", "/v1/customers/c1/auto-conversion-rules/orders", "/v1/customers/{customer_id}/auto-conversion-rules/orders"},
+		{"GET", "/v1/customers/c1", "/v1/customers/{customer_id}"},
+		{"DELETE", "/v1/customers/c1", ""},
+	}
+	for _, tt := range tests {
+		ep, ok := match(tt.method, tt.path)
+		if got := map[bool]string{true: ep.Path}[ok]; got != tt.want {
+			t.Errorf("match(%s %s) = %q, want %q", tt.method, tt.path, got, tt.want)
+		}
+	}
+}
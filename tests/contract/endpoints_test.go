@@ -0,0 +1,201 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package contract
+
+import (
+	"net/http"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/address_allowlist"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/api_keys"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/audit_logs"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/auto_conversion_rules"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/conversions"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/customer"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/echo"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/events"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/external_accounts"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/fees"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/instructions"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/invoices"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/ledger"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/limits"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/notifications"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/payouts"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/platform"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/rates"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/recipients"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/screening"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/simulations"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/statements"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/status"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/sweep_rules"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/transactions"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/travel_rule"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/withdraws"
+)
+
+// endpoint is an API operation and the SDK type its JSON response is decoded into.
+type endpoint struct {
+	Method string
+	// Path is the path template; parameter names need not match the specification.
+	Path string
+	// Response is a value of the response type.
+	Response any
+}
+
+// endpoints lists the operations of every service that respond with a JSON body.
+// Add an entry when a service gains a method.
+var endpoints = []endpoint{
+	{http.MethodGet, "/echo", echo.Response{}},
+	{http.MethodPost, "/echo", echo.Response{}},
+	{http.MethodGet, "/v1/status", status.StatusResponse{}},
+
+	{http.MethodGet, "/v1/address-allowlist/settings", address_allowlist.SettingsResponse{}},
+	{http.MethodPost, "/v1/address-allowlist", address_allowlist.EntryResponse{}},
+	{http.MethodGet, "/v1/address-allowlist/list", []address_allowlist.EntryResponse{}},
+	{http.MethodPost, "/v1/address-allowlist/{entry_id}/remove", address_allowlist.EntryResponse{}},
+
+	{http.MethodPost, "/v1/api-keys", api_keys.APIKeySecretResponse{}},
+	{http.MethodGet, "/v1/api-keys/list", []api_keys.APIKeyResponse{}},
+	{http.MethodPost, "/v1/api-keys/{key_id}/rotate", api_keys.APIKeySecretResponse{}},
+	{http.MethodPost, "/v1/api-keys/{key_id}/revoke", api_keys.APIKeyResponse{}},
+
+	{http.MethodGet, "/v1/audit-logs", audit_logs.ListResponse{}},
+
+	{http.MethodGet, "/v1/platform/balances", platform.AggregateBalancesResponse{}},
+	{http.MethodPost, "/v1/platform/transactions/search", transactions.ListTransactionsResponse{}},
+	{http.MethodGet, "/v1/platform/kyb/summary", platform.KYBSummaryResponse{}},
+	{http.MethodGet, "/v1/platform/kyb/list", platform.ListKYBStatusesResponse{}},
+
+	{http.MethodGet, "/v1/rates/historical", rates.RateResponse{}},
+	{http.MethodGet, "/v1/rates/daily", []rates.DailyRate{}},
+
+	{http.MethodPost, "/v1/customers/tos_links", customer.TOSLinkResponse{}},
+	{http.MethodPost, "/v1/customers/tos_links/{session_token}/sign", customer.SignAgreementResponse{}},
+	{http.MethodPost, "/v1/customers", customer.CreateCustomerResponse{}},
+	{http.MethodGet, "/v1/customers", customer.ListCustomersResponse{}},
+	{http.MethodGet, "/v1/customers/{customer_id}", customer.CustomerResponse{}},
+	{http.MethodPut, "/v1/customers/{customer_id}", customer.UpdateCustomerResponse{}},
+	{http.MethodPost, "/v1/customers/{customer_id}/associated_persons", customer.AssociatedPersonResponse{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/associated_persons", customer.ListAssociatedPersonsResponse{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/associated_persons/{associated_person_id}", customer.AssociatedPersonResponse{}},
+	{http.MethodPut, "/v1/customers/{customer_id}/associated_persons/{associated_person_id}", customer.AssociatedPersonResponse{}},
+
+	{http.MethodGet, "/v1/customers/{customer_id}/assets", []assets.AssetResponse{}},
+
+	{http.MethodPost, "/v1/customers/{customer_id}/auto-conversion-rules", auto_conversion_rules.RuleResponse{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/auto-conversion-rules", auto_conversion_rules.RuleResponse{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/auto-conversion-rules/list", auto_conversion_rules.ListRulesResponse{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/auto-conversion-rules/orders", auto_conversion_rules.OrderResponse{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/auto-conversion-rules/orders/list", auto_conversion_rules.ListOrdersResponse{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/auto-conversion-rules/{rule_id}", auto_conversion_rules.RuleResponse{}},
+	{http.MethodPatch, "/v1/customers/{customer_id}/auto-conversion-rules/{rule_id}", auto_conversion_rules.RuleResponse{}},
+	{http.MethodPost, "/v1/customers/{customer_id}/auto-conversion-rules/{rule_id}/pause", auto_conversion_rules.RuleResponse{}},
+	{http.MethodPost, "/v1/customers/{customer_id}/auto-conversion-rules/{rule_id}/resume", auto_conversion_rules.RuleResponse{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/auto-conversion-rules/{rule_id}/orders", auto_conversion_rules.ListOrdersResponse{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/auto-conversion-rules/{rule_id}/orders/{order_id}", auto_conversion_rules.OrderResponse{}},
+	{http.MethodPost, "/v1/customers/{customer_id}/auto-conversion-rules/{rule_id}/orders/{order_id}/retry", auto_conversion_rules.OrderResponse{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/auto-conversion-rules/{rule_id}/stats", auto_conversion_rules.RuleStatsResponse{}},
+
+	{http.MethodPost, "/v1/customers/{customer_id}/conversions/quote", conversions.QuoteResponse{}},
+	{http.MethodPost, "/v1/customers/{customer_id}/conversions/hedge", conversions.OrderResponse{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/conversions/order", conversions.OrderResponse{}},
+
+	{http.MethodGet, "/v1/customers/{customer_id}/events", events.ListResponse{}},
+
+	{http.MethodPost, "/v1/customers/{customer_id}/external-accounts", external_accounts.Resp{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/external-accounts", external_accounts.Resp{}},
+	{http.MethodPost, "/v1/customers/{customer_id}/external-accounts/plaid", external_accounts.Resp{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/external-accounts/list", []external_accounts.Resp{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/external-accounts/{external_account_id}", external_accounts.Resp{}},
+	{http.MethodPatch, "/v1/customers/{customer_id}/external-accounts/{external_account_id}", external_accounts.Resp{}},
+	{http.MethodPost, "/v1/customers/{customer_id}/external-accounts/{external_account_id}/micro-deposits", external_accounts.Resp{}},
+	{http.MethodPost, "/v1/customers/{customer_id}/external-accounts/{external_account_id}/micro-deposits/confirm", external_accounts.Resp{}},
+
+	{http.MethodGet, "/v1/customers/{customer_id}/fees", fees.FeeScheduleResponse{}},
+
+	{http.MethodGet, "/v1/customers/{customer_id}/deposit_instructions", instructions.InstructionResponse{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/deposit_instructions/list", []instructions.InstructionResponse{}},
+
+	{http.MethodPost, "/v1/customers/{customer_id}/invoices", invoices.InvoiceResponse{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/invoices/list", invoices.ListInvoicesResponse{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/invoices/{invoice_id}", invoices.InvoiceResponse{}},
+	{http.MethodPost, "/v1/customers/{customer_id}/invoices/{invoice_id}/cancel", invoices.InvoiceResponse{}},
+
+	{http.MethodGet, "/v1/customers/{customer_id}/ledger/entries", ledger.ListEntriesResponse{}},
+
+	{http.MethodGet, "/v1/customers/{customer_id}/limits", limits.LimitsResponse{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/limits/utilization", []limits.Utilization{}},
+
+	{http.MethodGet, "/v1/customers/{customer_id}/notification-preferences", notifications.PreferencesResponse{}},
+	{http.MethodPatch, "/v1/customers/{customer_id}/notification-preferences", notifications.PreferencesResponse{}},
+
+	{http.MethodPost, "/v1/customers/{customer_id}/payouts", payouts.BatchResponse{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/payouts/list", payouts.ListBatchesResponse{}},
+	{http.MethodPost, "/v1/customers/{customer_id}/payouts/funding-check", payouts.FundingCheckResponse{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/payouts/{batch_id}", payouts.BatchResponse{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/payouts/{batch_id}/items", payouts.ListItemsResponse{}},
+
+	{http.MethodPost, "/v1/customers/{customer_id}/recipients", recipients.RecipientResponse{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/recipients/list", recipients.ListRecipientsResponse{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/recipients/{recipient_id}", recipients.RecipientResponse{}},
+	{http.MethodPatch, "/v1/customers/{customer_id}/recipients/{recipient_id}", recipients.RecipientResponse{}},
+	{http.MethodPost, "/v1/customers/{customer_id}/recipients/{recipient_id}/bank-accounts", recipients.BankAccountResponse{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/recipients/{recipient_id}/bank-accounts/list", recipients.ListBankAccountsResponse{}},
+	{http.MethodPost, "/v1/customers/{customer_id}/recipients/{recipient_id}/wallet-addresses", recipients.WalletAddressResponse{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/recipients/{recipient_id}/wallet-addresses/list", recipients.ListWalletAddressesResponse{}},
+
+	{http.MethodPost, "/v1/customers/{customer_id}/screening/wallet-addresses", screening.Result{}},
+	{http.MethodPost, "/v1/customers/{customer_id}/screening/bank-counterparties", screening.Result{}},
+
+	{http.MethodPost, "/v1/customers/{customer_id}/simulate-transactions", simulations.SimulateDepositResponse{}},
+	{http.MethodPost, "/v1/customers/{customer_id}/simulate-transactions/{transaction_id}/confirmations", transactions.TransactionResponse{}},
+	{http.MethodPost, "/v1/customers/{customer_id}/simulate-withdrawals/{transaction_id}", simulations.SimulateWithdrawalStatusResponse{}},
+	{http.MethodPost, "/v1/customers/{customer_id}/simulate-kyb-status", customer.CustomerResponse{}},
+	{http.MethodPost, "/v1/customers/{customer_id}/simulate-external-accounts/{external_account_id}", external_accounts.Resp{}},
+
+	{http.MethodPost, "/v1/customers/{customer_id}/statements", statements.StatementResponse{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/statements/list", []statements.StatementResponse{}},
+
+	{http.MethodPost, "/v1/customers/{customer_id}/sweep-rules", sweep_rules.RuleResponse{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/sweep-rules/list", sweep_rules.ListRulesResponse{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/sweep-rules/{rule_id}", sweep_rules.RuleResponse{}},
+	{http.MethodPost, "/v1/customers/{customer_id}/sweep-rules/{rule_id}/pause", sweep_rules.RuleResponse{}},
+	{http.MethodPost, "/v1/customers/{customer_id}/sweep-rules/{rule_id}/resume", sweep_rules.RuleResponse{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/sweep-rules/{rule_id}/executions", sweep_rules.ListExecutionsResponse{}},
+
+	{http.MethodGet, "/v1/customers/{customer_id}/transactions", transactions.ListTransactionsResponse{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/transactions/reconciliation", transactions.ReconciliationSummary{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/transactions/{transaction_id}", transactions.TransactionResponse{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/transactions/{transaction_id}/chain", transactions.TransactionChain{}},
+	{http.MethodPatch, "/v1/customers/{customer_id}/transactions/{transaction_id}/metadata", transactions.TransactionResponse{}},
+
+	{http.MethodPost, "/v1/customers/{customer_id}/travel-rule/packets", travel_rule.PacketResponse{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/travel-rule/packets/list", travel_rule.ListPacketsResponse{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/travel-rule/packets/{packet_id}", travel_rule.PacketResponse{}},
+
+	{http.MethodPost, "/v1/customers/{customer_id}/withdrawals", withdraws.WithdrawalResponse{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/withdrawals", withdraws.WithdrawalResponse{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/withdrawals/list", withdraws.ListWithdrawalsResponse{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/withdrawals/fee-estimate", withdraws.FeeEstimateResponse{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/withdrawals/limits", withdraws.LimitsResponse{}},
+	{http.MethodPost, "/v1/customers/{customer_id}/withdrawals/scheduled", withdraws.ScheduledWithdrawalResponse{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/withdrawals/scheduled/list", withdraws.ListScheduledWithdrawalsResponse{}},
+	{http.MethodPost, "/v1/customers/{customer_id}/withdrawals/scheduled/{schedule_id}/cancel", withdraws.ScheduledWithdrawalResponse{}},
+	{http.MethodGet, "/v1/customers/{customer_id}/withdrawals/{withdrawal_id}", withdraws.WithdrawalResponse{}},
+}
@@ -0,0 +1,94 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package e2e
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/address_allowlist"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+)
+
+// AddressAllowlistTestSuite tests address allowlist service operations.
+type AddressAllowlistTestSuite struct {
+	E2ETestSuite
+}
+
+// TestAddressAllowlist_Lifecycle tests the entry lifecycle: Add → List → Check → Remove
+func (s *AddressAllowlistTestSuite) TestAddressAllowlist_Lifecycle() {
+	settings, err := s.Client.AddressAllowlist.GetSettings(s.Ctx)
+	s.Require().NoError(err, "GetSettings should succeed")
+	s.GreaterOrEqual(settings.CooldownSeconds, 0)
+
+	address := s.Fixtures.EthereumAddress()
+	added, err := s.Client.AddressAllowlist.AddAddress(s.Ctx, &address_allowlist.AddAddressRequest{
+		IdempotencyKey: s.IdempotencyKey("add-address"),
+		Address:        address,
+		Network:        assets.NetworkNameETHEREUM,
+		Label:          s.Namespace(),
+	})
+	s.Require().NoError(err, "AddAddress should succeed")
+	s.Resources.Track("allowlist entry "+added.EntryID, func(ctx context.Context) error {
+		_, err := s.Client.AddressAllowlist.RemoveAddress(ctx, added.EntryID)
+		return err
+	})
+	s.NotEmpty(added.EntryID)
+	s.True(added.Status.IsValid(), "Entry status should be a known value")
+	if settings.CooldownSeconds > 0 {
+		s.Equal(address_allowlist.EntryStatusPENDING, added.Status, "New address should be in cooldown")
+	}
+
+	listed, err := s.Client.AddressAllowlist.ListAddresses(s.Ctx, &address_allowlist.ListAddressesRequest{
+		Network: assets.NetworkNameETHEREUM,
+		Address: address,
+	})
+	s.Require().NoError(err, "ListAddresses should succeed")
+	found := false
+	for i := range listed {
+		if listed[i].EntryID == added.EntryID {
+			found = true
+			break
+		}
+	}
+	s.True(found, "Added address should be listed")
+
+	err = s.Client.AddressAllowlist.CheckAddress(s.Ctx, assets.NetworkNameETHEREUM, address)
+	if settings.Enabled && added.Status == address_allowlist.EntryStatusPENDING {
+		s.ErrorIs(err, address_allowlist.ErrAddressPending)
+	} else {
+		s.NoError(err, "CheckAddress should allow the added address")
+	}
+
+	removed, err := s.Client.AddressAllowlist.RemoveAddress(s.Ctx, added.EntryID)
+	s.Require().NoError(err, "RemoveAddress should succeed")
+	s.Equal(added.EntryID, removed.EntryID)
+
+	if settings.Enabled {
+		err = s.Client.AddressAllowlist.CheckAddress(s.Ctx, assets.NetworkNameETHEREUM, address)
+		s.ErrorIs(err, address_allowlist.ErrAddressNotAllowlisted, "Removed address should be rejected")
+	}
+
+	s.T().Logf("Removed entry:\n%s", PrettyJSON(removed))
+}
+
+// TestAddressAllowlistTestSuite runs the address allowlist test suite.
+func TestAddressAllowlistTestSuite(t *testing.T) {
+	suite.Run(t, new(AddressAllowlistTestSuite))
+}
@@ -0,0 +1,81 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package e2e
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/api_keys"
+)
+
+// APIKeysTestSuite tests API keys service operations.
+type APIKeysTestSuite struct {
+	E2ETestSuite
+}
+
+// TestAPIKeys_Lifecycle tests the key lifecycle: Create → List → Rotate → Revoke
+func (s *APIKeysTestSuite) TestAPIKeys_Lifecycle() {
+	expiresAt := time.Now().Add(24 * time.Hour).UTC().Format(time.RFC3339)
+
+	created, err := s.Client.APIKeys.CreateAPIKey(s.Ctx, &api_keys.CreateAPIKeyRequest{
+		IdempotencyKey: s.IdempotencyKey("create-key"),
+		Name:           s.Namespace(),
+		Scopes:         []api_keys.Scope{api_keys.ScopeCUSTOMERSREAD},
+		ExpiresAt:      &expiresAt,
+	})
+	s.Require().NoError(err, "CreateAPIKey should succeed")
+	s.Resources.Track("api key "+created.KeyID, func(ctx context.Context) error {
+		_, err := s.Client.APIKeys.RevokeAPIKey(ctx, created.KeyID)
+		return err
+	})
+	s.NotEmpty(created.KeyID)
+	s.NotEmpty(created.AccessKey)
+	s.NotEmpty(created.SecretKey, "Secret should be returned on creation")
+	s.Equal(api_keys.APIKeyStatusACTIVE, created.Status)
+
+	listed, err := s.Client.APIKeys.ListAPIKeys(s.Ctx, &api_keys.ListAPIKeysRequest{Status: api_keys.APIKeyStatusACTIVE})
+	s.Require().NoError(err, "ListAPIKeys should succeed")
+	found := false
+	for i := range listed {
+		if listed[i].KeyID == created.KeyID {
+			found = true
+			break
+		}
+	}
+	s.True(found, "Created key should be listed")
+
+	rotated, err := s.Client.APIKeys.RotateAPIKey(s.Ctx, created.KeyID, &api_keys.RotateAPIKeyRequest{GracePeriodSeconds: 60})
+	s.Require().NoError(err, "RotateAPIKey should succeed")
+	s.NotEmpty(rotated.SecretKey)
+	s.NotEqual(created.SecretKey, rotated.SecretKey, "Rotation should issue a new secret")
+
+	revoked, err := s.Client.APIKeys.RevokeAPIKey(s.Ctx, rotated.KeyID)
+	s.Require().NoError(err, "RevokeAPIKey should succeed")
+	s.Equal(api_keys.APIKeyStatusREVOKED, revoked.Status)
+	s.False(revoked.IsUsable())
+
+	s.T().Logf("Revoked key:\n%s", PrettyJSON(revoked))
+}
+
+// TestAPIKeysTestSuite runs the API keys test suite.
+func TestAPIKeysTestSuite(t *testing.T) {
+	suite.Run(t, new(APIKeysTestSuite))
+}
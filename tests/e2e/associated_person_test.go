@@ -19,7 +19,6 @@ package e2e
 import (
 	"testing"
 
-	"github.com/brianvoe/gofakeit/v7"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/1Money-Co/1money-go-sdk/pkg/service/customer"
@@ -34,14 +33,12 @@ type AssociatedPersonTestSuite struct {
 // TestAssociatedPerson_Create tests creating an associated person.
 // Creates its own customer to avoid KYB approval timing issues.
 func (s *AssociatedPersonTestSuite) TestAssociatedPerson_Create() {
-	faker := gofakeit.New(0)
-
 	// Create a fresh customer for this test
 	customerID, _, err := s.CreatePendingCustomer()
 	s.Require().NoError(err, "CreatePendingCustomer should succeed")
 
 	req := &customer.CreateAssociatedPersonRequest{
-		AssociatedPerson: FakeAssociatedPerson(faker),
+		AssociatedPerson: s.Fixtures.AssociatedPerson(),
 	}
 
 	resp, err := s.Client.Customer.CreateAssociatedPerson(s.Ctx, customerID, req)
@@ -74,14 +71,12 @@ func (s *AssociatedPersonTestSuite) TestAssociatedPerson_Get() {
 // TestAssociatedPerson_Update tests updating an associated person.
 // Creates its own customer and associated person to avoid KYB approval timing issues.
 func (s *AssociatedPersonTestSuite) TestAssociatedPerson_Update() {
-	faker := gofakeit.New(0)
-
 	// Create a fresh customer for this test
 	customerID, associatedPersonIDs, err := s.CreatePendingCustomer()
 	s.Require().NoError(err, "CreatePendingCustomer should succeed")
 	s.Require().NotEmpty(associatedPersonIDs, "Should have associated persons")
 
-	newEmail := faker.Email()
+	newEmail := s.Fixtures.Faker().Email()
 	hasControl := true
 	updateReq := &customer.UpdateAssociatedPersonRequest{
 		Email:      &newEmail,
@@ -119,8 +114,6 @@ func (s *AssociatedPersonTestSuite) TestAssociatedPerson_Delete() {
 
 // TestAssociatedPerson_FileSizeLimit tests that files larger than 3MB are rejected.
 func (s *AssociatedPersonTestSuite) TestAssociatedPerson_FileSizeLimit() {
-	faker := gofakeit.New(0)
-
 	// Generate data larger than 3MB (3 * 1024 * 1024 = 3145728 bytes)
 	// We need slightly more to ensure we exceed the limit after base64 encoding
 	oversizedData := make([]byte, 3*1024*1024+1)
@@ -130,7 +123,7 @@ func (s *AssociatedPersonTestSuite) TestAssociatedPerson_FileSizeLimit() {
 	oversizedDataURI := customer.EncodeBase64ToDataURI(oversizedData, customer.ImageFormatJpeg)
 
 	s.Run("OversizedPOA", func() {
-		person := FakeAssociatedPerson(faker)
+		person := s.Fixtures.AssociatedPerson()
 		person.POA = oversizedDataURI
 
 		req := &customer.CreateAssociatedPersonRequest{
@@ -143,7 +136,7 @@ func (s *AssociatedPersonTestSuite) TestAssociatedPerson_FileSizeLimit() {
 	})
 
 	s.Run("OversizedImageFront", func() {
-		person := FakeAssociatedPerson(faker)
+		person := s.Fixtures.AssociatedPerson()
 		person.IdentifyingInformation[0].ImageFront = oversizedDataURI
 
 		req := &customer.CreateAssociatedPersonRequest{
@@ -156,7 +149,7 @@ func (s *AssociatedPersonTestSuite) TestAssociatedPerson_FileSizeLimit() {
 	})
 
 	s.Run("OversizedImageBack", func() {
-		person := FakeAssociatedPerson(faker)
+		person := s.Fixtures.AssociatedPerson()
 		person.IdentifyingInformation[0].ImageBack = oversizedDataURI
 
 		req := &customer.CreateAssociatedPersonRequest{
@@ -0,0 +1,73 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package e2e
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/audit_logs"
+)
+
+// AuditLogsTestSuite tests audit logs service operations.
+type AuditLogsTestSuite struct {
+	E2ETestSuite
+}
+
+// TestAuditLogs_List tests listing recent API activity with filters.
+func (s *AuditLogsTestSuite) TestAuditLogs_List() {
+	// Make a request so there is recent activity to find.
+	_, err := s.Client.Echo.Get(s.Ctx)
+	s.Require().NoError(err, "Echo should succeed")
+
+	start := time.Now().Add(-time.Hour)
+	resp, err := s.Client.AuditLogs.List(s.Ctx, &audit_logs.ListRequest{
+		Method:    http.MethodGet,
+		StartTime: start,
+		Size:      20,
+	})
+	s.Require().NoError(err, "List should succeed")
+	s.Require().NotNil(resp)
+
+	for _, entry := range resp.List {
+		s.NotEmpty(entry.KeyID, "KeyID should not be empty")
+		s.Equal(http.MethodGet, entry.Method)
+		s.True(entry.Result.IsValid(), "Result should be a known value")
+	}
+	s.T().Logf("Audit logs: total=%d, returned=%d", resp.Total, len(resp.List))
+
+	s.Run("All", func() {
+		count := 0
+		for entry, err := range s.Client.AuditLogs.All(s.Ctx, &audit_logs.ListRequest{PathPrefix: "/v1", StartTime: start}) {
+			s.Require().NoError(err, "All should succeed")
+			s.True(strings.HasPrefix(entry.Path, "/v1"), "Path should match the prefix")
+			if count++; count >= 250 {
+				break
+			}
+		}
+		s.T().Logf("Iterated %d audit log entries", count)
+	})
+}
+
+// TestAuditLogsTestSuite runs the audit logs test suite.
+func TestAuditLogsTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditLogsTestSuite))
+}
@@ -19,12 +19,14 @@ package e2e
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/1Money-Co/1money-go-sdk/internal/transport"
 	"github.com/1Money-Co/1money-go-sdk/pkg/service/auto_conversion_rules"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/transactions"
 )
 
 // AutoConversionRulesTestSuite tests auto conversion rules service operations.
@@ -79,7 +81,7 @@ func (s *AutoConversionRulesTestSuite) TestAutoConversionRules_List() {
 
 // TestAutoConversionRules_CreateAndGet tests creating and retrieving an auto conversion rule.
 func (s *AutoConversionRulesTestSuite) TestAutoConversionRules_CreateAndGet() {
-	createReq := FakeAutoConversionRuleRequest()
+	createReq := s.Fixtures.AutoConversionRuleRequest()
 
 	// Create auto conversion rule
 	createResp, err := s.Client.AutoConversionRules.CreateRule(s.Ctx, s.CustomerID, createReq)
@@ -132,7 +134,7 @@ func (s *AutoConversionRulesTestSuite) TestAutoConversionRules_CreateCryptoToFia
 
 	network := "POLYGON"
 	createReq := &auto_conversion_rules.CreateRuleRequest{
-		IdempotencyKey: uuid.New().String(),
+		IdempotencyKey: s.IdempotencyKey("create-rule"),
 		Source: auto_conversion_rules.SourceAssetInfo{
 			Asset:   "USDC",
 			Network: "POLYGON",
@@ -169,10 +171,50 @@ func (s *AutoConversionRulesTestSuite) TestAutoConversionRules_CreateCryptoToFia
 	s.T().Logf("Crypto deposit wallet address: %s", getResp.SourceDepositInfo.Crypto.WalletAddress)
 }
 
+// TestAutoConversionRules_Update tests updating a rule's nickname without recreating it.
+func (s *AutoConversionRulesTestSuite) TestAutoConversionRules_Update() {
+	ruleID, err := s.EnsureAutoConversionRule()
+	s.Require().NoError(err, "EnsureAutoConversionRule should succeed")
+
+	before, err := s.Client.AutoConversionRules.GetRule(s.Ctx, s.CustomerID, ruleID)
+	s.Require().NoError(err, "GetRule should succeed")
+
+	nickname := "e2e-" + uuid.New().String()[:8]
+	updateResp, err := s.Client.AutoConversionRules.UpdateRule(s.Ctx, s.CustomerID, ruleID,
+		&auto_conversion_rules.UpdateRuleRequest{Nickname: &nickname})
+	s.Require().NoError(err, "UpdateRule should succeed")
+	s.Require().NotNil(updateResp, "Update response should not be nil")
+
+	s.Equal(ruleID, updateResp.AutoConversionRuleID, "Rule ID should not change")
+	s.Equal(nickname, updateResp.Nickname, "Nickname should be updated")
+	s.Equal(before.Source, updateResp.Source, "Source should not change")
+
+	s.T().Logf("Updated auto conversion rule:\n%s", PrettyJSON(updateResp))
+}
+
+// TestAutoConversionRules_PauseResume tests pausing and resuming a rule keeps its deposit info.
+func (s *AutoConversionRulesTestSuite) TestAutoConversionRules_PauseResume() {
+	createResp, err := s.Client.AutoConversionRules.CreateRule(s.Ctx, s.CustomerID, s.Fixtures.AutoConversionRuleRequest())
+	s.Require().NoError(err, "CreateRule should succeed")
+
+	ruleID := createResp.AutoConversionRuleID
+	_, err = auto_conversion_rules.WaitForActive(s.Ctx, s.Client.AutoConversionRules, s.CustomerID, ruleID, nil)
+	s.Require().NoError(err, "Rule should become active")
+
+	pauseResp, err := s.Client.AutoConversionRules.PauseRule(s.Ctx, s.CustomerID, ruleID)
+	s.Require().NoError(err, "PauseRule should succeed")
+	s.Equal(auto_conversion_rules.RuleStatusPAUSED, pauseResp.Status, "Rule should be paused")
+
+	resumeResp, err := s.Client.AutoConversionRules.ResumeRule(s.Ctx, s.CustomerID, ruleID)
+	s.Require().NoError(err, "ResumeRule should succeed")
+	s.Equal(auto_conversion_rules.RuleStatusACTIVE, resumeResp.Status, "Rule should be active again")
+	s.Equal(ruleID, resumeResp.AutoConversionRuleID, "Rule ID should not change")
+}
+
 // TestAutoConversionRules_Delete tests deleting an auto conversion rule.
 func (s *AutoConversionRulesTestSuite) TestAutoConversionRules_Delete() {
 	// First create a rule to delete
-	createReq := FakeAutoConversionRuleRequest()
+	createReq := s.Fixtures.AutoConversionRuleRequest()
 
 	createResp, err := s.Client.AutoConversionRules.CreateRule(s.Ctx, s.CustomerID, createReq)
 	s.Require().NoError(err, "CreateRule should succeed")
@@ -192,6 +234,23 @@ func (s *AutoConversionRulesTestSuite) TestAutoConversionRules_Delete() {
 	s.True(transport.IsNotFoundError(err), "GetRule should return 404 Not Found after deletion")
 }
 
+// TestAutoConversionRules_GetRuleStats tests retrieving rule statistics for the current month.
+func (s *AutoConversionRulesTestSuite) TestAutoConversionRules_GetRuleStats() {
+	ruleID, err := s.EnsureAutoConversionRule()
+	s.Require().NoError(err, "EnsureAutoConversionRule should succeed")
+
+	now := time.Now().UTC()
+	period := transactions.MonthPeriod(now.Year(), now.Month(), time.UTC)
+
+	resp, err := s.Client.AutoConversionRules.GetRuleStats(s.Ctx, s.CustomerID, ruleID, period)
+	s.Require().NoError(err, "GetRuleStats should succeed")
+	s.Require().NotNil(resp, "Response should not be nil")
+	s.Equal(ruleID, resp.AutoConversionRuleID, "Rule ID should match")
+	s.GreaterOrEqual(resp.OrderCount, int64(0), "OrderCount should be non-negative")
+
+	s.T().Logf("Rule stats:\n%s", PrettyJSON(resp))
+}
+
 // TestAutoConversionRules_ListOrders tests listing orders for an auto conversion rule.
 func (s *AutoConversionRulesTestSuite) TestAutoConversionRules_ListOrders() {
 	// Ensure we have a rule
@@ -232,6 +291,20 @@ func (s *AutoConversionRulesTestSuite) TestAutoConversionRules_ListOrders() {
 			s.Equal("COMPLETED", strings.ToUpper(resp.Items[i].Status), "Status should match filter (Completed/COMPLETED)")
 		}
 	})
+
+	s.Run("AcrossAllRules", func() {
+		req := &auto_conversion_rules.ListAllOrdersRequest{
+			CreatedAfter: time.Now().Add(-30 * 24 * time.Hour).UTC().Format(time.RFC3339),
+			Page:         1,
+			Size:         20,
+		}
+
+		resp, err := s.Client.AutoConversionRules.ListAllOrders(s.Ctx, s.CustomerID, req)
+		s.Require().NoError(err, "ListAllOrders should succeed")
+		s.Require().NotNil(resp, "Response should not be nil")
+		s.LessOrEqual(len(resp.Items), 20, "Should return at most 20 items")
+		s.T().Logf("Orders across all rules: %d orders, total: %d", len(resp.Items), resp.Total)
+	})
 }
 
 // TestAutoConversionRulesTestSuite runs the auto conversion rules test suite.
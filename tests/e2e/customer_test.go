@@ -18,18 +18,16 @@ package e2e
 
 import (
 	"errors"
-	"fmt"
 	"net/url"
 	"strings"
 	"testing"
 
-	"github.com/brianvoe/gofakeit/v7"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/1Money-Co/1money-go-sdk/internal/transport"
 	"github.com/1Money-Co/1money-go-sdk/internal/utils"
+	"github.com/1Money-Co/1money-go-sdk/pkg/fixtures"
 	"github.com/1Money-Co/1money-go-sdk/pkg/service/customer"
-	"github.com/1Money-Co/1money-go-sdk/pkg/service/external_accounts"
 )
 
 // CustomerTestSuite tests customer service operations.
@@ -64,50 +62,15 @@ func (s *CustomerTestSuite) TestCustomerService_CreateCustomer() {
 	signedAgreementID, err := s.EnsureSignedAgreement()
 	s.Require().NoError(err, "EnsureSignedAgreement should succeed")
 
-	faker := gofakeit.New(0)
-
-	req := &customer.CreateCustomerRequest{
-		BusinessLegalName:          faker.Company(),
-		BusinessDescription:        faker.JobDescriptor() + " " + faker.BS(),
-		BusinessRegistrationNumber: fmt.Sprintf("%s-%d", faker.LetterN(3), faker.Number(100000, 999999)),
-		Email:                      faker.Email(),
-		BusinessType:               customer.BusinessTypeCorporation,
-		BusinessIndustry:           "332999",
-		RegisteredAddress: &customer.Address{
-			StreetLine1: faker.Street(),
-			StreetLine2: fmt.Sprintf("Suite %d", faker.Number(100, 999)),
-			City:        faker.City(),
-			State:       faker.StateAbr(),
-			Country:     external_accounts.CountryCodeDEU.String(),
-			PostalCode:  faker.Zip(),
-			Subdivision: faker.StateAbr(),
-		},
-		DateOfIncorporation: faker.Date().Format("2006-01-02"),
-		SignedAgreementID:   signedAgreementID,
-		AssociatedPersons: []customer.AssociatedPerson{
-			FakeAssociatedPerson(faker),
-			FakeAssociatedPerson(faker),
-			FakeAssociatedPerson(faker),
-			FakeAssociatedPerson(faker),
-		},
-		SourceOfFunds:                  []customer.SourceOfFunds{customer.SourceOfFundsSalesOfGoodsAndServices},
-		SourceOfWealth:                 []customer.SourceOfWealth{customer.SourceOfWealthBusinessDividendsOrProfits},
-		Documents:                      FakeCustomerDocuments(),
-		AccountPurpose:                 customer.AccountPurposeTreasuryManagement,
-		IsDAO:                          false,
-		PubliclyTraded:                 false,
-		EstimatedAnnualRevenueUSD:      customer.MoneyRange099999,
-		ExpectedMonthlyFiatDeposits:    customer.MoneyRange099999,
-		ExpectedMonthlyFiatWithdrawals: customer.MoneyRange099999,
-		TaxID:                          fmt.Sprintf("%d-%d", faker.Number(10, 99), faker.Number(1000000, 9999999)),
-		TaxType:                        customer.TaxIDTypeEIN,
-		TaxCountry:                     external_accounts.CountryCodeDEU.String(),
-	}
+	req := s.Fixtures.CreateCustomerRequest(signedAgreementID, s.Namespaced(), func(r *customer.CreateCustomerRequest) {
+		r.AssociatedPersons = append(r.AssociatedPersons, s.Fixtures.AssociatedPerson(), s.Fixtures.AssociatedPerson())
+	})
 
 	resp, err := s.Client.Customer.CreateCustomer(s.Ctx, req)
 
 	s.Require().NoError(err, "CreateCustomer should not return error")
 	s.Require().NotNil(resp, "Response should not be nil")
+	s.TrackCustomer(resp.CustomerID)
 	s.NotEmpty(resp.CustomerID, "Customer ID should not be empty")
 	s.Equal(req.BusinessLegalName, resp.BusinessLegalName, "Business name should match")
 	s.Equal(req.Email, resp.Email, "Customer email should match")
@@ -123,8 +86,6 @@ func (s *CustomerTestSuite) TestCustomerService_CreateCustomer() {
 
 // TestCustomerService_CreateCustomer_InvalidFileFormat tests that invalid file formats are rejected.
 func (s *CustomerTestSuite) TestCustomerService_CreateCustomer_InvalidFileFormat() {
-	faker := gofakeit.New(0)
-
 	// Get a valid signed agreement ID
 	signedAgreementID, err := s.EnsureSignedAgreement()
 	s.Require().NoError(err, "EnsureSignedAgreement should succeed")
@@ -132,43 +93,16 @@ func (s *CustomerTestSuite) TestCustomerService_CreateCustomer_InvalidFileFormat
 	// Test 1: Invalid MIME type (using unsupported format like .exe)
 	invalidMIME := "data:application/x-msdownload;base64,TVqQAAMAAAAEAAAA"
 
-	req := &customer.CreateCustomerRequest{
-		BusinessLegalName:          faker.Company(),
-		BusinessDescription:        faker.JobDescriptor(),
-		BusinessRegistrationNumber: fmt.Sprintf("%s-%d", faker.LetterN(3), faker.Number(100000, 999999)),
-		Email:                      faker.Email(),
-		BusinessType:               customer.BusinessTypeCorporation,
-		BusinessIndustry:           "332999",
-		RegisteredAddress: &customer.Address{
-			StreetLine1: faker.Street(),
-			City:        faker.City(),
-			State:       faker.StateAbr(),
-			Country:     external_accounts.CountryCodeDEU.String(),
-			PostalCode:  faker.Zip(),
-			Subdivision: faker.StateAbr(),
-		},
-		DateOfIncorporation: faker.Date().Format("2006-01-02"),
-		SignedAgreementID:   signedAgreementID,
-		AssociatedPersons: []customer.AssociatedPerson{
-			FakeAssociatedPerson(faker),
-		},
-		SourceOfFunds:  []customer.SourceOfFunds{customer.SourceOfFundsSalesOfGoodsAndServices},
-		SourceOfWealth: []customer.SourceOfWealth{customer.SourceOfWealthBusinessDividendsOrProfits},
-		Documents: []customer.Document{
+	req := s.Fixtures.CreateCustomerRequest(signedAgreementID, func(r *customer.CreateCustomerRequest) {
+		r.AssociatedPersons = r.AssociatedPersons[:1]
+		r.Documents = []customer.Document{
 			{
 				DocType:     customer.DocumentTypeFlowOfFunds,
 				File:        invalidMIME, // Invalid MIME type
 				Description: "Invalid file format test",
 			},
-		},
-		AccountPurpose:                 customer.AccountPurposeTreasuryManagement,
-		EstimatedAnnualRevenueUSD:      customer.MoneyRange099999,
-		ExpectedMonthlyFiatDeposits:    customer.MoneyRange099999,
-		ExpectedMonthlyFiatWithdrawals: customer.MoneyRange099999,
-		TaxID:                          fmt.Sprintf("%d-%d", faker.Number(10, 99), faker.Number(1000000, 9999999)),
-		TaxType:                        customer.TaxIDTypeEIN,
-		TaxCountry:                     external_accounts.CountryCodeDEU.String(),
-	}
+		}
+	})
 
 	_, err = s.Client.Customer.CreateCustomer(s.Ctx, req)
 	s.Require().Error(err, "CreateCustomer should return error for invalid MIME type")
@@ -177,8 +111,6 @@ func (s *CustomerTestSuite) TestCustomerService_CreateCustomer_InvalidFileFormat
 
 // TestCustomerService_CreateCustomer_InvalidBase64 tests that invalid base64 data is rejected.
 func (s *CustomerTestSuite) TestCustomerService_CreateCustomer_InvalidBase64() {
-	faker := gofakeit.New(0)
-
 	// Get a valid signed agreement ID
 	signedAgreementID, err := s.EnsureSignedAgreement()
 	s.Require().NoError(err, "EnsureSignedAgreement should succeed")
@@ -186,43 +118,16 @@ func (s *CustomerTestSuite) TestCustomerService_CreateCustomer_InvalidBase64() {
 	// Invalid base64 data (not properly encoded)
 	invalidBase64 := "data:image/jpeg;base64,this-is-not-valid-base64!!!"
 
-	req := &customer.CreateCustomerRequest{
-		BusinessLegalName:          faker.Company(),
-		BusinessDescription:        faker.JobDescriptor(),
-		BusinessRegistrationNumber: fmt.Sprintf("%s-%d", faker.LetterN(3), faker.Number(100000, 999999)),
-		Email:                      faker.Email(),
-		BusinessType:               customer.BusinessTypeCorporation,
-		BusinessIndustry:           "332999",
-		RegisteredAddress: &customer.Address{
-			StreetLine1: faker.Street(),
-			City:        faker.City(),
-			State:       faker.StateAbr(),
-			Country:     external_accounts.CountryCodeDEU.String(),
-			PostalCode:  faker.Zip(),
-			Subdivision: faker.StateAbr(),
-		},
-		DateOfIncorporation: faker.Date().Format("2006-01-02"),
-		SignedAgreementID:   signedAgreementID,
-		AssociatedPersons: []customer.AssociatedPerson{
-			FakeAssociatedPerson(faker),
-		},
-		SourceOfFunds:  []customer.SourceOfFunds{customer.SourceOfFundsSalesOfGoodsAndServices},
-		SourceOfWealth: []customer.SourceOfWealth{customer.SourceOfWealthBusinessDividendsOrProfits},
-		Documents: []customer.Document{
+	req := s.Fixtures.CreateCustomerRequest(signedAgreementID, func(r *customer.CreateCustomerRequest) {
+		r.AssociatedPersons = r.AssociatedPersons[:1]
+		r.Documents = []customer.Document{
 			{
 				DocType:     customer.DocumentTypeFlowOfFunds,
 				File:        invalidBase64, // Invalid base64
 				Description: "Invalid base64 test",
 			},
-		},
-		AccountPurpose:                 customer.AccountPurposeTreasuryManagement,
-		EstimatedAnnualRevenueUSD:      customer.MoneyRange099999,
-		ExpectedMonthlyFiatDeposits:    customer.MoneyRange099999,
-		ExpectedMonthlyFiatWithdrawals: customer.MoneyRange099999,
-		TaxID:                          fmt.Sprintf("%d-%d", faker.Number(10, 99), faker.Number(1000000, 9999999)),
-		TaxType:                        customer.TaxIDTypeEIN,
-		TaxCountry:                     external_accounts.CountryCodeDEU.String(),
-	}
+		}
+	})
 
 	_, err = s.Client.Customer.CreateCustomer(s.Ctx, req)
 	s.Require().Error(err, "CreateCustomer should return error for invalid base64")
@@ -232,8 +137,6 @@ func (s *CustomerTestSuite) TestCustomerService_CreateCustomer_InvalidBase64() {
 // TestCustomerService_CreateCustomer_CorruptedXLSX tests that corrupted XLSX files are rejected.
 func (s *CustomerTestSuite) TestCustomerService_CreateCustomer_CorruptedXLSX() {
 	s.T().Skip("API does not validate XLSX file content integrity - corrupted files are accepted")
-	faker := gofakeit.New(0)
-
 	// Get a valid signed agreement ID
 	signedAgreementID, err := s.EnsureSignedAgreement()
 	s.Require().NoError(err, "EnsureSignedAgreement should succeed")
@@ -243,7 +146,7 @@ func (s *CustomerTestSuite) TestCustomerService_CreateCustomer_CorruptedXLSX() {
 	corruptedXLSX := customer.EncodeDocumentToDataURI(corruptedData, customer.FileFormatXlsx)
 
 	// Get all required documents, then replace one with the corrupted XLSX
-	docs := FakeCustomerDocuments()
+	docs := fixtures.CustomerDocuments(customer.BusinessTypeCorporation, fixtures.Country)
 	for i := range docs {
 		if docs[i].DocType == customer.DocumentTypeShareholderRegister {
 			docs[i].File = corruptedXLSX
@@ -252,37 +155,10 @@ func (s *CustomerTestSuite) TestCustomerService_CreateCustomer_CorruptedXLSX() {
 		}
 	}
 
-	req := &customer.CreateCustomerRequest{
-		BusinessLegalName:          faker.Company(),
-		BusinessDescription:        faker.JobDescriptor(),
-		BusinessRegistrationNumber: fmt.Sprintf("%s-%d", faker.LetterN(3), faker.Number(100000, 999999)),
-		Email:                      faker.Email(),
-		BusinessType:               customer.BusinessTypeCorporation,
-		BusinessIndustry:           "332999",
-		RegisteredAddress: &customer.Address{
-			StreetLine1: faker.Street(),
-			City:        faker.City(),
-			State:       faker.StateAbr(),
-			Country:     external_accounts.CountryCodeDEU.String(),
-			PostalCode:  faker.Zip(),
-			Subdivision: faker.StateAbr(),
-		},
-		DateOfIncorporation: faker.Date().Format("2006-01-02"),
-		SignedAgreementID:   signedAgreementID,
-		AssociatedPersons: []customer.AssociatedPerson{
-			FakeAssociatedPerson(faker),
-		},
-		SourceOfFunds:                  []customer.SourceOfFunds{customer.SourceOfFundsSalesOfGoodsAndServices},
-		SourceOfWealth:                 []customer.SourceOfWealth{customer.SourceOfWealthBusinessDividendsOrProfits},
-		Documents:                      docs,
-		AccountPurpose:                 customer.AccountPurposeTreasuryManagement,
-		EstimatedAnnualRevenueUSD:      customer.MoneyRange099999,
-		ExpectedMonthlyFiatDeposits:    customer.MoneyRange099999,
-		ExpectedMonthlyFiatWithdrawals: customer.MoneyRange099999,
-		TaxID:                          fmt.Sprintf("%d-%d", faker.Number(10, 99), faker.Number(1000000, 9999999)),
-		TaxType:                        customer.TaxIDTypeEIN,
-		TaxCountry:                     external_accounts.CountryCodeDEU.String(),
-	}
+	req := s.Fixtures.CreateCustomerRequest(signedAgreementID, func(r *customer.CreateCustomerRequest) {
+		r.AssociatedPersons = r.AssociatedPersons[:1]
+		r.Documents = docs
+	})
 
 	_, err = s.Client.Customer.CreateCustomer(s.Ctx, req)
 	s.Require().Error(err, "CreateCustomer should return error for corrupted XLSX")
@@ -0,0 +1,60 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package e2e
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// EventsTestSuite tests events service operations.
+type EventsTestSuite struct {
+	CustomerDependentTestSuite
+}
+
+// TestEvents_ResumeFromCursor tests that resuming from a cursor continues the feed without repeats.
+func (s *EventsTestSuite) TestEvents_ResumeFromCursor() {
+	_, err := s.EnsureTransaction()
+	s.Require().NoError(err, "EnsureTransaction should succeed")
+
+	first, err := s.Client.Events.List(s.Ctx, s.CustomerID, "")
+	s.Require().NoError(err, "List should succeed")
+	s.Require().NotEmpty(first.Events, "Customer with a transaction should have events")
+
+	seen := make(map[string]bool)
+	for _, event := range first.Events {
+		s.True(event.Type.IsValid(), "Event type should be a known value")
+		s.NotEmpty(event.Cursor, "Event should have a cursor")
+		seen[event.EventID] = true
+	}
+	s.Equal(first.Events[len(first.Events)-1].Cursor, first.NextCursor, "NextCursor should be the last event's cursor")
+
+	count := 0
+	for event, err := range s.Client.Events.All(s.Ctx, s.CustomerID, first.NextCursor) {
+		s.Require().NoError(err, "All should succeed")
+		s.False(seen[event.EventID], "Event %s should not be repeated after its cursor", event.EventID)
+		count++
+	}
+
+	s.T().Logf("First page: %d events, %d more after cursor", len(first.Events), count)
+}
+
+// TestEventsTestSuite runs the events test suite.
+func TestEventsTestSuite(t *testing.T) {
+	suite.Run(t, new(EventsTestSuite))
+}
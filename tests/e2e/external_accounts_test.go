@@ -73,6 +73,7 @@ func (s *ExternalAccountsTestSuite) TestExternalAccounts_List() {
 			external_accounts.BankNetworkNameUSACH,
 			external_accounts.BankNetworkNameSWIFT,
 			external_accounts.BankNetworkNameUSFEDWIRE,
+			external_accounts.BankNetworkNameSEPA,
 		}
 
 		for _, network := range networks {
@@ -88,6 +89,26 @@ func (s *ExternalAccountsTestSuite) TestExternalAccounts_List() {
 			}
 		}
 	})
+
+	s.Run("FilterByStatusPaginated", func() {
+		req := &external_accounts.ListReq{Status: external_accounts.BankAccountStatusAPPROVED, Page: 1, Size: 2}
+		resp, err := s.Client.ExternalAccounts.ListExternalAccounts(s.Ctx, s.CustomerID, req)
+		s.Require().NoError(err, "ListExternalAccounts with status filter should succeed")
+		s.LessOrEqual(len(resp), 2, "Page should not exceed requested size")
+		for i := range resp {
+			s.Equal(string(external_accounts.BankAccountStatusAPPROVED), resp[i].Status, "Status should match filter")
+		}
+	})
+
+	s.Run("All", func() {
+		count := 0
+		for acc, err := range s.Client.ExternalAccounts.All(s.Ctx, s.CustomerID, nil) {
+			s.Require().NoError(err, "All should not fail")
+			s.NotEmpty(acc.ExternalAccountID, "External account ID should not be empty")
+			count++
+		}
+		s.T().Logf("Iterated over %d external accounts", count)
+	})
 }
 
 // pollExternalAccountStatus polls until the external account reaches the expected status.
@@ -128,7 +149,7 @@ func (s *ExternalAccountsTestSuite) TestExternalAccounts_CreateAndGet() {
 		maxWaitTime  = 10 * time.Second
 	)
 
-	createReq := FakeExternalAccountRequest()
+	createReq := s.Fixtures.ExternalAccountRequest()
 
 	// Create external account
 	createResp, err := s.Client.ExternalAccounts.CreateExternalAccount(s.Ctx, s.CustomerID, createReq)
@@ -201,7 +222,7 @@ func (s *ExternalAccountsTestSuite) TestExternalAccounts_CreateAndGet() {
 // Validates account is no longer retrievable after deletion.
 func (s *ExternalAccountsTestSuite) TestExternalAccounts_Delete() {
 	// First create an account to delete
-	createReq := FakeExternalAccountRequest()
+	createReq := s.Fixtures.ExternalAccountRequest()
 
 	createResp, err := s.Client.ExternalAccounts.CreateExternalAccount(s.Ctx, s.CustomerID, createReq)
 	s.Require().NoError(err, "CreateExternalAccount should succeed")
@@ -227,6 +248,71 @@ func (s *ExternalAccountsTestSuite) TestExternalAccounts_Delete() {
 	}
 }
 
+// TestExternalAccounts_Update tests updating the nickname of an external account.
+func (s *ExternalAccountsTestSuite) TestExternalAccounts_Update() {
+	accountID, err := s.EnsureExternalAccount()
+	if err != nil && strings.Contains(err.Error(), "verified fiat account") {
+		s.T().Skip("Skipping: customer doesn't have a verified fiat account yet")
+	}
+	s.Require().NoError(err, "EnsureExternalAccount should succeed")
+
+	before, err := s.Client.ExternalAccounts.GetExternalAccount(s.Ctx, s.CustomerID, accountID)
+	s.Require().NoError(err, "GetExternalAccount should succeed")
+
+	nickname := "Payroll account " + time.Now().Format("150405")
+	updateResp, err := s.Client.ExternalAccounts.UpdateExternalAccount(s.Ctx, s.CustomerID, accountID,
+		&external_accounts.UpdateReq{Nickname: &nickname})
+	s.Require().NoError(err, "UpdateExternalAccount should succeed")
+	s.Require().NotNil(updateResp, "Update response should not be nil")
+
+	s.Equal(accountID, updateResp.ExternalAccountID, "External account ID should not change")
+	s.Require().NotNil(updateResp.Nickname, "Nickname should be set")
+	s.Equal(nickname, *updateResp.Nickname, "Nickname should be updated")
+	s.Equal(before.AccountNumber, updateResp.AccountNumber, "Account number should not change")
+	s.Equal(before.InstitutionID, updateResp.InstitutionID, "Institution ID should not change")
+
+	s.T().Logf("Updated external account:\n%s", PrettyJSON(updateResp))
+}
+
+// TestExternalAccounts_MicroDeposits tests starting micro-deposit verification on a new account.
+func (s *ExternalAccountsTestSuite) TestExternalAccounts_MicroDeposits() {
+	createResp, err := s.Client.ExternalAccounts.CreateExternalAccount(s.Ctx, s.CustomerID, s.Fixtures.ExternalAccountRequest())
+	if err != nil && strings.Contains(err.Error(), "verified fiat account") {
+		s.T().Skip("Skipping: customer doesn't have a verified fiat account yet")
+	}
+	s.Require().NoError(err, "CreateExternalAccount should succeed")
+
+	startResp, err := s.Client.ExternalAccounts.StartMicroDepositVerification(
+		s.Ctx, s.CustomerID, createResp.ExternalAccountID)
+	s.Require().NoError(err, "StartMicroDepositVerification should succeed")
+	s.Require().NotNil(startResp, "Response should not be nil")
+	s.Equal(createResp.ExternalAccountID, startResp.ExternalAccountID, "External account ID should match")
+	s.Equal(string(external_accounts.BankAccountStatusMICRODEPOSITSPENDING), startResp.Status,
+		"Status should be MICRO_DEPOSITS_PENDING")
+
+	_, err = s.Client.ExternalAccounts.ConfirmMicroDeposits(s.Ctx, s.CustomerID, createResp.ExternalAccountID, nil)
+	s.Require().Error(err, "ConfirmMicroDeposits without amounts should fail")
+
+	s.T().Logf("Micro-deposit verification started:\n%s", PrettyJSON(startResp))
+}
+
+// TestExternalAccounts_IdempotentCreate tests that re-submitting a creation with the same
+// idempotency key returns the already-registered account.
+func (s *ExternalAccountsTestSuite) TestExternalAccounts_IdempotentCreate() {
+	createReq := s.Fixtures.ExternalAccountRequest()
+
+	first, err := s.Client.ExternalAccounts.CreateExternalAccount(s.Ctx, s.CustomerID, createReq)
+	if err != nil && strings.Contains(err.Error(), "verified fiat account") {
+		s.T().Skip("Skipping: customer doesn't have a verified fiat account yet")
+	}
+	s.Require().NoError(err, "CreateExternalAccount should succeed")
+
+	second, err := s.Client.ExternalAccounts.CreateExternalAccount(s.Ctx, s.CustomerID, createReq)
+	s.Require().NoError(err, "Retried CreateExternalAccount should return the existing account")
+	s.Equal(first.ExternalAccountID, second.ExternalAccountID, "Retried creation should return the same account")
+	s.Equal(createReq.IdempotencyKey, second.IdempotencyKey, "Idempotency key should match")
+}
+
 // TestExternalAccountsTestSuite runs the external accounts test suite.
 func TestExternalAccountsTestSuite(t *testing.T) {
 	suite.Run(t, new(ExternalAccountsTestSuite))
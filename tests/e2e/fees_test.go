@@ -0,0 +1,49 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package e2e
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// FeesTestSuite tests fees service operations.
+type FeesTestSuite struct {
+	CustomerDependentTestSuite
+}
+
+// TestFees_GetFeeSchedule tests retrieving the customer's fee schedule.
+func (s *FeesTestSuite) TestFees_GetFeeSchedule() {
+	resp, err := s.Client.Fees.GetFeeSchedule(s.Ctx, s.CustomerID)
+	s.Require().NoError(err, "GetFeeSchedule should succeed")
+	s.Require().NotNil(resp)
+
+	s.Equal(s.CustomerID, resp.CustomerID)
+	for _, entry := range resp.Fees {
+		s.True(entry.Category.IsValid(), "Fee category should be a known value")
+		s.NotEmpty(entry.Asset, "Asset should not be empty")
+		s.NotEmpty(entry.Tiers, "Fee entry should have at least one tier")
+	}
+
+	s.T().Logf("Fee schedule:\n%s", PrettyJSON(resp))
+}
+
+// TestFeesTestSuite runs the fees test suite.
+func TestFeesTestSuite(t *testing.T) {
+	suite.Run(t, new(FeesTestSuite))
+}
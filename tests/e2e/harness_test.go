@@ -0,0 +1,194 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package e2e
+
+import (
+	"cmp"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"hash/fnv"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/1Money-Co/1money-go-sdk/internal/transport"
+	"github.com/1Money-Co/1money-go-sdk/pkg/fixtures"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/customer"
+)
+
+// runIDEnv overrides the run namespace, e.g. with a CI job ID. Re-running with the same ID
+// sends the same fixtures and idempotency keys, so a failed run resumes instead of duplicating
+// what it already created.
+const runIDEnv = "ONEMONEY_E2E_RUN_ID"
+
+// runID namespaces everything this test run creates, so concurrent runs never share customers.
+var runID = cmp.Or(os.Getenv(runIDEnv), randomHex())
+
+// attemptID distinguishes the attempts of one run. Customer creation takes no idempotency key,
+// so a resumed run creates fresh customers, which need emails of their own.
+var attemptID = randomHex()
+
+func randomHex() string {
+	b := make([]byte, 4)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// Resources tracks what a suite created and removes it in reverse order at teardown.
+// Resources that a test already removed itself (404 or 409 on removal) are not reported.
+type Resources struct {
+	mu       sync.Mutex
+	cleanups []cleanup
+}
+
+type cleanup struct {
+	name string
+	fn   func(ctx context.Context) error
+}
+
+// Track registers fn to remove the named resource at teardown.
+func (r *Resources) Track(name string, fn func(ctx context.Context) error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cleanups = append(r.cleanups, cleanup{name: name, fn: fn})
+}
+
+// Cleanup runs the registered cleanups, newest first, and returns the failures.
+func (r *Resources) Cleanup(ctx context.Context) []error {
+	r.mu.Lock()
+	cleanups := r.cleanups
+	r.cleanups = nil
+	r.mu.Unlock()
+
+	var errs []error
+	for i := len(cleanups) - 1; i >= 0; i-- {
+		c := cleanups[i]
+		err := c.fn(ctx)
+		if err == nil || transport.IsNotFoundError(err) {
+			continue
+		}
+		if apiErr, ok := transport.IsAPIError(err); ok && apiErr.IsConflictError() {
+			continue
+		}
+		errs = append(errs, fmt.Errorf("cleanup %s: %w", c.name, err))
+	}
+	return errs
+}
+
+// fixtureSeed derives the suite's fixture seed from its namespace, so a re-run with the same
+// run ID builds the same requests.
+func fixtureSeed(namespace string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(namespace))
+	return h.Sum64()
+}
+
+// IdempotencyKey returns the idempotency key for a step of the current test, derived from the
+// run ID, the test name and the step. Use it instead of a random key for requests that create
+// resources.
+func (s *E2ETestSuite) IdempotencyKey(step string) string {
+	return s.keys.Key(s.T(), step)
+}
+
+// suiteSeq distinguishes the customers of one suite within a run.
+var suiteSeq atomic.Int64
+
+// Namespace returns the tag marking resources of this run and suite, e.g. "e2e-1a2b3c4d-customertestsuite".
+// Customers carry no metadata, so the tag is embedded in their email and legal name instead.
+func (s *E2ETestSuite) Namespace() string {
+	suiteName := strings.TrimPrefix(strings.ToLower(s.T().Name()), "test")
+	if i := strings.IndexByte(suiteName, '/'); i >= 0 {
+		suiteName = suiteName[:i]
+	}
+	return "e2e-" + runID + "-" + suiteName
+}
+
+// Namespaced tags a customer request with the suite's namespace.
+func (s *E2ETestSuite) Namespaced() fixtures.Option[customer.CreateCustomerRequest] {
+	namespace := s.Namespace()
+	n := suiteSeq.Add(1)
+	return func(r *customer.CreateCustomerRequest) {
+		r.Email = fmt.Sprintf("%s-%s-%d@example.com", namespace, attemptID, n)
+		r.BusinessLegalName = fmt.Sprintf("%s [%s]", r.BusinessLegalName, namespace)
+	}
+}
+
+// TrackCustomer soft-deletes the customer at teardown. Customers cannot be deleted for
+// compliance reasons, so their sandbox data is reset and their KYB status set to rejected,
+// which keeps them out of any lookup for approved customers.
+func (s *E2ETestSuite) TrackCustomer(customerID string) {
+	s.Resources.Track("customer "+customerID, func(ctx context.Context) error {
+		if err := s.Client.Simulations.ResetCustomerData(ctx, customerID); err != nil {
+			return err
+		}
+		_, err := s.Client.Simulations.SetKybStatus(ctx, customerID, customer.KybStatusRejected, []string{"e2e teardown"})
+		return err
+	})
+}
+
+// NewTestCustomer creates a namespaced customer that is soft-deleted at teardown.
+// With approve set, it forces KYB approval instead of waiting for the sandbox to approve it.
+// Returns the customer ID and the IDs of its associated persons.
+func (s *E2ETestSuite) NewTestCustomer(approve bool) (
+	customerID string,
+	associatedPersonIDs []string,
+	err error,
+) {
+	// Step 1: Create TOS link
+	tosResp, err := s.Client.Customer.CreateTOSLink(s.Ctx, &customer.CreateTOSLinkRequest{
+		RedirectUrl: "https://example.com/redirect",
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("CreateTOSLink failed: %w", err)
+	}
+
+	// Step 2: Sign the agreement
+	signResp, err := s.Client.Customer.SignTOSAgreement(s.Ctx, tosResp.SessionToken)
+	if err != nil {
+		return "", nil, fmt.Errorf("SignTOSAgreement failed: %w", err)
+	}
+
+	// Step 3: Create customer with associated persons
+	req := s.Fixtures.CreateCustomerRequest(signResp.SignedAgreementID, s.Namespaced())
+	resp, err := s.Client.Customer.CreateCustomer(s.Ctx, req)
+	if err != nil {
+		return "", nil, fmt.Errorf("CreateCustomer failed: %w", err)
+	}
+	s.TrackCustomer(resp.CustomerID)
+	s.T().Logf("Customer created: %s (%s)", resp.CustomerID, req.Email)
+
+	// Step 4: Approve the customer
+	if approve && resp.Status != customer.KybStatusApproved {
+		if _, err := s.Client.Simulations.SetKybStatus(s.Ctx, resp.CustomerID, customer.KybStatusApproved, nil); err != nil {
+			return "", nil, fmt.Errorf("SetKybStatus failed: %w", err)
+		}
+	}
+
+	// Get associated person IDs from the created customer
+	associatedPersonsResp, err := s.Client.Customer.ListAssociatedPersons(s.Ctx, resp.CustomerID)
+	if err != nil {
+		return "", nil, fmt.Errorf("ListAssociatedPersons failed: %w", err)
+	}
+	for i := range *associatedPersonsResp {
+		associatedPersonIDs = append(associatedPersonIDs, (*associatedPersonsResp)[i].AssociatedPersonID)
+	}
+
+	return resp.CustomerID, associatedPersonIDs, nil
+}
@@ -142,6 +142,25 @@ func (s *InstructionsTestSuite) TestInstructions_GetDepositInstruction() {
 	}
 }
 
+// TestInstructions_ListDepositInstructions tests listing instructions for all provisioned assets.
+func (s *InstructionsTestSuite) TestInstructions_ListDepositInstructions() {
+	resp, err := s.Client.Instructions.ListDepositInstructions(s.Ctx, s.CustomerID)
+	if s.skipIfVerifiedFiatAccountRequired(err) {
+		return
+	}
+	s.Require().NoError(err, "ListDepositInstructions should succeed")
+	s.Require().NotEmpty(resp, "Customer should have at least one deposit instruction")
+
+	for i := range resp {
+		s.NotEmpty(resp[i].Asset, "Asset should not be empty")
+		s.NotEmpty(resp[i].Network, "Network should not be empty")
+		s.True(resp[i].BankInstruction != nil || resp[i].WalletInstruction != nil,
+			"Instruction %s/%s should have bank or wallet details", resp[i].Asset, resp[i].Network)
+	}
+
+	s.T().Logf("Deposit instructions: %d", len(resp))
+}
+
 // TestInstructionsTestSuite runs the instructions test suite.
 func TestInstructionsTestSuite(t *testing.T) {
 	suite.Run(t, new(InstructionsTestSuite))
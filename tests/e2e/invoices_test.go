@@ -0,0 +1,83 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package e2e
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/invoices"
+)
+
+// InvoicesTestSuite tests invoices service operations.
+type InvoicesTestSuite struct {
+	CustomerDependentTestSuite
+}
+
+// TestInvoices_Flow tests the invoice flow: Create → Get → List → Cancel
+func (s *InvoicesTestSuite) TestInvoices_Flow() {
+	testCases := []struct {
+		name    string
+		asset   assets.AssetName
+		network assets.NetworkName
+	}{
+		{"Fiat_USD_ACH", assets.AssetNameUSD, assets.NetworkNameUSACH},
+		{"Crypto_USDC_Ethereum", assets.AssetNameUSDC, assets.NetworkNameETHEREUM},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			created, err := s.Client.Invoices.CreateInvoice(s.Ctx, s.CustomerID, &invoices.CreateInvoiceRequest{
+				IdempotencyKey: s.IdempotencyKey("create-invoice"),
+				Amount:         "25.00",
+				Asset:          tc.asset,
+				Network:        tc.network,
+				Memo:           "e2e invoice",
+				ExpiresAt:      time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
+			})
+			s.Require().NoError(err, "CreateInvoice should succeed")
+			s.NotEmpty(created.InvoiceID)
+			s.Equal(invoices.InvoiceStatusOPEN, created.Status)
+			s.Require().NotNil(created.DepositInstruction, "Invoice should have deposit instructions")
+			s.T().Logf("Invoice:\n%s", PrettyJSON(created))
+
+			got, err := s.Client.Invoices.GetInvoice(s.Ctx, s.CustomerID, created.InvoiceID)
+			s.Require().NoError(err, "GetInvoice should succeed")
+			s.Equal(created.InvoiceID, got.InvoiceID)
+			s.Equal(created.ReferenceCode, got.ReferenceCode)
+
+			list, err := s.Client.Invoices.ListInvoices(s.Ctx, s.CustomerID,
+				&invoices.ListInvoicesRequest{Status: invoices.InvoiceStatusOPEN, Asset: tc.asset})
+			s.Require().NoError(err, "ListInvoices should succeed")
+			for i := range list.List {
+				s.Equal(invoices.InvoiceStatusOPEN, list.List[i].Status)
+			}
+
+			cancelled, err := s.Client.Invoices.CancelInvoice(s.Ctx, s.CustomerID, created.InvoiceID)
+			s.Require().NoError(err, "CancelInvoice should succeed")
+			s.Equal(invoices.InvoiceStatusCANCELLED, cancelled.Status)
+		})
+	}
+}
+
+// TestInvoicesTestSuite runs the invoices test suite.
+func TestInvoicesTestSuite(t *testing.T) {
+	suite.Run(t, new(InvoicesTestSuite))
+}
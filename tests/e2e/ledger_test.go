@@ -0,0 +1,83 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package e2e
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/ledger"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/simulations"
+)
+
+// LedgerTestSuite tests ledger service operations.
+type LedgerTestSuite struct {
+	CustomerDependentTestSuite
+}
+
+// TestLedger_ListEntries tests that a deposit produces balanced ledger entries.
+func (s *LedgerTestSuite) TestLedger_ListEntries() {
+	deposit, err := s.Client.Simulations.SimulateDeposit(s.Ctx, s.CustomerID, &simulations.SimulateDepositRequest{
+		Asset:   assets.AssetNameUSD,
+		Amount:  "12.34",
+		Network: simulations.WalletNetworkNameUSACH,
+	})
+	s.Require().NoError(err, "SimulateDeposit should succeed")
+
+	resp, err := s.Client.Ledger.ListEntries(s.Ctx, s.CustomerID, &ledger.ListEntriesRequest{
+		TransactionID: deposit.SimulationID,
+	})
+	s.Require().NoError(err, "ListEntries should succeed")
+	s.Require().NotEmpty(resp.List, "Deposit should produce ledger entries")
+
+	journals := make(map[string]*big.Rat)
+	for i := range resp.List {
+		entry := &resp.List[i]
+		s.Equal(deposit.SimulationID, entry.TransactionID)
+		s.True(entry.Direction.IsValid(), "Direction should be a known value")
+		s.NotEmpty(entry.BalanceAfter, "BalanceAfter should not be empty")
+
+		amount, err := entry.SignedAmount()
+		s.Require().NoError(err)
+		if journals[entry.JournalID] == nil {
+			journals[entry.JournalID] = new(big.Rat)
+		}
+		journals[entry.JournalID].Add(journals[entry.JournalID], amount)
+	}
+	for journalID, sum := range journals {
+		s.Zero(sum.Sign(), "Journal %s should balance", journalID)
+	}
+	s.T().Logf("Ledger entries:\n%s", PrettyJSON(resp.List))
+
+	s.Run("All", func() {
+		count := 0
+		for entry, err := range s.Client.Ledger.All(s.Ctx, s.CustomerID, &ledger.ListEntriesRequest{Asset: assets.AssetNameUSD}) {
+			s.Require().NoError(err, "All should succeed")
+			s.Equal(string(assets.AssetNameUSD), entry.Asset)
+			count++
+		}
+		s.GreaterOrEqual(count, len(resp.List))
+	})
+}
+
+// TestLedgerTestSuite runs the ledger test suite.
+func TestLedgerTestSuite(t *testing.T) {
+	suite.Run(t, new(LedgerTestSuite))
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package e2e
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/limits"
+)
+
+// LimitsTestSuite tests limits service operations.
+type LimitsTestSuite struct {
+	CustomerDependentTestSuite
+}
+
+// TestLimits_Get tests retrieving the customer's configured limits.
+func (s *LimitsTestSuite) TestLimits_Get() {
+	resp, err := s.Client.Limits.Get(s.Ctx, s.CustomerID)
+	s.Require().NoError(err, "Get should succeed")
+	s.Require().NotNil(resp)
+
+	s.Equal(s.CustomerID, resp.CustomerID)
+	for _, limit := range resp.Limits {
+		s.True(limit.Type.IsValid(), "Limit type should be a known value")
+		s.True(limit.Period.IsValid(), "Limit period should be a known value")
+		s.NotEmpty(limit.MaxAmount, "Max amount should not be empty")
+	}
+
+	s.T().Logf("Limits:\n%s", PrettyJSON(resp))
+}
+
+// TestLimits_ListUtilization tests retrieving today's limit utilization.
+func (s *LimitsTestSuite) TestLimits_ListUtilization() {
+	usage, err := s.Client.Limits.ListUtilization(s.Ctx, s.CustomerID, limits.LimitPeriodDAILY)
+	s.Require().NoError(err, "ListUtilization should succeed")
+
+	for i := range usage {
+		s.Equal(limits.LimitPeriodDAILY, usage[i].Period, "Utilization should be filtered by period")
+		s.NotEmpty(usage[i].RemainingAmount, "Remaining amount should not be empty")
+		fraction := usage[i].UsedFraction()
+		s.GreaterOrEqual(fraction, 0.0)
+		s.LessOrEqual(fraction, 1.0)
+	}
+
+	s.T().Logf("Daily utilization:\n%s", PrettyJSON(usage))
+}
+
+// TestLimitsTestSuite runs the limits test suite.
+func TestLimitsTestSuite(t *testing.T) {
+	suite.Run(t, new(LimitsTestSuite))
+}
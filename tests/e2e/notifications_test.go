@@ -0,0 +1,65 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package e2e
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/notifications"
+)
+
+// NotificationsTestSuite tests notifications service operations.
+type NotificationsTestSuite struct {
+	CustomerDependentTestSuite
+}
+
+// TestNotifications_UpdatePreferences tests the preference flow: Get → Update → Get
+func (s *NotificationsTestSuite) TestNotifications_UpdatePreferences() {
+	before, err := s.Client.Notifications.GetPreferences(s.Ctx, s.CustomerID)
+	s.Require().NoError(err, "GetPreferences should succeed")
+	s.Require().NotNil(before)
+	s.T().Logf("Preferences before:\n%s", PrettyJSON(before))
+
+	pref := notifications.CategoryPreference{
+		Category:        notifications.EventCategoryDEPOSITS,
+		Email:           true,
+		EmailRecipients: []string{"finance@example.com"},
+	}
+	updated, err := s.Client.Notifications.UpdatePreferences(s.Ctx, s.CustomerID,
+		&notifications.UpdatePreferencesRequest{Preferences: []notifications.CategoryPreference{pref}})
+	s.Require().NoError(err, "UpdatePreferences should succeed")
+
+	got := updated.Preference(notifications.EventCategoryDEPOSITS)
+	s.Require().NotNil(got, "Deposits preference should be present")
+	s.True(got.Email)
+	s.Equal(pref.EmailRecipients, got.EmailRecipients)
+
+	after, err := s.Client.Notifications.GetPreferences(s.Ctx, s.CustomerID)
+	s.Require().NoError(err, "GetPreferences should succeed")
+	s.Equal(updated.Preferences, after.Preferences, "Updated preferences should be persisted")
+
+	if prev := before.Preference(notifications.EventCategoryKYB); prev != nil {
+		s.Equal(*prev, *after.Preference(notifications.EventCategoryKYB), "Other categories should be unchanged")
+	}
+}
+
+// TestNotificationsTestSuite runs the notifications test suite.
+func TestNotificationsTestSuite(t *testing.T) {
+	suite.Run(t, new(NotificationsTestSuite))
+}
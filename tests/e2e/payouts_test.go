@@ -0,0 +1,96 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package e2e
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/payouts"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/simulations"
+)
+
+// PayoutsTestSuite tests payouts service operations.
+type PayoutsTestSuite struct {
+	CustomerDependentTestSuite
+	externalAccountID string
+}
+
+// SetupSuite prepares a USD balance and an external account for payouts.
+func (s *PayoutsTestSuite) SetupSuite() {
+	s.CustomerDependentTestSuite.SetupSuite()
+
+	_, err := s.Client.Simulations.SimulateDeposit(s.Ctx, s.CustomerID, &simulations.SimulateDepositRequest{
+		Asset:   assets.AssetNameUSD,
+		Amount:  "100.00",
+		Network: simulations.WalletNetworkNameUSACH,
+	})
+	s.Require().NoError(err, "SimulateDeposit USD should succeed")
+
+	externalAccountID, err := s.EnsureExternalAccount()
+	s.Require().NoError(err, "EnsureExternalAccount should succeed")
+	s.externalAccountID = externalAccountID
+}
+
+// TestPayouts_Flow tests the payout flow: CheckFunding → CreateBatch → GetBatch → ListItems
+func (s *PayoutsTestSuite) TestPayouts_Flow() {
+	req := &payouts.CreateBatchRequest{
+		IdempotencyKey: s.IdempotencyKey("create-batch"),
+		Description:    "e2e payout",
+		Items: []payouts.Item{
+			{
+				Reference: "item-1", Amount: "1.00", Asset: assets.AssetNameUSD,
+				Network: assets.NetworkNameUSACH, ExternalAccountID: s.externalAccountID,
+			},
+			{
+				Reference: "item-2", Amount: "2.00", Asset: assets.AssetNameUSD,
+				Network: assets.NetworkNameUSACH, ExternalAccountID: s.externalAccountID,
+			},
+		},
+	}
+
+	funding, err := s.Client.Payouts.CheckFunding(s.Ctx, s.CustomerID, req)
+	s.Require().NoError(err, "CheckFunding should succeed")
+	s.True(funding.Sufficient, "Balance should cover the batch")
+	s.T().Logf("Funding check:\n%s", PrettyJSON(funding))
+
+	batch, err := s.Client.Payouts.CreateBatch(s.Ctx, s.CustomerID, req)
+	s.Require().NoError(err, "CreateBatch should succeed")
+	s.NotEmpty(batch.BatchID)
+	s.Equal(len(req.Items), batch.TotalItems)
+
+	got, err := s.Client.Payouts.GetBatch(s.Ctx, s.CustomerID, batch.BatchID)
+	s.Require().NoError(err, "GetBatch should succeed")
+	s.Equal(batch.BatchID, got.BatchID)
+	s.True(got.Status.IsValid(), "Batch status should be a known value")
+
+	items, err := s.Client.Payouts.ListItems(s.Ctx, s.CustomerID, batch.BatchID, nil)
+	s.Require().NoError(err, "ListItems should succeed")
+	s.Len(items.List, len(req.Items))
+	for _, item := range items.List {
+		s.True(item.Status.IsValid(), "Item status should be a known value")
+	}
+
+	s.T().Logf("Payout items:\n%s", PrettyJSON(items))
+}
+
+// TestPayoutsTestSuite runs the payouts test suite.
+func TestPayoutsTestSuite(t *testing.T) {
+	suite.Run(t, new(PayoutsTestSuite))
+}
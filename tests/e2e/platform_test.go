@@ -0,0 +1,99 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package e2e
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/platform"
+)
+
+// PlatformTestSuite tests platform service operations.
+type PlatformTestSuite struct {
+	CustomerDependentTestSuite
+}
+
+// TestPlatform_GetAggregateBalances tests retrieving balances summed across customers.
+func (s *PlatformTestSuite) TestPlatform_GetAggregateBalances() {
+	resp, err := s.Client.Platform.GetAggregateBalances(s.Ctx, nil)
+	s.Require().NoError(err, "GetAggregateBalances should succeed")
+	s.NotEmpty(resp.AsOf, "AsOf should not be empty")
+
+	for _, balance := range resp.Balances {
+		s.NotEmpty(balance.Asset, "Asset should not be empty")
+		s.NotEmpty(balance.AvailableAmount, "Available amount should not be empty")
+		s.GreaterOrEqual(balance.CustomerCount, 0)
+	}
+
+	s.T().Logf("Aggregate balances:\n%s", PrettyJSON(resp))
+}
+
+// TestPlatform_SearchTransactions tests searching transactions restricted to the test customer.
+func (s *PlatformTestSuite) TestPlatform_SearchTransactions() {
+	_, err := s.EnsureTransaction()
+	s.Require().NoError(err, "EnsureTransaction should succeed")
+
+	req := &platform.SearchTransactionsRequest{
+		CustomerIDs: []svc.CustomerID{s.CustomerID},
+		StartTime:   time.Now().Add(-30 * 24 * time.Hour),
+	}
+	count := 0
+	for tx, err := range s.Client.Platform.AllTransactions(s.Ctx, req) {
+		s.Require().NoError(err, "AllTransactions should succeed")
+		s.Equal(s.CustomerID, tx.CustomerID, "Search should be restricted to the listed customers")
+		count++
+	}
+	s.Positive(count, "Test customer should have transactions")
+}
+
+// TestPlatform_KYB tests that the KYB summary is consistent with the KYB status list.
+func (s *PlatformTestSuite) TestPlatform_KYB() {
+	summary, err := s.Client.Platform.GetKYBSummary(s.Ctx)
+	s.Require().NoError(err, "GetKYBSummary should succeed")
+	s.Positive(summary.Total, "Platform should have at least the test customer")
+
+	sum := 0
+	for _, entry := range summary.ByStatus {
+		s.True(entry.Status.IsValid(), "KYB status should be a known value")
+		sum += entry.Count
+	}
+	s.Equal(summary.Total, sum, "Status counts should add up to the total")
+
+	if len(summary.ByStatus) > 0 {
+		entry := summary.ByStatus[0]
+		listed, err := s.Client.Platform.ListKYBStatuses(s.Ctx, &platform.ListKYBStatusesRequest{
+			Status: entry.Status,
+			Size:   10,
+		})
+		s.Require().NoError(err, "ListKYBStatuses should succeed")
+		s.Equal(entry.Count, listed.Total, "List total should match the summary count")
+		for _, c := range listed.List {
+			s.Equal(entry.Status, c.Status)
+		}
+	}
+
+	s.T().Logf("KYB summary:\n%s", PrettyJSON(summary))
+}
+
+// TestPlatformTestSuite runs the platform test suite.
+func TestPlatformTestSuite(t *testing.T) {
+	suite.Run(t, new(PlatformTestSuite))
+}
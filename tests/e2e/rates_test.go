@@ -0,0 +1,78 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package e2e
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/rates"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/transactions"
+)
+
+// RatesTestSuite tests rates service operations.
+type RatesTestSuite struct {
+	E2ETestSuite
+}
+
+// TestRates_GetHistoricalRate tests retrieving the rate in effect a day ago.
+func (s *RatesTestSuite) TestRates_GetHistoricalRate() {
+	pair := rates.Pair{Base: assets.AssetNameUSDT, Quote: assets.AssetNameUSD}
+	timestamp := time.Now().Add(-24 * time.Hour)
+
+	rate, err := s.Client.Rates.GetHistoricalRate(s.Ctx, pair, timestamp)
+	s.Require().NoError(err, "GetHistoricalRate should succeed")
+	s.Equal(string(pair.Base), rate.Base)
+	s.Equal(string(pair.Quote), rate.Quote)
+	s.NotEmpty(rate.Rate, "Rate should not be empty")
+
+	effectiveAt, err := time.Parse(time.RFC3339, rate.EffectiveAt)
+	s.Require().NoError(err, "EffectiveAt should be RFC3339")
+	s.False(effectiveAt.After(timestamp), "Rate should have taken effect at or before the timestamp")
+
+	value, err := rate.Value("100", 2)
+	s.Require().NoError(err)
+	s.T().Logf("100 %s = %s %s at %s", rate.Base, value, rate.Quote, rate.EffectiveAt)
+}
+
+// TestRates_ListDailyRates tests listing daily rates for the last week.
+func (s *RatesTestSuite) TestRates_ListDailyRates() {
+	pair := rates.Pair{Base: assets.AssetNameUSDC, Quote: assets.AssetNameUSD}
+	end := time.Now().UTC().Truncate(24 * time.Hour)
+	period := transactions.Period{Start: end.AddDate(0, 0, -7), End: end}
+
+	daily, err := s.Client.Rates.ListDailyRates(s.Ctx, pair, period)
+	s.Require().NoError(err, "ListDailyRates should succeed")
+	s.LessOrEqual(len(daily), 7, "Should return at most one entry per day")
+
+	for _, day := range daily {
+		date, err := time.Parse(time.DateOnly, day.Date)
+		s.Require().NoError(err, "Date should be YYYY-MM-DD")
+		s.False(date.Before(period.Start) || !date.Before(period.End), "Date should be within the period")
+		s.NotEmpty(day.Close, "Close should not be empty")
+	}
+
+	s.T().Logf("Daily rates:\n%s", PrettyJSON(daily))
+}
+
+// TestRatesTestSuite runs the rates test suite.
+func TestRatesTestSuite(t *testing.T) {
+	suite.Run(t, new(RatesTestSuite))
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package e2e
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/screening"
+)
+
+// ScreeningTestSuite tests screening service operations.
+type ScreeningTestSuite struct {
+	CustomerDependentTestSuite
+}
+
+// TestScreening_ScreenWalletAddress tests screening a wallet address.
+func (s *ScreeningTestSuite) TestScreening_ScreenWalletAddress() {
+	result, err := s.Client.Screening.ScreenWalletAddress(s.Ctx, s.CustomerID, &screening.WalletAddressRequest{
+		Address: s.Fixtures.EthereumAddress(),
+		Network: assets.NetworkNameETHEREUM,
+	})
+	s.Require().NoError(err, "ScreenWalletAddress should succeed")
+	s.NotEmpty(result.ScreeningID)
+	s.True(result.Decision.IsValid(), "Decision should be a known value")
+	s.True(result.RiskLevel.IsValid(), "RiskLevel should be a known value")
+	s.GreaterOrEqual(result.RiskScore, 0)
+	s.LessOrEqual(result.RiskScore, 100)
+
+	s.T().Logf("Wallet screening:\n%s", PrettyJSON(result))
+}
+
+// TestScreening_ScreenBankCounterparty tests screening a registered external account's holder.
+func (s *ScreeningTestSuite) TestScreening_ScreenBankCounterparty() {
+	externalAccountID, err := s.EnsureExternalAccount()
+	s.Require().NoError(err, "EnsureExternalAccount should succeed")
+
+	result, err := s.Client.Screening.ScreenBankCounterparty(s.Ctx, s.CustomerID, &screening.BankCounterpartyRequest{
+		ExternalAccountID: externalAccountID,
+	})
+	s.Require().NoError(err, "ScreenBankCounterparty should succeed")
+	s.NotEmpty(result.ScreeningID)
+	s.True(result.Decision.IsValid(), "Decision should be a known value")
+
+	s.T().Logf("Bank counterparty screening:\n%s", PrettyJSON(result))
+}
+
+// TestScreeningTestSuite runs the screening test suite.
+func TestScreeningTestSuite(t *testing.T) {
+	suite.Run(t, new(ScreeningTestSuite))
+}
@@ -18,11 +18,16 @@ package e2e
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
 	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/customer"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/external_accounts"
 	"github.com/1Money-Co/1money-go-sdk/pkg/service/simulations"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/transactions"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/withdraws"
 )
 
 // SimulationsTestSuite tests simulations service operations.
@@ -90,7 +95,233 @@ func (s *SimulationsTestSuite) TestSimulations_SimulateDeposit() {
 	}
 }
 
+// TestSimulations_SimulateWithdrawalStatus tests driving withdrawals to each terminal outcome.
+func (s *SimulationsTestSuite) TestSimulations_SimulateWithdrawalStatus() {
+	_, err := s.Client.Simulations.SimulateDeposit(s.Ctx, s.CustomerID, &simulations.SimulateDepositRequest{
+		Asset:   assets.AssetNameUSDC,
+		Network: simulations.WalletNetworkNameETHEREUM,
+		Amount:  "30.00",
+	})
+	s.Require().NoError(err, "SimulateDeposit should succeed")
+
+	testCases := []struct {
+		name       string
+		target     simulations.WithdrawalSimulationStatus
+		reason     string
+		wantStatus transactions.TransactionStatus
+	}{
+		{name: "Settled", target: simulations.WithdrawalSimulationStatusSETTLED, wantStatus: transactions.TransactionStatusCOMPLETED},
+		{name: "Returned", target: simulations.WithdrawalSimulationStatusRETURNED, reason: "R01", wantStatus: transactions.TransactionStatusREVERSED},
+		{name: "Failed", target: simulations.WithdrawalSimulationStatusFAILED, reason: "beneficiary bank rejected", wantStatus: transactions.TransactionStatusFAILED},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			withdrawal, err := s.Client.Withdrawals.CreateWithdrawal(s.Ctx, s.CustomerID, &withdraws.CreateWithdrawalRequest{
+				IdempotencyKey: s.IdempotencyKey("create-withdrawal"),
+				Amount:         "1.00",
+				Asset:          assets.AssetNameUSDC,
+				Network:        assets.NetworkNameETHEREUM,
+				WalletAddress:  s.Fixtures.EthereumAddress(),
+			})
+			s.Require().NoError(err, "CreateWithdrawal should succeed")
+
+			resp, err := s.Client.Simulations.SimulateWithdrawalStatus(
+				s.Ctx, s.CustomerID, withdrawal.TransactionID, tc.target, tc.reason)
+			s.Require().NoError(err, "SimulateWithdrawalStatus should succeed")
+			s.Equal(withdrawal.TransactionID, resp.TransactionID, "Transaction ID should match")
+			s.Equal(tc.wantStatus, resp.Status, "Status should reflect the simulated outcome")
+
+			s.T().Logf("Simulated withdrawal %s:\n%s", tc.name, PrettyJSON(resp))
+		})
+	}
+
+	s.Run("ReturnedWithoutCode", func() {
+		_, err := s.Client.Simulations.SimulateWithdrawalStatus(
+			s.Ctx, s.CustomerID, "unused", simulations.WithdrawalSimulationStatusRETURNED, "")
+		s.Require().Error(err, "RETURNED without a return code should fail locally")
+	})
+}
+
+// TestSimulations_SetExternalAccountStatus tests forcing external account review outcomes,
+// including the failure branch of WaitForApproved.
+func (s *SimulationsTestSuite) TestSimulations_SetExternalAccountStatus() {
+	testCases := []struct {
+		name    string
+		status  external_accounts.BankAccountStatus
+		wantErr bool
+	}{
+		{name: "Approved", status: external_accounts.BankAccountStatusAPPROVED},
+		{name: "Failed", status: external_accounts.BankAccountStatusFAILED, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			account, err := s.Client.ExternalAccounts.CreateExternalAccount(s.Ctx, s.CustomerID, s.Fixtures.ExternalAccountRequest())
+			s.Require().NoError(err, "CreateExternalAccount should succeed")
+
+			resp, err := s.Client.Simulations.SetExternalAccountStatus(
+				s.Ctx, s.CustomerID, account.ExternalAccountID, tc.status)
+			s.Require().NoError(err, "SetExternalAccountStatus should succeed")
+			s.Equal(string(tc.status), resp.Status, "Status should be %s", tc.status)
+
+			_, err = external_accounts.WaitForApproved(
+				s.Ctx, s.Client.ExternalAccounts, s.CustomerID, account.ExternalAccountID, nil)
+			if tc.wantErr {
+				s.Require().Error(err, "WaitForApproved should fail for a failed account")
+			} else {
+				s.Require().NoError(err, "WaitForApproved should succeed for an approved account")
+			}
+		})
+	}
+}
+
+// TestSimulations_Confirmations tests progressing a crypto deposit from pending to confirmed.
+func (s *SimulationsTestSuite) TestSimulations_Confirmations() {
+	const required = 3
+
+	resp, err := s.Client.Simulations.SimulateDeposit(s.Ctx, s.CustomerID, &simulations.SimulateDepositRequest{
+		Asset:                 assets.AssetNameUSDC,
+		Network:               simulations.WalletNetworkNameETHEREUM,
+		Amount:                "5.00",
+		RequiredConfirmations: required,
+	})
+	s.Require().NoError(err, "SimulateDeposit should succeed")
+	s.Equal(transactions.TransactionStatusPENDING, resp.Status, "Deposit should be pending until confirmed")
+
+	tx, err := s.Client.Simulations.SimulateConfirmations(s.Ctx, s.CustomerID, resp.SimulationID, 1)
+	s.Require().NoError(err, "SimulateConfirmations should succeed")
+	s.Equal(uint64(1), tx.Confirmations, "One confirmation should be observed")
+	s.Equal(transactions.TransactionStatusPENDING, tx.Status, "Deposit should still be pending")
+
+	_, err = s.Client.Simulations.SimulateConfirmations(s.Ctx, s.CustomerID, resp.SimulationID, required-1)
+	s.Require().NoError(err, "SimulateConfirmations should succeed")
+
+	tx, err = simulations.WaitForCompleted(s.Ctx, s.Client.Transactions, s.CustomerID, resp.SimulationID, nil)
+	s.Require().NoError(err, "Deposit should complete once confirmed")
+	s.GreaterOrEqual(tx.Confirmations, uint64(required), "Required confirmations should be reached")
+
+	s.T().Logf("Confirmed deposit:\n%s", PrettyJSON(tx))
+}
+
+// TestSimulations_Seed tests seeding balances, transactions and an approved external account.
+func (s *SimulationsTestSuite) TestSimulations_Seed() {
+	result, err := s.Client.Simulations.Seed(s.Ctx, s.CustomerID, &simulations.SeedScenario{
+		Balances: []simulations.SimulateDepositRequest{
+			{Asset: assets.AssetNameUSD, Network: simulations.WalletNetworkNameUSACH, Amount: "500.00"},
+			{Asset: assets.AssetNameUSDC, Network: simulations.WalletNetworkNameETHEREUM, Amount: "100.00"},
+		},
+		Transactions:    2,
+		ExternalAccount: s.Fixtures.ExternalAccountRequest(),
+	})
+	s.Require().NoError(err, "Seed should succeed")
+	s.Len(result.Deposits, 4, "Seed should create one deposit per balance and transaction")
+	s.Require().NotNil(result.ExternalAccount, "Seed should create an external account")
+	s.Equal(string(external_accounts.BankAccountStatusAPPROVED), result.ExternalAccount.Status,
+		"Seeded external account should be approved")
+
+	s.T().Logf("Seed result:\n%s", PrettyJSON(result))
+}
+
+// TestSimulations_Scenario tests running a multi-step scenario end to end.
+func (s *SimulationsTestSuite) TestSimulations_Scenario() {
+	scenario := &simulations.Scenario{
+		Name: "fund and fail a withdrawal",
+		Steps: []simulations.Step{
+			simulations.DepositStep(simulations.SimulateDepositRequest{
+				Asset: assets.AssetNameUSD, Network: simulations.WalletNetworkNameUSACH, Amount: "500.00",
+			}),
+			simulations.WaitStep(time.Second),
+			simulations.DepositStep(simulations.SimulateDepositRequest{
+				Asset: assets.AssetNameUSDC, Network: simulations.WalletNetworkNameETHEREUM, Amount: "100.00",
+			}),
+			simulations.WaitForSettledStep(),
+			simulations.WithdrawStep(withdraws.CreateWithdrawalRequest{
+				Amount:        "1.00",
+				Asset:         assets.AssetNameUSDC,
+				Network:       assets.NetworkNameETHEREUM,
+				WalletAddress: s.Fixtures.EthereumAddress(),
+			}),
+			simulations.WithdrawalStatusStep(simulations.WithdrawalSimulationStatusFAILED, "beneficiary unreachable"),
+		},
+	}
+
+	results, err := scenario.Run(s.Ctx, s.Client.ScenarioServices(), s.CustomerID)
+	s.Require().NoError(err, "Scenario should run successfully")
+	s.Require().Len(results, len(scenario.Steps), "Every step should produce a result")
+	s.Equal(transactions.TransactionStatusFAILED, results[len(results)-1].Status, "Withdrawal should be failed")
+
+	s.T().Logf("Scenario results:\n%s", PrettyJSON(results))
+}
+
+// TestSimulations_DepositOriginator tests that a named counterparty is attached to the deposit.
+func (s *SimulationsTestSuite) TestSimulations_DepositOriginator() {
+	originator := &transactions.Originator{
+		Name:          "Acme Logistics LLC",
+		BankName:      "First Republic Test Bank",
+		AccountNumber: "000123456789",
+		Address: &transactions.OriginatorAddress{
+			StreetLine1: "1 Market St",
+			City:        "San Francisco",
+			State:       "CA",
+			PostalCode:  "94105",
+			Country:     "USA",
+		},
+	}
+
+	resp, err := s.Client.Simulations.SimulateDeposit(s.Ctx, s.CustomerID, &simulations.SimulateDepositRequest{
+		Asset:      assets.AssetNameUSD,
+		Network:    simulations.WalletNetworkNameUSFEDWIRE,
+		Amount:     "250.00",
+		Originator: originator,
+	})
+	s.Require().NoError(err, "SimulateDeposit with originator should succeed")
+
+	tx, err := s.Client.Transactions.GetTransaction(s.Ctx, s.CustomerID, resp.SimulationID)
+	s.Require().NoError(err, "GetTransaction should succeed")
+	s.Require().NotNil(tx.Originator, "Deposit should carry the originator")
+	s.Equal(originator.Name, tx.Originator.Name, "Originator name should match")
+	s.Equal(originator.BankName, tx.Originator.BankName, "Originator bank should match")
+
+	s.T().Logf("Deposit with originator:\n%s", PrettyJSON(tx))
+}
+
 // TestSimulationsTestSuite runs the simulations test suite.
 func TestSimulationsTestSuite(t *testing.T) {
 	suite.Run(t, new(SimulationsTestSuite))
 }
+
+// SimulationsKybTestSuite tests KYB status simulation on a customer that is not yet approved.
+type SimulationsKybTestSuite struct {
+	PendingCustomerTestSuite
+}
+
+// TestSimulations_SetKybStatus tests driving a pending customer through KYB states.
+func (s *SimulationsKybTestSuite) TestSimulations_SetKybStatus() {
+	steps := []struct {
+		status  customer.KybStatus
+		reasons []string
+	}{
+		{status: customer.KybStatusUnderReview},
+		{status: customer.KybStatusRejected, reasons: []string{"Proof of address is older than 90 days"}},
+		{status: customer.KybStatusApproved},
+	}
+
+	for _, step := range steps {
+		resp, err := s.Client.Simulations.SetKybStatus(s.Ctx, s.CustomerID, step.status, step.reasons)
+		s.Require().NoError(err, "SetKybStatus(%s) should succeed", step.status)
+		s.Equal(step.status, resp.Status, "Customer status should be %s", step.status)
+
+		cust, err := s.Client.Customer.GetCustomer(s.Ctx, s.CustomerID)
+		s.Require().NoError(err, "GetCustomer should succeed")
+		s.Equal(step.status, cust.Status, "Persisted status should be %s", step.status)
+	}
+
+	_, err := s.Client.Simulations.SetKybStatus(s.Ctx, s.CustomerID, customer.KybStatusApproved, []string{"reason"})
+	s.Require().Error(err, "Rejection reasons without rejected status should fail locally")
+}
+
+// TestSimulationsKybTestSuite runs the KYB simulation test suite.
+func TestSimulationsKybTestSuite(t *testing.T) {
+	suite.Run(t, new(SimulationsKybTestSuite))
+}
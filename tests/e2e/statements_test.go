@@ -0,0 +1,77 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package e2e
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/statements"
+)
+
+// StatementsTestSuite tests statements service operations.
+type StatementsTestSuite struct {
+	CustomerDependentTestSuite
+}
+
+// TestStatements_Flow tests the statement flow: Generate → List → Download
+func (s *StatementsTestSuite) TestStatements_Flow() {
+	period := statements.Period(time.Now().AddDate(0, -1, 0))
+
+	genResp, err := s.Client.Statements.GenerateStatement(s.Ctx, s.CustomerID, &statements.GenerateStatementRequest{
+		IdempotencyKey: s.IdempotencyKey("generate-statement"),
+		Period:         period,
+	})
+	s.Require().NoError(err, "GenerateStatement should succeed")
+	s.Require().NotNil(genResp)
+	s.NotEmpty(genResp.StatementID)
+	s.Equal(period, genResp.Period)
+	s.True(genResp.Status.IsValid(), "Statement status should be a known value")
+
+	listResp, err := s.Client.Statements.ListStatements(s.Ctx, s.CustomerID)
+	s.Require().NoError(err, "ListStatements should succeed")
+
+	var listed *statements.StatementResponse
+	for i := range listResp {
+		if listResp[i].StatementID == genResp.StatementID {
+			listed = &listResp[i]
+			break
+		}
+	}
+	s.Require().NotNil(listed, "Generated statement should be listed")
+	s.T().Logf("Statement:\n%s", PrettyJSON(listed))
+
+	if listed.Status != statements.StatementStatusREADY {
+		s.T().Skipf("Statement %s is %s, skipping download", listed.StatementID, listed.Status)
+	}
+
+	for _, format := range []statements.StatementFormat{statements.StatementFormatPDF, statements.StatementFormatCSV} {
+		s.Run(format.String(), func() {
+			doc, err := s.Client.Statements.DownloadStatement(s.Ctx, s.CustomerID, period, format)
+			s.Require().NoError(err, "DownloadStatement should succeed")
+			s.NotEmpty(doc.Content, "Statement content should not be empty")
+			s.T().Logf("Downloaded %s statement: %d bytes (%s)", format, len(doc.Content), doc.ContentType)
+		})
+	}
+}
+
+// TestStatementsTestSuite runs the statements test suite.
+func TestStatementsTestSuite(t *testing.T) {
+	suite.Run(t, new(StatementsTestSuite))
+}
@@ -0,0 +1,56 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package e2e
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/status"
+)
+
+// StatusTestSuite tests status service operations.
+type StatusTestSuite struct {
+	E2ETestSuite
+}
+
+// TestStatus_Get tests retrieving platform and rail availability.
+func (s *StatusTestSuite) TestStatus_Get() {
+	report, err := s.Client.Status.Get(s.Ctx)
+	s.Require().NoError(err, "Get should succeed")
+	s.True(report.State.IsValid(), "Platform state should be a known value")
+	s.True(report.Source.IsValid(), "Source should be set")
+
+	for _, rail := range report.Rails {
+		s.True(assets.NetworkName(rail.Network).IsValid(), "Rail %s should be a known network", rail.Network)
+		s.True(rail.State.IsValid(), "Rail state should be a known value")
+	}
+
+	if err := report.CheckRail(assets.NetworkNameUSACH); err != nil {
+		s.ErrorIs(err, status.ErrRailUnavailable)
+		s.T().Logf("US_ACH is unavailable: %v", err)
+	}
+
+	s.T().Logf("Status (from %s):\n%s", report.Source, PrettyJSON(report))
+}
+
+// TestStatusTestSuite runs the status test suite.
+func TestStatusTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusTestSuite))
+}
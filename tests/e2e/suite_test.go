@@ -25,50 +25,50 @@ import (
 	"image"
 	"image/color"
 	"image/png"
+	"io/fs"
+	"os"
 	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/brianvoe/gofakeit/v7"
-	"github.com/google/uuid"
 	"github.com/joho/godotenv"
 	"github.com/stretchr/testify/suite"
 	"github.com/xuri/excelize/v2"
 
 	"github.com/1Money-Co/1money-go-sdk/internal/transport"
 	"github.com/1Money-Co/1money-go-sdk/internal/utils"
+	"github.com/1Money-Co/1money-go-sdk/pkg/fixtures"
 	"github.com/1Money-Co/1money-go-sdk/pkg/onemoney"
+	"github.com/1Money-Co/1money-go-sdk/pkg/onemoneytest"
+	svc "github.com/1Money-Co/1money-go-sdk/pkg/service"
 	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
-	"github.com/1Money-Co/1money-go-sdk/pkg/service/auto_conversion_rules"
 	"github.com/1Money-Co/1money-go-sdk/pkg/service/customer"
 	"github.com/1Money-Co/1money-go-sdk/pkg/service/external_accounts"
 	"github.com/1Money-Co/1money-go-sdk/pkg/service/simulations"
-	"github.com/1Money-Co/1money-go-sdk/pkg/testdata"
 )
 
 const (
-	// CountryDEU is the country code for Germany.
-	CountryDEU = "DEU"
+	// vcrEnv selects how the suites reach the API: unset runs against the sandbox,
+	// "record" also records cassettes, and "replay" serves the cassettes offline.
+	vcrEnv = "ONEMONEY_VCR"
+	// cassetteDir holds one cassette per suite, named after the suite's test function.
+	cassetteDir = "testdata/cassettes"
 )
 
-// ValidGermanStates contains valid German federal state codes (Bundesländer).
-var ValidGermanStates = []string{
-	"BW", "BY", "BE", "BB", "HB", "HH", "HE", "MV",
-	"NI", "NW", "RP", "SL", "SN", "ST", "SH", "TH",
-}
-
-// RandomGermanState returns a random valid German state code.
-func RandomGermanState(faker *gofakeit.Faker) string {
-	return ValidGermanStates[faker.Number(0, len(ValidGermanStates)-1)]
-}
-
 // E2ETestSuite defines the integration test suite for the OneMoney client.
 // This suite is used for end-to-end testing during development.
 type E2ETestSuite struct {
 	suite.Suite
-	Client *onemoney.Client
-	Ctx    context.Context
+	Client   *onemoney.Client
+	Ctx      context.Context
+	Fixtures *fixtures.Builder
+	// Resources tracks what the suite created, for removal at teardown.
+	Resources *Resources
+
+	keys     *onemoneytest.IdempotencyKeys
+	recorder *onemoneytest.Recorder
 }
 
 // SetupSuite runs once before all tests in the suite.
@@ -82,6 +82,9 @@ func (s *E2ETestSuite) SetupSuite() {
 
 	// Create client configuration
 	cfg := &onemoney.Config{}
+	if mode := os.Getenv(vcrEnv); mode != "" {
+		s.setupRecorder(cfg, mode)
+	}
 
 	// Create client
 	client, err := onemoney.NewClient(cfg)
@@ -91,6 +94,35 @@ func (s *E2ETestSuite) SetupSuite() {
 
 	s.Client = client
 	s.Ctx = context.Background()
+	s.Fixtures = fixtures.New(fixtureSeed(s.Namespace()))
+	s.Resources = &Resources{}
+	s.keys = onemoneytest.NewIdempotencyKeys(runID)
+}
+
+// setupRecorder routes the client through a recorder for the suite's cassette.
+// Suites without a cassette are skipped in replay mode.
+func (s *E2ETestSuite) setupRecorder(cfg *onemoney.Config, mode string) {
+	vcrMode, err := onemoneytest.ParseMode(mode)
+	if err != nil {
+		s.T().Fatalf("%s: %v", vcrEnv, err)
+	}
+
+	cassette := filepath.Join(cassetteDir, s.T().Name()+".json")
+	recorder, err := onemoneytest.NewRecorder(cassette, vcrMode, nil)
+	if errors.Is(err, fs.ErrNotExist) {
+		s.T().Skipf("no cassette %s; record it with %s=record", cassette, vcrEnv)
+	}
+	if err != nil {
+		s.T().Fatalf("failed to create recorder: %v", err)
+	}
+
+	if vcrMode == onemoneytest.ModeReplay {
+		// Requests never reach the API, so credentials are not needed.
+		cfg.AccessKey = "replay"
+		cfg.SecretKey = "replay"
+	}
+	cfg.HTTPClient = recorder.Client()
+	s.recorder = recorder
 }
 
 // SetupTest runs before each test.
@@ -99,171 +131,46 @@ func (*E2ETestSuite) SetupTest() {}
 // TearDownTest runs after each test.
 func (*E2ETestSuite) TearDownTest() {}
 
-// TearDownSuite runs once after all tests.
-func (*E2ETestSuite) TearDownSuite() {}
+// TearDownSuite runs once after all tests. It removes the tracked resources
+// and saves the cassette when recording.
+func (s *E2ETestSuite) TearDownSuite() {
+	if s.Resources != nil {
+		for _, err := range s.Resources.Cleanup(context.Background()) {
+			s.T().Errorf("%v", err)
+		}
+	}
+
+	if s.recorder == nil {
+		return
+	}
+	if err := s.recorder.Save(); err != nil {
+		s.T().Errorf("failed to save cassette: %v", err)
+	}
+}
 
 // CustomerDependentTestSuite is a base test suite for tests that require a customer.
-// Each test suite that embeds this will create its own customer during SetupSuite.
+// Each test suite that embeds this creates its own namespaced, KYB-approved customer during
+// SetupSuite, so suites never share state and can run concurrently.
 type CustomerDependentTestSuite struct {
 	E2ETestSuite
-	CustomerID          string
+	CustomerID          svc.CustomerID
 	AssociatedPersonIDs []string
 }
 
-// SetupSuite creates or reuses a customer for the test suite.
-// If an existing customer is found, it will be reused to speed up tests.
+// SetupSuite creates an approved customer for the test suite.
+// The customer is soft-deleted when the suite tears down.
 func (s *CustomerDependentTestSuite) SetupSuite() {
 	s.E2ETestSuite.SetupSuite()
 
-	// Try to reuse existing customer
-	customerID, associatedPersonIDs, err := s.GetOrCreateTestCustomer()
+	customerID, associatedPersonIDs, err := s.NewTestCustomer(true)
 	if err != nil {
-		s.T().Fatalf("failed to get or create test customer: %v", err)
+		s.T().Fatalf("failed to create test customer: %v", err)
 	}
 
 	s.CustomerID = customerID
 	s.AssociatedPersonIDs = associatedPersonIDs
 }
 
-// GetOrCreateTestCustomer returns an approved customer if available, otherwise creates a new one
-// and waits for KYB approval.
-func (s *CustomerDependentTestSuite) GetOrCreateTestCustomer() (
-	customerID string,
-	associatedPersonIDs []string,
-	err error,
-) {
-	// Try to find an existing approved customer (prefer the most recently created one)
-	listResp, err := s.Client.Customer.ListCustomers(s.Ctx, &customer.ListCustomersRequest{
-		PageSize:  2,
-		KybStatus: string(customer.KybStatusApproved),
-	})
-	if err == nil && listResp != nil && len(listResp.Customers) > 0 {
-		// Find the customer with the latest CreatedAt timestamp
-		latestIdx := 0
-		for i := 1; i < len(listResp.Customers); i++ {
-			if listResp.Customers[i].CreatedAt > listResp.Customers[latestIdx].CreatedAt {
-				latestIdx = i
-			}
-		}
-		existingCustomer := listResp.Customers[latestIdx]
-		s.T().Logf("Reusing existing approved customer: %s (%s, created: %s)",
-			existingCustomer.CustomerID, existingCustomer.BusinessLegalName, existingCustomer.CreatedAt)
-
-		// Get associated persons for the existing customer
-		associatedPersonsResp, err := s.Client.Customer.ListAssociatedPersons(s.Ctx, existingCustomer.CustomerID)
-		if err != nil {
-			return "", nil, fmt.Errorf("ListAssociatedPersons failed: %w", err)
-		}
-
-		for i := range *associatedPersonsResp {
-			associatedPersonIDs = append(associatedPersonIDs, (*associatedPersonsResp)[i].AssociatedPersonID)
-		}
-
-		return existingCustomer.CustomerID, associatedPersonIDs, nil
-	}
-
-	// No approved customer found, create a new one
-	s.T().Log("No approved customer found, creating new test customer...")
-	return s.CreateTestCustomer()
-}
-
-// TearDownSuite cleans up resources created during testing.
-// Note: Cleanup is currently disabled as resources cannot be fully deleted due to compliance requirements.
-func (*CustomerDependentTestSuite) TearDownSuite() {
-	// No cleanup performed - customer and associated resources cannot be deleted due to compliance requirements
-}
-
-// CreateTestCustomer creates a new customer with all required data for testing.
-// Returns the customer ID, list of associated person IDs, and any error.
-func (s *CustomerDependentTestSuite) CreateTestCustomer() (
-	customerID string,
-	associatedPersonIDs []string,
-	err error,
-) {
-	faker := gofakeit.New(0)
-
-	// Step 1: Create TOS link
-	tosResp, err := s.Client.Customer.CreateTOSLink(s.Ctx, &customer.CreateTOSLinkRequest{
-		RedirectUrl: "https://example.com/redirect",
-	})
-	if err != nil {
-		return "", nil, fmt.Errorf("CreateTOSLink failed: %w", err)
-	}
-
-	// Step 2: Sign the agreement
-	signResp, err := s.Client.Customer.SignTOSAgreement(s.Ctx, tosResp.SessionToken)
-	if err != nil {
-		return "", nil, fmt.Errorf("SignTOSAgreement failed: %w", err)
-	}
-
-	// Step 3: Create customer with associated persons
-	associatedPersons := []customer.AssociatedPerson{
-		FakeAssociatedPerson(faker),
-		FakeAssociatedPerson(faker),
-	}
-
-	req := &customer.CreateCustomerRequest{
-		BusinessLegalName:          faker.Company(),
-		BusinessDescription:        faker.JobDescriptor() + " " + faker.BS(),
-		BusinessRegistrationNumber: fmt.Sprintf("%s-%d", faker.LetterN(3), faker.Number(100000, 999999)),
-		Email:                      faker.Email(),
-		BusinessType:               customer.BusinessTypeCorporation,
-		BusinessIndustry:           "332999",
-		RegisteredAddress: &customer.Address{
-			StreetLine1: faker.Street(),
-			StreetLine2: fmt.Sprintf("Suite %d", faker.Number(100, 999)),
-			City:        faker.City(),
-			State:       RandomGermanState(faker),
-			Country:     CountryDEU,
-			PostalCode:  faker.Zip(),
-			Subdivision: RandomGermanState(faker),
-		},
-		DateOfIncorporation:            faker.Date().Format("2006-01-02"),
-		SignedAgreementID:              signResp.SignedAgreementID,
-		AssociatedPersons:              associatedPersons,
-		SourceOfFunds:                  []customer.SourceOfFunds{customer.SourceOfFundsSalesOfGoodsAndServices},
-		SourceOfWealth:                 []customer.SourceOfWealth{customer.SourceOfWealthBusinessDividendsOrProfits},
-		Documents:                      FakeCustomerDocuments(),
-		AccountPurpose:                 customer.AccountPurposeTreasuryManagement,
-		IsDAO:                          false,
-		PubliclyTraded:                 false,
-		EstimatedAnnualRevenueUSD:      customer.MoneyRange099999,
-		ExpectedMonthlyFiatDeposits:    customer.MoneyRange099999,
-		ExpectedMonthlyFiatWithdrawals: customer.MoneyRange099999,
-		TaxID:                          fmt.Sprintf("%d-%d", faker.Number(10, 99), faker.Number(1000000, 9999999)),
-		TaxType:                        customer.TaxIDTypeEIN,
-		TaxCountry:                     CountryDEU,
-	}
-
-	resp, err := s.Client.Customer.CreateCustomer(s.Ctx, req)
-	if err != nil {
-		return "", nil, fmt.Errorf("CreateCustomer failed: %w", err)
-	}
-
-	s.T().Logf("Customer created: %s, waiting for KYB approval...", resp.CustomerID)
-
-	// Wait for KYB approval
-	if resp.Status != customer.KybStatusApproved {
-		resp, err = customer.WaitForKybApproved(s.Ctx, s.Client.Customer, resp.CustomerID, nil)
-		if err != nil {
-			return "", nil, err
-		}
-	}
-
-	// Get associated person IDs from the created customer
-	associatedPersonsResp, err := s.Client.Customer.ListAssociatedPersons(s.Ctx, resp.CustomerID)
-	if err != nil {
-		return "", nil, fmt.Errorf("ListAssociatedPersons failed: %w", err)
-	}
-
-	for i := range *associatedPersonsResp {
-		associatedPersonIDs = append(associatedPersonIDs, (*associatedPersonsResp)[i].AssociatedPersonID)
-	}
-
-	customerID = resp.CustomerID
-	return customerID, associatedPersonIDs, nil
-}
-
 // EnsureExternalAccount ensures an approved external account exists for the customer.
 // If no external account exists, it creates one and polls until it reaches APPROVED status.
 // Returns the external account ID or an error if the account fails approval or times out.
@@ -295,7 +202,7 @@ func (s *CustomerDependentTestSuite) EnsureExternalAccount() (string, error) {
 
 	// Create a new external account if none exists
 	if accountID == "" {
-		createResp, err := s.Client.ExternalAccounts.CreateExternalAccount(s.Ctx, s.CustomerID, FakeExternalAccountRequest())
+		createResp, err := s.Client.ExternalAccounts.CreateExternalAccount(s.Ctx, s.CustomerID, s.Fixtures.ExternalAccountRequest())
 		if err != nil {
 			// Check if fiat account is not yet verified (400 error)
 			var apiErr *transport.APIError
@@ -336,7 +243,7 @@ func (s *CustomerDependentTestSuite) EnsureExternalAccount() (string, error) {
 //
 // If neither produces a transaction, an error is returned and callers may choose
 // to skip tests that require persisted transaction history.
-func (s *CustomerDependentTestSuite) EnsureTransaction() (string, error) {
+func (s *CustomerDependentTestSuite) EnsureTransaction() (svc.TransactionID, error) {
 	// Try to get existing transactions
 	txResp, err := s.Client.Transactions.ListTransactions(s.Ctx, s.CustomerID, nil)
 	if err != nil {
@@ -383,7 +290,7 @@ func (s *CustomerDependentTestSuite) EnsureAutoConversionRule() (string, error)
 	}
 
 	// Create a new auto conversion rule using fake data
-	createResp, err := s.Client.AutoConversionRules.CreateRule(s.Ctx, s.CustomerID, FakeAutoConversionRuleRequest())
+	createResp, err := s.Client.AutoConversionRules.CreateRule(s.Ctx, s.CustomerID, s.Fixtures.AutoConversionRuleRequest())
 	if err != nil {
 		return "", fmt.Errorf("CreateRule failed: %w", err)
 	}
@@ -410,31 +317,6 @@ func (s *CustomerDependentTestSuite) EnsureSignedAgreement() (string, error) {
 	return signResp.SignedAgreementID, nil
 }
 
-// FakeExternalAccountRequest generates a fake external account request for testing.
-// Uses uuid.New() for IdempotencyKey to ensure uniqueness across test runs.
-func FakeExternalAccountRequest() *external_accounts.CreateReq {
-	return &external_accounts.CreateReq{
-		IdempotencyKey: uuid.New().String(),
-		Network:        external_accounts.BankNetworkNameUSACH,
-		Currency:       external_accounts.CurrencyUSD,
-		CountryCode:    external_accounts.CountryCodeUSA,
-		// https://qodex.ai/all-tools/routing-number-generator
-		AccountNumber:   "5097935393",
-		InstitutionID:   "327984566",
-		InstitutionName: gofakeit.Company() + " Bank",
-	}
-}
-
-// FakeEthereumAddress generates a fake Ethereum wallet address for testing.
-// Returns a valid 42-character address (0x + 40 hex chars).
-func FakeEthereumAddress() string {
-	addrBytes := make([]byte, 20)
-	for i := range addrBytes {
-		addrBytes[i] = byte(gofakeit.Number(0, 255))
-	}
-	return fmt.Sprintf("0x%x", addrBytes)
-}
-
 // safeUint8 converts an int to uint8 with bounds checking to avoid overflow.
 func safeUint8(n int) uint8 {
 	if n < 0 {
@@ -468,63 +350,36 @@ func FakeImagePNG(width, height int) []byte {
 	return buf.Bytes()
 }
 
-// FakeCustomerDocuments generates documents required for customer creation.
-// Uses embedded real images from testdata directory.
-func FakeCustomerDocuments() []customer.Document {
-	// Use POA image as a generic document image for all document types
-	docImage := testdata.POA()
-
-	return []customer.Document{
-		{
-			DocType:     customer.DocumentTypeFlowOfFunds,
-			File:        docImage,
-			Description: "Proof of Funds",
-		},
-		{
-			DocType:     customer.DocumentTypeRegistrationDocument,
-			File:        docImage,
-			Description: "Certificate of Incorporation",
-		},
-		{
-			DocType:     customer.DocumentTypeProofOfTaxIdentification,
-			File:        docImage,
-			Description: "W9 Form",
-		},
-		{
-			DocType:     customer.DocumentTypeShareholderRegister,
-			File:        docImage,
-			Description: "Ownership Structure",
-		},
-		{
-			DocType:     customer.DocumentTypeESignatureCertificate,
-			File:        docImage,
-			Description: "Authorized Representative List",
-		},
-		{
-			DocType:     customer.DocumentTypeEvidenceOfGoodStanding,
-			File:        docImage,
-			Description: "Evidence of Good Standing",
-		},
-		{
-			DocType:     customer.DocumentTypeProofOfAddress,
-			File:        docImage,
-			Description: "Proof of Address",
-		},
-	}
-}
-
 // TestClient_Initialization tests client initialization.
 func (s *E2ETestSuite) TestClient_Initialization() {
 	s.Require().NotNil(s.Client, "Client should not be nil")
+	s.Require().NotNil(s.Client.APIKeys, "APIKeys service should be initialized")
+	s.Require().NotNil(s.Client.AddressAllowlist, "AddressAllowlist service should be initialized")
 	s.Require().NotNil(s.Client.Assets, "Assets service should be initialized")
+	s.Require().NotNil(s.Client.AuditLogs, "AuditLogs service should be initialized")
 	s.Require().NotNil(s.Client.AutoConversionRules, "AutoConversionRules service should be initialized")
 	s.Require().NotNil(s.Client.Conversions, "Conversions service should be initialized")
 	s.Require().NotNil(s.Client.Customer, "Customer service should be initialized")
 	s.Require().NotNil(s.Client.Echo, "Echo service should be initialized")
+	s.Require().NotNil(s.Client.Events, "Events service should be initialized")
 	s.Require().NotNil(s.Client.ExternalAccounts, "ExternalAccounts service should be initialized")
+	s.Require().NotNil(s.Client.Fees, "Fees service should be initialized")
 	s.Require().NotNil(s.Client.Instructions, "Instructions service should be initialized")
+	s.Require().NotNil(s.Client.Invoices, "Invoices service should be initialized")
+	s.Require().NotNil(s.Client.Ledger, "Ledger service should be initialized")
+	s.Require().NotNil(s.Client.Limits, "Limits service should be initialized")
+	s.Require().NotNil(s.Client.Notifications, "Notifications service should be initialized")
+	s.Require().NotNil(s.Client.Payouts, "Payouts service should be initialized")
+	s.Require().NotNil(s.Client.Platform, "Platform service should be initialized")
+	s.Require().NotNil(s.Client.Rates, "Rates service should be initialized")
+	s.Require().NotNil(s.Client.Recipients, "Recipients service should be initialized")
+	s.Require().NotNil(s.Client.Screening, "Screening service should be initialized")
 	s.Require().NotNil(s.Client.Simulations, "Simulations service should be initialized")
+	s.Require().NotNil(s.Client.Statements, "Statements service should be initialized")
+	s.Require().NotNil(s.Client.Status, "Status service should be initialized")
+	s.Require().NotNil(s.Client.SweepRules, "SweepRules service should be initialized")
 	s.Require().NotNil(s.Client.Transactions, "Transactions service should be initialized")
+	s.Require().NotNil(s.Client.TravelRule, "TravelRule service should be initialized")
 	s.Require().NotNil(s.Client.Withdrawals, "Withdrawals service should be initialized")
 	s.NotEmpty(s.Client.Version(), "Version should not be empty")
 }
@@ -559,29 +414,11 @@ func FakeXLSXData() []byte {
 	return buf.Bytes()
 }
 
-// FakeAutoConversionRuleRequest generates a fake auto conversion rule request for testing.
-// Creates a USD -> USDC (Polygon) conversion rule by default.
-// Uses uuid.New() for IdempotencyKey to ensure uniqueness across test runs.
-func FakeAutoConversionRuleRequest() *auto_conversion_rules.CreateRuleRequest {
-	network := "POLYGON"
-	return &auto_conversion_rules.CreateRuleRequest{
-		IdempotencyKey: uuid.New().String(),
-		Source: auto_conversion_rules.SourceAssetInfo{
-			Asset:   "USD",
-			Network: "US_ACH",
-		},
-		Destination: auto_conversion_rules.DestinationAssetInfo{
-			Asset:   "USDC",
-			Network: &network,
-		},
-	}
-}
-
 // PendingCustomerTestSuite is a test suite for tests that require a customer WITHOUT KYB approval.
 // This is needed for tests that modify associated persons, which cannot be modified after KYB approval.
 type PendingCustomerTestSuite struct {
 	E2ETestSuite
-	CustomerID          string
+	CustomerID          svc.CustomerID
 	AssociatedPersonIDs []string
 }
 
@@ -598,131 +435,12 @@ func (s *PendingCustomerTestSuite) SetupSuite() {
 	s.AssociatedPersonIDs = associatedPersonIDs
 }
 
-// CreatePendingCustomer creates a new customer with all required data but does NOT wait for KYB approval.
+// CreatePendingCustomer creates a new namespaced customer but does NOT approve it.
 // This allows tests to modify associated persons before the customer is approved.
 func (s *PendingCustomerTestSuite) CreatePendingCustomer() (
-	customerID string,
+	customerID svc.CustomerID,
 	associatedPersonIDs []string,
 	err error,
 ) {
-	faker := gofakeit.New(0)
-
-	// Step 1: Create TOS link
-	tosResp, err := s.Client.Customer.CreateTOSLink(s.Ctx, &customer.CreateTOSLinkRequest{
-		RedirectUrl: "https://example.com/redirect",
-	})
-	if err != nil {
-		return "", nil, fmt.Errorf("CreateTOSLink failed: %w", err)
-	}
-
-	// Step 2: Sign the agreement
-	signResp, err := s.Client.Customer.SignTOSAgreement(s.Ctx, tosResp.SessionToken)
-	if err != nil {
-		return "", nil, fmt.Errorf("SignTOSAgreement failed: %w", err)
-	}
-
-	// Step 3: Create customer with associated persons
-	associatedPersons := []customer.AssociatedPerson{
-		FakeAssociatedPerson(faker),
-		FakeAssociatedPerson(faker),
-	}
-
-	req := &customer.CreateCustomerRequest{
-		BusinessLegalName:          faker.Company(),
-		BusinessDescription:        faker.JobDescriptor() + " " + faker.BS(),
-		BusinessRegistrationNumber: fmt.Sprintf("%s-%d", faker.LetterN(3), faker.Number(100000, 999999)),
-		Email:                      faker.Email(),
-		BusinessType:               customer.BusinessTypeCorporation,
-		BusinessIndustry:           "332999",
-		RegisteredAddress: &customer.Address{
-			StreetLine1: faker.Street(),
-			StreetLine2: fmt.Sprintf("Suite %d", faker.Number(100, 999)),
-			City:        faker.City(),
-			State:       RandomGermanState(faker),
-			Country:     CountryDEU,
-			PostalCode:  faker.Zip(),
-			Subdivision: RandomGermanState(faker),
-		},
-		DateOfIncorporation:            faker.Date().Format("2006-01-02"),
-		SignedAgreementID:              signResp.SignedAgreementID,
-		AssociatedPersons:              associatedPersons,
-		SourceOfFunds:                  []customer.SourceOfFunds{customer.SourceOfFundsSalesOfGoodsAndServices},
-		SourceOfWealth:                 []customer.SourceOfWealth{customer.SourceOfWealthBusinessDividendsOrProfits},
-		Documents:                      FakeCustomerDocuments(),
-		AccountPurpose:                 customer.AccountPurposeTreasuryManagement,
-		IsDAO:                          false,
-		PubliclyTraded:                 false,
-		EstimatedAnnualRevenueUSD:      customer.MoneyRange099999,
-		ExpectedMonthlyFiatDeposits:    customer.MoneyRange099999,
-		ExpectedMonthlyFiatWithdrawals: customer.MoneyRange099999,
-		TaxID:                          fmt.Sprintf("%d-%d", faker.Number(10, 99), faker.Number(1000000, 9999999)),
-		TaxType:                        customer.TaxIDTypeEIN,
-		TaxCountry:                     CountryDEU,
-	}
-
-	resp, err := s.Client.Customer.CreateCustomer(s.Ctx, req)
-	if err != nil {
-		return "", nil, fmt.Errorf("CreateCustomer failed: %w", err)
-	}
-
-	s.T().Logf("Created pending customer: %s (NOT waiting for KYB approval)", resp.CustomerID)
-
-	// Get associated person IDs from the created customer
-	associatedPersonsResp, err := s.Client.Customer.ListAssociatedPersons(s.Ctx, resp.CustomerID)
-	if err != nil {
-		return "", nil, fmt.Errorf("ListAssociatedPersons failed: %w", err)
-	}
-
-	for i := range *associatedPersonsResp {
-		associatedPersonIDs = append(associatedPersonIDs, (*associatedPersonsResp)[i].AssociatedPersonID)
-	}
-
-	return resp.CustomerID, associatedPersonIDs, nil
-}
-
-// FakeAssociatedPerson generates a fake associated person for testing.
-// Uses embedded real images from testdata directory.
-func FakeAssociatedPerson(faker *gofakeit.Faker) customer.AssociatedPerson {
-	// Randomly select gender
-	gender := customer.GenderMale
-	if faker.Bool() {
-		gender = customer.GenderFemale
-	}
-
-	return customer.AssociatedPerson{
-		FirstName: faker.FirstName(),
-		LastName:  faker.LastName(),
-		Email:     faker.Email(),
-		Gender:    gender,
-		ResidentialAddress: &customer.Address{
-			StreetLine1: faker.Street(),
-			City:        faker.City(),
-			State:       RandomGermanState(faker),
-			Country:     CountryDEU,
-			PostalCode:  faker.Zip(),
-			Subdivision: RandomGermanState(faker),
-		},
-		BirthDate:           faker.Date().Format("2006-01-02"),
-		CountryOfBirth:      CountryDEU,
-		PrimaryNationality:  CountryDEU,
-		HasOwnership:        true,
-		OwnershipPercentage: 100,
-		HasControl:          true,
-		IsSigner:            true,
-		IsDirector:          true,
-		IdentifyingInformation: []customer.IdentifyingInformation{
-			{
-				Type:                   customer.IDTypeNationalId,
-				IssuingCountry:         external_accounts.CountryCodeDEU.String(),
-				ImageFront:             testdata.IDFront(),
-				ImageBack:              testdata.IDBack(),
-				NationalIdentityNumber: faker.LetterN(8) + faker.DigitN(4),
-			},
-		},
-		CountryOfTax: CountryDEU,
-		TaxType:      customer.TaxIDTypeSSN,
-		TaxID:        faker.SSN(),
-		POA:          testdata.POA(),
-		POAType:      "utility_bill",
-	}
+	return s.NewTestCustomer(false)
 }
@@ -0,0 +1,99 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package e2e
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/sweep_rules"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/withdraws"
+)
+
+// SweepRulesTestSuite tests sweep rules service operations.
+type SweepRulesTestSuite struct {
+	CustomerDependentTestSuite
+}
+
+// TestSweepRules_Lifecycle tests the rule lifecycle: Create → List → Pause → Resume → Executions → Delete
+func (s *SweepRulesTestSuite) TestSweepRules_Lifecycle() {
+	externalAccountID, err := s.EnsureExternalAccount()
+	s.Require().NoError(err, "EnsureExternalAccount should succeed")
+
+	created, err := s.Client.SweepRules.CreateRule(s.Ctx, s.CustomerID, &sweep_rules.CreateRuleRequest{
+		IdempotencyKey:    s.IdempotencyKey("create-rule"),
+		Nickname:          "e2e-" + uuid.New().String()[:8],
+		Asset:             assets.AssetNameUSD,
+		Network:           assets.NetworkNameUSACH,
+		TargetBalance:     "50000",
+		ExternalAccountID: externalAccountID,
+		Schedule: sweep_rules.Schedule{
+			Frequency: sweep_rules.FrequencyWEEKLY,
+			DayOfWeek: withdraws.WeekdayFRIDAY,
+			TimeOfDay: "17:00",
+		},
+	})
+	s.Require().NoError(err, "CreateRule should succeed")
+	s.NotEmpty(created.SweepRuleID)
+	s.Equal(sweep_rules.RuleStatusACTIVE, created.Status)
+	s.Equal(externalAccountID, created.ExternalAccountID)
+
+	listed, err := s.Client.SweepRules.ListRules(s.Ctx, s.CustomerID, &sweep_rules.ListRulesRequest{
+		Status: sweep_rules.RuleStatusACTIVE,
+	})
+	s.Require().NoError(err, "ListRules should succeed")
+	found := false
+	for i := range listed.List {
+		if listed.List[i].SweepRuleID == created.SweepRuleID {
+			found = true
+			break
+		}
+	}
+	s.True(found, "Created rule should be listed")
+
+	paused, err := s.Client.SweepRules.PauseRule(s.Ctx, s.CustomerID, created.SweepRuleID)
+	s.Require().NoError(err, "PauseRule should succeed")
+	s.Equal(sweep_rules.RuleStatusPAUSED, paused.Status)
+
+	resumed, err := s.Client.SweepRules.ResumeRule(s.Ctx, s.CustomerID, created.SweepRuleID)
+	s.Require().NoError(err, "ResumeRule should succeed")
+	s.Equal(sweep_rules.RuleStatusACTIVE, resumed.Status)
+
+	executions, err := s.Client.SweepRules.ListExecutions(s.Ctx, s.CustomerID, created.SweepRuleID, nil)
+	s.Require().NoError(err, "ListExecutions should succeed")
+	for _, execution := range executions.List {
+		s.Equal(created.SweepRuleID, execution.SweepRuleID)
+		s.True(execution.Status.IsValid(), "Execution status should be a known value")
+	}
+
+	err = s.Client.SweepRules.DeleteRule(s.Ctx, s.CustomerID, created.SweepRuleID)
+	s.Require().NoError(err, "DeleteRule should succeed")
+
+	deleted, err := s.Client.SweepRules.GetRule(s.Ctx, s.CustomerID, created.SweepRuleID)
+	s.Require().NoError(err, "GetRule should succeed after deletion")
+	s.Equal(sweep_rules.RuleStatusDELETED, deleted.Status)
+
+	s.T().Logf("Deleted rule:\n%s", PrettyJSON(deleted))
+}
+
+// TestSweepRulesTestSuite runs the sweep rules test suite.
+func TestSweepRulesTestSuite(t *testing.T) {
+	suite.Run(t, new(SweepRulesTestSuite))
+}
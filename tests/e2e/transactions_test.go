@@ -17,10 +17,14 @@
 package e2e
 
 import (
+	"bytes"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/1Money-Co/1money-go-sdk/internal/transport"
 	"github.com/1Money-Co/1money-go-sdk/pkg/service/assets"
 	"github.com/1Money-Co/1money-go-sdk/pkg/service/transactions"
 )
@@ -124,6 +128,31 @@ func (s *TransactionsTestSuite) TestTransactions_List() {
 
 		s.T().Logf("Listed %d USD transactions", len(resp.List))
 	})
+
+	s.Run("FilterByActionStatusAndTime", func() {
+		_, err := s.EnsureTransaction()
+		if err != nil {
+			s.T().Skipf("Skipping FilterByActionStatusAndTime: %v", err)
+		}
+
+		req := &transactions.ListTransactionsRequest{
+			StartTime:         time.Now().Add(-24 * time.Hour),
+			EndTime:           time.Now().Add(time.Minute),
+			TransactionAction: transactions.TransactionActionDEPOSIT,
+			Direction:         transactions.TransactionDirectionINBOUND,
+		}
+
+		resp, err := s.Client.Transactions.ListTransactions(s.Ctx, s.CustomerID, req)
+		s.Require().NoError(err, "ListTransactions should succeed")
+		s.Require().NotNil(resp, "Response should not be nil")
+
+		for i := range resp.List {
+			s.Equal(string(transactions.TransactionActionDEPOSIT), resp.List[i].TransactionAction,
+				"All filtered transactions should be deposits")
+		}
+
+		s.T().Logf("Listed %d deposits in the last 24h", len(resp.List))
+	})
 }
 
 // TestTransactions_GetTransaction tests retrieving a specific transaction.
@@ -150,12 +179,120 @@ func (s *TransactionsTestSuite) TestTransactions_GetTransaction() {
 	s.NotEmpty(resp.ModifiedAt, "ModifiedAt should not be empty")
 
 	// Validate transaction action is valid
-	validActions := []string{"DEPOSIT", "WITHDRAWAL", "CONVERSION"}
-	s.Contains(validActions, resp.TransactionAction, "TransactionAction should be valid")
+	s.True(resp.Action().IsValid(), "TransactionAction should be valid")
+
+	createdAt, err := resp.CreatedTime()
+	s.Require().NoError(err, "CreatedAt should parse")
+	s.False(createdAt.IsZero(), "CreatedAt should not be zero")
 
 	s.T().Logf("Retrieved transaction:\n%s", PrettyJSON(resp))
 }
 
+// TestTransactions_GetByIdempotencyKey tests looking up a transaction by its idempotency key.
+func (s *TransactionsTestSuite) TestTransactions_GetByIdempotencyKey() {
+	transactionID, err := s.EnsureTransaction()
+	if err != nil {
+		s.T().Skipf("Skipping GetByIdempotencyKey: %v", err)
+	}
+
+	tx, err := s.Client.Transactions.GetTransaction(s.Ctx, s.CustomerID, transactionID)
+	s.Require().NoError(err, "GetTransaction should succeed")
+	if tx.IdempotencyKey == "" {
+		s.T().Skip("Transaction has no idempotency key")
+	}
+
+	resp, err := s.Client.Transactions.GetTransactionByIdempotencyKey(s.Ctx, s.CustomerID, tx.IdempotencyKey)
+	s.Require().NoError(err, "GetTransactionByIdempotencyKey should succeed")
+	s.Equal(transactionID, resp.TransactionID)
+
+	_, err = s.Client.Transactions.GetTransactionByIdempotencyKey(s.Ctx, s.CustomerID, uuid.New().String())
+	s.True(transport.IsNotFoundError(err), "Unknown idempotency key should return not found")
+}
+
+// TestTransactions_GetChain tests that a transaction's chain includes the transaction itself.
+func (s *TransactionsTestSuite) TestTransactions_GetChain() {
+	transactionID, err := s.EnsureTransaction()
+	if err != nil {
+		s.T().Skipf("Skipping GetChain: %v", err)
+	}
+
+	chain, err := s.Client.Transactions.GetChain(s.Ctx, s.CustomerID, transactionID)
+	s.Require().NoError(err, "GetChain should succeed")
+	s.Require().NotNil(chain)
+	s.NotEmpty(chain.RootTransactionID, "RootTransactionID should not be empty")
+
+	found := false
+	for i := range chain.Transactions {
+		if chain.Transactions[i].TransactionID == transactionID {
+			found = true
+		}
+	}
+	s.True(found, "Chain should include the requested transaction")
+
+	s.T().Logf("Transaction chain:\n%s", PrettyJSON(chain))
+}
+
+// TestTransactions_UpdateMetadata tests attaching notes and tags and filtering by tag.
+func (s *TransactionsTestSuite) TestTransactions_UpdateMetadata() {
+	transactionID, err := s.EnsureTransaction()
+	if err != nil {
+		s.T().Skipf("Skipping UpdateMetadata: %v", err)
+	}
+
+	tag := "e2e-" + uuid.New().String()[:8]
+	resp, err := s.Client.Transactions.UpdateMetadata(s.Ctx, s.CustomerID, transactionID,
+		"refund for invoice 123", []string{tag})
+	s.Require().NoError(err, "UpdateMetadata should succeed")
+	s.Equal("refund for invoice 123", resp.Notes)
+	s.Contains(resp.Tags, tag)
+
+	listResp, err := s.Client.Transactions.ListTransactions(s.Ctx, s.CustomerID,
+		&transactions.ListTransactionsRequest{Tag: tag})
+	s.Require().NoError(err, "ListTransactions by tag should succeed")
+	s.Require().Len(listResp.List, 1, "Exactly one transaction should carry the tag")
+	s.Equal(transactionID, listResp.List[0].TransactionID)
+}
+
+// TestTransactions_GetReconciliationSummary tests the reconciliation report for the current month.
+func (s *TransactionsTestSuite) TestTransactions_GetReconciliationSummary() {
+	now := time.Now().UTC()
+	period := transactions.MonthPeriod(now.Year(), now.Month(), time.UTC)
+
+	resp, err := s.Client.Transactions.GetReconciliationSummary(s.Ctx, s.CustomerID, period)
+	s.Require().NoError(err, "GetReconciliationSummary should succeed")
+	s.Require().NotNil(resp)
+
+	for i := range resp.Assets {
+		s.NotEmpty(resp.Assets[i].Asset, "Asset should not be empty")
+		s.NotEmpty(resp.Assets[i].ClosingBalance, "ClosingBalance should not be empty")
+	}
+
+	s.T().Logf("Reconciliation summary:\n%s", PrettyJSON(resp))
+}
+
+// TestTransactions_GetReceipt tests downloading a transaction receipt in both formats.
+func (s *TransactionsTestSuite) TestTransactions_GetReceipt() {
+	transactionID, err := s.EnsureTransaction()
+	if err != nil {
+		s.T().Skipf("Skipping GetReceipt: %v", err)
+	}
+
+	s.Run("JSON", func() {
+		resp, err := s.Client.Transactions.GetReceipt(s.Ctx, s.CustomerID, transactionID, transactions.ReceiptFormatJSON)
+		s.Require().NoError(err, "GetReceipt should succeed")
+		s.Require().NotNil(resp.Receipt, "Structured receipt should be set")
+		s.Equal(transactionID, resp.Receipt.TransactionID, "TransactionID should match")
+		s.T().Logf("Receipt:\n%s", PrettyJSON(resp.Receipt))
+	})
+
+	s.Run("PDF", func() {
+		resp, err := s.Client.Transactions.GetReceipt(s.Ctx, s.CustomerID, transactionID, transactions.ReceiptFormatPDF)
+		s.Require().NoError(err, "GetReceipt should succeed")
+		s.True(bytes.HasPrefix(resp.Content, []byte("%PDF")), "Content should be a PDF document")
+		s.T().Logf("Receipt PDF: %d bytes", len(resp.Content))
+	})
+}
+
 // TestTransactionsTestSuite runs the transactions test suite.
 func TestTransactionsTestSuite(t *testing.T) {
 	suite.Run(t, new(TransactionsTestSuite))
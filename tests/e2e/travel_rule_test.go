@@ -0,0 +1,92 @@
+/*
+ * Copyright 2025 1Money Co.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package e2e
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/travel_rule"
+	"github.com/1Money-Co/1money-go-sdk/pkg/service/withdraws"
+)
+
+// TravelRuleTestSuite tests travel rule service operations.
+type TravelRuleTestSuite struct {
+	CustomerDependentTestSuite
+}
+
+// TestTravelRule_SubmitAndGet tests submitting a packet for a transaction and reading it back.
+func (s *TravelRuleTestSuite) TestTravelRule_SubmitAndGet() {
+	transactionID, err := s.EnsureTransaction()
+	s.Require().NoError(err, "EnsureTransaction should succeed")
+
+	packet, err := s.Client.TravelRule.SubmitPacket(s.Ctx, s.CustomerID, &travel_rule.SubmitPacketRequest{
+		IdempotencyKey: s.IdempotencyKey("submit-packet"),
+		TransactionID:  transactionID,
+		Originator: withdraws.TravelRuleParty{
+			Type:      withdraws.PartyTypeLEGALPERSON,
+			LegalName: "E2E Test Corp",
+			Address:   &withdraws.TravelRuleAddress{TownName: "New York", Country: "US"},
+		},
+		Beneficiary: withdraws.TravelRuleParty{
+			Type:          withdraws.PartyTypeNATURALPERSON,
+			FirstName:     "Jane",
+			LastName:      "Doe",
+			AccountNumber: s.Fixtures.EthereumAddress(),
+		},
+	})
+	s.Require().NoError(err, "SubmitPacket should succeed")
+	s.NotEmpty(packet.PacketID)
+	s.Equal(transactionID, packet.TransactionID)
+	s.Equal(travel_rule.PacketDirectionOUTBOUND, packet.Direction)
+	s.True(packet.Status.IsValid(), "Packet status should be a known value")
+
+	fetched, err := s.Client.TravelRule.GetPacket(s.Ctx, s.CustomerID, packet.PacketID)
+	s.Require().NoError(err, "GetPacket should succeed")
+	s.Equal(packet.PacketID, fetched.PacketID)
+	s.Equal("E2E Test Corp", fetched.Originator.LegalName)
+
+	listed, err := s.Client.TravelRule.ListPackets(s.Ctx, s.CustomerID, &travel_rule.ListPacketsRequest{
+		TransactionID: transactionID,
+	})
+	s.Require().NoError(err, "ListPackets should succeed")
+	found := false
+	for i := range listed.List {
+		s.Equal(transactionID, listed.List[i].TransactionID, "List should be filtered by transaction")
+		found = found || listed.List[i].PacketID == packet.PacketID
+	}
+	s.True(found, "Submitted packet should be listed")
+
+	s.T().Logf("Packet:\n%s", PrettyJSON(fetched))
+}
+
+// TestTravelRule_ListInbound tests listing packets received from counterparty VASPs.
+func (s *TravelRuleTestSuite) TestTravelRule_ListInbound() {
+	listed, err := s.Client.TravelRule.ListPackets(s.Ctx, s.CustomerID, &travel_rule.ListPacketsRequest{
+		Direction: travel_rule.PacketDirectionINBOUND,
+	})
+	s.Require().NoError(err, "ListPackets should succeed")
+	for i := range listed.List {
+		s.Equal(travel_rule.PacketDirectionINBOUND, listed.List[i].Direction)
+	}
+}
+
+// TestTravelRuleTestSuite runs the travel rule test suite.
+func TestTravelRuleTestSuite(t *testing.T) {
+	suite.Run(t, new(TravelRuleTestSuite))
+}
@@ -78,7 +78,7 @@ type withdrawalTestCase struct {
 	isFiat  bool
 }
 
-// TestWithdrawals_Flow tests the complete withdrawal flow: Create → GetByID → GetByIdempotencyKey
+// TestWithdrawals_Flow tests the complete withdrawal flow: Create → GetByID → GetByIdempotencyKey → List
 func (s *WithdrawalsTestSuite) TestWithdrawals_Flow() {
 	testCases := []withdrawalTestCase{
 		{
@@ -99,7 +99,7 @@ func (s *WithdrawalsTestSuite) TestWithdrawals_Flow() {
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			idempotencyKey := uuid.New().String()
+			idempotencyKey := s.IdempotencyKey("create-withdrawal")
 
 			// Step 1: Create Withdrawal
 			req := &withdraws.CreateWithdrawalRequest{
@@ -158,10 +158,131 @@ func (s *WithdrawalsTestSuite) TestWithdrawals_Flow() {
 			s.Require().NotNil(txResp)
 
 			s.T().Logf("Transactions: total=%d, returned=%d", txResp.Total, len(txResp.List))
+
+			// Step 5: List Withdrawals filtered by asset
+			listResp, err := s.Client.Withdrawals.ListWithdrawals(s.Ctx, s.CustomerID, &withdraws.ListWithdrawalsRequest{
+				Asset: tc.asset,
+				Page:  1,
+				Size:  20,
+			})
+			s.Require().NoError(err, "ListWithdrawals should succeed")
+			s.Require().NotNil(listResp)
+
+			for i := range listResp.List {
+				s.Equal(string(tc.asset), listResp.List[i].Asset, "All filtered withdrawals should match asset")
+				s.True(listResp.List[i].Status.IsValid(), "Withdrawal status should be a known value")
+			}
+
+			s.T().Logf("Withdrawals: total=%d, returned=%d", listResp.Total, len(listResp.List))
 		})
 	}
 }
 
+// TestWithdrawals_EstimateFee tests fee estimation for fiat and crypto withdrawals.
+func (s *WithdrawalsTestSuite) TestWithdrawals_EstimateFee() {
+	testCases := []withdrawalTestCase{
+		{name: "Fiat_USD_ACH", asset: assets.AssetNameUSD, network: assets.NetworkNameUSACH, amount: "10.00"},
+		{name: "Crypto_USDT_Ethereum", asset: assets.AssetNameUSDT, network: assets.NetworkNameETHEREUM, amount: "10.00"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			resp, err := s.Client.Withdrawals.EstimateFee(s.Ctx, s.CustomerID, tc.asset, tc.network, tc.amount)
+			s.Require().NoError(err, "EstimateFee should succeed")
+			s.Require().NotNil(resp)
+
+			s.Equal(string(tc.asset), resp.Asset)
+			s.NotEmpty(resp.NetAmount, "NetAmount should not be empty")
+			s.LessOrEqual(resp.ETA.MinSeconds, resp.ETA.MaxSeconds, "ETA window should be ordered")
+
+			s.T().Logf("Fee estimate:\n%s", PrettyJSON(resp))
+		})
+	}
+}
+
+// TestWithdrawals_CreateOrRecover tests that retrying with the same idempotency key recovers the original withdrawal.
+func (s *WithdrawalsTestSuite) TestWithdrawals_CreateOrRecover() {
+	idempotencyKey := uuid.New().String()
+
+	missing, err := withdraws.FindByIdempotencyKey(s.Ctx, s.Client.Withdrawals, s.CustomerID, idempotencyKey)
+	s.Require().NoError(err, "FindByIdempotencyKey should not fail for an unknown key")
+	s.Nil(missing, "Unknown idempotency key should not resolve to a withdrawal")
+
+	req := &withdraws.CreateWithdrawalRequest{
+		IdempotencyKey:    idempotencyKey,
+		Amount:            "1.00",
+		Asset:             assets.AssetNameUSD,
+		Network:           assets.NetworkNameUSACH,
+		ExternalAccountID: s.externalAccountID,
+	}
+
+	first, err := withdraws.CreateOrRecover(s.Ctx, s.Client.Withdrawals, s.CustomerID, req)
+	s.Require().NoError(err, "CreateOrRecover should create the withdrawal")
+
+	second, err := withdraws.CreateOrRecover(s.Ctx, s.Client.Withdrawals, s.CustomerID, req)
+	s.Require().NoError(err, "CreateOrRecover should recover the withdrawal")
+	s.Equal(first.TransactionID, second.TransactionID, "Retry should return the original withdrawal")
+}
+
+// TestWithdrawals_GetLimits tests retrieving withdrawal limits for fiat and crypto networks.
+func (s *WithdrawalsTestSuite) TestWithdrawals_GetLimits() {
+	networks := []assets.NetworkName{assets.NetworkNameUSACH, assets.NetworkNameETHEREUM}
+
+	for _, network := range networks {
+		s.Run(string(network), func() {
+			resp, err := s.Client.Withdrawals.GetLimits(s.Ctx, s.CustomerID, network)
+			s.Require().NoError(err, "GetLimits should succeed")
+			s.Require().NotNil(resp)
+
+			s.Equal(string(network), resp.Network)
+			s.NotEmpty(resp.MinAmount, "MinAmount should not be empty")
+			s.NotEmpty(resp.DailyRemaining, "DailyRemaining should not be empty")
+
+			s.T().Logf("Limits:\n%s", PrettyJSON(resp))
+		})
+	}
+}
+
+// TestWithdrawals_Scheduled tests the scheduled withdrawal flow: Create → List → Cancel
+func (s *WithdrawalsTestSuite) TestWithdrawals_Scheduled() {
+	createResp, err := s.Client.Withdrawals.CreateScheduledWithdrawal(s.Ctx, s.CustomerID,
+		&withdraws.CreateScheduledWithdrawalRequest{
+			IdempotencyKey: s.IdempotencyKey("schedule-withdrawal"),
+			Type:           withdraws.ScheduleTypeWEEKLY,
+			Weekly: &withdraws.WeeklySchedule{
+				DayOfWeek: withdraws.WeekdayFRIDAY,
+				TimeOfDay: "17:00",
+			},
+			Asset:             assets.AssetNameUSD,
+			Network:           assets.NetworkNameUSACH,
+			ExternalAccountID: s.externalAccountID,
+		})
+	s.Require().NoError(err, "CreateScheduledWithdrawal should succeed")
+	s.Require().NotNil(createResp)
+	s.NotEmpty(createResp.ScheduleID)
+	s.Equal(withdraws.ScheduleStatusACTIVE, createResp.Status)
+
+	listResp, err := s.Client.Withdrawals.ListScheduledWithdrawals(s.Ctx, s.CustomerID,
+		&withdraws.ListScheduledWithdrawalsRequest{Status: withdraws.ScheduleStatusACTIVE})
+	s.Require().NoError(err, "ListScheduledWithdrawals should succeed")
+	s.Require().NotNil(listResp)
+
+	found := false
+	for i := range listResp.List {
+		if listResp.List[i].ScheduleID == createResp.ScheduleID {
+			found = true
+			break
+		}
+	}
+	s.True(found, "Created schedule should be listed")
+
+	cancelResp, err := s.Client.Withdrawals.CancelScheduledWithdrawal(s.Ctx, s.CustomerID, createResp.ScheduleID)
+	s.Require().NoError(err, "CancelScheduledWithdrawal should succeed")
+	s.Equal(withdraws.ScheduleStatusCANCELLED, cancelResp.Status)
+
+	s.T().Logf("Scheduled withdrawal cancelled:\n%s", PrettyJSON(cancelResp))
+}
+
 // TestWithdrawalsTestSuite runs the withdrawals test suite.
 func TestWithdrawalsTestSuite(t *testing.T) {
 	suite.Run(t, new(WithdrawalsTestSuite))